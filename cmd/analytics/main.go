@@ -0,0 +1,291 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/Blogem/enron-graph/ent"
+	"github.com/Blogem/enron-graph/internal/graph/analytics"
+	"github.com/Blogem/enron-graph/pkg/utils"
+	_ "github.com/lib/pq"
+	"github.com/spf13/cobra"
+)
+
+// Graph analytics CLI: compute centrality scores and rank the most central entities, and
+// detect communities in the communication graph
+
+var rootCmd = &cobra.Command{
+	Use:   "analytics",
+	Short: "Graph analytics tool",
+	Long:  "Computes structural metrics over the entity graph and ranks entities by them",
+}
+
+var centralityCmd = &cobra.Command{
+	Use:   "centrality",
+	Short: "Centrality of entities: degree, weighted degree, PageRank, betweenness and closeness",
+}
+
+var computeCmd = &cobra.Command{
+	Use:   "compute",
+	Short: "Compute and store centrality scores",
+	Long:  "Computes the centrality of every entity over the relationships matching the filter and replaces the stored scores of that scope",
+	RunE:  runCompute,
+}
+
+var topCmd = &cobra.Command{
+	Use:   "top",
+	Short: "Rank entities by a stored centrality metric",
+	RunE:  runTop,
+}
+
+var communitiesCmd = &cobra.Command{
+	Use:   "communities",
+	Short: "Communities of entities that communicate mostly with each other",
+}
+
+var detectCmd = &cobra.Command{
+	Use:   "detect",
+	Short: "Detect and store communities",
+	Long:  "Runs Louvain community detection over the COMMUNICATES_WITH, SENT and RECEIVED relationships and replaces the stored communities and MEMBER_OF relationships",
+	RunE:  runDetect,
+}
+
+var listCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the stored communities, largest first",
+	RunE:  runList,
+}
+
+var showCmd = &cobra.Command{
+	Use:   "show [community-id]",
+	Short: "Show the members of a community",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runShow,
+}
+
+var (
+	filterTypes string
+	filterSince string
+	filterUntil string
+	samples     int
+	metric      string
+	topN        int
+	resolution  float64
+	seed        int64
+	minSize     int
+)
+
+func init() {
+	centralityCmd.PersistentFlags().StringVar(&filterTypes, "types", "", "Comma-separated relationship types to include (default all)")
+	centralityCmd.PersistentFlags().StringVar(&filterSince, "since", "", "Only relationships at or after this date (2001-01-01 or RFC 3339)")
+	centralityCmd.PersistentFlags().StringVar(&filterUntil, "until", "", "Only relationships before this date (2001-01-01 or RFC 3339)")
+	centralityCmd.PersistentFlags().StringVar(&metric, "metric", analytics.MetricPageRank, "Metric to rank by: degree, weighted_degree, pagerank, betweenness or closeness")
+	centralityCmd.PersistentFlags().IntVar(&topN, "top", 20, "Number of entities to display")
+	computeCmd.Flags().IntVar(&samples, "samples", 0, "Estimate betweenness and closeness from this many sources (0 for exact)")
+
+	detectCmd.Flags().Float64Var(&resolution, "resolution", 1, "Higher values give more, smaller communities")
+	detectCmd.Flags().Int64Var(&seed, "seed", 0, "Seed for the order entities are visited in")
+	communitiesCmd.PersistentFlags().IntVar(&minSize, "min-size", 2, "Smallest community to display")
+	communitiesCmd.PersistentFlags().IntVar(&topN, "top", 20, "Number of communities or members to display")
+
+	centralityCmd.AddCommand(computeCmd, topCmd)
+	communitiesCmd.AddCommand(detectCmd, listCmd, showCmd)
+	rootCmd.AddCommand(centralityCmd, communitiesCmd)
+}
+
+func getDBClient() (*ent.Client, error) {
+	cfg, err := utils.LoadConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config: %w", err)
+	}
+	client, err := ent.Open("postgres", cfg.DatabaseURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+	return client, nil
+}
+
+func parseFlags() (analytics.Filter, error) {
+	filter, err := analytics.ParseFilter(filterTypes, filterSince, filterUntil)
+	if err != nil {
+		return analytics.Filter{}, err
+	}
+	if err := analytics.ValidateMetric(metric); err != nil {
+		return analytics.Filter{}, err
+	}
+	return filter, nil
+}
+
+func runCompute(cmd *cobra.Command, args []string) error {
+	filter, err := parseFlags()
+	if err != nil {
+		return err
+	}
+	client, err := getDBClient()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	ctx := context.Background()
+	result, err := analytics.Run(ctx, client, filter, analytics.Options{Samples: samples}, utils.NewLogger())
+	if err != nil {
+		return fmt.Errorf("centrality failed: %w", err)
+	}
+	fmt.Printf("✓ Computed centrality of %d entities over %d relationships in %s\n",
+		result.Entities, result.Relationships, result.Duration.Round(time.Millisecond))
+	fmt.Printf("  Scope: %s\n\n", result.Scope)
+
+	return printTop(ctx, client, result.Scope)
+}
+
+func runTop(cmd *cobra.Command, args []string) error {
+	filter, err := parseFlags()
+	if err != nil {
+		return err
+	}
+	client, err := getDBClient()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	return printTop(context.Background(), client, filter.Scope())
+}
+
+func printTop(ctx context.Context, client *ent.Client, scope string) error {
+	ranked, err := analytics.TopEntities(ctx, client, scope, metric, topN)
+	if err != nil {
+		return err
+	}
+	if len(ranked) == 0 {
+		fmt.Printf("No centrality scores for scope %q, run `analytics centrality compute` first\n", scope)
+		return nil
+	}
+
+	fmt.Printf("Top %d entities by %s (scope %s, computed %s):\n\n",
+		len(ranked), metric, scope, ranked[0].ComputedAt.Format("2006-01-02 15:04:05"))
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Rank\tEntity\tType\tDegree\tWeighted\tPageRank\tBetweenness\tCloseness")
+	fmt.Fprintln(w, "----\t------\t----\t------\t--------\t--------\t-----------\t---------")
+	for _, e := range ranked {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%d\t%.2f\t%.5f\t%.4f\t%.4f\n",
+			e.Rank,
+			e.Name,
+			e.TypeCategory,
+			e.Degree,
+			e.WeightedDegree,
+			e.PageRank,
+			e.Betweenness,
+			e.Closeness,
+		)
+	}
+	w.Flush()
+
+	return nil
+}
+
+func runDetect(cmd *cobra.Command, args []string) error {
+	client, err := getDBClient()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	ctx := context.Background()
+	opts := analytics.CommunityOptions{Resolution: resolution, Seed: seed}
+	result, err := analytics.DetectCommunities(ctx, client, opts, utils.NewLogger())
+	if err != nil {
+		return fmt.Errorf("community detection failed: %w", err)
+	}
+	fmt.Printf("✓ Found %d communities among %d entities in %s\n",
+		result.Communities, result.Entities, result.Duration.Round(time.Millisecond))
+	fmt.Printf("  Modularity: %.3f\n\n", result.Modularity)
+
+	return printCommunities(ctx, client)
+}
+
+func runList(cmd *cobra.Command, args []string) error {
+	client, err := getDBClient()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	return printCommunities(context.Background(), client)
+}
+
+func printCommunities(ctx context.Context, client *ent.Client) error {
+	communities, err := analytics.ListCommunities(ctx, client, minSize, topN)
+	if err != nil {
+		return err
+	}
+	if len(communities) == 0 {
+		fmt.Println("No communities stored, run `analytics communities detect` first")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tSize\tLabel\tTop members")
+	fmt.Fprintln(w, "--\t----\t-----\t-----------")
+	for _, c := range communities {
+		names := make([]string, 0, 3)
+		for _, m := range c.TopMembers[:min(3, len(c.TopMembers))] {
+			names = append(names, m.Name)
+		}
+		fmt.Fprintf(w, "%d\t%d\t%s\t%s\n", c.ID, c.Size, c.Label, strings.Join(names, ", "))
+	}
+	w.Flush()
+
+	return nil
+}
+
+func runShow(cmd *cobra.Command, args []string) error {
+	id, err := strconv.Atoi(args[0])
+	if err != nil {
+		return fmt.Errorf("invalid ID %q: %w", args[0], err)
+	}
+	client, err := getDBClient()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	ctx := context.Background()
+	community, err := analytics.GetCommunity(ctx, client, id)
+	if err != nil {
+		return err
+	}
+	members, err := analytics.CommunityMembers(ctx, client, id, topN)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Community %d: %s\n", community.ID, community.Label)
+	fmt.Printf("  Size: %d\n", community.Size)
+	fmt.Printf("  Internal weight: %.2f\n", community.InternalWeight)
+	fmt.Printf("  Computed: %s (modularity %.3f, resolution %.2f)\n",
+		community.ComputedAt.Format("2006-01-02 15:04:05"), community.Modularity, community.Resolution)
+	fmt.Println("\nMost connected members:")
+	for i, m := range community.TopMembers {
+		fmt.Printf("  %d. %s (%s)\n", i+1, m.Name, m.UniqueID)
+	}
+	fmt.Printf("\nMembers (%d of %d):\n", len(members), community.Size)
+	for _, m := range members {
+		fmt.Printf("  - %s (%s) [%s]\n", m.Name, m.UniqueID, m.TypeCategory)
+	}
+
+	return nil
+}
+
+func main() {
+	if err := rootCmd.Execute(); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,249 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"os/user"
+	"strconv"
+
+	"github.com/Blogem/enron-graph/ent"
+	"github.com/Blogem/enron-graph/internal/graph"
+	"github.com/Blogem/enron-graph/pkg/utils"
+	_ "github.com/lib/pq"
+	"github.com/spf13/cobra"
+)
+
+// Entity curation CLI: merge duplicates, split bad merges and inspect the audit trail
+
+var rootCmd = &cobra.Command{
+	Use:   "entity",
+	Short: "Entity curation tool",
+	Long:  "Merges duplicate entities, splits wrongly merged ones and shows the curation history",
+}
+
+var mergeCmd = &cobra.Command{
+	Use:   "merge [survivor-id] [duplicate-id...]",
+	Short: "Merge duplicate entities into a survivor",
+	Long:  "Moves relationships and provenance of the duplicates to the survivor, unions their properties, records their names as aliases and deletes them",
+	Args:  cobra.MinimumNArgs(2),
+	RunE:  runMerge,
+}
+
+var splitCmd = &cobra.Command{
+	Use:   "split [entity-id]",
+	Short: "Split a new entity out of an existing one",
+	Long:  "Creates a new entity and moves the selected relationships, provenance, properties and aliases to it",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runSplit,
+}
+
+var unmergeCmd = &cobra.Command{
+	Use:   "unmerge [audit-id] [merged-id]",
+	Short: "Undo a merge for one of the merged entities",
+	Long:  "Restores an entity folded away by a merge, using the snapshot in the merge's audit entry",
+	Args:  cobra.ExactArgs(2),
+	RunE:  runUnmerge,
+}
+
+var historyCmd = &cobra.Command{
+	Use:   "history [entity-id]",
+	Short: "Show the curation history of an entity",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runHistory,
+}
+
+var (
+	mergeReason string
+	splitInput  graph.SplitInput
+)
+
+func init() {
+	mergeCmd.Flags().StringVar(&mergeReason, "reason", "", "Why the entities are being merged")
+
+	splitCmd.Flags().StringVar(&splitInput.UniqueID, "unique-id", "", "Unique ID of the new entity (required)")
+	splitCmd.Flags().StringVar(&splitInput.Name, "name", "", "Name of the new entity (required)")
+	splitCmd.Flags().StringVar(&splitInput.TypeCategory, "type", "", "Type of the new entity (defaults to the source type)")
+	splitCmd.Flags().IntSliceVar(&splitInput.RelationshipIDs, "relationships", nil, "Relationship IDs to move to the new entity")
+	splitCmd.Flags().IntSliceVar(&splitInput.ProvenanceIDs, "provenance", nil, "Provenance record IDs to move to the new entity")
+	splitCmd.Flags().StringSliceVar(&splitInput.PropertyKeys, "properties", nil, "Property keys to move to the new entity")
+	splitCmd.Flags().StringSliceVar(&splitInput.Aliases, "aliases", nil, "Aliases to move to the new entity")
+	splitCmd.Flags().StringVar(&splitInput.Reason, "reason", "", "Why the entity is being split")
+	splitCmd.MarkFlagRequired("unique-id")
+	splitCmd.MarkFlagRequired("name")
+
+	rootCmd.AddCommand(mergeCmd, splitCmd, unmergeCmd, historyCmd)
+}
+
+func getRepository() (graph.Repository, func(), error) {
+	cfg, err := utils.LoadConfig()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load config: %w", err)
+	}
+
+	client, err := ent.Open("postgres", cfg.DatabaseURL)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open database: %w", err)
+	}
+
+	sqlDB, err := sql.Open("postgres", cfg.DatabaseURL)
+	if err != nil {
+		client.Close()
+		return nil, nil, fmt.Errorf("failed to open raw SQL connection: %w", err)
+	}
+
+	repo := graph.NewRepositoryWithDB(client, sqlDB, utils.NewLogger())
+	closeFn := func() {
+		repo.Close()
+		sqlDB.Close()
+	}
+	return repo, closeFn, nil
+}
+
+// curationContext attributes audit entries to the OS user running the CLI
+func curationContext() context.Context {
+	actor := "cli"
+	if u, err := user.Current(); err == nil {
+		actor = "cli:" + u.Username
+	}
+	return graph.WithAuditActor(context.Background(), actor)
+}
+
+func parseIDs(args []string) ([]int, error) {
+	ids := make([]int, len(args))
+	for i, arg := range args {
+		id, err := strconv.Atoi(arg)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ID %q: %w", arg, err)
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
+
+func runMerge(cmd *cobra.Command, args []string) error {
+	ids, err := parseIDs(args)
+	if err != nil {
+		return err
+	}
+
+	repo, closeFn, err := getRepository()
+	if err != nil {
+		return err
+	}
+	defer closeFn()
+
+	result, err := repo.MergeEntities(curationContext(), ids[0], ids[1:], mergeReason)
+	if err != nil {
+		return fmt.Errorf("merge failed: %w", err)
+	}
+
+	fmt.Printf("✓ Merged %v into %d (%s)\n", result.MergedIDs, result.Survivor.ID, result.Survivor.Name)
+	fmt.Printf("  Relationships moved: %d\n", result.RelationshipsMoved)
+	fmt.Printf("  Relationships removed: %d\n", result.RelationshipsRemoved)
+	fmt.Printf("  Provenance moved: %d\n", result.ProvenanceMoved)
+	fmt.Printf("  Aliases: %v\n", graph.Aliases(result.Survivor))
+	fmt.Printf("  Audit entry: %d\n", result.Audit.ID)
+
+	return nil
+}
+
+func runSplit(cmd *cobra.Command, args []string) error {
+	ids, err := parseIDs(args)
+	if err != nil {
+		return err
+	}
+
+	repo, closeFn, err := getRepository()
+	if err != nil {
+		return err
+	}
+	defer closeFn()
+
+	return split(repo, ids[0], &splitInput)
+}
+
+func runUnmerge(cmd *cobra.Command, args []string) error {
+	ids, err := parseIDs(args)
+	if err != nil {
+		return err
+	}
+	auditID, mergedID := ids[0], ids[1]
+
+	repo, closeFn, err := getRepository()
+	if err != nil {
+		return err
+	}
+	defer closeFn()
+
+	audit, err := repo.GetClient().EntityAudit.Get(context.Background(), auditID)
+	if err != nil {
+		return fmt.Errorf("failed to load audit entry %d: %w", auditID, err)
+	}
+
+	input, err := graph.SplitInputFromMerge(audit, mergedID)
+	if err != nil {
+		return err
+	}
+
+	return split(repo, audit.EntityID, input)
+}
+
+func split(repo graph.Repository, entityID int, input *graph.SplitInput) error {
+	result, err := repo.SplitEntity(curationContext(), entityID, input)
+	if err != nil {
+		return fmt.Errorf("split failed: %w", err)
+	}
+
+	fmt.Printf("✓ Split %d (%s) out of %d (%s)\n", result.Created.ID, result.Created.Name, result.Source.ID, result.Source.Name)
+	fmt.Printf("  Relationships moved: %d\n", result.RelationshipsMoved)
+	fmt.Printf("  Provenance moved: %d\n", result.ProvenanceMoved)
+	fmt.Printf("  Audit entry: %d\n", result.Audit.ID)
+
+	return nil
+}
+
+func runHistory(cmd *cobra.Command, args []string) error {
+	ids, err := parseIDs(args)
+	if err != nil {
+		return err
+	}
+
+	repo, closeFn, err := getRepository()
+	if err != nil {
+		return err
+	}
+	defer closeFn()
+
+	entries, err := repo.FindEntityAudits(context.Background(), ids[0])
+	if err != nil {
+		return fmt.Errorf("failed to load history: %w", err)
+	}
+
+	if len(entries) == 0 {
+		fmt.Printf("No curation history for entity %d\n", ids[0])
+		return nil
+	}
+
+	for _, entry := range entries {
+		fmt.Printf("#%d %s %s %d %v by %s\n",
+			entry.ID,
+			entry.CreatedAt.Format("2006-01-02 15:04:05"),
+			entry.Operation,
+			entry.EntityID,
+			entry.RelatedIds,
+			entry.Actor)
+		if entry.Reason != "" {
+			fmt.Printf("    %s\n", entry.Reason)
+		}
+	}
+
+	return nil
+}
+
+func main() {
+	if err := rootCmd.Execute(); err != nil {
+		log.Fatal(err)
+	}
+}
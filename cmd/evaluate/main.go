@@ -0,0 +1,138 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/Blogem/enron-graph/internal/eval"
+	"github.com/Blogem/enron-graph/internal/extractor"
+	"github.com/Blogem/enron-graph/internal/prompts"
+	"github.com/Blogem/enron-graph/pkg/llm"
+	"github.com/Blogem/enron-graph/pkg/utils"
+)
+
+func main() {
+	fixtures := flag.String("fixtures", "tests/fixtures/eval", "Fixture directory with emails.csv and annotations.json")
+	model := flag.String("model", "", "Completion model to extract with (defaults to COMPLETION_MODEL)")
+	cacheDir := flag.String("cache-dir", "", "Directory of recorded LLM responses (defaults to <fixtures>/responses)")
+	replay := flag.Bool("replay", false, "Score only recorded responses, never calling the LLM")
+	jsonOut := flag.String("json", "", "Write the report as JSON to this file")
+	htmlOut := flag.String("html", "", "Write the report as HTML to this file")
+	baselinePath := flag.String("baseline", "", "JSON report of an earlier run to compare against")
+	minF1 := flag.Float64("min-f1", 0, "Exit with status 1 when entity or relationship F1 is below this")
+
+	flag.Parse()
+
+	logger := utils.NewLogger()
+
+	config, err := utils.LoadConfig()
+	if err != nil {
+		logger.Error("Failed to load configuration", "error", err)
+		os.Exit(1)
+	}
+	if *model != "" {
+		config.CompletionModel = *model
+	}
+	promptRegistry, err := prompts.Load(config.PromptDir, config.PromptVersions)
+	if err != nil {
+		logger.Error("Failed to load prompt templates", "error", err)
+		os.Exit(1)
+	}
+
+	gold, err := eval.LoadGold(filepath.Join(*fixtures, "annotations.json"))
+	if err != nil {
+		logger.Error("Failed to load annotations", "error", err)
+		os.Exit(1)
+	}
+	emails, err := eval.LoadEmails(filepath.Join(*fixtures, "emails.csv"))
+	if err != nil {
+		logger.Error("Failed to load emails", "error", err)
+		os.Exit(1)
+	}
+
+	var baseline *eval.Report
+	if *baselinePath != "" {
+		if baseline, err = eval.LoadReport(*baselinePath); err != nil {
+			logger.Error("Failed to load baseline", "error", err)
+			os.Exit(1)
+		}
+	}
+
+	if *cacheDir == "" {
+		*cacheDir = filepath.Join(*fixtures, "responses")
+	}
+	cache, err := extractor.NewDiskCache(*cacheDir)
+	if err != nil {
+		logger.Error("Failed to open response cache", "error", err)
+		os.Exit(1)
+	}
+
+	var llmClient llm.Client = &eval.OfflineClient{Dimensions: config.EmbeddingDimensions}
+	if !*replay {
+		llmClient, err = llm.NewClientFromConfig(config, logger)
+		if err != nil {
+			logger.Error("Failed to create LLM client", "error", err)
+			os.Exit(1)
+		}
+	}
+	defer llmClient.Close()
+
+	logger.Info("Evaluating extraction",
+		"emails", len(gold.Emails),
+		"model", config.CompletionModel,
+		"prompt_version", promptRegistry.Version(prompts.Extraction),
+		"cache_dir", *cacheDir,
+		"replay", *replay)
+
+	report, err := eval.Run(context.Background(), llmClient, gold, emails, eval.Options{
+		Model:   config.CompletionModel,
+		Prompts: promptRegistry,
+		Cache:   cache,
+		Replay:  *replay,
+	}, logger)
+	if err != nil {
+		logger.Error("Evaluation failed", "error", err)
+		os.Exit(1)
+	}
+	hits, misses := cache.Stats()
+	logger.Info("Response cache", "hits", hits, "misses", misses)
+
+	if err := report.WriteText(os.Stdout, baseline); err != nil {
+		logger.Error("Failed to write report", "error", err)
+		os.Exit(1)
+	}
+	if *jsonOut != "" {
+		if err := writeFile(*jsonOut, func(f *os.File) error { return report.WriteJSON(f) }); err != nil {
+			logger.Error("Failed to write JSON report", "error", err)
+			os.Exit(1)
+		}
+	}
+	if *htmlOut != "" {
+		if err := writeFile(*htmlOut, func(f *os.File) error { return report.WriteHTML(f, baseline) }); err != nil {
+			logger.Error("Failed to write HTML report", "error", err)
+			os.Exit(1)
+		}
+	}
+
+	if report.Entities.F1 < *minF1 || report.Relationships.F1 < *minF1 {
+		fmt.Fprintf(os.Stderr, "F1 below %.3f: entities %.3f, relationships %.3f\n",
+			*minF1, report.Entities.F1, report.Relationships.F1)
+		os.Exit(1)
+	}
+}
+
+// writeFile creates path and writes it with write
+func writeFile(path string, write func(f *os.File) error) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := write(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"log/slog"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -14,7 +15,9 @@ import (
 	"github.com/Blogem/enron-graph/internal/analyst"
 	"github.com/Blogem/enron-graph/internal/chat"
 	"github.com/Blogem/enron-graph/internal/explorer"
+	"github.com/Blogem/enron-graph/internal/graph"
 	"github.com/Blogem/enron-graph/internal/promoter"
+	"github.com/Blogem/enron-graph/internal/prompts"
 	"github.com/Blogem/enron-graph/pkg/llm"
 	"github.com/Blogem/enron-graph/pkg/utils"
 	"github.com/wailsapp/wails/v2/pkg/runtime"
@@ -26,8 +29,10 @@ type App struct {
 	client        *ent.Client
 	db            *sql.DB
 	config        *utils.Config
+	llmClient     llm.Client
 	schemaService *explorer.SchemaService
 	graphService  *explorer.GraphService
+	repo          graph.Repository
 	chatHandler   chat.Handler
 	chatContext   chat.Context
 }
@@ -77,24 +82,61 @@ type PropertyInfo struct {
 	Required bool   `json:"required"`
 }
 
-// NewApp creates a new App application struct
-func NewApp(client *ent.Client, db *sql.DB, cfg *utils.Config, llmClient llm.Client) *App {
-	// Create chat dependencies
-	// TODO: avoid creating multiple LLM clients
-	llmClientPrd := newProductionLLMClient(cfg)
-	// chatRepo needs context, will be initialized in startup
-	chatHandler := chat.NewHandler(llmClientPrd, nil)
-	chatContext := chat.NewContext()
+// MergeNodesRequest lists the duplicate nodes to fold into the target node
+type MergeNodesRequest struct {
+	TargetID  string   `json:"targetId"`
+	SourceIDs []string `json:"sourceIds"`
+}
+
+// MergeNodesResponse contains the merged node and what was moved onto it
+type MergeNodesResponse struct {
+	Node               *explorer.GraphNode `json:"node"`
+	MergedCount        int                 `json:"mergedCount"`
+	RelationshipsMoved int                 `json:"relationshipsMoved"`
+	AuditID            int                 `json:"auditId"`
+}
+
+// ThreadView is the conversation an email node belongs to
+type ThreadView struct {
+	ID           int                 `json:"id"`
+	Subject      string              `json:"subject"`
+	Participants []string            `json:"participants"`
+	Messages     []ThreadViewMessage `json:"messages"`
+}
+
+// ThreadViewMessage is an email in a conversation, indented by its reply depth
+type ThreadViewMessage struct {
+	MessageID string `json:"messageId"`
+	From      string `json:"from"`
+	Subject   string `json:"subject"`
+	Date      string `json:"date"`
+	Depth     int    `json:"depth"`
+	Method    string `json:"method,omitempty"`
+}
 
-	return &App{
+// NewApp creates a new App application struct
+func NewApp(client *ent.Client, db *sql.DB, cfg *utils.Config, vectorConfig graph.VectorConfig, llmClient llm.Client) *App {
+	app := &App{
 		client:        client,
 		db:            db,
 		config:        cfg,
+		llmClient:     llmClient,
 		schemaService: explorer.NewSchemaService(client, db),
-		graphService:  explorer.NewGraphService(client, db, llmClient),
-		chatHandler:   chatHandler,
-		chatContext:   chatContext,
+		graphService:  explorer.NewGraphServiceWithVectorConfig(client, db, llmClient, vectorConfig),
+		repo:          graph.NewRepositoryWithVectorConfig(client, db, slog.Default(), vectorConfig),
+		chatContext:   chat.NewContext(),
 	}
+	// chatRepo needs context, will be initialized in startup
+	app.chatHandler = chat.NewHandler(app.chatLLMClient(), nil)
+
+	// Rebuild the chat ontology from the refreshed schema on the next query
+	app.schemaService.OnRefresh(func() {
+		if invalidator, ok := app.chatHandler.(chat.SchemaInvalidator); ok {
+			invalidator.InvalidateSchema()
+		}
+	})
+
+	return app
 }
 
 // startup is called when the app starts. The context is saved
@@ -103,9 +145,32 @@ func (a *App) startup(ctx context.Context) {
 	a.ctx = ctx
 
 	// Initialize chat adapter with context
-	llmClient := newProductionLLMClient(a.config)
+	llmClient := a.chatLLMClient()
 	chatRepo := newChatAdapter(a.client, ctx)
-	a.chatHandler = chat.NewHandler(llmClient, chatRepo)
+	chatHandler, err := newChatHandler(llmClient, chatRepo, a.config)
+	if err != nil {
+		slog.Default().Warn("Failed to load prompt templates, using built-in prompts", "error", err)
+		chatHandler = chat.NewHandlerWithMaxSteps(llmClient, chatRepo, a.config.ChatMaxSteps)
+	}
+	a.chatHandler = chatHandler
+}
+
+// chatLLMClient returns the LLM client for chat: the app's own client, so that chat traffic
+// goes through the same cassette, or a client created from the config when there is none
+func (a *App) chatLLMClient() chat.LLMClient {
+	if a.llmClient != nil {
+		return newLLMAdapter(a.llmClient)
+	}
+	return newProductionLLMClient(a.config)
+}
+
+// newChatHandler creates the chat handler with the configured prompt template versions
+func newChatHandler(llmClient chat.LLMClient, chatRepo chat.Repository, cfg *utils.Config) (chat.Handler, error) {
+	promptRegistry, err := prompts.Load(cfg.PromptDir, cfg.PromptVersions)
+	if err != nil {
+		return nil, err
+	}
+	return chat.NewHandlerWithPrompts(llmClient, chatRepo, cfg.ChatMaxSteps, promptRegistry)
 }
 
 // GetSchema returns the complete schema metadata (promoted and discovered types)
@@ -272,6 +337,103 @@ func (a *App) PromoteEntity(req PromotionRequest) (*PromotionResponse, error) {
 	return response, nil
 }
 
+// MergeNodes merges duplicate discovered entities into the target node
+func (a *App) MergeNodes(req MergeNodesRequest) (*MergeNodesResponse, error) {
+	if len(req.SourceIDs) == 0 {
+		return nil, fmt.Errorf("select at least one node to merge")
+	}
+
+	target, err := a.repo.FindEntityByUniqueID(a.ctx, req.TargetID)
+	if err != nil {
+		return nil, fmt.Errorf("node %s does not exist", req.TargetID)
+	}
+
+	victimIDs := make([]int, 0, len(req.SourceIDs))
+	for _, sourceID := range req.SourceIDs {
+		sourceID = strings.TrimSpace(sourceID)
+		if sourceID == "" {
+			continue
+		}
+		source, err := a.repo.FindEntityByUniqueID(a.ctx, sourceID)
+		if err != nil {
+			return nil, fmt.Errorf("node %s does not exist", sourceID)
+		}
+		victimIDs = append(victimIDs, source.ID)
+	}
+
+	ctx := graph.WithAuditActor(a.ctx, "explorer")
+	result, err := a.repo.MergeEntities(ctx, target.ID, victimIDs, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to merge nodes: %w", err)
+	}
+
+	node, err := a.graphService.GetNodeDetails(a.ctx, target.UniqueID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MergeNodesResponse{
+		Node:               node,
+		MergedCount:        len(result.MergedIDs),
+		RelationshipsMoved: result.RelationshipsMoved,
+		AuditID:            result.Audit.ID,
+	}, nil
+}
+
+// GetThread returns the conversation of an email node, or nil when the email is not threaded
+func (a *App) GetThread(messageID string) (*ThreadView, error) {
+	email, err := a.repo.FindEmailByMessageID(a.ctx, messageID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to fetch email: %w", err)
+	}
+
+	thread, err := a.repo.FindThreadByEmail(a.ctx, email.ID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to fetch thread: %w", err)
+	}
+
+	messages, err := a.repo.FindThreadEmails(a.ctx, thread.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch thread messages: %w", err)
+	}
+
+	parents := make(map[int]int, len(messages))
+	for _, message := range messages {
+		parents[message.Email.ID] = message.ParentID
+	}
+	depth := func(id int) int {
+		d := 0
+		for parent := parents[id]; parent != 0 && d < len(messages); parent = parents[parent] {
+			d++
+		}
+		return d
+	}
+
+	view := &ThreadView{
+		ID:           thread.ID,
+		Subject:      thread.Subject,
+		Participants: thread.Participants,
+		Messages:     make([]ThreadViewMessage, len(messages)),
+	}
+	for i, message := range messages {
+		view.Messages[i] = ThreadViewMessage{
+			MessageID: message.Email.MessageID,
+			From:      message.Email.From,
+			Subject:   message.Email.Subject,
+			Date:      message.Email.Date.Format(time.RFC3339),
+			Depth:     depth(message.Email.ID),
+			Method:    message.Method,
+		}
+	}
+	return view, nil
+}
+
 // calculateProjectRoot calculates the project root directory
 func (a *App) calculateProjectRoot() (string, error) {
 	// Get current working directory
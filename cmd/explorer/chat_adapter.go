@@ -6,8 +6,12 @@ import (
 
 	"github.com/Blogem/enron-graph/ent"
 	"github.com/Blogem/enron-graph/ent/discoveredentity"
+	"github.com/Blogem/enron-graph/ent/email"
+	"github.com/Blogem/enron-graph/ent/entityalias"
+	"github.com/Blogem/enron-graph/ent/provenance"
 	"github.com/Blogem/enron-graph/ent/relationship"
 	"github.com/Blogem/enron-graph/internal/chat"
+	"github.com/Blogem/enron-graph/internal/graph"
 )
 
 // chatAdapter implements chat.Repository interface using ent client
@@ -29,11 +33,7 @@ func (a *chatAdapter) FindEntityByName(name string) (*chat.Entity, error) {
 	fmt.Printf("[FindEntityByName] Searching for entity with name: %q\n", name)
 
 	// Try to find in discovered entities first
-	entity, err := a.client.DiscoveredEntity.
-		Query().
-		Where(discoveredentity.NameEQ(name)).
-		First(a.ctx)
-
+	entity, err := a.findByNameOrAlias(name)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return nil, fmt.Errorf("entity not found: %s", name)
@@ -75,6 +75,35 @@ func (a *chatAdapter) FindEntityByName(name string) (*chat.Entity, error) {
 	return chatEntity, nil
 }
 
+// findByNameOrAlias matches the exact name first, then names and recorded aliases case-insensitively
+func (a *chatAdapter) findByNameOrAlias(name string) (*ent.DiscoveredEntity, error) {
+	entity, err := a.client.DiscoveredEntity.
+		Query().
+		Where(discoveredentity.NameEQ(name)).
+		First(a.ctx)
+	if err == nil || !ent.IsNotFound(err) {
+		return entity, err
+	}
+
+	aliasedIDs, err := a.client.EntityAlias.
+		Query().
+		Where(entityalias.NormalizedEQ(graph.NormalizeAlias(name))).
+		Select(entityalias.FieldEntityID).
+		Ints(a.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.client.DiscoveredEntity.
+		Query().
+		Where(discoveredentity.Or(
+			discoveredentity.NameEqualFold(name),
+			discoveredentity.IDIn(aliasedIDs...),
+		)).
+		Order(ent.Desc(discoveredentity.FieldConfidenceScore)).
+		First(a.ctx)
+}
+
 // TraverseRelationships finds all entities connected by a specific relationship type
 func (a *chatAdapter) TraverseRelationships(entityID int, relType string) ([]*chat.Entity, error) {
 	// Query relationships where the entity is the source
@@ -280,3 +309,132 @@ func (a *chatAdapter) CountRelationships(entityID int, relType string) (int, err
 
 	return count, nil
 }
+
+// FindEntityCitations returns the source emails an entity was extracted from
+func (a *chatAdapter) FindEntityCitations(entityID int, limit int) ([]chat.Citation, error) {
+	records, err := a.client.Provenance.
+		Query().
+		Where(provenance.SubjectTypeEQ("discovered_entity")).
+		Where(provenance.SubjectIDEQ(entityID)).
+		Order(ent.Asc(provenance.FieldExtractedAt)).
+		All(a.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	messageIDs := make([]string, 0, len(records))
+	for _, record := range records {
+		messageIDs = append(messageIDs, record.MessageID)
+	}
+
+	return a.buildCitations(messageIDs, entityID, "", limit)
+}
+
+// FindRelationshipCitations returns the source emails of relationships between two entities
+func (a *chatAdapter) FindRelationshipCitations(fromID, toID int, relType string, limit int) ([]chat.Citation, error) {
+	query := a.client.Relationship.
+		Query().
+		Where(relationship.Or(
+			relationship.And(relationship.FromIDEQ(fromID), relationship.ToIDEQ(toID)),
+			relationship.And(relationship.FromIDEQ(toID), relationship.ToIDEQ(fromID)),
+		))
+	if relType != "" {
+		query = query.Where(relationship.TypeEQ(relType))
+	}
+
+	rels, err := query.All(a.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	citations := make([]chat.Citation, 0)
+	for _, rel := range rels {
+		if len(citations) >= limit {
+			break
+		}
+
+		records, err := a.client.Provenance.
+			Query().
+			Where(provenance.SubjectTypeEQ("relationship")).
+			Where(provenance.SubjectIDEQ(rel.ID)).
+			All(a.ctx)
+		if err != nil {
+			return nil, fmt.Errorf("database error: %w", err)
+		}
+
+		var messageIDs []string
+		for _, record := range records {
+			messageIDs = append(messageIDs, record.MessageID)
+		}
+		// Relationships created before provenance tracking still name their email
+		if viaEmail, ok := rel.Properties["via_email"].(string); ok && len(messageIDs) == 0 {
+			messageIDs = append(messageIDs, viaEmail)
+		}
+
+		relCitations, err := a.buildCitations(messageIDs, 0, rel.Type, limit-len(citations))
+		if err != nil {
+			return nil, err
+		}
+		citations = append(citations, relCitations...)
+	}
+
+	return citations, nil
+}
+
+// buildCitations looks up the subject and date of each distinct message ID
+func (a *chatAdapter) buildCitations(messageIDs []string, entityID int, relType string, limit int) ([]chat.Citation, error) {
+	var unique []string
+	seen := make(map[string]bool)
+	for _, messageID := range messageIDs {
+		if messageID == "" || seen[messageID] {
+			continue
+		}
+		seen[messageID] = true
+		unique = append(unique, messageID)
+		if len(unique) >= limit {
+			break
+		}
+	}
+
+	if len(unique) == 0 {
+		return []chat.Citation{}, nil
+	}
+
+	emails, err := a.client.Email.
+		Query().
+		Where(email.MessageIDIn(unique...)).
+		All(a.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	byMessageID := make(map[string]*ent.Email, len(emails))
+	for _, e := range emails {
+		byMessageID[e.MessageID] = e
+	}
+
+	citations := make([]chat.Citation, 0, len(unique))
+	for _, messageID := range unique {
+		citation := chat.Citation{
+			MessageID:    messageID,
+			EntityID:     entityID,
+			Relationship: relType,
+		}
+		if e, ok := byMessageID[messageID]; ok {
+			citation.Subject = e.Subject
+			citation.Date = e.Date.Format("2006-01-02")
+		}
+		citations = append(citations, citation)
+	}
+
+	return citations, nil
+}
+
+// DescribeSchema summarizes the entity and relationship types currently in the graph
+func (a *chatAdapter) DescribeSchema() (*chat.SchemaSummary, error) {
+	summary, err := chat.DescribeGraphSchema(a.ctx, a.client)
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	return summary, nil
+}
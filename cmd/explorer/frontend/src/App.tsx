@@ -246,7 +246,11 @@ function App() {
             setSelectedNode({
                 ...node,
                 properties: details.properties,
-                category: details.category
+                category: details.category,
+                provenance: details.provenance,
+                centrality: details.centrality,
+                community: details.community,
+                community_label: details.community_label
             });
         } catch (err) {
             console.error('Error loading node details:', err);
@@ -370,6 +374,32 @@ function App() {
         setSelectedNode(null);
     }, []);
 
+    // Merge duplicate nodes into the selected node and fold them out of the graph
+    const handleMergeNodes = useCallback(async (targetId: string, sourceIds: string[]) => {
+        const result = await wailsAPI.mergeNodes({ targetId, sourceIds });
+        const merged = new Set(sourceIds);
+        const rewire = (end: any) => {
+            const id = typeof end === 'string' ? end : end.id;
+            return merged.has(id) ? targetId : id;
+        };
+
+        setGraphData(prev => ({
+            nodes: prev.nodes.filter(n => !merged.has(n.id)),
+            links: prev.links
+                .map(link => ({ ...link, source: rewire(link.source), target: rewire(link.target) }))
+                .filter(link => link.source !== link.target)
+        }));
+        setSelectedNode(prev => prev && prev.id === targetId ? {
+            ...prev,
+            properties: result.node.properties,
+            provenance: result.node.provenance
+        } : prev);
+
+        setToastMessage(`Merged ${result.mergedCount} node(s) into ${targetId}`);
+        setShowToast(true);
+        setTimeout(() => setShowToast(false), 3000);
+    }, []);
+
     // Keyboard shortcuts (T109)
     useEffect(() => {
         const handleKeyDown = (e: KeyboardEvent) => {
@@ -640,6 +670,8 @@ function App() {
                                             onClose={handleCloseDetail}
                                             relatedEntities={relatedEntities}
                                             onExpandRelationship={handleExpandRelationship}
+                                            onMergeNodes={handleMergeNodes}
+                                            onLoadThread={wailsAPI.getThread}
                                         />
                                     )}
                                 </ErrorBoundary>
@@ -9,8 +9,8 @@
  * - FR-023: Special characters in queries and responses display correctly
  */
 
-import { describe, it, expect } from 'vitest';
-import { render, screen } from '@testing-library/react';
+import { describe, it, expect, vi } from 'vitest';
+import { render, screen, fireEvent } from '@testing-library/react';
 import ChatMessage from './ChatMessage';
 import type { ChatMessage as ChatMessageType } from '../types/chat';
 
@@ -248,6 +248,68 @@ describe('ChatMessage Component', () => {
     });
   });
 
+  describe('Citations', () => {
+    it('renders source emails as clickable references', () => {
+      const onEntityClick = vi.fn();
+      const message: ChatMessageType = {
+        id: '20',
+        text: 'Jeff Skilling is a person in the Enron email dataset.',
+        sender: 'system',
+        timestamp: new Date('2026-01-27T10:00:00Z'),
+        citations: [
+          {
+            message_id: '<123.JavaMail@enron.com>',
+            subject: 'Q3 results',
+            date: '2001-10-16',
+            entity_id: 1,
+          },
+        ],
+      };
+
+      render(<ChatMessage message={message} onEntityClick={onEntityClick} />);
+
+      expect(screen.getByText('Sources')).toBeInTheDocument();
+      expect(screen.getByText(/2001-10-16/)).toBeInTheDocument();
+
+      fireEvent.click(screen.getByText('Q3 results'));
+      expect(onEntityClick).toHaveBeenCalledWith('<123.JavaMail@enron.com>');
+    });
+
+    it('omits the sources section without citations', () => {
+      const message: ChatMessageType = {
+        id: '21',
+        text: 'No sources here',
+        sender: 'system',
+        timestamp: new Date('2026-01-27T10:00:00Z'),
+      };
+
+      render(<ChatMessage message={message} />);
+
+      expect(screen.queryByText('Sources')).not.toBeInTheDocument();
+    });
+  });
+
+  describe('Tool Call Trace', () => {
+    it('renders the planner steps behind an answer', () => {
+      const message: ChatMessageType = {
+        id: '22',
+        text: 'Jeff Skilling mostly wrote about California.',
+        sender: 'system',
+        timestamp: new Date('2026-01-27T10:00:00Z'),
+        trace: [
+          { step: 1, action: 'relationship', args: { entity: 'Kenneth Lay' }, observation: 'Found 2 entities' },
+          { step: 2, action: 'entity_lookup', args: { entity: 'Ken' }, error: "I couldn't find that entity." },
+        ],
+      };
+
+      render(<ChatMessage message={message} />);
+
+      expect(screen.getByText('2 steps')).toBeInTheDocument();
+      expect(screen.getByText('relationship(entity="Kenneth Lay")')).toBeInTheDocument();
+      expect(screen.getByText("I couldn't find that entity.")).toBeInTheDocument();
+    });
+  });
+
   describe('Accessibility', () => {
     it('has appropriate ARIA attributes for user messages', () => {
       const message: ChatMessageType = {
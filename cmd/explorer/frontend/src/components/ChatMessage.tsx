@@ -5,7 +5,7 @@ import './ChatMessage.css';
 import type { ChatMessageProps } from '../types/chat';
 
 const ChatMessage: FC<ChatMessageProps> = ({ message, onEntityClick }) => {
-    const { text, sender, timestamp, entities } = message;
+    const { text, sender, timestamp, entities, citations, trace } = message;
 
     // Format timestamp for display
     const formatTime = (date: Date) => {
@@ -134,6 +134,80 @@ const ChatMessage: FC<ChatMessageProps> = ({ message, onEntityClick }) => {
         return <>{parts}</>;
     };
 
+    // Render the graph tool calls behind the answer as a collapsible list
+    const renderTrace = () => {
+        if (!trace || trace.length === 0) {
+            return null;
+        }
+
+        return (
+            <details className="chat-message__trace">
+                <summary className="chat-message__trace-title">
+                    {trace.length} {trace.length === 1 ? 'step' : 'steps'}
+                </summary>
+                <ol className="chat-message__trace-list">
+                    {trace.map((call) => (
+                        <li
+                            key={`step-${call.step}`}
+                            className={`chat-message__trace-step${call.error ? ' chat-message__trace-step--error' : ''}`}
+                        >
+                            <code>
+                                {call.action}({Object.entries(call.args || {})
+                                    .map(([key, value]) => `${key}="${value}"`)
+                                    .join(', ')})
+                            </code>
+                            {(call.error || call.observation) && (
+                                <div className="chat-message__trace-observation">
+                                    {call.error || call.observation}
+                                </div>
+                            )}
+                        </li>
+                    ))}
+                </ol>
+            </details>
+        );
+    };
+
+    // Render source emails as clickable references (email nodes use the message ID as node ID)
+    const renderCitations = () => {
+        if (!citations || citations.length === 0) {
+            return null;
+        }
+
+        return (
+            <div className="chat-message__citations">
+                <div className="chat-message__citations-title">Sources</div>
+                <ol className="chat-message__citation-list">
+                    {citations.map((citation, idx) => (
+                        <li key={`citation-${idx}`} className="chat-message__citation">
+                            <span
+                                className="chat-message__citation-link"
+                                onClick={() => onEntityClick?.(citation.message_id)}
+                                role="button"
+                                tabIndex={0}
+                                onKeyDown={(e) => {
+                                    if (e.key === 'Enter' || e.key === ' ') {
+                                        e.preventDefault();
+                                        onEntityClick?.(citation.message_id);
+                                    }
+                                }}
+                                title={`Click to view email ${citation.message_id}`}
+                            >
+                                {citation.subject || citation.message_id}
+                            </span>
+                            {citation.date && (
+                                <span className="chat-message__citation-meta"> · {citation.date}</span>
+                            )}
+                            {citation.relationship && (
+                                <span className="chat-message__citation-meta"> · {citation.relationship}</span>
+                            )}
+                        </li>
+                    ))}
+                </ol>
+            </div>
+        );
+    };
+
     return (
         <div
             className={`chat-message chat-message--${sender}`}
@@ -142,6 +216,8 @@ const ChatMessage: FC<ChatMessageProps> = ({ message, onEntityClick }) => {
         >
             <div className="chat-message__content">
                 {renderMessageContent()}
+                {renderTrace()}
+                {renderCitations()}
                 <div className="chat-message__timestamp">{formatTime(timestamp)}</div>
             </div>
         </div>
@@ -97,11 +97,15 @@ const ChatPanel: FC<ChatPanelProps> = ({
             // Parse JSON response containing text and entities
             let messageText = response;
             let entities = undefined;
+            let citations = undefined;
+            let trace = undefined;
 
             try {
                 const parsed: FormattedResponse = JSON.parse(response);
                 messageText = parsed.text;
                 entities = parsed.entities;
+                citations = parsed.citations;
+                trace = parsed.trace;
                 console.log('Parsed chat response:', {
                     text: messageText.substring(0, 100),
                     entityCount: entities?.length,
@@ -118,7 +122,9 @@ const ChatPanel: FC<ChatPanelProps> = ({
                 text: messageText,
                 sender: 'system',
                 timestamp: new Date(),
-                entities: entities
+                entities: entities,
+                citations: citations,
+                trace: trace
             };
             setMessages(prev => [...prev, systemMessage]);
             setCurrentInput(''); // Clear input on success
@@ -1,5 +1,7 @@
-import React, { useState, useMemo } from 'react';
+import React, { useState, useMemo, useEffect } from 'react';
+import { CENTRALITY_METRICS } from '../types/graph';
 import type { GraphNodeWithPosition, GraphEdge } from '../types/graph';
+import type { main } from '../wailsjs/go/models';
 import LoadMoreButton from './LoadMoreButton';
 import LoadingSkeleton from './LoadingSkeleton';
 import Tooltip from './Tooltip';
@@ -20,6 +22,8 @@ interface DetailPanelProps {
         node: GraphNodeWithPosition;
     }>;
     onExpandRelationship?: (nodeId: string) => void;
+    onMergeNodes?: (targetId: string, sourceIds: string[]) => Promise<void>;
+    onLoadThread?: (messageId: string) => Promise<main.ThreadView | null>;
 }
 
 const DetailPanel: React.FC<DetailPanelProps> = ({
@@ -29,15 +33,43 @@ const DetailPanel: React.FC<DetailPanelProps> = ({
     onLoadMore,
     onClose,
     relatedEntities = [],
-    onExpandRelationship
+    onExpandRelationship,
+    onMergeNodes,
+    onLoadThread
 }) => {
     // Collapsible section states
     const [sectionsExpanded, setSectionsExpanded] = useState({
         properties: true,
         metadata: true,
-        relationships: true
+        provenance: false,
+        analytics: true,
+        relationships: true,
+        conversation: true
     });
 
+    // Merge form state
+    const [mergeInput, setMergeInput] = useState('');
+    const [merging, setMerging] = useState(false);
+    const [mergeError, setMergeError] = useState<string | null>(null);
+
+    // Conversation the selected email belongs to
+    const [thread, setThread] = useState<main.ThreadView | null>(null);
+
+    useEffect(() => {
+        setThread(null);
+        if (!node || node.type !== 'email' || !onLoadThread) return;
+
+        let cancelled = false;
+        onLoadThread(node.id)
+            .then(view => {
+                if (!cancelled) setThread(view);
+            })
+            .catch(err => console.error('Failed to load thread:', err));
+        return () => {
+            cancelled = true;
+        };
+    }, [node?.id, node?.type, onLoadThread]);
+
     const toggleSection = (section: keyof typeof sectionsExpanded) => {
         setSectionsExpanded(prev => ({
             ...prev,
@@ -113,6 +145,25 @@ const DetailPanel: React.FC<DetailPanelProps> = ({
         }
     };
 
+    const handleMerge = async () => {
+        if (!node || !onMergeNodes) return;
+        const sourceIds = mergeInput.split(',').map(id => id.trim()).filter(id => id && id !== node.id);
+        if (sourceIds.length === 0) {
+            setMergeError('Enter the IDs of the duplicate nodes');
+            return;
+        }
+        setMerging(true);
+        setMergeError(null);
+        try {
+            await onMergeNodes(node.id, sourceIds);
+            setMergeInput('');
+        } catch (err) {
+            setMergeError(err instanceof Error ? err.message : String(err));
+        } finally {
+            setMerging(false);
+        }
+    };
+
     if (!node) {
         return (
             <div className="detail-panel empty">
@@ -260,6 +311,115 @@ const DetailPanel: React.FC<DetailPanelProps> = ({
                         </div>
                     )}
 
+                    {/* Centrality scores and community from the last graph analytics runs */}
+                    {(node.centrality || node.community) && (
+                        <div className="detail-section">
+                            <div
+                                className="section-header collapsible"
+                                onClick={() => toggleSection('analytics')}
+                            >
+                                <h3>Graph Analytics</h3>
+                                <span className="collapse-icon">
+                                    {sectionsExpanded.analytics ? '▼' : '▶'}
+                                </span>
+                            </div>
+                            {sectionsExpanded.analytics && (
+                                <div className="metadata-list">
+                                    {node.community && (
+                                        <div className="metadata-item">
+                                            <span className="metadata-label">Community:</span>
+                                            <span className="metadata-value">
+                                                #{node.community}{node.community_label && ` (${node.community_label})`}
+                                            </span>
+                                        </div>
+                                    )}
+                                    {node.centrality && CENTRALITY_METRICS.map(({ key, label }) => (
+                                        <div key={key} className="metadata-item">
+                                            <span className="metadata-label">{label}:</span>
+                                            <span className="metadata-value">
+                                                {Number(node.centrality![key] ?? 0).toPrecision(3)}
+                                            </span>
+                                        </div>
+                                    ))}
+                                </div>
+                            )}
+                        </div>
+                    )}
+
+                    {/* Provenance: source emails and extraction runs behind this node */}
+                    {node.provenance && node.provenance.length > 0 && (
+                        <div className="detail-section">
+                            <div
+                                className="section-header collapsible"
+                                onClick={() => toggleSection('provenance')}
+                            >
+                                <h3>Provenance ({node.provenance.length})</h3>
+                                <span className="collapse-icon">
+                                    {sectionsExpanded.provenance ? '▼' : '▶'}
+                                </span>
+                            </div>
+                            {sectionsExpanded.provenance && (
+                                <div className="provenance-list">
+                                    {node.provenance.map((record, idx) => (
+                                        <div key={idx} className="provenance-item">
+                                            <div className="provenance-header">
+                                                <span className="provenance-source">{record.source}</span>
+                                                <span className="provenance-date">{record.extracted_at}</span>
+                                            </div>
+                                            {record.message_id && (
+                                                <div className="provenance-detail">{record.message_id}</div>
+                                            )}
+                                            {record.file_path && (
+                                                <div className="provenance-detail">{record.file_path}</div>
+                                            )}
+                                            {record.model && (
+                                                <div className="provenance-detail">
+                                                    {record.model}
+                                                    {record.prompt_version && ` (prompt ${record.prompt_version})`}
+                                                </div>
+                                            )}
+                                        </div>
+                                    ))}
+                                </div>
+                            )}
+                        </div>
+                    )}
+
+                    {/* Conversation: the email's thread, indented by reply depth */}
+                    {thread && thread.messages.length > 0 && (
+                        <div className="detail-section">
+                            <div
+                                className="section-header collapsible"
+                                onClick={() => toggleSection('conversation')}
+                            >
+                                <h3>Conversation ({thread.messages.length})</h3>
+                                <span className="collapse-icon">
+                                    {sectionsExpanded.conversation ? '▼' : '▶'}
+                                </span>
+                            </div>
+                            {sectionsExpanded.conversation && (
+                                <div className="thread-list">
+                                    {thread.messages.map(message => (
+                                        <div
+                                            key={message.messageId}
+                                            className={`thread-item${message.messageId === node.id ? ' current' : ''}`}
+                                            style={{ marginLeft: `${Math.min(message.depth, 6) * 12}px` }}
+                                        >
+                                            <div className="thread-header">
+                                                <span className="thread-from">{message.from}</span>
+                                                <span className="thread-date">{message.date}</span>
+                                            </div>
+                                            <div className="thread-subject">{message.subject || '(no subject)'}</div>
+                                            {message.method && message.method !== 'in_reply_to' && message.method !== 'references' && (
+                                                <div className="thread-method">matched by {message.method}</div>
+                                            )}
+                                        </div>
+                                    ))}
+                                </div>
+                            )}
+                        </div>
+                    )}
+
                     {/* T098: Related entities list with T100: expand buttons */}
                     {relatedEntities.length > 0 && (
                         <div className="detail-section">
@@ -341,6 +501,31 @@ const DetailPanel: React.FC<DetailPanelProps> = ({
                                 🔗 Copy as JSON
                             </button>
                         </div>
+                        {/* Merge duplicates: only discovered entities can be merged */}
+                        {onMergeNodes && node.category === 'discovered' && (
+                            <div className="merge-form">
+                                <label className="merge-label" htmlFor="merge-ids">
+                                    Merge duplicates into this node
+                                </label>
+                                <input
+                                    id="merge-ids"
+                                    className="merge-input"
+                                    type="text"
+                                    placeholder="Node IDs, comma-separated"
+                                    value={mergeInput}
+                                    onChange={e => setMergeInput(e.target.value)}
+                                    disabled={merging}
+                                />
+                                <button
+                                    className="action-button"
+                                    onClick={handleMerge}
+                                    disabled={merging || mergeInput.trim() === ''}
+                                >
+                                    {merging ? 'Merging…' : '🔀 Merge'}
+                                </button>
+                                {mergeError && <div className="merge-error">{mergeError}</div>}
+                            </div>
+                        )}
                     </div>
                 </div>
             )}
@@ -1,5 +1,6 @@
 import React, { useRef, useCallback, useEffect, useState, useMemo } from 'react';
 import ForceGraph2D from 'react-force-graph-2d';
+import { CENTRALITY_METRICS } from '../types/graph';
 import type { GraphData, GraphNodeWithPosition, GraphEdge, ExpandedNodeState } from '../types/graph';
 import Tooltip from './Tooltip';
 import './GraphCanvas.css';
@@ -29,6 +30,10 @@ const GraphCanvas: React.FC<GraphCanvasProps> = ({
     const graphRef = useRef<any>(null);
     const containerRef = useRef<HTMLDivElement>(null);
     const [dimensions, setDimensions] = useState({ width: 800, height: 600 });
+    // Sizes nodes by relationship count, or by a centrality metric
+    const [sizeBy, setSizeBy] = useState('relationships');
+    // Colours nodes by entity type, or by detected community
+    const [colorBy, setColorBy] = useState<'type' | 'community'>('type');
 
     // Performance optimization: Enable particle rendering for large graphs (T105)
     const isLargeGraph = data.nodes.length > 500;
@@ -49,6 +54,11 @@ const GraphCanvas: React.FC<GraphCanvasProps> = ({
             } else if (highlightedNodeIds.has(node.id)) {
                 // Highlighted search result nodes (T092)
                 map.set(node.id, '#fbbf24'); // Bright amber/yellow for search matches
+            } else if (colorBy === 'community') {
+                // Spread community IDs around the colour wheel; nodes without one stay neutral
+                map.set(node.id, node.community
+                    ? `hsl(${(node.community * 137.508) % 360}, 65%, 62%)`
+                    : '#6e7681');
             } else {
                 // Color by type
                 const typeColors: Record<string, string> = {
@@ -63,7 +73,7 @@ const GraphCanvas: React.FC<GraphCanvasProps> = ({
         });
 
         return map;
-    }, [data.nodes, selectedNodeId, highlightedNodeIds]);
+    }, [data.nodes, selectedNodeId, highlightedNodeIds, colorBy]);
 
     // Update dimensions on mount and resize
     useEffect(() => {
@@ -93,12 +103,23 @@ const GraphCanvas: React.FC<GraphCanvasProps> = ({
         return nodeColorMap.get(node.id) || '#a8dadc';
     }, [nodeColorMap]);
 
-    // Node size based on degree (relationship count)
+    // Highest score of the selected centrality metric, so sizes are relative to the visible graph
+    const maxCentrality = useMemo(() => {
+        if (sizeBy === 'relationships') return 0;
+        return data.nodes.reduce((max, node) => Math.max(max, node.centrality?.[sizeBy] || 0), 0);
+    }, [data.nodes, sizeBy]);
+
+    // Node size based on degree (relationship count) or the selected centrality metric
     const getNodeSize = useCallback((node: GraphNodeWithPosition) => {
+        if (sizeBy !== 'relationships') {
+            // Scale size from 4 to 12 relative to the most central node
+            const score = node.centrality?.[sizeBy] || 0;
+            return maxCentrality > 0 ? 4 + 8 * score / maxCentrality : 4;
+        }
         const degree = node.properties?.degree || 0;
         // Scale size from 4 to 12 based on degree
         return Math.min(12, 4 + Math.log(degree + 1) * 2);
-    }, []);
+    }, [sizeBy, maxCentrality]);
 
     // Node label with relationship count indicator
     const getNodeLabel = useCallback((node: GraphNodeWithPosition) => {
@@ -194,6 +215,28 @@ const GraphCanvas: React.FC<GraphCanvasProps> = ({
                         ⌖ Recenter
                     </button>
                 </Tooltip>
+                <Tooltip content="Colour nodes by entity type or by detected community (run community detection first)">
+                    <select
+                        value={colorBy}
+                        onChange={e => setColorBy(e.target.value as 'type' | 'community')}
+                        aria-label="Colour nodes by"
+                    >
+                        <option value="type">Colour: type</option>
+                        <option value="community">Colour: community</option>
+                    </select>
+                </Tooltip>
+                <Tooltip content="Size nodes by relationship count or by centrality (run the analytics first)">
+                    <select
+                        value={sizeBy}
+                        onChange={e => setSizeBy(e.target.value)}
+                        aria-label="Size nodes by"
+                    >
+                        <option value="relationships">Size: relationships</option>
+                        {CENTRALITY_METRICS.map(({ key, label }) => (
+                            <option key={key} value={key}>Size: {label}</option>
+                        ))}
+                    </select>
+                </Tooltip>
                 <div className="graph-stats">
                     {data.nodes.length} nodes, {data.links.length} edges
                 </div>
@@ -206,10 +249,7 @@ const GraphCanvas: React.FC<GraphCanvasProps> = ({
                 nodeId="id"
                 nodeLabel={getNodeLabel}
                 nodeColor={getNodeColor}
-                nodeVal={(node: any) => {
-                    const degree = node.properties?.degree || 0;
-                    return Math.min(12, 4 + Math.log(degree + 1) * 2);
-                }}
+                nodeVal={getNodeSize}
                 nodeCanvasObjectMode={() => 'after'}
                 nodeCanvasObject={(node: any, ctx: CanvasRenderingContext2D, globalScale: number) => {
                     const isGhost = node.properties?.is_ghost === true;
@@ -226,7 +266,7 @@ const GraphCanvas: React.FC<GraphCanvasProps> = ({
 
                     // Draw search highlight ring
                     if (highlightedNodeIds.has(node.id)) {
-                        const nodeSize = Math.min(12, 4 + Math.log((node.properties?.degree || 0) + 1) * 2);
+                        const nodeSize = getNodeSize(node);
                         ctx.beginPath();
                         ctx.arc(node.x!, node.y!, nodeSize * 1.8, 0, 2 * Math.PI);
                         ctx.strokeStyle = '#fbbf24';
@@ -237,7 +277,7 @@ const GraphCanvas: React.FC<GraphCanvasProps> = ({
                     // Draw relationship count badge for nodes with relationships
                     const degree = node.properties?.degree || 0;
                     if (degree > 0) {
-                        const nodeSize = Math.min(12, 4 + Math.log(degree + 1) * 2);
+                        const nodeSize = getNodeSize(node);
                         const badgeSize = 6 / globalScale;
                         ctx.beginPath();
                         ctx.arc(node.x! + nodeSize * 0.7, node.y! - nodeSize * 0.7, badgeSize, 0, 2 * Math.PI);
@@ -266,8 +306,7 @@ const GraphCanvas: React.FC<GraphCanvasProps> = ({
                 nodePointerAreaPaint={(node: any, color: string, ctx: CanvasRenderingContext2D) => {
                     // Increase clickable area for better UX
                     ctx.fillStyle = color;
-                    const degree = node.properties?.degree || 0;
-                    const nodeSize = Math.min(12, 4 + Math.log(degree + 1) * 2);
+                    const nodeSize = getNodeSize(node);
                     ctx.beginPath();
                     ctx.arc(node.x!, node.y!, nodeSize * 1.5, 0, 2 * Math.PI);
                     ctx.fill();
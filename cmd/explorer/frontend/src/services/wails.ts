@@ -9,7 +9,9 @@ import {
     GetNodes,
     AnalyzeEntities,
     PromoteEntity,
-    RegenerateAndReload
+    RegenerateAndReload,
+    MergeNodes,
+    GetThread
 } from '../wailsjs/go/main/App';
 import type { explorer, main } from '../wailsjs/go/models';
 import type { NodeFilter } from '../types/graph';
@@ -64,4 +66,14 @@ export const wailsAPI = {
     async regenerateAndReload(): Promise<void> {
         return await RegenerateAndReload();
     },
+
+    // Curation operations
+    async mergeNodes(request: main.MergeNodesRequest): Promise<main.MergeNodesResponse> {
+        return await MergeNodes(request);
+    },
+
+    // Thread operations
+    async getThread(messageId: string): Promise<main.ThreadView | null> {
+        return await GetThread(messageId);
+    },
 };
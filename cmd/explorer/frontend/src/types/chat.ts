@@ -20,6 +20,46 @@ export interface EntityReference {
     unique_id: string;
 }
 
+/**
+ * Represents an email supporting an entity or relationship in a chat response
+ */
+export interface Citation {
+    /** Message ID of the source email (used as node ID in graph) */
+    message_id: string;
+
+    /** Subject of the source email */
+    subject: string;
+
+    /** Date of the source email (YYYY-MM-DD) */
+    date: string;
+
+    /** Entity the email supports, if any */
+    entity_id?: number;
+
+    /** Relationship type the email supports, if any */
+    relationship?: string;
+}
+
+/**
+ * Represents one graph tool call made by the chat planner
+ */
+export interface ToolCall {
+    /** 1-based step number */
+    step: number;
+
+    /** Graph tool the LLM chose (entity_lookup, relationship, ...) */
+    action: string;
+
+    /** Arguments passed to the tool */
+    args?: Record<string, string>;
+
+    /** Text observed from the tool */
+    observation?: string;
+
+    /** Error message if the tool call failed */
+    error?: string;
+}
+
 /**
  * Represents a chat response with entity metadata
  */
@@ -29,6 +69,12 @@ export interface FormattedResponse {
 
     /** Array of entity references mentioned in the response */
     entities: EntityReference[];
+
+    /** Source emails supporting the response */
+    citations?: Citation[];
+
+    /** Graph tool calls made to produce the response */
+    trace?: ToolCall[];
 }
 
 /**
@@ -49,6 +95,12 @@ export interface ChatMessage {
 
     /** Entity references in the message (for system messages) */
     entities?: EntityReference[];
+
+    /** Source email citations in the message (for system messages) */
+    citations?: Citation[];
+
+    /** Graph tool calls behind the message (for system messages) */
+    trace?: ToolCall[];
 }
 
 /**
@@ -30,6 +30,19 @@ export interface GraphNode {
     properties: Record<string, any>;
     is_ghost?: boolean;
     degree?: number;
+    provenance?: ProvenanceRecord[];
+    centrality?: Record<string, number>; // Stored centrality scores by metric
+    community?: number; // Detected community ID
+    community_label?: string;
+}
+
+export interface ProvenanceRecord {
+    message_id?: string;
+    file_path?: string;
+    source: string;
+    model?: string;
+    prompt_version?: string;
+    extracted_at: string;
 }
 
 export interface GraphEdge {
@@ -85,3 +98,12 @@ export interface ExpandedNodeState {
     hasMore: boolean;
     totalRelationships: number;
 }
+
+// Centrality metrics computed by the graph analytics, in the order they are displayed
+export const CENTRALITY_METRICS = [
+    { key: 'degree', label: 'Degree' },
+    { key: 'weighted_degree', label: 'Weighted degree' },
+    { key: 'pagerank', label: 'PageRank' },
+    { key: 'betweenness', label: 'Betweenness' },
+    { key: 'closeness', label: 'Closeness' },
+];
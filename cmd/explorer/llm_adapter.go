@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"log/slog"
 	"os"
 
@@ -25,6 +26,11 @@ func (a *llmAdapter) GenerateCompletion(ctx context.Context, prompt string) (str
 	return a.client.GenerateCompletion(ctx, prompt)
 }
 
+// GenerateStructured implements chat.StructuredLLMClient
+func (a *llmAdapter) GenerateStructured(ctx context.Context, prompt string, schema *llm.ResponseSchema) (json.RawMessage, error) {
+	return a.client.GenerateStructured(ctx, prompt, schema)
+}
+
 // GenerateEmbedding implements chat.LLMClient
 func (a *llmAdapter) GenerateEmbedding(ctx context.Context, text string) ([]float32, error) {
 	return a.client.GenerateEmbedding(ctx, text)
@@ -37,27 +43,10 @@ func newProductionLLMClient(cfg *utils.Config) chat.LLMClient {
 		Level: slog.LevelInfo,
 	}))
 
-	var client llm.Client
-
-	// Choose client based on LLM_PROVIDER setting
-	if cfg.LLMProvider == "litellm" {
-		client = llm.NewLiteLLMClient(
-			cfg.LiteLLMURL,
-			cfg.CompletionModel,
-			cfg.EmbeddingModel,
-			cfg.LiteLLMAPIKey,
-			logger,
-		)
-	} else {
-		// Default to Ollama
-		client = llm.NewOllamaClient(
-			cfg.OllamaURL,
-			cfg.CompletionModel,
-			cfg.EmbeddingModel,
-			logger,
-		)
+	client, err := llm.NewClientFromConfig(cfg, logger)
+	if err != nil {
+		logger.Error("Failed to create LLM client, chat falls back to the development stub", "error", err)
+		return chat.NewStubLLMClient()
 	}
-
-	// Wrap in adapter
 	return newLLMAdapter(client)
 }
@@ -8,6 +8,7 @@ import (
 	"os"
 
 	"github.com/Blogem/enron-graph/ent"
+	"github.com/Blogem/enron-graph/internal/graph"
 	"github.com/Blogem/enron-graph/pkg/llm"
 	"github.com/Blogem/enron-graph/pkg/utils"
 	_ "github.com/lib/pq"
@@ -42,16 +43,20 @@ func main() {
 
 	// Initialize LLM client
 	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
-	var llmClient llm.Client
-	if cfg.LLMProvider == "litellm" {
-		llmClient = llm.NewLiteLLMClient(cfg.LiteLLMURL, cfg.CompletionModel, cfg.EmbeddingModel, cfg.LiteLLMAPIKey, logger)
-	} else {
-		llmClient = llm.NewOllamaClient(cfg.OllamaURL, cfg.CompletionModel, cfg.EmbeddingModel, logger)
+	llmClient, err := llm.NewClientFromConfig(cfg, logger)
+	if err != nil {
+		log.Fatalf("Failed to create LLM client: %v", err)
 	}
 	log.Printf("Initialized LLM client: %s (embedding model: %s)", cfg.LLMProvider, cfg.EmbeddingModel)
 
+	// Semantic search must use the metric the embedding index was built for
+	vectorConfig, err := graph.NewVectorConfig(cfg.EmbeddingDimensions, cfg.VectorMetric, cfg.VectorIndex)
+	if err != nil {
+		log.Fatalf("Invalid vector search configuration: %v", err)
+	}
+
 	// Create an instance of the app structure
-	app := NewApp(client, db, cfg, llmClient)
+	app := NewApp(client, db, cfg, vectorConfig, llmClient)
 
 	// Create application with options
 	err = wails.Run(&options.App{
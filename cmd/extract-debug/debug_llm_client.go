@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log/slog"
 	"strings"
@@ -62,6 +63,37 @@ func (d *DebugLLMClient) GenerateCompletion(ctx context.Context, prompt string)
 	return response, err
 }
 
+// GenerateStructured wraps the base client's GenerateStructured with debug logging
+func (d *DebugLLMClient) GenerateStructured(ctx context.Context, prompt string, schema *llm.ResponseSchema) (json.RawMessage, error) {
+	// Log the prompt
+	fmt.Println(strings.Repeat("-", 80))
+	fmt.Printf("LLM PROMPT (structured: %s):\n", schema.Name)
+	fmt.Println(strings.Repeat("-", 80))
+	if d.verbose {
+		fmt.Println(prompt)
+	} else {
+		fmt.Println(truncate(prompt, 500))
+	}
+	fmt.Println(strings.Repeat("-", 80))
+
+	// Call the base client
+	response, err := d.base.GenerateStructured(ctx, prompt, schema)
+
+	// Log the response
+	fmt.Println("\nLLM RESPONSE:")
+	fmt.Println(strings.Repeat("-", 80))
+	if err != nil {
+		fmt.Printf("ERROR: %v\n", err)
+	} else if d.verbose {
+		fmt.Println(string(response))
+	} else {
+		fmt.Println(truncate(string(response), 500))
+	}
+	fmt.Println(strings.Repeat("-", 80))
+
+	return response, err
+}
+
 // GenerateEmbedding wraps the base client's GenerateEmbedding (no logging needed for embeddings)
 func (d *DebugLLMClient) GenerateEmbedding(ctx context.Context, text string) ([]float32, error) {
 	d.logger.Debug("Generating embedding", "text_length", len(text))
@@ -14,6 +14,7 @@ import (
 	"github.com/Blogem/enron-graph/ent"
 	"github.com/Blogem/enron-graph/internal/extractor"
 	"github.com/Blogem/enron-graph/internal/graph"
+	"github.com/Blogem/enron-graph/internal/prompts"
 	"github.com/Blogem/enron-graph/pkg/llm"
 	"github.com/Blogem/enron-graph/pkg/utils"
 
@@ -26,6 +27,8 @@ func main() {
 	messageID := flag.String("message-id", "", "Extract from email with specific Message-ID")
 	limit := flag.Int("limit", 1, "Number of random emails to extract from (ignored if email-id or message-id specified)")
 	verbose := flag.Bool("verbose", false, "Show detailed extraction results (entities and relationships)")
+	cacheDir := flag.String("cache-dir", "", "Directory of cached LLM extraction responses (defaults to EXTRACTION_CACHE_DIR; empty disables the cache)")
+	replay := flag.Bool("replay", false, "Extract only from cached responses, never calling the LLM (requires a cache directory)")
 	dbURL := flag.String("db", "", "Database connection URL (optional, uses config if not provided)")
 
 	flag.Parse()
@@ -68,41 +71,21 @@ func main() {
 	}
 	defer sqlDB.Close()
 
+	vectorConfig, err := graph.NewVectorConfig(config.EmbeddingDimensions, config.VectorMetric, config.VectorIndex)
+	if err != nil {
+		logger.Error("Invalid vector search configuration", "error", err)
+		os.Exit(1)
+	}
+
 	// Create repository (wrapped to prevent writes)
-	baseRepo := graph.NewRepositoryWithDB(client, sqlDB, logger)
+	baseRepo := graph.NewRepositoryWithVectorConfig(client, sqlDB, logger, vectorConfig)
 	repo := NewReadOnlyRepository(baseRepo, logger)
 
 	// Initialize LLM client based on provider
-	var llmClient llm.Client
-	switch config.LLMProvider {
-	case "litellm":
-		logger.Info("Using LiteLLM provider",
-			"url", config.LiteLLMURL,
-			"completion_model", config.CompletionModel,
-			"embedding_model", config.EmbeddingModel)
-		llmClient = llm.NewLiteLLMClient(
-			config.LiteLLMURL,
-			config.CompletionModel,
-			config.EmbeddingModel,
-			config.LiteLLMAPIKey,
-			logger,
-		)
-	default:
-		// Default to Ollama
-		ollamaURL := config.OllamaURL
-		if ollamaURL == "" {
-			ollamaURL = "http://localhost:11434"
-		}
-		logger.Info("Using Ollama provider",
-			"url", ollamaURL,
-			"completion_model", config.CompletionModel,
-			"embedding_model", config.EmbeddingModel)
-		llmClient = llm.NewOllamaClient(
-			ollamaURL,
-			config.CompletionModel,
-			config.EmbeddingModel,
-			logger,
-		)
+	llmClient, err := llm.NewClientFromConfig(config, logger)
+	if err != nil {
+		logger.Error("Failed to create LLM client", "error", err)
+		os.Exit(1)
 	}
 
 	// Wrap LLM client with debug logging
@@ -110,6 +93,34 @@ func main() {
 
 	// Create extractor
 	extr := extractor.NewExtractor(debugLLMClient, repo, logger)
+	extr.SetModel(config.CompletionModel)
+	promptRegistry, err := prompts.Load(config.PromptDir, config.PromptVersions)
+	if err != nil {
+		logger.Error("Failed to load prompt templates", "error", err)
+		os.Exit(1)
+	}
+	if err := extr.SetPrompts(promptRegistry); err != nil {
+		logger.Error("Failed to select extraction prompt", "error", err)
+		os.Exit(1)
+	}
+	logger.Info("Using extraction prompt", "version", extr.PromptVersion())
+
+	// Consult the extraction cache, if configured, so repeated debug runs skip the LLM
+	if *cacheDir == "" {
+		*cacheDir = config.ExtractionCacheDir
+	}
+	if *cacheDir != "" {
+		cache, err := extractor.NewDiskCache(*cacheDir)
+		if err != nil {
+			logger.Error("Failed to open extraction cache", "error", err)
+			os.Exit(1)
+		}
+		extr.SetCache(cache, *replay)
+		logger.Info("Using extraction cache", "dir", *cacheDir, "replay", *replay)
+	} else if *replay {
+		logger.Error("--replay requires --cache-dir or EXTRACTION_CACHE_DIR")
+		os.Exit(1)
+	}
 
 	// Query emails based on flags
 	ctx := context.Background()
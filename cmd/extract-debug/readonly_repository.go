@@ -4,6 +4,7 @@ import (
 	"context"
 	"log/slog"
 	"math/rand"
+	"time"
 
 	"github.com/Blogem/enron-graph/ent"
 	"github.com/Blogem/enron-graph/internal/graph"
@@ -36,6 +37,16 @@ func (r *ReadOnlyRepository) FindEmailByMessageID(ctx context.Context, messageID
 	return r.base.FindEmailByMessageID(ctx, messageID)
 }
 
+// GetEmail delegates to base repository (read operation)
+func (r *ReadOnlyRepository) GetEmail(ctx context.Context, id int) (*ent.Email, error) {
+	return r.base.GetEmail(ctx, id)
+}
+
+// FindEmailIDs delegates to base repository (read operation)
+func (r *ReadOnlyRepository) FindEmailIDs(ctx context.Context, filter *graph.EmailFilter) ([]int, error) {
+	return r.base.FindEmailIDs(ctx, filter)
+}
+
 // CreateDiscoveredEntity captures the entity but doesn't persist it
 func (r *ReadOnlyRepository) CreateDiscoveredEntity(ctx context.Context, entity *graph.EntityInput) (*ent.DiscoveredEntity, error) {
 	r.logger.Debug("Captured entity (not persisted)", "type", entity.TypeCategory, "name", entity.Name)
@@ -98,8 +109,8 @@ func (r *ReadOnlyRepository) CreateRelationship(ctx context.Context, rel *graph.
 }
 
 // FindRelationshipsByEntity delegates to base repository (read operation)
-func (r *ReadOnlyRepository) FindRelationshipsByEntity(ctx context.Context, entityType string, entityID int) ([]*ent.Relationship, error) {
-	return r.base.FindRelationshipsByEntity(ctx, entityType, entityID)
+func (r *ReadOnlyRepository) FindRelationshipsByEntity(ctx context.Context, entityType string, entityID int, window graph.TimeWindow) ([]*ent.Relationship, error) {
+	return r.base.FindRelationshipsByEntity(ctx, entityType, entityID, window)
 }
 
 // GetDistinctRelationshipTypes delegates to base repository (read operation)
@@ -108,13 +119,135 @@ func (r *ReadOnlyRepository) GetDistinctRelationshipTypes(ctx context.Context) (
 }
 
 // TraverseRelationships delegates to base repository (read operation)
-func (r *ReadOnlyRepository) TraverseRelationships(ctx context.Context, fromID int, relType string, depth int) ([]*ent.DiscoveredEntity, error) {
-	return r.base.TraverseRelationships(ctx, fromID, relType, depth)
+func (r *ReadOnlyRepository) TraverseRelationships(ctx context.Context, fromID int, relType string, depth int, window graph.TimeWindow) ([]*ent.DiscoveredEntity, error) {
+	return r.base.TraverseRelationships(ctx, fromID, relType, depth, window)
 }
 
 // FindShortestPath delegates to base repository (read operation)
-func (r *ReadOnlyRepository) FindShortestPath(ctx context.Context, fromID, toID int) ([]*ent.Relationship, error) {
-	return r.base.FindShortestPath(ctx, fromID, toID)
+func (r *ReadOnlyRepository) FindShortestPath(ctx context.Context, fromID, toID int, window graph.TimeWindow) ([]*ent.Relationship, error) {
+	return r.base.FindShortestPath(ctx, fromID, toID, window)
+}
+
+// FindPaths delegates to base repository (read operation)
+func (r *ReadOnlyRepository) FindPaths(ctx context.Context, fromID, toID int, opts graph.PathOptions) ([]graph.Path, error) {
+	return r.base.FindPaths(ctx, fromID, toID, opts)
+}
+
+// RecordProvenance is blocked (read-only)
+func (r *ReadOnlyRepository) RecordProvenance(ctx context.Context, input *graph.ProvenanceInput) (*ent.Provenance, error) {
+	r.logger.Debug("Blocked RecordProvenance call (read-only mode)", "subject_type", input.SubjectType, "source", input.Source)
+	return &ent.Provenance{}, nil
+}
+
+// FindProvenance delegates to base repository (read operation)
+func (r *ReadOnlyRepository) FindProvenance(ctx context.Context, subjectType string, subjectID int) ([]*ent.Provenance, error) {
+	return r.base.FindProvenance(ctx, subjectType, subjectID)
+}
+
+// MergeEntities is blocked (read-only)
+func (r *ReadOnlyRepository) MergeEntities(ctx context.Context, survivorID int, victimIDs []int, reason string) (*graph.MergeResult, error) {
+	r.logger.Debug("Blocked MergeEntities call (read-only mode)", "survivor_id", survivorID, "merged_ids", victimIDs)
+	return &graph.MergeResult{MergedIDs: victimIDs}, nil
+}
+
+// SplitEntity is blocked (read-only)
+func (r *ReadOnlyRepository) SplitEntity(ctx context.Context, entityID int, input *graph.SplitInput) (*graph.SplitResult, error) {
+	r.logger.Debug("Blocked SplitEntity call (read-only mode)", "entity_id", entityID, "unique_id", input.UniqueID)
+	return &graph.SplitResult{}, nil
+}
+
+// FindEntityAudits delegates to base repository (read operation)
+func (r *ReadOnlyRepository) FindEntityAudits(ctx context.Context, entityID int) ([]*ent.EntityAudit, error) {
+	return r.base.FindEntityAudits(ctx, entityID)
+}
+
+// RecordAlias is blocked (read-only)
+func (r *ReadOnlyRepository) RecordAlias(ctx context.Context, input *graph.AliasInput) (*ent.EntityAlias, error) {
+	r.logger.Debug("Blocked RecordAlias call (read-only mode)", "entity_id", input.EntityID, "alias", input.Alias, "source", input.Source)
+	return &ent.EntityAlias{EntityID: input.EntityID, Alias: input.Alias, Source: input.Source}, nil
+}
+
+// ThreadEmail is blocked (read-only)
+func (r *ReadOnlyRepository) ThreadEmail(ctx context.Context, input *graph.ThreadInput) (*ent.Thread, error) {
+	r.logger.Debug("Blocked ThreadEmail call (read-only mode)", "email_id", input.Email.ID)
+	return &ent.Thread{}, nil
+}
+
+// GetThread delegates to base repository (read operation)
+func (r *ReadOnlyRepository) GetThread(ctx context.Context, id int) (*ent.Thread, error) {
+	return r.base.GetThread(ctx, id)
+}
+
+// FindThreadByEmail delegates to base repository (read operation)
+func (r *ReadOnlyRepository) FindThreadByEmail(ctx context.Context, emailID int) (*ent.Thread, error) {
+	return r.base.FindThreadByEmail(ctx, emailID)
+}
+
+// FindThreadBySubject delegates to base repository (read operation)
+func (r *ReadOnlyRepository) FindThreadBySubject(ctx context.Context, subject string, since time.Time) (*ent.Thread, error) {
+	return r.base.FindThreadBySubject(ctx, subject, since)
+}
+
+// FindThreadEmails delegates to base repository (read operation)
+func (r *ReadOnlyRepository) FindThreadEmails(ctx context.Context, threadID int) ([]*graph.ThreadMessage, error) {
+	return r.base.FindThreadEmails(ctx, threadID)
+}
+
+// EnqueueExtractionJobs is blocked (read-only)
+func (r *ReadOnlyRepository) EnqueueExtractionJobs(ctx context.Context, emailIDs []int, model, batch string) (int, error) {
+	r.logger.Debug("Blocked EnqueueExtractionJobs call (read-only mode)", "emails", len(emailIDs))
+	return 0, nil
+}
+
+// ClaimExtractionJobs is blocked (read-only)
+func (r *ReadOnlyRepository) ClaimExtractionJobs(ctx context.Context, worker, batch string, limit int) ([]*ent.ExtractionJob, error) {
+	r.logger.Debug("Blocked ClaimExtractionJobs call (read-only mode)", "worker", worker)
+	return nil, nil
+}
+
+// RequeueExtractionJobs is blocked (read-only)
+func (r *ReadOnlyRepository) RequeueExtractionJobs(ctx context.Context, emailIDs []int, model, batch string) (int, error) {
+	r.logger.Debug("Blocked RequeueExtractionJobs call (read-only mode)", "emails", len(emailIDs))
+	return 0, nil
+}
+
+// CompleteExtractionJob is blocked (read-only)
+func (r *ReadOnlyRepository) CompleteExtractionJob(ctx context.Context, id int, model, promptVersion string) error {
+	r.logger.Debug("Blocked CompleteExtractionJob call (read-only mode)", "job_id", id)
+	return nil
+}
+
+// FailExtractionJob is blocked (read-only)
+func (r *ReadOnlyRepository) FailExtractionJob(ctx context.Context, id int, cause string, maxAttempts int) (*ent.ExtractionJob, error) {
+	r.logger.Debug("Blocked FailExtractionJob call (read-only mode)", "job_id", id)
+	return &ent.ExtractionJob{ID: id}, nil
+}
+
+// ReleaseStaleExtractionJobs is blocked (read-only)
+func (r *ReadOnlyRepository) ReleaseStaleExtractionJobs(ctx context.Context, claimedBefore time.Time) (int, error) {
+	r.logger.Debug("Blocked ReleaseStaleExtractionJobs call (read-only mode)")
+	return 0, nil
+}
+
+// CountExtractionJobs delegates to base repository (read operation)
+func (r *ReadOnlyRepository) CountExtractionJobs(ctx context.Context) (map[string]int, error) {
+	return r.base.CountExtractionJobs(ctx)
+}
+
+// ClearEmailExtraction is blocked (read-only)
+func (r *ReadOnlyRepository) ClearEmailExtraction(ctx context.Context, emailID int) (*graph.ClearResult, error) {
+	r.logger.Debug("Blocked ClearEmailExtraction call (read-only mode)", "email_id", emailID)
+	return &graph.ClearResult{EmailID: emailID}, nil
+}
+
+// FindAliases delegates to base repository (read operation)
+func (r *ReadOnlyRepository) FindAliases(ctx context.Context, entityID int) ([]*ent.EntityAlias, error) {
+	return r.base.FindAliases(ctx, entityID)
+}
+
+// FindEntitiesByAlias delegates to base repository (read operation)
+func (r *ReadOnlyRepository) FindEntitiesByAlias(ctx context.Context, alias string, typeHint ...string) ([]*ent.DiscoveredEntity, error) {
+	return r.base.FindEntitiesByAlias(ctx, alias, typeHint...)
 }
 
 // SimilaritySearch delegates to base repository (read operation)
@@ -0,0 +1,288 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"flag"
+	"log"
+	"log/slog"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/Blogem/enron-graph/ent"
+	"github.com/Blogem/enron-graph/internal/extractor"
+	"github.com/Blogem/enron-graph/internal/graph"
+	"github.com/Blogem/enron-graph/internal/prompts"
+	"github.com/Blogem/enron-graph/pkg/llm"
+	"github.com/Blogem/enron-graph/pkg/utils"
+
+	_ "github.com/lib/pq"
+)
+
+const dateLayout = "2006-01-02"
+
+func main() {
+	// Selection flags
+	since := flag.String("since", "", "Only emails sent on or after this date (YYYY-MM-DD)")
+	until := flag.String("until", "", "Only emails sent before this date (YYYY-MM-DD)")
+	from := flag.String("from", "", "Only emails from these senders (comma-separated addresses)")
+	messageIDs := flag.String("message-ids", "", "Only these Message-IDs (comma-separated)")
+	messageIDFile := flag.String("message-id-file", "", "Only the Message-IDs listed in this file, one per line")
+	neverExtracted := flag.Bool("never-extracted", false, "Only emails that were never extracted")
+	outdated := flag.Bool("outdated", false, "Only emails last extracted with a different model or prompt version (implies --reextract)")
+	limit := flag.Int("limit", 0, "Maximum number of emails to select (0 for no limit)")
+
+	// Run flags
+	reextract := flag.Bool("reextract", false, "Extract already extracted emails again, replacing their previous results")
+	model := flag.String("model", "", "Completion model to extract with (defaults to COMPLETION_MODEL)")
+	workers := flag.Int("workers", 10, "Number of concurrent extraction workers (1-100)")
+	maxAttempts := flag.Int("max-attempts", extractor.DefaultMaxAttempts, "Attempts per email before an extraction job is marked failed")
+	staleAfter := flag.Duration("stale-after", extractor.DefaultStaleAfter, "Requeue running extraction jobs claimed longer ago than this (abandoned by a crashed process)")
+	cacheDir := flag.String("cache-dir", "", "Directory of cached LLM extraction responses (defaults to EXTRACTION_CACHE_DIR; empty disables the cache)")
+	replay := flag.Bool("replay", false, "Extract only from cached responses, never calling the LLM (requires a cache directory)")
+	dryRun := flag.Bool("dry-run", false, "Only report how many emails the selection matches")
+	dbURL := flag.String("db", "", "Database connection URL (optional, uses config if not provided)")
+
+	flag.Parse()
+
+	if *workers < 1 || *workers > 100 {
+		log.Fatal("--workers must be between 1 and 100")
+	}
+	if *neverExtracted && (*outdated || *reextract) {
+		log.Fatal("--never-extracted cannot be combined with --outdated or --reextract")
+	}
+
+	filter := graph.EmailFilter{
+		Senders:        splitList(*from),
+		MessageIDs:     trimMessageIDs(splitList(*messageIDs)),
+		NeverExtracted: *neverExtracted,
+		Limit:          *limit,
+	}
+	var err error
+	if filter.Since, err = parseDate(*since); err != nil {
+		log.Fatalf("invalid --since: %v", err)
+	}
+	if filter.Until, err = parseDate(*until); err != nil {
+		log.Fatalf("invalid --until: %v", err)
+	}
+	if *messageIDFile != "" {
+		ids, err := readLines(*messageIDFile)
+		if err != nil {
+			log.Fatalf("failed to read --message-id-file: %v", err)
+		}
+		filter.MessageIDs = append(filter.MessageIDs, trimMessageIDs(ids)...)
+	}
+
+	// Initialize logger
+	logger := utils.NewLogger()
+
+	// Load configuration
+	config, err := utils.LoadConfig()
+	if err != nil {
+		logger.Error("Failed to load configuration", "error", err)
+		os.Exit(1)
+	}
+	if *model != "" {
+		config.CompletionModel = *model
+	}
+	promptRegistry, err := prompts.Load(config.PromptDir, config.PromptVersions)
+	if err != nil {
+		logger.Error("Failed to load prompt templates", "error", err)
+		os.Exit(1)
+	}
+	promptVersion := promptRegistry.Version(prompts.Extraction)
+
+	logger.Info("Starting extraction",
+		"since", *since,
+		"until", *until,
+		"senders", len(filter.Senders),
+		"message_ids", len(filter.MessageIDs),
+		"never_extracted", *neverExtracted,
+		"outdated", *outdated,
+		"reextract", *reextract,
+		"model", config.CompletionModel,
+		"prompt_version", promptVersion,
+		"workers", *workers)
+
+	// Use provided DB URL or from config
+	connStr := *dbURL
+	if connStr == "" {
+		connStr = config.DatabaseURL
+	}
+
+	// Connect to database
+	client, err := ent.Open("postgres", connStr)
+	if err != nil {
+		logger.Error("Failed to connect to database", "error", err)
+		os.Exit(1)
+	}
+	defer client.Close()
+
+	// Also open a direct SQL connection for raw queries
+	sqlDB, err := sql.Open("postgres", connStr)
+	if err != nil {
+		logger.Error("Failed to open SQL database connection", slog.Any("error", err))
+		os.Exit(1)
+	}
+	defer sqlDB.Close()
+
+	vectorConfig, err := graph.NewVectorConfig(config.EmbeddingDimensions, config.VectorMetric, config.VectorIndex)
+	if err != nil {
+		logger.Error("Invalid vector search configuration", "error", err)
+		os.Exit(1)
+	}
+
+	repo := graph.NewRepositoryWithVectorConfig(client, sqlDB, logger, vectorConfig)
+
+	// Stop claiming jobs on Ctrl-C; jobs in flight go back to the queue
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if *dryRun {
+		filterCopy := filter
+		if *outdated {
+			filterCopy.OutdatedModel = config.CompletionModel
+			filterCopy.OutdatedPromptVersion = promptVersion
+		}
+		ids, err := repo.FindEmailIDs(ctx, &filterCopy)
+		if err != nil {
+			logger.Error("Failed to select emails", "error", err)
+			os.Exit(1)
+		}
+		logger.Info("Dry run: emails matching selection", "count", len(ids))
+		return
+	}
+
+	llmClient, err := llm.NewClientFromConfig(config, logger)
+	if err != nil {
+		logger.Error("Failed to create LLM client", "error", err)
+		os.Exit(1)
+	}
+
+	batchExtractor := extractor.NewBatchExtractor(llmClient, repo, logger, *workers)
+	batchExtractor.SetModel(config.CompletionModel)
+	if err := batchExtractor.SetPrompts(promptRegistry); err != nil {
+		logger.Error("Failed to select extraction prompt", "error", err)
+		os.Exit(1)
+	}
+	cache := openCache(*cacheDir, config, *replay, logger)
+	if cache != nil {
+		batchExtractor.SetCache(cache, *replay)
+	}
+
+	// Jobs are queued under a batch of their own so that this run extracts only the selection,
+	// not jobs other runs left in the queue
+	batch := extractor.NewBatchName()
+	selected, err := batchExtractor.QueueStored(ctx, extractor.SelectOptions{
+		Filter:    filter,
+		Outdated:  *outdated,
+		Reextract: *reextract,
+		Batch:     batch,
+	})
+	if err != nil {
+		logger.Error("Failed to queue emails", "error", err)
+		os.Exit(1)
+	}
+	logger.Info("Emails queued for extraction",
+		"selected", selected.Selected,
+		"queued", selected.Queued,
+		"batch", batch)
+
+	queueOptions := extractor.DefaultQueueOptions()
+	queueOptions.Batch = batch
+	queueOptions.MaxAttempts = *maxAttempts
+	queueOptions.StaleAfter = *staleAfter
+
+	start := time.Now()
+	if err := batchExtractor.ProcessQueue(ctx, queueOptions); err != nil {
+		logger.Error("Extraction failed", "error", err)
+		os.Exit(1)
+	}
+
+	stats := batchExtractor.GetStats()
+	jobCounts, err := repo.CountExtractionJobs(ctx)
+	if err != nil {
+		logger.Warn("Failed to count extraction jobs", "error", err)
+	}
+	logger.Info("Extraction complete",
+		"emails_processed", stats.EmailsProcessed,
+		"entities_created", stats.EntitiesCreated,
+		"relationships_created", stats.RelationshipsCreated,
+		"failures", stats.Failures,
+		"jobs_failed", jobCounts[graph.JobFailed],
+		"duration", time.Since(start).Round(time.Second))
+	if cache != nil {
+		hits, misses := cache.Stats()
+		logger.Info("Extraction cache", "hits", hits, "misses", misses)
+	}
+}
+
+// openCache opens the extraction response cache, or returns nil when no directory is configured
+func openCache(dir string, config *utils.Config, replay bool, logger *slog.Logger) *extractor.DiskCache {
+	if dir == "" {
+		dir = config.ExtractionCacheDir
+	}
+	if dir == "" {
+		if replay {
+			logger.Error("--replay requires --cache-dir or EXTRACTION_CACHE_DIR")
+			os.Exit(1)
+		}
+		return nil
+	}
+	cache, err := extractor.NewDiskCache(dir)
+	if err != nil {
+		logger.Error("Failed to open extraction cache", "error", err)
+		os.Exit(1)
+	}
+	logger.Info("Using extraction cache", "dir", dir, "replay", replay)
+	return cache
+}
+
+// parseDate parses a YYYY-MM-DD flag value; empty values yield the zero time
+func parseDate(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(dateLayout, value)
+}
+
+// splitList splits a comma-separated flag value, dropping empty items
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// trimMessageIDs strips the angle brackets Message-ID headers carry; IDs are stored without them
+func trimMessageIDs(ids []string) []string {
+	for i, id := range ids {
+		ids[i] = strings.Trim(id, "<>")
+	}
+	return ids
+}
+
+// readLines returns the non-empty lines of a file
+func readLines(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var lines []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines, scanner.Err()
+}
@@ -8,12 +8,16 @@ import (
 	"log"
 	"log/slog"
 	"os"
+	"os/signal"
+	"strings"
+	"syscall"
 	"time"
 
 	"github.com/Blogem/enron-graph/ent"
 	"github.com/Blogem/enron-graph/internal/extractor"
 	"github.com/Blogem/enron-graph/internal/graph"
 	"github.com/Blogem/enron-graph/internal/loader"
+	"github.com/Blogem/enron-graph/internal/prompts"
 	"github.com/Blogem/enron-graph/pkg/llm"
 	"github.com/Blogem/enron-graph/pkg/utils"
 
@@ -22,16 +26,32 @@ import (
 
 func main() {
 	// Command line flags
-	csvPath := flag.String("csv-path", "", "Path to Enron emails CSV file (required)")
+	sourceKind := flag.String("source", loader.SourceAuto, "Email source: "+strings.Join(loader.SourceKinds, ", "))
+	sourcePath := flag.String("path", "", "Path to the CSV file, maildir directory, mbox file or .eml file/directory (required unless --extract-only)")
+	csvPath := flag.String("csv-path", "", "Path to Enron emails CSV file (same as --source csv --path)")
 	workers := flag.Int("workers", 50, "Number of concurrent workers (10-100)")
 	extract := flag.Bool("extract", false, "Enable entity extraction (requires LLM)")
+	extractOnly := flag.Bool("extract-only", false, "Skip loading and work through the extraction queue (implies --extract)")
+	maxAttempts := flag.Int("max-attempts", extractor.DefaultMaxAttempts, "Attempts per email before an extraction job is marked failed")
+	staleAfter := flag.Duration("stale-after", extractor.DefaultStaleAfter, "Requeue running extraction jobs claimed longer ago than this (abandoned by a crashed process)")
+	cacheDir := flag.String("cache-dir", "", "Directory of cached LLM extraction responses (defaults to EXTRACTION_CACHE_DIR; empty disables the cache)")
+	replay := flag.Bool("replay", false, "Extract only from cached responses, never calling the LLM (requires a cache directory)")
 	dbURL := flag.String("db", "", "Database connection URL (optional, uses config if not provided)")
 
 	flag.Parse()
 
 	// Validate flags
-	if *csvPath == "" {
-		log.Fatal("--csv-path is required")
+	if *csvPath != "" {
+		if *sourcePath != "" {
+			log.Fatal("use either --csv-path or --path, not both")
+		}
+		*sourcePath = *csvPath
+		*sourceKind = loader.SourceCSV
+	}
+	if *extractOnly {
+		*extract = true
+	} else if *sourcePath == "" {
+		log.Fatal("--path is required")
 	}
 
 	if *workers < 1 || *workers > 100 {
@@ -41,9 +61,11 @@ func main() {
 	// Initialize logger
 	logger := utils.NewLogger()
 	logger.Info("Starting email loader",
-		"csv_path", *csvPath,
+		"source", *sourceKind,
+		"path", *sourcePath,
 		"workers", *workers,
-		"extract", *extract)
+		"extract", *extract,
+		"extract_only", *extractOnly)
 
 	// Load configuration
 	config, err := utils.LoadConfig()
@@ -52,6 +74,16 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Load prompt templates up front so a bad PROMPT_VERSIONS fails before loading emails
+	var promptRegistry *prompts.Registry
+	if *extract {
+		promptRegistry, err = prompts.Load(config.PromptDir, config.PromptVersions)
+		if err != nil {
+			logger.Error("Failed to load prompt templates", "error", err)
+			os.Exit(1)
+		}
+	}
+
 	// Use provided DB URL or from config
 	connStr := *dbURL
 	if connStr == "" {
@@ -74,22 +106,138 @@ func main() {
 	}
 	defer sqlDB.Close()
 
+	vectorConfig, err := graph.NewVectorConfig(config.EmbeddingDimensions, config.VectorMetric, config.VectorIndex)
+	if err != nil {
+		logger.Error("Invalid vector search configuration", "error", err)
+		os.Exit(1)
+	}
+
 	// Create repository
-	repo := graph.NewRepositoryWithDB(client, sqlDB, logger)
+	repo := graph.NewRepositoryWithVectorConfig(client, sqlDB, logger, vectorConfig)
+
+	// Stop claiming extraction jobs on Ctrl-C; jobs in flight go back to the queue
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
 
-	// Parse CSV
-	logger.Info("Parsing CSV file", "path", *csvPath)
-	records, errors, err := loader.ParseCSV(*csvPath)
+	if !*extractOnly {
+		loadEmails(ctx, repo, logger, *sourceKind, *sourcePath, *workers)
+	}
+
+	// Run entity extraction if enabled
+	if *extract {
+		logger.Info("Starting entity extraction...")
+
+		// Initialize LLM client based on provider
+		llmClient, err := llm.NewClientFromConfig(config, logger)
+		if err != nil {
+			logger.Error("Failed to create LLM client", "error", err)
+			os.Exit(1)
+		}
+
+		// Queue every stored email that has no extraction job yet. Jobs left over from an
+		// interrupted run are kept, so a restart continues where the previous one stopped.
+		emailIDs, err := client.Email.Query().IDs(ctx)
+		if err != nil {
+			logger.Error("Failed to query emails", "error", err)
+			os.Exit(1)
+		}
+		queued, err := repo.EnqueueExtractionJobs(ctx, emailIDs, config.CompletionModel, "")
+		if err != nil {
+			logger.Error("Failed to enqueue extraction jobs", "error", err)
+			os.Exit(1)
+		}
+		jobCounts, err := repo.CountExtractionJobs(ctx)
+		if err != nil {
+			logger.Error("Failed to count extraction jobs", "error", err)
+			os.Exit(1)
+		}
+		logger.Info("Extraction queue ready",
+			"queued", queued,
+			"pending", jobCounts[graph.JobPending],
+			"running", jobCounts[graph.JobRunning],
+			"done", jobCounts[graph.JobDone],
+			"failed", jobCounts[graph.JobFailed])
+
+		// Run queued extraction
+		extractionStart := time.Now()
+		batchExtractor := extractor.NewBatchExtractor(llmClient, repo, logger, *workers)
+		batchExtractor.SetModel(config.CompletionModel)
+		if err := batchExtractor.SetPrompts(promptRegistry); err != nil {
+			logger.Error("Failed to select extraction prompt", "error", err)
+			os.Exit(1)
+		}
+		logger.Info("Using extraction prompt", "version", batchExtractor.PromptVersion())
+		cache := openCache(*cacheDir, config, *replay, logger)
+		if cache != nil {
+			batchExtractor.SetCache(cache, *replay)
+		}
+
+		queueOptions := extractor.DefaultQueueOptions()
+		queueOptions.MaxAttempts = *maxAttempts
+		queueOptions.StaleAfter = *staleAfter
+
+		if err := batchExtractor.ProcessQueue(ctx, queueOptions); err != nil {
+			logger.Error("Extraction failed", "error", err)
+			os.Exit(1)
+		}
+
+		extractionStats := batchExtractor.GetStats()
+		extractionDuration := time.Since(extractionStart)
+
+		// Report extraction summary
+		logger.Info("Extraction complete",
+			"emails_processed", extractionStats.EmailsProcessed,
+			"entities_created", extractionStats.EntitiesCreated,
+			"relationships_created", extractionStats.RelationshipsCreated,
+			"failures", extractionStats.Failures,
+			"duration", extractionDuration.Round(time.Second))
+		if cache != nil {
+			hits, misses := cache.Stats()
+			logger.Info("Extraction cache", "hits", hits, "misses", misses)
+		}
+	}
+}
+
+// openCache opens the extraction response cache, or returns nil when no directory is configured
+func openCache(dir string, config *utils.Config, replay bool, logger *slog.Logger) *extractor.DiskCache {
+	if dir == "" {
+		dir = config.ExtractionCacheDir
+	}
+	if dir == "" {
+		if replay {
+			logger.Error("--replay requires --cache-dir or EXTRACTION_CACHE_DIR")
+			os.Exit(1)
+		}
+		return nil
+	}
+	cache, err := extractor.NewDiskCache(dir)
+	if err != nil {
+		logger.Error("Failed to open extraction cache", "error", err)
+		os.Exit(1)
+	}
+	logger.Info("Using extraction cache", "dir", dir, "replay", replay)
+	return cache
+}
+
+// loadEmails streams the email source into the database and exits on failure
+func loadEmails(ctx context.Context, repo graph.Repository, logger *slog.Logger, sourceKind, sourcePath string, workers int) {
+	// Open the email source
+	source, err := loader.NewEmailSource(sourceKind, sourcePath)
 	if err != nil {
-		logger.Error("Failed to open CSV file", "error", err)
+		logger.Error("Invalid email source", "error", err)
+		os.Exit(1)
+	}
+	logger.Info("Reading emails", "path", sourcePath)
+	records, errors, err := source.Stream()
+	if err != nil {
+		logger.Error("Failed to open email source", "error", err)
 		os.Exit(1)
 	}
 
 	// Create processor
-	processor := loader.NewProcessor(repo, logger, *workers)
+	processor := loader.NewProcessor(repo, logger, workers)
 
 	// Process emails
-	ctx := context.Background()
 	startTime := time.Now()
 
 	logger.Info("Processing emails...")
@@ -107,6 +255,7 @@ func main() {
 		"total_processed", stats.Processed,
 		"failures", stats.Failures,
 		"duplicates_skipped", stats.Skipped,
+		"threaded", stats.Threaded,
 		"duration", duration.Round(time.Second),
 		"rate", fmt.Sprintf("%.1f emails/sec", float64(stats.Processed)/duration.Seconds()))
 
@@ -123,75 +272,4 @@ func main() {
 	}
 
 	logger.Info("Email loading successful")
-
-	// Run entity extraction if enabled
-	if *extract {
-		logger.Info("Starting entity extraction...")
-
-		// Initialize LLM client based on provider
-		var llmClient llm.Client
-		switch config.LLMProvider {
-		case "litellm":
-			logger.Info("Using LiteLLM provider",
-				"url", config.LiteLLMURL,
-				"completion_model", config.CompletionModel,
-				"embedding_model", config.EmbeddingModel)
-			llmClient = llm.NewLiteLLMClient(
-				config.LiteLLMURL,
-				config.CompletionModel,
-				config.EmbeddingModel,
-				config.LiteLLMAPIKey,
-				logger,
-			)
-		default:
-			// Default to Ollama
-			ollamaURL := config.OllamaURL
-			if ollamaURL == "" {
-				ollamaURL = "http://localhost:11434"
-			}
-			logger.Info("Using Ollama provider",
-				"url", ollamaURL,
-				"completion_model", config.CompletionModel,
-				"embedding_model", config.EmbeddingModel)
-			llmClient = llm.NewOllamaClient(
-				ollamaURL,
-				config.CompletionModel,
-				config.EmbeddingModel,
-				logger,
-			)
-		}
-
-		// Query emails that were just loaded
-		logger.Info("Querying emails for extraction...")
-
-		emails, err := client.Email.Query().
-			Limit(int(stats.Processed)).
-			All(ctx)
-		if err != nil {
-			logger.Error("Failed to query emails", "error", err)
-			os.Exit(1)
-		}
-
-		logger.Info("Retrieved emails from database", "count", len(emails))
-
-		// Run batch extraction
-		extractionStart := time.Now()
-		batchExtractor := extractor.NewBatchExtractor(llmClient, repo, logger, *workers)
-
-		if err := batchExtractor.ProcessBatch(ctx, emails); err != nil {
-			logger.Error("Extraction failed", "error", err)
-			os.Exit(1)
-		}
-
-		extractionStats := batchExtractor.GetStats()
-		extractionDuration := time.Since(extractionStart)
-
-		// Report extraction summary
-		logger.Info("Extraction complete",
-			"emails_processed", extractionStats.EmailsProcessed,
-			"entities_created", extractionStats.EntitiesCreated,
-			"relationships_created", extractionStats.RelationshipsCreated,
-			"failures", extractionStats.Failures,
-			"duration", extractionDuration.Round(time.Second))
-	}
 }
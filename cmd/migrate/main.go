@@ -2,10 +2,12 @@ package main
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"log"
 
 	"github.com/Blogem/enron-graph/ent"
+	"github.com/Blogem/enron-graph/internal/graph"
 	"github.com/Blogem/enron-graph/pkg/utils"
 
 	_ "github.com/lib/pq"
@@ -37,7 +39,35 @@ func main() {
 
 	fmt.Println("✅ Database schema created successfully")
 
-	// Verify pgvector extension
-	utils.Info("Verifying pgvector extension...")
+	// Native pgvector column and index for similarity search
+	vectorConfig, err := graph.NewVectorConfig(config.EmbeddingDimensions, config.VectorMetric, config.VectorIndex)
+	if err != nil {
+		log.Fatalf("invalid vector search configuration: %v", err)
+	}
+
+	db, err := sql.Open("postgres", config.PostgresURL())
+	if err != nil {
+		log.Fatalf("failed opening SQL connection to postgres: %v", err)
+	}
+	defer db.Close()
+
+	utils.Info("Migrating embedding vectors...",
+		"dimensions", vectorConfig.Dimensions,
+		"metric", vectorConfig.Metric,
+		"index", vectorConfig.IndexType)
+
+	if err := graph.MigrateVectorColumn(ctx, db, vectorConfig, utils.NewLogger()); err != nil {
+		log.Fatalf("failed migrating embedding vectors: %v", err)
+	}
+
+	fmt.Println("✅ Embedding vector index created")
+
+	// Extraction used to store the type category of entities in relationships
+	migrated, err := graph.MigrateEntityRelationships(ctx, client, utils.NewLogger())
+	if err != nil {
+		log.Fatalf("failed migrating entity relationships: %v", err)
+	}
+
+	fmt.Printf("✅ Entity relationships migrated (%d updated)\n", migrated)
 	fmt.Println("✅ Migration complete")
 }
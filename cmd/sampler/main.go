@@ -6,8 +6,10 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
+	"github.com/Blogem/enron-graph/internal/loader"
 	"github.com/Blogem/enron-graph/internal/sampler"
 )
 
@@ -21,6 +23,8 @@ const (
 func main() {
 	// Command line flags
 	count := flag.Int("count", 0, "Number of emails to extract (required)")
+	source := flag.String("source", loader.SourceAuto, "Source format: "+strings.Join(loader.SourceKinds, ", "))
+	input := flag.String("input", defaultSourcePath, "Path to the source CSV file, maildir directory, mbox file or .eml file/directory")
 	help := flag.Bool("help", false, "Show usage information")
 
 	flag.Parse()
@@ -39,13 +43,13 @@ func main() {
 	fmt.Printf("Random Email Sampler - extracting %d emails\n", *count)
 
 	// Run extraction workflow
-	if err := runExtraction(*count); err != nil {
+	if err := runExtraction(*count, *source, *input); err != nil {
 		log.Fatalf("Extraction failed: %v", err)
 	}
 }
 
 // runExtraction performs the complete email extraction workflow
-func runExtraction(requestedCount int) error {
+func runExtraction(requestedCount int, sourceKind, sourcePath string) error {
 	timestamp := time.Now()
 
 	// Step 1: Load tracking registry
@@ -59,11 +63,11 @@ func runExtraction(requestedCount int) error {
 		fmt.Printf("Found %d previously extracted emails (from %d tracking files)\n", registry.Count(), fileCount)
 	}
 
-	// Step 2: Parse source CSV file (T021: error handling for missing file)
-	fmt.Printf("Parsing source CSV: %s\n", defaultSourcePath)
-	recordsChan, errsChan, err := sampler.ParseCSV(defaultSourcePath)
+	// Step 2: Parse source emails (T021: error handling for missing file)
+	fmt.Printf("Parsing source: %s\n", sourcePath)
+	recordsChan, errsChan, err := sampler.ParseSource(sourceKind, sourcePath)
 	if err != nil {
-		return fmt.Errorf("failed to open source CSV file '%s': %w\nPlease ensure the file exists and is accessible", defaultSourcePath, err)
+		return fmt.Errorf("failed to open source '%s': %w\nPlease ensure the file exists and is accessible", sourcePath, err)
 	}
 
 	// Collect all records (T019: progress logging)
@@ -75,7 +79,7 @@ func runExtraction(requestedCount int) error {
 	go func() {
 		for err := range errsChan {
 			parseErrors = append(parseErrors, err)
-			log.Printf("WARNING: CSV parsing error: %v", err)
+			log.Printf("WARNING: Source parsing error: %v", err)
 		}
 		done <- true
 	}()
@@ -173,13 +177,16 @@ func printUsage() {
 	fmt.Println("Random Email Sampler")
 	fmt.Println()
 	fmt.Println("Usage:")
-	fmt.Println("  go run cmd/sampler/main.go --count <number>")
+	fmt.Println("  go run cmd/sampler/main.go --count <number> [--source <format>] [--input <path>]")
 	fmt.Println()
 	fmt.Println("Flags:")
-	fmt.Println("  --count  Number of emails to extract (required, must be positive)")
-	fmt.Println("  --help   Show this help message")
+	fmt.Println("  --count   Number of emails to extract (required, must be positive)")
+	fmt.Println("  --source  Source format: auto, csv, maildir, mbox or eml (default auto)")
+	fmt.Println("  --input   Source path (default " + defaultSourcePath + ")")
+	fmt.Println("  --help    Show this help message")
 	fmt.Println()
 	fmt.Println("Examples:")
 	fmt.Println("  go run cmd/sampler/main.go --count 10")
 	fmt.Println("  go run cmd/sampler/main.go --count 1000")
+	fmt.Println("  go run cmd/sampler/main.go --count 100 --source maildir --input assets/enron-emails/maildir")
 }
@@ -80,42 +80,22 @@ func main() {
 	}
 	defer sqlDB.Close()
 
+	vectorConfig, err := graph.NewVectorConfig(cfg.EmbeddingDimensions, cfg.VectorMetric, cfg.VectorIndex)
+	if err != nil {
+		logger.Error("Invalid vector search configuration", slog.Any("error", err))
+		os.Exit(1)
+	}
+
 	// Create repository with both ent client and SQL connection
-	repo := graph.NewRepositoryWithDB(entClient, sqlDB, logger)
+	repo := graph.NewRepositoryWithVectorConfig(entClient, sqlDB, logger, vectorConfig)
 
 	logger.Info("Connected to database")
 
 	// Initialize LLM client based on provider (optional - for semantic search)
-	var llmClient llm.Client
-	switch cfg.LLMProvider {
-	case "litellm":
-		logger.Info("Using LiteLLM provider",
-			slog.String("url", cfg.LiteLLMURL),
-			slog.String("completion_model", cfg.CompletionModel),
-			slog.String("embedding_model", cfg.EmbeddingModel))
-		llmClient = llm.NewLiteLLMClient(
-			cfg.LiteLLMURL,
-			cfg.CompletionModel,
-			cfg.EmbeddingModel,
-			cfg.LiteLLMAPIKey,
-			logger,
-		)
-	default:
-		// Default to Ollama
-		ollamaURL := cfg.OllamaURL
-		if ollamaURL == "" {
-			ollamaURL = "http://localhost:11434"
-		}
-		logger.Info("Using Ollama provider",
-			slog.String("url", ollamaURL),
-			slog.String("completion_model", cfg.CompletionModel),
-			slog.String("embedding_model", cfg.EmbeddingModel))
-		llmClient = llm.NewOllamaClient(
-			ollamaURL,
-			cfg.CompletionModel,
-			cfg.EmbeddingModel,
-			logger,
-		)
+	llmClient, err := llm.NewClientFromConfig(cfg, logger)
+	if err != nil {
+		logger.Error("Failed to create LLM client", "error", err)
+		os.Exit(1)
 	}
 
 	// Create API handler
@@ -136,10 +116,30 @@ func main() {
 		r.Get("/entities", handler.SearchEntities)
 		r.Get("/entities/{id}/relationships", handler.GetEntityRelationships)
 		r.Get("/entities/{id}/neighbors", handler.GetEntityNeighbors)
+		r.Get("/entities/{id}/provenance", handler.GetEntityProvenance)
+		r.Get("/entities/{id}/audit", handler.GetEntityAudit)
+		r.Get("/entities/{id}/centrality", handler.GetEntityCentrality)
+		r.Get("/entities/{id}/community", handler.GetEntityCommunity)
+
+		// Entity curation
+		r.Post("/entities/{id}/merge", handler.MergeEntities)
+		r.Post("/entities/{id}/split", handler.SplitEntity)
 
 		// Graph operations
 		r.Post("/entities/path", handler.FindPath)
 		r.Post("/entities/search", handler.SemanticSearch)
+
+		// Graph analytics
+		r.Get("/analytics/centrality", handler.GetCentralityRanking)
+		r.Post("/analytics/centrality", handler.ComputeCentrality)
+		r.Get("/analytics/communities", handler.ListCommunities)
+		r.Post("/analytics/communities", handler.DetectCommunities)
+		r.Get("/analytics/runs/{id}", handler.GetAnalyticsRun)
+		r.Get("/communities/{id}", handler.GetCommunity)
+
+		// Conversation threads
+		r.Get("/threads/{id}", handler.GetThread)
+		r.Get("/emails/{id}/thread", handler.GetEmailThread)
 	})
 
 	// Health check endpoint
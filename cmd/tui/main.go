@@ -8,6 +8,7 @@ import (
 
 	"github.com/Blogem/enron-graph/ent"
 	"github.com/Blogem/enron-graph/internal/graph"
+	"github.com/Blogem/enron-graph/internal/prompts"
 	"github.com/Blogem/enron-graph/internal/tui"
 	"github.com/Blogem/enron-graph/pkg/llm"
 	"github.com/Blogem/enron-graph/pkg/utils"
@@ -37,41 +38,23 @@ func main() {
 	repo := graph.NewRepository(client, logger)
 
 	// Initialize LLM client based on provider (optional - chat will still work without it)
-	var llmClient llm.Client
-	switch cfg.LLMProvider {
-	case "litellm":
-		logger.Info("Using LiteLLM provider",
-			"url", cfg.LiteLLMURL,
-			"completion_model", cfg.CompletionModel,
-			"embedding_model", cfg.EmbeddingModel)
-		llmClient = llm.NewLiteLLMClient(
-			cfg.LiteLLMURL,
-			cfg.CompletionModel,
-			cfg.EmbeddingModel,
-			cfg.LiteLLMAPIKey,
-			logger,
-		)
-	default:
-		// Default to Ollama
-		ollamaURL := cfg.OllamaURL
-		if ollamaURL == "" {
-			ollamaURL = "http://localhost:11434"
-		}
-		logger.Info("Using Ollama provider",
-			"url", ollamaURL,
-			"completion_model", cfg.CompletionModel,
-			"embedding_model", cfg.EmbeddingModel)
-		llmClient = llm.NewOllamaClient(
-			ollamaURL,
-			cfg.CompletionModel,
-			cfg.EmbeddingModel,
-			logger,
-		)
+	llmClient, err := llm.NewClientFromConfig(cfg, logger)
+	if err != nil {
+		logger.Error("Failed to create LLM client", "error", err)
+		os.Exit(1)
 	}
 
 	// Create TUI model with repository
 	model := tui.NewModel(repo)
 
+	// Use the configured prompt template versions for chat
+	promptRegistry, err := prompts.Load(cfg.PromptDir, cfg.PromptVersions)
+	if err != nil {
+		log.Fatalf("Failed to load prompt templates: %v", err)
+	}
+	model.SetPrompts(promptRegistry)
+	model.SetMaxSteps(cfg.ChatMaxSteps)
+
 	// Set LLM client for chat functionality
 	model.SetLLMClient(llmClient)
 
@@ -0,0 +1,185 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Blogem/enron-graph/ent/centralityscore"
+)
+
+// CentralityScore is the model entity for the CentralityScore schema.
+type CentralityScore struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Relationship filter the scores were computed over, e.g. all or types=COMMUNICATES_WITH
+	Scope string `json:"scope,omitempty"`
+	// Discovered entity ID
+	EntityID int `json:"entity_id,omitempty"`
+	// Number of distinct neighbors
+	Degree int `json:"degree,omitempty"`
+	// Summed confidence of the entity's relationships
+	WeightedDegree float64 `json:"weighted_degree,omitempty"`
+	// Pagerank holds the value of the "pagerank" field.
+	Pagerank float64 `json:"pagerank,omitempty"`
+	// Normalized betweenness centrality (0-1)
+	Betweenness float64 `json:"betweenness,omitempty"`
+	// Normalized harmonic closeness centrality (0-1)
+	Closeness float64 `json:"closeness,omitempty"`
+	// ComputedAt holds the value of the "computed_at" field.
+	ComputedAt   time.Time `json:"computed_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CentralityScore) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case centralityscore.FieldWeightedDegree, centralityscore.FieldPagerank, centralityscore.FieldBetweenness, centralityscore.FieldCloseness:
+			values[i] = new(sql.NullFloat64)
+		case centralityscore.FieldID, centralityscore.FieldEntityID, centralityscore.FieldDegree:
+			values[i] = new(sql.NullInt64)
+		case centralityscore.FieldScope:
+			values[i] = new(sql.NullString)
+		case centralityscore.FieldComputedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CentralityScore fields.
+func (_m *CentralityScore) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case centralityscore.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case centralityscore.FieldScope:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field scope", values[i])
+			} else if value.Valid {
+				_m.Scope = value.String
+			}
+		case centralityscore.FieldEntityID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field entity_id", values[i])
+			} else if value.Valid {
+				_m.EntityID = int(value.Int64)
+			}
+		case centralityscore.FieldDegree:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field degree", values[i])
+			} else if value.Valid {
+				_m.Degree = int(value.Int64)
+			}
+		case centralityscore.FieldWeightedDegree:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field weighted_degree", values[i])
+			} else if value.Valid {
+				_m.WeightedDegree = value.Float64
+			}
+		case centralityscore.FieldPagerank:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field pagerank", values[i])
+			} else if value.Valid {
+				_m.Pagerank = value.Float64
+			}
+		case centralityscore.FieldBetweenness:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field betweenness", values[i])
+			} else if value.Valid {
+				_m.Betweenness = value.Float64
+			}
+		case centralityscore.FieldCloseness:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field closeness", values[i])
+			} else if value.Valid {
+				_m.Closeness = value.Float64
+			}
+		case centralityscore.FieldComputedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field computed_at", values[i])
+			} else if value.Valid {
+				_m.ComputedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CentralityScore.
+// This includes values selected through modifiers, order, etc.
+func (_m *CentralityScore) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this CentralityScore.
+// Note that you need to call CentralityScore.Unwrap() before calling this method if this CentralityScore
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *CentralityScore) Update() *CentralityScoreUpdateOne {
+	return NewCentralityScoreClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the CentralityScore entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *CentralityScore) Unwrap() *CentralityScore {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CentralityScore is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *CentralityScore) String() string {
+	var builder strings.Builder
+	builder.WriteString("CentralityScore(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("scope=")
+	builder.WriteString(_m.Scope)
+	builder.WriteString(", ")
+	builder.WriteString("entity_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EntityID))
+	builder.WriteString(", ")
+	builder.WriteString("degree=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Degree))
+	builder.WriteString(", ")
+	builder.WriteString("weighted_degree=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WeightedDegree))
+	builder.WriteString(", ")
+	builder.WriteString("pagerank=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Pagerank))
+	builder.WriteString(", ")
+	builder.WriteString("betweenness=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Betweenness))
+	builder.WriteString(", ")
+	builder.WriteString("closeness=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Closeness))
+	builder.WriteString(", ")
+	builder.WriteString("computed_at=")
+	builder.WriteString(_m.ComputedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CentralityScores is a parsable slice of CentralityScore.
+type CentralityScores []*CentralityScore
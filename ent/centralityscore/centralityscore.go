@@ -0,0 +1,124 @@
+// Code generated by ent, DO NOT EDIT.
+
+package centralityscore
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the centralityscore type in the database.
+	Label = "centrality_score"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldScope holds the string denoting the scope field in the database.
+	FieldScope = "scope"
+	// FieldEntityID holds the string denoting the entity_id field in the database.
+	FieldEntityID = "entity_id"
+	// FieldDegree holds the string denoting the degree field in the database.
+	FieldDegree = "degree"
+	// FieldWeightedDegree holds the string denoting the weighted_degree field in the database.
+	FieldWeightedDegree = "weighted_degree"
+	// FieldPagerank holds the string denoting the pagerank field in the database.
+	FieldPagerank = "pagerank"
+	// FieldBetweenness holds the string denoting the betweenness field in the database.
+	FieldBetweenness = "betweenness"
+	// FieldCloseness holds the string denoting the closeness field in the database.
+	FieldCloseness = "closeness"
+	// FieldComputedAt holds the string denoting the computed_at field in the database.
+	FieldComputedAt = "computed_at"
+	// Table holds the table name of the centralityscore in the database.
+	Table = "centrality_scores"
+)
+
+// Columns holds all SQL columns for centralityscore fields.
+var Columns = []string{
+	FieldID,
+	FieldScope,
+	FieldEntityID,
+	FieldDegree,
+	FieldWeightedDegree,
+	FieldPagerank,
+	FieldBetweenness,
+	FieldCloseness,
+	FieldComputedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ScopeValidator is a validator for the "scope" field. It is called by the builders before save.
+	ScopeValidator func(string) error
+	// EntityIDValidator is a validator for the "entity_id" field. It is called by the builders before save.
+	EntityIDValidator func(int) error
+	// DefaultDegree holds the default value on creation for the "degree" field.
+	DefaultDegree int
+	// DefaultWeightedDegree holds the default value on creation for the "weighted_degree" field.
+	DefaultWeightedDegree float64
+	// DefaultPagerank holds the default value on creation for the "pagerank" field.
+	DefaultPagerank float64
+	// DefaultBetweenness holds the default value on creation for the "betweenness" field.
+	DefaultBetweenness float64
+	// DefaultCloseness holds the default value on creation for the "closeness" field.
+	DefaultCloseness float64
+	// DefaultComputedAt holds the default value on creation for the "computed_at" field.
+	DefaultComputedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the CentralityScore queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByScope orders the results by the scope field.
+func ByScope(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScope, opts...).ToFunc()
+}
+
+// ByEntityID orders the results by the entity_id field.
+func ByEntityID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEntityID, opts...).ToFunc()
+}
+
+// ByDegree orders the results by the degree field.
+func ByDegree(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDegree, opts...).ToFunc()
+}
+
+// ByWeightedDegree orders the results by the weighted_degree field.
+func ByWeightedDegree(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWeightedDegree, opts...).ToFunc()
+}
+
+// ByPagerank orders the results by the pagerank field.
+func ByPagerank(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPagerank, opts...).ToFunc()
+}
+
+// ByBetweenness orders the results by the betweenness field.
+func ByBetweenness(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBetweenness, opts...).ToFunc()
+}
+
+// ByCloseness orders the results by the closeness field.
+func ByCloseness(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCloseness, opts...).ToFunc()
+}
+
+// ByComputedAt orders the results by the computed_at field.
+func ByComputedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldComputedAt, opts...).ToFunc()
+}
@@ -0,0 +1,455 @@
+// Code generated by ent, DO NOT EDIT.
+
+package centralityscore
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Blogem/enron-graph/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldLTE(FieldID, id))
+}
+
+// Scope applies equality check predicate on the "scope" field. It's identical to ScopeEQ.
+func Scope(v string) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldEQ(FieldScope, v))
+}
+
+// EntityID applies equality check predicate on the "entity_id" field. It's identical to EntityIDEQ.
+func EntityID(v int) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldEQ(FieldEntityID, v))
+}
+
+// Degree applies equality check predicate on the "degree" field. It's identical to DegreeEQ.
+func Degree(v int) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldEQ(FieldDegree, v))
+}
+
+// WeightedDegree applies equality check predicate on the "weighted_degree" field. It's identical to WeightedDegreeEQ.
+func WeightedDegree(v float64) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldEQ(FieldWeightedDegree, v))
+}
+
+// Pagerank applies equality check predicate on the "pagerank" field. It's identical to PagerankEQ.
+func Pagerank(v float64) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldEQ(FieldPagerank, v))
+}
+
+// Betweenness applies equality check predicate on the "betweenness" field. It's identical to BetweennessEQ.
+func Betweenness(v float64) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldEQ(FieldBetweenness, v))
+}
+
+// Closeness applies equality check predicate on the "closeness" field. It's identical to ClosenessEQ.
+func Closeness(v float64) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldEQ(FieldCloseness, v))
+}
+
+// ComputedAt applies equality check predicate on the "computed_at" field. It's identical to ComputedAtEQ.
+func ComputedAt(v time.Time) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldEQ(FieldComputedAt, v))
+}
+
+// ScopeEQ applies the EQ predicate on the "scope" field.
+func ScopeEQ(v string) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldEQ(FieldScope, v))
+}
+
+// ScopeNEQ applies the NEQ predicate on the "scope" field.
+func ScopeNEQ(v string) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldNEQ(FieldScope, v))
+}
+
+// ScopeIn applies the In predicate on the "scope" field.
+func ScopeIn(vs ...string) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldIn(FieldScope, vs...))
+}
+
+// ScopeNotIn applies the NotIn predicate on the "scope" field.
+func ScopeNotIn(vs ...string) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldNotIn(FieldScope, vs...))
+}
+
+// ScopeGT applies the GT predicate on the "scope" field.
+func ScopeGT(v string) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldGT(FieldScope, v))
+}
+
+// ScopeGTE applies the GTE predicate on the "scope" field.
+func ScopeGTE(v string) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldGTE(FieldScope, v))
+}
+
+// ScopeLT applies the LT predicate on the "scope" field.
+func ScopeLT(v string) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldLT(FieldScope, v))
+}
+
+// ScopeLTE applies the LTE predicate on the "scope" field.
+func ScopeLTE(v string) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldLTE(FieldScope, v))
+}
+
+// ScopeContains applies the Contains predicate on the "scope" field.
+func ScopeContains(v string) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldContains(FieldScope, v))
+}
+
+// ScopeHasPrefix applies the HasPrefix predicate on the "scope" field.
+func ScopeHasPrefix(v string) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldHasPrefix(FieldScope, v))
+}
+
+// ScopeHasSuffix applies the HasSuffix predicate on the "scope" field.
+func ScopeHasSuffix(v string) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldHasSuffix(FieldScope, v))
+}
+
+// ScopeEqualFold applies the EqualFold predicate on the "scope" field.
+func ScopeEqualFold(v string) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldEqualFold(FieldScope, v))
+}
+
+// ScopeContainsFold applies the ContainsFold predicate on the "scope" field.
+func ScopeContainsFold(v string) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldContainsFold(FieldScope, v))
+}
+
+// EntityIDEQ applies the EQ predicate on the "entity_id" field.
+func EntityIDEQ(v int) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldEQ(FieldEntityID, v))
+}
+
+// EntityIDNEQ applies the NEQ predicate on the "entity_id" field.
+func EntityIDNEQ(v int) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldNEQ(FieldEntityID, v))
+}
+
+// EntityIDIn applies the In predicate on the "entity_id" field.
+func EntityIDIn(vs ...int) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldIn(FieldEntityID, vs...))
+}
+
+// EntityIDNotIn applies the NotIn predicate on the "entity_id" field.
+func EntityIDNotIn(vs ...int) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldNotIn(FieldEntityID, vs...))
+}
+
+// EntityIDGT applies the GT predicate on the "entity_id" field.
+func EntityIDGT(v int) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldGT(FieldEntityID, v))
+}
+
+// EntityIDGTE applies the GTE predicate on the "entity_id" field.
+func EntityIDGTE(v int) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldGTE(FieldEntityID, v))
+}
+
+// EntityIDLT applies the LT predicate on the "entity_id" field.
+func EntityIDLT(v int) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldLT(FieldEntityID, v))
+}
+
+// EntityIDLTE applies the LTE predicate on the "entity_id" field.
+func EntityIDLTE(v int) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldLTE(FieldEntityID, v))
+}
+
+// DegreeEQ applies the EQ predicate on the "degree" field.
+func DegreeEQ(v int) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldEQ(FieldDegree, v))
+}
+
+// DegreeNEQ applies the NEQ predicate on the "degree" field.
+func DegreeNEQ(v int) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldNEQ(FieldDegree, v))
+}
+
+// DegreeIn applies the In predicate on the "degree" field.
+func DegreeIn(vs ...int) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldIn(FieldDegree, vs...))
+}
+
+// DegreeNotIn applies the NotIn predicate on the "degree" field.
+func DegreeNotIn(vs ...int) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldNotIn(FieldDegree, vs...))
+}
+
+// DegreeGT applies the GT predicate on the "degree" field.
+func DegreeGT(v int) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldGT(FieldDegree, v))
+}
+
+// DegreeGTE applies the GTE predicate on the "degree" field.
+func DegreeGTE(v int) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldGTE(FieldDegree, v))
+}
+
+// DegreeLT applies the LT predicate on the "degree" field.
+func DegreeLT(v int) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldLT(FieldDegree, v))
+}
+
+// DegreeLTE applies the LTE predicate on the "degree" field.
+func DegreeLTE(v int) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldLTE(FieldDegree, v))
+}
+
+// WeightedDegreeEQ applies the EQ predicate on the "weighted_degree" field.
+func WeightedDegreeEQ(v float64) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldEQ(FieldWeightedDegree, v))
+}
+
+// WeightedDegreeNEQ applies the NEQ predicate on the "weighted_degree" field.
+func WeightedDegreeNEQ(v float64) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldNEQ(FieldWeightedDegree, v))
+}
+
+// WeightedDegreeIn applies the In predicate on the "weighted_degree" field.
+func WeightedDegreeIn(vs ...float64) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldIn(FieldWeightedDegree, vs...))
+}
+
+// WeightedDegreeNotIn applies the NotIn predicate on the "weighted_degree" field.
+func WeightedDegreeNotIn(vs ...float64) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldNotIn(FieldWeightedDegree, vs...))
+}
+
+// WeightedDegreeGT applies the GT predicate on the "weighted_degree" field.
+func WeightedDegreeGT(v float64) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldGT(FieldWeightedDegree, v))
+}
+
+// WeightedDegreeGTE applies the GTE predicate on the "weighted_degree" field.
+func WeightedDegreeGTE(v float64) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldGTE(FieldWeightedDegree, v))
+}
+
+// WeightedDegreeLT applies the LT predicate on the "weighted_degree" field.
+func WeightedDegreeLT(v float64) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldLT(FieldWeightedDegree, v))
+}
+
+// WeightedDegreeLTE applies the LTE predicate on the "weighted_degree" field.
+func WeightedDegreeLTE(v float64) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldLTE(FieldWeightedDegree, v))
+}
+
+// PagerankEQ applies the EQ predicate on the "pagerank" field.
+func PagerankEQ(v float64) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldEQ(FieldPagerank, v))
+}
+
+// PagerankNEQ applies the NEQ predicate on the "pagerank" field.
+func PagerankNEQ(v float64) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldNEQ(FieldPagerank, v))
+}
+
+// PagerankIn applies the In predicate on the "pagerank" field.
+func PagerankIn(vs ...float64) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldIn(FieldPagerank, vs...))
+}
+
+// PagerankNotIn applies the NotIn predicate on the "pagerank" field.
+func PagerankNotIn(vs ...float64) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldNotIn(FieldPagerank, vs...))
+}
+
+// PagerankGT applies the GT predicate on the "pagerank" field.
+func PagerankGT(v float64) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldGT(FieldPagerank, v))
+}
+
+// PagerankGTE applies the GTE predicate on the "pagerank" field.
+func PagerankGTE(v float64) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldGTE(FieldPagerank, v))
+}
+
+// PagerankLT applies the LT predicate on the "pagerank" field.
+func PagerankLT(v float64) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldLT(FieldPagerank, v))
+}
+
+// PagerankLTE applies the LTE predicate on the "pagerank" field.
+func PagerankLTE(v float64) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldLTE(FieldPagerank, v))
+}
+
+// BetweennessEQ applies the EQ predicate on the "betweenness" field.
+func BetweennessEQ(v float64) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldEQ(FieldBetweenness, v))
+}
+
+// BetweennessNEQ applies the NEQ predicate on the "betweenness" field.
+func BetweennessNEQ(v float64) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldNEQ(FieldBetweenness, v))
+}
+
+// BetweennessIn applies the In predicate on the "betweenness" field.
+func BetweennessIn(vs ...float64) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldIn(FieldBetweenness, vs...))
+}
+
+// BetweennessNotIn applies the NotIn predicate on the "betweenness" field.
+func BetweennessNotIn(vs ...float64) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldNotIn(FieldBetweenness, vs...))
+}
+
+// BetweennessGT applies the GT predicate on the "betweenness" field.
+func BetweennessGT(v float64) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldGT(FieldBetweenness, v))
+}
+
+// BetweennessGTE applies the GTE predicate on the "betweenness" field.
+func BetweennessGTE(v float64) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldGTE(FieldBetweenness, v))
+}
+
+// BetweennessLT applies the LT predicate on the "betweenness" field.
+func BetweennessLT(v float64) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldLT(FieldBetweenness, v))
+}
+
+// BetweennessLTE applies the LTE predicate on the "betweenness" field.
+func BetweennessLTE(v float64) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldLTE(FieldBetweenness, v))
+}
+
+// ClosenessEQ applies the EQ predicate on the "closeness" field.
+func ClosenessEQ(v float64) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldEQ(FieldCloseness, v))
+}
+
+// ClosenessNEQ applies the NEQ predicate on the "closeness" field.
+func ClosenessNEQ(v float64) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldNEQ(FieldCloseness, v))
+}
+
+// ClosenessIn applies the In predicate on the "closeness" field.
+func ClosenessIn(vs ...float64) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldIn(FieldCloseness, vs...))
+}
+
+// ClosenessNotIn applies the NotIn predicate on the "closeness" field.
+func ClosenessNotIn(vs ...float64) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldNotIn(FieldCloseness, vs...))
+}
+
+// ClosenessGT applies the GT predicate on the "closeness" field.
+func ClosenessGT(v float64) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldGT(FieldCloseness, v))
+}
+
+// ClosenessGTE applies the GTE predicate on the "closeness" field.
+func ClosenessGTE(v float64) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldGTE(FieldCloseness, v))
+}
+
+// ClosenessLT applies the LT predicate on the "closeness" field.
+func ClosenessLT(v float64) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldLT(FieldCloseness, v))
+}
+
+// ClosenessLTE applies the LTE predicate on the "closeness" field.
+func ClosenessLTE(v float64) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldLTE(FieldCloseness, v))
+}
+
+// ComputedAtEQ applies the EQ predicate on the "computed_at" field.
+func ComputedAtEQ(v time.Time) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldEQ(FieldComputedAt, v))
+}
+
+// ComputedAtNEQ applies the NEQ predicate on the "computed_at" field.
+func ComputedAtNEQ(v time.Time) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldNEQ(FieldComputedAt, v))
+}
+
+// ComputedAtIn applies the In predicate on the "computed_at" field.
+func ComputedAtIn(vs ...time.Time) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldIn(FieldComputedAt, vs...))
+}
+
+// ComputedAtNotIn applies the NotIn predicate on the "computed_at" field.
+func ComputedAtNotIn(vs ...time.Time) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldNotIn(FieldComputedAt, vs...))
+}
+
+// ComputedAtGT applies the GT predicate on the "computed_at" field.
+func ComputedAtGT(v time.Time) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldGT(FieldComputedAt, v))
+}
+
+// ComputedAtGTE applies the GTE predicate on the "computed_at" field.
+func ComputedAtGTE(v time.Time) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldGTE(FieldComputedAt, v))
+}
+
+// ComputedAtLT applies the LT predicate on the "computed_at" field.
+func ComputedAtLT(v time.Time) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldLT(FieldComputedAt, v))
+}
+
+// ComputedAtLTE applies the LTE predicate on the "computed_at" field.
+func ComputedAtLTE(v time.Time) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.FieldLTE(FieldComputedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CentralityScore) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CentralityScore) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CentralityScore) predicate.CentralityScore {
+	return predicate.CentralityScore(sql.NotPredicates(p))
+}
@@ -0,0 +1,363 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Blogem/enron-graph/ent/centralityscore"
+)
+
+// CentralityScoreCreate is the builder for creating a CentralityScore entity.
+type CentralityScoreCreate struct {
+	config
+	mutation *CentralityScoreMutation
+	hooks    []Hook
+}
+
+// SetScope sets the "scope" field.
+func (_c *CentralityScoreCreate) SetScope(v string) *CentralityScoreCreate {
+	_c.mutation.SetScope(v)
+	return _c
+}
+
+// SetEntityID sets the "entity_id" field.
+func (_c *CentralityScoreCreate) SetEntityID(v int) *CentralityScoreCreate {
+	_c.mutation.SetEntityID(v)
+	return _c
+}
+
+// SetDegree sets the "degree" field.
+func (_c *CentralityScoreCreate) SetDegree(v int) *CentralityScoreCreate {
+	_c.mutation.SetDegree(v)
+	return _c
+}
+
+// SetNillableDegree sets the "degree" field if the given value is not nil.
+func (_c *CentralityScoreCreate) SetNillableDegree(v *int) *CentralityScoreCreate {
+	if v != nil {
+		_c.SetDegree(*v)
+	}
+	return _c
+}
+
+// SetWeightedDegree sets the "weighted_degree" field.
+func (_c *CentralityScoreCreate) SetWeightedDegree(v float64) *CentralityScoreCreate {
+	_c.mutation.SetWeightedDegree(v)
+	return _c
+}
+
+// SetNillableWeightedDegree sets the "weighted_degree" field if the given value is not nil.
+func (_c *CentralityScoreCreate) SetNillableWeightedDegree(v *float64) *CentralityScoreCreate {
+	if v != nil {
+		_c.SetWeightedDegree(*v)
+	}
+	return _c
+}
+
+// SetPagerank sets the "pagerank" field.
+func (_c *CentralityScoreCreate) SetPagerank(v float64) *CentralityScoreCreate {
+	_c.mutation.SetPagerank(v)
+	return _c
+}
+
+// SetNillablePagerank sets the "pagerank" field if the given value is not nil.
+func (_c *CentralityScoreCreate) SetNillablePagerank(v *float64) *CentralityScoreCreate {
+	if v != nil {
+		_c.SetPagerank(*v)
+	}
+	return _c
+}
+
+// SetBetweenness sets the "betweenness" field.
+func (_c *CentralityScoreCreate) SetBetweenness(v float64) *CentralityScoreCreate {
+	_c.mutation.SetBetweenness(v)
+	return _c
+}
+
+// SetNillableBetweenness sets the "betweenness" field if the given value is not nil.
+func (_c *CentralityScoreCreate) SetNillableBetweenness(v *float64) *CentralityScoreCreate {
+	if v != nil {
+		_c.SetBetweenness(*v)
+	}
+	return _c
+}
+
+// SetCloseness sets the "closeness" field.
+func (_c *CentralityScoreCreate) SetCloseness(v float64) *CentralityScoreCreate {
+	_c.mutation.SetCloseness(v)
+	return _c
+}
+
+// SetNillableCloseness sets the "closeness" field if the given value is not nil.
+func (_c *CentralityScoreCreate) SetNillableCloseness(v *float64) *CentralityScoreCreate {
+	if v != nil {
+		_c.SetCloseness(*v)
+	}
+	return _c
+}
+
+// SetComputedAt sets the "computed_at" field.
+func (_c *CentralityScoreCreate) SetComputedAt(v time.Time) *CentralityScoreCreate {
+	_c.mutation.SetComputedAt(v)
+	return _c
+}
+
+// SetNillableComputedAt sets the "computed_at" field if the given value is not nil.
+func (_c *CentralityScoreCreate) SetNillableComputedAt(v *time.Time) *CentralityScoreCreate {
+	if v != nil {
+		_c.SetComputedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the CentralityScoreMutation object of the builder.
+func (_c *CentralityScoreCreate) Mutation() *CentralityScoreMutation {
+	return _c.mutation
+}
+
+// Save creates the CentralityScore in the database.
+func (_c *CentralityScoreCreate) Save(ctx context.Context) (*CentralityScore, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CentralityScoreCreate) SaveX(ctx context.Context) *CentralityScore {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CentralityScoreCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CentralityScoreCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *CentralityScoreCreate) defaults() {
+	if _, ok := _c.mutation.Degree(); !ok {
+		v := centralityscore.DefaultDegree
+		_c.mutation.SetDegree(v)
+	}
+	if _, ok := _c.mutation.WeightedDegree(); !ok {
+		v := centralityscore.DefaultWeightedDegree
+		_c.mutation.SetWeightedDegree(v)
+	}
+	if _, ok := _c.mutation.Pagerank(); !ok {
+		v := centralityscore.DefaultPagerank
+		_c.mutation.SetPagerank(v)
+	}
+	if _, ok := _c.mutation.Betweenness(); !ok {
+		v := centralityscore.DefaultBetweenness
+		_c.mutation.SetBetweenness(v)
+	}
+	if _, ok := _c.mutation.Closeness(); !ok {
+		v := centralityscore.DefaultCloseness
+		_c.mutation.SetCloseness(v)
+	}
+	if _, ok := _c.mutation.ComputedAt(); !ok {
+		v := centralityscore.DefaultComputedAt()
+		_c.mutation.SetComputedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CentralityScoreCreate) check() error {
+	if _, ok := _c.mutation.Scope(); !ok {
+		return &ValidationError{Name: "scope", err: errors.New(`ent: missing required field "CentralityScore.scope"`)}
+	}
+	if v, ok := _c.mutation.Scope(); ok {
+		if err := centralityscore.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf(`ent: validator failed for field "CentralityScore.scope": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.EntityID(); !ok {
+		return &ValidationError{Name: "entity_id", err: errors.New(`ent: missing required field "CentralityScore.entity_id"`)}
+	}
+	if v, ok := _c.mutation.EntityID(); ok {
+		if err := centralityscore.EntityIDValidator(v); err != nil {
+			return &ValidationError{Name: "entity_id", err: fmt.Errorf(`ent: validator failed for field "CentralityScore.entity_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Degree(); !ok {
+		return &ValidationError{Name: "degree", err: errors.New(`ent: missing required field "CentralityScore.degree"`)}
+	}
+	if _, ok := _c.mutation.WeightedDegree(); !ok {
+		return &ValidationError{Name: "weighted_degree", err: errors.New(`ent: missing required field "CentralityScore.weighted_degree"`)}
+	}
+	if _, ok := _c.mutation.Pagerank(); !ok {
+		return &ValidationError{Name: "pagerank", err: errors.New(`ent: missing required field "CentralityScore.pagerank"`)}
+	}
+	if _, ok := _c.mutation.Betweenness(); !ok {
+		return &ValidationError{Name: "betweenness", err: errors.New(`ent: missing required field "CentralityScore.betweenness"`)}
+	}
+	if _, ok := _c.mutation.Closeness(); !ok {
+		return &ValidationError{Name: "closeness", err: errors.New(`ent: missing required field "CentralityScore.closeness"`)}
+	}
+	if _, ok := _c.mutation.ComputedAt(); !ok {
+		return &ValidationError{Name: "computed_at", err: errors.New(`ent: missing required field "CentralityScore.computed_at"`)}
+	}
+	return nil
+}
+
+func (_c *CentralityScoreCreate) sqlSave(ctx context.Context) (*CentralityScore, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CentralityScoreCreate) createSpec() (*CentralityScore, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CentralityScore{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(centralityscore.Table, sqlgraph.NewFieldSpec(centralityscore.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Scope(); ok {
+		_spec.SetField(centralityscore.FieldScope, field.TypeString, value)
+		_node.Scope = value
+	}
+	if value, ok := _c.mutation.EntityID(); ok {
+		_spec.SetField(centralityscore.FieldEntityID, field.TypeInt, value)
+		_node.EntityID = value
+	}
+	if value, ok := _c.mutation.Degree(); ok {
+		_spec.SetField(centralityscore.FieldDegree, field.TypeInt, value)
+		_node.Degree = value
+	}
+	if value, ok := _c.mutation.WeightedDegree(); ok {
+		_spec.SetField(centralityscore.FieldWeightedDegree, field.TypeFloat64, value)
+		_node.WeightedDegree = value
+	}
+	if value, ok := _c.mutation.Pagerank(); ok {
+		_spec.SetField(centralityscore.FieldPagerank, field.TypeFloat64, value)
+		_node.Pagerank = value
+	}
+	if value, ok := _c.mutation.Betweenness(); ok {
+		_spec.SetField(centralityscore.FieldBetweenness, field.TypeFloat64, value)
+		_node.Betweenness = value
+	}
+	if value, ok := _c.mutation.Closeness(); ok {
+		_spec.SetField(centralityscore.FieldCloseness, field.TypeFloat64, value)
+		_node.Closeness = value
+	}
+	if value, ok := _c.mutation.ComputedAt(); ok {
+		_spec.SetField(centralityscore.FieldComputedAt, field.TypeTime, value)
+		_node.ComputedAt = value
+	}
+	return _node, _spec
+}
+
+// CentralityScoreCreateBulk is the builder for creating many CentralityScore entities in bulk.
+type CentralityScoreCreateBulk struct {
+	config
+	err      error
+	builders []*CentralityScoreCreate
+}
+
+// Save creates the CentralityScore entities in the database.
+func (_c *CentralityScoreCreateBulk) Save(ctx context.Context) ([]*CentralityScore, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*CentralityScore, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CentralityScoreMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CentralityScoreCreateBulk) SaveX(ctx context.Context) []*CentralityScore {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CentralityScoreCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CentralityScoreCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
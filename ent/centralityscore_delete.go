@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Blogem/enron-graph/ent/centralityscore"
+	"github.com/Blogem/enron-graph/ent/predicate"
+)
+
+// CentralityScoreDelete is the builder for deleting a CentralityScore entity.
+type CentralityScoreDelete struct {
+	config
+	hooks    []Hook
+	mutation *CentralityScoreMutation
+}
+
+// Where appends a list predicates to the CentralityScoreDelete builder.
+func (_d *CentralityScoreDelete) Where(ps ...predicate.CentralityScore) *CentralityScoreDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *CentralityScoreDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *CentralityScoreDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *CentralityScoreDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(centralityscore.Table, sqlgraph.NewFieldSpec(centralityscore.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// CentralityScoreDeleteOne is the builder for deleting a single CentralityScore entity.
+type CentralityScoreDeleteOne struct {
+	_d *CentralityScoreDelete
+}
+
+// Where appends a list predicates to the CentralityScoreDelete builder.
+func (_d *CentralityScoreDeleteOne) Where(ps ...predicate.CentralityScore) *CentralityScoreDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *CentralityScoreDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{centralityscore.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *CentralityScoreDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,604 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Blogem/enron-graph/ent/centralityscore"
+	"github.com/Blogem/enron-graph/ent/predicate"
+)
+
+// CentralityScoreUpdate is the builder for updating CentralityScore entities.
+type CentralityScoreUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CentralityScoreMutation
+}
+
+// Where appends a list predicates to the CentralityScoreUpdate builder.
+func (_u *CentralityScoreUpdate) Where(ps ...predicate.CentralityScore) *CentralityScoreUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetScope sets the "scope" field.
+func (_u *CentralityScoreUpdate) SetScope(v string) *CentralityScoreUpdate {
+	_u.mutation.SetScope(v)
+	return _u
+}
+
+// SetNillableScope sets the "scope" field if the given value is not nil.
+func (_u *CentralityScoreUpdate) SetNillableScope(v *string) *CentralityScoreUpdate {
+	if v != nil {
+		_u.SetScope(*v)
+	}
+	return _u
+}
+
+// SetEntityID sets the "entity_id" field.
+func (_u *CentralityScoreUpdate) SetEntityID(v int) *CentralityScoreUpdate {
+	_u.mutation.ResetEntityID()
+	_u.mutation.SetEntityID(v)
+	return _u
+}
+
+// SetNillableEntityID sets the "entity_id" field if the given value is not nil.
+func (_u *CentralityScoreUpdate) SetNillableEntityID(v *int) *CentralityScoreUpdate {
+	if v != nil {
+		_u.SetEntityID(*v)
+	}
+	return _u
+}
+
+// AddEntityID adds value to the "entity_id" field.
+func (_u *CentralityScoreUpdate) AddEntityID(v int) *CentralityScoreUpdate {
+	_u.mutation.AddEntityID(v)
+	return _u
+}
+
+// SetDegree sets the "degree" field.
+func (_u *CentralityScoreUpdate) SetDegree(v int) *CentralityScoreUpdate {
+	_u.mutation.ResetDegree()
+	_u.mutation.SetDegree(v)
+	return _u
+}
+
+// SetNillableDegree sets the "degree" field if the given value is not nil.
+func (_u *CentralityScoreUpdate) SetNillableDegree(v *int) *CentralityScoreUpdate {
+	if v != nil {
+		_u.SetDegree(*v)
+	}
+	return _u
+}
+
+// AddDegree adds value to the "degree" field.
+func (_u *CentralityScoreUpdate) AddDegree(v int) *CentralityScoreUpdate {
+	_u.mutation.AddDegree(v)
+	return _u
+}
+
+// SetWeightedDegree sets the "weighted_degree" field.
+func (_u *CentralityScoreUpdate) SetWeightedDegree(v float64) *CentralityScoreUpdate {
+	_u.mutation.ResetWeightedDegree()
+	_u.mutation.SetWeightedDegree(v)
+	return _u
+}
+
+// SetNillableWeightedDegree sets the "weighted_degree" field if the given value is not nil.
+func (_u *CentralityScoreUpdate) SetNillableWeightedDegree(v *float64) *CentralityScoreUpdate {
+	if v != nil {
+		_u.SetWeightedDegree(*v)
+	}
+	return _u
+}
+
+// AddWeightedDegree adds value to the "weighted_degree" field.
+func (_u *CentralityScoreUpdate) AddWeightedDegree(v float64) *CentralityScoreUpdate {
+	_u.mutation.AddWeightedDegree(v)
+	return _u
+}
+
+// SetPagerank sets the "pagerank" field.
+func (_u *CentralityScoreUpdate) SetPagerank(v float64) *CentralityScoreUpdate {
+	_u.mutation.ResetPagerank()
+	_u.mutation.SetPagerank(v)
+	return _u
+}
+
+// SetNillablePagerank sets the "pagerank" field if the given value is not nil.
+func (_u *CentralityScoreUpdate) SetNillablePagerank(v *float64) *CentralityScoreUpdate {
+	if v != nil {
+		_u.SetPagerank(*v)
+	}
+	return _u
+}
+
+// AddPagerank adds value to the "pagerank" field.
+func (_u *CentralityScoreUpdate) AddPagerank(v float64) *CentralityScoreUpdate {
+	_u.mutation.AddPagerank(v)
+	return _u
+}
+
+// SetBetweenness sets the "betweenness" field.
+func (_u *CentralityScoreUpdate) SetBetweenness(v float64) *CentralityScoreUpdate {
+	_u.mutation.ResetBetweenness()
+	_u.mutation.SetBetweenness(v)
+	return _u
+}
+
+// SetNillableBetweenness sets the "betweenness" field if the given value is not nil.
+func (_u *CentralityScoreUpdate) SetNillableBetweenness(v *float64) *CentralityScoreUpdate {
+	if v != nil {
+		_u.SetBetweenness(*v)
+	}
+	return _u
+}
+
+// AddBetweenness adds value to the "betweenness" field.
+func (_u *CentralityScoreUpdate) AddBetweenness(v float64) *CentralityScoreUpdate {
+	_u.mutation.AddBetweenness(v)
+	return _u
+}
+
+// SetCloseness sets the "closeness" field.
+func (_u *CentralityScoreUpdate) SetCloseness(v float64) *CentralityScoreUpdate {
+	_u.mutation.ResetCloseness()
+	_u.mutation.SetCloseness(v)
+	return _u
+}
+
+// SetNillableCloseness sets the "closeness" field if the given value is not nil.
+func (_u *CentralityScoreUpdate) SetNillableCloseness(v *float64) *CentralityScoreUpdate {
+	if v != nil {
+		_u.SetCloseness(*v)
+	}
+	return _u
+}
+
+// AddCloseness adds value to the "closeness" field.
+func (_u *CentralityScoreUpdate) AddCloseness(v float64) *CentralityScoreUpdate {
+	_u.mutation.AddCloseness(v)
+	return _u
+}
+
+// SetComputedAt sets the "computed_at" field.
+func (_u *CentralityScoreUpdate) SetComputedAt(v time.Time) *CentralityScoreUpdate {
+	_u.mutation.SetComputedAt(v)
+	return _u
+}
+
+// SetNillableComputedAt sets the "computed_at" field if the given value is not nil.
+func (_u *CentralityScoreUpdate) SetNillableComputedAt(v *time.Time) *CentralityScoreUpdate {
+	if v != nil {
+		_u.SetComputedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the CentralityScoreMutation object of the builder.
+func (_u *CentralityScoreUpdate) Mutation() *CentralityScoreMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CentralityScoreUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CentralityScoreUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CentralityScoreUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CentralityScoreUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CentralityScoreUpdate) check() error {
+	if v, ok := _u.mutation.Scope(); ok {
+		if err := centralityscore.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf(`ent: validator failed for field "CentralityScore.scope": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EntityID(); ok {
+		if err := centralityscore.EntityIDValidator(v); err != nil {
+			return &ValidationError{Name: "entity_id", err: fmt.Errorf(`ent: validator failed for field "CentralityScore.entity_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *CentralityScoreUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(centralityscore.Table, centralityscore.Columns, sqlgraph.NewFieldSpec(centralityscore.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Scope(); ok {
+		_spec.SetField(centralityscore.FieldScope, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EntityID(); ok {
+		_spec.SetField(centralityscore.FieldEntityID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEntityID(); ok {
+		_spec.AddField(centralityscore.FieldEntityID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Degree(); ok {
+		_spec.SetField(centralityscore.FieldDegree, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDegree(); ok {
+		_spec.AddField(centralityscore.FieldDegree, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.WeightedDegree(); ok {
+		_spec.SetField(centralityscore.FieldWeightedDegree, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedWeightedDegree(); ok {
+		_spec.AddField(centralityscore.FieldWeightedDegree, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Pagerank(); ok {
+		_spec.SetField(centralityscore.FieldPagerank, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedPagerank(); ok {
+		_spec.AddField(centralityscore.FieldPagerank, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Betweenness(); ok {
+		_spec.SetField(centralityscore.FieldBetweenness, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedBetweenness(); ok {
+		_spec.AddField(centralityscore.FieldBetweenness, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Closeness(); ok {
+		_spec.SetField(centralityscore.FieldCloseness, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCloseness(); ok {
+		_spec.AddField(centralityscore.FieldCloseness, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.ComputedAt(); ok {
+		_spec.SetField(centralityscore.FieldComputedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{centralityscore.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CentralityScoreUpdateOne is the builder for updating a single CentralityScore entity.
+type CentralityScoreUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CentralityScoreMutation
+}
+
+// SetScope sets the "scope" field.
+func (_u *CentralityScoreUpdateOne) SetScope(v string) *CentralityScoreUpdateOne {
+	_u.mutation.SetScope(v)
+	return _u
+}
+
+// SetNillableScope sets the "scope" field if the given value is not nil.
+func (_u *CentralityScoreUpdateOne) SetNillableScope(v *string) *CentralityScoreUpdateOne {
+	if v != nil {
+		_u.SetScope(*v)
+	}
+	return _u
+}
+
+// SetEntityID sets the "entity_id" field.
+func (_u *CentralityScoreUpdateOne) SetEntityID(v int) *CentralityScoreUpdateOne {
+	_u.mutation.ResetEntityID()
+	_u.mutation.SetEntityID(v)
+	return _u
+}
+
+// SetNillableEntityID sets the "entity_id" field if the given value is not nil.
+func (_u *CentralityScoreUpdateOne) SetNillableEntityID(v *int) *CentralityScoreUpdateOne {
+	if v != nil {
+		_u.SetEntityID(*v)
+	}
+	return _u
+}
+
+// AddEntityID adds value to the "entity_id" field.
+func (_u *CentralityScoreUpdateOne) AddEntityID(v int) *CentralityScoreUpdateOne {
+	_u.mutation.AddEntityID(v)
+	return _u
+}
+
+// SetDegree sets the "degree" field.
+func (_u *CentralityScoreUpdateOne) SetDegree(v int) *CentralityScoreUpdateOne {
+	_u.mutation.ResetDegree()
+	_u.mutation.SetDegree(v)
+	return _u
+}
+
+// SetNillableDegree sets the "degree" field if the given value is not nil.
+func (_u *CentralityScoreUpdateOne) SetNillableDegree(v *int) *CentralityScoreUpdateOne {
+	if v != nil {
+		_u.SetDegree(*v)
+	}
+	return _u
+}
+
+// AddDegree adds value to the "degree" field.
+func (_u *CentralityScoreUpdateOne) AddDegree(v int) *CentralityScoreUpdateOne {
+	_u.mutation.AddDegree(v)
+	return _u
+}
+
+// SetWeightedDegree sets the "weighted_degree" field.
+func (_u *CentralityScoreUpdateOne) SetWeightedDegree(v float64) *CentralityScoreUpdateOne {
+	_u.mutation.ResetWeightedDegree()
+	_u.mutation.SetWeightedDegree(v)
+	return _u
+}
+
+// SetNillableWeightedDegree sets the "weighted_degree" field if the given value is not nil.
+func (_u *CentralityScoreUpdateOne) SetNillableWeightedDegree(v *float64) *CentralityScoreUpdateOne {
+	if v != nil {
+		_u.SetWeightedDegree(*v)
+	}
+	return _u
+}
+
+// AddWeightedDegree adds value to the "weighted_degree" field.
+func (_u *CentralityScoreUpdateOne) AddWeightedDegree(v float64) *CentralityScoreUpdateOne {
+	_u.mutation.AddWeightedDegree(v)
+	return _u
+}
+
+// SetPagerank sets the "pagerank" field.
+func (_u *CentralityScoreUpdateOne) SetPagerank(v float64) *CentralityScoreUpdateOne {
+	_u.mutation.ResetPagerank()
+	_u.mutation.SetPagerank(v)
+	return _u
+}
+
+// SetNillablePagerank sets the "pagerank" field if the given value is not nil.
+func (_u *CentralityScoreUpdateOne) SetNillablePagerank(v *float64) *CentralityScoreUpdateOne {
+	if v != nil {
+		_u.SetPagerank(*v)
+	}
+	return _u
+}
+
+// AddPagerank adds value to the "pagerank" field.
+func (_u *CentralityScoreUpdateOne) AddPagerank(v float64) *CentralityScoreUpdateOne {
+	_u.mutation.AddPagerank(v)
+	return _u
+}
+
+// SetBetweenness sets the "betweenness" field.
+func (_u *CentralityScoreUpdateOne) SetBetweenness(v float64) *CentralityScoreUpdateOne {
+	_u.mutation.ResetBetweenness()
+	_u.mutation.SetBetweenness(v)
+	return _u
+}
+
+// SetNillableBetweenness sets the "betweenness" field if the given value is not nil.
+func (_u *CentralityScoreUpdateOne) SetNillableBetweenness(v *float64) *CentralityScoreUpdateOne {
+	if v != nil {
+		_u.SetBetweenness(*v)
+	}
+	return _u
+}
+
+// AddBetweenness adds value to the "betweenness" field.
+func (_u *CentralityScoreUpdateOne) AddBetweenness(v float64) *CentralityScoreUpdateOne {
+	_u.mutation.AddBetweenness(v)
+	return _u
+}
+
+// SetCloseness sets the "closeness" field.
+func (_u *CentralityScoreUpdateOne) SetCloseness(v float64) *CentralityScoreUpdateOne {
+	_u.mutation.ResetCloseness()
+	_u.mutation.SetCloseness(v)
+	return _u
+}
+
+// SetNillableCloseness sets the "closeness" field if the given value is not nil.
+func (_u *CentralityScoreUpdateOne) SetNillableCloseness(v *float64) *CentralityScoreUpdateOne {
+	if v != nil {
+		_u.SetCloseness(*v)
+	}
+	return _u
+}
+
+// AddCloseness adds value to the "closeness" field.
+func (_u *CentralityScoreUpdateOne) AddCloseness(v float64) *CentralityScoreUpdateOne {
+	_u.mutation.AddCloseness(v)
+	return _u
+}
+
+// SetComputedAt sets the "computed_at" field.
+func (_u *CentralityScoreUpdateOne) SetComputedAt(v time.Time) *CentralityScoreUpdateOne {
+	_u.mutation.SetComputedAt(v)
+	return _u
+}
+
+// SetNillableComputedAt sets the "computed_at" field if the given value is not nil.
+func (_u *CentralityScoreUpdateOne) SetNillableComputedAt(v *time.Time) *CentralityScoreUpdateOne {
+	if v != nil {
+		_u.SetComputedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the CentralityScoreMutation object of the builder.
+func (_u *CentralityScoreUpdateOne) Mutation() *CentralityScoreMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the CentralityScoreUpdate builder.
+func (_u *CentralityScoreUpdateOne) Where(ps ...predicate.CentralityScore) *CentralityScoreUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CentralityScoreUpdateOne) Select(field string, fields ...string) *CentralityScoreUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated CentralityScore entity.
+func (_u *CentralityScoreUpdateOne) Save(ctx context.Context) (*CentralityScore, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CentralityScoreUpdateOne) SaveX(ctx context.Context) *CentralityScore {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CentralityScoreUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CentralityScoreUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CentralityScoreUpdateOne) check() error {
+	if v, ok := _u.mutation.Scope(); ok {
+		if err := centralityscore.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf(`ent: validator failed for field "CentralityScore.scope": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EntityID(); ok {
+		if err := centralityscore.EntityIDValidator(v); err != nil {
+			return &ValidationError{Name: "entity_id", err: fmt.Errorf(`ent: validator failed for field "CentralityScore.entity_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *CentralityScoreUpdateOne) sqlSave(ctx context.Context) (_node *CentralityScore, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(centralityscore.Table, centralityscore.Columns, sqlgraph.NewFieldSpec(centralityscore.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CentralityScore.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, centralityscore.FieldID)
+		for _, f := range fields {
+			if !centralityscore.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != centralityscore.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Scope(); ok {
+		_spec.SetField(centralityscore.FieldScope, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EntityID(); ok {
+		_spec.SetField(centralityscore.FieldEntityID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEntityID(); ok {
+		_spec.AddField(centralityscore.FieldEntityID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Degree(); ok {
+		_spec.SetField(centralityscore.FieldDegree, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDegree(); ok {
+		_spec.AddField(centralityscore.FieldDegree, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.WeightedDegree(); ok {
+		_spec.SetField(centralityscore.FieldWeightedDegree, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedWeightedDegree(); ok {
+		_spec.AddField(centralityscore.FieldWeightedDegree, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Pagerank(); ok {
+		_spec.SetField(centralityscore.FieldPagerank, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedPagerank(); ok {
+		_spec.AddField(centralityscore.FieldPagerank, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Betweenness(); ok {
+		_spec.SetField(centralityscore.FieldBetweenness, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedBetweenness(); ok {
+		_spec.AddField(centralityscore.FieldBetweenness, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Closeness(); ok {
+		_spec.SetField(centralityscore.FieldCloseness, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCloseness(); ok {
+		_spec.AddField(centralityscore.FieldCloseness, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.ComputedAt(); ok {
+		_spec.SetField(centralityscore.FieldComputedAt, field.TypeTime, value)
+	}
+	_node = &CentralityScore{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{centralityscore.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -14,10 +14,17 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
+	"github.com/Blogem/enron-graph/ent/centralityscore"
+	"github.com/Blogem/enron-graph/ent/community"
 	"github.com/Blogem/enron-graph/ent/discoveredentity"
 	"github.com/Blogem/enron-graph/ent/email"
+	"github.com/Blogem/enron-graph/ent/entityalias"
+	"github.com/Blogem/enron-graph/ent/entityaudit"
+	"github.com/Blogem/enron-graph/ent/extractionjob"
+	"github.com/Blogem/enron-graph/ent/provenance"
 	"github.com/Blogem/enron-graph/ent/relationship"
 	"github.com/Blogem/enron-graph/ent/schemapromotion"
+	"github.com/Blogem/enron-graph/ent/thread"
 )
 
 // Client is the client that holds all ent builders.
@@ -25,14 +32,28 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// CentralityScore is the client for interacting with the CentralityScore builders.
+	CentralityScore *CentralityScoreClient
+	// Community is the client for interacting with the Community builders.
+	Community *CommunityClient
 	// DiscoveredEntity is the client for interacting with the DiscoveredEntity builders.
 	DiscoveredEntity *DiscoveredEntityClient
 	// Email is the client for interacting with the Email builders.
 	Email *EmailClient
+	// EntityAlias is the client for interacting with the EntityAlias builders.
+	EntityAlias *EntityAliasClient
+	// EntityAudit is the client for interacting with the EntityAudit builders.
+	EntityAudit *EntityAuditClient
+	// ExtractionJob is the client for interacting with the ExtractionJob builders.
+	ExtractionJob *ExtractionJobClient
+	// Provenance is the client for interacting with the Provenance builders.
+	Provenance *ProvenanceClient
 	// Relationship is the client for interacting with the Relationship builders.
 	Relationship *RelationshipClient
 	// SchemaPromotion is the client for interacting with the SchemaPromotion builders.
 	SchemaPromotion *SchemaPromotionClient
+	// Thread is the client for interacting with the Thread builders.
+	Thread *ThreadClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -44,10 +65,17 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.CentralityScore = NewCentralityScoreClient(c.config)
+	c.Community = NewCommunityClient(c.config)
 	c.DiscoveredEntity = NewDiscoveredEntityClient(c.config)
 	c.Email = NewEmailClient(c.config)
+	c.EntityAlias = NewEntityAliasClient(c.config)
+	c.EntityAudit = NewEntityAuditClient(c.config)
+	c.ExtractionJob = NewExtractionJobClient(c.config)
+	c.Provenance = NewProvenanceClient(c.config)
 	c.Relationship = NewRelationshipClient(c.config)
 	c.SchemaPromotion = NewSchemaPromotionClient(c.config)
+	c.Thread = NewThreadClient(c.config)
 }
 
 type (
@@ -140,10 +168,17 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	return &Tx{
 		ctx:              ctx,
 		config:           cfg,
+		CentralityScore:  NewCentralityScoreClient(cfg),
+		Community:        NewCommunityClient(cfg),
 		DiscoveredEntity: NewDiscoveredEntityClient(cfg),
 		Email:            NewEmailClient(cfg),
+		EntityAlias:      NewEntityAliasClient(cfg),
+		EntityAudit:      NewEntityAuditClient(cfg),
+		ExtractionJob:    NewExtractionJobClient(cfg),
+		Provenance:       NewProvenanceClient(cfg),
 		Relationship:     NewRelationshipClient(cfg),
 		SchemaPromotion:  NewSchemaPromotionClient(cfg),
+		Thread:           NewThreadClient(cfg),
 	}, nil
 }
 
@@ -163,17 +198,24 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	return &Tx{
 		ctx:              ctx,
 		config:           cfg,
+		CentralityScore:  NewCentralityScoreClient(cfg),
+		Community:        NewCommunityClient(cfg),
 		DiscoveredEntity: NewDiscoveredEntityClient(cfg),
 		Email:            NewEmailClient(cfg),
+		EntityAlias:      NewEntityAliasClient(cfg),
+		EntityAudit:      NewEntityAuditClient(cfg),
+		ExtractionJob:    NewExtractionJobClient(cfg),
+		Provenance:       NewProvenanceClient(cfg),
 		Relationship:     NewRelationshipClient(cfg),
 		SchemaPromotion:  NewSchemaPromotionClient(cfg),
+		Thread:           NewThreadClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		DiscoveredEntity.
+//		CentralityScore.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -195,138 +237,956 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.DiscoveredEntity.Use(hooks...)
-	c.Email.Use(hooks...)
-	c.Relationship.Use(hooks...)
-	c.SchemaPromotion.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.CentralityScore, c.Community, c.DiscoveredEntity, c.Email, c.EntityAlias,
+		c.EntityAudit, c.ExtractionJob, c.Provenance, c.Relationship,
+		c.SchemaPromotion, c.Thread,
+	} {
+		n.Use(hooks...)
+	}
+}
+
+// Intercept adds the query interceptors to all the entity clients.
+// In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
+func (c *Client) Intercept(interceptors ...Interceptor) {
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.CentralityScore, c.Community, c.DiscoveredEntity, c.Email, c.EntityAlias,
+		c.EntityAudit, c.ExtractionJob, c.Provenance, c.Relationship,
+		c.SchemaPromotion, c.Thread,
+	} {
+		n.Intercept(interceptors...)
+	}
+}
+
+// Mutate implements the ent.Mutator interface.
+func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
+	switch m := m.(type) {
+	case *CentralityScoreMutation:
+		return c.CentralityScore.mutate(ctx, m)
+	case *CommunityMutation:
+		return c.Community.mutate(ctx, m)
+	case *DiscoveredEntityMutation:
+		return c.DiscoveredEntity.mutate(ctx, m)
+	case *EmailMutation:
+		return c.Email.mutate(ctx, m)
+	case *EntityAliasMutation:
+		return c.EntityAlias.mutate(ctx, m)
+	case *EntityAuditMutation:
+		return c.EntityAudit.mutate(ctx, m)
+	case *ExtractionJobMutation:
+		return c.ExtractionJob.mutate(ctx, m)
+	case *ProvenanceMutation:
+		return c.Provenance.mutate(ctx, m)
+	case *RelationshipMutation:
+		return c.Relationship.mutate(ctx, m)
+	case *SchemaPromotionMutation:
+		return c.SchemaPromotion.mutate(ctx, m)
+	case *ThreadMutation:
+		return c.Thread.mutate(ctx, m)
+	default:
+		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
+	}
+}
+
+// CentralityScoreClient is a client for the CentralityScore schema.
+type CentralityScoreClient struct {
+	config
+}
+
+// NewCentralityScoreClient returns a client for the CentralityScore from the given config.
+func NewCentralityScoreClient(c config) *CentralityScoreClient {
+	return &CentralityScoreClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `centralityscore.Hooks(f(g(h())))`.
+func (c *CentralityScoreClient) Use(hooks ...Hook) {
+	c.hooks.CentralityScore = append(c.hooks.CentralityScore, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `centralityscore.Intercept(f(g(h())))`.
+func (c *CentralityScoreClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CentralityScore = append(c.inters.CentralityScore, interceptors...)
+}
+
+// Create returns a builder for creating a CentralityScore entity.
+func (c *CentralityScoreClient) Create() *CentralityScoreCreate {
+	mutation := newCentralityScoreMutation(c.config, OpCreate)
+	return &CentralityScoreCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CentralityScore entities.
+func (c *CentralityScoreClient) CreateBulk(builders ...*CentralityScoreCreate) *CentralityScoreCreateBulk {
+	return &CentralityScoreCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CentralityScoreClient) MapCreateBulk(slice any, setFunc func(*CentralityScoreCreate, int)) *CentralityScoreCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CentralityScoreCreateBulk{err: fmt.Errorf("calling to CentralityScoreClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CentralityScoreCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CentralityScoreCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CentralityScore.
+func (c *CentralityScoreClient) Update() *CentralityScoreUpdate {
+	mutation := newCentralityScoreMutation(c.config, OpUpdate)
+	return &CentralityScoreUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CentralityScoreClient) UpdateOne(_m *CentralityScore) *CentralityScoreUpdateOne {
+	mutation := newCentralityScoreMutation(c.config, OpUpdateOne, withCentralityScore(_m))
+	return &CentralityScoreUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CentralityScoreClient) UpdateOneID(id int) *CentralityScoreUpdateOne {
+	mutation := newCentralityScoreMutation(c.config, OpUpdateOne, withCentralityScoreID(id))
+	return &CentralityScoreUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CentralityScore.
+func (c *CentralityScoreClient) Delete() *CentralityScoreDelete {
+	mutation := newCentralityScoreMutation(c.config, OpDelete)
+	return &CentralityScoreDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CentralityScoreClient) DeleteOne(_m *CentralityScore) *CentralityScoreDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CentralityScoreClient) DeleteOneID(id int) *CentralityScoreDeleteOne {
+	builder := c.Delete().Where(centralityscore.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CentralityScoreDeleteOne{builder}
+}
+
+// Query returns a query builder for CentralityScore.
+func (c *CentralityScoreClient) Query() *CentralityScoreQuery {
+	return &CentralityScoreQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCentralityScore},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CentralityScore entity by its id.
+func (c *CentralityScoreClient) Get(ctx context.Context, id int) (*CentralityScore, error) {
+	return c.Query().Where(centralityscore.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CentralityScoreClient) GetX(ctx context.Context, id int) *CentralityScore {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *CentralityScoreClient) Hooks() []Hook {
+	return c.hooks.CentralityScore
+}
+
+// Interceptors returns the client interceptors.
+func (c *CentralityScoreClient) Interceptors() []Interceptor {
+	return c.inters.CentralityScore
+}
+
+func (c *CentralityScoreClient) mutate(ctx context.Context, m *CentralityScoreMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CentralityScoreCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CentralityScoreUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CentralityScoreUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CentralityScoreDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CentralityScore mutation op: %q", m.Op())
+	}
+}
+
+// CommunityClient is a client for the Community schema.
+type CommunityClient struct {
+	config
+}
+
+// NewCommunityClient returns a client for the Community from the given config.
+func NewCommunityClient(c config) *CommunityClient {
+	return &CommunityClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `community.Hooks(f(g(h())))`.
+func (c *CommunityClient) Use(hooks ...Hook) {
+	c.hooks.Community = append(c.hooks.Community, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `community.Intercept(f(g(h())))`.
+func (c *CommunityClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Community = append(c.inters.Community, interceptors...)
+}
+
+// Create returns a builder for creating a Community entity.
+func (c *CommunityClient) Create() *CommunityCreate {
+	mutation := newCommunityMutation(c.config, OpCreate)
+	return &CommunityCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Community entities.
+func (c *CommunityClient) CreateBulk(builders ...*CommunityCreate) *CommunityCreateBulk {
+	return &CommunityCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CommunityClient) MapCreateBulk(slice any, setFunc func(*CommunityCreate, int)) *CommunityCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CommunityCreateBulk{err: fmt.Errorf("calling to CommunityClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CommunityCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CommunityCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Community.
+func (c *CommunityClient) Update() *CommunityUpdate {
+	mutation := newCommunityMutation(c.config, OpUpdate)
+	return &CommunityUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CommunityClient) UpdateOne(_m *Community) *CommunityUpdateOne {
+	mutation := newCommunityMutation(c.config, OpUpdateOne, withCommunity(_m))
+	return &CommunityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CommunityClient) UpdateOneID(id int) *CommunityUpdateOne {
+	mutation := newCommunityMutation(c.config, OpUpdateOne, withCommunityID(id))
+	return &CommunityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Community.
+func (c *CommunityClient) Delete() *CommunityDelete {
+	mutation := newCommunityMutation(c.config, OpDelete)
+	return &CommunityDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CommunityClient) DeleteOne(_m *Community) *CommunityDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CommunityClient) DeleteOneID(id int) *CommunityDeleteOne {
+	builder := c.Delete().Where(community.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CommunityDeleteOne{builder}
+}
+
+// Query returns a query builder for Community.
+func (c *CommunityClient) Query() *CommunityQuery {
+	return &CommunityQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCommunity},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Community entity by its id.
+func (c *CommunityClient) Get(ctx context.Context, id int) (*Community, error) {
+	return c.Query().Where(community.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CommunityClient) GetX(ctx context.Context, id int) *Community {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *CommunityClient) Hooks() []Hook {
+	return c.hooks.Community
+}
+
+// Interceptors returns the client interceptors.
+func (c *CommunityClient) Interceptors() []Interceptor {
+	return c.inters.Community
+}
+
+func (c *CommunityClient) mutate(ctx context.Context, m *CommunityMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CommunityCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CommunityUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CommunityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CommunityDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Community mutation op: %q", m.Op())
+	}
+}
+
+// DiscoveredEntityClient is a client for the DiscoveredEntity schema.
+type DiscoveredEntityClient struct {
+	config
+}
+
+// NewDiscoveredEntityClient returns a client for the DiscoveredEntity from the given config.
+func NewDiscoveredEntityClient(c config) *DiscoveredEntityClient {
+	return &DiscoveredEntityClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `discoveredentity.Hooks(f(g(h())))`.
+func (c *DiscoveredEntityClient) Use(hooks ...Hook) {
+	c.hooks.DiscoveredEntity = append(c.hooks.DiscoveredEntity, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `discoveredentity.Intercept(f(g(h())))`.
+func (c *DiscoveredEntityClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DiscoveredEntity = append(c.inters.DiscoveredEntity, interceptors...)
+}
+
+// Create returns a builder for creating a DiscoveredEntity entity.
+func (c *DiscoveredEntityClient) Create() *DiscoveredEntityCreate {
+	mutation := newDiscoveredEntityMutation(c.config, OpCreate)
+	return &DiscoveredEntityCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DiscoveredEntity entities.
+func (c *DiscoveredEntityClient) CreateBulk(builders ...*DiscoveredEntityCreate) *DiscoveredEntityCreateBulk {
+	return &DiscoveredEntityCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DiscoveredEntityClient) MapCreateBulk(slice any, setFunc func(*DiscoveredEntityCreate, int)) *DiscoveredEntityCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DiscoveredEntityCreateBulk{err: fmt.Errorf("calling to DiscoveredEntityClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DiscoveredEntityCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DiscoveredEntityCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DiscoveredEntity.
+func (c *DiscoveredEntityClient) Update() *DiscoveredEntityUpdate {
+	mutation := newDiscoveredEntityMutation(c.config, OpUpdate)
+	return &DiscoveredEntityUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DiscoveredEntityClient) UpdateOne(_m *DiscoveredEntity) *DiscoveredEntityUpdateOne {
+	mutation := newDiscoveredEntityMutation(c.config, OpUpdateOne, withDiscoveredEntity(_m))
+	return &DiscoveredEntityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DiscoveredEntityClient) UpdateOneID(id int) *DiscoveredEntityUpdateOne {
+	mutation := newDiscoveredEntityMutation(c.config, OpUpdateOne, withDiscoveredEntityID(id))
+	return &DiscoveredEntityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DiscoveredEntity.
+func (c *DiscoveredEntityClient) Delete() *DiscoveredEntityDelete {
+	mutation := newDiscoveredEntityMutation(c.config, OpDelete)
+	return &DiscoveredEntityDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DiscoveredEntityClient) DeleteOne(_m *DiscoveredEntity) *DiscoveredEntityDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DiscoveredEntityClient) DeleteOneID(id int) *DiscoveredEntityDeleteOne {
+	builder := c.Delete().Where(discoveredentity.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DiscoveredEntityDeleteOne{builder}
+}
+
+// Query returns a query builder for DiscoveredEntity.
+func (c *DiscoveredEntityClient) Query() *DiscoveredEntityQuery {
+	return &DiscoveredEntityQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDiscoveredEntity},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DiscoveredEntity entity by its id.
+func (c *DiscoveredEntityClient) Get(ctx context.Context, id int) (*DiscoveredEntity, error) {
+	return c.Query().Where(discoveredentity.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DiscoveredEntityClient) GetX(ctx context.Context, id int) *DiscoveredEntity {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *DiscoveredEntityClient) Hooks() []Hook {
+	return c.hooks.DiscoveredEntity
+}
+
+// Interceptors returns the client interceptors.
+func (c *DiscoveredEntityClient) Interceptors() []Interceptor {
+	return c.inters.DiscoveredEntity
+}
+
+func (c *DiscoveredEntityClient) mutate(ctx context.Context, m *DiscoveredEntityMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DiscoveredEntityCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DiscoveredEntityUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DiscoveredEntityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DiscoveredEntityDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DiscoveredEntity mutation op: %q", m.Op())
+	}
+}
+
+// EmailClient is a client for the Email schema.
+type EmailClient struct {
+	config
+}
+
+// NewEmailClient returns a client for the Email from the given config.
+func NewEmailClient(c config) *EmailClient {
+	return &EmailClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `email.Hooks(f(g(h())))`.
+func (c *EmailClient) Use(hooks ...Hook) {
+	c.hooks.Email = append(c.hooks.Email, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `email.Intercept(f(g(h())))`.
+func (c *EmailClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Email = append(c.inters.Email, interceptors...)
+}
+
+// Create returns a builder for creating a Email entity.
+func (c *EmailClient) Create() *EmailCreate {
+	mutation := newEmailMutation(c.config, OpCreate)
+	return &EmailCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Email entities.
+func (c *EmailClient) CreateBulk(builders ...*EmailCreate) *EmailCreateBulk {
+	return &EmailCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EmailClient) MapCreateBulk(slice any, setFunc func(*EmailCreate, int)) *EmailCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EmailCreateBulk{err: fmt.Errorf("calling to EmailClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EmailCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EmailCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Email.
+func (c *EmailClient) Update() *EmailUpdate {
+	mutation := newEmailMutation(c.config, OpUpdate)
+	return &EmailUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EmailClient) UpdateOne(_m *Email) *EmailUpdateOne {
+	mutation := newEmailMutation(c.config, OpUpdateOne, withEmail(_m))
+	return &EmailUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EmailClient) UpdateOneID(id int) *EmailUpdateOne {
+	mutation := newEmailMutation(c.config, OpUpdateOne, withEmailID(id))
+	return &EmailUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Email.
+func (c *EmailClient) Delete() *EmailDelete {
+	mutation := newEmailMutation(c.config, OpDelete)
+	return &EmailDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EmailClient) DeleteOne(_m *Email) *EmailDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EmailClient) DeleteOneID(id int) *EmailDeleteOne {
+	builder := c.Delete().Where(email.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EmailDeleteOne{builder}
+}
+
+// Query returns a query builder for Email.
+func (c *EmailClient) Query() *EmailQuery {
+	return &EmailQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEmail},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Email entity by its id.
+func (c *EmailClient) Get(ctx context.Context, id int) (*Email, error) {
+	return c.Query().Where(email.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EmailClient) GetX(ctx context.Context, id int) *Email {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *EmailClient) Hooks() []Hook {
+	return c.hooks.Email
+}
+
+// Interceptors returns the client interceptors.
+func (c *EmailClient) Interceptors() []Interceptor {
+	return c.inters.Email
+}
+
+func (c *EmailClient) mutate(ctx context.Context, m *EmailMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EmailCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EmailUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EmailUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EmailDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Email mutation op: %q", m.Op())
+	}
+}
+
+// EntityAliasClient is a client for the EntityAlias schema.
+type EntityAliasClient struct {
+	config
+}
+
+// NewEntityAliasClient returns a client for the EntityAlias from the given config.
+func NewEntityAliasClient(c config) *EntityAliasClient {
+	return &EntityAliasClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `entityalias.Hooks(f(g(h())))`.
+func (c *EntityAliasClient) Use(hooks ...Hook) {
+	c.hooks.EntityAlias = append(c.hooks.EntityAlias, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `entityalias.Intercept(f(g(h())))`.
+func (c *EntityAliasClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EntityAlias = append(c.inters.EntityAlias, interceptors...)
+}
+
+// Create returns a builder for creating a EntityAlias entity.
+func (c *EntityAliasClient) Create() *EntityAliasCreate {
+	mutation := newEntityAliasMutation(c.config, OpCreate)
+	return &EntityAliasCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EntityAlias entities.
+func (c *EntityAliasClient) CreateBulk(builders ...*EntityAliasCreate) *EntityAliasCreateBulk {
+	return &EntityAliasCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EntityAliasClient) MapCreateBulk(slice any, setFunc func(*EntityAliasCreate, int)) *EntityAliasCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EntityAliasCreateBulk{err: fmt.Errorf("calling to EntityAliasClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EntityAliasCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EntityAliasCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for EntityAlias.
+func (c *EntityAliasClient) Update() *EntityAliasUpdate {
+	mutation := newEntityAliasMutation(c.config, OpUpdate)
+	return &EntityAliasUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EntityAliasClient) UpdateOne(_m *EntityAlias) *EntityAliasUpdateOne {
+	mutation := newEntityAliasMutation(c.config, OpUpdateOne, withEntityAlias(_m))
+	return &EntityAliasUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EntityAliasClient) UpdateOneID(id int) *EntityAliasUpdateOne {
+	mutation := newEntityAliasMutation(c.config, OpUpdateOne, withEntityAliasID(id))
+	return &EntityAliasUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EntityAlias.
+func (c *EntityAliasClient) Delete() *EntityAliasDelete {
+	mutation := newEntityAliasMutation(c.config, OpDelete)
+	return &EntityAliasDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EntityAliasClient) DeleteOne(_m *EntityAlias) *EntityAliasDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EntityAliasClient) DeleteOneID(id int) *EntityAliasDeleteOne {
+	builder := c.Delete().Where(entityalias.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EntityAliasDeleteOne{builder}
+}
+
+// Query returns a query builder for EntityAlias.
+func (c *EntityAliasClient) Query() *EntityAliasQuery {
+	return &EntityAliasQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEntityAlias},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a EntityAlias entity by its id.
+func (c *EntityAliasClient) Get(ctx context.Context, id int) (*EntityAlias, error) {
+	return c.Query().Where(entityalias.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EntityAliasClient) GetX(ctx context.Context, id int) *EntityAlias {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *EntityAliasClient) Hooks() []Hook {
+	return c.hooks.EntityAlias
+}
+
+// Interceptors returns the client interceptors.
+func (c *EntityAliasClient) Interceptors() []Interceptor {
+	return c.inters.EntityAlias
+}
+
+func (c *EntityAliasClient) mutate(ctx context.Context, m *EntityAliasMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EntityAliasCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EntityAliasUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EntityAliasUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EntityAliasDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown EntityAlias mutation op: %q", m.Op())
+	}
+}
+
+// EntityAuditClient is a client for the EntityAudit schema.
+type EntityAuditClient struct {
+	config
+}
+
+// NewEntityAuditClient returns a client for the EntityAudit from the given config.
+func NewEntityAuditClient(c config) *EntityAuditClient {
+	return &EntityAuditClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `entityaudit.Hooks(f(g(h())))`.
+func (c *EntityAuditClient) Use(hooks ...Hook) {
+	c.hooks.EntityAudit = append(c.hooks.EntityAudit, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `entityaudit.Intercept(f(g(h())))`.
+func (c *EntityAuditClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EntityAudit = append(c.inters.EntityAudit, interceptors...)
+}
+
+// Create returns a builder for creating a EntityAudit entity.
+func (c *EntityAuditClient) Create() *EntityAuditCreate {
+	mutation := newEntityAuditMutation(c.config, OpCreate)
+	return &EntityAuditCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EntityAudit entities.
+func (c *EntityAuditClient) CreateBulk(builders ...*EntityAuditCreate) *EntityAuditCreateBulk {
+	return &EntityAuditCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EntityAuditClient) MapCreateBulk(slice any, setFunc func(*EntityAuditCreate, int)) *EntityAuditCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EntityAuditCreateBulk{err: fmt.Errorf("calling to EntityAuditClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EntityAuditCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EntityAuditCreateBulk{config: c.config, builders: builders}
 }
 
-// Intercept adds the query interceptors to all the entity clients.
-// In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
-func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.DiscoveredEntity.Intercept(interceptors...)
-	c.Email.Intercept(interceptors...)
-	c.Relationship.Intercept(interceptors...)
-	c.SchemaPromotion.Intercept(interceptors...)
+// Update returns an update builder for EntityAudit.
+func (c *EntityAuditClient) Update() *EntityAuditUpdate {
+	mutation := newEntityAuditMutation(c.config, OpUpdate)
+	return &EntityAuditUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Mutate implements the ent.Mutator interface.
-func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
-	switch m := m.(type) {
-	case *DiscoveredEntityMutation:
-		return c.DiscoveredEntity.mutate(ctx, m)
-	case *EmailMutation:
-		return c.Email.mutate(ctx, m)
-	case *RelationshipMutation:
-		return c.Relationship.mutate(ctx, m)
-	case *SchemaPromotionMutation:
-		return c.SchemaPromotion.mutate(ctx, m)
+// UpdateOne returns an update builder for the given entity.
+func (c *EntityAuditClient) UpdateOne(_m *EntityAudit) *EntityAuditUpdateOne {
+	mutation := newEntityAuditMutation(c.config, OpUpdateOne, withEntityAudit(_m))
+	return &EntityAuditUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EntityAuditClient) UpdateOneID(id int) *EntityAuditUpdateOne {
+	mutation := newEntityAuditMutation(c.config, OpUpdateOne, withEntityAuditID(id))
+	return &EntityAuditUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EntityAudit.
+func (c *EntityAuditClient) Delete() *EntityAuditDelete {
+	mutation := newEntityAuditMutation(c.config, OpDelete)
+	return &EntityAuditDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EntityAuditClient) DeleteOne(_m *EntityAudit) *EntityAuditDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EntityAuditClient) DeleteOneID(id int) *EntityAuditDeleteOne {
+	builder := c.Delete().Where(entityaudit.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EntityAuditDeleteOne{builder}
+}
+
+// Query returns a query builder for EntityAudit.
+func (c *EntityAuditClient) Query() *EntityAuditQuery {
+	return &EntityAuditQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEntityAudit},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a EntityAudit entity by its id.
+func (c *EntityAuditClient) Get(ctx context.Context, id int) (*EntityAudit, error) {
+	return c.Query().Where(entityaudit.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EntityAuditClient) GetX(ctx context.Context, id int) *EntityAudit {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *EntityAuditClient) Hooks() []Hook {
+	return c.hooks.EntityAudit
+}
+
+// Interceptors returns the client interceptors.
+func (c *EntityAuditClient) Interceptors() []Interceptor {
+	return c.inters.EntityAudit
+}
+
+func (c *EntityAuditClient) mutate(ctx context.Context, m *EntityAuditMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EntityAuditCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EntityAuditUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EntityAuditUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EntityAuditDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
+		return nil, fmt.Errorf("ent: unknown EntityAudit mutation op: %q", m.Op())
 	}
 }
 
-// DiscoveredEntityClient is a client for the DiscoveredEntity schema.
-type DiscoveredEntityClient struct {
+// ExtractionJobClient is a client for the ExtractionJob schema.
+type ExtractionJobClient struct {
 	config
 }
 
-// NewDiscoveredEntityClient returns a client for the DiscoveredEntity from the given config.
-func NewDiscoveredEntityClient(c config) *DiscoveredEntityClient {
-	return &DiscoveredEntityClient{config: c}
+// NewExtractionJobClient returns a client for the ExtractionJob from the given config.
+func NewExtractionJobClient(c config) *ExtractionJobClient {
+	return &ExtractionJobClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `discoveredentity.Hooks(f(g(h())))`.
-func (c *DiscoveredEntityClient) Use(hooks ...Hook) {
-	c.hooks.DiscoveredEntity = append(c.hooks.DiscoveredEntity, hooks...)
+// A call to `Use(f, g, h)` equals to `extractionjob.Hooks(f(g(h())))`.
+func (c *ExtractionJobClient) Use(hooks ...Hook) {
+	c.hooks.ExtractionJob = append(c.hooks.ExtractionJob, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `discoveredentity.Intercept(f(g(h())))`.
-func (c *DiscoveredEntityClient) Intercept(interceptors ...Interceptor) {
-	c.inters.DiscoveredEntity = append(c.inters.DiscoveredEntity, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `extractionjob.Intercept(f(g(h())))`.
+func (c *ExtractionJobClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ExtractionJob = append(c.inters.ExtractionJob, interceptors...)
 }
 
-// Create returns a builder for creating a DiscoveredEntity entity.
-func (c *DiscoveredEntityClient) Create() *DiscoveredEntityCreate {
-	mutation := newDiscoveredEntityMutation(c.config, OpCreate)
-	return &DiscoveredEntityCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ExtractionJob entity.
+func (c *ExtractionJobClient) Create() *ExtractionJobCreate {
+	mutation := newExtractionJobMutation(c.config, OpCreate)
+	return &ExtractionJobCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of DiscoveredEntity entities.
-func (c *DiscoveredEntityClient) CreateBulk(builders ...*DiscoveredEntityCreate) *DiscoveredEntityCreateBulk {
-	return &DiscoveredEntityCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ExtractionJob entities.
+func (c *ExtractionJobClient) CreateBulk(builders ...*ExtractionJobCreate) *ExtractionJobCreateBulk {
+	return &ExtractionJobCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *DiscoveredEntityClient) MapCreateBulk(slice any, setFunc func(*DiscoveredEntityCreate, int)) *DiscoveredEntityCreateBulk {
+func (c *ExtractionJobClient) MapCreateBulk(slice any, setFunc func(*ExtractionJobCreate, int)) *ExtractionJobCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &DiscoveredEntityCreateBulk{err: fmt.Errorf("calling to DiscoveredEntityClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ExtractionJobCreateBulk{err: fmt.Errorf("calling to ExtractionJobClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*DiscoveredEntityCreate, rv.Len())
+	builders := make([]*ExtractionJobCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &DiscoveredEntityCreateBulk{config: c.config, builders: builders}
+	return &ExtractionJobCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for DiscoveredEntity.
-func (c *DiscoveredEntityClient) Update() *DiscoveredEntityUpdate {
-	mutation := newDiscoveredEntityMutation(c.config, OpUpdate)
-	return &DiscoveredEntityUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ExtractionJob.
+func (c *ExtractionJobClient) Update() *ExtractionJobUpdate {
+	mutation := newExtractionJobMutation(c.config, OpUpdate)
+	return &ExtractionJobUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *DiscoveredEntityClient) UpdateOne(_m *DiscoveredEntity) *DiscoveredEntityUpdateOne {
-	mutation := newDiscoveredEntityMutation(c.config, OpUpdateOne, withDiscoveredEntity(_m))
-	return &DiscoveredEntityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ExtractionJobClient) UpdateOne(_m *ExtractionJob) *ExtractionJobUpdateOne {
+	mutation := newExtractionJobMutation(c.config, OpUpdateOne, withExtractionJob(_m))
+	return &ExtractionJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *DiscoveredEntityClient) UpdateOneID(id int) *DiscoveredEntityUpdateOne {
-	mutation := newDiscoveredEntityMutation(c.config, OpUpdateOne, withDiscoveredEntityID(id))
-	return &DiscoveredEntityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ExtractionJobClient) UpdateOneID(id int) *ExtractionJobUpdateOne {
+	mutation := newExtractionJobMutation(c.config, OpUpdateOne, withExtractionJobID(id))
+	return &ExtractionJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for DiscoveredEntity.
-func (c *DiscoveredEntityClient) Delete() *DiscoveredEntityDelete {
-	mutation := newDiscoveredEntityMutation(c.config, OpDelete)
-	return &DiscoveredEntityDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ExtractionJob.
+func (c *ExtractionJobClient) Delete() *ExtractionJobDelete {
+	mutation := newExtractionJobMutation(c.config, OpDelete)
+	return &ExtractionJobDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *DiscoveredEntityClient) DeleteOne(_m *DiscoveredEntity) *DiscoveredEntityDeleteOne {
+func (c *ExtractionJobClient) DeleteOne(_m *ExtractionJob) *ExtractionJobDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *DiscoveredEntityClient) DeleteOneID(id int) *DiscoveredEntityDeleteOne {
-	builder := c.Delete().Where(discoveredentity.ID(id))
+func (c *ExtractionJobClient) DeleteOneID(id int) *ExtractionJobDeleteOne {
+	builder := c.Delete().Where(extractionjob.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &DiscoveredEntityDeleteOne{builder}
+	return &ExtractionJobDeleteOne{builder}
 }
 
-// Query returns a query builder for DiscoveredEntity.
-func (c *DiscoveredEntityClient) Query() *DiscoveredEntityQuery {
-	return &DiscoveredEntityQuery{
+// Query returns a query builder for ExtractionJob.
+func (c *ExtractionJobClient) Query() *ExtractionJobQuery {
+	return &ExtractionJobQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeDiscoveredEntity},
+		ctx:    &QueryContext{Type: TypeExtractionJob},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a DiscoveredEntity entity by its id.
-func (c *DiscoveredEntityClient) Get(ctx context.Context, id int) (*DiscoveredEntity, error) {
-	return c.Query().Where(discoveredentity.ID(id)).Only(ctx)
+// Get returns a ExtractionJob entity by its id.
+func (c *ExtractionJobClient) Get(ctx context.Context, id int) (*ExtractionJob, error) {
+	return c.Query().Where(extractionjob.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *DiscoveredEntityClient) GetX(ctx context.Context, id int) *DiscoveredEntity {
+func (c *ExtractionJobClient) GetX(ctx context.Context, id int) *ExtractionJob {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -335,131 +1195,131 @@ func (c *DiscoveredEntityClient) GetX(ctx context.Context, id int) *DiscoveredEn
 }
 
 // Hooks returns the client hooks.
-func (c *DiscoveredEntityClient) Hooks() []Hook {
-	return c.hooks.DiscoveredEntity
+func (c *ExtractionJobClient) Hooks() []Hook {
+	return c.hooks.ExtractionJob
 }
 
 // Interceptors returns the client interceptors.
-func (c *DiscoveredEntityClient) Interceptors() []Interceptor {
-	return c.inters.DiscoveredEntity
+func (c *ExtractionJobClient) Interceptors() []Interceptor {
+	return c.inters.ExtractionJob
 }
 
-func (c *DiscoveredEntityClient) mutate(ctx context.Context, m *DiscoveredEntityMutation) (Value, error) {
+func (c *ExtractionJobClient) mutate(ctx context.Context, m *ExtractionJobMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&DiscoveredEntityCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ExtractionJobCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&DiscoveredEntityUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ExtractionJobUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&DiscoveredEntityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ExtractionJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&DiscoveredEntityDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ExtractionJobDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown DiscoveredEntity mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ExtractionJob mutation op: %q", m.Op())
 	}
 }
 
-// EmailClient is a client for the Email schema.
-type EmailClient struct {
+// ProvenanceClient is a client for the Provenance schema.
+type ProvenanceClient struct {
 	config
 }
 
-// NewEmailClient returns a client for the Email from the given config.
-func NewEmailClient(c config) *EmailClient {
-	return &EmailClient{config: c}
+// NewProvenanceClient returns a client for the Provenance from the given config.
+func NewProvenanceClient(c config) *ProvenanceClient {
+	return &ProvenanceClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `email.Hooks(f(g(h())))`.
-func (c *EmailClient) Use(hooks ...Hook) {
-	c.hooks.Email = append(c.hooks.Email, hooks...)
+// A call to `Use(f, g, h)` equals to `provenance.Hooks(f(g(h())))`.
+func (c *ProvenanceClient) Use(hooks ...Hook) {
+	c.hooks.Provenance = append(c.hooks.Provenance, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `email.Intercept(f(g(h())))`.
-func (c *EmailClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Email = append(c.inters.Email, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `provenance.Intercept(f(g(h())))`.
+func (c *ProvenanceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Provenance = append(c.inters.Provenance, interceptors...)
 }
 
-// Create returns a builder for creating a Email entity.
-func (c *EmailClient) Create() *EmailCreate {
-	mutation := newEmailMutation(c.config, OpCreate)
-	return &EmailCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Provenance entity.
+func (c *ProvenanceClient) Create() *ProvenanceCreate {
+	mutation := newProvenanceMutation(c.config, OpCreate)
+	return &ProvenanceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Email entities.
-func (c *EmailClient) CreateBulk(builders ...*EmailCreate) *EmailCreateBulk {
-	return &EmailCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Provenance entities.
+func (c *ProvenanceClient) CreateBulk(builders ...*ProvenanceCreate) *ProvenanceCreateBulk {
+	return &ProvenanceCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *EmailClient) MapCreateBulk(slice any, setFunc func(*EmailCreate, int)) *EmailCreateBulk {
+func (c *ProvenanceClient) MapCreateBulk(slice any, setFunc func(*ProvenanceCreate, int)) *ProvenanceCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &EmailCreateBulk{err: fmt.Errorf("calling to EmailClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ProvenanceCreateBulk{err: fmt.Errorf("calling to ProvenanceClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*EmailCreate, rv.Len())
+	builders := make([]*ProvenanceCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &EmailCreateBulk{config: c.config, builders: builders}
+	return &ProvenanceCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Email.
-func (c *EmailClient) Update() *EmailUpdate {
-	mutation := newEmailMutation(c.config, OpUpdate)
-	return &EmailUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Provenance.
+func (c *ProvenanceClient) Update() *ProvenanceUpdate {
+	mutation := newProvenanceMutation(c.config, OpUpdate)
+	return &ProvenanceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *EmailClient) UpdateOne(_m *Email) *EmailUpdateOne {
-	mutation := newEmailMutation(c.config, OpUpdateOne, withEmail(_m))
-	return &EmailUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ProvenanceClient) UpdateOne(_m *Provenance) *ProvenanceUpdateOne {
+	mutation := newProvenanceMutation(c.config, OpUpdateOne, withProvenance(_m))
+	return &ProvenanceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *EmailClient) UpdateOneID(id int) *EmailUpdateOne {
-	mutation := newEmailMutation(c.config, OpUpdateOne, withEmailID(id))
-	return &EmailUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ProvenanceClient) UpdateOneID(id int) *ProvenanceUpdateOne {
+	mutation := newProvenanceMutation(c.config, OpUpdateOne, withProvenanceID(id))
+	return &ProvenanceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Email.
-func (c *EmailClient) Delete() *EmailDelete {
-	mutation := newEmailMutation(c.config, OpDelete)
-	return &EmailDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Provenance.
+func (c *ProvenanceClient) Delete() *ProvenanceDelete {
+	mutation := newProvenanceMutation(c.config, OpDelete)
+	return &ProvenanceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *EmailClient) DeleteOne(_m *Email) *EmailDeleteOne {
+func (c *ProvenanceClient) DeleteOne(_m *Provenance) *ProvenanceDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *EmailClient) DeleteOneID(id int) *EmailDeleteOne {
-	builder := c.Delete().Where(email.ID(id))
+func (c *ProvenanceClient) DeleteOneID(id int) *ProvenanceDeleteOne {
+	builder := c.Delete().Where(provenance.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &EmailDeleteOne{builder}
+	return &ProvenanceDeleteOne{builder}
 }
 
-// Query returns a query builder for Email.
-func (c *EmailClient) Query() *EmailQuery {
-	return &EmailQuery{
+// Query returns a query builder for Provenance.
+func (c *ProvenanceClient) Query() *ProvenanceQuery {
+	return &ProvenanceQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeEmail},
+		ctx:    &QueryContext{Type: TypeProvenance},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Email entity by its id.
-func (c *EmailClient) Get(ctx context.Context, id int) (*Email, error) {
-	return c.Query().Where(email.ID(id)).Only(ctx)
+// Get returns a Provenance entity by its id.
+func (c *ProvenanceClient) Get(ctx context.Context, id int) (*Provenance, error) {
+	return c.Query().Where(provenance.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *EmailClient) GetX(ctx context.Context, id int) *Email {
+func (c *ProvenanceClient) GetX(ctx context.Context, id int) *Provenance {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -468,27 +1328,27 @@ func (c *EmailClient) GetX(ctx context.Context, id int) *Email {
 }
 
 // Hooks returns the client hooks.
-func (c *EmailClient) Hooks() []Hook {
-	return c.hooks.Email
+func (c *ProvenanceClient) Hooks() []Hook {
+	return c.hooks.Provenance
 }
 
 // Interceptors returns the client interceptors.
-func (c *EmailClient) Interceptors() []Interceptor {
-	return c.inters.Email
+func (c *ProvenanceClient) Interceptors() []Interceptor {
+	return c.inters.Provenance
 }
 
-func (c *EmailClient) mutate(ctx context.Context, m *EmailMutation) (Value, error) {
+func (c *ProvenanceClient) mutate(ctx context.Context, m *ProvenanceMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&EmailCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProvenanceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&EmailUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProvenanceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&EmailUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProvenanceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&EmailDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ProvenanceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Email mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Provenance mutation op: %q", m.Op())
 	}
 }
 
@@ -758,12 +1618,148 @@ func (c *SchemaPromotionClient) mutate(ctx context.Context, m *SchemaPromotionMu
 	}
 }
 
+// ThreadClient is a client for the Thread schema.
+type ThreadClient struct {
+	config
+}
+
+// NewThreadClient returns a client for the Thread from the given config.
+func NewThreadClient(c config) *ThreadClient {
+	return &ThreadClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `thread.Hooks(f(g(h())))`.
+func (c *ThreadClient) Use(hooks ...Hook) {
+	c.hooks.Thread = append(c.hooks.Thread, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `thread.Intercept(f(g(h())))`.
+func (c *ThreadClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Thread = append(c.inters.Thread, interceptors...)
+}
+
+// Create returns a builder for creating a Thread entity.
+func (c *ThreadClient) Create() *ThreadCreate {
+	mutation := newThreadMutation(c.config, OpCreate)
+	return &ThreadCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Thread entities.
+func (c *ThreadClient) CreateBulk(builders ...*ThreadCreate) *ThreadCreateBulk {
+	return &ThreadCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ThreadClient) MapCreateBulk(slice any, setFunc func(*ThreadCreate, int)) *ThreadCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ThreadCreateBulk{err: fmt.Errorf("calling to ThreadClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ThreadCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ThreadCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Thread.
+func (c *ThreadClient) Update() *ThreadUpdate {
+	mutation := newThreadMutation(c.config, OpUpdate)
+	return &ThreadUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ThreadClient) UpdateOne(_m *Thread) *ThreadUpdateOne {
+	mutation := newThreadMutation(c.config, OpUpdateOne, withThread(_m))
+	return &ThreadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ThreadClient) UpdateOneID(id int) *ThreadUpdateOne {
+	mutation := newThreadMutation(c.config, OpUpdateOne, withThreadID(id))
+	return &ThreadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Thread.
+func (c *ThreadClient) Delete() *ThreadDelete {
+	mutation := newThreadMutation(c.config, OpDelete)
+	return &ThreadDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ThreadClient) DeleteOne(_m *Thread) *ThreadDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ThreadClient) DeleteOneID(id int) *ThreadDeleteOne {
+	builder := c.Delete().Where(thread.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ThreadDeleteOne{builder}
+}
+
+// Query returns a query builder for Thread.
+func (c *ThreadClient) Query() *ThreadQuery {
+	return &ThreadQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeThread},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Thread entity by its id.
+func (c *ThreadClient) Get(ctx context.Context, id int) (*Thread, error) {
+	return c.Query().Where(thread.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ThreadClient) GetX(ctx context.Context, id int) *Thread {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ThreadClient) Hooks() []Hook {
+	return c.hooks.Thread
+}
+
+// Interceptors returns the client interceptors.
+func (c *ThreadClient) Interceptors() []Interceptor {
+	return c.inters.Thread
+}
+
+func (c *ThreadClient) mutate(ctx context.Context, m *ThreadMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ThreadCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ThreadUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ThreadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ThreadDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Thread mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		DiscoveredEntity, Email, Relationship, SchemaPromotion []ent.Hook
+		CentralityScore, Community, DiscoveredEntity, Email, EntityAlias, EntityAudit,
+		ExtractionJob, Provenance, Relationship, SchemaPromotion, Thread []ent.Hook
 	}
 	inters struct {
-		DiscoveredEntity, Email, Relationship, SchemaPromotion []ent.Interceptor
+		CentralityScore, Community, DiscoveredEntity, Email, EntityAlias, EntityAudit,
+		ExtractionJob, Provenance, Relationship, SchemaPromotion,
+		Thread []ent.Interceptor
 	}
 )
@@ -0,0 +1,179 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Blogem/enron-graph/ent/community"
+)
+
+// Community is the model entity for the Community schema.
+type Community struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name of the most connected member
+	Label string `json:"label,omitempty"`
+	// Number of member entities
+	Size int `json:"size,omitempty"`
+	// Entity IDs of the members with the most connections inside the community, most first
+	TopMembers []int `json:"top_members,omitempty"`
+	// Summed weight of the relationships between members
+	InternalWeight float64 `json:"internal_weight,omitempty"`
+	// Modularity of the partition the community is part of
+	Modularity float64 `json:"modularity,omitempty"`
+	// Resolution the partition was computed with; higher values give smaller communities
+	Resolution float64 `json:"resolution,omitempty"`
+	// ComputedAt holds the value of the "computed_at" field.
+	ComputedAt   time.Time `json:"computed_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Community) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case community.FieldTopMembers:
+			values[i] = new([]byte)
+		case community.FieldInternalWeight, community.FieldModularity, community.FieldResolution:
+			values[i] = new(sql.NullFloat64)
+		case community.FieldID, community.FieldSize:
+			values[i] = new(sql.NullInt64)
+		case community.FieldLabel:
+			values[i] = new(sql.NullString)
+		case community.FieldComputedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Community fields.
+func (_m *Community) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case community.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case community.FieldLabel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field label", values[i])
+			} else if value.Valid {
+				_m.Label = value.String
+			}
+		case community.FieldSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field size", values[i])
+			} else if value.Valid {
+				_m.Size = int(value.Int64)
+			}
+		case community.FieldTopMembers:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field top_members", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.TopMembers); err != nil {
+					return fmt.Errorf("unmarshal field top_members: %w", err)
+				}
+			}
+		case community.FieldInternalWeight:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field internal_weight", values[i])
+			} else if value.Valid {
+				_m.InternalWeight = value.Float64
+			}
+		case community.FieldModularity:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field modularity", values[i])
+			} else if value.Valid {
+				_m.Modularity = value.Float64
+			}
+		case community.FieldResolution:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field resolution", values[i])
+			} else if value.Valid {
+				_m.Resolution = value.Float64
+			}
+		case community.FieldComputedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field computed_at", values[i])
+			} else if value.Valid {
+				_m.ComputedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Community.
+// This includes values selected through modifiers, order, etc.
+func (_m *Community) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Community.
+// Note that you need to call Community.Unwrap() before calling this method if this Community
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Community) Update() *CommunityUpdateOne {
+	return NewCommunityClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Community entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Community) Unwrap() *Community {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Community is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Community) String() string {
+	var builder strings.Builder
+	builder.WriteString("Community(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("label=")
+	builder.WriteString(_m.Label)
+	builder.WriteString(", ")
+	builder.WriteString("size=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Size))
+	builder.WriteString(", ")
+	builder.WriteString("top_members=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TopMembers))
+	builder.WriteString(", ")
+	builder.WriteString("internal_weight=")
+	builder.WriteString(fmt.Sprintf("%v", _m.InternalWeight))
+	builder.WriteString(", ")
+	builder.WriteString("modularity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Modularity))
+	builder.WriteString(", ")
+	builder.WriteString("resolution=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Resolution))
+	builder.WriteString(", ")
+	builder.WriteString("computed_at=")
+	builder.WriteString(_m.ComputedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Communities is a parsable slice of Community.
+type Communities []*Community
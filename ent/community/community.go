@@ -0,0 +1,107 @@
+// Code generated by ent, DO NOT EDIT.
+
+package community
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the community type in the database.
+	Label = "community"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldLabel holds the string denoting the label field in the database.
+	FieldLabel = "label"
+	// FieldSize holds the string denoting the size field in the database.
+	FieldSize = "size"
+	// FieldTopMembers holds the string denoting the top_members field in the database.
+	FieldTopMembers = "top_members"
+	// FieldInternalWeight holds the string denoting the internal_weight field in the database.
+	FieldInternalWeight = "internal_weight"
+	// FieldModularity holds the string denoting the modularity field in the database.
+	FieldModularity = "modularity"
+	// FieldResolution holds the string denoting the resolution field in the database.
+	FieldResolution = "resolution"
+	// FieldComputedAt holds the string denoting the computed_at field in the database.
+	FieldComputedAt = "computed_at"
+	// Table holds the table name of the community in the database.
+	Table = "communities"
+)
+
+// Columns holds all SQL columns for community fields.
+var Columns = []string{
+	FieldID,
+	FieldLabel,
+	FieldSize,
+	FieldTopMembers,
+	FieldInternalWeight,
+	FieldModularity,
+	FieldResolution,
+	FieldComputedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultLabel holds the default value on creation for the "label" field.
+	DefaultLabel string
+	// DefaultSize holds the default value on creation for the "size" field.
+	DefaultSize int
+	// DefaultInternalWeight holds the default value on creation for the "internal_weight" field.
+	DefaultInternalWeight float64
+	// DefaultModularity holds the default value on creation for the "modularity" field.
+	DefaultModularity float64
+	// DefaultResolution holds the default value on creation for the "resolution" field.
+	DefaultResolution float64
+	// DefaultComputedAt holds the default value on creation for the "computed_at" field.
+	DefaultComputedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the Community queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByLabel orders the results by the label field.
+func ByLabel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLabel, opts...).ToFunc()
+}
+
+// BySize orders the results by the size field.
+func BySize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSize, opts...).ToFunc()
+}
+
+// ByInternalWeight orders the results by the internal_weight field.
+func ByInternalWeight(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInternalWeight, opts...).ToFunc()
+}
+
+// ByModularity orders the results by the modularity field.
+func ByModularity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModularity, opts...).ToFunc()
+}
+
+// ByResolution orders the results by the resolution field.
+func ByResolution(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResolution, opts...).ToFunc()
+}
+
+// ByComputedAt orders the results by the computed_at field.
+func ByComputedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldComputedAt, opts...).ToFunc()
+}
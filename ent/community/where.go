@@ -0,0 +1,370 @@
+// Code generated by ent, DO NOT EDIT.
+
+package community
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Blogem/enron-graph/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Community {
+	return predicate.Community(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Community {
+	return predicate.Community(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Community {
+	return predicate.Community(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Community {
+	return predicate.Community(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Community {
+	return predicate.Community(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Community {
+	return predicate.Community(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Community {
+	return predicate.Community(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Community {
+	return predicate.Community(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Community {
+	return predicate.Community(sql.FieldLTE(FieldID, id))
+}
+
+// Size applies equality check predicate on the "size" field. It's identical to SizeEQ.
+func Size(v int) predicate.Community {
+	return predicate.Community(sql.FieldEQ(FieldSize, v))
+}
+
+// InternalWeight applies equality check predicate on the "internal_weight" field. It's identical to InternalWeightEQ.
+func InternalWeight(v float64) predicate.Community {
+	return predicate.Community(sql.FieldEQ(FieldInternalWeight, v))
+}
+
+// Modularity applies equality check predicate on the "modularity" field. It's identical to ModularityEQ.
+func Modularity(v float64) predicate.Community {
+	return predicate.Community(sql.FieldEQ(FieldModularity, v))
+}
+
+// Resolution applies equality check predicate on the "resolution" field. It's identical to ResolutionEQ.
+func Resolution(v float64) predicate.Community {
+	return predicate.Community(sql.FieldEQ(FieldResolution, v))
+}
+
+// ComputedAt applies equality check predicate on the "computed_at" field. It's identical to ComputedAtEQ.
+func ComputedAt(v time.Time) predicate.Community {
+	return predicate.Community(sql.FieldEQ(FieldComputedAt, v))
+}
+
+// LabelEQ applies the EQ predicate on the "label" field.
+func LabelEQ(v string) predicate.Community {
+	return predicate.Community(sql.FieldEQ(FieldLabel, v))
+}
+
+// LabelNEQ applies the NEQ predicate on the "label" field.
+func LabelNEQ(v string) predicate.Community {
+	return predicate.Community(sql.FieldNEQ(FieldLabel, v))
+}
+
+// LabelIn applies the In predicate on the "label" field.
+func LabelIn(vs ...string) predicate.Community {
+	return predicate.Community(sql.FieldIn(FieldLabel, vs...))
+}
+
+// LabelNotIn applies the NotIn predicate on the "label" field.
+func LabelNotIn(vs ...string) predicate.Community {
+	return predicate.Community(sql.FieldNotIn(FieldLabel, vs...))
+}
+
+// LabelGT applies the GT predicate on the "label" field.
+func LabelGT(v string) predicate.Community {
+	return predicate.Community(sql.FieldGT(FieldLabel, v))
+}
+
+// LabelGTE applies the GTE predicate on the "label" field.
+func LabelGTE(v string) predicate.Community {
+	return predicate.Community(sql.FieldGTE(FieldLabel, v))
+}
+
+// LabelLT applies the LT predicate on the "label" field.
+func LabelLT(v string) predicate.Community {
+	return predicate.Community(sql.FieldLT(FieldLabel, v))
+}
+
+// LabelLTE applies the LTE predicate on the "label" field.
+func LabelLTE(v string) predicate.Community {
+	return predicate.Community(sql.FieldLTE(FieldLabel, v))
+}
+
+// LabelContains applies the Contains predicate on the "label" field.
+func LabelContains(v string) predicate.Community {
+	return predicate.Community(sql.FieldContains(FieldLabel, v))
+}
+
+// LabelHasPrefix applies the HasPrefix predicate on the "label" field.
+func LabelHasPrefix(v string) predicate.Community {
+	return predicate.Community(sql.FieldHasPrefix(FieldLabel, v))
+}
+
+// LabelHasSuffix applies the HasSuffix predicate on the "label" field.
+func LabelHasSuffix(v string) predicate.Community {
+	return predicate.Community(sql.FieldHasSuffix(FieldLabel, v))
+}
+
+// LabelEqualFold applies the EqualFold predicate on the "label" field.
+func LabelEqualFold(v string) predicate.Community {
+	return predicate.Community(sql.FieldEqualFold(FieldLabel, v))
+}
+
+// LabelContainsFold applies the ContainsFold predicate on the "label" field.
+func LabelContainsFold(v string) predicate.Community {
+	return predicate.Community(sql.FieldContainsFold(FieldLabel, v))
+}
+
+// SizeEQ applies the EQ predicate on the "size" field.
+func SizeEQ(v int) predicate.Community {
+	return predicate.Community(sql.FieldEQ(FieldSize, v))
+}
+
+// SizeNEQ applies the NEQ predicate on the "size" field.
+func SizeNEQ(v int) predicate.Community {
+	return predicate.Community(sql.FieldNEQ(FieldSize, v))
+}
+
+// SizeIn applies the In predicate on the "size" field.
+func SizeIn(vs ...int) predicate.Community {
+	return predicate.Community(sql.FieldIn(FieldSize, vs...))
+}
+
+// SizeNotIn applies the NotIn predicate on the "size" field.
+func SizeNotIn(vs ...int) predicate.Community {
+	return predicate.Community(sql.FieldNotIn(FieldSize, vs...))
+}
+
+// SizeGT applies the GT predicate on the "size" field.
+func SizeGT(v int) predicate.Community {
+	return predicate.Community(sql.FieldGT(FieldSize, v))
+}
+
+// SizeGTE applies the GTE predicate on the "size" field.
+func SizeGTE(v int) predicate.Community {
+	return predicate.Community(sql.FieldGTE(FieldSize, v))
+}
+
+// SizeLT applies the LT predicate on the "size" field.
+func SizeLT(v int) predicate.Community {
+	return predicate.Community(sql.FieldLT(FieldSize, v))
+}
+
+// SizeLTE applies the LTE predicate on the "size" field.
+func SizeLTE(v int) predicate.Community {
+	return predicate.Community(sql.FieldLTE(FieldSize, v))
+}
+
+// TopMembersIsNil applies the IsNil predicate on the "top_members" field.
+func TopMembersIsNil() predicate.Community {
+	return predicate.Community(sql.FieldIsNull(FieldTopMembers))
+}
+
+// TopMembersNotNil applies the NotNil predicate on the "top_members" field.
+func TopMembersNotNil() predicate.Community {
+	return predicate.Community(sql.FieldNotNull(FieldTopMembers))
+}
+
+// InternalWeightEQ applies the EQ predicate on the "internal_weight" field.
+func InternalWeightEQ(v float64) predicate.Community {
+	return predicate.Community(sql.FieldEQ(FieldInternalWeight, v))
+}
+
+// InternalWeightNEQ applies the NEQ predicate on the "internal_weight" field.
+func InternalWeightNEQ(v float64) predicate.Community {
+	return predicate.Community(sql.FieldNEQ(FieldInternalWeight, v))
+}
+
+// InternalWeightIn applies the In predicate on the "internal_weight" field.
+func InternalWeightIn(vs ...float64) predicate.Community {
+	return predicate.Community(sql.FieldIn(FieldInternalWeight, vs...))
+}
+
+// InternalWeightNotIn applies the NotIn predicate on the "internal_weight" field.
+func InternalWeightNotIn(vs ...float64) predicate.Community {
+	return predicate.Community(sql.FieldNotIn(FieldInternalWeight, vs...))
+}
+
+// InternalWeightGT applies the GT predicate on the "internal_weight" field.
+func InternalWeightGT(v float64) predicate.Community {
+	return predicate.Community(sql.FieldGT(FieldInternalWeight, v))
+}
+
+// InternalWeightGTE applies the GTE predicate on the "internal_weight" field.
+func InternalWeightGTE(v float64) predicate.Community {
+	return predicate.Community(sql.FieldGTE(FieldInternalWeight, v))
+}
+
+// InternalWeightLT applies the LT predicate on the "internal_weight" field.
+func InternalWeightLT(v float64) predicate.Community {
+	return predicate.Community(sql.FieldLT(FieldInternalWeight, v))
+}
+
+// InternalWeightLTE applies the LTE predicate on the "internal_weight" field.
+func InternalWeightLTE(v float64) predicate.Community {
+	return predicate.Community(sql.FieldLTE(FieldInternalWeight, v))
+}
+
+// ModularityEQ applies the EQ predicate on the "modularity" field.
+func ModularityEQ(v float64) predicate.Community {
+	return predicate.Community(sql.FieldEQ(FieldModularity, v))
+}
+
+// ModularityNEQ applies the NEQ predicate on the "modularity" field.
+func ModularityNEQ(v float64) predicate.Community {
+	return predicate.Community(sql.FieldNEQ(FieldModularity, v))
+}
+
+// ModularityIn applies the In predicate on the "modularity" field.
+func ModularityIn(vs ...float64) predicate.Community {
+	return predicate.Community(sql.FieldIn(FieldModularity, vs...))
+}
+
+// ModularityNotIn applies the NotIn predicate on the "modularity" field.
+func ModularityNotIn(vs ...float64) predicate.Community {
+	return predicate.Community(sql.FieldNotIn(FieldModularity, vs...))
+}
+
+// ModularityGT applies the GT predicate on the "modularity" field.
+func ModularityGT(v float64) predicate.Community {
+	return predicate.Community(sql.FieldGT(FieldModularity, v))
+}
+
+// ModularityGTE applies the GTE predicate on the "modularity" field.
+func ModularityGTE(v float64) predicate.Community {
+	return predicate.Community(sql.FieldGTE(FieldModularity, v))
+}
+
+// ModularityLT applies the LT predicate on the "modularity" field.
+func ModularityLT(v float64) predicate.Community {
+	return predicate.Community(sql.FieldLT(FieldModularity, v))
+}
+
+// ModularityLTE applies the LTE predicate on the "modularity" field.
+func ModularityLTE(v float64) predicate.Community {
+	return predicate.Community(sql.FieldLTE(FieldModularity, v))
+}
+
+// ResolutionEQ applies the EQ predicate on the "resolution" field.
+func ResolutionEQ(v float64) predicate.Community {
+	return predicate.Community(sql.FieldEQ(FieldResolution, v))
+}
+
+// ResolutionNEQ applies the NEQ predicate on the "resolution" field.
+func ResolutionNEQ(v float64) predicate.Community {
+	return predicate.Community(sql.FieldNEQ(FieldResolution, v))
+}
+
+// ResolutionIn applies the In predicate on the "resolution" field.
+func ResolutionIn(vs ...float64) predicate.Community {
+	return predicate.Community(sql.FieldIn(FieldResolution, vs...))
+}
+
+// ResolutionNotIn applies the NotIn predicate on the "resolution" field.
+func ResolutionNotIn(vs ...float64) predicate.Community {
+	return predicate.Community(sql.FieldNotIn(FieldResolution, vs...))
+}
+
+// ResolutionGT applies the GT predicate on the "resolution" field.
+func ResolutionGT(v float64) predicate.Community {
+	return predicate.Community(sql.FieldGT(FieldResolution, v))
+}
+
+// ResolutionGTE applies the GTE predicate on the "resolution" field.
+func ResolutionGTE(v float64) predicate.Community {
+	return predicate.Community(sql.FieldGTE(FieldResolution, v))
+}
+
+// ResolutionLT applies the LT predicate on the "resolution" field.
+func ResolutionLT(v float64) predicate.Community {
+	return predicate.Community(sql.FieldLT(FieldResolution, v))
+}
+
+// ResolutionLTE applies the LTE predicate on the "resolution" field.
+func ResolutionLTE(v float64) predicate.Community {
+	return predicate.Community(sql.FieldLTE(FieldResolution, v))
+}
+
+// ComputedAtEQ applies the EQ predicate on the "computed_at" field.
+func ComputedAtEQ(v time.Time) predicate.Community {
+	return predicate.Community(sql.FieldEQ(FieldComputedAt, v))
+}
+
+// ComputedAtNEQ applies the NEQ predicate on the "computed_at" field.
+func ComputedAtNEQ(v time.Time) predicate.Community {
+	return predicate.Community(sql.FieldNEQ(FieldComputedAt, v))
+}
+
+// ComputedAtIn applies the In predicate on the "computed_at" field.
+func ComputedAtIn(vs ...time.Time) predicate.Community {
+	return predicate.Community(sql.FieldIn(FieldComputedAt, vs...))
+}
+
+// ComputedAtNotIn applies the NotIn predicate on the "computed_at" field.
+func ComputedAtNotIn(vs ...time.Time) predicate.Community {
+	return predicate.Community(sql.FieldNotIn(FieldComputedAt, vs...))
+}
+
+// ComputedAtGT applies the GT predicate on the "computed_at" field.
+func ComputedAtGT(v time.Time) predicate.Community {
+	return predicate.Community(sql.FieldGT(FieldComputedAt, v))
+}
+
+// ComputedAtGTE applies the GTE predicate on the "computed_at" field.
+func ComputedAtGTE(v time.Time) predicate.Community {
+	return predicate.Community(sql.FieldGTE(FieldComputedAt, v))
+}
+
+// ComputedAtLT applies the LT predicate on the "computed_at" field.
+func ComputedAtLT(v time.Time) predicate.Community {
+	return predicate.Community(sql.FieldLT(FieldComputedAt, v))
+}
+
+// ComputedAtLTE applies the LTE predicate on the "computed_at" field.
+func ComputedAtLTE(v time.Time) predicate.Community {
+	return predicate.Community(sql.FieldLTE(FieldComputedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Community) predicate.Community {
+	return predicate.Community(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Community) predicate.Community {
+	return predicate.Community(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Community) predicate.Community {
+	return predicate.Community(sql.NotPredicates(p))
+}
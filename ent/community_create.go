@@ -0,0 +1,337 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Blogem/enron-graph/ent/community"
+)
+
+// CommunityCreate is the builder for creating a Community entity.
+type CommunityCreate struct {
+	config
+	mutation *CommunityMutation
+	hooks    []Hook
+}
+
+// SetLabel sets the "label" field.
+func (_c *CommunityCreate) SetLabel(v string) *CommunityCreate {
+	_c.mutation.SetLabel(v)
+	return _c
+}
+
+// SetNillableLabel sets the "label" field if the given value is not nil.
+func (_c *CommunityCreate) SetNillableLabel(v *string) *CommunityCreate {
+	if v != nil {
+		_c.SetLabel(*v)
+	}
+	return _c
+}
+
+// SetSize sets the "size" field.
+func (_c *CommunityCreate) SetSize(v int) *CommunityCreate {
+	_c.mutation.SetSize(v)
+	return _c
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (_c *CommunityCreate) SetNillableSize(v *int) *CommunityCreate {
+	if v != nil {
+		_c.SetSize(*v)
+	}
+	return _c
+}
+
+// SetTopMembers sets the "top_members" field.
+func (_c *CommunityCreate) SetTopMembers(v []int) *CommunityCreate {
+	_c.mutation.SetTopMembers(v)
+	return _c
+}
+
+// SetInternalWeight sets the "internal_weight" field.
+func (_c *CommunityCreate) SetInternalWeight(v float64) *CommunityCreate {
+	_c.mutation.SetInternalWeight(v)
+	return _c
+}
+
+// SetNillableInternalWeight sets the "internal_weight" field if the given value is not nil.
+func (_c *CommunityCreate) SetNillableInternalWeight(v *float64) *CommunityCreate {
+	if v != nil {
+		_c.SetInternalWeight(*v)
+	}
+	return _c
+}
+
+// SetModularity sets the "modularity" field.
+func (_c *CommunityCreate) SetModularity(v float64) *CommunityCreate {
+	_c.mutation.SetModularity(v)
+	return _c
+}
+
+// SetNillableModularity sets the "modularity" field if the given value is not nil.
+func (_c *CommunityCreate) SetNillableModularity(v *float64) *CommunityCreate {
+	if v != nil {
+		_c.SetModularity(*v)
+	}
+	return _c
+}
+
+// SetResolution sets the "resolution" field.
+func (_c *CommunityCreate) SetResolution(v float64) *CommunityCreate {
+	_c.mutation.SetResolution(v)
+	return _c
+}
+
+// SetNillableResolution sets the "resolution" field if the given value is not nil.
+func (_c *CommunityCreate) SetNillableResolution(v *float64) *CommunityCreate {
+	if v != nil {
+		_c.SetResolution(*v)
+	}
+	return _c
+}
+
+// SetComputedAt sets the "computed_at" field.
+func (_c *CommunityCreate) SetComputedAt(v time.Time) *CommunityCreate {
+	_c.mutation.SetComputedAt(v)
+	return _c
+}
+
+// SetNillableComputedAt sets the "computed_at" field if the given value is not nil.
+func (_c *CommunityCreate) SetNillableComputedAt(v *time.Time) *CommunityCreate {
+	if v != nil {
+		_c.SetComputedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the CommunityMutation object of the builder.
+func (_c *CommunityCreate) Mutation() *CommunityMutation {
+	return _c.mutation
+}
+
+// Save creates the Community in the database.
+func (_c *CommunityCreate) Save(ctx context.Context) (*Community, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CommunityCreate) SaveX(ctx context.Context) *Community {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CommunityCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CommunityCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *CommunityCreate) defaults() {
+	if _, ok := _c.mutation.Label(); !ok {
+		v := community.DefaultLabel
+		_c.mutation.SetLabel(v)
+	}
+	if _, ok := _c.mutation.Size(); !ok {
+		v := community.DefaultSize
+		_c.mutation.SetSize(v)
+	}
+	if _, ok := _c.mutation.InternalWeight(); !ok {
+		v := community.DefaultInternalWeight
+		_c.mutation.SetInternalWeight(v)
+	}
+	if _, ok := _c.mutation.Modularity(); !ok {
+		v := community.DefaultModularity
+		_c.mutation.SetModularity(v)
+	}
+	if _, ok := _c.mutation.Resolution(); !ok {
+		v := community.DefaultResolution
+		_c.mutation.SetResolution(v)
+	}
+	if _, ok := _c.mutation.ComputedAt(); !ok {
+		v := community.DefaultComputedAt()
+		_c.mutation.SetComputedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CommunityCreate) check() error {
+	if _, ok := _c.mutation.Label(); !ok {
+		return &ValidationError{Name: "label", err: errors.New(`ent: missing required field "Community.label"`)}
+	}
+	if _, ok := _c.mutation.Size(); !ok {
+		return &ValidationError{Name: "size", err: errors.New(`ent: missing required field "Community.size"`)}
+	}
+	if _, ok := _c.mutation.InternalWeight(); !ok {
+		return &ValidationError{Name: "internal_weight", err: errors.New(`ent: missing required field "Community.internal_weight"`)}
+	}
+	if _, ok := _c.mutation.Modularity(); !ok {
+		return &ValidationError{Name: "modularity", err: errors.New(`ent: missing required field "Community.modularity"`)}
+	}
+	if _, ok := _c.mutation.Resolution(); !ok {
+		return &ValidationError{Name: "resolution", err: errors.New(`ent: missing required field "Community.resolution"`)}
+	}
+	if _, ok := _c.mutation.ComputedAt(); !ok {
+		return &ValidationError{Name: "computed_at", err: errors.New(`ent: missing required field "Community.computed_at"`)}
+	}
+	return nil
+}
+
+func (_c *CommunityCreate) sqlSave(ctx context.Context) (*Community, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CommunityCreate) createSpec() (*Community, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Community{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(community.Table, sqlgraph.NewFieldSpec(community.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Label(); ok {
+		_spec.SetField(community.FieldLabel, field.TypeString, value)
+		_node.Label = value
+	}
+	if value, ok := _c.mutation.Size(); ok {
+		_spec.SetField(community.FieldSize, field.TypeInt, value)
+		_node.Size = value
+	}
+	if value, ok := _c.mutation.TopMembers(); ok {
+		_spec.SetField(community.FieldTopMembers, field.TypeJSON, value)
+		_node.TopMembers = value
+	}
+	if value, ok := _c.mutation.InternalWeight(); ok {
+		_spec.SetField(community.FieldInternalWeight, field.TypeFloat64, value)
+		_node.InternalWeight = value
+	}
+	if value, ok := _c.mutation.Modularity(); ok {
+		_spec.SetField(community.FieldModularity, field.TypeFloat64, value)
+		_node.Modularity = value
+	}
+	if value, ok := _c.mutation.Resolution(); ok {
+		_spec.SetField(community.FieldResolution, field.TypeFloat64, value)
+		_node.Resolution = value
+	}
+	if value, ok := _c.mutation.ComputedAt(); ok {
+		_spec.SetField(community.FieldComputedAt, field.TypeTime, value)
+		_node.ComputedAt = value
+	}
+	return _node, _spec
+}
+
+// CommunityCreateBulk is the builder for creating many Community entities in bulk.
+type CommunityCreateBulk struct {
+	config
+	err      error
+	builders []*CommunityCreate
+}
+
+// Save creates the Community entities in the database.
+func (_c *CommunityCreateBulk) Save(ctx context.Context) ([]*Community, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Community, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CommunityMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CommunityCreateBulk) SaveX(ctx context.Context) []*Community {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CommunityCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CommunityCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
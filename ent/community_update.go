@@ -0,0 +1,519 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/Blogem/enron-graph/ent/community"
+	"github.com/Blogem/enron-graph/ent/predicate"
+)
+
+// CommunityUpdate is the builder for updating Community entities.
+type CommunityUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CommunityMutation
+}
+
+// Where appends a list predicates to the CommunityUpdate builder.
+func (_u *CommunityUpdate) Where(ps ...predicate.Community) *CommunityUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetLabel sets the "label" field.
+func (_u *CommunityUpdate) SetLabel(v string) *CommunityUpdate {
+	_u.mutation.SetLabel(v)
+	return _u
+}
+
+// SetNillableLabel sets the "label" field if the given value is not nil.
+func (_u *CommunityUpdate) SetNillableLabel(v *string) *CommunityUpdate {
+	if v != nil {
+		_u.SetLabel(*v)
+	}
+	return _u
+}
+
+// SetSize sets the "size" field.
+func (_u *CommunityUpdate) SetSize(v int) *CommunityUpdate {
+	_u.mutation.ResetSize()
+	_u.mutation.SetSize(v)
+	return _u
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (_u *CommunityUpdate) SetNillableSize(v *int) *CommunityUpdate {
+	if v != nil {
+		_u.SetSize(*v)
+	}
+	return _u
+}
+
+// AddSize adds value to the "size" field.
+func (_u *CommunityUpdate) AddSize(v int) *CommunityUpdate {
+	_u.mutation.AddSize(v)
+	return _u
+}
+
+// SetTopMembers sets the "top_members" field.
+func (_u *CommunityUpdate) SetTopMembers(v []int) *CommunityUpdate {
+	_u.mutation.SetTopMembers(v)
+	return _u
+}
+
+// AppendTopMembers appends value to the "top_members" field.
+func (_u *CommunityUpdate) AppendTopMembers(v []int) *CommunityUpdate {
+	_u.mutation.AppendTopMembers(v)
+	return _u
+}
+
+// ClearTopMembers clears the value of the "top_members" field.
+func (_u *CommunityUpdate) ClearTopMembers() *CommunityUpdate {
+	_u.mutation.ClearTopMembers()
+	return _u
+}
+
+// SetInternalWeight sets the "internal_weight" field.
+func (_u *CommunityUpdate) SetInternalWeight(v float64) *CommunityUpdate {
+	_u.mutation.ResetInternalWeight()
+	_u.mutation.SetInternalWeight(v)
+	return _u
+}
+
+// SetNillableInternalWeight sets the "internal_weight" field if the given value is not nil.
+func (_u *CommunityUpdate) SetNillableInternalWeight(v *float64) *CommunityUpdate {
+	if v != nil {
+		_u.SetInternalWeight(*v)
+	}
+	return _u
+}
+
+// AddInternalWeight adds value to the "internal_weight" field.
+func (_u *CommunityUpdate) AddInternalWeight(v float64) *CommunityUpdate {
+	_u.mutation.AddInternalWeight(v)
+	return _u
+}
+
+// SetModularity sets the "modularity" field.
+func (_u *CommunityUpdate) SetModularity(v float64) *CommunityUpdate {
+	_u.mutation.ResetModularity()
+	_u.mutation.SetModularity(v)
+	return _u
+}
+
+// SetNillableModularity sets the "modularity" field if the given value is not nil.
+func (_u *CommunityUpdate) SetNillableModularity(v *float64) *CommunityUpdate {
+	if v != nil {
+		_u.SetModularity(*v)
+	}
+	return _u
+}
+
+// AddModularity adds value to the "modularity" field.
+func (_u *CommunityUpdate) AddModularity(v float64) *CommunityUpdate {
+	_u.mutation.AddModularity(v)
+	return _u
+}
+
+// SetResolution sets the "resolution" field.
+func (_u *CommunityUpdate) SetResolution(v float64) *CommunityUpdate {
+	_u.mutation.ResetResolution()
+	_u.mutation.SetResolution(v)
+	return _u
+}
+
+// SetNillableResolution sets the "resolution" field if the given value is not nil.
+func (_u *CommunityUpdate) SetNillableResolution(v *float64) *CommunityUpdate {
+	if v != nil {
+		_u.SetResolution(*v)
+	}
+	return _u
+}
+
+// AddResolution adds value to the "resolution" field.
+func (_u *CommunityUpdate) AddResolution(v float64) *CommunityUpdate {
+	_u.mutation.AddResolution(v)
+	return _u
+}
+
+// SetComputedAt sets the "computed_at" field.
+func (_u *CommunityUpdate) SetComputedAt(v time.Time) *CommunityUpdate {
+	_u.mutation.SetComputedAt(v)
+	return _u
+}
+
+// SetNillableComputedAt sets the "computed_at" field if the given value is not nil.
+func (_u *CommunityUpdate) SetNillableComputedAt(v *time.Time) *CommunityUpdate {
+	if v != nil {
+		_u.SetComputedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the CommunityMutation object of the builder.
+func (_u *CommunityUpdate) Mutation() *CommunityMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CommunityUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CommunityUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CommunityUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CommunityUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *CommunityUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(community.Table, community.Columns, sqlgraph.NewFieldSpec(community.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Label(); ok {
+		_spec.SetField(community.FieldLabel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Size(); ok {
+		_spec.SetField(community.FieldSize, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSize(); ok {
+		_spec.AddField(community.FieldSize, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TopMembers(); ok {
+		_spec.SetField(community.FieldTopMembers, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTopMembers(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, community.FieldTopMembers, value)
+		})
+	}
+	if _u.mutation.TopMembersCleared() {
+		_spec.ClearField(community.FieldTopMembers, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.InternalWeight(); ok {
+		_spec.SetField(community.FieldInternalWeight, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedInternalWeight(); ok {
+		_spec.AddField(community.FieldInternalWeight, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Modularity(); ok {
+		_spec.SetField(community.FieldModularity, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedModularity(); ok {
+		_spec.AddField(community.FieldModularity, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Resolution(); ok {
+		_spec.SetField(community.FieldResolution, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedResolution(); ok {
+		_spec.AddField(community.FieldResolution, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.ComputedAt(); ok {
+		_spec.SetField(community.FieldComputedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{community.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CommunityUpdateOne is the builder for updating a single Community entity.
+type CommunityUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CommunityMutation
+}
+
+// SetLabel sets the "label" field.
+func (_u *CommunityUpdateOne) SetLabel(v string) *CommunityUpdateOne {
+	_u.mutation.SetLabel(v)
+	return _u
+}
+
+// SetNillableLabel sets the "label" field if the given value is not nil.
+func (_u *CommunityUpdateOne) SetNillableLabel(v *string) *CommunityUpdateOne {
+	if v != nil {
+		_u.SetLabel(*v)
+	}
+	return _u
+}
+
+// SetSize sets the "size" field.
+func (_u *CommunityUpdateOne) SetSize(v int) *CommunityUpdateOne {
+	_u.mutation.ResetSize()
+	_u.mutation.SetSize(v)
+	return _u
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (_u *CommunityUpdateOne) SetNillableSize(v *int) *CommunityUpdateOne {
+	if v != nil {
+		_u.SetSize(*v)
+	}
+	return _u
+}
+
+// AddSize adds value to the "size" field.
+func (_u *CommunityUpdateOne) AddSize(v int) *CommunityUpdateOne {
+	_u.mutation.AddSize(v)
+	return _u
+}
+
+// SetTopMembers sets the "top_members" field.
+func (_u *CommunityUpdateOne) SetTopMembers(v []int) *CommunityUpdateOne {
+	_u.mutation.SetTopMembers(v)
+	return _u
+}
+
+// AppendTopMembers appends value to the "top_members" field.
+func (_u *CommunityUpdateOne) AppendTopMembers(v []int) *CommunityUpdateOne {
+	_u.mutation.AppendTopMembers(v)
+	return _u
+}
+
+// ClearTopMembers clears the value of the "top_members" field.
+func (_u *CommunityUpdateOne) ClearTopMembers() *CommunityUpdateOne {
+	_u.mutation.ClearTopMembers()
+	return _u
+}
+
+// SetInternalWeight sets the "internal_weight" field.
+func (_u *CommunityUpdateOne) SetInternalWeight(v float64) *CommunityUpdateOne {
+	_u.mutation.ResetInternalWeight()
+	_u.mutation.SetInternalWeight(v)
+	return _u
+}
+
+// SetNillableInternalWeight sets the "internal_weight" field if the given value is not nil.
+func (_u *CommunityUpdateOne) SetNillableInternalWeight(v *float64) *CommunityUpdateOne {
+	if v != nil {
+		_u.SetInternalWeight(*v)
+	}
+	return _u
+}
+
+// AddInternalWeight adds value to the "internal_weight" field.
+func (_u *CommunityUpdateOne) AddInternalWeight(v float64) *CommunityUpdateOne {
+	_u.mutation.AddInternalWeight(v)
+	return _u
+}
+
+// SetModularity sets the "modularity" field.
+func (_u *CommunityUpdateOne) SetModularity(v float64) *CommunityUpdateOne {
+	_u.mutation.ResetModularity()
+	_u.mutation.SetModularity(v)
+	return _u
+}
+
+// SetNillableModularity sets the "modularity" field if the given value is not nil.
+func (_u *CommunityUpdateOne) SetNillableModularity(v *float64) *CommunityUpdateOne {
+	if v != nil {
+		_u.SetModularity(*v)
+	}
+	return _u
+}
+
+// AddModularity adds value to the "modularity" field.
+func (_u *CommunityUpdateOne) AddModularity(v float64) *CommunityUpdateOne {
+	_u.mutation.AddModularity(v)
+	return _u
+}
+
+// SetResolution sets the "resolution" field.
+func (_u *CommunityUpdateOne) SetResolution(v float64) *CommunityUpdateOne {
+	_u.mutation.ResetResolution()
+	_u.mutation.SetResolution(v)
+	return _u
+}
+
+// SetNillableResolution sets the "resolution" field if the given value is not nil.
+func (_u *CommunityUpdateOne) SetNillableResolution(v *float64) *CommunityUpdateOne {
+	if v != nil {
+		_u.SetResolution(*v)
+	}
+	return _u
+}
+
+// AddResolution adds value to the "resolution" field.
+func (_u *CommunityUpdateOne) AddResolution(v float64) *CommunityUpdateOne {
+	_u.mutation.AddResolution(v)
+	return _u
+}
+
+// SetComputedAt sets the "computed_at" field.
+func (_u *CommunityUpdateOne) SetComputedAt(v time.Time) *CommunityUpdateOne {
+	_u.mutation.SetComputedAt(v)
+	return _u
+}
+
+// SetNillableComputedAt sets the "computed_at" field if the given value is not nil.
+func (_u *CommunityUpdateOne) SetNillableComputedAt(v *time.Time) *CommunityUpdateOne {
+	if v != nil {
+		_u.SetComputedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the CommunityMutation object of the builder.
+func (_u *CommunityUpdateOne) Mutation() *CommunityMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the CommunityUpdate builder.
+func (_u *CommunityUpdateOne) Where(ps ...predicate.Community) *CommunityUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CommunityUpdateOne) Select(field string, fields ...string) *CommunityUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Community entity.
+func (_u *CommunityUpdateOne) Save(ctx context.Context) (*Community, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CommunityUpdateOne) SaveX(ctx context.Context) *Community {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CommunityUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CommunityUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *CommunityUpdateOne) sqlSave(ctx context.Context) (_node *Community, err error) {
+	_spec := sqlgraph.NewUpdateSpec(community.Table, community.Columns, sqlgraph.NewFieldSpec(community.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Community.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, community.FieldID)
+		for _, f := range fields {
+			if !community.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != community.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Label(); ok {
+		_spec.SetField(community.FieldLabel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Size(); ok {
+		_spec.SetField(community.FieldSize, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSize(); ok {
+		_spec.AddField(community.FieldSize, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TopMembers(); ok {
+		_spec.SetField(community.FieldTopMembers, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTopMembers(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, community.FieldTopMembers, value)
+		})
+	}
+	if _u.mutation.TopMembersCleared() {
+		_spec.ClearField(community.FieldTopMembers, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.InternalWeight(); ok {
+		_spec.SetField(community.FieldInternalWeight, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedInternalWeight(); ok {
+		_spec.AddField(community.FieldInternalWeight, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Modularity(); ok {
+		_spec.SetField(community.FieldModularity, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedModularity(); ok {
+		_spec.AddField(community.FieldModularity, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Resolution(); ok {
+		_spec.SetField(community.FieldResolution, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedResolution(); ok {
+		_spec.AddField(community.FieldResolution, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.ComputedAt(); ok {
+		_spec.SetField(community.FieldComputedAt, field.TypeTime, value)
+	}
+	_node = &Community{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{community.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -28,6 +28,12 @@ type Email struct {
 	Cc []string `json:"cc,omitempty"`
 	// BCC email addresses
 	Bcc []string `json:"bcc,omitempty"`
+	// Display names seen in the headers, keyed by email address
+	DisplayNames map[string]string `json:"display_names,omitempty"`
+	// Message ID from the In-Reply-To header
+	InReplyTo string `json:"in_reply_to,omitempty"`
+	// Message IDs from the References header, oldest first
+	References []string `json:"references,omitempty"`
 	// Email subject line
 	Subject string `json:"subject,omitempty"`
 	// Email send date
@@ -46,11 +52,11 @@ func (*Email) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case email.FieldTo, email.FieldCc, email.FieldBcc:
+		case email.FieldTo, email.FieldCc, email.FieldBcc, email.FieldDisplayNames, email.FieldReferences:
 			values[i] = new([]byte)
 		case email.FieldID:
 			values[i] = new(sql.NullInt64)
-		case email.FieldMessageID, email.FieldFrom, email.FieldSubject, email.FieldBody, email.FieldFilePath:
+		case email.FieldMessageID, email.FieldFrom, email.FieldInReplyTo, email.FieldSubject, email.FieldBody, email.FieldFilePath:
 			values[i] = new(sql.NullString)
 		case email.FieldDate, email.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
@@ -111,6 +117,28 @@ func (_m *Email) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field bcc: %w", err)
 				}
 			}
+		case email.FieldDisplayNames:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field display_names", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.DisplayNames); err != nil {
+					return fmt.Errorf("unmarshal field display_names: %w", err)
+				}
+			}
+		case email.FieldInReplyTo:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field in_reply_to", values[i])
+			} else if value.Valid {
+				_m.InReplyTo = value.String
+			}
+		case email.FieldReferences:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field references", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.References); err != nil {
+					return fmt.Errorf("unmarshal field references: %w", err)
+				}
+			}
 		case email.FieldSubject:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field subject", values[i])
@@ -192,6 +220,15 @@ func (_m *Email) String() string {
 	builder.WriteString("bcc=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Bcc))
 	builder.WriteString(", ")
+	builder.WriteString("display_names=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DisplayNames))
+	builder.WriteString(", ")
+	builder.WriteString("in_reply_to=")
+	builder.WriteString(_m.InReplyTo)
+	builder.WriteString(", ")
+	builder.WriteString("references=")
+	builder.WriteString(fmt.Sprintf("%v", _m.References))
+	builder.WriteString(", ")
 	builder.WriteString("subject=")
 	builder.WriteString(_m.Subject)
 	builder.WriteString(", ")
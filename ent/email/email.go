@@ -23,6 +23,12 @@ const (
 	FieldCc = "cc"
 	// FieldBcc holds the string denoting the bcc field in the database.
 	FieldBcc = "bcc"
+	// FieldDisplayNames holds the string denoting the display_names field in the database.
+	FieldDisplayNames = "display_names"
+	// FieldInReplyTo holds the string denoting the in_reply_to field in the database.
+	FieldInReplyTo = "in_reply_to"
+	// FieldReferences holds the string denoting the references field in the database.
+	FieldReferences = "references"
 	// FieldSubject holds the string denoting the subject field in the database.
 	FieldSubject = "subject"
 	// FieldDate holds the string denoting the date field in the database.
@@ -45,6 +51,9 @@ var Columns = []string{
 	FieldTo,
 	FieldCc,
 	FieldBcc,
+	FieldDisplayNames,
+	FieldInReplyTo,
+	FieldReferences,
 	FieldSubject,
 	FieldDate,
 	FieldBody,
@@ -95,6 +104,11 @@ func ByFrom(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldFrom, opts...).ToFunc()
 }
 
+// ByInReplyTo orders the results by the in_reply_to field.
+func ByInReplyTo(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInReplyTo, opts...).ToFunc()
+}
+
 // BySubject orders the results by the subject field.
 func BySubject(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSubject, opts...).ToFunc()
@@ -64,6 +64,11 @@ func From(v string) predicate.Email {
 	return predicate.Email(sql.FieldEQ(FieldFrom, v))
 }
 
+// InReplyTo applies equality check predicate on the "in_reply_to" field. It's identical to InReplyToEQ.
+func InReplyTo(v string) predicate.Email {
+	return predicate.Email(sql.FieldEQ(FieldInReplyTo, v))
+}
+
 // Subject applies equality check predicate on the "subject" field. It's identical to SubjectEQ.
 func Subject(v string) predicate.Email {
 	return predicate.Email(sql.FieldEQ(FieldSubject, v))
@@ -249,6 +254,101 @@ func BccNotNil() predicate.Email {
 	return predicate.Email(sql.FieldNotNull(FieldBcc))
 }
 
+// DisplayNamesIsNil applies the IsNil predicate on the "display_names" field.
+func DisplayNamesIsNil() predicate.Email {
+	return predicate.Email(sql.FieldIsNull(FieldDisplayNames))
+}
+
+// DisplayNamesNotNil applies the NotNil predicate on the "display_names" field.
+func DisplayNamesNotNil() predicate.Email {
+	return predicate.Email(sql.FieldNotNull(FieldDisplayNames))
+}
+
+// InReplyToEQ applies the EQ predicate on the "in_reply_to" field.
+func InReplyToEQ(v string) predicate.Email {
+	return predicate.Email(sql.FieldEQ(FieldInReplyTo, v))
+}
+
+// InReplyToNEQ applies the NEQ predicate on the "in_reply_to" field.
+func InReplyToNEQ(v string) predicate.Email {
+	return predicate.Email(sql.FieldNEQ(FieldInReplyTo, v))
+}
+
+// InReplyToIn applies the In predicate on the "in_reply_to" field.
+func InReplyToIn(vs ...string) predicate.Email {
+	return predicate.Email(sql.FieldIn(FieldInReplyTo, vs...))
+}
+
+// InReplyToNotIn applies the NotIn predicate on the "in_reply_to" field.
+func InReplyToNotIn(vs ...string) predicate.Email {
+	return predicate.Email(sql.FieldNotIn(FieldInReplyTo, vs...))
+}
+
+// InReplyToGT applies the GT predicate on the "in_reply_to" field.
+func InReplyToGT(v string) predicate.Email {
+	return predicate.Email(sql.FieldGT(FieldInReplyTo, v))
+}
+
+// InReplyToGTE applies the GTE predicate on the "in_reply_to" field.
+func InReplyToGTE(v string) predicate.Email {
+	return predicate.Email(sql.FieldGTE(FieldInReplyTo, v))
+}
+
+// InReplyToLT applies the LT predicate on the "in_reply_to" field.
+func InReplyToLT(v string) predicate.Email {
+	return predicate.Email(sql.FieldLT(FieldInReplyTo, v))
+}
+
+// InReplyToLTE applies the LTE predicate on the "in_reply_to" field.
+func InReplyToLTE(v string) predicate.Email {
+	return predicate.Email(sql.FieldLTE(FieldInReplyTo, v))
+}
+
+// InReplyToContains applies the Contains predicate on the "in_reply_to" field.
+func InReplyToContains(v string) predicate.Email {
+	return predicate.Email(sql.FieldContains(FieldInReplyTo, v))
+}
+
+// InReplyToHasPrefix applies the HasPrefix predicate on the "in_reply_to" field.
+func InReplyToHasPrefix(v string) predicate.Email {
+	return predicate.Email(sql.FieldHasPrefix(FieldInReplyTo, v))
+}
+
+// InReplyToHasSuffix applies the HasSuffix predicate on the "in_reply_to" field.
+func InReplyToHasSuffix(v string) predicate.Email {
+	return predicate.Email(sql.FieldHasSuffix(FieldInReplyTo, v))
+}
+
+// InReplyToIsNil applies the IsNil predicate on the "in_reply_to" field.
+func InReplyToIsNil() predicate.Email {
+	return predicate.Email(sql.FieldIsNull(FieldInReplyTo))
+}
+
+// InReplyToNotNil applies the NotNil predicate on the "in_reply_to" field.
+func InReplyToNotNil() predicate.Email {
+	return predicate.Email(sql.FieldNotNull(FieldInReplyTo))
+}
+
+// InReplyToEqualFold applies the EqualFold predicate on the "in_reply_to" field.
+func InReplyToEqualFold(v string) predicate.Email {
+	return predicate.Email(sql.FieldEqualFold(FieldInReplyTo, v))
+}
+
+// InReplyToContainsFold applies the ContainsFold predicate on the "in_reply_to" field.
+func InReplyToContainsFold(v string) predicate.Email {
+	return predicate.Email(sql.FieldContainsFold(FieldInReplyTo, v))
+}
+
+// ReferencesIsNil applies the IsNil predicate on the "references" field.
+func ReferencesIsNil() predicate.Email {
+	return predicate.Email(sql.FieldIsNull(FieldReferences))
+}
+
+// ReferencesNotNil applies the NotNil predicate on the "references" field.
+func ReferencesNotNil() predicate.Email {
+	return predicate.Email(sql.FieldNotNull(FieldReferences))
+}
+
 // SubjectEQ applies the EQ predicate on the "subject" field.
 func SubjectEQ(v string) predicate.Email {
 	return predicate.Email(sql.FieldEQ(FieldSubject, v))
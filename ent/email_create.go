@@ -50,6 +50,32 @@ func (_c *EmailCreate) SetBcc(v []string) *EmailCreate {
 	return _c
 }
 
+// SetDisplayNames sets the "display_names" field.
+func (_c *EmailCreate) SetDisplayNames(v map[string]string) *EmailCreate {
+	_c.mutation.SetDisplayNames(v)
+	return _c
+}
+
+// SetInReplyTo sets the "in_reply_to" field.
+func (_c *EmailCreate) SetInReplyTo(v string) *EmailCreate {
+	_c.mutation.SetInReplyTo(v)
+	return _c
+}
+
+// SetNillableInReplyTo sets the "in_reply_to" field if the given value is not nil.
+func (_c *EmailCreate) SetNillableInReplyTo(v *string) *EmailCreate {
+	if v != nil {
+		_c.SetInReplyTo(*v)
+	}
+	return _c
+}
+
+// SetReferences sets the "references" field.
+func (_c *EmailCreate) SetReferences(v []string) *EmailCreate {
+	_c.mutation.SetReferences(v)
+	return _c
+}
+
 // SetSubject sets the "subject" field.
 func (_c *EmailCreate) SetSubject(v string) *EmailCreate {
 	_c.mutation.SetSubject(v)
@@ -249,6 +275,18 @@ func (_c *EmailCreate) createSpec() (*Email, *sqlgraph.CreateSpec) {
 		_spec.SetField(email.FieldBcc, field.TypeJSON, value)
 		_node.Bcc = value
 	}
+	if value, ok := _c.mutation.DisplayNames(); ok {
+		_spec.SetField(email.FieldDisplayNames, field.TypeJSON, value)
+		_node.DisplayNames = value
+	}
+	if value, ok := _c.mutation.InReplyTo(); ok {
+		_spec.SetField(email.FieldInReplyTo, field.TypeString, value)
+		_node.InReplyTo = value
+	}
+	if value, ok := _c.mutation.References(); ok {
+		_spec.SetField(email.FieldReferences, field.TypeJSON, value)
+		_node.References = value
+	}
 	if value, ok := _c.mutation.Subject(); ok {
 		_spec.SetField(email.FieldSubject, field.TypeString, value)
 		_node.Subject = value
@@ -111,6 +111,56 @@ func (_u *EmailUpdate) ClearBcc() *EmailUpdate {
 	return _u
 }
 
+// SetDisplayNames sets the "display_names" field.
+func (_u *EmailUpdate) SetDisplayNames(v map[string]string) *EmailUpdate {
+	_u.mutation.SetDisplayNames(v)
+	return _u
+}
+
+// ClearDisplayNames clears the value of the "display_names" field.
+func (_u *EmailUpdate) ClearDisplayNames() *EmailUpdate {
+	_u.mutation.ClearDisplayNames()
+	return _u
+}
+
+// SetInReplyTo sets the "in_reply_to" field.
+func (_u *EmailUpdate) SetInReplyTo(v string) *EmailUpdate {
+	_u.mutation.SetInReplyTo(v)
+	return _u
+}
+
+// SetNillableInReplyTo sets the "in_reply_to" field if the given value is not nil.
+func (_u *EmailUpdate) SetNillableInReplyTo(v *string) *EmailUpdate {
+	if v != nil {
+		_u.SetInReplyTo(*v)
+	}
+	return _u
+}
+
+// ClearInReplyTo clears the value of the "in_reply_to" field.
+func (_u *EmailUpdate) ClearInReplyTo() *EmailUpdate {
+	_u.mutation.ClearInReplyTo()
+	return _u
+}
+
+// SetReferences sets the "references" field.
+func (_u *EmailUpdate) SetReferences(v []string) *EmailUpdate {
+	_u.mutation.SetReferences(v)
+	return _u
+}
+
+// AppendReferences appends value to the "references" field.
+func (_u *EmailUpdate) AppendReferences(v []string) *EmailUpdate {
+	_u.mutation.AppendReferences(v)
+	return _u
+}
+
+// ClearReferences clears the value of the "references" field.
+func (_u *EmailUpdate) ClearReferences() *EmailUpdate {
+	_u.mutation.ClearReferences()
+	return _u
+}
+
 // SetSubject sets the "subject" field.
 func (_u *EmailUpdate) SetSubject(v string) *EmailUpdate {
 	_u.mutation.SetSubject(v)
@@ -271,6 +321,29 @@ func (_u *EmailUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.BccCleared() {
 		_spec.ClearField(email.FieldBcc, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.DisplayNames(); ok {
+		_spec.SetField(email.FieldDisplayNames, field.TypeJSON, value)
+	}
+	if _u.mutation.DisplayNamesCleared() {
+		_spec.ClearField(email.FieldDisplayNames, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.InReplyTo(); ok {
+		_spec.SetField(email.FieldInReplyTo, field.TypeString, value)
+	}
+	if _u.mutation.InReplyToCleared() {
+		_spec.ClearField(email.FieldInReplyTo, field.TypeString)
+	}
+	if value, ok := _u.mutation.References(); ok {
+		_spec.SetField(email.FieldReferences, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedReferences(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, email.FieldReferences, value)
+		})
+	}
+	if _u.mutation.ReferencesCleared() {
+		_spec.ClearField(email.FieldReferences, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.Subject(); ok {
 		_spec.SetField(email.FieldSubject, field.TypeString, value)
 	}
@@ -388,6 +461,56 @@ func (_u *EmailUpdateOne) ClearBcc() *EmailUpdateOne {
 	return _u
 }
 
+// SetDisplayNames sets the "display_names" field.
+func (_u *EmailUpdateOne) SetDisplayNames(v map[string]string) *EmailUpdateOne {
+	_u.mutation.SetDisplayNames(v)
+	return _u
+}
+
+// ClearDisplayNames clears the value of the "display_names" field.
+func (_u *EmailUpdateOne) ClearDisplayNames() *EmailUpdateOne {
+	_u.mutation.ClearDisplayNames()
+	return _u
+}
+
+// SetInReplyTo sets the "in_reply_to" field.
+func (_u *EmailUpdateOne) SetInReplyTo(v string) *EmailUpdateOne {
+	_u.mutation.SetInReplyTo(v)
+	return _u
+}
+
+// SetNillableInReplyTo sets the "in_reply_to" field if the given value is not nil.
+func (_u *EmailUpdateOne) SetNillableInReplyTo(v *string) *EmailUpdateOne {
+	if v != nil {
+		_u.SetInReplyTo(*v)
+	}
+	return _u
+}
+
+// ClearInReplyTo clears the value of the "in_reply_to" field.
+func (_u *EmailUpdateOne) ClearInReplyTo() *EmailUpdateOne {
+	_u.mutation.ClearInReplyTo()
+	return _u
+}
+
+// SetReferences sets the "references" field.
+func (_u *EmailUpdateOne) SetReferences(v []string) *EmailUpdateOne {
+	_u.mutation.SetReferences(v)
+	return _u
+}
+
+// AppendReferences appends value to the "references" field.
+func (_u *EmailUpdateOne) AppendReferences(v []string) *EmailUpdateOne {
+	_u.mutation.AppendReferences(v)
+	return _u
+}
+
+// ClearReferences clears the value of the "references" field.
+func (_u *EmailUpdateOne) ClearReferences() *EmailUpdateOne {
+	_u.mutation.ClearReferences()
+	return _u
+}
+
 // SetSubject sets the "subject" field.
 func (_u *EmailUpdateOne) SetSubject(v string) *EmailUpdateOne {
 	_u.mutation.SetSubject(v)
@@ -578,6 +701,29 @@ func (_u *EmailUpdateOne) sqlSave(ctx context.Context) (_node *Email, err error)
 	if _u.mutation.BccCleared() {
 		_spec.ClearField(email.FieldBcc, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.DisplayNames(); ok {
+		_spec.SetField(email.FieldDisplayNames, field.TypeJSON, value)
+	}
+	if _u.mutation.DisplayNamesCleared() {
+		_spec.ClearField(email.FieldDisplayNames, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.InReplyTo(); ok {
+		_spec.SetField(email.FieldInReplyTo, field.TypeString, value)
+	}
+	if _u.mutation.InReplyToCleared() {
+		_spec.ClearField(email.FieldInReplyTo, field.TypeString)
+	}
+	if value, ok := _u.mutation.References(); ok {
+		_spec.SetField(email.FieldReferences, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedReferences(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, email.FieldReferences, value)
+		})
+	}
+	if _u.mutation.ReferencesCleared() {
+		_spec.ClearField(email.FieldReferences, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.Subject(); ok {
 		_spec.SetField(email.FieldSubject, field.TypeString, value)
 	}
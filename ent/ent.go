@@ -12,10 +12,17 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/Blogem/enron-graph/ent/centralityscore"
+	"github.com/Blogem/enron-graph/ent/community"
 	"github.com/Blogem/enron-graph/ent/discoveredentity"
 	"github.com/Blogem/enron-graph/ent/email"
+	"github.com/Blogem/enron-graph/ent/entityalias"
+	"github.com/Blogem/enron-graph/ent/entityaudit"
+	"github.com/Blogem/enron-graph/ent/extractionjob"
+	"github.com/Blogem/enron-graph/ent/provenance"
 	"github.com/Blogem/enron-graph/ent/relationship"
 	"github.com/Blogem/enron-graph/ent/schemapromotion"
+	"github.com/Blogem/enron-graph/ent/thread"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -76,10 +83,17 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
+			centralityscore.Table:  centralityscore.ValidColumn,
+			community.Table:        community.ValidColumn,
 			discoveredentity.Table: discoveredentity.ValidColumn,
 			email.Table:            email.ValidColumn,
+			entityalias.Table:      entityalias.ValidColumn,
+			entityaudit.Table:      entityaudit.ValidColumn,
+			extractionjob.Table:    extractionjob.ValidColumn,
+			provenance.Table:       provenance.ValidColumn,
 			relationship.Table:     relationship.ValidColumn,
 			schemapromotion.Table:  schemapromotion.ValidColumn,
+			thread.Table:           thread.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -0,0 +1,161 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Blogem/enron-graph/ent/entityalias"
+)
+
+// EntityAlias is the model entity for the EntityAlias schema.
+type EntityAlias struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ID of the discovered entity the alias belongs to
+	EntityID int `json:"entity_id,omitempty"`
+	// Alias as it was seen
+	Alias string `json:"alias,omitempty"`
+	// Lowercased, whitespace-collapsed alias used for lookups
+	Normalized string `json:"normalized,omitempty"`
+	// Kind of alias: name or email
+	Kind string `json:"kind,omitempty"`
+	// Where the alias was seen: header, signature, content, merge
+	Source string `json:"source,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EntityAlias) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case entityalias.FieldID, entityalias.FieldEntityID:
+			values[i] = new(sql.NullInt64)
+		case entityalias.FieldAlias, entityalias.FieldNormalized, entityalias.FieldKind, entityalias.FieldSource:
+			values[i] = new(sql.NullString)
+		case entityalias.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EntityAlias fields.
+func (_m *EntityAlias) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case entityalias.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case entityalias.FieldEntityID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field entity_id", values[i])
+			} else if value.Valid {
+				_m.EntityID = int(value.Int64)
+			}
+		case entityalias.FieldAlias:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field alias", values[i])
+			} else if value.Valid {
+				_m.Alias = value.String
+			}
+		case entityalias.FieldNormalized:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field normalized", values[i])
+			} else if value.Valid {
+				_m.Normalized = value.String
+			}
+		case entityalias.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = value.String
+			}
+		case entityalias.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				_m.Source = value.String
+			}
+		case entityalias.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EntityAlias.
+// This includes values selected through modifiers, order, etc.
+func (_m *EntityAlias) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this EntityAlias.
+// Note that you need to call EntityAlias.Unwrap() before calling this method if this EntityAlias
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *EntityAlias) Update() *EntityAliasUpdateOne {
+	return NewEntityAliasClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the EntityAlias entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *EntityAlias) Unwrap() *EntityAlias {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: EntityAlias is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *EntityAlias) String() string {
+	var builder strings.Builder
+	builder.WriteString("EntityAlias(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("entity_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EntityID))
+	builder.WriteString(", ")
+	builder.WriteString("alias=")
+	builder.WriteString(_m.Alias)
+	builder.WriteString(", ")
+	builder.WriteString("normalized=")
+	builder.WriteString(_m.Normalized)
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(_m.Kind)
+	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(_m.Source)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EntityAliasSlice is a parsable slice of EntityAlias.
+type EntityAliasSlice []*EntityAlias
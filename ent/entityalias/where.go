@@ -0,0 +1,440 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entityalias
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Blogem/enron-graph/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldLTE(FieldID, id))
+}
+
+// EntityID applies equality check predicate on the "entity_id" field. It's identical to EntityIDEQ.
+func EntityID(v int) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldEQ(FieldEntityID, v))
+}
+
+// Alias applies equality check predicate on the "alias" field. It's identical to AliasEQ.
+func Alias(v string) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldEQ(FieldAlias, v))
+}
+
+// Normalized applies equality check predicate on the "normalized" field. It's identical to NormalizedEQ.
+func Normalized(v string) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldEQ(FieldNormalized, v))
+}
+
+// Kind applies equality check predicate on the "kind" field. It's identical to KindEQ.
+func Kind(v string) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldEQ(FieldKind, v))
+}
+
+// Source applies equality check predicate on the "source" field. It's identical to SourceEQ.
+func Source(v string) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldEQ(FieldSource, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// EntityIDEQ applies the EQ predicate on the "entity_id" field.
+func EntityIDEQ(v int) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldEQ(FieldEntityID, v))
+}
+
+// EntityIDNEQ applies the NEQ predicate on the "entity_id" field.
+func EntityIDNEQ(v int) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldNEQ(FieldEntityID, v))
+}
+
+// EntityIDIn applies the In predicate on the "entity_id" field.
+func EntityIDIn(vs ...int) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldIn(FieldEntityID, vs...))
+}
+
+// EntityIDNotIn applies the NotIn predicate on the "entity_id" field.
+func EntityIDNotIn(vs ...int) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldNotIn(FieldEntityID, vs...))
+}
+
+// EntityIDGT applies the GT predicate on the "entity_id" field.
+func EntityIDGT(v int) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldGT(FieldEntityID, v))
+}
+
+// EntityIDGTE applies the GTE predicate on the "entity_id" field.
+func EntityIDGTE(v int) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldGTE(FieldEntityID, v))
+}
+
+// EntityIDLT applies the LT predicate on the "entity_id" field.
+func EntityIDLT(v int) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldLT(FieldEntityID, v))
+}
+
+// EntityIDLTE applies the LTE predicate on the "entity_id" field.
+func EntityIDLTE(v int) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldLTE(FieldEntityID, v))
+}
+
+// AliasEQ applies the EQ predicate on the "alias" field.
+func AliasEQ(v string) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldEQ(FieldAlias, v))
+}
+
+// AliasNEQ applies the NEQ predicate on the "alias" field.
+func AliasNEQ(v string) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldNEQ(FieldAlias, v))
+}
+
+// AliasIn applies the In predicate on the "alias" field.
+func AliasIn(vs ...string) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldIn(FieldAlias, vs...))
+}
+
+// AliasNotIn applies the NotIn predicate on the "alias" field.
+func AliasNotIn(vs ...string) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldNotIn(FieldAlias, vs...))
+}
+
+// AliasGT applies the GT predicate on the "alias" field.
+func AliasGT(v string) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldGT(FieldAlias, v))
+}
+
+// AliasGTE applies the GTE predicate on the "alias" field.
+func AliasGTE(v string) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldGTE(FieldAlias, v))
+}
+
+// AliasLT applies the LT predicate on the "alias" field.
+func AliasLT(v string) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldLT(FieldAlias, v))
+}
+
+// AliasLTE applies the LTE predicate on the "alias" field.
+func AliasLTE(v string) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldLTE(FieldAlias, v))
+}
+
+// AliasContains applies the Contains predicate on the "alias" field.
+func AliasContains(v string) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldContains(FieldAlias, v))
+}
+
+// AliasHasPrefix applies the HasPrefix predicate on the "alias" field.
+func AliasHasPrefix(v string) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldHasPrefix(FieldAlias, v))
+}
+
+// AliasHasSuffix applies the HasSuffix predicate on the "alias" field.
+func AliasHasSuffix(v string) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldHasSuffix(FieldAlias, v))
+}
+
+// AliasEqualFold applies the EqualFold predicate on the "alias" field.
+func AliasEqualFold(v string) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldEqualFold(FieldAlias, v))
+}
+
+// AliasContainsFold applies the ContainsFold predicate on the "alias" field.
+func AliasContainsFold(v string) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldContainsFold(FieldAlias, v))
+}
+
+// NormalizedEQ applies the EQ predicate on the "normalized" field.
+func NormalizedEQ(v string) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldEQ(FieldNormalized, v))
+}
+
+// NormalizedNEQ applies the NEQ predicate on the "normalized" field.
+func NormalizedNEQ(v string) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldNEQ(FieldNormalized, v))
+}
+
+// NormalizedIn applies the In predicate on the "normalized" field.
+func NormalizedIn(vs ...string) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldIn(FieldNormalized, vs...))
+}
+
+// NormalizedNotIn applies the NotIn predicate on the "normalized" field.
+func NormalizedNotIn(vs ...string) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldNotIn(FieldNormalized, vs...))
+}
+
+// NormalizedGT applies the GT predicate on the "normalized" field.
+func NormalizedGT(v string) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldGT(FieldNormalized, v))
+}
+
+// NormalizedGTE applies the GTE predicate on the "normalized" field.
+func NormalizedGTE(v string) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldGTE(FieldNormalized, v))
+}
+
+// NormalizedLT applies the LT predicate on the "normalized" field.
+func NormalizedLT(v string) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldLT(FieldNormalized, v))
+}
+
+// NormalizedLTE applies the LTE predicate on the "normalized" field.
+func NormalizedLTE(v string) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldLTE(FieldNormalized, v))
+}
+
+// NormalizedContains applies the Contains predicate on the "normalized" field.
+func NormalizedContains(v string) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldContains(FieldNormalized, v))
+}
+
+// NormalizedHasPrefix applies the HasPrefix predicate on the "normalized" field.
+func NormalizedHasPrefix(v string) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldHasPrefix(FieldNormalized, v))
+}
+
+// NormalizedHasSuffix applies the HasSuffix predicate on the "normalized" field.
+func NormalizedHasSuffix(v string) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldHasSuffix(FieldNormalized, v))
+}
+
+// NormalizedEqualFold applies the EqualFold predicate on the "normalized" field.
+func NormalizedEqualFold(v string) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldEqualFold(FieldNormalized, v))
+}
+
+// NormalizedContainsFold applies the ContainsFold predicate on the "normalized" field.
+func NormalizedContainsFold(v string) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldContainsFold(FieldNormalized, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v string) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v string) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...string) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...string) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// KindGT applies the GT predicate on the "kind" field.
+func KindGT(v string) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldGT(FieldKind, v))
+}
+
+// KindGTE applies the GTE predicate on the "kind" field.
+func KindGTE(v string) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldGTE(FieldKind, v))
+}
+
+// KindLT applies the LT predicate on the "kind" field.
+func KindLT(v string) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldLT(FieldKind, v))
+}
+
+// KindLTE applies the LTE predicate on the "kind" field.
+func KindLTE(v string) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldLTE(FieldKind, v))
+}
+
+// KindContains applies the Contains predicate on the "kind" field.
+func KindContains(v string) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldContains(FieldKind, v))
+}
+
+// KindHasPrefix applies the HasPrefix predicate on the "kind" field.
+func KindHasPrefix(v string) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldHasPrefix(FieldKind, v))
+}
+
+// KindHasSuffix applies the HasSuffix predicate on the "kind" field.
+func KindHasSuffix(v string) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldHasSuffix(FieldKind, v))
+}
+
+// KindEqualFold applies the EqualFold predicate on the "kind" field.
+func KindEqualFold(v string) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldEqualFold(FieldKind, v))
+}
+
+// KindContainsFold applies the ContainsFold predicate on the "kind" field.
+func KindContainsFold(v string) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldContainsFold(FieldKind, v))
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v string) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldEQ(FieldSource, v))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v string) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldNEQ(FieldSource, v))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...string) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldIn(FieldSource, vs...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...string) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldNotIn(FieldSource, vs...))
+}
+
+// SourceGT applies the GT predicate on the "source" field.
+func SourceGT(v string) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldGT(FieldSource, v))
+}
+
+// SourceGTE applies the GTE predicate on the "source" field.
+func SourceGTE(v string) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldGTE(FieldSource, v))
+}
+
+// SourceLT applies the LT predicate on the "source" field.
+func SourceLT(v string) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldLT(FieldSource, v))
+}
+
+// SourceLTE applies the LTE predicate on the "source" field.
+func SourceLTE(v string) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldLTE(FieldSource, v))
+}
+
+// SourceContains applies the Contains predicate on the "source" field.
+func SourceContains(v string) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldContains(FieldSource, v))
+}
+
+// SourceHasPrefix applies the HasPrefix predicate on the "source" field.
+func SourceHasPrefix(v string) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldHasPrefix(FieldSource, v))
+}
+
+// SourceHasSuffix applies the HasSuffix predicate on the "source" field.
+func SourceHasSuffix(v string) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldHasSuffix(FieldSource, v))
+}
+
+// SourceEqualFold applies the EqualFold predicate on the "source" field.
+func SourceEqualFold(v string) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldEqualFold(FieldSource, v))
+}
+
+// SourceContainsFold applies the ContainsFold predicate on the "source" field.
+func SourceContainsFold(v string) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldContainsFold(FieldSource, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EntityAlias) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EntityAlias) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EntityAlias) predicate.EntityAlias {
+	return predicate.EntityAlias(sql.NotPredicates(p))
+}
@@ -0,0 +1,299 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Blogem/enron-graph/ent/entityalias"
+)
+
+// EntityAliasCreate is the builder for creating a EntityAlias entity.
+type EntityAliasCreate struct {
+	config
+	mutation *EntityAliasMutation
+	hooks    []Hook
+}
+
+// SetEntityID sets the "entity_id" field.
+func (_c *EntityAliasCreate) SetEntityID(v int) *EntityAliasCreate {
+	_c.mutation.SetEntityID(v)
+	return _c
+}
+
+// SetAlias sets the "alias" field.
+func (_c *EntityAliasCreate) SetAlias(v string) *EntityAliasCreate {
+	_c.mutation.SetAlias(v)
+	return _c
+}
+
+// SetNormalized sets the "normalized" field.
+func (_c *EntityAliasCreate) SetNormalized(v string) *EntityAliasCreate {
+	_c.mutation.SetNormalized(v)
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *EntityAliasCreate) SetKind(v string) *EntityAliasCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_c *EntityAliasCreate) SetNillableKind(v *string) *EntityAliasCreate {
+	if v != nil {
+		_c.SetKind(*v)
+	}
+	return _c
+}
+
+// SetSource sets the "source" field.
+func (_c *EntityAliasCreate) SetSource(v string) *EntityAliasCreate {
+	_c.mutation.SetSource(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *EntityAliasCreate) SetCreatedAt(v time.Time) *EntityAliasCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *EntityAliasCreate) SetNillableCreatedAt(v *time.Time) *EntityAliasCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the EntityAliasMutation object of the builder.
+func (_c *EntityAliasCreate) Mutation() *EntityAliasMutation {
+	return _c.mutation
+}
+
+// Save creates the EntityAlias in the database.
+func (_c *EntityAliasCreate) Save(ctx context.Context) (*EntityAlias, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *EntityAliasCreate) SaveX(ctx context.Context) *EntityAlias {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EntityAliasCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EntityAliasCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *EntityAliasCreate) defaults() {
+	if _, ok := _c.mutation.Kind(); !ok {
+		v := entityalias.DefaultKind
+		_c.mutation.SetKind(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := entityalias.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *EntityAliasCreate) check() error {
+	if _, ok := _c.mutation.EntityID(); !ok {
+		return &ValidationError{Name: "entity_id", err: errors.New(`ent: missing required field "EntityAlias.entity_id"`)}
+	}
+	if v, ok := _c.mutation.EntityID(); ok {
+		if err := entityalias.EntityIDValidator(v); err != nil {
+			return &ValidationError{Name: "entity_id", err: fmt.Errorf(`ent: validator failed for field "EntityAlias.entity_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Alias(); !ok {
+		return &ValidationError{Name: "alias", err: errors.New(`ent: missing required field "EntityAlias.alias"`)}
+	}
+	if v, ok := _c.mutation.Alias(); ok {
+		if err := entityalias.AliasValidator(v); err != nil {
+			return &ValidationError{Name: "alias", err: fmt.Errorf(`ent: validator failed for field "EntityAlias.alias": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Normalized(); !ok {
+		return &ValidationError{Name: "normalized", err: errors.New(`ent: missing required field "EntityAlias.normalized"`)}
+	}
+	if v, ok := _c.mutation.Normalized(); ok {
+		if err := entityalias.NormalizedValidator(v); err != nil {
+			return &ValidationError{Name: "normalized", err: fmt.Errorf(`ent: validator failed for field "EntityAlias.normalized": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "EntityAlias.kind"`)}
+	}
+	if _, ok := _c.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "EntityAlias.source"`)}
+	}
+	if v, ok := _c.mutation.Source(); ok {
+		if err := entityalias.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "EntityAlias.source": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "EntityAlias.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *EntityAliasCreate) sqlSave(ctx context.Context) (*EntityAlias, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *EntityAliasCreate) createSpec() (*EntityAlias, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EntityAlias{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(entityalias.Table, sqlgraph.NewFieldSpec(entityalias.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.EntityID(); ok {
+		_spec.SetField(entityalias.FieldEntityID, field.TypeInt, value)
+		_node.EntityID = value
+	}
+	if value, ok := _c.mutation.Alias(); ok {
+		_spec.SetField(entityalias.FieldAlias, field.TypeString, value)
+		_node.Alias = value
+	}
+	if value, ok := _c.mutation.Normalized(); ok {
+		_spec.SetField(entityalias.FieldNormalized, field.TypeString, value)
+		_node.Normalized = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(entityalias.FieldKind, field.TypeString, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.Source(); ok {
+		_spec.SetField(entityalias.FieldSource, field.TypeString, value)
+		_node.Source = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(entityalias.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// EntityAliasCreateBulk is the builder for creating many EntityAlias entities in bulk.
+type EntityAliasCreateBulk struct {
+	config
+	err      error
+	builders []*EntityAliasCreate
+}
+
+// Save creates the EntityAlias entities in the database.
+func (_c *EntityAliasCreateBulk) Save(ctx context.Context) ([]*EntityAlias, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*EntityAlias, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EntityAliasMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *EntityAliasCreateBulk) SaveX(ctx context.Context) []*EntityAlias {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EntityAliasCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EntityAliasCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,421 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Blogem/enron-graph/ent/entityalias"
+	"github.com/Blogem/enron-graph/ent/predicate"
+)
+
+// EntityAliasUpdate is the builder for updating EntityAlias entities.
+type EntityAliasUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EntityAliasMutation
+}
+
+// Where appends a list predicates to the EntityAliasUpdate builder.
+func (_u *EntityAliasUpdate) Where(ps ...predicate.EntityAlias) *EntityAliasUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetEntityID sets the "entity_id" field.
+func (_u *EntityAliasUpdate) SetEntityID(v int) *EntityAliasUpdate {
+	_u.mutation.ResetEntityID()
+	_u.mutation.SetEntityID(v)
+	return _u
+}
+
+// SetNillableEntityID sets the "entity_id" field if the given value is not nil.
+func (_u *EntityAliasUpdate) SetNillableEntityID(v *int) *EntityAliasUpdate {
+	if v != nil {
+		_u.SetEntityID(*v)
+	}
+	return _u
+}
+
+// AddEntityID adds value to the "entity_id" field.
+func (_u *EntityAliasUpdate) AddEntityID(v int) *EntityAliasUpdate {
+	_u.mutation.AddEntityID(v)
+	return _u
+}
+
+// SetAlias sets the "alias" field.
+func (_u *EntityAliasUpdate) SetAlias(v string) *EntityAliasUpdate {
+	_u.mutation.SetAlias(v)
+	return _u
+}
+
+// SetNillableAlias sets the "alias" field if the given value is not nil.
+func (_u *EntityAliasUpdate) SetNillableAlias(v *string) *EntityAliasUpdate {
+	if v != nil {
+		_u.SetAlias(*v)
+	}
+	return _u
+}
+
+// SetNormalized sets the "normalized" field.
+func (_u *EntityAliasUpdate) SetNormalized(v string) *EntityAliasUpdate {
+	_u.mutation.SetNormalized(v)
+	return _u
+}
+
+// SetNillableNormalized sets the "normalized" field if the given value is not nil.
+func (_u *EntityAliasUpdate) SetNillableNormalized(v *string) *EntityAliasUpdate {
+	if v != nil {
+		_u.SetNormalized(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *EntityAliasUpdate) SetKind(v string) *EntityAliasUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *EntityAliasUpdate) SetNillableKind(v *string) *EntityAliasUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *EntityAliasUpdate) SetSource(v string) *EntityAliasUpdate {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *EntityAliasUpdate) SetNillableSource(v *string) *EntityAliasUpdate {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// Mutation returns the EntityAliasMutation object of the builder.
+func (_u *EntityAliasUpdate) Mutation() *EntityAliasMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *EntityAliasUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EntityAliasUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *EntityAliasUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EntityAliasUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EntityAliasUpdate) check() error {
+	if v, ok := _u.mutation.EntityID(); ok {
+		if err := entityalias.EntityIDValidator(v); err != nil {
+			return &ValidationError{Name: "entity_id", err: fmt.Errorf(`ent: validator failed for field "EntityAlias.entity_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Alias(); ok {
+		if err := entityalias.AliasValidator(v); err != nil {
+			return &ValidationError{Name: "alias", err: fmt.Errorf(`ent: validator failed for field "EntityAlias.alias": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Normalized(); ok {
+		if err := entityalias.NormalizedValidator(v); err != nil {
+			return &ValidationError{Name: "normalized", err: fmt.Errorf(`ent: validator failed for field "EntityAlias.normalized": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Source(); ok {
+		if err := entityalias.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "EntityAlias.source": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *EntityAliasUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(entityalias.Table, entityalias.Columns, sqlgraph.NewFieldSpec(entityalias.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.EntityID(); ok {
+		_spec.SetField(entityalias.FieldEntityID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEntityID(); ok {
+		_spec.AddField(entityalias.FieldEntityID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Alias(); ok {
+		_spec.SetField(entityalias.FieldAlias, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Normalized(); ok {
+		_spec.SetField(entityalias.FieldNormalized, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(entityalias.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(entityalias.FieldSource, field.TypeString, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{entityalias.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// EntityAliasUpdateOne is the builder for updating a single EntityAlias entity.
+type EntityAliasUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EntityAliasMutation
+}
+
+// SetEntityID sets the "entity_id" field.
+func (_u *EntityAliasUpdateOne) SetEntityID(v int) *EntityAliasUpdateOne {
+	_u.mutation.ResetEntityID()
+	_u.mutation.SetEntityID(v)
+	return _u
+}
+
+// SetNillableEntityID sets the "entity_id" field if the given value is not nil.
+func (_u *EntityAliasUpdateOne) SetNillableEntityID(v *int) *EntityAliasUpdateOne {
+	if v != nil {
+		_u.SetEntityID(*v)
+	}
+	return _u
+}
+
+// AddEntityID adds value to the "entity_id" field.
+func (_u *EntityAliasUpdateOne) AddEntityID(v int) *EntityAliasUpdateOne {
+	_u.mutation.AddEntityID(v)
+	return _u
+}
+
+// SetAlias sets the "alias" field.
+func (_u *EntityAliasUpdateOne) SetAlias(v string) *EntityAliasUpdateOne {
+	_u.mutation.SetAlias(v)
+	return _u
+}
+
+// SetNillableAlias sets the "alias" field if the given value is not nil.
+func (_u *EntityAliasUpdateOne) SetNillableAlias(v *string) *EntityAliasUpdateOne {
+	if v != nil {
+		_u.SetAlias(*v)
+	}
+	return _u
+}
+
+// SetNormalized sets the "normalized" field.
+func (_u *EntityAliasUpdateOne) SetNormalized(v string) *EntityAliasUpdateOne {
+	_u.mutation.SetNormalized(v)
+	return _u
+}
+
+// SetNillableNormalized sets the "normalized" field if the given value is not nil.
+func (_u *EntityAliasUpdateOne) SetNillableNormalized(v *string) *EntityAliasUpdateOne {
+	if v != nil {
+		_u.SetNormalized(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *EntityAliasUpdateOne) SetKind(v string) *EntityAliasUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *EntityAliasUpdateOne) SetNillableKind(v *string) *EntityAliasUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *EntityAliasUpdateOne) SetSource(v string) *EntityAliasUpdateOne {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *EntityAliasUpdateOne) SetNillableSource(v *string) *EntityAliasUpdateOne {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// Mutation returns the EntityAliasMutation object of the builder.
+func (_u *EntityAliasUpdateOne) Mutation() *EntityAliasMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the EntityAliasUpdate builder.
+func (_u *EntityAliasUpdateOne) Where(ps ...predicate.EntityAlias) *EntityAliasUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *EntityAliasUpdateOne) Select(field string, fields ...string) *EntityAliasUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated EntityAlias entity.
+func (_u *EntityAliasUpdateOne) Save(ctx context.Context) (*EntityAlias, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EntityAliasUpdateOne) SaveX(ctx context.Context) *EntityAlias {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *EntityAliasUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EntityAliasUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EntityAliasUpdateOne) check() error {
+	if v, ok := _u.mutation.EntityID(); ok {
+		if err := entityalias.EntityIDValidator(v); err != nil {
+			return &ValidationError{Name: "entity_id", err: fmt.Errorf(`ent: validator failed for field "EntityAlias.entity_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Alias(); ok {
+		if err := entityalias.AliasValidator(v); err != nil {
+			return &ValidationError{Name: "alias", err: fmt.Errorf(`ent: validator failed for field "EntityAlias.alias": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Normalized(); ok {
+		if err := entityalias.NormalizedValidator(v); err != nil {
+			return &ValidationError{Name: "normalized", err: fmt.Errorf(`ent: validator failed for field "EntityAlias.normalized": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Source(); ok {
+		if err := entityalias.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "EntityAlias.source": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *EntityAliasUpdateOne) sqlSave(ctx context.Context) (_node *EntityAlias, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(entityalias.Table, entityalias.Columns, sqlgraph.NewFieldSpec(entityalias.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EntityAlias.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, entityalias.FieldID)
+		for _, f := range fields {
+			if !entityalias.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != entityalias.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.EntityID(); ok {
+		_spec.SetField(entityalias.FieldEntityID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEntityID(); ok {
+		_spec.AddField(entityalias.FieldEntityID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Alias(); ok {
+		_spec.SetField(entityalias.FieldAlias, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Normalized(); ok {
+		_spec.SetField(entityalias.FieldNormalized, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(entityalias.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(entityalias.FieldSource, field.TypeString, value)
+	}
+	_node = &EntityAlias{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{entityalias.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
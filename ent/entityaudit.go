@@ -0,0 +1,179 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Blogem/enron-graph/ent/entityaudit"
+)
+
+// EntityAudit is the model entity for the EntityAudit schema.
+type EntityAudit struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Curation operation: merge or split
+	Operation string `json:"operation,omitempty"`
+	// Surviving entity of a merge, or source entity of a split
+	EntityID int `json:"entity_id,omitempty"`
+	// Entities merged away, or the entity created by a split
+	RelatedIds []int `json:"related_ids,omitempty"`
+	// Who performed the operation (CLI user, api, explorer)
+	Actor string `json:"actor,omitempty"`
+	// Free-text justification
+	Reason string `json:"reason,omitempty"`
+	// Snapshots of the affected entities and moved relationship IDs
+	Details map[string]interface{} `json:"details,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EntityAudit) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case entityaudit.FieldRelatedIds, entityaudit.FieldDetails:
+			values[i] = new([]byte)
+		case entityaudit.FieldID, entityaudit.FieldEntityID:
+			values[i] = new(sql.NullInt64)
+		case entityaudit.FieldOperation, entityaudit.FieldActor, entityaudit.FieldReason:
+			values[i] = new(sql.NullString)
+		case entityaudit.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EntityAudit fields.
+func (_m *EntityAudit) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case entityaudit.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case entityaudit.FieldOperation:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field operation", values[i])
+			} else if value.Valid {
+				_m.Operation = value.String
+			}
+		case entityaudit.FieldEntityID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field entity_id", values[i])
+			} else if value.Valid {
+				_m.EntityID = int(value.Int64)
+			}
+		case entityaudit.FieldRelatedIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field related_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.RelatedIds); err != nil {
+					return fmt.Errorf("unmarshal field related_ids: %w", err)
+				}
+			}
+		case entityaudit.FieldActor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field actor", values[i])
+			} else if value.Valid {
+				_m.Actor = value.String
+			}
+		case entityaudit.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = value.String
+			}
+		case entityaudit.FieldDetails:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field details", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Details); err != nil {
+					return fmt.Errorf("unmarshal field details: %w", err)
+				}
+			}
+		case entityaudit.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EntityAudit.
+// This includes values selected through modifiers, order, etc.
+func (_m *EntityAudit) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this EntityAudit.
+// Note that you need to call EntityAudit.Unwrap() before calling this method if this EntityAudit
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *EntityAudit) Update() *EntityAuditUpdateOne {
+	return NewEntityAuditClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the EntityAudit entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *EntityAudit) Unwrap() *EntityAudit {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: EntityAudit is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *EntityAudit) String() string {
+	var builder strings.Builder
+	builder.WriteString("EntityAudit(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("operation=")
+	builder.WriteString(_m.Operation)
+	builder.WriteString(", ")
+	builder.WriteString("entity_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EntityID))
+	builder.WriteString(", ")
+	builder.WriteString("related_ids=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RelatedIds))
+	builder.WriteString(", ")
+	builder.WriteString("actor=")
+	builder.WriteString(_m.Actor)
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(_m.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("details=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Details))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EntityAudits is a parsable slice of EntityAudit.
+type EntityAudits []*EntityAudit
@@ -0,0 +1,294 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Blogem/enron-graph/ent/entityaudit"
+)
+
+// EntityAuditCreate is the builder for creating a EntityAudit entity.
+type EntityAuditCreate struct {
+	config
+	mutation *EntityAuditMutation
+	hooks    []Hook
+}
+
+// SetOperation sets the "operation" field.
+func (_c *EntityAuditCreate) SetOperation(v string) *EntityAuditCreate {
+	_c.mutation.SetOperation(v)
+	return _c
+}
+
+// SetEntityID sets the "entity_id" field.
+func (_c *EntityAuditCreate) SetEntityID(v int) *EntityAuditCreate {
+	_c.mutation.SetEntityID(v)
+	return _c
+}
+
+// SetRelatedIds sets the "related_ids" field.
+func (_c *EntityAuditCreate) SetRelatedIds(v []int) *EntityAuditCreate {
+	_c.mutation.SetRelatedIds(v)
+	return _c
+}
+
+// SetActor sets the "actor" field.
+func (_c *EntityAuditCreate) SetActor(v string) *EntityAuditCreate {
+	_c.mutation.SetActor(v)
+	return _c
+}
+
+// SetNillableActor sets the "actor" field if the given value is not nil.
+func (_c *EntityAuditCreate) SetNillableActor(v *string) *EntityAuditCreate {
+	if v != nil {
+		_c.SetActor(*v)
+	}
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *EntityAuditCreate) SetReason(v string) *EntityAuditCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_c *EntityAuditCreate) SetNillableReason(v *string) *EntityAuditCreate {
+	if v != nil {
+		_c.SetReason(*v)
+	}
+	return _c
+}
+
+// SetDetails sets the "details" field.
+func (_c *EntityAuditCreate) SetDetails(v map[string]interface{}) *EntityAuditCreate {
+	_c.mutation.SetDetails(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *EntityAuditCreate) SetCreatedAt(v time.Time) *EntityAuditCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *EntityAuditCreate) SetNillableCreatedAt(v *time.Time) *EntityAuditCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the EntityAuditMutation object of the builder.
+func (_c *EntityAuditCreate) Mutation() *EntityAuditMutation {
+	return _c.mutation
+}
+
+// Save creates the EntityAudit in the database.
+func (_c *EntityAuditCreate) Save(ctx context.Context) (*EntityAudit, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *EntityAuditCreate) SaveX(ctx context.Context) *EntityAudit {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EntityAuditCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EntityAuditCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *EntityAuditCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := entityaudit.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *EntityAuditCreate) check() error {
+	if _, ok := _c.mutation.Operation(); !ok {
+		return &ValidationError{Name: "operation", err: errors.New(`ent: missing required field "EntityAudit.operation"`)}
+	}
+	if v, ok := _c.mutation.Operation(); ok {
+		if err := entityaudit.OperationValidator(v); err != nil {
+			return &ValidationError{Name: "operation", err: fmt.Errorf(`ent: validator failed for field "EntityAudit.operation": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.EntityID(); !ok {
+		return &ValidationError{Name: "entity_id", err: errors.New(`ent: missing required field "EntityAudit.entity_id"`)}
+	}
+	if v, ok := _c.mutation.EntityID(); ok {
+		if err := entityaudit.EntityIDValidator(v); err != nil {
+			return &ValidationError{Name: "entity_id", err: fmt.Errorf(`ent: validator failed for field "EntityAudit.entity_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "EntityAudit.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *EntityAuditCreate) sqlSave(ctx context.Context) (*EntityAudit, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *EntityAuditCreate) createSpec() (*EntityAudit, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EntityAudit{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(entityaudit.Table, sqlgraph.NewFieldSpec(entityaudit.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Operation(); ok {
+		_spec.SetField(entityaudit.FieldOperation, field.TypeString, value)
+		_node.Operation = value
+	}
+	if value, ok := _c.mutation.EntityID(); ok {
+		_spec.SetField(entityaudit.FieldEntityID, field.TypeInt, value)
+		_node.EntityID = value
+	}
+	if value, ok := _c.mutation.RelatedIds(); ok {
+		_spec.SetField(entityaudit.FieldRelatedIds, field.TypeJSON, value)
+		_node.RelatedIds = value
+	}
+	if value, ok := _c.mutation.Actor(); ok {
+		_spec.SetField(entityaudit.FieldActor, field.TypeString, value)
+		_node.Actor = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(entityaudit.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.Details(); ok {
+		_spec.SetField(entityaudit.FieldDetails, field.TypeJSON, value)
+		_node.Details = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(entityaudit.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// EntityAuditCreateBulk is the builder for creating many EntityAudit entities in bulk.
+type EntityAuditCreateBulk struct {
+	config
+	err      error
+	builders []*EntityAuditCreate
+}
+
+// Save creates the EntityAudit entities in the database.
+func (_c *EntityAuditCreateBulk) Save(ctx context.Context) ([]*EntityAudit, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*EntityAudit, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EntityAuditMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *EntityAuditCreateBulk) SaveX(ctx context.Context) []*EntityAudit {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EntityAuditCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EntityAuditCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
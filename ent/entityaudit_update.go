@@ -0,0 +1,498 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/Blogem/enron-graph/ent/entityaudit"
+	"github.com/Blogem/enron-graph/ent/predicate"
+)
+
+// EntityAuditUpdate is the builder for updating EntityAudit entities.
+type EntityAuditUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EntityAuditMutation
+}
+
+// Where appends a list predicates to the EntityAuditUpdate builder.
+func (_u *EntityAuditUpdate) Where(ps ...predicate.EntityAudit) *EntityAuditUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetOperation sets the "operation" field.
+func (_u *EntityAuditUpdate) SetOperation(v string) *EntityAuditUpdate {
+	_u.mutation.SetOperation(v)
+	return _u
+}
+
+// SetNillableOperation sets the "operation" field if the given value is not nil.
+func (_u *EntityAuditUpdate) SetNillableOperation(v *string) *EntityAuditUpdate {
+	if v != nil {
+		_u.SetOperation(*v)
+	}
+	return _u
+}
+
+// SetEntityID sets the "entity_id" field.
+func (_u *EntityAuditUpdate) SetEntityID(v int) *EntityAuditUpdate {
+	_u.mutation.ResetEntityID()
+	_u.mutation.SetEntityID(v)
+	return _u
+}
+
+// SetNillableEntityID sets the "entity_id" field if the given value is not nil.
+func (_u *EntityAuditUpdate) SetNillableEntityID(v *int) *EntityAuditUpdate {
+	if v != nil {
+		_u.SetEntityID(*v)
+	}
+	return _u
+}
+
+// AddEntityID adds value to the "entity_id" field.
+func (_u *EntityAuditUpdate) AddEntityID(v int) *EntityAuditUpdate {
+	_u.mutation.AddEntityID(v)
+	return _u
+}
+
+// SetRelatedIds sets the "related_ids" field.
+func (_u *EntityAuditUpdate) SetRelatedIds(v []int) *EntityAuditUpdate {
+	_u.mutation.SetRelatedIds(v)
+	return _u
+}
+
+// AppendRelatedIds appends value to the "related_ids" field.
+func (_u *EntityAuditUpdate) AppendRelatedIds(v []int) *EntityAuditUpdate {
+	_u.mutation.AppendRelatedIds(v)
+	return _u
+}
+
+// ClearRelatedIds clears the value of the "related_ids" field.
+func (_u *EntityAuditUpdate) ClearRelatedIds() *EntityAuditUpdate {
+	_u.mutation.ClearRelatedIds()
+	return _u
+}
+
+// SetActor sets the "actor" field.
+func (_u *EntityAuditUpdate) SetActor(v string) *EntityAuditUpdate {
+	_u.mutation.SetActor(v)
+	return _u
+}
+
+// SetNillableActor sets the "actor" field if the given value is not nil.
+func (_u *EntityAuditUpdate) SetNillableActor(v *string) *EntityAuditUpdate {
+	if v != nil {
+		_u.SetActor(*v)
+	}
+	return _u
+}
+
+// ClearActor clears the value of the "actor" field.
+func (_u *EntityAuditUpdate) ClearActor() *EntityAuditUpdate {
+	_u.mutation.ClearActor()
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *EntityAuditUpdate) SetReason(v string) *EntityAuditUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *EntityAuditUpdate) SetNillableReason(v *string) *EntityAuditUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *EntityAuditUpdate) ClearReason() *EntityAuditUpdate {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetDetails sets the "details" field.
+func (_u *EntityAuditUpdate) SetDetails(v map[string]interface{}) *EntityAuditUpdate {
+	_u.mutation.SetDetails(v)
+	return _u
+}
+
+// ClearDetails clears the value of the "details" field.
+func (_u *EntityAuditUpdate) ClearDetails() *EntityAuditUpdate {
+	_u.mutation.ClearDetails()
+	return _u
+}
+
+// Mutation returns the EntityAuditMutation object of the builder.
+func (_u *EntityAuditUpdate) Mutation() *EntityAuditMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *EntityAuditUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EntityAuditUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *EntityAuditUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EntityAuditUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EntityAuditUpdate) check() error {
+	if v, ok := _u.mutation.Operation(); ok {
+		if err := entityaudit.OperationValidator(v); err != nil {
+			return &ValidationError{Name: "operation", err: fmt.Errorf(`ent: validator failed for field "EntityAudit.operation": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EntityID(); ok {
+		if err := entityaudit.EntityIDValidator(v); err != nil {
+			return &ValidationError{Name: "entity_id", err: fmt.Errorf(`ent: validator failed for field "EntityAudit.entity_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *EntityAuditUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(entityaudit.Table, entityaudit.Columns, sqlgraph.NewFieldSpec(entityaudit.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Operation(); ok {
+		_spec.SetField(entityaudit.FieldOperation, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EntityID(); ok {
+		_spec.SetField(entityaudit.FieldEntityID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEntityID(); ok {
+		_spec.AddField(entityaudit.FieldEntityID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RelatedIds(); ok {
+		_spec.SetField(entityaudit.FieldRelatedIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRelatedIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, entityaudit.FieldRelatedIds, value)
+		})
+	}
+	if _u.mutation.RelatedIdsCleared() {
+		_spec.ClearField(entityaudit.FieldRelatedIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Actor(); ok {
+		_spec.SetField(entityaudit.FieldActor, field.TypeString, value)
+	}
+	if _u.mutation.ActorCleared() {
+		_spec.ClearField(entityaudit.FieldActor, field.TypeString)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(entityaudit.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(entityaudit.FieldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.Details(); ok {
+		_spec.SetField(entityaudit.FieldDetails, field.TypeJSON, value)
+	}
+	if _u.mutation.DetailsCleared() {
+		_spec.ClearField(entityaudit.FieldDetails, field.TypeJSON)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{entityaudit.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// EntityAuditUpdateOne is the builder for updating a single EntityAudit entity.
+type EntityAuditUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EntityAuditMutation
+}
+
+// SetOperation sets the "operation" field.
+func (_u *EntityAuditUpdateOne) SetOperation(v string) *EntityAuditUpdateOne {
+	_u.mutation.SetOperation(v)
+	return _u
+}
+
+// SetNillableOperation sets the "operation" field if the given value is not nil.
+func (_u *EntityAuditUpdateOne) SetNillableOperation(v *string) *EntityAuditUpdateOne {
+	if v != nil {
+		_u.SetOperation(*v)
+	}
+	return _u
+}
+
+// SetEntityID sets the "entity_id" field.
+func (_u *EntityAuditUpdateOne) SetEntityID(v int) *EntityAuditUpdateOne {
+	_u.mutation.ResetEntityID()
+	_u.mutation.SetEntityID(v)
+	return _u
+}
+
+// SetNillableEntityID sets the "entity_id" field if the given value is not nil.
+func (_u *EntityAuditUpdateOne) SetNillableEntityID(v *int) *EntityAuditUpdateOne {
+	if v != nil {
+		_u.SetEntityID(*v)
+	}
+	return _u
+}
+
+// AddEntityID adds value to the "entity_id" field.
+func (_u *EntityAuditUpdateOne) AddEntityID(v int) *EntityAuditUpdateOne {
+	_u.mutation.AddEntityID(v)
+	return _u
+}
+
+// SetRelatedIds sets the "related_ids" field.
+func (_u *EntityAuditUpdateOne) SetRelatedIds(v []int) *EntityAuditUpdateOne {
+	_u.mutation.SetRelatedIds(v)
+	return _u
+}
+
+// AppendRelatedIds appends value to the "related_ids" field.
+func (_u *EntityAuditUpdateOne) AppendRelatedIds(v []int) *EntityAuditUpdateOne {
+	_u.mutation.AppendRelatedIds(v)
+	return _u
+}
+
+// ClearRelatedIds clears the value of the "related_ids" field.
+func (_u *EntityAuditUpdateOne) ClearRelatedIds() *EntityAuditUpdateOne {
+	_u.mutation.ClearRelatedIds()
+	return _u
+}
+
+// SetActor sets the "actor" field.
+func (_u *EntityAuditUpdateOne) SetActor(v string) *EntityAuditUpdateOne {
+	_u.mutation.SetActor(v)
+	return _u
+}
+
+// SetNillableActor sets the "actor" field if the given value is not nil.
+func (_u *EntityAuditUpdateOne) SetNillableActor(v *string) *EntityAuditUpdateOne {
+	if v != nil {
+		_u.SetActor(*v)
+	}
+	return _u
+}
+
+// ClearActor clears the value of the "actor" field.
+func (_u *EntityAuditUpdateOne) ClearActor() *EntityAuditUpdateOne {
+	_u.mutation.ClearActor()
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *EntityAuditUpdateOne) SetReason(v string) *EntityAuditUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *EntityAuditUpdateOne) SetNillableReason(v *string) *EntityAuditUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *EntityAuditUpdateOne) ClearReason() *EntityAuditUpdateOne {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetDetails sets the "details" field.
+func (_u *EntityAuditUpdateOne) SetDetails(v map[string]interface{}) *EntityAuditUpdateOne {
+	_u.mutation.SetDetails(v)
+	return _u
+}
+
+// ClearDetails clears the value of the "details" field.
+func (_u *EntityAuditUpdateOne) ClearDetails() *EntityAuditUpdateOne {
+	_u.mutation.ClearDetails()
+	return _u
+}
+
+// Mutation returns the EntityAuditMutation object of the builder.
+func (_u *EntityAuditUpdateOne) Mutation() *EntityAuditMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the EntityAuditUpdate builder.
+func (_u *EntityAuditUpdateOne) Where(ps ...predicate.EntityAudit) *EntityAuditUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *EntityAuditUpdateOne) Select(field string, fields ...string) *EntityAuditUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated EntityAudit entity.
+func (_u *EntityAuditUpdateOne) Save(ctx context.Context) (*EntityAudit, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EntityAuditUpdateOne) SaveX(ctx context.Context) *EntityAudit {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *EntityAuditUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EntityAuditUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EntityAuditUpdateOne) check() error {
+	if v, ok := _u.mutation.Operation(); ok {
+		if err := entityaudit.OperationValidator(v); err != nil {
+			return &ValidationError{Name: "operation", err: fmt.Errorf(`ent: validator failed for field "EntityAudit.operation": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EntityID(); ok {
+		if err := entityaudit.EntityIDValidator(v); err != nil {
+			return &ValidationError{Name: "entity_id", err: fmt.Errorf(`ent: validator failed for field "EntityAudit.entity_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *EntityAuditUpdateOne) sqlSave(ctx context.Context) (_node *EntityAudit, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(entityaudit.Table, entityaudit.Columns, sqlgraph.NewFieldSpec(entityaudit.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EntityAudit.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, entityaudit.FieldID)
+		for _, f := range fields {
+			if !entityaudit.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != entityaudit.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Operation(); ok {
+		_spec.SetField(entityaudit.FieldOperation, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EntityID(); ok {
+		_spec.SetField(entityaudit.FieldEntityID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEntityID(); ok {
+		_spec.AddField(entityaudit.FieldEntityID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RelatedIds(); ok {
+		_spec.SetField(entityaudit.FieldRelatedIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRelatedIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, entityaudit.FieldRelatedIds, value)
+		})
+	}
+	if _u.mutation.RelatedIdsCleared() {
+		_spec.ClearField(entityaudit.FieldRelatedIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Actor(); ok {
+		_spec.SetField(entityaudit.FieldActor, field.TypeString, value)
+	}
+	if _u.mutation.ActorCleared() {
+		_spec.ClearField(entityaudit.FieldActor, field.TypeString)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(entityaudit.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(entityaudit.FieldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.Details(); ok {
+		_spec.SetField(entityaudit.FieldDetails, field.TypeJSON, value)
+	}
+	if _u.mutation.DetailsCleared() {
+		_spec.ClearField(entityaudit.FieldDetails, field.TypeJSON)
+	}
+	_node = &EntityAudit{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{entityaudit.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,800 @@
+// Code generated by ent, DO NOT EDIT.
+
+package extractionjob
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Blogem/enron-graph/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldLTE(FieldID, id))
+}
+
+// EmailID applies equality check predicate on the "email_id" field. It's identical to EmailIDEQ.
+func EmailID(v int) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldEQ(FieldEmailID, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldEQ(FieldStatus, v))
+}
+
+// Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
+func Attempts(v int) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldEQ(FieldAttempts, v))
+}
+
+// LastError applies equality check predicate on the "last_error" field. It's identical to LastErrorEQ.
+func LastError(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldEQ(FieldLastError, v))
+}
+
+// Model applies equality check predicate on the "model" field. It's identical to ModelEQ.
+func Model(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldEQ(FieldModel, v))
+}
+
+// PromptVersion applies equality check predicate on the "prompt_version" field. It's identical to PromptVersionEQ.
+func PromptVersion(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldEQ(FieldPromptVersion, v))
+}
+
+// Batch applies equality check predicate on the "batch" field. It's identical to BatchEQ.
+func Batch(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldEQ(FieldBatch, v))
+}
+
+// Worker applies equality check predicate on the "worker" field. It's identical to WorkerEQ.
+func Worker(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldEQ(FieldWorker, v))
+}
+
+// ClaimedAt applies equality check predicate on the "claimed_at" field. It's identical to ClaimedAtEQ.
+func ClaimedAt(v time.Time) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldEQ(FieldClaimedAt, v))
+}
+
+// CompletedAt applies equality check predicate on the "completed_at" field. It's identical to CompletedAtEQ.
+func CompletedAt(v time.Time) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// EmailIDEQ applies the EQ predicate on the "email_id" field.
+func EmailIDEQ(v int) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldEQ(FieldEmailID, v))
+}
+
+// EmailIDNEQ applies the NEQ predicate on the "email_id" field.
+func EmailIDNEQ(v int) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldNEQ(FieldEmailID, v))
+}
+
+// EmailIDIn applies the In predicate on the "email_id" field.
+func EmailIDIn(vs ...int) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldIn(FieldEmailID, vs...))
+}
+
+// EmailIDNotIn applies the NotIn predicate on the "email_id" field.
+func EmailIDNotIn(vs ...int) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldNotIn(FieldEmailID, vs...))
+}
+
+// EmailIDGT applies the GT predicate on the "email_id" field.
+func EmailIDGT(v int) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldGT(FieldEmailID, v))
+}
+
+// EmailIDGTE applies the GTE predicate on the "email_id" field.
+func EmailIDGTE(v int) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldGTE(FieldEmailID, v))
+}
+
+// EmailIDLT applies the LT predicate on the "email_id" field.
+func EmailIDLT(v int) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldLT(FieldEmailID, v))
+}
+
+// EmailIDLTE applies the LTE predicate on the "email_id" field.
+func EmailIDLTE(v int) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldLTE(FieldEmailID, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// AttemptsEQ applies the EQ predicate on the "attempts" field.
+func AttemptsEQ(v int) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldEQ(FieldAttempts, v))
+}
+
+// AttemptsNEQ applies the NEQ predicate on the "attempts" field.
+func AttemptsNEQ(v int) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldNEQ(FieldAttempts, v))
+}
+
+// AttemptsIn applies the In predicate on the "attempts" field.
+func AttemptsIn(vs ...int) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldIn(FieldAttempts, vs...))
+}
+
+// AttemptsNotIn applies the NotIn predicate on the "attempts" field.
+func AttemptsNotIn(vs ...int) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldNotIn(FieldAttempts, vs...))
+}
+
+// AttemptsGT applies the GT predicate on the "attempts" field.
+func AttemptsGT(v int) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldGT(FieldAttempts, v))
+}
+
+// AttemptsGTE applies the GTE predicate on the "attempts" field.
+func AttemptsGTE(v int) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldGTE(FieldAttempts, v))
+}
+
+// AttemptsLT applies the LT predicate on the "attempts" field.
+func AttemptsLT(v int) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldLT(FieldAttempts, v))
+}
+
+// AttemptsLTE applies the LTE predicate on the "attempts" field.
+func AttemptsLTE(v int) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldLTE(FieldAttempts, v))
+}
+
+// LastErrorEQ applies the EQ predicate on the "last_error" field.
+func LastErrorEQ(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldEQ(FieldLastError, v))
+}
+
+// LastErrorNEQ applies the NEQ predicate on the "last_error" field.
+func LastErrorNEQ(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldNEQ(FieldLastError, v))
+}
+
+// LastErrorIn applies the In predicate on the "last_error" field.
+func LastErrorIn(vs ...string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldIn(FieldLastError, vs...))
+}
+
+// LastErrorNotIn applies the NotIn predicate on the "last_error" field.
+func LastErrorNotIn(vs ...string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldNotIn(FieldLastError, vs...))
+}
+
+// LastErrorGT applies the GT predicate on the "last_error" field.
+func LastErrorGT(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldGT(FieldLastError, v))
+}
+
+// LastErrorGTE applies the GTE predicate on the "last_error" field.
+func LastErrorGTE(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldGTE(FieldLastError, v))
+}
+
+// LastErrorLT applies the LT predicate on the "last_error" field.
+func LastErrorLT(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldLT(FieldLastError, v))
+}
+
+// LastErrorLTE applies the LTE predicate on the "last_error" field.
+func LastErrorLTE(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldLTE(FieldLastError, v))
+}
+
+// LastErrorContains applies the Contains predicate on the "last_error" field.
+func LastErrorContains(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldContains(FieldLastError, v))
+}
+
+// LastErrorHasPrefix applies the HasPrefix predicate on the "last_error" field.
+func LastErrorHasPrefix(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldHasPrefix(FieldLastError, v))
+}
+
+// LastErrorHasSuffix applies the HasSuffix predicate on the "last_error" field.
+func LastErrorHasSuffix(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldHasSuffix(FieldLastError, v))
+}
+
+// LastErrorIsNil applies the IsNil predicate on the "last_error" field.
+func LastErrorIsNil() predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldIsNull(FieldLastError))
+}
+
+// LastErrorNotNil applies the NotNil predicate on the "last_error" field.
+func LastErrorNotNil() predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldNotNull(FieldLastError))
+}
+
+// LastErrorEqualFold applies the EqualFold predicate on the "last_error" field.
+func LastErrorEqualFold(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldEqualFold(FieldLastError, v))
+}
+
+// LastErrorContainsFold applies the ContainsFold predicate on the "last_error" field.
+func LastErrorContainsFold(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldContainsFold(FieldLastError, v))
+}
+
+// ModelEQ applies the EQ predicate on the "model" field.
+func ModelEQ(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldEQ(FieldModel, v))
+}
+
+// ModelNEQ applies the NEQ predicate on the "model" field.
+func ModelNEQ(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldNEQ(FieldModel, v))
+}
+
+// ModelIn applies the In predicate on the "model" field.
+func ModelIn(vs ...string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldIn(FieldModel, vs...))
+}
+
+// ModelNotIn applies the NotIn predicate on the "model" field.
+func ModelNotIn(vs ...string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldNotIn(FieldModel, vs...))
+}
+
+// ModelGT applies the GT predicate on the "model" field.
+func ModelGT(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldGT(FieldModel, v))
+}
+
+// ModelGTE applies the GTE predicate on the "model" field.
+func ModelGTE(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldGTE(FieldModel, v))
+}
+
+// ModelLT applies the LT predicate on the "model" field.
+func ModelLT(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldLT(FieldModel, v))
+}
+
+// ModelLTE applies the LTE predicate on the "model" field.
+func ModelLTE(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldLTE(FieldModel, v))
+}
+
+// ModelContains applies the Contains predicate on the "model" field.
+func ModelContains(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldContains(FieldModel, v))
+}
+
+// ModelHasPrefix applies the HasPrefix predicate on the "model" field.
+func ModelHasPrefix(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldHasPrefix(FieldModel, v))
+}
+
+// ModelHasSuffix applies the HasSuffix predicate on the "model" field.
+func ModelHasSuffix(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldHasSuffix(FieldModel, v))
+}
+
+// ModelEqualFold applies the EqualFold predicate on the "model" field.
+func ModelEqualFold(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldEqualFold(FieldModel, v))
+}
+
+// ModelContainsFold applies the ContainsFold predicate on the "model" field.
+func ModelContainsFold(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldContainsFold(FieldModel, v))
+}
+
+// PromptVersionEQ applies the EQ predicate on the "prompt_version" field.
+func PromptVersionEQ(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldEQ(FieldPromptVersion, v))
+}
+
+// PromptVersionNEQ applies the NEQ predicate on the "prompt_version" field.
+func PromptVersionNEQ(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldNEQ(FieldPromptVersion, v))
+}
+
+// PromptVersionIn applies the In predicate on the "prompt_version" field.
+func PromptVersionIn(vs ...string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldIn(FieldPromptVersion, vs...))
+}
+
+// PromptVersionNotIn applies the NotIn predicate on the "prompt_version" field.
+func PromptVersionNotIn(vs ...string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldNotIn(FieldPromptVersion, vs...))
+}
+
+// PromptVersionGT applies the GT predicate on the "prompt_version" field.
+func PromptVersionGT(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldGT(FieldPromptVersion, v))
+}
+
+// PromptVersionGTE applies the GTE predicate on the "prompt_version" field.
+func PromptVersionGTE(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldGTE(FieldPromptVersion, v))
+}
+
+// PromptVersionLT applies the LT predicate on the "prompt_version" field.
+func PromptVersionLT(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldLT(FieldPromptVersion, v))
+}
+
+// PromptVersionLTE applies the LTE predicate on the "prompt_version" field.
+func PromptVersionLTE(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldLTE(FieldPromptVersion, v))
+}
+
+// PromptVersionContains applies the Contains predicate on the "prompt_version" field.
+func PromptVersionContains(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldContains(FieldPromptVersion, v))
+}
+
+// PromptVersionHasPrefix applies the HasPrefix predicate on the "prompt_version" field.
+func PromptVersionHasPrefix(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldHasPrefix(FieldPromptVersion, v))
+}
+
+// PromptVersionHasSuffix applies the HasSuffix predicate on the "prompt_version" field.
+func PromptVersionHasSuffix(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldHasSuffix(FieldPromptVersion, v))
+}
+
+// PromptVersionEqualFold applies the EqualFold predicate on the "prompt_version" field.
+func PromptVersionEqualFold(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldEqualFold(FieldPromptVersion, v))
+}
+
+// PromptVersionContainsFold applies the ContainsFold predicate on the "prompt_version" field.
+func PromptVersionContainsFold(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldContainsFold(FieldPromptVersion, v))
+}
+
+// BatchEQ applies the EQ predicate on the "batch" field.
+func BatchEQ(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldEQ(FieldBatch, v))
+}
+
+// BatchNEQ applies the NEQ predicate on the "batch" field.
+func BatchNEQ(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldNEQ(FieldBatch, v))
+}
+
+// BatchIn applies the In predicate on the "batch" field.
+func BatchIn(vs ...string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldIn(FieldBatch, vs...))
+}
+
+// BatchNotIn applies the NotIn predicate on the "batch" field.
+func BatchNotIn(vs ...string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldNotIn(FieldBatch, vs...))
+}
+
+// BatchGT applies the GT predicate on the "batch" field.
+func BatchGT(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldGT(FieldBatch, v))
+}
+
+// BatchGTE applies the GTE predicate on the "batch" field.
+func BatchGTE(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldGTE(FieldBatch, v))
+}
+
+// BatchLT applies the LT predicate on the "batch" field.
+func BatchLT(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldLT(FieldBatch, v))
+}
+
+// BatchLTE applies the LTE predicate on the "batch" field.
+func BatchLTE(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldLTE(FieldBatch, v))
+}
+
+// BatchContains applies the Contains predicate on the "batch" field.
+func BatchContains(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldContains(FieldBatch, v))
+}
+
+// BatchHasPrefix applies the HasPrefix predicate on the "batch" field.
+func BatchHasPrefix(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldHasPrefix(FieldBatch, v))
+}
+
+// BatchHasSuffix applies the HasSuffix predicate on the "batch" field.
+func BatchHasSuffix(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldHasSuffix(FieldBatch, v))
+}
+
+// BatchEqualFold applies the EqualFold predicate on the "batch" field.
+func BatchEqualFold(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldEqualFold(FieldBatch, v))
+}
+
+// BatchContainsFold applies the ContainsFold predicate on the "batch" field.
+func BatchContainsFold(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldContainsFold(FieldBatch, v))
+}
+
+// WorkerEQ applies the EQ predicate on the "worker" field.
+func WorkerEQ(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldEQ(FieldWorker, v))
+}
+
+// WorkerNEQ applies the NEQ predicate on the "worker" field.
+func WorkerNEQ(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldNEQ(FieldWorker, v))
+}
+
+// WorkerIn applies the In predicate on the "worker" field.
+func WorkerIn(vs ...string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldIn(FieldWorker, vs...))
+}
+
+// WorkerNotIn applies the NotIn predicate on the "worker" field.
+func WorkerNotIn(vs ...string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldNotIn(FieldWorker, vs...))
+}
+
+// WorkerGT applies the GT predicate on the "worker" field.
+func WorkerGT(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldGT(FieldWorker, v))
+}
+
+// WorkerGTE applies the GTE predicate on the "worker" field.
+func WorkerGTE(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldGTE(FieldWorker, v))
+}
+
+// WorkerLT applies the LT predicate on the "worker" field.
+func WorkerLT(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldLT(FieldWorker, v))
+}
+
+// WorkerLTE applies the LTE predicate on the "worker" field.
+func WorkerLTE(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldLTE(FieldWorker, v))
+}
+
+// WorkerContains applies the Contains predicate on the "worker" field.
+func WorkerContains(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldContains(FieldWorker, v))
+}
+
+// WorkerHasPrefix applies the HasPrefix predicate on the "worker" field.
+func WorkerHasPrefix(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldHasPrefix(FieldWorker, v))
+}
+
+// WorkerHasSuffix applies the HasSuffix predicate on the "worker" field.
+func WorkerHasSuffix(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldHasSuffix(FieldWorker, v))
+}
+
+// WorkerIsNil applies the IsNil predicate on the "worker" field.
+func WorkerIsNil() predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldIsNull(FieldWorker))
+}
+
+// WorkerNotNil applies the NotNil predicate on the "worker" field.
+func WorkerNotNil() predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldNotNull(FieldWorker))
+}
+
+// WorkerEqualFold applies the EqualFold predicate on the "worker" field.
+func WorkerEqualFold(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldEqualFold(FieldWorker, v))
+}
+
+// WorkerContainsFold applies the ContainsFold predicate on the "worker" field.
+func WorkerContainsFold(v string) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldContainsFold(FieldWorker, v))
+}
+
+// ClaimedAtEQ applies the EQ predicate on the "claimed_at" field.
+func ClaimedAtEQ(v time.Time) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldEQ(FieldClaimedAt, v))
+}
+
+// ClaimedAtNEQ applies the NEQ predicate on the "claimed_at" field.
+func ClaimedAtNEQ(v time.Time) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldNEQ(FieldClaimedAt, v))
+}
+
+// ClaimedAtIn applies the In predicate on the "claimed_at" field.
+func ClaimedAtIn(vs ...time.Time) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldIn(FieldClaimedAt, vs...))
+}
+
+// ClaimedAtNotIn applies the NotIn predicate on the "claimed_at" field.
+func ClaimedAtNotIn(vs ...time.Time) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldNotIn(FieldClaimedAt, vs...))
+}
+
+// ClaimedAtGT applies the GT predicate on the "claimed_at" field.
+func ClaimedAtGT(v time.Time) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldGT(FieldClaimedAt, v))
+}
+
+// ClaimedAtGTE applies the GTE predicate on the "claimed_at" field.
+func ClaimedAtGTE(v time.Time) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldGTE(FieldClaimedAt, v))
+}
+
+// ClaimedAtLT applies the LT predicate on the "claimed_at" field.
+func ClaimedAtLT(v time.Time) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldLT(FieldClaimedAt, v))
+}
+
+// ClaimedAtLTE applies the LTE predicate on the "claimed_at" field.
+func ClaimedAtLTE(v time.Time) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldLTE(FieldClaimedAt, v))
+}
+
+// ClaimedAtIsNil applies the IsNil predicate on the "claimed_at" field.
+func ClaimedAtIsNil() predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldIsNull(FieldClaimedAt))
+}
+
+// ClaimedAtNotNil applies the NotNil predicate on the "claimed_at" field.
+func ClaimedAtNotNil() predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldNotNull(FieldClaimedAt))
+}
+
+// CompletedAtEQ applies the EQ predicate on the "completed_at" field.
+func CompletedAtEQ(v time.Time) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtNEQ applies the NEQ predicate on the "completed_at" field.
+func CompletedAtNEQ(v time.Time) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldNEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtIn applies the In predicate on the "completed_at" field.
+func CompletedAtIn(vs ...time.Time) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtNotIn applies the NotIn predicate on the "completed_at" field.
+func CompletedAtNotIn(vs ...time.Time) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldNotIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtGT applies the GT predicate on the "completed_at" field.
+func CompletedAtGT(v time.Time) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldGT(FieldCompletedAt, v))
+}
+
+// CompletedAtGTE applies the GTE predicate on the "completed_at" field.
+func CompletedAtGTE(v time.Time) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldGTE(FieldCompletedAt, v))
+}
+
+// CompletedAtLT applies the LT predicate on the "completed_at" field.
+func CompletedAtLT(v time.Time) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldLT(FieldCompletedAt, v))
+}
+
+// CompletedAtLTE applies the LTE predicate on the "completed_at" field.
+func CompletedAtLTE(v time.Time) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldLTE(FieldCompletedAt, v))
+}
+
+// CompletedAtIsNil applies the IsNil predicate on the "completed_at" field.
+func CompletedAtIsNil() predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldIsNull(FieldCompletedAt))
+}
+
+// CompletedAtNotNil applies the NotNil predicate on the "completed_at" field.
+func CompletedAtNotNil() predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldNotNull(FieldCompletedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ExtractionJob) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ExtractionJob) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ExtractionJob) predicate.ExtractionJob {
+	return predicate.ExtractionJob(sql.NotPredicates(p))
+}
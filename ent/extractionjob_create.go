@@ -0,0 +1,442 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Blogem/enron-graph/ent/extractionjob"
+)
+
+// ExtractionJobCreate is the builder for creating a ExtractionJob entity.
+type ExtractionJobCreate struct {
+	config
+	mutation *ExtractionJobMutation
+	hooks    []Hook
+}
+
+// SetEmailID sets the "email_id" field.
+func (_c *ExtractionJobCreate) SetEmailID(v int) *ExtractionJobCreate {
+	_c.mutation.SetEmailID(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *ExtractionJobCreate) SetStatus(v string) *ExtractionJobCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *ExtractionJobCreate) SetNillableStatus(v *string) *ExtractionJobCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetAttempts sets the "attempts" field.
+func (_c *ExtractionJobCreate) SetAttempts(v int) *ExtractionJobCreate {
+	_c.mutation.SetAttempts(v)
+	return _c
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_c *ExtractionJobCreate) SetNillableAttempts(v *int) *ExtractionJobCreate {
+	if v != nil {
+		_c.SetAttempts(*v)
+	}
+	return _c
+}
+
+// SetLastError sets the "last_error" field.
+func (_c *ExtractionJobCreate) SetLastError(v string) *ExtractionJobCreate {
+	_c.mutation.SetLastError(v)
+	return _c
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_c *ExtractionJobCreate) SetNillableLastError(v *string) *ExtractionJobCreate {
+	if v != nil {
+		_c.SetLastError(*v)
+	}
+	return _c
+}
+
+// SetModel sets the "model" field.
+func (_c *ExtractionJobCreate) SetModel(v string) *ExtractionJobCreate {
+	_c.mutation.SetModel(v)
+	return _c
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (_c *ExtractionJobCreate) SetNillableModel(v *string) *ExtractionJobCreate {
+	if v != nil {
+		_c.SetModel(*v)
+	}
+	return _c
+}
+
+// SetPromptVersion sets the "prompt_version" field.
+func (_c *ExtractionJobCreate) SetPromptVersion(v string) *ExtractionJobCreate {
+	_c.mutation.SetPromptVersion(v)
+	return _c
+}
+
+// SetNillablePromptVersion sets the "prompt_version" field if the given value is not nil.
+func (_c *ExtractionJobCreate) SetNillablePromptVersion(v *string) *ExtractionJobCreate {
+	if v != nil {
+		_c.SetPromptVersion(*v)
+	}
+	return _c
+}
+
+// SetBatch sets the "batch" field.
+func (_c *ExtractionJobCreate) SetBatch(v string) *ExtractionJobCreate {
+	_c.mutation.SetBatch(v)
+	return _c
+}
+
+// SetNillableBatch sets the "batch" field if the given value is not nil.
+func (_c *ExtractionJobCreate) SetNillableBatch(v *string) *ExtractionJobCreate {
+	if v != nil {
+		_c.SetBatch(*v)
+	}
+	return _c
+}
+
+// SetWorker sets the "worker" field.
+func (_c *ExtractionJobCreate) SetWorker(v string) *ExtractionJobCreate {
+	_c.mutation.SetWorker(v)
+	return _c
+}
+
+// SetNillableWorker sets the "worker" field if the given value is not nil.
+func (_c *ExtractionJobCreate) SetNillableWorker(v *string) *ExtractionJobCreate {
+	if v != nil {
+		_c.SetWorker(*v)
+	}
+	return _c
+}
+
+// SetClaimedAt sets the "claimed_at" field.
+func (_c *ExtractionJobCreate) SetClaimedAt(v time.Time) *ExtractionJobCreate {
+	_c.mutation.SetClaimedAt(v)
+	return _c
+}
+
+// SetNillableClaimedAt sets the "claimed_at" field if the given value is not nil.
+func (_c *ExtractionJobCreate) SetNillableClaimedAt(v *time.Time) *ExtractionJobCreate {
+	if v != nil {
+		_c.SetClaimedAt(*v)
+	}
+	return _c
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_c *ExtractionJobCreate) SetCompletedAt(v time.Time) *ExtractionJobCreate {
+	_c.mutation.SetCompletedAt(v)
+	return _c
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_c *ExtractionJobCreate) SetNillableCompletedAt(v *time.Time) *ExtractionJobCreate {
+	if v != nil {
+		_c.SetCompletedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ExtractionJobCreate) SetCreatedAt(v time.Time) *ExtractionJobCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ExtractionJobCreate) SetNillableCreatedAt(v *time.Time) *ExtractionJobCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ExtractionJobCreate) SetUpdatedAt(v time.Time) *ExtractionJobCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ExtractionJobCreate) SetNillableUpdatedAt(v *time.Time) *ExtractionJobCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ExtractionJobMutation object of the builder.
+func (_c *ExtractionJobCreate) Mutation() *ExtractionJobMutation {
+	return _c.mutation
+}
+
+// Save creates the ExtractionJob in the database.
+func (_c *ExtractionJobCreate) Save(ctx context.Context) (*ExtractionJob, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ExtractionJobCreate) SaveX(ctx context.Context) *ExtractionJob {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ExtractionJobCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ExtractionJobCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ExtractionJobCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := extractionjob.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		v := extractionjob.DefaultAttempts
+		_c.mutation.SetAttempts(v)
+	}
+	if _, ok := _c.mutation.Model(); !ok {
+		v := extractionjob.DefaultModel
+		_c.mutation.SetModel(v)
+	}
+	if _, ok := _c.mutation.PromptVersion(); !ok {
+		v := extractionjob.DefaultPromptVersion
+		_c.mutation.SetPromptVersion(v)
+	}
+	if _, ok := _c.mutation.Batch(); !ok {
+		v := extractionjob.DefaultBatch
+		_c.mutation.SetBatch(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := extractionjob.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := extractionjob.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ExtractionJobCreate) check() error {
+	if _, ok := _c.mutation.EmailID(); !ok {
+		return &ValidationError{Name: "email_id", err: errors.New(`ent: missing required field "ExtractionJob.email_id"`)}
+	}
+	if v, ok := _c.mutation.EmailID(); ok {
+		if err := extractionjob.EmailIDValidator(v); err != nil {
+			return &ValidationError{Name: "email_id", err: fmt.Errorf(`ent: validator failed for field "ExtractionJob.email_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ExtractionJob.status"`)}
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "ExtractionJob.attempts"`)}
+	}
+	if _, ok := _c.mutation.Model(); !ok {
+		return &ValidationError{Name: "model", err: errors.New(`ent: missing required field "ExtractionJob.model"`)}
+	}
+	if _, ok := _c.mutation.PromptVersion(); !ok {
+		return &ValidationError{Name: "prompt_version", err: errors.New(`ent: missing required field "ExtractionJob.prompt_version"`)}
+	}
+	if _, ok := _c.mutation.Batch(); !ok {
+		return &ValidationError{Name: "batch", err: errors.New(`ent: missing required field "ExtractionJob.batch"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ExtractionJob.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ExtractionJob.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *ExtractionJobCreate) sqlSave(ctx context.Context) (*ExtractionJob, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ExtractionJobCreate) createSpec() (*ExtractionJob, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ExtractionJob{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(extractionjob.Table, sqlgraph.NewFieldSpec(extractionjob.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.EmailID(); ok {
+		_spec.SetField(extractionjob.FieldEmailID, field.TypeInt, value)
+		_node.EmailID = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(extractionjob.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Attempts(); ok {
+		_spec.SetField(extractionjob.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := _c.mutation.LastError(); ok {
+		_spec.SetField(extractionjob.FieldLastError, field.TypeString, value)
+		_node.LastError = value
+	}
+	if value, ok := _c.mutation.Model(); ok {
+		_spec.SetField(extractionjob.FieldModel, field.TypeString, value)
+		_node.Model = value
+	}
+	if value, ok := _c.mutation.PromptVersion(); ok {
+		_spec.SetField(extractionjob.FieldPromptVersion, field.TypeString, value)
+		_node.PromptVersion = value
+	}
+	if value, ok := _c.mutation.Batch(); ok {
+		_spec.SetField(extractionjob.FieldBatch, field.TypeString, value)
+		_node.Batch = value
+	}
+	if value, ok := _c.mutation.Worker(); ok {
+		_spec.SetField(extractionjob.FieldWorker, field.TypeString, value)
+		_node.Worker = value
+	}
+	if value, ok := _c.mutation.ClaimedAt(); ok {
+		_spec.SetField(extractionjob.FieldClaimedAt, field.TypeTime, value)
+		_node.ClaimedAt = &value
+	}
+	if value, ok := _c.mutation.CompletedAt(); ok {
+		_spec.SetField(extractionjob.FieldCompletedAt, field.TypeTime, value)
+		_node.CompletedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(extractionjob.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(extractionjob.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// ExtractionJobCreateBulk is the builder for creating many ExtractionJob entities in bulk.
+type ExtractionJobCreateBulk struct {
+	config
+	err      error
+	builders []*ExtractionJobCreate
+}
+
+// Save creates the ExtractionJob entities in the database.
+func (_c *ExtractionJobCreateBulk) Save(ctx context.Context) ([]*ExtractionJob, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ExtractionJob, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ExtractionJobMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ExtractionJobCreateBulk) SaveX(ctx context.Context) []*ExtractionJob {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ExtractionJobCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ExtractionJobCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
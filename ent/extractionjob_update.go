@@ -0,0 +1,690 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Blogem/enron-graph/ent/extractionjob"
+	"github.com/Blogem/enron-graph/ent/predicate"
+)
+
+// ExtractionJobUpdate is the builder for updating ExtractionJob entities.
+type ExtractionJobUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ExtractionJobMutation
+}
+
+// Where appends a list predicates to the ExtractionJobUpdate builder.
+func (_u *ExtractionJobUpdate) Where(ps ...predicate.ExtractionJob) *ExtractionJobUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetEmailID sets the "email_id" field.
+func (_u *ExtractionJobUpdate) SetEmailID(v int) *ExtractionJobUpdate {
+	_u.mutation.ResetEmailID()
+	_u.mutation.SetEmailID(v)
+	return _u
+}
+
+// SetNillableEmailID sets the "email_id" field if the given value is not nil.
+func (_u *ExtractionJobUpdate) SetNillableEmailID(v *int) *ExtractionJobUpdate {
+	if v != nil {
+		_u.SetEmailID(*v)
+	}
+	return _u
+}
+
+// AddEmailID adds value to the "email_id" field.
+func (_u *ExtractionJobUpdate) AddEmailID(v int) *ExtractionJobUpdate {
+	_u.mutation.AddEmailID(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ExtractionJobUpdate) SetStatus(v string) *ExtractionJobUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ExtractionJobUpdate) SetNillableStatus(v *string) *ExtractionJobUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *ExtractionJobUpdate) SetAttempts(v int) *ExtractionJobUpdate {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *ExtractionJobUpdate) SetNillableAttempts(v *int) *ExtractionJobUpdate {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *ExtractionJobUpdate) AddAttempts(v int) *ExtractionJobUpdate {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *ExtractionJobUpdate) SetLastError(v string) *ExtractionJobUpdate {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *ExtractionJobUpdate) SetNillableLastError(v *string) *ExtractionJobUpdate {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *ExtractionJobUpdate) ClearLastError() *ExtractionJobUpdate {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetModel sets the "model" field.
+func (_u *ExtractionJobUpdate) SetModel(v string) *ExtractionJobUpdate {
+	_u.mutation.SetModel(v)
+	return _u
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (_u *ExtractionJobUpdate) SetNillableModel(v *string) *ExtractionJobUpdate {
+	if v != nil {
+		_u.SetModel(*v)
+	}
+	return _u
+}
+
+// SetPromptVersion sets the "prompt_version" field.
+func (_u *ExtractionJobUpdate) SetPromptVersion(v string) *ExtractionJobUpdate {
+	_u.mutation.SetPromptVersion(v)
+	return _u
+}
+
+// SetNillablePromptVersion sets the "prompt_version" field if the given value is not nil.
+func (_u *ExtractionJobUpdate) SetNillablePromptVersion(v *string) *ExtractionJobUpdate {
+	if v != nil {
+		_u.SetPromptVersion(*v)
+	}
+	return _u
+}
+
+// SetBatch sets the "batch" field.
+func (_u *ExtractionJobUpdate) SetBatch(v string) *ExtractionJobUpdate {
+	_u.mutation.SetBatch(v)
+	return _u
+}
+
+// SetNillableBatch sets the "batch" field if the given value is not nil.
+func (_u *ExtractionJobUpdate) SetNillableBatch(v *string) *ExtractionJobUpdate {
+	if v != nil {
+		_u.SetBatch(*v)
+	}
+	return _u
+}
+
+// SetWorker sets the "worker" field.
+func (_u *ExtractionJobUpdate) SetWorker(v string) *ExtractionJobUpdate {
+	_u.mutation.SetWorker(v)
+	return _u
+}
+
+// SetNillableWorker sets the "worker" field if the given value is not nil.
+func (_u *ExtractionJobUpdate) SetNillableWorker(v *string) *ExtractionJobUpdate {
+	if v != nil {
+		_u.SetWorker(*v)
+	}
+	return _u
+}
+
+// ClearWorker clears the value of the "worker" field.
+func (_u *ExtractionJobUpdate) ClearWorker() *ExtractionJobUpdate {
+	_u.mutation.ClearWorker()
+	return _u
+}
+
+// SetClaimedAt sets the "claimed_at" field.
+func (_u *ExtractionJobUpdate) SetClaimedAt(v time.Time) *ExtractionJobUpdate {
+	_u.mutation.SetClaimedAt(v)
+	return _u
+}
+
+// SetNillableClaimedAt sets the "claimed_at" field if the given value is not nil.
+func (_u *ExtractionJobUpdate) SetNillableClaimedAt(v *time.Time) *ExtractionJobUpdate {
+	if v != nil {
+		_u.SetClaimedAt(*v)
+	}
+	return _u
+}
+
+// ClearClaimedAt clears the value of the "claimed_at" field.
+func (_u *ExtractionJobUpdate) ClearClaimedAt() *ExtractionJobUpdate {
+	_u.mutation.ClearClaimedAt()
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *ExtractionJobUpdate) SetCompletedAt(v time.Time) *ExtractionJobUpdate {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *ExtractionJobUpdate) SetNillableCompletedAt(v *time.Time) *ExtractionJobUpdate {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *ExtractionJobUpdate) ClearCompletedAt() *ExtractionJobUpdate {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ExtractionJobUpdate) SetUpdatedAt(v time.Time) *ExtractionJobUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the ExtractionJobMutation object of the builder.
+func (_u *ExtractionJobUpdate) Mutation() *ExtractionJobMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ExtractionJobUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ExtractionJobUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ExtractionJobUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ExtractionJobUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ExtractionJobUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := extractionjob.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ExtractionJobUpdate) check() error {
+	if v, ok := _u.mutation.EmailID(); ok {
+		if err := extractionjob.EmailIDValidator(v); err != nil {
+			return &ValidationError{Name: "email_id", err: fmt.Errorf(`ent: validator failed for field "ExtractionJob.email_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ExtractionJobUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(extractionjob.Table, extractionjob.Columns, sqlgraph.NewFieldSpec(extractionjob.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.EmailID(); ok {
+		_spec.SetField(extractionjob.FieldEmailID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEmailID(); ok {
+		_spec.AddField(extractionjob.FieldEmailID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(extractionjob.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(extractionjob.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(extractionjob.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(extractionjob.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(extractionjob.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.Model(); ok {
+		_spec.SetField(extractionjob.FieldModel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PromptVersion(); ok {
+		_spec.SetField(extractionjob.FieldPromptVersion, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Batch(); ok {
+		_spec.SetField(extractionjob.FieldBatch, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Worker(); ok {
+		_spec.SetField(extractionjob.FieldWorker, field.TypeString, value)
+	}
+	if _u.mutation.WorkerCleared() {
+		_spec.ClearField(extractionjob.FieldWorker, field.TypeString)
+	}
+	if value, ok := _u.mutation.ClaimedAt(); ok {
+		_spec.SetField(extractionjob.FieldClaimedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ClaimedAtCleared() {
+		_spec.ClearField(extractionjob.FieldClaimedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(extractionjob.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(extractionjob.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(extractionjob.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{extractionjob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ExtractionJobUpdateOne is the builder for updating a single ExtractionJob entity.
+type ExtractionJobUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ExtractionJobMutation
+}
+
+// SetEmailID sets the "email_id" field.
+func (_u *ExtractionJobUpdateOne) SetEmailID(v int) *ExtractionJobUpdateOne {
+	_u.mutation.ResetEmailID()
+	_u.mutation.SetEmailID(v)
+	return _u
+}
+
+// SetNillableEmailID sets the "email_id" field if the given value is not nil.
+func (_u *ExtractionJobUpdateOne) SetNillableEmailID(v *int) *ExtractionJobUpdateOne {
+	if v != nil {
+		_u.SetEmailID(*v)
+	}
+	return _u
+}
+
+// AddEmailID adds value to the "email_id" field.
+func (_u *ExtractionJobUpdateOne) AddEmailID(v int) *ExtractionJobUpdateOne {
+	_u.mutation.AddEmailID(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ExtractionJobUpdateOne) SetStatus(v string) *ExtractionJobUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ExtractionJobUpdateOne) SetNillableStatus(v *string) *ExtractionJobUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *ExtractionJobUpdateOne) SetAttempts(v int) *ExtractionJobUpdateOne {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *ExtractionJobUpdateOne) SetNillableAttempts(v *int) *ExtractionJobUpdateOne {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *ExtractionJobUpdateOne) AddAttempts(v int) *ExtractionJobUpdateOne {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *ExtractionJobUpdateOne) SetLastError(v string) *ExtractionJobUpdateOne {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *ExtractionJobUpdateOne) SetNillableLastError(v *string) *ExtractionJobUpdateOne {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *ExtractionJobUpdateOne) ClearLastError() *ExtractionJobUpdateOne {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetModel sets the "model" field.
+func (_u *ExtractionJobUpdateOne) SetModel(v string) *ExtractionJobUpdateOne {
+	_u.mutation.SetModel(v)
+	return _u
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (_u *ExtractionJobUpdateOne) SetNillableModel(v *string) *ExtractionJobUpdateOne {
+	if v != nil {
+		_u.SetModel(*v)
+	}
+	return _u
+}
+
+// SetPromptVersion sets the "prompt_version" field.
+func (_u *ExtractionJobUpdateOne) SetPromptVersion(v string) *ExtractionJobUpdateOne {
+	_u.mutation.SetPromptVersion(v)
+	return _u
+}
+
+// SetNillablePromptVersion sets the "prompt_version" field if the given value is not nil.
+func (_u *ExtractionJobUpdateOne) SetNillablePromptVersion(v *string) *ExtractionJobUpdateOne {
+	if v != nil {
+		_u.SetPromptVersion(*v)
+	}
+	return _u
+}
+
+// SetBatch sets the "batch" field.
+func (_u *ExtractionJobUpdateOne) SetBatch(v string) *ExtractionJobUpdateOne {
+	_u.mutation.SetBatch(v)
+	return _u
+}
+
+// SetNillableBatch sets the "batch" field if the given value is not nil.
+func (_u *ExtractionJobUpdateOne) SetNillableBatch(v *string) *ExtractionJobUpdateOne {
+	if v != nil {
+		_u.SetBatch(*v)
+	}
+	return _u
+}
+
+// SetWorker sets the "worker" field.
+func (_u *ExtractionJobUpdateOne) SetWorker(v string) *ExtractionJobUpdateOne {
+	_u.mutation.SetWorker(v)
+	return _u
+}
+
+// SetNillableWorker sets the "worker" field if the given value is not nil.
+func (_u *ExtractionJobUpdateOne) SetNillableWorker(v *string) *ExtractionJobUpdateOne {
+	if v != nil {
+		_u.SetWorker(*v)
+	}
+	return _u
+}
+
+// ClearWorker clears the value of the "worker" field.
+func (_u *ExtractionJobUpdateOne) ClearWorker() *ExtractionJobUpdateOne {
+	_u.mutation.ClearWorker()
+	return _u
+}
+
+// SetClaimedAt sets the "claimed_at" field.
+func (_u *ExtractionJobUpdateOne) SetClaimedAt(v time.Time) *ExtractionJobUpdateOne {
+	_u.mutation.SetClaimedAt(v)
+	return _u
+}
+
+// SetNillableClaimedAt sets the "claimed_at" field if the given value is not nil.
+func (_u *ExtractionJobUpdateOne) SetNillableClaimedAt(v *time.Time) *ExtractionJobUpdateOne {
+	if v != nil {
+		_u.SetClaimedAt(*v)
+	}
+	return _u
+}
+
+// ClearClaimedAt clears the value of the "claimed_at" field.
+func (_u *ExtractionJobUpdateOne) ClearClaimedAt() *ExtractionJobUpdateOne {
+	_u.mutation.ClearClaimedAt()
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *ExtractionJobUpdateOne) SetCompletedAt(v time.Time) *ExtractionJobUpdateOne {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *ExtractionJobUpdateOne) SetNillableCompletedAt(v *time.Time) *ExtractionJobUpdateOne {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *ExtractionJobUpdateOne) ClearCompletedAt() *ExtractionJobUpdateOne {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ExtractionJobUpdateOne) SetUpdatedAt(v time.Time) *ExtractionJobUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the ExtractionJobMutation object of the builder.
+func (_u *ExtractionJobUpdateOne) Mutation() *ExtractionJobMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ExtractionJobUpdate builder.
+func (_u *ExtractionJobUpdateOne) Where(ps ...predicate.ExtractionJob) *ExtractionJobUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ExtractionJobUpdateOne) Select(field string, fields ...string) *ExtractionJobUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ExtractionJob entity.
+func (_u *ExtractionJobUpdateOne) Save(ctx context.Context) (*ExtractionJob, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ExtractionJobUpdateOne) SaveX(ctx context.Context) *ExtractionJob {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ExtractionJobUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ExtractionJobUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ExtractionJobUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := extractionjob.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ExtractionJobUpdateOne) check() error {
+	if v, ok := _u.mutation.EmailID(); ok {
+		if err := extractionjob.EmailIDValidator(v); err != nil {
+			return &ValidationError{Name: "email_id", err: fmt.Errorf(`ent: validator failed for field "ExtractionJob.email_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ExtractionJobUpdateOne) sqlSave(ctx context.Context) (_node *ExtractionJob, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(extractionjob.Table, extractionjob.Columns, sqlgraph.NewFieldSpec(extractionjob.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ExtractionJob.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, extractionjob.FieldID)
+		for _, f := range fields {
+			if !extractionjob.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != extractionjob.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.EmailID(); ok {
+		_spec.SetField(extractionjob.FieldEmailID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEmailID(); ok {
+		_spec.AddField(extractionjob.FieldEmailID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(extractionjob.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(extractionjob.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(extractionjob.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(extractionjob.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(extractionjob.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.Model(); ok {
+		_spec.SetField(extractionjob.FieldModel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PromptVersion(); ok {
+		_spec.SetField(extractionjob.FieldPromptVersion, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Batch(); ok {
+		_spec.SetField(extractionjob.FieldBatch, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Worker(); ok {
+		_spec.SetField(extractionjob.FieldWorker, field.TypeString, value)
+	}
+	if _u.mutation.WorkerCleared() {
+		_spec.ClearField(extractionjob.FieldWorker, field.TypeString)
+	}
+	if value, ok := _u.mutation.ClaimedAt(); ok {
+		_spec.SetField(extractionjob.FieldClaimedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ClaimedAtCleared() {
+		_spec.ClearField(extractionjob.FieldClaimedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(extractionjob.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(extractionjob.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(extractionjob.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &ExtractionJob{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{extractionjob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -9,6 +9,30 @@ import (
 	"github.com/Blogem/enron-graph/ent"
 )
 
+// The CentralityScoreFunc type is an adapter to allow the use of ordinary
+// function as CentralityScore mutator.
+type CentralityScoreFunc func(context.Context, *ent.CentralityScoreMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CentralityScoreFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CentralityScoreMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CentralityScoreMutation", m)
+}
+
+// The CommunityFunc type is an adapter to allow the use of ordinary
+// function as Community mutator.
+type CommunityFunc func(context.Context, *ent.CommunityMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CommunityFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CommunityMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CommunityMutation", m)
+}
+
 // The DiscoveredEntityFunc type is an adapter to allow the use of ordinary
 // function as DiscoveredEntity mutator.
 type DiscoveredEntityFunc func(context.Context, *ent.DiscoveredEntityMutation) (ent.Value, error)
@@ -33,6 +57,54 @@ func (f EmailFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EmailMutation", m)
 }
 
+// The EntityAliasFunc type is an adapter to allow the use of ordinary
+// function as EntityAlias mutator.
+type EntityAliasFunc func(context.Context, *ent.EntityAliasMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EntityAliasFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.EntityAliasMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EntityAliasMutation", m)
+}
+
+// The EntityAuditFunc type is an adapter to allow the use of ordinary
+// function as EntityAudit mutator.
+type EntityAuditFunc func(context.Context, *ent.EntityAuditMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EntityAuditFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.EntityAuditMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EntityAuditMutation", m)
+}
+
+// The ExtractionJobFunc type is an adapter to allow the use of ordinary
+// function as ExtractionJob mutator.
+type ExtractionJobFunc func(context.Context, *ent.ExtractionJobMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ExtractionJobFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ExtractionJobMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ExtractionJobMutation", m)
+}
+
+// The ProvenanceFunc type is an adapter to allow the use of ordinary
+// function as Provenance mutator.
+type ProvenanceFunc func(context.Context, *ent.ProvenanceMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ProvenanceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ProvenanceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProvenanceMutation", m)
+}
+
 // The RelationshipFunc type is an adapter to allow the use of ordinary
 // function as Relationship mutator.
 type RelationshipFunc func(context.Context, *ent.RelationshipMutation) (ent.Value, error)
@@ -57,6 +129,18 @@ func (f SchemaPromotionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Va
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SchemaPromotionMutation", m)
 }
 
+// The ThreadFunc type is an adapter to allow the use of ordinary
+// function as Thread mutator.
+type ThreadFunc func(context.Context, *ent.ThreadMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ThreadFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ThreadMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ThreadMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
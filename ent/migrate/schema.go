@@ -8,6 +8,53 @@ import (
 )
 
 var (
+	// CentralityScoresColumns holds the columns for the "centrality_scores" table.
+	CentralityScoresColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "scope", Type: field.TypeString},
+		{Name: "entity_id", Type: field.TypeInt},
+		{Name: "degree", Type: field.TypeInt, Default: 0},
+		{Name: "weighted_degree", Type: field.TypeFloat64, Default: 0},
+		{Name: "pagerank", Type: field.TypeFloat64, Default: 0},
+		{Name: "betweenness", Type: field.TypeFloat64, Default: 0},
+		{Name: "closeness", Type: field.TypeFloat64, Default: 0},
+		{Name: "computed_at", Type: field.TypeTime},
+	}
+	// CentralityScoresTable holds the schema information for the "centrality_scores" table.
+	CentralityScoresTable = &schema.Table{
+		Name:       "centrality_scores",
+		Columns:    CentralityScoresColumns,
+		PrimaryKey: []*schema.Column{CentralityScoresColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "centralityscore_scope_entity_id",
+				Unique:  true,
+				Columns: []*schema.Column{CentralityScoresColumns[1], CentralityScoresColumns[2]},
+			},
+			{
+				Name:    "centralityscore_entity_id",
+				Unique:  false,
+				Columns: []*schema.Column{CentralityScoresColumns[2]},
+			},
+		},
+	}
+	// CommunitiesColumns holds the columns for the "communities" table.
+	CommunitiesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "label", Type: field.TypeString, Default: ""},
+		{Name: "size", Type: field.TypeInt, Default: 0},
+		{Name: "top_members", Type: field.TypeJSON, Nullable: true},
+		{Name: "internal_weight", Type: field.TypeFloat64, Default: 0},
+		{Name: "modularity", Type: field.TypeFloat64, Default: 0},
+		{Name: "resolution", Type: field.TypeFloat64, Default: 1},
+		{Name: "computed_at", Type: field.TypeTime},
+	}
+	// CommunitiesTable holds the schema information for the "communities" table.
+	CommunitiesTable = &schema.Table{
+		Name:       "communities",
+		Columns:    CommunitiesColumns,
+		PrimaryKey: []*schema.Column{CommunitiesColumns[0]},
+	}
 	// DiscoveredEntitiesColumns holds the columns for the "discovered_entities" table.
 	DiscoveredEntitiesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -50,6 +97,9 @@ var (
 		{Name: "to", Type: field.TypeJSON, Nullable: true},
 		{Name: "cc", Type: field.TypeJSON, Nullable: true},
 		{Name: "bcc", Type: field.TypeJSON, Nullable: true},
+		{Name: "display_names", Type: field.TypeJSON, Nullable: true},
+		{Name: "in_reply_to", Type: field.TypeString, Nullable: true},
+		{Name: "references", Type: field.TypeJSON, Nullable: true},
 		{Name: "subject", Type: field.TypeString, Default: ""},
 		{Name: "date", Type: field.TypeTime},
 		{Name: "body", Type: field.TypeString, Size: 2147483647, Default: ""},
@@ -65,13 +115,146 @@ var (
 			{
 				Name:    "email_date",
 				Unique:  false,
-				Columns: []*schema.Column{EmailsColumns[7]},
+				Columns: []*schema.Column{EmailsColumns[10]},
 			},
 			{
 				Name:    "email_from",
 				Unique:  false,
 				Columns: []*schema.Column{EmailsColumns[2]},
 			},
+			{
+				Name:    "email_in_reply_to",
+				Unique:  false,
+				Columns: []*schema.Column{EmailsColumns[7]},
+			},
+		},
+	}
+	// EntityAliasColumns holds the columns for the "entity_alias" table.
+	EntityAliasColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "entity_id", Type: field.TypeInt},
+		{Name: "alias", Type: field.TypeString},
+		{Name: "normalized", Type: field.TypeString},
+		{Name: "kind", Type: field.TypeString, Default: "name"},
+		{Name: "source", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// EntityAliasTable holds the schema information for the "entity_alias" table.
+	EntityAliasTable = &schema.Table{
+		Name:       "entity_alias",
+		Columns:    EntityAliasColumns,
+		PrimaryKey: []*schema.Column{EntityAliasColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "entityalias_entity_id_normalized",
+				Unique:  true,
+				Columns: []*schema.Column{EntityAliasColumns[1], EntityAliasColumns[3]},
+			},
+			{
+				Name:    "entityalias_normalized",
+				Unique:  false,
+				Columns: []*schema.Column{EntityAliasColumns[3]},
+			},
+		},
+	}
+	// EntityAuditsColumns holds the columns for the "entity_audits" table.
+	EntityAuditsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "operation", Type: field.TypeString},
+		{Name: "entity_id", Type: field.TypeInt},
+		{Name: "related_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "actor", Type: field.TypeString, Nullable: true},
+		{Name: "reason", Type: field.TypeString, Nullable: true},
+		{Name: "details", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// EntityAuditsTable holds the schema information for the "entity_audits" table.
+	EntityAuditsTable = &schema.Table{
+		Name:       "entity_audits",
+		Columns:    EntityAuditsColumns,
+		PrimaryKey: []*schema.Column{EntityAuditsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "entityaudit_entity_id",
+				Unique:  false,
+				Columns: []*schema.Column{EntityAuditsColumns[2]},
+			},
+			{
+				Name:    "entityaudit_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{EntityAuditsColumns[7]},
+			},
+		},
+	}
+	// ExtractionJobsColumns holds the columns for the "extraction_jobs" table.
+	ExtractionJobsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "email_id", Type: field.TypeInt, Unique: true},
+		{Name: "status", Type: field.TypeString, Default: "pending"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "last_error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "model", Type: field.TypeString, Default: ""},
+		{Name: "prompt_version", Type: field.TypeString, Default: ""},
+		{Name: "batch", Type: field.TypeString, Default: ""},
+		{Name: "worker", Type: field.TypeString, Nullable: true},
+		{Name: "claimed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// ExtractionJobsTable holds the schema information for the "extraction_jobs" table.
+	ExtractionJobsTable = &schema.Table{
+		Name:       "extraction_jobs",
+		Columns:    ExtractionJobsColumns,
+		PrimaryKey: []*schema.Column{ExtractionJobsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "extractionjob_status_claimed_at",
+				Unique:  false,
+				Columns: []*schema.Column{ExtractionJobsColumns[2], ExtractionJobsColumns[9]},
+			},
+			{
+				Name:    "extractionjob_batch_status",
+				Unique:  false,
+				Columns: []*schema.Column{ExtractionJobsColumns[7], ExtractionJobsColumns[2]},
+			},
+		},
+	}
+	// ProvenancesColumns holds the columns for the "provenances" table.
+	ProvenancesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "subject_type", Type: field.TypeString},
+		{Name: "subject_id", Type: field.TypeInt},
+		{Name: "email_id", Type: field.TypeInt, Nullable: true},
+		{Name: "message_id", Type: field.TypeString, Nullable: true},
+		{Name: "file_path", Type: field.TypeString, Nullable: true},
+		{Name: "source", Type: field.TypeString},
+		{Name: "model", Type: field.TypeString, Nullable: true},
+		{Name: "prompt_version", Type: field.TypeString, Nullable: true},
+		{Name: "extracted_at", Type: field.TypeTime},
+		{Name: "properties", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+	}
+	// ProvenancesTable holds the schema information for the "provenances" table.
+	ProvenancesTable = &schema.Table{
+		Name:       "provenances",
+		Columns:    ProvenancesColumns,
+		PrimaryKey: []*schema.Column{ProvenancesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "provenance_subject_type_subject_id",
+				Unique:  false,
+				Columns: []*schema.Column{ProvenancesColumns[1], ProvenancesColumns[2]},
+			},
+			{
+				Name:    "provenance_email_id",
+				Unique:  false,
+				Columns: []*schema.Column{ProvenancesColumns[3]},
+			},
+			{
+				Name:    "provenance_extracted_at",
+				Unique:  false,
+				Columns: []*schema.Column{ProvenancesColumns[9]},
+			},
 		},
 	}
 	// RelationshipsColumns holds the columns for the "relationships" table.
@@ -143,12 +326,44 @@ var (
 			},
 		},
 	}
+	// ThreadsColumns holds the columns for the "threads" table.
+	ThreadsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "root_message_id", Type: field.TypeString, Unique: true},
+		{Name: "subject", Type: field.TypeString, Default: ""},
+		{Name: "normalized_subject", Type: field.TypeString, Default: ""},
+		{Name: "message_count", Type: field.TypeInt, Default: 0},
+		{Name: "participants", Type: field.TypeJSON, Nullable: true},
+		{Name: "first_date", Type: field.TypeTime, Nullable: true},
+		{Name: "last_date", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// ThreadsTable holds the schema information for the "threads" table.
+	ThreadsTable = &schema.Table{
+		Name:       "threads",
+		Columns:    ThreadsColumns,
+		PrimaryKey: []*schema.Column{ThreadsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "thread_normalized_subject_last_date",
+				Unique:  false,
+				Columns: []*schema.Column{ThreadsColumns[3], ThreadsColumns[7]},
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		CentralityScoresTable,
+		CommunitiesTable,
 		DiscoveredEntitiesTable,
 		EmailsTable,
+		EntityAliasTable,
+		EntityAuditsTable,
+		ExtractionJobsTable,
+		ProvenancesTable,
 		RelationshipsTable,
 		SchemaPromotionsTable,
+		ThreadsTable,
 	}
 )
 
@@ -11,11 +11,18 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/Blogem/enron-graph/ent/centralityscore"
+	"github.com/Blogem/enron-graph/ent/community"
 	"github.com/Blogem/enron-graph/ent/discoveredentity"
 	"github.com/Blogem/enron-graph/ent/email"
+	"github.com/Blogem/enron-graph/ent/entityalias"
+	"github.com/Blogem/enron-graph/ent/entityaudit"
+	"github.com/Blogem/enron-graph/ent/extractionjob"
 	"github.com/Blogem/enron-graph/ent/predicate"
+	"github.com/Blogem/enron-graph/ent/provenance"
 	"github.com/Blogem/enron-graph/ent/relationship"
 	"github.com/Blogem/enron-graph/ent/schemapromotion"
+	"github.com/Blogem/enron-graph/ent/thread"
 )
 
 const (
@@ -27,44 +34,56 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
+	TypeCentralityScore  = "CentralityScore"
+	TypeCommunity        = "Community"
 	TypeDiscoveredEntity = "DiscoveredEntity"
 	TypeEmail            = "Email"
+	TypeEntityAlias      = "EntityAlias"
+	TypeEntityAudit      = "EntityAudit"
+	TypeExtractionJob    = "ExtractionJob"
+	TypeProvenance       = "Provenance"
 	TypeRelationship     = "Relationship"
 	TypeSchemaPromotion  = "SchemaPromotion"
+	TypeThread           = "Thread"
 )
 
-// DiscoveredEntityMutation represents an operation that mutates the DiscoveredEntity nodes in the graph.
-type DiscoveredEntityMutation struct {
+// CentralityScoreMutation represents an operation that mutates the CentralityScore nodes in the graph.
+type CentralityScoreMutation struct {
 	config
-	op                  Op
-	typ                 string
-	id                  *int
-	unique_id           *string
-	type_category       *string
-	name                *string
-	properties          *map[string]interface{}
-	embedding           *[]float32
-	appendembedding     []float32
-	confidence_score    *float64
-	addconfidence_score *float64
-	created_at          *time.Time
-	clearedFields       map[string]struct{}
-	done                bool
-	oldValue            func(context.Context) (*DiscoveredEntity, error)
-	predicates          []predicate.DiscoveredEntity
-}
-
-var _ ent.Mutation = (*DiscoveredEntityMutation)(nil)
-
-// discoveredentityOption allows management of the mutation configuration using functional options.
-type discoveredentityOption func(*DiscoveredEntityMutation)
-
-// newDiscoveredEntityMutation creates new mutation for the DiscoveredEntity entity.
-func newDiscoveredEntityMutation(c config, op Op, opts ...discoveredentityOption) *DiscoveredEntityMutation {
-	m := &DiscoveredEntityMutation{
+	op                 Op
+	typ                string
+	id                 *int
+	scope              *string
+	entity_id          *int
+	addentity_id       *int
+	degree             *int
+	adddegree          *int
+	weighted_degree    *float64
+	addweighted_degree *float64
+	pagerank           *float64
+	addpagerank        *float64
+	betweenness        *float64
+	addbetweenness     *float64
+	closeness          *float64
+	addcloseness       *float64
+	computed_at        *time.Time
+	clearedFields      map[string]struct{}
+	done               bool
+	oldValue           func(context.Context) (*CentralityScore, error)
+	predicates         []predicate.CentralityScore
+}
+
+var _ ent.Mutation = (*CentralityScoreMutation)(nil)
+
+// centralityscoreOption allows management of the mutation configuration using functional options.
+type centralityscoreOption func(*CentralityScoreMutation)
+
+// newCentralityScoreMutation creates new mutation for the CentralityScore entity.
+func newCentralityScoreMutation(c config, op Op, opts ...centralityscoreOption) *CentralityScoreMutation {
+	m := &CentralityScoreMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeDiscoveredEntity,
+		typ:           TypeCentralityScore,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -73,20 +92,20 @@ func newDiscoveredEntityMutation(c config, op Op, opts ...discoveredentityOption
 	return m
 }
 
-// withDiscoveredEntityID sets the ID field of the mutation.
-func withDiscoveredEntityID(id int) discoveredentityOption {
-	return func(m *DiscoveredEntityMutation) {
+// withCentralityScoreID sets the ID field of the mutation.
+func withCentralityScoreID(id int) centralityscoreOption {
+	return func(m *CentralityScoreMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *DiscoveredEntity
+			value *CentralityScore
 		)
-		m.oldValue = func(ctx context.Context) (*DiscoveredEntity, error) {
+		m.oldValue = func(ctx context.Context) (*CentralityScore, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().DiscoveredEntity.Get(ctx, id)
+					value, err = m.Client().CentralityScore.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -95,10 +114,10 @@ func withDiscoveredEntityID(id int) discoveredentityOption {
 	}
 }
 
-// withDiscoveredEntity sets the old DiscoveredEntity of the mutation.
-func withDiscoveredEntity(node *DiscoveredEntity) discoveredentityOption {
-	return func(m *DiscoveredEntityMutation) {
-		m.oldValue = func(context.Context) (*DiscoveredEntity, error) {
+// withCentralityScore sets the old CentralityScore of the mutation.
+func withCentralityScore(node *CentralityScore) centralityscoreOption {
+	return func(m *CentralityScoreMutation) {
+		m.oldValue = func(context.Context) (*CentralityScore, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -107,7 +126,7 @@ func withDiscoveredEntity(node *DiscoveredEntity) discoveredentityOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DiscoveredEntityMutation) Client() *Client {
+func (m CentralityScoreMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -115,7 +134,7 @@ func (m DiscoveredEntityMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m DiscoveredEntityMutation) Tx() (*Tx, error) {
+func (m CentralityScoreMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -126,7 +145,7 @@ func (m DiscoveredEntityMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *DiscoveredEntityMutation) ID() (id int, exists bool) {
+func (m *CentralityScoreMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -137,7 +156,7 @@ func (m *DiscoveredEntityMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *DiscoveredEntityMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *CentralityScoreMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -146,335 +165,429 @@ func (m *DiscoveredEntityMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().DiscoveredEntity.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().CentralityScore.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetUniqueID sets the "unique_id" field.
-func (m *DiscoveredEntityMutation) SetUniqueID(s string) {
-	m.unique_id = &s
+// SetScope sets the "scope" field.
+func (m *CentralityScoreMutation) SetScope(s string) {
+	m.scope = &s
 }
 
-// UniqueID returns the value of the "unique_id" field in the mutation.
-func (m *DiscoveredEntityMutation) UniqueID() (r string, exists bool) {
-	v := m.unique_id
+// Scope returns the value of the "scope" field in the mutation.
+func (m *CentralityScoreMutation) Scope() (r string, exists bool) {
+	v := m.scope
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUniqueID returns the old "unique_id" field's value of the DiscoveredEntity entity.
-// If the DiscoveredEntity object wasn't provided to the builder, the object is fetched from the database.
+// OldScope returns the old "scope" field's value of the CentralityScore entity.
+// If the CentralityScore object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DiscoveredEntityMutation) OldUniqueID(ctx context.Context) (v string, err error) {
+func (m *CentralityScoreMutation) OldScope(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUniqueID is only allowed on UpdateOne operations")
+		return v, errors.New("OldScope is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUniqueID requires an ID field in the mutation")
+		return v, errors.New("OldScope requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUniqueID: %w", err)
+		return v, fmt.Errorf("querying old value for OldScope: %w", err)
 	}
-	return oldValue.UniqueID, nil
+	return oldValue.Scope, nil
 }
 
-// ResetUniqueID resets all changes to the "unique_id" field.
-func (m *DiscoveredEntityMutation) ResetUniqueID() {
-	m.unique_id = nil
+// ResetScope resets all changes to the "scope" field.
+func (m *CentralityScoreMutation) ResetScope() {
+	m.scope = nil
 }
 
-// SetTypeCategory sets the "type_category" field.
-func (m *DiscoveredEntityMutation) SetTypeCategory(s string) {
-	m.type_category = &s
+// SetEntityID sets the "entity_id" field.
+func (m *CentralityScoreMutation) SetEntityID(i int) {
+	m.entity_id = &i
+	m.addentity_id = nil
 }
 
-// TypeCategory returns the value of the "type_category" field in the mutation.
-func (m *DiscoveredEntityMutation) TypeCategory() (r string, exists bool) {
-	v := m.type_category
+// EntityID returns the value of the "entity_id" field in the mutation.
+func (m *CentralityScoreMutation) EntityID() (r int, exists bool) {
+	v := m.entity_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTypeCategory returns the old "type_category" field's value of the DiscoveredEntity entity.
-// If the DiscoveredEntity object wasn't provided to the builder, the object is fetched from the database.
+// OldEntityID returns the old "entity_id" field's value of the CentralityScore entity.
+// If the CentralityScore object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DiscoveredEntityMutation) OldTypeCategory(ctx context.Context) (v string, err error) {
+func (m *CentralityScoreMutation) OldEntityID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTypeCategory is only allowed on UpdateOne operations")
+		return v, errors.New("OldEntityID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTypeCategory requires an ID field in the mutation")
+		return v, errors.New("OldEntityID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTypeCategory: %w", err)
+		return v, fmt.Errorf("querying old value for OldEntityID: %w", err)
 	}
-	return oldValue.TypeCategory, nil
+	return oldValue.EntityID, nil
 }
 
-// ResetTypeCategory resets all changes to the "type_category" field.
-func (m *DiscoveredEntityMutation) ResetTypeCategory() {
-	m.type_category = nil
+// AddEntityID adds i to the "entity_id" field.
+func (m *CentralityScoreMutation) AddEntityID(i int) {
+	if m.addentity_id != nil {
+		*m.addentity_id += i
+	} else {
+		m.addentity_id = &i
+	}
 }
 
-// SetName sets the "name" field.
-func (m *DiscoveredEntityMutation) SetName(s string) {
-	m.name = &s
+// AddedEntityID returns the value that was added to the "entity_id" field in this mutation.
+func (m *CentralityScoreMutation) AddedEntityID() (r int, exists bool) {
+	v := m.addentity_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *DiscoveredEntityMutation) Name() (r string, exists bool) {
-	v := m.name
+// ResetEntityID resets all changes to the "entity_id" field.
+func (m *CentralityScoreMutation) ResetEntityID() {
+	m.entity_id = nil
+	m.addentity_id = nil
+}
+
+// SetDegree sets the "degree" field.
+func (m *CentralityScoreMutation) SetDegree(i int) {
+	m.degree = &i
+	m.adddegree = nil
+}
+
+// Degree returns the value of the "degree" field in the mutation.
+func (m *CentralityScoreMutation) Degree() (r int, exists bool) {
+	v := m.degree
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the DiscoveredEntity entity.
-// If the DiscoveredEntity object wasn't provided to the builder, the object is fetched from the database.
+// OldDegree returns the old "degree" field's value of the CentralityScore entity.
+// If the CentralityScore object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DiscoveredEntityMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *CentralityScoreMutation) OldDegree(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldDegree is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldDegree requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldDegree: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.Degree, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *DiscoveredEntityMutation) ResetName() {
-	m.name = nil
+// AddDegree adds i to the "degree" field.
+func (m *CentralityScoreMutation) AddDegree(i int) {
+	if m.adddegree != nil {
+		*m.adddegree += i
+	} else {
+		m.adddegree = &i
+	}
 }
 
-// SetProperties sets the "properties" field.
-func (m *DiscoveredEntityMutation) SetProperties(value map[string]interface{}) {
-	m.properties = &value
+// AddedDegree returns the value that was added to the "degree" field in this mutation.
+func (m *CentralityScoreMutation) AddedDegree() (r int, exists bool) {
+	v := m.adddegree
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Properties returns the value of the "properties" field in the mutation.
-func (m *DiscoveredEntityMutation) Properties() (r map[string]interface{}, exists bool) {
-	v := m.properties
+// ResetDegree resets all changes to the "degree" field.
+func (m *CentralityScoreMutation) ResetDegree() {
+	m.degree = nil
+	m.adddegree = nil
+}
+
+// SetWeightedDegree sets the "weighted_degree" field.
+func (m *CentralityScoreMutation) SetWeightedDegree(f float64) {
+	m.weighted_degree = &f
+	m.addweighted_degree = nil
+}
+
+// WeightedDegree returns the value of the "weighted_degree" field in the mutation.
+func (m *CentralityScoreMutation) WeightedDegree() (r float64, exists bool) {
+	v := m.weighted_degree
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProperties returns the old "properties" field's value of the DiscoveredEntity entity.
-// If the DiscoveredEntity object wasn't provided to the builder, the object is fetched from the database.
+// OldWeightedDegree returns the old "weighted_degree" field's value of the CentralityScore entity.
+// If the CentralityScore object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DiscoveredEntityMutation) OldProperties(ctx context.Context) (v map[string]interface{}, err error) {
+func (m *CentralityScoreMutation) OldWeightedDegree(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProperties is only allowed on UpdateOne operations")
+		return v, errors.New("OldWeightedDegree is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProperties requires an ID field in the mutation")
+		return v, errors.New("OldWeightedDegree requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProperties: %w", err)
+		return v, fmt.Errorf("querying old value for OldWeightedDegree: %w", err)
 	}
-	return oldValue.Properties, nil
+	return oldValue.WeightedDegree, nil
 }
 
-// ClearProperties clears the value of the "properties" field.
-func (m *DiscoveredEntityMutation) ClearProperties() {
-	m.properties = nil
-	m.clearedFields[discoveredentity.FieldProperties] = struct{}{}
+// AddWeightedDegree adds f to the "weighted_degree" field.
+func (m *CentralityScoreMutation) AddWeightedDegree(f float64) {
+	if m.addweighted_degree != nil {
+		*m.addweighted_degree += f
+	} else {
+		m.addweighted_degree = &f
+	}
 }
 
-// PropertiesCleared returns if the "properties" field was cleared in this mutation.
-func (m *DiscoveredEntityMutation) PropertiesCleared() bool {
-	_, ok := m.clearedFields[discoveredentity.FieldProperties]
-	return ok
+// AddedWeightedDegree returns the value that was added to the "weighted_degree" field in this mutation.
+func (m *CentralityScoreMutation) AddedWeightedDegree() (r float64, exists bool) {
+	v := m.addweighted_degree
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetProperties resets all changes to the "properties" field.
-func (m *DiscoveredEntityMutation) ResetProperties() {
-	m.properties = nil
-	delete(m.clearedFields, discoveredentity.FieldProperties)
+// ResetWeightedDegree resets all changes to the "weighted_degree" field.
+func (m *CentralityScoreMutation) ResetWeightedDegree() {
+	m.weighted_degree = nil
+	m.addweighted_degree = nil
 }
 
-// SetEmbedding sets the "embedding" field.
-func (m *DiscoveredEntityMutation) SetEmbedding(f []float32) {
-	m.embedding = &f
-	m.appendembedding = nil
+// SetPagerank sets the "pagerank" field.
+func (m *CentralityScoreMutation) SetPagerank(f float64) {
+	m.pagerank = &f
+	m.addpagerank = nil
 }
 
-// Embedding returns the value of the "embedding" field in the mutation.
-func (m *DiscoveredEntityMutation) Embedding() (r []float32, exists bool) {
-	v := m.embedding
+// Pagerank returns the value of the "pagerank" field in the mutation.
+func (m *CentralityScoreMutation) Pagerank() (r float64, exists bool) {
+	v := m.pagerank
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEmbedding returns the old "embedding" field's value of the DiscoveredEntity entity.
-// If the DiscoveredEntity object wasn't provided to the builder, the object is fetched from the database.
+// OldPagerank returns the old "pagerank" field's value of the CentralityScore entity.
+// If the CentralityScore object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DiscoveredEntityMutation) OldEmbedding(ctx context.Context) (v []float32, err error) {
+func (m *CentralityScoreMutation) OldPagerank(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmbedding is only allowed on UpdateOne operations")
+		return v, errors.New("OldPagerank is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmbedding requires an ID field in the mutation")
+		return v, errors.New("OldPagerank requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmbedding: %w", err)
+		return v, fmt.Errorf("querying old value for OldPagerank: %w", err)
 	}
-	return oldValue.Embedding, nil
+	return oldValue.Pagerank, nil
 }
 
-// AppendEmbedding adds f to the "embedding" field.
-func (m *DiscoveredEntityMutation) AppendEmbedding(f []float32) {
-	m.appendembedding = append(m.appendembedding, f...)
+// AddPagerank adds f to the "pagerank" field.
+func (m *CentralityScoreMutation) AddPagerank(f float64) {
+	if m.addpagerank != nil {
+		*m.addpagerank += f
+	} else {
+		m.addpagerank = &f
+	}
 }
 
-// AppendedEmbedding returns the list of values that were appended to the "embedding" field in this mutation.
-func (m *DiscoveredEntityMutation) AppendedEmbedding() ([]float32, bool) {
-	if len(m.appendembedding) == 0 {
-		return nil, false
+// AddedPagerank returns the value that was added to the "pagerank" field in this mutation.
+func (m *CentralityScoreMutation) AddedPagerank() (r float64, exists bool) {
+	v := m.addpagerank
+	if v == nil {
+		return
 	}
-	return m.appendembedding, true
+	return *v, true
 }
 
-// ClearEmbedding clears the value of the "embedding" field.
-func (m *DiscoveredEntityMutation) ClearEmbedding() {
-	m.embedding = nil
-	m.appendembedding = nil
-	m.clearedFields[discoveredentity.FieldEmbedding] = struct{}{}
+// ResetPagerank resets all changes to the "pagerank" field.
+func (m *CentralityScoreMutation) ResetPagerank() {
+	m.pagerank = nil
+	m.addpagerank = nil
 }
 
-// EmbeddingCleared returns if the "embedding" field was cleared in this mutation.
-func (m *DiscoveredEntityMutation) EmbeddingCleared() bool {
-	_, ok := m.clearedFields[discoveredentity.FieldEmbedding]
-	return ok
+// SetBetweenness sets the "betweenness" field.
+func (m *CentralityScoreMutation) SetBetweenness(f float64) {
+	m.betweenness = &f
+	m.addbetweenness = nil
 }
 
-// ResetEmbedding resets all changes to the "embedding" field.
-func (m *DiscoveredEntityMutation) ResetEmbedding() {
-	m.embedding = nil
-	m.appendembedding = nil
-	delete(m.clearedFields, discoveredentity.FieldEmbedding)
+// Betweenness returns the value of the "betweenness" field in the mutation.
+func (m *CentralityScoreMutation) Betweenness() (r float64, exists bool) {
+	v := m.betweenness
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetConfidenceScore sets the "confidence_score" field.
-func (m *DiscoveredEntityMutation) SetConfidenceScore(f float64) {
-	m.confidence_score = &f
-	m.addconfidence_score = nil
+// OldBetweenness returns the old "betweenness" field's value of the CentralityScore entity.
+// If the CentralityScore object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CentralityScoreMutation) OldBetweenness(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBetweenness is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBetweenness requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBetweenness: %w", err)
+	}
+	return oldValue.Betweenness, nil
 }
 
-// ConfidenceScore returns the value of the "confidence_score" field in the mutation.
-func (m *DiscoveredEntityMutation) ConfidenceScore() (r float64, exists bool) {
-	v := m.confidence_score
+// AddBetweenness adds f to the "betweenness" field.
+func (m *CentralityScoreMutation) AddBetweenness(f float64) {
+	if m.addbetweenness != nil {
+		*m.addbetweenness += f
+	} else {
+		m.addbetweenness = &f
+	}
+}
+
+// AddedBetweenness returns the value that was added to the "betweenness" field in this mutation.
+func (m *CentralityScoreMutation) AddedBetweenness() (r float64, exists bool) {
+	v := m.addbetweenness
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldConfidenceScore returns the old "confidence_score" field's value of the DiscoveredEntity entity.
-// If the DiscoveredEntity object wasn't provided to the builder, the object is fetched from the database.
+// ResetBetweenness resets all changes to the "betweenness" field.
+func (m *CentralityScoreMutation) ResetBetweenness() {
+	m.betweenness = nil
+	m.addbetweenness = nil
+}
+
+// SetCloseness sets the "closeness" field.
+func (m *CentralityScoreMutation) SetCloseness(f float64) {
+	m.closeness = &f
+	m.addcloseness = nil
+}
+
+// Closeness returns the value of the "closeness" field in the mutation.
+func (m *CentralityScoreMutation) Closeness() (r float64, exists bool) {
+	v := m.closeness
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCloseness returns the old "closeness" field's value of the CentralityScore entity.
+// If the CentralityScore object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DiscoveredEntityMutation) OldConfidenceScore(ctx context.Context) (v float64, err error) {
+func (m *CentralityScoreMutation) OldCloseness(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldConfidenceScore is only allowed on UpdateOne operations")
+		return v, errors.New("OldCloseness is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldConfidenceScore requires an ID field in the mutation")
+		return v, errors.New("OldCloseness requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldConfidenceScore: %w", err)
+		return v, fmt.Errorf("querying old value for OldCloseness: %w", err)
 	}
-	return oldValue.ConfidenceScore, nil
+	return oldValue.Closeness, nil
 }
 
-// AddConfidenceScore adds f to the "confidence_score" field.
-func (m *DiscoveredEntityMutation) AddConfidenceScore(f float64) {
-	if m.addconfidence_score != nil {
-		*m.addconfidence_score += f
+// AddCloseness adds f to the "closeness" field.
+func (m *CentralityScoreMutation) AddCloseness(f float64) {
+	if m.addcloseness != nil {
+		*m.addcloseness += f
 	} else {
-		m.addconfidence_score = &f
+		m.addcloseness = &f
 	}
 }
 
-// AddedConfidenceScore returns the value that was added to the "confidence_score" field in this mutation.
-func (m *DiscoveredEntityMutation) AddedConfidenceScore() (r float64, exists bool) {
-	v := m.addconfidence_score
+// AddedCloseness returns the value that was added to the "closeness" field in this mutation.
+func (m *CentralityScoreMutation) AddedCloseness() (r float64, exists bool) {
+	v := m.addcloseness
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetConfidenceScore resets all changes to the "confidence_score" field.
-func (m *DiscoveredEntityMutation) ResetConfidenceScore() {
-	m.confidence_score = nil
-	m.addconfidence_score = nil
+// ResetCloseness resets all changes to the "closeness" field.
+func (m *CentralityScoreMutation) ResetCloseness() {
+	m.closeness = nil
+	m.addcloseness = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *DiscoveredEntityMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetComputedAt sets the "computed_at" field.
+func (m *CentralityScoreMutation) SetComputedAt(t time.Time) {
+	m.computed_at = &t
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *DiscoveredEntityMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// ComputedAt returns the value of the "computed_at" field in the mutation.
+func (m *CentralityScoreMutation) ComputedAt() (r time.Time, exists bool) {
+	v := m.computed_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the DiscoveredEntity entity.
-// If the DiscoveredEntity object wasn't provided to the builder, the object is fetched from the database.
+// OldComputedAt returns the old "computed_at" field's value of the CentralityScore entity.
+// If the CentralityScore object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DiscoveredEntityMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *CentralityScoreMutation) OldComputedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldComputedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldComputedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldComputedAt: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.ComputedAt, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *DiscoveredEntityMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetComputedAt resets all changes to the "computed_at" field.
+func (m *CentralityScoreMutation) ResetComputedAt() {
+	m.computed_at = nil
 }
 
-// Where appends a list predicates to the DiscoveredEntityMutation builder.
-func (m *DiscoveredEntityMutation) Where(ps ...predicate.DiscoveredEntity) {
+// Where appends a list predicates to the CentralityScoreMutation builder.
+func (m *CentralityScoreMutation) Where(ps ...predicate.CentralityScore) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the DiscoveredEntityMutation builder. Using this method,
+// WhereP appends storage-level predicates to the CentralityScoreMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DiscoveredEntityMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.DiscoveredEntity, len(ps))
+func (m *CentralityScoreMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CentralityScore, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -482,45 +595,48 @@ func (m *DiscoveredEntityMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *DiscoveredEntityMutation) Op() Op {
+func (m *CentralityScoreMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *DiscoveredEntityMutation) SetOp(op Op) {
+func (m *CentralityScoreMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (DiscoveredEntity).
-func (m *DiscoveredEntityMutation) Type() string {
+// Type returns the node type of this mutation (CentralityScore).
+func (m *CentralityScoreMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *DiscoveredEntityMutation) Fields() []string {
-	fields := make([]string, 0, 7)
-	if m.unique_id != nil {
-		fields = append(fields, discoveredentity.FieldUniqueID)
+func (m *CentralityScoreMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.scope != nil {
+		fields = append(fields, centralityscore.FieldScope)
 	}
-	if m.type_category != nil {
-		fields = append(fields, discoveredentity.FieldTypeCategory)
+	if m.entity_id != nil {
+		fields = append(fields, centralityscore.FieldEntityID)
 	}
-	if m.name != nil {
-		fields = append(fields, discoveredentity.FieldName)
+	if m.degree != nil {
+		fields = append(fields, centralityscore.FieldDegree)
 	}
-	if m.properties != nil {
-		fields = append(fields, discoveredentity.FieldProperties)
+	if m.weighted_degree != nil {
+		fields = append(fields, centralityscore.FieldWeightedDegree)
 	}
-	if m.embedding != nil {
-		fields = append(fields, discoveredentity.FieldEmbedding)
+	if m.pagerank != nil {
+		fields = append(fields, centralityscore.FieldPagerank)
 	}
-	if m.confidence_score != nil {
-		fields = append(fields, discoveredentity.FieldConfidenceScore)
+	if m.betweenness != nil {
+		fields = append(fields, centralityscore.FieldBetweenness)
 	}
-	if m.created_at != nil {
-		fields = append(fields, discoveredentity.FieldCreatedAt)
+	if m.closeness != nil {
+		fields = append(fields, centralityscore.FieldCloseness)
+	}
+	if m.computed_at != nil {
+		fields = append(fields, centralityscore.FieldComputedAt)
 	}
 	return fields
 }
@@ -528,22 +644,24 @@ func (m *DiscoveredEntityMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *DiscoveredEntityMutation) Field(name string) (ent.Value, bool) {
+func (m *CentralityScoreMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case discoveredentity.FieldUniqueID:
-		return m.UniqueID()
-	case discoveredentity.FieldTypeCategory:
-		return m.TypeCategory()
-	case discoveredentity.FieldName:
-		return m.Name()
-	case discoveredentity.FieldProperties:
-		return m.Properties()
-	case discoveredentity.FieldEmbedding:
-		return m.Embedding()
-	case discoveredentity.FieldConfidenceScore:
-		return m.ConfidenceScore()
-	case discoveredentity.FieldCreatedAt:
-		return m.CreatedAt()
+	case centralityscore.FieldScope:
+		return m.Scope()
+	case centralityscore.FieldEntityID:
+		return m.EntityID()
+	case centralityscore.FieldDegree:
+		return m.Degree()
+	case centralityscore.FieldWeightedDegree:
+		return m.WeightedDegree()
+	case centralityscore.FieldPagerank:
+		return m.Pagerank()
+	case centralityscore.FieldBetweenness:
+		return m.Betweenness()
+	case centralityscore.FieldCloseness:
+		return m.Closeness()
+	case centralityscore.FieldComputedAt:
+		return m.ComputedAt()
 	}
 	return nil, false
 }
@@ -551,90 +669,114 @@ func (m *DiscoveredEntityMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DiscoveredEntityMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *CentralityScoreMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case discoveredentity.FieldUniqueID:
-		return m.OldUniqueID(ctx)
-	case discoveredentity.FieldTypeCategory:
-		return m.OldTypeCategory(ctx)
-	case discoveredentity.FieldName:
-		return m.OldName(ctx)
-	case discoveredentity.FieldProperties:
-		return m.OldProperties(ctx)
-	case discoveredentity.FieldEmbedding:
-		return m.OldEmbedding(ctx)
-	case discoveredentity.FieldConfidenceScore:
-		return m.OldConfidenceScore(ctx)
-	case discoveredentity.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	}
-	return nil, fmt.Errorf("unknown DiscoveredEntity field %s", name)
+	case centralityscore.FieldScope:
+		return m.OldScope(ctx)
+	case centralityscore.FieldEntityID:
+		return m.OldEntityID(ctx)
+	case centralityscore.FieldDegree:
+		return m.OldDegree(ctx)
+	case centralityscore.FieldWeightedDegree:
+		return m.OldWeightedDegree(ctx)
+	case centralityscore.FieldPagerank:
+		return m.OldPagerank(ctx)
+	case centralityscore.FieldBetweenness:
+		return m.OldBetweenness(ctx)
+	case centralityscore.FieldCloseness:
+		return m.OldCloseness(ctx)
+	case centralityscore.FieldComputedAt:
+		return m.OldComputedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown CentralityScore field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DiscoveredEntityMutation) SetField(name string, value ent.Value) error {
+func (m *CentralityScoreMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case discoveredentity.FieldUniqueID:
+	case centralityscore.FieldScope:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUniqueID(v)
+		m.SetScope(v)
 		return nil
-	case discoveredentity.FieldTypeCategory:
-		v, ok := value.(string)
+	case centralityscore.FieldEntityID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTypeCategory(v)
+		m.SetEntityID(v)
 		return nil
-	case discoveredentity.FieldName:
-		v, ok := value.(string)
+	case centralityscore.FieldDegree:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetDegree(v)
 		return nil
-	case discoveredentity.FieldProperties:
-		v, ok := value.(map[string]interface{})
+	case centralityscore.FieldWeightedDegree:
+		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetProperties(v)
+		m.SetWeightedDegree(v)
 		return nil
-	case discoveredentity.FieldEmbedding:
-		v, ok := value.([]float32)
+	case centralityscore.FieldPagerank:
+		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEmbedding(v)
+		m.SetPagerank(v)
 		return nil
-	case discoveredentity.FieldConfidenceScore:
+	case centralityscore.FieldBetweenness:
 		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetConfidenceScore(v)
+		m.SetBetweenness(v)
 		return nil
-	case discoveredentity.FieldCreatedAt:
+	case centralityscore.FieldCloseness:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCloseness(v)
+		return nil
+	case centralityscore.FieldComputedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetComputedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown DiscoveredEntity field %s", name)
+	return fmt.Errorf("unknown CentralityScore field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *DiscoveredEntityMutation) AddedFields() []string {
+func (m *CentralityScoreMutation) AddedFields() []string {
 	var fields []string
-	if m.addconfidence_score != nil {
-		fields = append(fields, discoveredentity.FieldConfidenceScore)
+	if m.addentity_id != nil {
+		fields = append(fields, centralityscore.FieldEntityID)
+	}
+	if m.adddegree != nil {
+		fields = append(fields, centralityscore.FieldDegree)
+	}
+	if m.addweighted_degree != nil {
+		fields = append(fields, centralityscore.FieldWeightedDegree)
+	}
+	if m.addpagerank != nil {
+		fields = append(fields, centralityscore.FieldPagerank)
+	}
+	if m.addbetweenness != nil {
+		fields = append(fields, centralityscore.FieldBetweenness)
+	}
+	if m.addcloseness != nil {
+		fields = append(fields, centralityscore.FieldCloseness)
 	}
 	return fields
 }
@@ -642,10 +784,20 @@ func (m *DiscoveredEntityMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *DiscoveredEntityMutation) AddedField(name string) (ent.Value, bool) {
+func (m *CentralityScoreMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case discoveredentity.FieldConfidenceScore:
-		return m.AddedConfidenceScore()
+	case centralityscore.FieldEntityID:
+		return m.AddedEntityID()
+	case centralityscore.FieldDegree:
+		return m.AddedDegree()
+	case centralityscore.FieldWeightedDegree:
+		return m.AddedWeightedDegree()
+	case centralityscore.FieldPagerank:
+		return m.AddedPagerank()
+	case centralityscore.FieldBetweenness:
+		return m.AddedBetweenness()
+	case centralityscore.FieldCloseness:
+		return m.AddedCloseness()
 	}
 	return nil, false
 }
@@ -653,166 +805,188 @@ func (m *DiscoveredEntityMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DiscoveredEntityMutation) AddField(name string, value ent.Value) error {
+func (m *CentralityScoreMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case discoveredentity.FieldConfidenceScore:
+	case centralityscore.FieldEntityID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEntityID(v)
+		return nil
+	case centralityscore.FieldDegree:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDegree(v)
+		return nil
+	case centralityscore.FieldWeightedDegree:
 		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddConfidenceScore(v)
+		m.AddWeightedDegree(v)
+		return nil
+	case centralityscore.FieldPagerank:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPagerank(v)
+		return nil
+	case centralityscore.FieldBetweenness:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBetweenness(v)
+		return nil
+	case centralityscore.FieldCloseness:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCloseness(v)
 		return nil
 	}
-	return fmt.Errorf("unknown DiscoveredEntity numeric field %s", name)
+	return fmt.Errorf("unknown CentralityScore numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DiscoveredEntityMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(discoveredentity.FieldProperties) {
-		fields = append(fields, discoveredentity.FieldProperties)
-	}
-	if m.FieldCleared(discoveredentity.FieldEmbedding) {
-		fields = append(fields, discoveredentity.FieldEmbedding)
-	}
-	return fields
+func (m *CentralityScoreMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *DiscoveredEntityMutation) FieldCleared(name string) bool {
+func (m *CentralityScoreMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *DiscoveredEntityMutation) ClearField(name string) error {
-	switch name {
-	case discoveredentity.FieldProperties:
-		m.ClearProperties()
-		return nil
-	case discoveredentity.FieldEmbedding:
-		m.ClearEmbedding()
-		return nil
-	}
-	return fmt.Errorf("unknown DiscoveredEntity nullable field %s", name)
+func (m *CentralityScoreMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown CentralityScore nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *DiscoveredEntityMutation) ResetField(name string) error {
+func (m *CentralityScoreMutation) ResetField(name string) error {
 	switch name {
-	case discoveredentity.FieldUniqueID:
-		m.ResetUniqueID()
+	case centralityscore.FieldScope:
+		m.ResetScope()
 		return nil
-	case discoveredentity.FieldTypeCategory:
-		m.ResetTypeCategory()
+	case centralityscore.FieldEntityID:
+		m.ResetEntityID()
 		return nil
-	case discoveredentity.FieldName:
-		m.ResetName()
+	case centralityscore.FieldDegree:
+		m.ResetDegree()
 		return nil
-	case discoveredentity.FieldProperties:
-		m.ResetProperties()
+	case centralityscore.FieldWeightedDegree:
+		m.ResetWeightedDegree()
 		return nil
-	case discoveredentity.FieldEmbedding:
-		m.ResetEmbedding()
+	case centralityscore.FieldPagerank:
+		m.ResetPagerank()
 		return nil
-	case discoveredentity.FieldConfidenceScore:
-		m.ResetConfidenceScore()
+	case centralityscore.FieldBetweenness:
+		m.ResetBetweenness()
 		return nil
-	case discoveredentity.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case centralityscore.FieldCloseness:
+		m.ResetCloseness()
+		return nil
+	case centralityscore.FieldComputedAt:
+		m.ResetComputedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown DiscoveredEntity field %s", name)
+	return fmt.Errorf("unknown CentralityScore field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DiscoveredEntityMutation) AddedEdges() []string {
+func (m *CentralityScoreMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DiscoveredEntityMutation) AddedIDs(name string) []ent.Value {
+func (m *CentralityScoreMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DiscoveredEntityMutation) RemovedEdges() []string {
+func (m *CentralityScoreMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DiscoveredEntityMutation) RemovedIDs(name string) []ent.Value {
+func (m *CentralityScoreMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DiscoveredEntityMutation) ClearedEdges() []string {
+func (m *CentralityScoreMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DiscoveredEntityMutation) EdgeCleared(name string) bool {
+func (m *CentralityScoreMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DiscoveredEntityMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown DiscoveredEntity unique edge %s", name)
+func (m *CentralityScoreMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown CentralityScore unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DiscoveredEntityMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown DiscoveredEntity edge %s", name)
+func (m *CentralityScoreMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown CentralityScore edge %s", name)
 }
 
-// EmailMutation represents an operation that mutates the Email nodes in the graph.
-type EmailMutation struct {
+// CommunityMutation represents an operation that mutates the Community nodes in the graph.
+type CommunityMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	message_id    *string
-	from          *string
-	to            *[]string
-	appendto      []string
-	cc            *[]string
-	appendcc      []string
-	bcc           *[]string
-	appendbcc     []string
-	subject       *string
-	date          *time.Time
-	body          *string
-	file_path     *string
-	created_at    *time.Time
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*Email, error)
-	predicates    []predicate.Email
-}
-
-var _ ent.Mutation = (*EmailMutation)(nil)
-
-// emailOption allows management of the mutation configuration using functional options.
-type emailOption func(*EmailMutation)
-
-// newEmailMutation creates new mutation for the Email entity.
-func newEmailMutation(c config, op Op, opts ...emailOption) *EmailMutation {
-	m := &EmailMutation{
+	op                 Op
+	typ                string
+	id                 *int
+	label              *string
+	size               *int
+	addsize            *int
+	top_members        *[]int
+	appendtop_members  []int
+	internal_weight    *float64
+	addinternal_weight *float64
+	modularity         *float64
+	addmodularity      *float64
+	resolution         *float64
+	addresolution      *float64
+	computed_at        *time.Time
+	clearedFields      map[string]struct{}
+	done               bool
+	oldValue           func(context.Context) (*Community, error)
+	predicates         []predicate.Community
+}
+
+var _ ent.Mutation = (*CommunityMutation)(nil)
+
+// communityOption allows management of the mutation configuration using functional options.
+type communityOption func(*CommunityMutation)
+
+// newCommunityMutation creates new mutation for the Community entity.
+func newCommunityMutation(c config, op Op, opts ...communityOption) *CommunityMutation {
+	m := &CommunityMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeEmail,
+		typ:           TypeCommunity,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -821,20 +995,20 @@ func newEmailMutation(c config, op Op, opts ...emailOption) *EmailMutation {
 	return m
 }
 
-// withEmailID sets the ID field of the mutation.
-func withEmailID(id int) emailOption {
-	return func(m *EmailMutation) {
+// withCommunityID sets the ID field of the mutation.
+func withCommunityID(id int) communityOption {
+	return func(m *CommunityMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Email
+			value *Community
 		)
-		m.oldValue = func(ctx context.Context) (*Email, error) {
+		m.oldValue = func(ctx context.Context) (*Community, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Email.Get(ctx, id)
+					value, err = m.Client().Community.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -843,10 +1017,10 @@ func withEmailID(id int) emailOption {
 	}
 }
 
-// withEmail sets the old Email of the mutation.
-func withEmail(node *Email) emailOption {
-	return func(m *EmailMutation) {
-		m.oldValue = func(context.Context) (*Email, error) {
+// withCommunity sets the old Community of the mutation.
+func withCommunity(node *Community) communityOption {
+	return func(m *CommunityMutation) {
+		m.oldValue = func(context.Context) (*Community, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -855,7 +1029,7 @@ func withEmail(node *Email) emailOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m EmailMutation) Client() *Client {
+func (m CommunityMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -863,7 +1037,7 @@ func (m EmailMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m EmailMutation) Tx() (*Tx, error) {
+func (m CommunityMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -874,7 +1048,7 @@ func (m EmailMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *EmailMutation) ID() (id int, exists bool) {
+func (m *CommunityMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -885,7 +1059,7 @@ func (m *EmailMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *EmailMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *CommunityMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -894,481 +1068,382 @@ func (m *EmailMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Email.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Community.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetMessageID sets the "message_id" field.
-func (m *EmailMutation) SetMessageID(s string) {
-	m.message_id = &s
+// SetLabel sets the "label" field.
+func (m *CommunityMutation) SetLabel(s string) {
+	m.label = &s
 }
 
-// MessageID returns the value of the "message_id" field in the mutation.
-func (m *EmailMutation) MessageID() (r string, exists bool) {
-	v := m.message_id
+// Label returns the value of the "label" field in the mutation.
+func (m *CommunityMutation) Label() (r string, exists bool) {
+	v := m.label
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMessageID returns the old "message_id" field's value of the Email entity.
-// If the Email object wasn't provided to the builder, the object is fetched from the database.
+// OldLabel returns the old "label" field's value of the Community entity.
+// If the Community object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EmailMutation) OldMessageID(ctx context.Context) (v string, err error) {
+func (m *CommunityMutation) OldLabel(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMessageID is only allowed on UpdateOne operations")
+		return v, errors.New("OldLabel is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMessageID requires an ID field in the mutation")
+		return v, errors.New("OldLabel requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMessageID: %w", err)
+		return v, fmt.Errorf("querying old value for OldLabel: %w", err)
 	}
-	return oldValue.MessageID, nil
+	return oldValue.Label, nil
 }
 
-// ResetMessageID resets all changes to the "message_id" field.
-func (m *EmailMutation) ResetMessageID() {
-	m.message_id = nil
+// ResetLabel resets all changes to the "label" field.
+func (m *CommunityMutation) ResetLabel() {
+	m.label = nil
 }
 
-// SetFrom sets the "from" field.
-func (m *EmailMutation) SetFrom(s string) {
-	m.from = &s
+// SetSize sets the "size" field.
+func (m *CommunityMutation) SetSize(i int) {
+	m.size = &i
+	m.addsize = nil
 }
 
-// From returns the value of the "from" field in the mutation.
-func (m *EmailMutation) From() (r string, exists bool) {
-	v := m.from
+// Size returns the value of the "size" field in the mutation.
+func (m *CommunityMutation) Size() (r int, exists bool) {
+	v := m.size
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFrom returns the old "from" field's value of the Email entity.
-// If the Email object wasn't provided to the builder, the object is fetched from the database.
+// OldSize returns the old "size" field's value of the Community entity.
+// If the Community object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EmailMutation) OldFrom(ctx context.Context) (v string, err error) {
+func (m *CommunityMutation) OldSize(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFrom is only allowed on UpdateOne operations")
+		return v, errors.New("OldSize is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFrom requires an ID field in the mutation")
+		return v, errors.New("OldSize requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFrom: %w", err)
+		return v, fmt.Errorf("querying old value for OldSize: %w", err)
 	}
-	return oldValue.From, nil
+	return oldValue.Size, nil
 }
 
-// ResetFrom resets all changes to the "from" field.
-func (m *EmailMutation) ResetFrom() {
-	m.from = nil
+// AddSize adds i to the "size" field.
+func (m *CommunityMutation) AddSize(i int) {
+	if m.addsize != nil {
+		*m.addsize += i
+	} else {
+		m.addsize = &i
+	}
 }
 
-// SetTo sets the "to" field.
-func (m *EmailMutation) SetTo(s []string) {
-	m.to = &s
-	m.appendto = nil
+// AddedSize returns the value that was added to the "size" field in this mutation.
+func (m *CommunityMutation) AddedSize() (r int, exists bool) {
+	v := m.addsize
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// To returns the value of the "to" field in the mutation.
-func (m *EmailMutation) To() (r []string, exists bool) {
-	v := m.to
+// ResetSize resets all changes to the "size" field.
+func (m *CommunityMutation) ResetSize() {
+	m.size = nil
+	m.addsize = nil
+}
+
+// SetTopMembers sets the "top_members" field.
+func (m *CommunityMutation) SetTopMembers(i []int) {
+	m.top_members = &i
+	m.appendtop_members = nil
+}
+
+// TopMembers returns the value of the "top_members" field in the mutation.
+func (m *CommunityMutation) TopMembers() (r []int, exists bool) {
+	v := m.top_members
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTo returns the old "to" field's value of the Email entity.
-// If the Email object wasn't provided to the builder, the object is fetched from the database.
+// OldTopMembers returns the old "top_members" field's value of the Community entity.
+// If the Community object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EmailMutation) OldTo(ctx context.Context) (v []string, err error) {
+func (m *CommunityMutation) OldTopMembers(ctx context.Context) (v []int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTo is only allowed on UpdateOne operations")
+		return v, errors.New("OldTopMembers is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTo requires an ID field in the mutation")
+		return v, errors.New("OldTopMembers requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTo: %w", err)
+		return v, fmt.Errorf("querying old value for OldTopMembers: %w", err)
 	}
-	return oldValue.To, nil
+	return oldValue.TopMembers, nil
 }
 
-// AppendTo adds s to the "to" field.
-func (m *EmailMutation) AppendTo(s []string) {
-	m.appendto = append(m.appendto, s...)
+// AppendTopMembers adds i to the "top_members" field.
+func (m *CommunityMutation) AppendTopMembers(i []int) {
+	m.appendtop_members = append(m.appendtop_members, i...)
 }
 
-// AppendedTo returns the list of values that were appended to the "to" field in this mutation.
-func (m *EmailMutation) AppendedTo() ([]string, bool) {
-	if len(m.appendto) == 0 {
+// AppendedTopMembers returns the list of values that were appended to the "top_members" field in this mutation.
+func (m *CommunityMutation) AppendedTopMembers() ([]int, bool) {
+	if len(m.appendtop_members) == 0 {
 		return nil, false
 	}
-	return m.appendto, true
+	return m.appendtop_members, true
 }
 
-// ClearTo clears the value of the "to" field.
-func (m *EmailMutation) ClearTo() {
-	m.to = nil
-	m.appendto = nil
-	m.clearedFields[email.FieldTo] = struct{}{}
+// ClearTopMembers clears the value of the "top_members" field.
+func (m *CommunityMutation) ClearTopMembers() {
+	m.top_members = nil
+	m.appendtop_members = nil
+	m.clearedFields[community.FieldTopMembers] = struct{}{}
 }
 
-// ToCleared returns if the "to" field was cleared in this mutation.
-func (m *EmailMutation) ToCleared() bool {
-	_, ok := m.clearedFields[email.FieldTo]
+// TopMembersCleared returns if the "top_members" field was cleared in this mutation.
+func (m *CommunityMutation) TopMembersCleared() bool {
+	_, ok := m.clearedFields[community.FieldTopMembers]
 	return ok
 }
 
-// ResetTo resets all changes to the "to" field.
-func (m *EmailMutation) ResetTo() {
-	m.to = nil
-	m.appendto = nil
-	delete(m.clearedFields, email.FieldTo)
+// ResetTopMembers resets all changes to the "top_members" field.
+func (m *CommunityMutation) ResetTopMembers() {
+	m.top_members = nil
+	m.appendtop_members = nil
+	delete(m.clearedFields, community.FieldTopMembers)
 }
 
-// SetCc sets the "cc" field.
-func (m *EmailMutation) SetCc(s []string) {
-	m.cc = &s
-	m.appendcc = nil
+// SetInternalWeight sets the "internal_weight" field.
+func (m *CommunityMutation) SetInternalWeight(f float64) {
+	m.internal_weight = &f
+	m.addinternal_weight = nil
 }
 
-// Cc returns the value of the "cc" field in the mutation.
-func (m *EmailMutation) Cc() (r []string, exists bool) {
-	v := m.cc
+// InternalWeight returns the value of the "internal_weight" field in the mutation.
+func (m *CommunityMutation) InternalWeight() (r float64, exists bool) {
+	v := m.internal_weight
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCc returns the old "cc" field's value of the Email entity.
-// If the Email object wasn't provided to the builder, the object is fetched from the database.
+// OldInternalWeight returns the old "internal_weight" field's value of the Community entity.
+// If the Community object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EmailMutation) OldCc(ctx context.Context) (v []string, err error) {
+func (m *CommunityMutation) OldInternalWeight(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCc is only allowed on UpdateOne operations")
+		return v, errors.New("OldInternalWeight is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCc requires an ID field in the mutation")
+		return v, errors.New("OldInternalWeight requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCc: %w", err)
+		return v, fmt.Errorf("querying old value for OldInternalWeight: %w", err)
 	}
-	return oldValue.Cc, nil
-}
-
-// AppendCc adds s to the "cc" field.
-func (m *EmailMutation) AppendCc(s []string) {
-	m.appendcc = append(m.appendcc, s...)
+	return oldValue.InternalWeight, nil
 }
 
-// AppendedCc returns the list of values that were appended to the "cc" field in this mutation.
-func (m *EmailMutation) AppendedCc() ([]string, bool) {
-	if len(m.appendcc) == 0 {
-		return nil, false
+// AddInternalWeight adds f to the "internal_weight" field.
+func (m *CommunityMutation) AddInternalWeight(f float64) {
+	if m.addinternal_weight != nil {
+		*m.addinternal_weight += f
+	} else {
+		m.addinternal_weight = &f
 	}
-	return m.appendcc, true
-}
-
-// ClearCc clears the value of the "cc" field.
-func (m *EmailMutation) ClearCc() {
-	m.cc = nil
-	m.appendcc = nil
-	m.clearedFields[email.FieldCc] = struct{}{}
-}
-
-// CcCleared returns if the "cc" field was cleared in this mutation.
-func (m *EmailMutation) CcCleared() bool {
-	_, ok := m.clearedFields[email.FieldCc]
-	return ok
-}
-
-// ResetCc resets all changes to the "cc" field.
-func (m *EmailMutation) ResetCc() {
-	m.cc = nil
-	m.appendcc = nil
-	delete(m.clearedFields, email.FieldCc)
-}
-
-// SetBcc sets the "bcc" field.
-func (m *EmailMutation) SetBcc(s []string) {
-	m.bcc = &s
-	m.appendbcc = nil
 }
 
-// Bcc returns the value of the "bcc" field in the mutation.
-func (m *EmailMutation) Bcc() (r []string, exists bool) {
-	v := m.bcc
+// AddedInternalWeight returns the value that was added to the "internal_weight" field in this mutation.
+func (m *CommunityMutation) AddedInternalWeight() (r float64, exists bool) {
+	v := m.addinternal_weight
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBcc returns the old "bcc" field's value of the Email entity.
-// If the Email object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EmailMutation) OldBcc(ctx context.Context) (v []string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBcc is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBcc requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBcc: %w", err)
-	}
-	return oldValue.Bcc, nil
-}
-
-// AppendBcc adds s to the "bcc" field.
-func (m *EmailMutation) AppendBcc(s []string) {
-	m.appendbcc = append(m.appendbcc, s...)
-}
-
-// AppendedBcc returns the list of values that were appended to the "bcc" field in this mutation.
-func (m *EmailMutation) AppendedBcc() ([]string, bool) {
-	if len(m.appendbcc) == 0 {
-		return nil, false
-	}
-	return m.appendbcc, true
-}
-
-// ClearBcc clears the value of the "bcc" field.
-func (m *EmailMutation) ClearBcc() {
-	m.bcc = nil
-	m.appendbcc = nil
-	m.clearedFields[email.FieldBcc] = struct{}{}
-}
-
-// BccCleared returns if the "bcc" field was cleared in this mutation.
-func (m *EmailMutation) BccCleared() bool {
-	_, ok := m.clearedFields[email.FieldBcc]
-	return ok
+// ResetInternalWeight resets all changes to the "internal_weight" field.
+func (m *CommunityMutation) ResetInternalWeight() {
+	m.internal_weight = nil
+	m.addinternal_weight = nil
 }
 
-// ResetBcc resets all changes to the "bcc" field.
-func (m *EmailMutation) ResetBcc() {
-	m.bcc = nil
-	m.appendbcc = nil
-	delete(m.clearedFields, email.FieldBcc)
-}
-
-// SetSubject sets the "subject" field.
-func (m *EmailMutation) SetSubject(s string) {
-	m.subject = &s
+// SetModularity sets the "modularity" field.
+func (m *CommunityMutation) SetModularity(f float64) {
+	m.modularity = &f
+	m.addmodularity = nil
 }
 
-// Subject returns the value of the "subject" field in the mutation.
-func (m *EmailMutation) Subject() (r string, exists bool) {
-	v := m.subject
+// Modularity returns the value of the "modularity" field in the mutation.
+func (m *CommunityMutation) Modularity() (r float64, exists bool) {
+	v := m.modularity
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSubject returns the old "subject" field's value of the Email entity.
-// If the Email object wasn't provided to the builder, the object is fetched from the database.
+// OldModularity returns the old "modularity" field's value of the Community entity.
+// If the Community object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EmailMutation) OldSubject(ctx context.Context) (v string, err error) {
+func (m *CommunityMutation) OldModularity(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSubject is only allowed on UpdateOne operations")
+		return v, errors.New("OldModularity is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSubject requires an ID field in the mutation")
+		return v, errors.New("OldModularity requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSubject: %w", err)
+		return v, fmt.Errorf("querying old value for OldModularity: %w", err)
 	}
-	return oldValue.Subject, nil
-}
-
-// ResetSubject resets all changes to the "subject" field.
-func (m *EmailMutation) ResetSubject() {
-	m.subject = nil
+	return oldValue.Modularity, nil
 }
 
-// SetDate sets the "date" field.
-func (m *EmailMutation) SetDate(t time.Time) {
-	m.date = &t
+// AddModularity adds f to the "modularity" field.
+func (m *CommunityMutation) AddModularity(f float64) {
+	if m.addmodularity != nil {
+		*m.addmodularity += f
+	} else {
+		m.addmodularity = &f
+	}
 }
 
-// Date returns the value of the "date" field in the mutation.
-func (m *EmailMutation) Date() (r time.Time, exists bool) {
-	v := m.date
+// AddedModularity returns the value that was added to the "modularity" field in this mutation.
+func (m *CommunityMutation) AddedModularity() (r float64, exists bool) {
+	v := m.addmodularity
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDate returns the old "date" field's value of the Email entity.
-// If the Email object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EmailMutation) OldDate(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDate is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDate requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDate: %w", err)
-	}
-	return oldValue.Date, nil
-}
-
-// ResetDate resets all changes to the "date" field.
-func (m *EmailMutation) ResetDate() {
-	m.date = nil
+// ResetModularity resets all changes to the "modularity" field.
+func (m *CommunityMutation) ResetModularity() {
+	m.modularity = nil
+	m.addmodularity = nil
 }
 
-// SetBody sets the "body" field.
-func (m *EmailMutation) SetBody(s string) {
-	m.body = &s
+// SetResolution sets the "resolution" field.
+func (m *CommunityMutation) SetResolution(f float64) {
+	m.resolution = &f
+	m.addresolution = nil
 }
 
-// Body returns the value of the "body" field in the mutation.
-func (m *EmailMutation) Body() (r string, exists bool) {
-	v := m.body
+// Resolution returns the value of the "resolution" field in the mutation.
+func (m *CommunityMutation) Resolution() (r float64, exists bool) {
+	v := m.resolution
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBody returns the old "body" field's value of the Email entity.
-// If the Email object wasn't provided to the builder, the object is fetched from the database.
+// OldResolution returns the old "resolution" field's value of the Community entity.
+// If the Community object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EmailMutation) OldBody(ctx context.Context) (v string, err error) {
+func (m *CommunityMutation) OldResolution(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBody is only allowed on UpdateOne operations")
+		return v, errors.New("OldResolution is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBody requires an ID field in the mutation")
+		return v, errors.New("OldResolution requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBody: %w", err)
+		return v, fmt.Errorf("querying old value for OldResolution: %w", err)
 	}
-	return oldValue.Body, nil
-}
-
-// ResetBody resets all changes to the "body" field.
-func (m *EmailMutation) ResetBody() {
-	m.body = nil
+	return oldValue.Resolution, nil
 }
 
-// SetFilePath sets the "file_path" field.
-func (m *EmailMutation) SetFilePath(s string) {
-	m.file_path = &s
+// AddResolution adds f to the "resolution" field.
+func (m *CommunityMutation) AddResolution(f float64) {
+	if m.addresolution != nil {
+		*m.addresolution += f
+	} else {
+		m.addresolution = &f
+	}
 }
 
-// FilePath returns the value of the "file_path" field in the mutation.
-func (m *EmailMutation) FilePath() (r string, exists bool) {
-	v := m.file_path
+// AddedResolution returns the value that was added to the "resolution" field in this mutation.
+func (m *CommunityMutation) AddedResolution() (r float64, exists bool) {
+	v := m.addresolution
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFilePath returns the old "file_path" field's value of the Email entity.
-// If the Email object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EmailMutation) OldFilePath(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFilePath is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFilePath requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFilePath: %w", err)
-	}
-	return oldValue.FilePath, nil
-}
-
-// ClearFilePath clears the value of the "file_path" field.
-func (m *EmailMutation) ClearFilePath() {
-	m.file_path = nil
-	m.clearedFields[email.FieldFilePath] = struct{}{}
-}
-
-// FilePathCleared returns if the "file_path" field was cleared in this mutation.
-func (m *EmailMutation) FilePathCleared() bool {
-	_, ok := m.clearedFields[email.FieldFilePath]
-	return ok
-}
-
-// ResetFilePath resets all changes to the "file_path" field.
-func (m *EmailMutation) ResetFilePath() {
-	m.file_path = nil
-	delete(m.clearedFields, email.FieldFilePath)
+// ResetResolution resets all changes to the "resolution" field.
+func (m *CommunityMutation) ResetResolution() {
+	m.resolution = nil
+	m.addresolution = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *EmailMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetComputedAt sets the "computed_at" field.
+func (m *CommunityMutation) SetComputedAt(t time.Time) {
+	m.computed_at = &t
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *EmailMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// ComputedAt returns the value of the "computed_at" field in the mutation.
+func (m *CommunityMutation) ComputedAt() (r time.Time, exists bool) {
+	v := m.computed_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Email entity.
-// If the Email object wasn't provided to the builder, the object is fetched from the database.
+// OldComputedAt returns the old "computed_at" field's value of the Community entity.
+// If the Community object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EmailMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *CommunityMutation) OldComputedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldComputedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldComputedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldComputedAt: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.ComputedAt, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *EmailMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetComputedAt resets all changes to the "computed_at" field.
+func (m *CommunityMutation) ResetComputedAt() {
+	m.computed_at = nil
 }
 
-// Where appends a list predicates to the EmailMutation builder.
-func (m *EmailMutation) Where(ps ...predicate.Email) {
+// Where appends a list predicates to the CommunityMutation builder.
+func (m *CommunityMutation) Where(ps ...predicate.Community) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the EmailMutation builder. Using this method,
+// WhereP appends storage-level predicates to the CommunityMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *EmailMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Email, len(ps))
+func (m *CommunityMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Community, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1376,54 +1451,45 @@ func (m *EmailMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *EmailMutation) Op() Op {
+func (m *CommunityMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *EmailMutation) SetOp(op Op) {
+func (m *CommunityMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Email).
-func (m *EmailMutation) Type() string {
+// Type returns the node type of this mutation (Community).
+func (m *CommunityMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *EmailMutation) Fields() []string {
-	fields := make([]string, 0, 10)
-	if m.message_id != nil {
-		fields = append(fields, email.FieldMessageID)
-	}
-	if m.from != nil {
-		fields = append(fields, email.FieldFrom)
-	}
-	if m.to != nil {
-		fields = append(fields, email.FieldTo)
-	}
-	if m.cc != nil {
-		fields = append(fields, email.FieldCc)
+func (m *CommunityMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.label != nil {
+		fields = append(fields, community.FieldLabel)
 	}
-	if m.bcc != nil {
-		fields = append(fields, email.FieldBcc)
+	if m.size != nil {
+		fields = append(fields, community.FieldSize)
 	}
-	if m.subject != nil {
-		fields = append(fields, email.FieldSubject)
+	if m.top_members != nil {
+		fields = append(fields, community.FieldTopMembers)
 	}
-	if m.date != nil {
-		fields = append(fields, email.FieldDate)
+	if m.internal_weight != nil {
+		fields = append(fields, community.FieldInternalWeight)
 	}
-	if m.body != nil {
-		fields = append(fields, email.FieldBody)
+	if m.modularity != nil {
+		fields = append(fields, community.FieldModularity)
 	}
-	if m.file_path != nil {
-		fields = append(fields, email.FieldFilePath)
+	if m.resolution != nil {
+		fields = append(fields, community.FieldResolution)
 	}
-	if m.created_at != nil {
-		fields = append(fields, email.FieldCreatedAt)
+	if m.computed_at != nil {
+		fields = append(fields, community.FieldComputedAt)
 	}
 	return fields
 }
@@ -1431,28 +1497,22 @@ func (m *EmailMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *EmailMutation) Field(name string) (ent.Value, bool) {
+func (m *CommunityMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case email.FieldMessageID:
-		return m.MessageID()
-	case email.FieldFrom:
-		return m.From()
-	case email.FieldTo:
-		return m.To()
-	case email.FieldCc:
-		return m.Cc()
-	case email.FieldBcc:
-		return m.Bcc()
-	case email.FieldSubject:
-		return m.Subject()
-	case email.FieldDate:
-		return m.Date()
-	case email.FieldBody:
-		return m.Body()
-	case email.FieldFilePath:
-		return m.FilePath()
-	case email.FieldCreatedAt:
-		return m.CreatedAt()
+	case community.FieldLabel:
+		return m.Label()
+	case community.FieldSize:
+		return m.Size()
+	case community.FieldTopMembers:
+		return m.TopMembers()
+	case community.FieldInternalWeight:
+		return m.InternalWeight()
+	case community.FieldModularity:
+		return m.Modularity()
+	case community.FieldResolution:
+		return m.Resolution()
+	case community.FieldComputedAt:
+		return m.ComputedAt()
 	}
 	return nil, false
 }
@@ -1460,300 +1520,294 @@ func (m *EmailMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *EmailMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *CommunityMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case email.FieldMessageID:
-		return m.OldMessageID(ctx)
-	case email.FieldFrom:
-		return m.OldFrom(ctx)
-	case email.FieldTo:
-		return m.OldTo(ctx)
-	case email.FieldCc:
-		return m.OldCc(ctx)
-	case email.FieldBcc:
-		return m.OldBcc(ctx)
-	case email.FieldSubject:
-		return m.OldSubject(ctx)
-	case email.FieldDate:
-		return m.OldDate(ctx)
-	case email.FieldBody:
-		return m.OldBody(ctx)
-	case email.FieldFilePath:
-		return m.OldFilePath(ctx)
-	case email.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	}
-	return nil, fmt.Errorf("unknown Email field %s", name)
+	case community.FieldLabel:
+		return m.OldLabel(ctx)
+	case community.FieldSize:
+		return m.OldSize(ctx)
+	case community.FieldTopMembers:
+		return m.OldTopMembers(ctx)
+	case community.FieldInternalWeight:
+		return m.OldInternalWeight(ctx)
+	case community.FieldModularity:
+		return m.OldModularity(ctx)
+	case community.FieldResolution:
+		return m.OldResolution(ctx)
+	case community.FieldComputedAt:
+		return m.OldComputedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Community field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *EmailMutation) SetField(name string, value ent.Value) error {
+func (m *CommunityMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case email.FieldMessageID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMessageID(v)
-		return nil
-	case email.FieldFrom:
+	case community.FieldLabel:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFrom(v)
-		return nil
-	case email.FieldTo:
-		v, ok := value.([]string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTo(v)
+		m.SetLabel(v)
 		return nil
-	case email.FieldCc:
-		v, ok := value.([]string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCc(v)
-		return nil
-	case email.FieldBcc:
-		v, ok := value.([]string)
+	case community.FieldSize:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBcc(v)
+		m.SetSize(v)
 		return nil
-	case email.FieldSubject:
-		v, ok := value.(string)
+	case community.FieldTopMembers:
+		v, ok := value.([]int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSubject(v)
+		m.SetTopMembers(v)
 		return nil
-	case email.FieldDate:
-		v, ok := value.(time.Time)
+	case community.FieldInternalWeight:
+		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDate(v)
+		m.SetInternalWeight(v)
 		return nil
-	case email.FieldBody:
-		v, ok := value.(string)
+	case community.FieldModularity:
+		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBody(v)
+		m.SetModularity(v)
 		return nil
-	case email.FieldFilePath:
-		v, ok := value.(string)
+	case community.FieldResolution:
+		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFilePath(v)
+		m.SetResolution(v)
 		return nil
-	case email.FieldCreatedAt:
+	case community.FieldComputedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetComputedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Email field %s", name)
+	return fmt.Errorf("unknown Community field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *EmailMutation) AddedFields() []string {
-	return nil
+func (m *CommunityMutation) AddedFields() []string {
+	var fields []string
+	if m.addsize != nil {
+		fields = append(fields, community.FieldSize)
+	}
+	if m.addinternal_weight != nil {
+		fields = append(fields, community.FieldInternalWeight)
+	}
+	if m.addmodularity != nil {
+		fields = append(fields, community.FieldModularity)
+	}
+	if m.addresolution != nil {
+		fields = append(fields, community.FieldResolution)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *EmailMutation) AddedField(name string) (ent.Value, bool) {
+func (m *CommunityMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case community.FieldSize:
+		return m.AddedSize()
+	case community.FieldInternalWeight:
+		return m.AddedInternalWeight()
+	case community.FieldModularity:
+		return m.AddedModularity()
+	case community.FieldResolution:
+		return m.AddedResolution()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *EmailMutation) AddField(name string, value ent.Value) error {
+func (m *CommunityMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case community.FieldSize:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSize(v)
+		return nil
+	case community.FieldInternalWeight:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddInternalWeight(v)
+		return nil
+	case community.FieldModularity:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddModularity(v)
+		return nil
+	case community.FieldResolution:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddResolution(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Email numeric field %s", name)
+	return fmt.Errorf("unknown Community numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *EmailMutation) ClearedFields() []string {
+func (m *CommunityMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(email.FieldTo) {
-		fields = append(fields, email.FieldTo)
-	}
-	if m.FieldCleared(email.FieldCc) {
-		fields = append(fields, email.FieldCc)
-	}
-	if m.FieldCleared(email.FieldBcc) {
-		fields = append(fields, email.FieldBcc)
-	}
-	if m.FieldCleared(email.FieldFilePath) {
-		fields = append(fields, email.FieldFilePath)
+	if m.FieldCleared(community.FieldTopMembers) {
+		fields = append(fields, community.FieldTopMembers)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *EmailMutation) FieldCleared(name string) bool {
+func (m *CommunityMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *EmailMutation) ClearField(name string) error {
+func (m *CommunityMutation) ClearField(name string) error {
 	switch name {
-	case email.FieldTo:
-		m.ClearTo()
-		return nil
-	case email.FieldCc:
-		m.ClearCc()
-		return nil
-	case email.FieldBcc:
-		m.ClearBcc()
-		return nil
-	case email.FieldFilePath:
-		m.ClearFilePath()
+	case community.FieldTopMembers:
+		m.ClearTopMembers()
 		return nil
 	}
-	return fmt.Errorf("unknown Email nullable field %s", name)
+	return fmt.Errorf("unknown Community nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *EmailMutation) ResetField(name string) error {
+func (m *CommunityMutation) ResetField(name string) error {
 	switch name {
-	case email.FieldMessageID:
-		m.ResetMessageID()
-		return nil
-	case email.FieldFrom:
-		m.ResetFrom()
-		return nil
-	case email.FieldTo:
-		m.ResetTo()
-		return nil
-	case email.FieldCc:
-		m.ResetCc()
+	case community.FieldLabel:
+		m.ResetLabel()
 		return nil
-	case email.FieldBcc:
-		m.ResetBcc()
+	case community.FieldSize:
+		m.ResetSize()
 		return nil
-	case email.FieldSubject:
-		m.ResetSubject()
+	case community.FieldTopMembers:
+		m.ResetTopMembers()
 		return nil
-	case email.FieldDate:
-		m.ResetDate()
+	case community.FieldInternalWeight:
+		m.ResetInternalWeight()
 		return nil
-	case email.FieldBody:
-		m.ResetBody()
+	case community.FieldModularity:
+		m.ResetModularity()
 		return nil
-	case email.FieldFilePath:
-		m.ResetFilePath()
+	case community.FieldResolution:
+		m.ResetResolution()
 		return nil
-	case email.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case community.FieldComputedAt:
+		m.ResetComputedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Email field %s", name)
+	return fmt.Errorf("unknown Community field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *EmailMutation) AddedEdges() []string {
+func (m *CommunityMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *EmailMutation) AddedIDs(name string) []ent.Value {
+func (m *CommunityMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *EmailMutation) RemovedEdges() []string {
+func (m *CommunityMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *EmailMutation) RemovedIDs(name string) []ent.Value {
+func (m *CommunityMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *EmailMutation) ClearedEdges() []string {
+func (m *CommunityMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *EmailMutation) EdgeCleared(name string) bool {
+func (m *CommunityMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *EmailMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Email unique edge %s", name)
+func (m *CommunityMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Community unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *EmailMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Email edge %s", name)
+func (m *CommunityMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Community edge %s", name)
 }
 
-// RelationshipMutation represents an operation that mutates the Relationship nodes in the graph.
-type RelationshipMutation struct {
+// DiscoveredEntityMutation represents an operation that mutates the DiscoveredEntity nodes in the graph.
+type DiscoveredEntityMutation struct {
 	config
 	op                  Op
 	typ                 string
 	id                  *int
-	_type               *string
-	from_type           *string
-	from_id             *int
-	addfrom_id          *int
-	to_type             *string
-	to_id               *int
-	addto_id            *int
-	timestamp           *time.Time
+	unique_id           *string
+	type_category       *string
+	name                *string
+	properties          *map[string]interface{}
+	embedding           *[]float32
+	appendembedding     []float32
 	confidence_score    *float64
 	addconfidence_score *float64
-	properties          *map[string]interface{}
 	created_at          *time.Time
 	clearedFields       map[string]struct{}
 	done                bool
-	oldValue            func(context.Context) (*Relationship, error)
-	predicates          []predicate.Relationship
+	oldValue            func(context.Context) (*DiscoveredEntity, error)
+	predicates          []predicate.DiscoveredEntity
 }
 
-var _ ent.Mutation = (*RelationshipMutation)(nil)
+var _ ent.Mutation = (*DiscoveredEntityMutation)(nil)
 
-// relationshipOption allows management of the mutation configuration using functional options.
-type relationshipOption func(*RelationshipMutation)
+// discoveredentityOption allows management of the mutation configuration using functional options.
+type discoveredentityOption func(*DiscoveredEntityMutation)
 
-// newRelationshipMutation creates new mutation for the Relationship entity.
-func newRelationshipMutation(c config, op Op, opts ...relationshipOption) *RelationshipMutation {
-	m := &RelationshipMutation{
+// newDiscoveredEntityMutation creates new mutation for the DiscoveredEntity entity.
+func newDiscoveredEntityMutation(c config, op Op, opts ...discoveredentityOption) *DiscoveredEntityMutation {
+	m := &DiscoveredEntityMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeRelationship,
+		typ:           TypeDiscoveredEntity,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1762,20 +1816,20 @@ func newRelationshipMutation(c config, op Op, opts ...relationshipOption) *Relat
 	return m
 }
 
-// withRelationshipID sets the ID field of the mutation.
-func withRelationshipID(id int) relationshipOption {
-	return func(m *RelationshipMutation) {
+// withDiscoveredEntityID sets the ID field of the mutation.
+func withDiscoveredEntityID(id int) discoveredentityOption {
+	return func(m *DiscoveredEntityMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Relationship
+			value *DiscoveredEntity
 		)
-		m.oldValue = func(ctx context.Context) (*Relationship, error) {
+		m.oldValue = func(ctx context.Context) (*DiscoveredEntity, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Relationship.Get(ctx, id)
+					value, err = m.Client().DiscoveredEntity.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1784,10 +1838,10 @@ func withRelationshipID(id int) relationshipOption {
 	}
 }
 
-// withRelationship sets the old Relationship of the mutation.
-func withRelationship(node *Relationship) relationshipOption {
-	return func(m *RelationshipMutation) {
-		m.oldValue = func(context.Context) (*Relationship, error) {
+// withDiscoveredEntity sets the old DiscoveredEntity of the mutation.
+func withDiscoveredEntity(node *DiscoveredEntity) discoveredentityOption {
+	return func(m *DiscoveredEntityMutation) {
+		m.oldValue = func(context.Context) (*DiscoveredEntity, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1796,7 +1850,7 @@ func withRelationship(node *Relationship) relationshipOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m RelationshipMutation) Client() *Client {
+func (m DiscoveredEntityMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1804,7 +1858,7 @@ func (m RelationshipMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m RelationshipMutation) Tx() (*Tx, error) {
+func (m DiscoveredEntityMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1815,7 +1869,7 @@ func (m RelationshipMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *RelationshipMutation) ID() (id int, exists bool) {
+func (m *DiscoveredEntityMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1826,7 +1880,7 @@ func (m *RelationshipMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *RelationshipMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *DiscoveredEntityMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1835,276 +1889,242 @@ func (m *RelationshipMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Relationship.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().DiscoveredEntity.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetType sets the "type" field.
-func (m *RelationshipMutation) SetType(s string) {
-	m._type = &s
+// SetUniqueID sets the "unique_id" field.
+func (m *DiscoveredEntityMutation) SetUniqueID(s string) {
+	m.unique_id = &s
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *RelationshipMutation) GetType() (r string, exists bool) {
-	v := m._type
+// UniqueID returns the value of the "unique_id" field in the mutation.
+func (m *DiscoveredEntityMutation) UniqueID() (r string, exists bool) {
+	v := m.unique_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the Relationship entity.
-// If the Relationship object wasn't provided to the builder, the object is fetched from the database.
+// OldUniqueID returns the old "unique_id" field's value of the DiscoveredEntity entity.
+// If the DiscoveredEntity object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RelationshipMutation) OldType(ctx context.Context) (v string, err error) {
+func (m *DiscoveredEntityMutation) OldUniqueID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
+		return v, errors.New("OldUniqueID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
+		return v, errors.New("OldUniqueID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+		return v, fmt.Errorf("querying old value for OldUniqueID: %w", err)
 	}
-	return oldValue.Type, nil
+	return oldValue.UniqueID, nil
 }
 
-// ResetType resets all changes to the "type" field.
-func (m *RelationshipMutation) ResetType() {
-	m._type = nil
+// ResetUniqueID resets all changes to the "unique_id" field.
+func (m *DiscoveredEntityMutation) ResetUniqueID() {
+	m.unique_id = nil
 }
 
-// SetFromType sets the "from_type" field.
-func (m *RelationshipMutation) SetFromType(s string) {
-	m.from_type = &s
+// SetTypeCategory sets the "type_category" field.
+func (m *DiscoveredEntityMutation) SetTypeCategory(s string) {
+	m.type_category = &s
 }
 
-// FromType returns the value of the "from_type" field in the mutation.
-func (m *RelationshipMutation) FromType() (r string, exists bool) {
-	v := m.from_type
+// TypeCategory returns the value of the "type_category" field in the mutation.
+func (m *DiscoveredEntityMutation) TypeCategory() (r string, exists bool) {
+	v := m.type_category
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFromType returns the old "from_type" field's value of the Relationship entity.
-// If the Relationship object wasn't provided to the builder, the object is fetched from the database.
+// OldTypeCategory returns the old "type_category" field's value of the DiscoveredEntity entity.
+// If the DiscoveredEntity object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RelationshipMutation) OldFromType(ctx context.Context) (v string, err error) {
+func (m *DiscoveredEntityMutation) OldTypeCategory(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFromType is only allowed on UpdateOne operations")
+		return v, errors.New("OldTypeCategory is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFromType requires an ID field in the mutation")
+		return v, errors.New("OldTypeCategory requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFromType: %w", err)
+		return v, fmt.Errorf("querying old value for OldTypeCategory: %w", err)
 	}
-	return oldValue.FromType, nil
+	return oldValue.TypeCategory, nil
 }
 
-// ResetFromType resets all changes to the "from_type" field.
-func (m *RelationshipMutation) ResetFromType() {
-	m.from_type = nil
+// ResetTypeCategory resets all changes to the "type_category" field.
+func (m *DiscoveredEntityMutation) ResetTypeCategory() {
+	m.type_category = nil
 }
 
-// SetFromID sets the "from_id" field.
-func (m *RelationshipMutation) SetFromID(i int) {
-	m.from_id = &i
-	m.addfrom_id = nil
+// SetName sets the "name" field.
+func (m *DiscoveredEntityMutation) SetName(s string) {
+	m.name = &s
 }
 
-// FromID returns the value of the "from_id" field in the mutation.
-func (m *RelationshipMutation) FromID() (r int, exists bool) {
-	v := m.from_id
+// Name returns the value of the "name" field in the mutation.
+func (m *DiscoveredEntityMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFromID returns the old "from_id" field's value of the Relationship entity.
-// If the Relationship object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the DiscoveredEntity entity.
+// If the DiscoveredEntity object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RelationshipMutation) OldFromID(ctx context.Context) (v int, err error) {
+func (m *DiscoveredEntityMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFromID is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFromID requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFromID: %w", err)
-	}
-	return oldValue.FromID, nil
-}
-
-// AddFromID adds i to the "from_id" field.
-func (m *RelationshipMutation) AddFromID(i int) {
-	if m.addfrom_id != nil {
-		*m.addfrom_id += i
-	} else {
-		m.addfrom_id = &i
-	}
-}
-
-// AddedFromID returns the value that was added to the "from_id" field in this mutation.
-func (m *RelationshipMutation) AddedFromID() (r int, exists bool) {
-	v := m.addfrom_id
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return *v, true
+	return oldValue.Name, nil
 }
 
-// ResetFromID resets all changes to the "from_id" field.
-func (m *RelationshipMutation) ResetFromID() {
-	m.from_id = nil
-	m.addfrom_id = nil
+// ResetName resets all changes to the "name" field.
+func (m *DiscoveredEntityMutation) ResetName() {
+	m.name = nil
 }
 
-// SetToType sets the "to_type" field.
-func (m *RelationshipMutation) SetToType(s string) {
-	m.to_type = &s
+// SetProperties sets the "properties" field.
+func (m *DiscoveredEntityMutation) SetProperties(value map[string]interface{}) {
+	m.properties = &value
 }
 
-// ToType returns the value of the "to_type" field in the mutation.
-func (m *RelationshipMutation) ToType() (r string, exists bool) {
-	v := m.to_type
+// Properties returns the value of the "properties" field in the mutation.
+func (m *DiscoveredEntityMutation) Properties() (r map[string]interface{}, exists bool) {
+	v := m.properties
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldToType returns the old "to_type" field's value of the Relationship entity.
-// If the Relationship object wasn't provided to the builder, the object is fetched from the database.
+// OldProperties returns the old "properties" field's value of the DiscoveredEntity entity.
+// If the DiscoveredEntity object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RelationshipMutation) OldToType(ctx context.Context) (v string, err error) {
+func (m *DiscoveredEntityMutation) OldProperties(ctx context.Context) (v map[string]interface{}, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldToType is only allowed on UpdateOne operations")
+		return v, errors.New("OldProperties is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldToType requires an ID field in the mutation")
+		return v, errors.New("OldProperties requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldToType: %w", err)
+		return v, fmt.Errorf("querying old value for OldProperties: %w", err)
 	}
-	return oldValue.ToType, nil
+	return oldValue.Properties, nil
 }
 
-// ResetToType resets all changes to the "to_type" field.
-func (m *RelationshipMutation) ResetToType() {
-	m.to_type = nil
+// ClearProperties clears the value of the "properties" field.
+func (m *DiscoveredEntityMutation) ClearProperties() {
+	m.properties = nil
+	m.clearedFields[discoveredentity.FieldProperties] = struct{}{}
 }
 
-// SetToID sets the "to_id" field.
-func (m *RelationshipMutation) SetToID(i int) {
-	m.to_id = &i
-	m.addto_id = nil
+// PropertiesCleared returns if the "properties" field was cleared in this mutation.
+func (m *DiscoveredEntityMutation) PropertiesCleared() bool {
+	_, ok := m.clearedFields[discoveredentity.FieldProperties]
+	return ok
 }
 
-// ToID returns the value of the "to_id" field in the mutation.
-func (m *RelationshipMutation) ToID() (r int, exists bool) {
-	v := m.to_id
+// ResetProperties resets all changes to the "properties" field.
+func (m *DiscoveredEntityMutation) ResetProperties() {
+	m.properties = nil
+	delete(m.clearedFields, discoveredentity.FieldProperties)
+}
+
+// SetEmbedding sets the "embedding" field.
+func (m *DiscoveredEntityMutation) SetEmbedding(f []float32) {
+	m.embedding = &f
+	m.appendembedding = nil
+}
+
+// Embedding returns the value of the "embedding" field in the mutation.
+func (m *DiscoveredEntityMutation) Embedding() (r []float32, exists bool) {
+	v := m.embedding
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldToID returns the old "to_id" field's value of the Relationship entity.
-// If the Relationship object wasn't provided to the builder, the object is fetched from the database.
+// OldEmbedding returns the old "embedding" field's value of the DiscoveredEntity entity.
+// If the DiscoveredEntity object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RelationshipMutation) OldToID(ctx context.Context) (v int, err error) {
+func (m *DiscoveredEntityMutation) OldEmbedding(ctx context.Context) (v []float32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldToID is only allowed on UpdateOne operations")
+		return v, errors.New("OldEmbedding is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldToID requires an ID field in the mutation")
+		return v, errors.New("OldEmbedding requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldToID: %w", err)
+		return v, fmt.Errorf("querying old value for OldEmbedding: %w", err)
 	}
-	return oldValue.ToID, nil
+	return oldValue.Embedding, nil
 }
 
-// AddToID adds i to the "to_id" field.
-func (m *RelationshipMutation) AddToID(i int) {
-	if m.addto_id != nil {
-		*m.addto_id += i
-	} else {
-		m.addto_id = &i
-	}
+// AppendEmbedding adds f to the "embedding" field.
+func (m *DiscoveredEntityMutation) AppendEmbedding(f []float32) {
+	m.appendembedding = append(m.appendembedding, f...)
 }
 
-// AddedToID returns the value that was added to the "to_id" field in this mutation.
-func (m *RelationshipMutation) AddedToID() (r int, exists bool) {
-	v := m.addto_id
-	if v == nil {
-		return
+// AppendedEmbedding returns the list of values that were appended to the "embedding" field in this mutation.
+func (m *DiscoveredEntityMutation) AppendedEmbedding() ([]float32, bool) {
+	if len(m.appendembedding) == 0 {
+		return nil, false
 	}
-	return *v, true
+	return m.appendembedding, true
 }
 
-// ResetToID resets all changes to the "to_id" field.
-func (m *RelationshipMutation) ResetToID() {
-	m.to_id = nil
-	m.addto_id = nil
+// ClearEmbedding clears the value of the "embedding" field.
+func (m *DiscoveredEntityMutation) ClearEmbedding() {
+	m.embedding = nil
+	m.appendembedding = nil
+	m.clearedFields[discoveredentity.FieldEmbedding] = struct{}{}
 }
 
-// SetTimestamp sets the "timestamp" field.
-func (m *RelationshipMutation) SetTimestamp(t time.Time) {
-	m.timestamp = &t
+// EmbeddingCleared returns if the "embedding" field was cleared in this mutation.
+func (m *DiscoveredEntityMutation) EmbeddingCleared() bool {
+	_, ok := m.clearedFields[discoveredentity.FieldEmbedding]
+	return ok
 }
 
-// Timestamp returns the value of the "timestamp" field in the mutation.
-func (m *RelationshipMutation) Timestamp() (r time.Time, exists bool) {
-	v := m.timestamp
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldTimestamp returns the old "timestamp" field's value of the Relationship entity.
-// If the Relationship object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RelationshipMutation) OldTimestamp(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTimestamp is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTimestamp requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTimestamp: %w", err)
-	}
-	return oldValue.Timestamp, nil
-}
-
-// ResetTimestamp resets all changes to the "timestamp" field.
-func (m *RelationshipMutation) ResetTimestamp() {
-	m.timestamp = nil
+// ResetEmbedding resets all changes to the "embedding" field.
+func (m *DiscoveredEntityMutation) ResetEmbedding() {
+	m.embedding = nil
+	m.appendembedding = nil
+	delete(m.clearedFields, discoveredentity.FieldEmbedding)
 }
 
 // SetConfidenceScore sets the "confidence_score" field.
-func (m *RelationshipMutation) SetConfidenceScore(f float64) {
+func (m *DiscoveredEntityMutation) SetConfidenceScore(f float64) {
 	m.confidence_score = &f
 	m.addconfidence_score = nil
 }
 
 // ConfidenceScore returns the value of the "confidence_score" field in the mutation.
-func (m *RelationshipMutation) ConfidenceScore() (r float64, exists bool) {
+func (m *DiscoveredEntityMutation) ConfidenceScore() (r float64, exists bool) {
 	v := m.confidence_score
 	if v == nil {
 		return
@@ -2112,10 +2132,10 @@ func (m *RelationshipMutation) ConfidenceScore() (r float64, exists bool) {
 	return *v, true
 }
 
-// OldConfidenceScore returns the old "confidence_score" field's value of the Relationship entity.
-// If the Relationship object wasn't provided to the builder, the object is fetched from the database.
+// OldConfidenceScore returns the old "confidence_score" field's value of the DiscoveredEntity entity.
+// If the DiscoveredEntity object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RelationshipMutation) OldConfidenceScore(ctx context.Context) (v float64, err error) {
+func (m *DiscoveredEntityMutation) OldConfidenceScore(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldConfidenceScore is only allowed on UpdateOne operations")
 	}
@@ -2130,7 +2150,7 @@ func (m *RelationshipMutation) OldConfidenceScore(ctx context.Context) (v float6
 }
 
 // AddConfidenceScore adds f to the "confidence_score" field.
-func (m *RelationshipMutation) AddConfidenceScore(f float64) {
+func (m *DiscoveredEntityMutation) AddConfidenceScore(f float64) {
 	if m.addconfidence_score != nil {
 		*m.addconfidence_score += f
 	} else {
@@ -2139,7 +2159,7 @@ func (m *RelationshipMutation) AddConfidenceScore(f float64) {
 }
 
 // AddedConfidenceScore returns the value that was added to the "confidence_score" field in this mutation.
-func (m *RelationshipMutation) AddedConfidenceScore() (r float64, exists bool) {
+func (m *DiscoveredEntityMutation) AddedConfidenceScore() (r float64, exists bool) {
 	v := m.addconfidence_score
 	if v == nil {
 		return
@@ -2148,67 +2168,18 @@ func (m *RelationshipMutation) AddedConfidenceScore() (r float64, exists bool) {
 }
 
 // ResetConfidenceScore resets all changes to the "confidence_score" field.
-func (m *RelationshipMutation) ResetConfidenceScore() {
+func (m *DiscoveredEntityMutation) ResetConfidenceScore() {
 	m.confidence_score = nil
 	m.addconfidence_score = nil
 }
 
-// SetProperties sets the "properties" field.
-func (m *RelationshipMutation) SetProperties(value map[string]interface{}) {
-	m.properties = &value
-}
-
-// Properties returns the value of the "properties" field in the mutation.
-func (m *RelationshipMutation) Properties() (r map[string]interface{}, exists bool) {
-	v := m.properties
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldProperties returns the old "properties" field's value of the Relationship entity.
-// If the Relationship object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RelationshipMutation) OldProperties(ctx context.Context) (v map[string]interface{}, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProperties is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProperties requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProperties: %w", err)
-	}
-	return oldValue.Properties, nil
-}
-
-// ClearProperties clears the value of the "properties" field.
-func (m *RelationshipMutation) ClearProperties() {
-	m.properties = nil
-	m.clearedFields[relationship.FieldProperties] = struct{}{}
-}
-
-// PropertiesCleared returns if the "properties" field was cleared in this mutation.
-func (m *RelationshipMutation) PropertiesCleared() bool {
-	_, ok := m.clearedFields[relationship.FieldProperties]
-	return ok
-}
-
-// ResetProperties resets all changes to the "properties" field.
-func (m *RelationshipMutation) ResetProperties() {
-	m.properties = nil
-	delete(m.clearedFields, relationship.FieldProperties)
-}
-
 // SetCreatedAt sets the "created_at" field.
-func (m *RelationshipMutation) SetCreatedAt(t time.Time) {
+func (m *DiscoveredEntityMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *RelationshipMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *DiscoveredEntityMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -2216,10 +2187,10 @@ func (m *RelationshipMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Relationship entity.
-// If the Relationship object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the DiscoveredEntity entity.
+// If the DiscoveredEntity object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RelationshipMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *DiscoveredEntityMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -2234,19 +2205,19 @@ func (m *RelationshipMutation) OldCreatedAt(ctx context.Context) (v time.Time, e
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *RelationshipMutation) ResetCreatedAt() {
+func (m *DiscoveredEntityMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// Where appends a list predicates to the RelationshipMutation builder.
-func (m *RelationshipMutation) Where(ps ...predicate.Relationship) {
+// Where appends a list predicates to the DiscoveredEntityMutation builder.
+func (m *DiscoveredEntityMutation) Where(ps ...predicate.DiscoveredEntity) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the RelationshipMutation builder. Using this method,
+// WhereP appends storage-level predicates to the DiscoveredEntityMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *RelationshipMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Relationship, len(ps))
+func (m *DiscoveredEntityMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DiscoveredEntity, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2254,51 +2225,45 @@ func (m *RelationshipMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *RelationshipMutation) Op() Op {
+func (m *DiscoveredEntityMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *RelationshipMutation) SetOp(op Op) {
+func (m *DiscoveredEntityMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Relationship).
-func (m *RelationshipMutation) Type() string {
+// Type returns the node type of this mutation (DiscoveredEntity).
+func (m *DiscoveredEntityMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *RelationshipMutation) Fields() []string {
-	fields := make([]string, 0, 9)
-	if m._type != nil {
-		fields = append(fields, relationship.FieldType)
-	}
-	if m.from_type != nil {
-		fields = append(fields, relationship.FieldFromType)
+func (m *DiscoveredEntityMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.unique_id != nil {
+		fields = append(fields, discoveredentity.FieldUniqueID)
 	}
-	if m.from_id != nil {
-		fields = append(fields, relationship.FieldFromID)
+	if m.type_category != nil {
+		fields = append(fields, discoveredentity.FieldTypeCategory)
 	}
-	if m.to_type != nil {
-		fields = append(fields, relationship.FieldToType)
+	if m.name != nil {
+		fields = append(fields, discoveredentity.FieldName)
 	}
-	if m.to_id != nil {
-		fields = append(fields, relationship.FieldToID)
+	if m.properties != nil {
+		fields = append(fields, discoveredentity.FieldProperties)
 	}
-	if m.timestamp != nil {
-		fields = append(fields, relationship.FieldTimestamp)
+	if m.embedding != nil {
+		fields = append(fields, discoveredentity.FieldEmbedding)
 	}
 	if m.confidence_score != nil {
-		fields = append(fields, relationship.FieldConfidenceScore)
-	}
-	if m.properties != nil {
-		fields = append(fields, relationship.FieldProperties)
+		fields = append(fields, discoveredentity.FieldConfidenceScore)
 	}
 	if m.created_at != nil {
-		fields = append(fields, relationship.FieldCreatedAt)
+		fields = append(fields, discoveredentity.FieldCreatedAt)
 	}
 	return fields
 }
@@ -2306,25 +2271,21 @@ func (m *RelationshipMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *RelationshipMutation) Field(name string) (ent.Value, bool) {
+func (m *DiscoveredEntityMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case relationship.FieldType:
-		return m.GetType()
-	case relationship.FieldFromType:
-		return m.FromType()
-	case relationship.FieldFromID:
-		return m.FromID()
-	case relationship.FieldToType:
-		return m.ToType()
-	case relationship.FieldToID:
-		return m.ToID()
-	case relationship.FieldTimestamp:
-		return m.Timestamp()
-	case relationship.FieldConfidenceScore:
-		return m.ConfidenceScore()
-	case relationship.FieldProperties:
+	case discoveredentity.FieldUniqueID:
+		return m.UniqueID()
+	case discoveredentity.FieldTypeCategory:
+		return m.TypeCategory()
+	case discoveredentity.FieldName:
+		return m.Name()
+	case discoveredentity.FieldProperties:
 		return m.Properties()
-	case relationship.FieldCreatedAt:
+	case discoveredentity.FieldEmbedding:
+		return m.Embedding()
+	case discoveredentity.FieldConfidenceScore:
+		return m.ConfidenceScore()
+	case discoveredentity.FieldCreatedAt:
 		return m.CreatedAt()
 	}
 	return nil, false
@@ -2333,114 +2294,6331 @@ func (m *RelationshipMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *RelationshipMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *DiscoveredEntityMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case relationship.FieldType:
-		return m.OldType(ctx)
-	case relationship.FieldFromType:
-		return m.OldFromType(ctx)
-	case relationship.FieldFromID:
-		return m.OldFromID(ctx)
-	case relationship.FieldToType:
-		return m.OldToType(ctx)
-	case relationship.FieldToID:
-		return m.OldToID(ctx)
-	case relationship.FieldTimestamp:
-		return m.OldTimestamp(ctx)
-	case relationship.FieldConfidenceScore:
-		return m.OldConfidenceScore(ctx)
-	case relationship.FieldProperties:
+	case discoveredentity.FieldUniqueID:
+		return m.OldUniqueID(ctx)
+	case discoveredentity.FieldTypeCategory:
+		return m.OldTypeCategory(ctx)
+	case discoveredentity.FieldName:
+		return m.OldName(ctx)
+	case discoveredentity.FieldProperties:
 		return m.OldProperties(ctx)
-	case relationship.FieldCreatedAt:
+	case discoveredentity.FieldEmbedding:
+		return m.OldEmbedding(ctx)
+	case discoveredentity.FieldConfidenceScore:
+		return m.OldConfidenceScore(ctx)
+	case discoveredentity.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Relationship field %s", name)
+	return nil, fmt.Errorf("unknown DiscoveredEntity field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RelationshipMutation) SetField(name string, value ent.Value) error {
+func (m *DiscoveredEntityMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case relationship.FieldType:
+	case discoveredentity.FieldUniqueID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetType(v)
+		m.SetUniqueID(v)
 		return nil
-	case relationship.FieldFromType:
+	case discoveredentity.FieldTypeCategory:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFromType(v)
-		return nil
-	case relationship.FieldFromID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFromID(v)
+		m.SetTypeCategory(v)
 		return nil
-	case relationship.FieldToType:
+	case discoveredentity.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetToType(v)
+		m.SetName(v)
 		return nil
-	case relationship.FieldToID:
-		v, ok := value.(int)
+	case discoveredentity.FieldProperties:
+		v, ok := value.(map[string]interface{})
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetToID(v)
+		m.SetProperties(v)
 		return nil
-	case relationship.FieldTimestamp:
-		v, ok := value.(time.Time)
+	case discoveredentity.FieldEmbedding:
+		v, ok := value.([]float32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTimestamp(v)
+		m.SetEmbedding(v)
 		return nil
-	case relationship.FieldConfidenceScore:
+	case discoveredentity.FieldConfidenceScore:
 		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetConfidenceScore(v)
 		return nil
-	case relationship.FieldProperties:
-		v, ok := value.(map[string]interface{})
+	case discoveredentity.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DiscoveredEntity field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DiscoveredEntityMutation) AddedFields() []string {
+	var fields []string
+	if m.addconfidence_score != nil {
+		fields = append(fields, discoveredentity.FieldConfidenceScore)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DiscoveredEntityMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case discoveredentity.FieldConfidenceScore:
+		return m.AddedConfidenceScore()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DiscoveredEntityMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case discoveredentity.FieldConfidenceScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddConfidenceScore(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DiscoveredEntity numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DiscoveredEntityMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(discoveredentity.FieldProperties) {
+		fields = append(fields, discoveredentity.FieldProperties)
+	}
+	if m.FieldCleared(discoveredentity.FieldEmbedding) {
+		fields = append(fields, discoveredentity.FieldEmbedding)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DiscoveredEntityMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DiscoveredEntityMutation) ClearField(name string) error {
+	switch name {
+	case discoveredentity.FieldProperties:
+		m.ClearProperties()
+		return nil
+	case discoveredentity.FieldEmbedding:
+		m.ClearEmbedding()
+		return nil
+	}
+	return fmt.Errorf("unknown DiscoveredEntity nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DiscoveredEntityMutation) ResetField(name string) error {
+	switch name {
+	case discoveredentity.FieldUniqueID:
+		m.ResetUniqueID()
+		return nil
+	case discoveredentity.FieldTypeCategory:
+		m.ResetTypeCategory()
+		return nil
+	case discoveredentity.FieldName:
+		m.ResetName()
+		return nil
+	case discoveredentity.FieldProperties:
+		m.ResetProperties()
+		return nil
+	case discoveredentity.FieldEmbedding:
+		m.ResetEmbedding()
+		return nil
+	case discoveredentity.FieldConfidenceScore:
+		m.ResetConfidenceScore()
+		return nil
+	case discoveredentity.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown DiscoveredEntity field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DiscoveredEntityMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DiscoveredEntityMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DiscoveredEntityMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DiscoveredEntityMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DiscoveredEntityMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DiscoveredEntityMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DiscoveredEntityMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown DiscoveredEntity unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DiscoveredEntityMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown DiscoveredEntity edge %s", name)
+}
+
+// EmailMutation represents an operation that mutates the Email nodes in the graph.
+type EmailMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *int
+	message_id       *string
+	from             *string
+	to               *[]string
+	appendto         []string
+	cc               *[]string
+	appendcc         []string
+	bcc              *[]string
+	appendbcc        []string
+	display_names    *map[string]string
+	in_reply_to      *string
+	references       *[]string
+	appendreferences []string
+	subject          *string
+	date             *time.Time
+	body             *string
+	file_path        *string
+	created_at       *time.Time
+	clearedFields    map[string]struct{}
+	done             bool
+	oldValue         func(context.Context) (*Email, error)
+	predicates       []predicate.Email
+}
+
+var _ ent.Mutation = (*EmailMutation)(nil)
+
+// emailOption allows management of the mutation configuration using functional options.
+type emailOption func(*EmailMutation)
+
+// newEmailMutation creates new mutation for the Email entity.
+func newEmailMutation(c config, op Op, opts ...emailOption) *EmailMutation {
+	m := &EmailMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEmail,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withEmailID sets the ID field of the mutation.
+func withEmailID(id int) emailOption {
+	return func(m *EmailMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Email
+		)
+		m.oldValue = func(ctx context.Context) (*Email, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Email.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withEmail sets the old Email of the mutation.
+func withEmail(node *Email) emailOption {
+	return func(m *EmailMutation) {
+		m.oldValue = func(context.Context) (*Email, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EmailMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EmailMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EmailMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EmailMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Email.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetMessageID sets the "message_id" field.
+func (m *EmailMutation) SetMessageID(s string) {
+	m.message_id = &s
+}
+
+// MessageID returns the value of the "message_id" field in the mutation.
+func (m *EmailMutation) MessageID() (r string, exists bool) {
+	v := m.message_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessageID returns the old "message_id" field's value of the Email entity.
+// If the Email object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailMutation) OldMessageID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessageID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessageID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessageID: %w", err)
+	}
+	return oldValue.MessageID, nil
+}
+
+// ResetMessageID resets all changes to the "message_id" field.
+func (m *EmailMutation) ResetMessageID() {
+	m.message_id = nil
+}
+
+// SetFrom sets the "from" field.
+func (m *EmailMutation) SetFrom(s string) {
+	m.from = &s
+}
+
+// From returns the value of the "from" field in the mutation.
+func (m *EmailMutation) From() (r string, exists bool) {
+	v := m.from
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFrom returns the old "from" field's value of the Email entity.
+// If the Email object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailMutation) OldFrom(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFrom is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFrom requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFrom: %w", err)
+	}
+	return oldValue.From, nil
+}
+
+// ResetFrom resets all changes to the "from" field.
+func (m *EmailMutation) ResetFrom() {
+	m.from = nil
+}
+
+// SetTo sets the "to" field.
+func (m *EmailMutation) SetTo(s []string) {
+	m.to = &s
+	m.appendto = nil
+}
+
+// To returns the value of the "to" field in the mutation.
+func (m *EmailMutation) To() (r []string, exists bool) {
+	v := m.to
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTo returns the old "to" field's value of the Email entity.
+// If the Email object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailMutation) OldTo(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTo: %w", err)
+	}
+	return oldValue.To, nil
+}
+
+// AppendTo adds s to the "to" field.
+func (m *EmailMutation) AppendTo(s []string) {
+	m.appendto = append(m.appendto, s...)
+}
+
+// AppendedTo returns the list of values that were appended to the "to" field in this mutation.
+func (m *EmailMutation) AppendedTo() ([]string, bool) {
+	if len(m.appendto) == 0 {
+		return nil, false
+	}
+	return m.appendto, true
+}
+
+// ClearTo clears the value of the "to" field.
+func (m *EmailMutation) ClearTo() {
+	m.to = nil
+	m.appendto = nil
+	m.clearedFields[email.FieldTo] = struct{}{}
+}
+
+// ToCleared returns if the "to" field was cleared in this mutation.
+func (m *EmailMutation) ToCleared() bool {
+	_, ok := m.clearedFields[email.FieldTo]
+	return ok
+}
+
+// ResetTo resets all changes to the "to" field.
+func (m *EmailMutation) ResetTo() {
+	m.to = nil
+	m.appendto = nil
+	delete(m.clearedFields, email.FieldTo)
+}
+
+// SetCc sets the "cc" field.
+func (m *EmailMutation) SetCc(s []string) {
+	m.cc = &s
+	m.appendcc = nil
+}
+
+// Cc returns the value of the "cc" field in the mutation.
+func (m *EmailMutation) Cc() (r []string, exists bool) {
+	v := m.cc
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCc returns the old "cc" field's value of the Email entity.
+// If the Email object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailMutation) OldCc(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCc is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCc requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCc: %w", err)
+	}
+	return oldValue.Cc, nil
+}
+
+// AppendCc adds s to the "cc" field.
+func (m *EmailMutation) AppendCc(s []string) {
+	m.appendcc = append(m.appendcc, s...)
+}
+
+// AppendedCc returns the list of values that were appended to the "cc" field in this mutation.
+func (m *EmailMutation) AppendedCc() ([]string, bool) {
+	if len(m.appendcc) == 0 {
+		return nil, false
+	}
+	return m.appendcc, true
+}
+
+// ClearCc clears the value of the "cc" field.
+func (m *EmailMutation) ClearCc() {
+	m.cc = nil
+	m.appendcc = nil
+	m.clearedFields[email.FieldCc] = struct{}{}
+}
+
+// CcCleared returns if the "cc" field was cleared in this mutation.
+func (m *EmailMutation) CcCleared() bool {
+	_, ok := m.clearedFields[email.FieldCc]
+	return ok
+}
+
+// ResetCc resets all changes to the "cc" field.
+func (m *EmailMutation) ResetCc() {
+	m.cc = nil
+	m.appendcc = nil
+	delete(m.clearedFields, email.FieldCc)
+}
+
+// SetBcc sets the "bcc" field.
+func (m *EmailMutation) SetBcc(s []string) {
+	m.bcc = &s
+	m.appendbcc = nil
+}
+
+// Bcc returns the value of the "bcc" field in the mutation.
+func (m *EmailMutation) Bcc() (r []string, exists bool) {
+	v := m.bcc
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBcc returns the old "bcc" field's value of the Email entity.
+// If the Email object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailMutation) OldBcc(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBcc is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBcc requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBcc: %w", err)
+	}
+	return oldValue.Bcc, nil
+}
+
+// AppendBcc adds s to the "bcc" field.
+func (m *EmailMutation) AppendBcc(s []string) {
+	m.appendbcc = append(m.appendbcc, s...)
+}
+
+// AppendedBcc returns the list of values that were appended to the "bcc" field in this mutation.
+func (m *EmailMutation) AppendedBcc() ([]string, bool) {
+	if len(m.appendbcc) == 0 {
+		return nil, false
+	}
+	return m.appendbcc, true
+}
+
+// ClearBcc clears the value of the "bcc" field.
+func (m *EmailMutation) ClearBcc() {
+	m.bcc = nil
+	m.appendbcc = nil
+	m.clearedFields[email.FieldBcc] = struct{}{}
+}
+
+// BccCleared returns if the "bcc" field was cleared in this mutation.
+func (m *EmailMutation) BccCleared() bool {
+	_, ok := m.clearedFields[email.FieldBcc]
+	return ok
+}
+
+// ResetBcc resets all changes to the "bcc" field.
+func (m *EmailMutation) ResetBcc() {
+	m.bcc = nil
+	m.appendbcc = nil
+	delete(m.clearedFields, email.FieldBcc)
+}
+
+// SetDisplayNames sets the "display_names" field.
+func (m *EmailMutation) SetDisplayNames(value map[string]string) {
+	m.display_names = &value
+}
+
+// DisplayNames returns the value of the "display_names" field in the mutation.
+func (m *EmailMutation) DisplayNames() (r map[string]string, exists bool) {
+	v := m.display_names
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDisplayNames returns the old "display_names" field's value of the Email entity.
+// If the Email object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailMutation) OldDisplayNames(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDisplayNames is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDisplayNames requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDisplayNames: %w", err)
+	}
+	return oldValue.DisplayNames, nil
+}
+
+// ClearDisplayNames clears the value of the "display_names" field.
+func (m *EmailMutation) ClearDisplayNames() {
+	m.display_names = nil
+	m.clearedFields[email.FieldDisplayNames] = struct{}{}
+}
+
+// DisplayNamesCleared returns if the "display_names" field was cleared in this mutation.
+func (m *EmailMutation) DisplayNamesCleared() bool {
+	_, ok := m.clearedFields[email.FieldDisplayNames]
+	return ok
+}
+
+// ResetDisplayNames resets all changes to the "display_names" field.
+func (m *EmailMutation) ResetDisplayNames() {
+	m.display_names = nil
+	delete(m.clearedFields, email.FieldDisplayNames)
+}
+
+// SetInReplyTo sets the "in_reply_to" field.
+func (m *EmailMutation) SetInReplyTo(s string) {
+	m.in_reply_to = &s
+}
+
+// InReplyTo returns the value of the "in_reply_to" field in the mutation.
+func (m *EmailMutation) InReplyTo() (r string, exists bool) {
+	v := m.in_reply_to
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInReplyTo returns the old "in_reply_to" field's value of the Email entity.
+// If the Email object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailMutation) OldInReplyTo(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInReplyTo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInReplyTo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInReplyTo: %w", err)
+	}
+	return oldValue.InReplyTo, nil
+}
+
+// ClearInReplyTo clears the value of the "in_reply_to" field.
+func (m *EmailMutation) ClearInReplyTo() {
+	m.in_reply_to = nil
+	m.clearedFields[email.FieldInReplyTo] = struct{}{}
+}
+
+// InReplyToCleared returns if the "in_reply_to" field was cleared in this mutation.
+func (m *EmailMutation) InReplyToCleared() bool {
+	_, ok := m.clearedFields[email.FieldInReplyTo]
+	return ok
+}
+
+// ResetInReplyTo resets all changes to the "in_reply_to" field.
+func (m *EmailMutation) ResetInReplyTo() {
+	m.in_reply_to = nil
+	delete(m.clearedFields, email.FieldInReplyTo)
+}
+
+// SetReferences sets the "references" field.
+func (m *EmailMutation) SetReferences(s []string) {
+	m.references = &s
+	m.appendreferences = nil
+}
+
+// References returns the value of the "references" field in the mutation.
+func (m *EmailMutation) References() (r []string, exists bool) {
+	v := m.references
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReferences returns the old "references" field's value of the Email entity.
+// If the Email object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailMutation) OldReferences(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReferences is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReferences requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReferences: %w", err)
+	}
+	return oldValue.References, nil
+}
+
+// AppendReferences adds s to the "references" field.
+func (m *EmailMutation) AppendReferences(s []string) {
+	m.appendreferences = append(m.appendreferences, s...)
+}
+
+// AppendedReferences returns the list of values that were appended to the "references" field in this mutation.
+func (m *EmailMutation) AppendedReferences() ([]string, bool) {
+	if len(m.appendreferences) == 0 {
+		return nil, false
+	}
+	return m.appendreferences, true
+}
+
+// ClearReferences clears the value of the "references" field.
+func (m *EmailMutation) ClearReferences() {
+	m.references = nil
+	m.appendreferences = nil
+	m.clearedFields[email.FieldReferences] = struct{}{}
+}
+
+// ReferencesCleared returns if the "references" field was cleared in this mutation.
+func (m *EmailMutation) ReferencesCleared() bool {
+	_, ok := m.clearedFields[email.FieldReferences]
+	return ok
+}
+
+// ResetReferences resets all changes to the "references" field.
+func (m *EmailMutation) ResetReferences() {
+	m.references = nil
+	m.appendreferences = nil
+	delete(m.clearedFields, email.FieldReferences)
+}
+
+// SetSubject sets the "subject" field.
+func (m *EmailMutation) SetSubject(s string) {
+	m.subject = &s
+}
+
+// Subject returns the value of the "subject" field in the mutation.
+func (m *EmailMutation) Subject() (r string, exists bool) {
+	v := m.subject
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubject returns the old "subject" field's value of the Email entity.
+// If the Email object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailMutation) OldSubject(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubject is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubject requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubject: %w", err)
+	}
+	return oldValue.Subject, nil
+}
+
+// ResetSubject resets all changes to the "subject" field.
+func (m *EmailMutation) ResetSubject() {
+	m.subject = nil
+}
+
+// SetDate sets the "date" field.
+func (m *EmailMutation) SetDate(t time.Time) {
+	m.date = &t
+}
+
+// Date returns the value of the "date" field in the mutation.
+func (m *EmailMutation) Date() (r time.Time, exists bool) {
+	v := m.date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDate returns the old "date" field's value of the Email entity.
+// If the Email object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailMutation) OldDate(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDate: %w", err)
+	}
+	return oldValue.Date, nil
+}
+
+// ResetDate resets all changes to the "date" field.
+func (m *EmailMutation) ResetDate() {
+	m.date = nil
+}
+
+// SetBody sets the "body" field.
+func (m *EmailMutation) SetBody(s string) {
+	m.body = &s
+}
+
+// Body returns the value of the "body" field in the mutation.
+func (m *EmailMutation) Body() (r string, exists bool) {
+	v := m.body
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBody returns the old "body" field's value of the Email entity.
+// If the Email object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailMutation) OldBody(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBody is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBody requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBody: %w", err)
+	}
+	return oldValue.Body, nil
+}
+
+// ResetBody resets all changes to the "body" field.
+func (m *EmailMutation) ResetBody() {
+	m.body = nil
+}
+
+// SetFilePath sets the "file_path" field.
+func (m *EmailMutation) SetFilePath(s string) {
+	m.file_path = &s
+}
+
+// FilePath returns the value of the "file_path" field in the mutation.
+func (m *EmailMutation) FilePath() (r string, exists bool) {
+	v := m.file_path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFilePath returns the old "file_path" field's value of the Email entity.
+// If the Email object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailMutation) OldFilePath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFilePath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFilePath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFilePath: %w", err)
+	}
+	return oldValue.FilePath, nil
+}
+
+// ClearFilePath clears the value of the "file_path" field.
+func (m *EmailMutation) ClearFilePath() {
+	m.file_path = nil
+	m.clearedFields[email.FieldFilePath] = struct{}{}
+}
+
+// FilePathCleared returns if the "file_path" field was cleared in this mutation.
+func (m *EmailMutation) FilePathCleared() bool {
+	_, ok := m.clearedFields[email.FieldFilePath]
+	return ok
+}
+
+// ResetFilePath resets all changes to the "file_path" field.
+func (m *EmailMutation) ResetFilePath() {
+	m.file_path = nil
+	delete(m.clearedFields, email.FieldFilePath)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *EmailMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *EmailMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Email entity.
+// If the Email object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *EmailMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the EmailMutation builder.
+func (m *EmailMutation) Where(ps ...predicate.Email) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EmailMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EmailMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Email, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EmailMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EmailMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Email).
+func (m *EmailMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EmailMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.message_id != nil {
+		fields = append(fields, email.FieldMessageID)
+	}
+	if m.from != nil {
+		fields = append(fields, email.FieldFrom)
+	}
+	if m.to != nil {
+		fields = append(fields, email.FieldTo)
+	}
+	if m.cc != nil {
+		fields = append(fields, email.FieldCc)
+	}
+	if m.bcc != nil {
+		fields = append(fields, email.FieldBcc)
+	}
+	if m.display_names != nil {
+		fields = append(fields, email.FieldDisplayNames)
+	}
+	if m.in_reply_to != nil {
+		fields = append(fields, email.FieldInReplyTo)
+	}
+	if m.references != nil {
+		fields = append(fields, email.FieldReferences)
+	}
+	if m.subject != nil {
+		fields = append(fields, email.FieldSubject)
+	}
+	if m.date != nil {
+		fields = append(fields, email.FieldDate)
+	}
+	if m.body != nil {
+		fields = append(fields, email.FieldBody)
+	}
+	if m.file_path != nil {
+		fields = append(fields, email.FieldFilePath)
+	}
+	if m.created_at != nil {
+		fields = append(fields, email.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EmailMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case email.FieldMessageID:
+		return m.MessageID()
+	case email.FieldFrom:
+		return m.From()
+	case email.FieldTo:
+		return m.To()
+	case email.FieldCc:
+		return m.Cc()
+	case email.FieldBcc:
+		return m.Bcc()
+	case email.FieldDisplayNames:
+		return m.DisplayNames()
+	case email.FieldInReplyTo:
+		return m.InReplyTo()
+	case email.FieldReferences:
+		return m.References()
+	case email.FieldSubject:
+		return m.Subject()
+	case email.FieldDate:
+		return m.Date()
+	case email.FieldBody:
+		return m.Body()
+	case email.FieldFilePath:
+		return m.FilePath()
+	case email.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EmailMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case email.FieldMessageID:
+		return m.OldMessageID(ctx)
+	case email.FieldFrom:
+		return m.OldFrom(ctx)
+	case email.FieldTo:
+		return m.OldTo(ctx)
+	case email.FieldCc:
+		return m.OldCc(ctx)
+	case email.FieldBcc:
+		return m.OldBcc(ctx)
+	case email.FieldDisplayNames:
+		return m.OldDisplayNames(ctx)
+	case email.FieldInReplyTo:
+		return m.OldInReplyTo(ctx)
+	case email.FieldReferences:
+		return m.OldReferences(ctx)
+	case email.FieldSubject:
+		return m.OldSubject(ctx)
+	case email.FieldDate:
+		return m.OldDate(ctx)
+	case email.FieldBody:
+		return m.OldBody(ctx)
+	case email.FieldFilePath:
+		return m.OldFilePath(ctx)
+	case email.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Email field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EmailMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case email.FieldMessageID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessageID(v)
+		return nil
+	case email.FieldFrom:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFrom(v)
+		return nil
+	case email.FieldTo:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTo(v)
+		return nil
+	case email.FieldCc:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCc(v)
+		return nil
+	case email.FieldBcc:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBcc(v)
+		return nil
+	case email.FieldDisplayNames:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDisplayNames(v)
+		return nil
+	case email.FieldInReplyTo:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInReplyTo(v)
+		return nil
+	case email.FieldReferences:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReferences(v)
+		return nil
+	case email.FieldSubject:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubject(v)
+		return nil
+	case email.FieldDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDate(v)
+		return nil
+	case email.FieldBody:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBody(v)
+		return nil
+	case email.FieldFilePath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFilePath(v)
+		return nil
+	case email.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Email field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EmailMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EmailMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EmailMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Email numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EmailMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(email.FieldTo) {
+		fields = append(fields, email.FieldTo)
+	}
+	if m.FieldCleared(email.FieldCc) {
+		fields = append(fields, email.FieldCc)
+	}
+	if m.FieldCleared(email.FieldBcc) {
+		fields = append(fields, email.FieldBcc)
+	}
+	if m.FieldCleared(email.FieldDisplayNames) {
+		fields = append(fields, email.FieldDisplayNames)
+	}
+	if m.FieldCleared(email.FieldInReplyTo) {
+		fields = append(fields, email.FieldInReplyTo)
+	}
+	if m.FieldCleared(email.FieldReferences) {
+		fields = append(fields, email.FieldReferences)
+	}
+	if m.FieldCleared(email.FieldFilePath) {
+		fields = append(fields, email.FieldFilePath)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EmailMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EmailMutation) ClearField(name string) error {
+	switch name {
+	case email.FieldTo:
+		m.ClearTo()
+		return nil
+	case email.FieldCc:
+		m.ClearCc()
+		return nil
+	case email.FieldBcc:
+		m.ClearBcc()
+		return nil
+	case email.FieldDisplayNames:
+		m.ClearDisplayNames()
+		return nil
+	case email.FieldInReplyTo:
+		m.ClearInReplyTo()
+		return nil
+	case email.FieldReferences:
+		m.ClearReferences()
+		return nil
+	case email.FieldFilePath:
+		m.ClearFilePath()
+		return nil
+	}
+	return fmt.Errorf("unknown Email nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EmailMutation) ResetField(name string) error {
+	switch name {
+	case email.FieldMessageID:
+		m.ResetMessageID()
+		return nil
+	case email.FieldFrom:
+		m.ResetFrom()
+		return nil
+	case email.FieldTo:
+		m.ResetTo()
+		return nil
+	case email.FieldCc:
+		m.ResetCc()
+		return nil
+	case email.FieldBcc:
+		m.ResetBcc()
+		return nil
+	case email.FieldDisplayNames:
+		m.ResetDisplayNames()
+		return nil
+	case email.FieldInReplyTo:
+		m.ResetInReplyTo()
+		return nil
+	case email.FieldReferences:
+		m.ResetReferences()
+		return nil
+	case email.FieldSubject:
+		m.ResetSubject()
+		return nil
+	case email.FieldDate:
+		m.ResetDate()
+		return nil
+	case email.FieldBody:
+		m.ResetBody()
+		return nil
+	case email.FieldFilePath:
+		m.ResetFilePath()
+		return nil
+	case email.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Email field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EmailMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EmailMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EmailMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EmailMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EmailMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EmailMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EmailMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Email unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EmailMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Email edge %s", name)
+}
+
+// EntityAliasMutation represents an operation that mutates the EntityAlias nodes in the graph.
+type EntityAliasMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	entity_id     *int
+	addentity_id  *int
+	alias         *string
+	normalized    *string
+	kind          *string
+	source        *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*EntityAlias, error)
+	predicates    []predicate.EntityAlias
+}
+
+var _ ent.Mutation = (*EntityAliasMutation)(nil)
+
+// entityaliasOption allows management of the mutation configuration using functional options.
+type entityaliasOption func(*EntityAliasMutation)
+
+// newEntityAliasMutation creates new mutation for the EntityAlias entity.
+func newEntityAliasMutation(c config, op Op, opts ...entityaliasOption) *EntityAliasMutation {
+	m := &EntityAliasMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEntityAlias,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withEntityAliasID sets the ID field of the mutation.
+func withEntityAliasID(id int) entityaliasOption {
+	return func(m *EntityAliasMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *EntityAlias
+		)
+		m.oldValue = func(ctx context.Context) (*EntityAlias, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().EntityAlias.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withEntityAlias sets the old EntityAlias of the mutation.
+func withEntityAlias(node *EntityAlias) entityaliasOption {
+	return func(m *EntityAliasMutation) {
+		m.oldValue = func(context.Context) (*EntityAlias, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EntityAliasMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EntityAliasMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EntityAliasMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EntityAliasMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().EntityAlias.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetEntityID sets the "entity_id" field.
+func (m *EntityAliasMutation) SetEntityID(i int) {
+	m.entity_id = &i
+	m.addentity_id = nil
+}
+
+// EntityID returns the value of the "entity_id" field in the mutation.
+func (m *EntityAliasMutation) EntityID() (r int, exists bool) {
+	v := m.entity_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEntityID returns the old "entity_id" field's value of the EntityAlias entity.
+// If the EntityAlias object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntityAliasMutation) OldEntityID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEntityID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEntityID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEntityID: %w", err)
+	}
+	return oldValue.EntityID, nil
+}
+
+// AddEntityID adds i to the "entity_id" field.
+func (m *EntityAliasMutation) AddEntityID(i int) {
+	if m.addentity_id != nil {
+		*m.addentity_id += i
+	} else {
+		m.addentity_id = &i
+	}
+}
+
+// AddedEntityID returns the value that was added to the "entity_id" field in this mutation.
+func (m *EntityAliasMutation) AddedEntityID() (r int, exists bool) {
+	v := m.addentity_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetEntityID resets all changes to the "entity_id" field.
+func (m *EntityAliasMutation) ResetEntityID() {
+	m.entity_id = nil
+	m.addentity_id = nil
+}
+
+// SetAlias sets the "alias" field.
+func (m *EntityAliasMutation) SetAlias(s string) {
+	m.alias = &s
+}
+
+// Alias returns the value of the "alias" field in the mutation.
+func (m *EntityAliasMutation) Alias() (r string, exists bool) {
+	v := m.alias
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAlias returns the old "alias" field's value of the EntityAlias entity.
+// If the EntityAlias object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntityAliasMutation) OldAlias(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAlias is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAlias requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAlias: %w", err)
+	}
+	return oldValue.Alias, nil
+}
+
+// ResetAlias resets all changes to the "alias" field.
+func (m *EntityAliasMutation) ResetAlias() {
+	m.alias = nil
+}
+
+// SetNormalized sets the "normalized" field.
+func (m *EntityAliasMutation) SetNormalized(s string) {
+	m.normalized = &s
+}
+
+// Normalized returns the value of the "normalized" field in the mutation.
+func (m *EntityAliasMutation) Normalized() (r string, exists bool) {
+	v := m.normalized
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNormalized returns the old "normalized" field's value of the EntityAlias entity.
+// If the EntityAlias object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntityAliasMutation) OldNormalized(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNormalized is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNormalized requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNormalized: %w", err)
+	}
+	return oldValue.Normalized, nil
+}
+
+// ResetNormalized resets all changes to the "normalized" field.
+func (m *EntityAliasMutation) ResetNormalized() {
+	m.normalized = nil
+}
+
+// SetKind sets the "kind" field.
+func (m *EntityAliasMutation) SetKind(s string) {
+	m.kind = &s
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *EntityAliasMutation) Kind() (r string, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the EntityAlias entity.
+// If the EntityAlias object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntityAliasMutation) OldKind(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *EntityAliasMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetSource sets the "source" field.
+func (m *EntityAliasMutation) SetSource(s string) {
+	m.source = &s
+}
+
+// Source returns the value of the "source" field in the mutation.
+func (m *EntityAliasMutation) Source() (r string, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSource returns the old "source" field's value of the EntityAlias entity.
+// If the EntityAlias object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntityAliasMutation) OldSource(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+	}
+	return oldValue.Source, nil
+}
+
+// ResetSource resets all changes to the "source" field.
+func (m *EntityAliasMutation) ResetSource() {
+	m.source = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *EntityAliasMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *EntityAliasMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the EntityAlias entity.
+// If the EntityAlias object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntityAliasMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *EntityAliasMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the EntityAliasMutation builder.
+func (m *EntityAliasMutation) Where(ps ...predicate.EntityAlias) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EntityAliasMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EntityAliasMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.EntityAlias, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EntityAliasMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EntityAliasMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (EntityAlias).
+func (m *EntityAliasMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EntityAliasMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.entity_id != nil {
+		fields = append(fields, entityalias.FieldEntityID)
+	}
+	if m.alias != nil {
+		fields = append(fields, entityalias.FieldAlias)
+	}
+	if m.normalized != nil {
+		fields = append(fields, entityalias.FieldNormalized)
+	}
+	if m.kind != nil {
+		fields = append(fields, entityalias.FieldKind)
+	}
+	if m.source != nil {
+		fields = append(fields, entityalias.FieldSource)
+	}
+	if m.created_at != nil {
+		fields = append(fields, entityalias.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EntityAliasMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case entityalias.FieldEntityID:
+		return m.EntityID()
+	case entityalias.FieldAlias:
+		return m.Alias()
+	case entityalias.FieldNormalized:
+		return m.Normalized()
+	case entityalias.FieldKind:
+		return m.Kind()
+	case entityalias.FieldSource:
+		return m.Source()
+	case entityalias.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EntityAliasMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case entityalias.FieldEntityID:
+		return m.OldEntityID(ctx)
+	case entityalias.FieldAlias:
+		return m.OldAlias(ctx)
+	case entityalias.FieldNormalized:
+		return m.OldNormalized(ctx)
+	case entityalias.FieldKind:
+		return m.OldKind(ctx)
+	case entityalias.FieldSource:
+		return m.OldSource(ctx)
+	case entityalias.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown EntityAlias field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EntityAliasMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case entityalias.FieldEntityID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEntityID(v)
+		return nil
+	case entityalias.FieldAlias:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAlias(v)
+		return nil
+	case entityalias.FieldNormalized:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNormalized(v)
+		return nil
+	case entityalias.FieldKind:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case entityalias.FieldSource:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSource(v)
+		return nil
+	case entityalias.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EntityAlias field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EntityAliasMutation) AddedFields() []string {
+	var fields []string
+	if m.addentity_id != nil {
+		fields = append(fields, entityalias.FieldEntityID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EntityAliasMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case entityalias.FieldEntityID:
+		return m.AddedEntityID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EntityAliasMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case entityalias.FieldEntityID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEntityID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EntityAlias numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EntityAliasMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EntityAliasMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EntityAliasMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown EntityAlias nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EntityAliasMutation) ResetField(name string) error {
+	switch name {
+	case entityalias.FieldEntityID:
+		m.ResetEntityID()
+		return nil
+	case entityalias.FieldAlias:
+		m.ResetAlias()
+		return nil
+	case entityalias.FieldNormalized:
+		m.ResetNormalized()
+		return nil
+	case entityalias.FieldKind:
+		m.ResetKind()
+		return nil
+	case entityalias.FieldSource:
+		m.ResetSource()
+		return nil
+	case entityalias.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown EntityAlias field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EntityAliasMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EntityAliasMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EntityAliasMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EntityAliasMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EntityAliasMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EntityAliasMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EntityAliasMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown EntityAlias unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EntityAliasMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown EntityAlias edge %s", name)
+}
+
+// EntityAuditMutation represents an operation that mutates the EntityAudit nodes in the graph.
+type EntityAuditMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	operation         *string
+	entity_id         *int
+	addentity_id      *int
+	related_ids       *[]int
+	appendrelated_ids []int
+	actor             *string
+	reason            *string
+	details           *map[string]interface{}
+	created_at        *time.Time
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*EntityAudit, error)
+	predicates        []predicate.EntityAudit
+}
+
+var _ ent.Mutation = (*EntityAuditMutation)(nil)
+
+// entityauditOption allows management of the mutation configuration using functional options.
+type entityauditOption func(*EntityAuditMutation)
+
+// newEntityAuditMutation creates new mutation for the EntityAudit entity.
+func newEntityAuditMutation(c config, op Op, opts ...entityauditOption) *EntityAuditMutation {
+	m := &EntityAuditMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEntityAudit,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withEntityAuditID sets the ID field of the mutation.
+func withEntityAuditID(id int) entityauditOption {
+	return func(m *EntityAuditMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *EntityAudit
+		)
+		m.oldValue = func(ctx context.Context) (*EntityAudit, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().EntityAudit.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withEntityAudit sets the old EntityAudit of the mutation.
+func withEntityAudit(node *EntityAudit) entityauditOption {
+	return func(m *EntityAuditMutation) {
+		m.oldValue = func(context.Context) (*EntityAudit, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EntityAuditMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EntityAuditMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EntityAuditMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EntityAuditMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().EntityAudit.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetOperation sets the "operation" field.
+func (m *EntityAuditMutation) SetOperation(s string) {
+	m.operation = &s
+}
+
+// Operation returns the value of the "operation" field in the mutation.
+func (m *EntityAuditMutation) Operation() (r string, exists bool) {
+	v := m.operation
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOperation returns the old "operation" field's value of the EntityAudit entity.
+// If the EntityAudit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntityAuditMutation) OldOperation(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOperation is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOperation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOperation: %w", err)
+	}
+	return oldValue.Operation, nil
+}
+
+// ResetOperation resets all changes to the "operation" field.
+func (m *EntityAuditMutation) ResetOperation() {
+	m.operation = nil
+}
+
+// SetEntityID sets the "entity_id" field.
+func (m *EntityAuditMutation) SetEntityID(i int) {
+	m.entity_id = &i
+	m.addentity_id = nil
+}
+
+// EntityID returns the value of the "entity_id" field in the mutation.
+func (m *EntityAuditMutation) EntityID() (r int, exists bool) {
+	v := m.entity_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEntityID returns the old "entity_id" field's value of the EntityAudit entity.
+// If the EntityAudit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntityAuditMutation) OldEntityID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEntityID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEntityID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEntityID: %w", err)
+	}
+	return oldValue.EntityID, nil
+}
+
+// AddEntityID adds i to the "entity_id" field.
+func (m *EntityAuditMutation) AddEntityID(i int) {
+	if m.addentity_id != nil {
+		*m.addentity_id += i
+	} else {
+		m.addentity_id = &i
+	}
+}
+
+// AddedEntityID returns the value that was added to the "entity_id" field in this mutation.
+func (m *EntityAuditMutation) AddedEntityID() (r int, exists bool) {
+	v := m.addentity_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetEntityID resets all changes to the "entity_id" field.
+func (m *EntityAuditMutation) ResetEntityID() {
+	m.entity_id = nil
+	m.addentity_id = nil
+}
+
+// SetRelatedIds sets the "related_ids" field.
+func (m *EntityAuditMutation) SetRelatedIds(i []int) {
+	m.related_ids = &i
+	m.appendrelated_ids = nil
+}
+
+// RelatedIds returns the value of the "related_ids" field in the mutation.
+func (m *EntityAuditMutation) RelatedIds() (r []int, exists bool) {
+	v := m.related_ids
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRelatedIds returns the old "related_ids" field's value of the EntityAudit entity.
+// If the EntityAudit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntityAuditMutation) OldRelatedIds(ctx context.Context) (v []int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRelatedIds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRelatedIds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRelatedIds: %w", err)
+	}
+	return oldValue.RelatedIds, nil
+}
+
+// AppendRelatedIds adds i to the "related_ids" field.
+func (m *EntityAuditMutation) AppendRelatedIds(i []int) {
+	m.appendrelated_ids = append(m.appendrelated_ids, i...)
+}
+
+// AppendedRelatedIds returns the list of values that were appended to the "related_ids" field in this mutation.
+func (m *EntityAuditMutation) AppendedRelatedIds() ([]int, bool) {
+	if len(m.appendrelated_ids) == 0 {
+		return nil, false
+	}
+	return m.appendrelated_ids, true
+}
+
+// ClearRelatedIds clears the value of the "related_ids" field.
+func (m *EntityAuditMutation) ClearRelatedIds() {
+	m.related_ids = nil
+	m.appendrelated_ids = nil
+	m.clearedFields[entityaudit.FieldRelatedIds] = struct{}{}
+}
+
+// RelatedIdsCleared returns if the "related_ids" field was cleared in this mutation.
+func (m *EntityAuditMutation) RelatedIdsCleared() bool {
+	_, ok := m.clearedFields[entityaudit.FieldRelatedIds]
+	return ok
+}
+
+// ResetRelatedIds resets all changes to the "related_ids" field.
+func (m *EntityAuditMutation) ResetRelatedIds() {
+	m.related_ids = nil
+	m.appendrelated_ids = nil
+	delete(m.clearedFields, entityaudit.FieldRelatedIds)
+}
+
+// SetActor sets the "actor" field.
+func (m *EntityAuditMutation) SetActor(s string) {
+	m.actor = &s
+}
+
+// Actor returns the value of the "actor" field in the mutation.
+func (m *EntityAuditMutation) Actor() (r string, exists bool) {
+	v := m.actor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActor returns the old "actor" field's value of the EntityAudit entity.
+// If the EntityAudit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntityAuditMutation) OldActor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActor: %w", err)
+	}
+	return oldValue.Actor, nil
+}
+
+// ClearActor clears the value of the "actor" field.
+func (m *EntityAuditMutation) ClearActor() {
+	m.actor = nil
+	m.clearedFields[entityaudit.FieldActor] = struct{}{}
+}
+
+// ActorCleared returns if the "actor" field was cleared in this mutation.
+func (m *EntityAuditMutation) ActorCleared() bool {
+	_, ok := m.clearedFields[entityaudit.FieldActor]
+	return ok
+}
+
+// ResetActor resets all changes to the "actor" field.
+func (m *EntityAuditMutation) ResetActor() {
+	m.actor = nil
+	delete(m.clearedFields, entityaudit.FieldActor)
+}
+
+// SetReason sets the "reason" field.
+func (m *EntityAuditMutation) SetReason(s string) {
+	m.reason = &s
+}
+
+// Reason returns the value of the "reason" field in the mutation.
+func (m *EntityAuditMutation) Reason() (r string, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReason returns the old "reason" field's value of the EntityAudit entity.
+// If the EntityAudit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntityAuditMutation) OldReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
+}
+
+// ClearReason clears the value of the "reason" field.
+func (m *EntityAuditMutation) ClearReason() {
+	m.reason = nil
+	m.clearedFields[entityaudit.FieldReason] = struct{}{}
+}
+
+// ReasonCleared returns if the "reason" field was cleared in this mutation.
+func (m *EntityAuditMutation) ReasonCleared() bool {
+	_, ok := m.clearedFields[entityaudit.FieldReason]
+	return ok
+}
+
+// ResetReason resets all changes to the "reason" field.
+func (m *EntityAuditMutation) ResetReason() {
+	m.reason = nil
+	delete(m.clearedFields, entityaudit.FieldReason)
+}
+
+// SetDetails sets the "details" field.
+func (m *EntityAuditMutation) SetDetails(value map[string]interface{}) {
+	m.details = &value
+}
+
+// Details returns the value of the "details" field in the mutation.
+func (m *EntityAuditMutation) Details() (r map[string]interface{}, exists bool) {
+	v := m.details
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDetails returns the old "details" field's value of the EntityAudit entity.
+// If the EntityAudit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntityAuditMutation) OldDetails(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDetails is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDetails requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDetails: %w", err)
+	}
+	return oldValue.Details, nil
+}
+
+// ClearDetails clears the value of the "details" field.
+func (m *EntityAuditMutation) ClearDetails() {
+	m.details = nil
+	m.clearedFields[entityaudit.FieldDetails] = struct{}{}
+}
+
+// DetailsCleared returns if the "details" field was cleared in this mutation.
+func (m *EntityAuditMutation) DetailsCleared() bool {
+	_, ok := m.clearedFields[entityaudit.FieldDetails]
+	return ok
+}
+
+// ResetDetails resets all changes to the "details" field.
+func (m *EntityAuditMutation) ResetDetails() {
+	m.details = nil
+	delete(m.clearedFields, entityaudit.FieldDetails)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *EntityAuditMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *EntityAuditMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the EntityAudit entity.
+// If the EntityAudit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntityAuditMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *EntityAuditMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the EntityAuditMutation builder.
+func (m *EntityAuditMutation) Where(ps ...predicate.EntityAudit) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EntityAuditMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EntityAuditMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.EntityAudit, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EntityAuditMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EntityAuditMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (EntityAudit).
+func (m *EntityAuditMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EntityAuditMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.operation != nil {
+		fields = append(fields, entityaudit.FieldOperation)
+	}
+	if m.entity_id != nil {
+		fields = append(fields, entityaudit.FieldEntityID)
+	}
+	if m.related_ids != nil {
+		fields = append(fields, entityaudit.FieldRelatedIds)
+	}
+	if m.actor != nil {
+		fields = append(fields, entityaudit.FieldActor)
+	}
+	if m.reason != nil {
+		fields = append(fields, entityaudit.FieldReason)
+	}
+	if m.details != nil {
+		fields = append(fields, entityaudit.FieldDetails)
+	}
+	if m.created_at != nil {
+		fields = append(fields, entityaudit.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EntityAuditMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case entityaudit.FieldOperation:
+		return m.Operation()
+	case entityaudit.FieldEntityID:
+		return m.EntityID()
+	case entityaudit.FieldRelatedIds:
+		return m.RelatedIds()
+	case entityaudit.FieldActor:
+		return m.Actor()
+	case entityaudit.FieldReason:
+		return m.Reason()
+	case entityaudit.FieldDetails:
+		return m.Details()
+	case entityaudit.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EntityAuditMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case entityaudit.FieldOperation:
+		return m.OldOperation(ctx)
+	case entityaudit.FieldEntityID:
+		return m.OldEntityID(ctx)
+	case entityaudit.FieldRelatedIds:
+		return m.OldRelatedIds(ctx)
+	case entityaudit.FieldActor:
+		return m.OldActor(ctx)
+	case entityaudit.FieldReason:
+		return m.OldReason(ctx)
+	case entityaudit.FieldDetails:
+		return m.OldDetails(ctx)
+	case entityaudit.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown EntityAudit field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EntityAuditMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case entityaudit.FieldOperation:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOperation(v)
+		return nil
+	case entityaudit.FieldEntityID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEntityID(v)
+		return nil
+	case entityaudit.FieldRelatedIds:
+		v, ok := value.([]int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRelatedIds(v)
+		return nil
+	case entityaudit.FieldActor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActor(v)
+		return nil
+	case entityaudit.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	case entityaudit.FieldDetails:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDetails(v)
+		return nil
+	case entityaudit.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EntityAudit field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EntityAuditMutation) AddedFields() []string {
+	var fields []string
+	if m.addentity_id != nil {
+		fields = append(fields, entityaudit.FieldEntityID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EntityAuditMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case entityaudit.FieldEntityID:
+		return m.AddedEntityID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EntityAuditMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case entityaudit.FieldEntityID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEntityID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EntityAudit numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EntityAuditMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(entityaudit.FieldRelatedIds) {
+		fields = append(fields, entityaudit.FieldRelatedIds)
+	}
+	if m.FieldCleared(entityaudit.FieldActor) {
+		fields = append(fields, entityaudit.FieldActor)
+	}
+	if m.FieldCleared(entityaudit.FieldReason) {
+		fields = append(fields, entityaudit.FieldReason)
+	}
+	if m.FieldCleared(entityaudit.FieldDetails) {
+		fields = append(fields, entityaudit.FieldDetails)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EntityAuditMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EntityAuditMutation) ClearField(name string) error {
+	switch name {
+	case entityaudit.FieldRelatedIds:
+		m.ClearRelatedIds()
+		return nil
+	case entityaudit.FieldActor:
+		m.ClearActor()
+		return nil
+	case entityaudit.FieldReason:
+		m.ClearReason()
+		return nil
+	case entityaudit.FieldDetails:
+		m.ClearDetails()
+		return nil
+	}
+	return fmt.Errorf("unknown EntityAudit nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EntityAuditMutation) ResetField(name string) error {
+	switch name {
+	case entityaudit.FieldOperation:
+		m.ResetOperation()
+		return nil
+	case entityaudit.FieldEntityID:
+		m.ResetEntityID()
+		return nil
+	case entityaudit.FieldRelatedIds:
+		m.ResetRelatedIds()
+		return nil
+	case entityaudit.FieldActor:
+		m.ResetActor()
+		return nil
+	case entityaudit.FieldReason:
+		m.ResetReason()
+		return nil
+	case entityaudit.FieldDetails:
+		m.ResetDetails()
+		return nil
+	case entityaudit.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown EntityAudit field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EntityAuditMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EntityAuditMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EntityAuditMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EntityAuditMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EntityAuditMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EntityAuditMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EntityAuditMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown EntityAudit unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EntityAuditMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown EntityAudit edge %s", name)
+}
+
+// ExtractionJobMutation represents an operation that mutates the ExtractionJob nodes in the graph.
+type ExtractionJobMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	email_id       *int
+	addemail_id    *int
+	status         *string
+	attempts       *int
+	addattempts    *int
+	last_error     *string
+	model          *string
+	prompt_version *string
+	batch          *string
+	worker         *string
+	claimed_at     *time.Time
+	completed_at   *time.Time
+	created_at     *time.Time
+	updated_at     *time.Time
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*ExtractionJob, error)
+	predicates     []predicate.ExtractionJob
+}
+
+var _ ent.Mutation = (*ExtractionJobMutation)(nil)
+
+// extractionjobOption allows management of the mutation configuration using functional options.
+type extractionjobOption func(*ExtractionJobMutation)
+
+// newExtractionJobMutation creates new mutation for the ExtractionJob entity.
+func newExtractionJobMutation(c config, op Op, opts ...extractionjobOption) *ExtractionJobMutation {
+	m := &ExtractionJobMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeExtractionJob,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withExtractionJobID sets the ID field of the mutation.
+func withExtractionJobID(id int) extractionjobOption {
+	return func(m *ExtractionJobMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ExtractionJob
+		)
+		m.oldValue = func(ctx context.Context) (*ExtractionJob, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ExtractionJob.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withExtractionJob sets the old ExtractionJob of the mutation.
+func withExtractionJob(node *ExtractionJob) extractionjobOption {
+	return func(m *ExtractionJobMutation) {
+		m.oldValue = func(context.Context) (*ExtractionJob, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ExtractionJobMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ExtractionJobMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ExtractionJobMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ExtractionJobMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ExtractionJob.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetEmailID sets the "email_id" field.
+func (m *ExtractionJobMutation) SetEmailID(i int) {
+	m.email_id = &i
+	m.addemail_id = nil
+}
+
+// EmailID returns the value of the "email_id" field in the mutation.
+func (m *ExtractionJobMutation) EmailID() (r int, exists bool) {
+	v := m.email_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmailID returns the old "email_id" field's value of the ExtractionJob entity.
+// If the ExtractionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtractionJobMutation) OldEmailID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmailID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmailID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmailID: %w", err)
+	}
+	return oldValue.EmailID, nil
+}
+
+// AddEmailID adds i to the "email_id" field.
+func (m *ExtractionJobMutation) AddEmailID(i int) {
+	if m.addemail_id != nil {
+		*m.addemail_id += i
+	} else {
+		m.addemail_id = &i
+	}
+}
+
+// AddedEmailID returns the value that was added to the "email_id" field in this mutation.
+func (m *ExtractionJobMutation) AddedEmailID() (r int, exists bool) {
+	v := m.addemail_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetEmailID resets all changes to the "email_id" field.
+func (m *ExtractionJobMutation) ResetEmailID() {
+	m.email_id = nil
+	m.addemail_id = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *ExtractionJobMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ExtractionJobMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the ExtractionJob entity.
+// If the ExtractionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtractionJobMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ExtractionJobMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *ExtractionJobMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *ExtractionJobMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the ExtractionJob entity.
+// If the ExtractionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtractionJobMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *ExtractionJobMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *ExtractionJobMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *ExtractionJobMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetLastError sets the "last_error" field.
+func (m *ExtractionJobMutation) SetLastError(s string) {
+	m.last_error = &s
+}
+
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *ExtractionJobMutation) LastError() (r string, exists bool) {
+	v := m.last_error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastError returns the old "last_error" field's value of the ExtractionJob entity.
+// If the ExtractionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtractionJobMutation) OldLastError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
+	}
+	return oldValue.LastError, nil
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (m *ExtractionJobMutation) ClearLastError() {
+	m.last_error = nil
+	m.clearedFields[extractionjob.FieldLastError] = struct{}{}
+}
+
+// LastErrorCleared returns if the "last_error" field was cleared in this mutation.
+func (m *ExtractionJobMutation) LastErrorCleared() bool {
+	_, ok := m.clearedFields[extractionjob.FieldLastError]
+	return ok
+}
+
+// ResetLastError resets all changes to the "last_error" field.
+func (m *ExtractionJobMutation) ResetLastError() {
+	m.last_error = nil
+	delete(m.clearedFields, extractionjob.FieldLastError)
+}
+
+// SetModel sets the "model" field.
+func (m *ExtractionJobMutation) SetModel(s string) {
+	m.model = &s
+}
+
+// Model returns the value of the "model" field in the mutation.
+func (m *ExtractionJobMutation) Model() (r string, exists bool) {
+	v := m.model
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModel returns the old "model" field's value of the ExtractionJob entity.
+// If the ExtractionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtractionJobMutation) OldModel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModel: %w", err)
+	}
+	return oldValue.Model, nil
+}
+
+// ResetModel resets all changes to the "model" field.
+func (m *ExtractionJobMutation) ResetModel() {
+	m.model = nil
+}
+
+// SetPromptVersion sets the "prompt_version" field.
+func (m *ExtractionJobMutation) SetPromptVersion(s string) {
+	m.prompt_version = &s
+}
+
+// PromptVersion returns the value of the "prompt_version" field in the mutation.
+func (m *ExtractionJobMutation) PromptVersion() (r string, exists bool) {
+	v := m.prompt_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPromptVersion returns the old "prompt_version" field's value of the ExtractionJob entity.
+// If the ExtractionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtractionJobMutation) OldPromptVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPromptVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPromptVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPromptVersion: %w", err)
+	}
+	return oldValue.PromptVersion, nil
+}
+
+// ResetPromptVersion resets all changes to the "prompt_version" field.
+func (m *ExtractionJobMutation) ResetPromptVersion() {
+	m.prompt_version = nil
+}
+
+// SetBatch sets the "batch" field.
+func (m *ExtractionJobMutation) SetBatch(s string) {
+	m.batch = &s
+}
+
+// Batch returns the value of the "batch" field in the mutation.
+func (m *ExtractionJobMutation) Batch() (r string, exists bool) {
+	v := m.batch
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBatch returns the old "batch" field's value of the ExtractionJob entity.
+// If the ExtractionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtractionJobMutation) OldBatch(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBatch is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBatch requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBatch: %w", err)
+	}
+	return oldValue.Batch, nil
+}
+
+// ResetBatch resets all changes to the "batch" field.
+func (m *ExtractionJobMutation) ResetBatch() {
+	m.batch = nil
+}
+
+// SetWorker sets the "worker" field.
+func (m *ExtractionJobMutation) SetWorker(s string) {
+	m.worker = &s
+}
+
+// Worker returns the value of the "worker" field in the mutation.
+func (m *ExtractionJobMutation) Worker() (r string, exists bool) {
+	v := m.worker
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWorker returns the old "worker" field's value of the ExtractionJob entity.
+// If the ExtractionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtractionJobMutation) OldWorker(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWorker is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWorker requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWorker: %w", err)
+	}
+	return oldValue.Worker, nil
+}
+
+// ClearWorker clears the value of the "worker" field.
+func (m *ExtractionJobMutation) ClearWorker() {
+	m.worker = nil
+	m.clearedFields[extractionjob.FieldWorker] = struct{}{}
+}
+
+// WorkerCleared returns if the "worker" field was cleared in this mutation.
+func (m *ExtractionJobMutation) WorkerCleared() bool {
+	_, ok := m.clearedFields[extractionjob.FieldWorker]
+	return ok
+}
+
+// ResetWorker resets all changes to the "worker" field.
+func (m *ExtractionJobMutation) ResetWorker() {
+	m.worker = nil
+	delete(m.clearedFields, extractionjob.FieldWorker)
+}
+
+// SetClaimedAt sets the "claimed_at" field.
+func (m *ExtractionJobMutation) SetClaimedAt(t time.Time) {
+	m.claimed_at = &t
+}
+
+// ClaimedAt returns the value of the "claimed_at" field in the mutation.
+func (m *ExtractionJobMutation) ClaimedAt() (r time.Time, exists bool) {
+	v := m.claimed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClaimedAt returns the old "claimed_at" field's value of the ExtractionJob entity.
+// If the ExtractionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtractionJobMutation) OldClaimedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClaimedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClaimedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClaimedAt: %w", err)
+	}
+	return oldValue.ClaimedAt, nil
+}
+
+// ClearClaimedAt clears the value of the "claimed_at" field.
+func (m *ExtractionJobMutation) ClearClaimedAt() {
+	m.claimed_at = nil
+	m.clearedFields[extractionjob.FieldClaimedAt] = struct{}{}
+}
+
+// ClaimedAtCleared returns if the "claimed_at" field was cleared in this mutation.
+func (m *ExtractionJobMutation) ClaimedAtCleared() bool {
+	_, ok := m.clearedFields[extractionjob.FieldClaimedAt]
+	return ok
+}
+
+// ResetClaimedAt resets all changes to the "claimed_at" field.
+func (m *ExtractionJobMutation) ResetClaimedAt() {
+	m.claimed_at = nil
+	delete(m.clearedFields, extractionjob.FieldClaimedAt)
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (m *ExtractionJobMutation) SetCompletedAt(t time.Time) {
+	m.completed_at = &t
+}
+
+// CompletedAt returns the value of the "completed_at" field in the mutation.
+func (m *ExtractionJobMutation) CompletedAt() (r time.Time, exists bool) {
+	v := m.completed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompletedAt returns the old "completed_at" field's value of the ExtractionJob entity.
+// If the ExtractionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtractionJobMutation) OldCompletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompletedAt: %w", err)
+	}
+	return oldValue.CompletedAt, nil
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (m *ExtractionJobMutation) ClearCompletedAt() {
+	m.completed_at = nil
+	m.clearedFields[extractionjob.FieldCompletedAt] = struct{}{}
+}
+
+// CompletedAtCleared returns if the "completed_at" field was cleared in this mutation.
+func (m *ExtractionJobMutation) CompletedAtCleared() bool {
+	_, ok := m.clearedFields[extractionjob.FieldCompletedAt]
+	return ok
+}
+
+// ResetCompletedAt resets all changes to the "completed_at" field.
+func (m *ExtractionJobMutation) ResetCompletedAt() {
+	m.completed_at = nil
+	delete(m.clearedFields, extractionjob.FieldCompletedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ExtractionJobMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ExtractionJobMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ExtractionJob entity.
+// If the ExtractionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtractionJobMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ExtractionJobMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ExtractionJobMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ExtractionJobMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ExtractionJob entity.
+// If the ExtractionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtractionJobMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ExtractionJobMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the ExtractionJobMutation builder.
+func (m *ExtractionJobMutation) Where(ps ...predicate.ExtractionJob) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ExtractionJobMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ExtractionJobMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ExtractionJob, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ExtractionJobMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ExtractionJobMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ExtractionJob).
+func (m *ExtractionJobMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ExtractionJobMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.email_id != nil {
+		fields = append(fields, extractionjob.FieldEmailID)
+	}
+	if m.status != nil {
+		fields = append(fields, extractionjob.FieldStatus)
+	}
+	if m.attempts != nil {
+		fields = append(fields, extractionjob.FieldAttempts)
+	}
+	if m.last_error != nil {
+		fields = append(fields, extractionjob.FieldLastError)
+	}
+	if m.model != nil {
+		fields = append(fields, extractionjob.FieldModel)
+	}
+	if m.prompt_version != nil {
+		fields = append(fields, extractionjob.FieldPromptVersion)
+	}
+	if m.batch != nil {
+		fields = append(fields, extractionjob.FieldBatch)
+	}
+	if m.worker != nil {
+		fields = append(fields, extractionjob.FieldWorker)
+	}
+	if m.claimed_at != nil {
+		fields = append(fields, extractionjob.FieldClaimedAt)
+	}
+	if m.completed_at != nil {
+		fields = append(fields, extractionjob.FieldCompletedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, extractionjob.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, extractionjob.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ExtractionJobMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case extractionjob.FieldEmailID:
+		return m.EmailID()
+	case extractionjob.FieldStatus:
+		return m.Status()
+	case extractionjob.FieldAttempts:
+		return m.Attempts()
+	case extractionjob.FieldLastError:
+		return m.LastError()
+	case extractionjob.FieldModel:
+		return m.Model()
+	case extractionjob.FieldPromptVersion:
+		return m.PromptVersion()
+	case extractionjob.FieldBatch:
+		return m.Batch()
+	case extractionjob.FieldWorker:
+		return m.Worker()
+	case extractionjob.FieldClaimedAt:
+		return m.ClaimedAt()
+	case extractionjob.FieldCompletedAt:
+		return m.CompletedAt()
+	case extractionjob.FieldCreatedAt:
+		return m.CreatedAt()
+	case extractionjob.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ExtractionJobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case extractionjob.FieldEmailID:
+		return m.OldEmailID(ctx)
+	case extractionjob.FieldStatus:
+		return m.OldStatus(ctx)
+	case extractionjob.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case extractionjob.FieldLastError:
+		return m.OldLastError(ctx)
+	case extractionjob.FieldModel:
+		return m.OldModel(ctx)
+	case extractionjob.FieldPromptVersion:
+		return m.OldPromptVersion(ctx)
+	case extractionjob.FieldBatch:
+		return m.OldBatch(ctx)
+	case extractionjob.FieldWorker:
+		return m.OldWorker(ctx)
+	case extractionjob.FieldClaimedAt:
+		return m.OldClaimedAt(ctx)
+	case extractionjob.FieldCompletedAt:
+		return m.OldCompletedAt(ctx)
+	case extractionjob.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case extractionjob.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ExtractionJob field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ExtractionJobMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case extractionjob.FieldEmailID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmailID(v)
+		return nil
+	case extractionjob.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case extractionjob.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case extractionjob.FieldLastError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastError(v)
+		return nil
+	case extractionjob.FieldModel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModel(v)
+		return nil
+	case extractionjob.FieldPromptVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPromptVersion(v)
+		return nil
+	case extractionjob.FieldBatch:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBatch(v)
+		return nil
+	case extractionjob.FieldWorker:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWorker(v)
+		return nil
+	case extractionjob.FieldClaimedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClaimedAt(v)
+		return nil
+	case extractionjob.FieldCompletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompletedAt(v)
+		return nil
+	case extractionjob.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case extractionjob.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ExtractionJob field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ExtractionJobMutation) AddedFields() []string {
+	var fields []string
+	if m.addemail_id != nil {
+		fields = append(fields, extractionjob.FieldEmailID)
+	}
+	if m.addattempts != nil {
+		fields = append(fields, extractionjob.FieldAttempts)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ExtractionJobMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case extractionjob.FieldEmailID:
+		return m.AddedEmailID()
+	case extractionjob.FieldAttempts:
+		return m.AddedAttempts()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ExtractionJobMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case extractionjob.FieldEmailID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEmailID(v)
+		return nil
+	case extractionjob.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ExtractionJob numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ExtractionJobMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(extractionjob.FieldLastError) {
+		fields = append(fields, extractionjob.FieldLastError)
+	}
+	if m.FieldCleared(extractionjob.FieldWorker) {
+		fields = append(fields, extractionjob.FieldWorker)
+	}
+	if m.FieldCleared(extractionjob.FieldClaimedAt) {
+		fields = append(fields, extractionjob.FieldClaimedAt)
+	}
+	if m.FieldCleared(extractionjob.FieldCompletedAt) {
+		fields = append(fields, extractionjob.FieldCompletedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ExtractionJobMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ExtractionJobMutation) ClearField(name string) error {
+	switch name {
+	case extractionjob.FieldLastError:
+		m.ClearLastError()
+		return nil
+	case extractionjob.FieldWorker:
+		m.ClearWorker()
+		return nil
+	case extractionjob.FieldClaimedAt:
+		m.ClearClaimedAt()
+		return nil
+	case extractionjob.FieldCompletedAt:
+		m.ClearCompletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ExtractionJob nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ExtractionJobMutation) ResetField(name string) error {
+	switch name {
+	case extractionjob.FieldEmailID:
+		m.ResetEmailID()
+		return nil
+	case extractionjob.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case extractionjob.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case extractionjob.FieldLastError:
+		m.ResetLastError()
+		return nil
+	case extractionjob.FieldModel:
+		m.ResetModel()
+		return nil
+	case extractionjob.FieldPromptVersion:
+		m.ResetPromptVersion()
+		return nil
+	case extractionjob.FieldBatch:
+		m.ResetBatch()
+		return nil
+	case extractionjob.FieldWorker:
+		m.ResetWorker()
+		return nil
+	case extractionjob.FieldClaimedAt:
+		m.ResetClaimedAt()
+		return nil
+	case extractionjob.FieldCompletedAt:
+		m.ResetCompletedAt()
+		return nil
+	case extractionjob.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case extractionjob.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ExtractionJob field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ExtractionJobMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ExtractionJobMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ExtractionJobMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ExtractionJobMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ExtractionJobMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ExtractionJobMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ExtractionJobMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ExtractionJob unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ExtractionJobMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ExtractionJob edge %s", name)
+}
+
+// ProvenanceMutation represents an operation that mutates the Provenance nodes in the graph.
+type ProvenanceMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	subject_type   *string
+	subject_id     *int
+	addsubject_id  *int
+	email_id       *int
+	addemail_id    *int
+	message_id     *string
+	file_path      *string
+	source         *string
+	model          *string
+	prompt_version *string
+	extracted_at   *time.Time
+	properties     *map[string]interface{}
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*Provenance, error)
+	predicates     []predicate.Provenance
+}
+
+var _ ent.Mutation = (*ProvenanceMutation)(nil)
+
+// provenanceOption allows management of the mutation configuration using functional options.
+type provenanceOption func(*ProvenanceMutation)
+
+// newProvenanceMutation creates new mutation for the Provenance entity.
+func newProvenanceMutation(c config, op Op, opts ...provenanceOption) *ProvenanceMutation {
+	m := &ProvenanceMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeProvenance,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withProvenanceID sets the ID field of the mutation.
+func withProvenanceID(id int) provenanceOption {
+	return func(m *ProvenanceMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Provenance
+		)
+		m.oldValue = func(ctx context.Context) (*Provenance, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Provenance.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withProvenance sets the old Provenance of the mutation.
+func withProvenance(node *Provenance) provenanceOption {
+	return func(m *ProvenanceMutation) {
+		m.oldValue = func(context.Context) (*Provenance, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ProvenanceMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ProvenanceMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ProvenanceMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ProvenanceMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Provenance.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSubjectType sets the "subject_type" field.
+func (m *ProvenanceMutation) SetSubjectType(s string) {
+	m.subject_type = &s
+}
+
+// SubjectType returns the value of the "subject_type" field in the mutation.
+func (m *ProvenanceMutation) SubjectType() (r string, exists bool) {
+	v := m.subject_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubjectType returns the old "subject_type" field's value of the Provenance entity.
+// If the Provenance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProvenanceMutation) OldSubjectType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubjectType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubjectType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubjectType: %w", err)
+	}
+	return oldValue.SubjectType, nil
+}
+
+// ResetSubjectType resets all changes to the "subject_type" field.
+func (m *ProvenanceMutation) ResetSubjectType() {
+	m.subject_type = nil
+}
+
+// SetSubjectID sets the "subject_id" field.
+func (m *ProvenanceMutation) SetSubjectID(i int) {
+	m.subject_id = &i
+	m.addsubject_id = nil
+}
+
+// SubjectID returns the value of the "subject_id" field in the mutation.
+func (m *ProvenanceMutation) SubjectID() (r int, exists bool) {
+	v := m.subject_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubjectID returns the old "subject_id" field's value of the Provenance entity.
+// If the Provenance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProvenanceMutation) OldSubjectID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubjectID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubjectID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubjectID: %w", err)
+	}
+	return oldValue.SubjectID, nil
+}
+
+// AddSubjectID adds i to the "subject_id" field.
+func (m *ProvenanceMutation) AddSubjectID(i int) {
+	if m.addsubject_id != nil {
+		*m.addsubject_id += i
+	} else {
+		m.addsubject_id = &i
+	}
+}
+
+// AddedSubjectID returns the value that was added to the "subject_id" field in this mutation.
+func (m *ProvenanceMutation) AddedSubjectID() (r int, exists bool) {
+	v := m.addsubject_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSubjectID resets all changes to the "subject_id" field.
+func (m *ProvenanceMutation) ResetSubjectID() {
+	m.subject_id = nil
+	m.addsubject_id = nil
+}
+
+// SetEmailID sets the "email_id" field.
+func (m *ProvenanceMutation) SetEmailID(i int) {
+	m.email_id = &i
+	m.addemail_id = nil
+}
+
+// EmailID returns the value of the "email_id" field in the mutation.
+func (m *ProvenanceMutation) EmailID() (r int, exists bool) {
+	v := m.email_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmailID returns the old "email_id" field's value of the Provenance entity.
+// If the Provenance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProvenanceMutation) OldEmailID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmailID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmailID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmailID: %w", err)
+	}
+	return oldValue.EmailID, nil
+}
+
+// AddEmailID adds i to the "email_id" field.
+func (m *ProvenanceMutation) AddEmailID(i int) {
+	if m.addemail_id != nil {
+		*m.addemail_id += i
+	} else {
+		m.addemail_id = &i
+	}
+}
+
+// AddedEmailID returns the value that was added to the "email_id" field in this mutation.
+func (m *ProvenanceMutation) AddedEmailID() (r int, exists bool) {
+	v := m.addemail_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearEmailID clears the value of the "email_id" field.
+func (m *ProvenanceMutation) ClearEmailID() {
+	m.email_id = nil
+	m.addemail_id = nil
+	m.clearedFields[provenance.FieldEmailID] = struct{}{}
+}
+
+// EmailIDCleared returns if the "email_id" field was cleared in this mutation.
+func (m *ProvenanceMutation) EmailIDCleared() bool {
+	_, ok := m.clearedFields[provenance.FieldEmailID]
+	return ok
+}
+
+// ResetEmailID resets all changes to the "email_id" field.
+func (m *ProvenanceMutation) ResetEmailID() {
+	m.email_id = nil
+	m.addemail_id = nil
+	delete(m.clearedFields, provenance.FieldEmailID)
+}
+
+// SetMessageID sets the "message_id" field.
+func (m *ProvenanceMutation) SetMessageID(s string) {
+	m.message_id = &s
+}
+
+// MessageID returns the value of the "message_id" field in the mutation.
+func (m *ProvenanceMutation) MessageID() (r string, exists bool) {
+	v := m.message_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessageID returns the old "message_id" field's value of the Provenance entity.
+// If the Provenance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProvenanceMutation) OldMessageID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessageID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessageID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessageID: %w", err)
+	}
+	return oldValue.MessageID, nil
+}
+
+// ClearMessageID clears the value of the "message_id" field.
+func (m *ProvenanceMutation) ClearMessageID() {
+	m.message_id = nil
+	m.clearedFields[provenance.FieldMessageID] = struct{}{}
+}
+
+// MessageIDCleared returns if the "message_id" field was cleared in this mutation.
+func (m *ProvenanceMutation) MessageIDCleared() bool {
+	_, ok := m.clearedFields[provenance.FieldMessageID]
+	return ok
+}
+
+// ResetMessageID resets all changes to the "message_id" field.
+func (m *ProvenanceMutation) ResetMessageID() {
+	m.message_id = nil
+	delete(m.clearedFields, provenance.FieldMessageID)
+}
+
+// SetFilePath sets the "file_path" field.
+func (m *ProvenanceMutation) SetFilePath(s string) {
+	m.file_path = &s
+}
+
+// FilePath returns the value of the "file_path" field in the mutation.
+func (m *ProvenanceMutation) FilePath() (r string, exists bool) {
+	v := m.file_path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFilePath returns the old "file_path" field's value of the Provenance entity.
+// If the Provenance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProvenanceMutation) OldFilePath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFilePath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFilePath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFilePath: %w", err)
+	}
+	return oldValue.FilePath, nil
+}
+
+// ClearFilePath clears the value of the "file_path" field.
+func (m *ProvenanceMutation) ClearFilePath() {
+	m.file_path = nil
+	m.clearedFields[provenance.FieldFilePath] = struct{}{}
+}
+
+// FilePathCleared returns if the "file_path" field was cleared in this mutation.
+func (m *ProvenanceMutation) FilePathCleared() bool {
+	_, ok := m.clearedFields[provenance.FieldFilePath]
+	return ok
+}
+
+// ResetFilePath resets all changes to the "file_path" field.
+func (m *ProvenanceMutation) ResetFilePath() {
+	m.file_path = nil
+	delete(m.clearedFields, provenance.FieldFilePath)
+}
+
+// SetSource sets the "source" field.
+func (m *ProvenanceMutation) SetSource(s string) {
+	m.source = &s
+}
+
+// Source returns the value of the "source" field in the mutation.
+func (m *ProvenanceMutation) Source() (r string, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSource returns the old "source" field's value of the Provenance entity.
+// If the Provenance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProvenanceMutation) OldSource(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+	}
+	return oldValue.Source, nil
+}
+
+// ResetSource resets all changes to the "source" field.
+func (m *ProvenanceMutation) ResetSource() {
+	m.source = nil
+}
+
+// SetModel sets the "model" field.
+func (m *ProvenanceMutation) SetModel(s string) {
+	m.model = &s
+}
+
+// Model returns the value of the "model" field in the mutation.
+func (m *ProvenanceMutation) Model() (r string, exists bool) {
+	v := m.model
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModel returns the old "model" field's value of the Provenance entity.
+// If the Provenance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProvenanceMutation) OldModel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModel: %w", err)
+	}
+	return oldValue.Model, nil
+}
+
+// ClearModel clears the value of the "model" field.
+func (m *ProvenanceMutation) ClearModel() {
+	m.model = nil
+	m.clearedFields[provenance.FieldModel] = struct{}{}
+}
+
+// ModelCleared returns if the "model" field was cleared in this mutation.
+func (m *ProvenanceMutation) ModelCleared() bool {
+	_, ok := m.clearedFields[provenance.FieldModel]
+	return ok
+}
+
+// ResetModel resets all changes to the "model" field.
+func (m *ProvenanceMutation) ResetModel() {
+	m.model = nil
+	delete(m.clearedFields, provenance.FieldModel)
+}
+
+// SetPromptVersion sets the "prompt_version" field.
+func (m *ProvenanceMutation) SetPromptVersion(s string) {
+	m.prompt_version = &s
+}
+
+// PromptVersion returns the value of the "prompt_version" field in the mutation.
+func (m *ProvenanceMutation) PromptVersion() (r string, exists bool) {
+	v := m.prompt_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPromptVersion returns the old "prompt_version" field's value of the Provenance entity.
+// If the Provenance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProvenanceMutation) OldPromptVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPromptVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPromptVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPromptVersion: %w", err)
+	}
+	return oldValue.PromptVersion, nil
+}
+
+// ClearPromptVersion clears the value of the "prompt_version" field.
+func (m *ProvenanceMutation) ClearPromptVersion() {
+	m.prompt_version = nil
+	m.clearedFields[provenance.FieldPromptVersion] = struct{}{}
+}
+
+// PromptVersionCleared returns if the "prompt_version" field was cleared in this mutation.
+func (m *ProvenanceMutation) PromptVersionCleared() bool {
+	_, ok := m.clearedFields[provenance.FieldPromptVersion]
+	return ok
+}
+
+// ResetPromptVersion resets all changes to the "prompt_version" field.
+func (m *ProvenanceMutation) ResetPromptVersion() {
+	m.prompt_version = nil
+	delete(m.clearedFields, provenance.FieldPromptVersion)
+}
+
+// SetExtractedAt sets the "extracted_at" field.
+func (m *ProvenanceMutation) SetExtractedAt(t time.Time) {
+	m.extracted_at = &t
+}
+
+// ExtractedAt returns the value of the "extracted_at" field in the mutation.
+func (m *ProvenanceMutation) ExtractedAt() (r time.Time, exists bool) {
+	v := m.extracted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExtractedAt returns the old "extracted_at" field's value of the Provenance entity.
+// If the Provenance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProvenanceMutation) OldExtractedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExtractedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExtractedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExtractedAt: %w", err)
+	}
+	return oldValue.ExtractedAt, nil
+}
+
+// ResetExtractedAt resets all changes to the "extracted_at" field.
+func (m *ProvenanceMutation) ResetExtractedAt() {
+	m.extracted_at = nil
+}
+
+// SetProperties sets the "properties" field.
+func (m *ProvenanceMutation) SetProperties(value map[string]interface{}) {
+	m.properties = &value
+}
+
+// Properties returns the value of the "properties" field in the mutation.
+func (m *ProvenanceMutation) Properties() (r map[string]interface{}, exists bool) {
+	v := m.properties
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProperties returns the old "properties" field's value of the Provenance entity.
+// If the Provenance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProvenanceMutation) OldProperties(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProperties is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProperties requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProperties: %w", err)
+	}
+	return oldValue.Properties, nil
+}
+
+// ClearProperties clears the value of the "properties" field.
+func (m *ProvenanceMutation) ClearProperties() {
+	m.properties = nil
+	m.clearedFields[provenance.FieldProperties] = struct{}{}
+}
+
+// PropertiesCleared returns if the "properties" field was cleared in this mutation.
+func (m *ProvenanceMutation) PropertiesCleared() bool {
+	_, ok := m.clearedFields[provenance.FieldProperties]
+	return ok
+}
+
+// ResetProperties resets all changes to the "properties" field.
+func (m *ProvenanceMutation) ResetProperties() {
+	m.properties = nil
+	delete(m.clearedFields, provenance.FieldProperties)
+}
+
+// Where appends a list predicates to the ProvenanceMutation builder.
+func (m *ProvenanceMutation) Where(ps ...predicate.Provenance) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ProvenanceMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ProvenanceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Provenance, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ProvenanceMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ProvenanceMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Provenance).
+func (m *ProvenanceMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ProvenanceMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.subject_type != nil {
+		fields = append(fields, provenance.FieldSubjectType)
+	}
+	if m.subject_id != nil {
+		fields = append(fields, provenance.FieldSubjectID)
+	}
+	if m.email_id != nil {
+		fields = append(fields, provenance.FieldEmailID)
+	}
+	if m.message_id != nil {
+		fields = append(fields, provenance.FieldMessageID)
+	}
+	if m.file_path != nil {
+		fields = append(fields, provenance.FieldFilePath)
+	}
+	if m.source != nil {
+		fields = append(fields, provenance.FieldSource)
+	}
+	if m.model != nil {
+		fields = append(fields, provenance.FieldModel)
+	}
+	if m.prompt_version != nil {
+		fields = append(fields, provenance.FieldPromptVersion)
+	}
+	if m.extracted_at != nil {
+		fields = append(fields, provenance.FieldExtractedAt)
+	}
+	if m.properties != nil {
+		fields = append(fields, provenance.FieldProperties)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ProvenanceMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case provenance.FieldSubjectType:
+		return m.SubjectType()
+	case provenance.FieldSubjectID:
+		return m.SubjectID()
+	case provenance.FieldEmailID:
+		return m.EmailID()
+	case provenance.FieldMessageID:
+		return m.MessageID()
+	case provenance.FieldFilePath:
+		return m.FilePath()
+	case provenance.FieldSource:
+		return m.Source()
+	case provenance.FieldModel:
+		return m.Model()
+	case provenance.FieldPromptVersion:
+		return m.PromptVersion()
+	case provenance.FieldExtractedAt:
+		return m.ExtractedAt()
+	case provenance.FieldProperties:
+		return m.Properties()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ProvenanceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case provenance.FieldSubjectType:
+		return m.OldSubjectType(ctx)
+	case provenance.FieldSubjectID:
+		return m.OldSubjectID(ctx)
+	case provenance.FieldEmailID:
+		return m.OldEmailID(ctx)
+	case provenance.FieldMessageID:
+		return m.OldMessageID(ctx)
+	case provenance.FieldFilePath:
+		return m.OldFilePath(ctx)
+	case provenance.FieldSource:
+		return m.OldSource(ctx)
+	case provenance.FieldModel:
+		return m.OldModel(ctx)
+	case provenance.FieldPromptVersion:
+		return m.OldPromptVersion(ctx)
+	case provenance.FieldExtractedAt:
+		return m.OldExtractedAt(ctx)
+	case provenance.FieldProperties:
+		return m.OldProperties(ctx)
+	}
+	return nil, fmt.Errorf("unknown Provenance field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProvenanceMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case provenance.FieldSubjectType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubjectType(v)
+		return nil
+	case provenance.FieldSubjectID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubjectID(v)
+		return nil
+	case provenance.FieldEmailID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmailID(v)
+		return nil
+	case provenance.FieldMessageID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessageID(v)
+		return nil
+	case provenance.FieldFilePath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFilePath(v)
+		return nil
+	case provenance.FieldSource:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSource(v)
+		return nil
+	case provenance.FieldModel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModel(v)
+		return nil
+	case provenance.FieldPromptVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPromptVersion(v)
+		return nil
+	case provenance.FieldExtractedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExtractedAt(v)
+		return nil
+	case provenance.FieldProperties:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProperties(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Provenance field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ProvenanceMutation) AddedFields() []string {
+	var fields []string
+	if m.addsubject_id != nil {
+		fields = append(fields, provenance.FieldSubjectID)
+	}
+	if m.addemail_id != nil {
+		fields = append(fields, provenance.FieldEmailID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ProvenanceMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case provenance.FieldSubjectID:
+		return m.AddedSubjectID()
+	case provenance.FieldEmailID:
+		return m.AddedEmailID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProvenanceMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case provenance.FieldSubjectID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSubjectID(v)
+		return nil
+	case provenance.FieldEmailID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEmailID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Provenance numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ProvenanceMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(provenance.FieldEmailID) {
+		fields = append(fields, provenance.FieldEmailID)
+	}
+	if m.FieldCleared(provenance.FieldMessageID) {
+		fields = append(fields, provenance.FieldMessageID)
+	}
+	if m.FieldCleared(provenance.FieldFilePath) {
+		fields = append(fields, provenance.FieldFilePath)
+	}
+	if m.FieldCleared(provenance.FieldModel) {
+		fields = append(fields, provenance.FieldModel)
+	}
+	if m.FieldCleared(provenance.FieldPromptVersion) {
+		fields = append(fields, provenance.FieldPromptVersion)
+	}
+	if m.FieldCleared(provenance.FieldProperties) {
+		fields = append(fields, provenance.FieldProperties)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ProvenanceMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ProvenanceMutation) ClearField(name string) error {
+	switch name {
+	case provenance.FieldEmailID:
+		m.ClearEmailID()
+		return nil
+	case provenance.FieldMessageID:
+		m.ClearMessageID()
+		return nil
+	case provenance.FieldFilePath:
+		m.ClearFilePath()
+		return nil
+	case provenance.FieldModel:
+		m.ClearModel()
+		return nil
+	case provenance.FieldPromptVersion:
+		m.ClearPromptVersion()
+		return nil
+	case provenance.FieldProperties:
+		m.ClearProperties()
+		return nil
+	}
+	return fmt.Errorf("unknown Provenance nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ProvenanceMutation) ResetField(name string) error {
+	switch name {
+	case provenance.FieldSubjectType:
+		m.ResetSubjectType()
+		return nil
+	case provenance.FieldSubjectID:
+		m.ResetSubjectID()
+		return nil
+	case provenance.FieldEmailID:
+		m.ResetEmailID()
+		return nil
+	case provenance.FieldMessageID:
+		m.ResetMessageID()
+		return nil
+	case provenance.FieldFilePath:
+		m.ResetFilePath()
+		return nil
+	case provenance.FieldSource:
+		m.ResetSource()
+		return nil
+	case provenance.FieldModel:
+		m.ResetModel()
+		return nil
+	case provenance.FieldPromptVersion:
+		m.ResetPromptVersion()
+		return nil
+	case provenance.FieldExtractedAt:
+		m.ResetExtractedAt()
+		return nil
+	case provenance.FieldProperties:
+		m.ResetProperties()
+		return nil
+	}
+	return fmt.Errorf("unknown Provenance field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ProvenanceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ProvenanceMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ProvenanceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ProvenanceMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ProvenanceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ProvenanceMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ProvenanceMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Provenance unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ProvenanceMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Provenance edge %s", name)
+}
+
+// RelationshipMutation represents an operation that mutates the Relationship nodes in the graph.
+type RelationshipMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *int
+	_type               *string
+	from_type           *string
+	from_id             *int
+	addfrom_id          *int
+	to_type             *string
+	to_id               *int
+	addto_id            *int
+	timestamp           *time.Time
+	confidence_score    *float64
+	addconfidence_score *float64
+	properties          *map[string]interface{}
+	created_at          *time.Time
+	clearedFields       map[string]struct{}
+	done                bool
+	oldValue            func(context.Context) (*Relationship, error)
+	predicates          []predicate.Relationship
+}
+
+var _ ent.Mutation = (*RelationshipMutation)(nil)
+
+// relationshipOption allows management of the mutation configuration using functional options.
+type relationshipOption func(*RelationshipMutation)
+
+// newRelationshipMutation creates new mutation for the Relationship entity.
+func newRelationshipMutation(c config, op Op, opts ...relationshipOption) *RelationshipMutation {
+	m := &RelationshipMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRelationship,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRelationshipID sets the ID field of the mutation.
+func withRelationshipID(id int) relationshipOption {
+	return func(m *RelationshipMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Relationship
+		)
+		m.oldValue = func(ctx context.Context) (*Relationship, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Relationship.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRelationship sets the old Relationship of the mutation.
+func withRelationship(node *Relationship) relationshipOption {
+	return func(m *RelationshipMutation) {
+		m.oldValue = func(context.Context) (*Relationship, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RelationshipMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RelationshipMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RelationshipMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RelationshipMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Relationship.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetType sets the "type" field.
+func (m *RelationshipMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *RelationshipMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the Relationship entity.
+// If the Relationship object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RelationshipMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *RelationshipMutation) ResetType() {
+	m._type = nil
+}
+
+// SetFromType sets the "from_type" field.
+func (m *RelationshipMutation) SetFromType(s string) {
+	m.from_type = &s
+}
+
+// FromType returns the value of the "from_type" field in the mutation.
+func (m *RelationshipMutation) FromType() (r string, exists bool) {
+	v := m.from_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFromType returns the old "from_type" field's value of the Relationship entity.
+// If the Relationship object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RelationshipMutation) OldFromType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFromType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFromType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFromType: %w", err)
+	}
+	return oldValue.FromType, nil
+}
+
+// ResetFromType resets all changes to the "from_type" field.
+func (m *RelationshipMutation) ResetFromType() {
+	m.from_type = nil
+}
+
+// SetFromID sets the "from_id" field.
+func (m *RelationshipMutation) SetFromID(i int) {
+	m.from_id = &i
+	m.addfrom_id = nil
+}
+
+// FromID returns the value of the "from_id" field in the mutation.
+func (m *RelationshipMutation) FromID() (r int, exists bool) {
+	v := m.from_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFromID returns the old "from_id" field's value of the Relationship entity.
+// If the Relationship object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RelationshipMutation) OldFromID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFromID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFromID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFromID: %w", err)
+	}
+	return oldValue.FromID, nil
+}
+
+// AddFromID adds i to the "from_id" field.
+func (m *RelationshipMutation) AddFromID(i int) {
+	if m.addfrom_id != nil {
+		*m.addfrom_id += i
+	} else {
+		m.addfrom_id = &i
+	}
+}
+
+// AddedFromID returns the value that was added to the "from_id" field in this mutation.
+func (m *RelationshipMutation) AddedFromID() (r int, exists bool) {
+	v := m.addfrom_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFromID resets all changes to the "from_id" field.
+func (m *RelationshipMutation) ResetFromID() {
+	m.from_id = nil
+	m.addfrom_id = nil
+}
+
+// SetToType sets the "to_type" field.
+func (m *RelationshipMutation) SetToType(s string) {
+	m.to_type = &s
+}
+
+// ToType returns the value of the "to_type" field in the mutation.
+func (m *RelationshipMutation) ToType() (r string, exists bool) {
+	v := m.to_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldToType returns the old "to_type" field's value of the Relationship entity.
+// If the Relationship object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RelationshipMutation) OldToType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToType: %w", err)
+	}
+	return oldValue.ToType, nil
+}
+
+// ResetToType resets all changes to the "to_type" field.
+func (m *RelationshipMutation) ResetToType() {
+	m.to_type = nil
+}
+
+// SetToID sets the "to_id" field.
+func (m *RelationshipMutation) SetToID(i int) {
+	m.to_id = &i
+	m.addto_id = nil
+}
+
+// ToID returns the value of the "to_id" field in the mutation.
+func (m *RelationshipMutation) ToID() (r int, exists bool) {
+	v := m.to_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldToID returns the old "to_id" field's value of the Relationship entity.
+// If the Relationship object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RelationshipMutation) OldToID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToID: %w", err)
+	}
+	return oldValue.ToID, nil
+}
+
+// AddToID adds i to the "to_id" field.
+func (m *RelationshipMutation) AddToID(i int) {
+	if m.addto_id != nil {
+		*m.addto_id += i
+	} else {
+		m.addto_id = &i
+	}
+}
+
+// AddedToID returns the value that was added to the "to_id" field in this mutation.
+func (m *RelationshipMutation) AddedToID() (r int, exists bool) {
+	v := m.addto_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetToID resets all changes to the "to_id" field.
+func (m *RelationshipMutation) ResetToID() {
+	m.to_id = nil
+	m.addto_id = nil
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (m *RelationshipMutation) SetTimestamp(t time.Time) {
+	m.timestamp = &t
+}
+
+// Timestamp returns the value of the "timestamp" field in the mutation.
+func (m *RelationshipMutation) Timestamp() (r time.Time, exists bool) {
+	v := m.timestamp
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimestamp returns the old "timestamp" field's value of the Relationship entity.
+// If the Relationship object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RelationshipMutation) OldTimestamp(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimestamp is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimestamp requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimestamp: %w", err)
+	}
+	return oldValue.Timestamp, nil
+}
+
+// ResetTimestamp resets all changes to the "timestamp" field.
+func (m *RelationshipMutation) ResetTimestamp() {
+	m.timestamp = nil
+}
+
+// SetConfidenceScore sets the "confidence_score" field.
+func (m *RelationshipMutation) SetConfidenceScore(f float64) {
+	m.confidence_score = &f
+	m.addconfidence_score = nil
+}
+
+// ConfidenceScore returns the value of the "confidence_score" field in the mutation.
+func (m *RelationshipMutation) ConfidenceScore() (r float64, exists bool) {
+	v := m.confidence_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConfidenceScore returns the old "confidence_score" field's value of the Relationship entity.
+// If the Relationship object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RelationshipMutation) OldConfidenceScore(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConfidenceScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConfidenceScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConfidenceScore: %w", err)
+	}
+	return oldValue.ConfidenceScore, nil
+}
+
+// AddConfidenceScore adds f to the "confidence_score" field.
+func (m *RelationshipMutation) AddConfidenceScore(f float64) {
+	if m.addconfidence_score != nil {
+		*m.addconfidence_score += f
+	} else {
+		m.addconfidence_score = &f
+	}
+}
+
+// AddedConfidenceScore returns the value that was added to the "confidence_score" field in this mutation.
+func (m *RelationshipMutation) AddedConfidenceScore() (r float64, exists bool) {
+	v := m.addconfidence_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetConfidenceScore resets all changes to the "confidence_score" field.
+func (m *RelationshipMutation) ResetConfidenceScore() {
+	m.confidence_score = nil
+	m.addconfidence_score = nil
+}
+
+// SetProperties sets the "properties" field.
+func (m *RelationshipMutation) SetProperties(value map[string]interface{}) {
+	m.properties = &value
+}
+
+// Properties returns the value of the "properties" field in the mutation.
+func (m *RelationshipMutation) Properties() (r map[string]interface{}, exists bool) {
+	v := m.properties
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProperties returns the old "properties" field's value of the Relationship entity.
+// If the Relationship object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RelationshipMutation) OldProperties(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProperties is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProperties requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProperties: %w", err)
+	}
+	return oldValue.Properties, nil
+}
+
+// ClearProperties clears the value of the "properties" field.
+func (m *RelationshipMutation) ClearProperties() {
+	m.properties = nil
+	m.clearedFields[relationship.FieldProperties] = struct{}{}
+}
+
+// PropertiesCleared returns if the "properties" field was cleared in this mutation.
+func (m *RelationshipMutation) PropertiesCleared() bool {
+	_, ok := m.clearedFields[relationship.FieldProperties]
+	return ok
+}
+
+// ResetProperties resets all changes to the "properties" field.
+func (m *RelationshipMutation) ResetProperties() {
+	m.properties = nil
+	delete(m.clearedFields, relationship.FieldProperties)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RelationshipMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RelationshipMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Relationship entity.
+// If the Relationship object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RelationshipMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RelationshipMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the RelationshipMutation builder.
+func (m *RelationshipMutation) Where(ps ...predicate.Relationship) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RelationshipMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RelationshipMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Relationship, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RelationshipMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RelationshipMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Relationship).
+func (m *RelationshipMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RelationshipMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m._type != nil {
+		fields = append(fields, relationship.FieldType)
+	}
+	if m.from_type != nil {
+		fields = append(fields, relationship.FieldFromType)
+	}
+	if m.from_id != nil {
+		fields = append(fields, relationship.FieldFromID)
+	}
+	if m.to_type != nil {
+		fields = append(fields, relationship.FieldToType)
+	}
+	if m.to_id != nil {
+		fields = append(fields, relationship.FieldToID)
+	}
+	if m.timestamp != nil {
+		fields = append(fields, relationship.FieldTimestamp)
+	}
+	if m.confidence_score != nil {
+		fields = append(fields, relationship.FieldConfidenceScore)
+	}
+	if m.properties != nil {
+		fields = append(fields, relationship.FieldProperties)
+	}
+	if m.created_at != nil {
+		fields = append(fields, relationship.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RelationshipMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case relationship.FieldType:
+		return m.GetType()
+	case relationship.FieldFromType:
+		return m.FromType()
+	case relationship.FieldFromID:
+		return m.FromID()
+	case relationship.FieldToType:
+		return m.ToType()
+	case relationship.FieldToID:
+		return m.ToID()
+	case relationship.FieldTimestamp:
+		return m.Timestamp()
+	case relationship.FieldConfidenceScore:
+		return m.ConfidenceScore()
+	case relationship.FieldProperties:
+		return m.Properties()
+	case relationship.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RelationshipMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case relationship.FieldType:
+		return m.OldType(ctx)
+	case relationship.FieldFromType:
+		return m.OldFromType(ctx)
+	case relationship.FieldFromID:
+		return m.OldFromID(ctx)
+	case relationship.FieldToType:
+		return m.OldToType(ctx)
+	case relationship.FieldToID:
+		return m.OldToID(ctx)
+	case relationship.FieldTimestamp:
+		return m.OldTimestamp(ctx)
+	case relationship.FieldConfidenceScore:
+		return m.OldConfidenceScore(ctx)
+	case relationship.FieldProperties:
+		return m.OldProperties(ctx)
+	case relationship.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Relationship field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RelationshipMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case relationship.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case relationship.FieldFromType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFromType(v)
+		return nil
+	case relationship.FieldFromID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFromID(v)
+		return nil
+	case relationship.FieldToType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToType(v)
+		return nil
+	case relationship.FieldToID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToID(v)
+		return nil
+	case relationship.FieldTimestamp:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimestamp(v)
+		return nil
+	case relationship.FieldConfidenceScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConfidenceScore(v)
+		return nil
+	case relationship.FieldProperties:
+		v, ok := value.(map[string]interface{})
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetProperties(v)
 		return nil
-	case relationship.FieldCreatedAt:
+	case relationship.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Relationship field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RelationshipMutation) AddedFields() []string {
+	var fields []string
+	if m.addfrom_id != nil {
+		fields = append(fields, relationship.FieldFromID)
+	}
+	if m.addto_id != nil {
+		fields = append(fields, relationship.FieldToID)
+	}
+	if m.addconfidence_score != nil {
+		fields = append(fields, relationship.FieldConfidenceScore)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RelationshipMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case relationship.FieldFromID:
+		return m.AddedFromID()
+	case relationship.FieldToID:
+		return m.AddedToID()
+	case relationship.FieldConfidenceScore:
+		return m.AddedConfidenceScore()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RelationshipMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case relationship.FieldFromID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFromID(v)
+		return nil
+	case relationship.FieldToID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddToID(v)
+		return nil
+	case relationship.FieldConfidenceScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddConfidenceScore(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Relationship numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RelationshipMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(relationship.FieldProperties) {
+		fields = append(fields, relationship.FieldProperties)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RelationshipMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RelationshipMutation) ClearField(name string) error {
+	switch name {
+	case relationship.FieldProperties:
+		m.ClearProperties()
+		return nil
+	}
+	return fmt.Errorf("unknown Relationship nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RelationshipMutation) ResetField(name string) error {
+	switch name {
+	case relationship.FieldType:
+		m.ResetType()
+		return nil
+	case relationship.FieldFromType:
+		m.ResetFromType()
+		return nil
+	case relationship.FieldFromID:
+		m.ResetFromID()
+		return nil
+	case relationship.FieldToType:
+		m.ResetToType()
+		return nil
+	case relationship.FieldToID:
+		m.ResetToID()
+		return nil
+	case relationship.FieldTimestamp:
+		m.ResetTimestamp()
+		return nil
+	case relationship.FieldConfidenceScore:
+		m.ResetConfidenceScore()
+		return nil
+	case relationship.FieldProperties:
+		m.ResetProperties()
+		return nil
+	case relationship.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Relationship field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RelationshipMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RelationshipMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RelationshipMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RelationshipMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RelationshipMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RelationshipMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RelationshipMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Relationship unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RelationshipMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Relationship edge %s", name)
+}
+
+// SchemaPromotionMutation represents an operation that mutates the SchemaPromotion nodes in the graph.
+type SchemaPromotionMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *int
+	type_name              *string
+	promoted_at            *time.Time
+	promotion_criteria     *map[string]interface{}
+	entities_affected      *int
+	addentities_affected   *int
+	validation_failures    *int
+	addvalidation_failures *int
+	schema_definition      *map[string]interface{}
+	clearedFields          map[string]struct{}
+	done                   bool
+	oldValue               func(context.Context) (*SchemaPromotion, error)
+	predicates             []predicate.SchemaPromotion
+}
+
+var _ ent.Mutation = (*SchemaPromotionMutation)(nil)
+
+// schemapromotionOption allows management of the mutation configuration using functional options.
+type schemapromotionOption func(*SchemaPromotionMutation)
+
+// newSchemaPromotionMutation creates new mutation for the SchemaPromotion entity.
+func newSchemaPromotionMutation(c config, op Op, opts ...schemapromotionOption) *SchemaPromotionMutation {
+	m := &SchemaPromotionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSchemaPromotion,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSchemaPromotionID sets the ID field of the mutation.
+func withSchemaPromotionID(id int) schemapromotionOption {
+	return func(m *SchemaPromotionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SchemaPromotion
+		)
+		m.oldValue = func(ctx context.Context) (*SchemaPromotion, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SchemaPromotion.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSchemaPromotion sets the old SchemaPromotion of the mutation.
+func withSchemaPromotion(node *SchemaPromotion) schemapromotionOption {
+	return func(m *SchemaPromotionMutation) {
+		m.oldValue = func(context.Context) (*SchemaPromotion, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SchemaPromotionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SchemaPromotionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SchemaPromotionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SchemaPromotionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SchemaPromotion.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTypeName sets the "type_name" field.
+func (m *SchemaPromotionMutation) SetTypeName(s string) {
+	m.type_name = &s
+}
+
+// TypeName returns the value of the "type_name" field in the mutation.
+func (m *SchemaPromotionMutation) TypeName() (r string, exists bool) {
+	v := m.type_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTypeName returns the old "type_name" field's value of the SchemaPromotion entity.
+// If the SchemaPromotion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SchemaPromotionMutation) OldTypeName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTypeName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTypeName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTypeName: %w", err)
+	}
+	return oldValue.TypeName, nil
+}
+
+// ResetTypeName resets all changes to the "type_name" field.
+func (m *SchemaPromotionMutation) ResetTypeName() {
+	m.type_name = nil
+}
+
+// SetPromotedAt sets the "promoted_at" field.
+func (m *SchemaPromotionMutation) SetPromotedAt(t time.Time) {
+	m.promoted_at = &t
+}
+
+// PromotedAt returns the value of the "promoted_at" field in the mutation.
+func (m *SchemaPromotionMutation) PromotedAt() (r time.Time, exists bool) {
+	v := m.promoted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPromotedAt returns the old "promoted_at" field's value of the SchemaPromotion entity.
+// If the SchemaPromotion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SchemaPromotionMutation) OldPromotedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPromotedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPromotedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPromotedAt: %w", err)
+	}
+	return oldValue.PromotedAt, nil
+}
+
+// ResetPromotedAt resets all changes to the "promoted_at" field.
+func (m *SchemaPromotionMutation) ResetPromotedAt() {
+	m.promoted_at = nil
+}
+
+// SetPromotionCriteria sets the "promotion_criteria" field.
+func (m *SchemaPromotionMutation) SetPromotionCriteria(value map[string]interface{}) {
+	m.promotion_criteria = &value
+}
+
+// PromotionCriteria returns the value of the "promotion_criteria" field in the mutation.
+func (m *SchemaPromotionMutation) PromotionCriteria() (r map[string]interface{}, exists bool) {
+	v := m.promotion_criteria
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPromotionCriteria returns the old "promotion_criteria" field's value of the SchemaPromotion entity.
+// If the SchemaPromotion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SchemaPromotionMutation) OldPromotionCriteria(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPromotionCriteria is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPromotionCriteria requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPromotionCriteria: %w", err)
+	}
+	return oldValue.PromotionCriteria, nil
+}
+
+// ClearPromotionCriteria clears the value of the "promotion_criteria" field.
+func (m *SchemaPromotionMutation) ClearPromotionCriteria() {
+	m.promotion_criteria = nil
+	m.clearedFields[schemapromotion.FieldPromotionCriteria] = struct{}{}
+}
+
+// PromotionCriteriaCleared returns if the "promotion_criteria" field was cleared in this mutation.
+func (m *SchemaPromotionMutation) PromotionCriteriaCleared() bool {
+	_, ok := m.clearedFields[schemapromotion.FieldPromotionCriteria]
+	return ok
+}
+
+// ResetPromotionCriteria resets all changes to the "promotion_criteria" field.
+func (m *SchemaPromotionMutation) ResetPromotionCriteria() {
+	m.promotion_criteria = nil
+	delete(m.clearedFields, schemapromotion.FieldPromotionCriteria)
+}
+
+// SetEntitiesAffected sets the "entities_affected" field.
+func (m *SchemaPromotionMutation) SetEntitiesAffected(i int) {
+	m.entities_affected = &i
+	m.addentities_affected = nil
+}
+
+// EntitiesAffected returns the value of the "entities_affected" field in the mutation.
+func (m *SchemaPromotionMutation) EntitiesAffected() (r int, exists bool) {
+	v := m.entities_affected
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEntitiesAffected returns the old "entities_affected" field's value of the SchemaPromotion entity.
+// If the SchemaPromotion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SchemaPromotionMutation) OldEntitiesAffected(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEntitiesAffected is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEntitiesAffected requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEntitiesAffected: %w", err)
+	}
+	return oldValue.EntitiesAffected, nil
+}
+
+// AddEntitiesAffected adds i to the "entities_affected" field.
+func (m *SchemaPromotionMutation) AddEntitiesAffected(i int) {
+	if m.addentities_affected != nil {
+		*m.addentities_affected += i
+	} else {
+		m.addentities_affected = &i
+	}
+}
+
+// AddedEntitiesAffected returns the value that was added to the "entities_affected" field in this mutation.
+func (m *SchemaPromotionMutation) AddedEntitiesAffected() (r int, exists bool) {
+	v := m.addentities_affected
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetEntitiesAffected resets all changes to the "entities_affected" field.
+func (m *SchemaPromotionMutation) ResetEntitiesAffected() {
+	m.entities_affected = nil
+	m.addentities_affected = nil
+}
+
+// SetValidationFailures sets the "validation_failures" field.
+func (m *SchemaPromotionMutation) SetValidationFailures(i int) {
+	m.validation_failures = &i
+	m.addvalidation_failures = nil
+}
+
+// ValidationFailures returns the value of the "validation_failures" field in the mutation.
+func (m *SchemaPromotionMutation) ValidationFailures() (r int, exists bool) {
+	v := m.validation_failures
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValidationFailures returns the old "validation_failures" field's value of the SchemaPromotion entity.
+// If the SchemaPromotion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SchemaPromotionMutation) OldValidationFailures(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValidationFailures is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValidationFailures requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValidationFailures: %w", err)
+	}
+	return oldValue.ValidationFailures, nil
+}
+
+// AddValidationFailures adds i to the "validation_failures" field.
+func (m *SchemaPromotionMutation) AddValidationFailures(i int) {
+	if m.addvalidation_failures != nil {
+		*m.addvalidation_failures += i
+	} else {
+		m.addvalidation_failures = &i
+	}
+}
+
+// AddedValidationFailures returns the value that was added to the "validation_failures" field in this mutation.
+func (m *SchemaPromotionMutation) AddedValidationFailures() (r int, exists bool) {
+	v := m.addvalidation_failures
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetValidationFailures resets all changes to the "validation_failures" field.
+func (m *SchemaPromotionMutation) ResetValidationFailures() {
+	m.validation_failures = nil
+	m.addvalidation_failures = nil
+}
+
+// SetSchemaDefinition sets the "schema_definition" field.
+func (m *SchemaPromotionMutation) SetSchemaDefinition(value map[string]interface{}) {
+	m.schema_definition = &value
+}
+
+// SchemaDefinition returns the value of the "schema_definition" field in the mutation.
+func (m *SchemaPromotionMutation) SchemaDefinition() (r map[string]interface{}, exists bool) {
+	v := m.schema_definition
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSchemaDefinition returns the old "schema_definition" field's value of the SchemaPromotion entity.
+// If the SchemaPromotion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SchemaPromotionMutation) OldSchemaDefinition(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSchemaDefinition is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSchemaDefinition requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSchemaDefinition: %w", err)
+	}
+	return oldValue.SchemaDefinition, nil
+}
+
+// ClearSchemaDefinition clears the value of the "schema_definition" field.
+func (m *SchemaPromotionMutation) ClearSchemaDefinition() {
+	m.schema_definition = nil
+	m.clearedFields[schemapromotion.FieldSchemaDefinition] = struct{}{}
+}
+
+// SchemaDefinitionCleared returns if the "schema_definition" field was cleared in this mutation.
+func (m *SchemaPromotionMutation) SchemaDefinitionCleared() bool {
+	_, ok := m.clearedFields[schemapromotion.FieldSchemaDefinition]
+	return ok
+}
+
+// ResetSchemaDefinition resets all changes to the "schema_definition" field.
+func (m *SchemaPromotionMutation) ResetSchemaDefinition() {
+	m.schema_definition = nil
+	delete(m.clearedFields, schemapromotion.FieldSchemaDefinition)
+}
+
+// Where appends a list predicates to the SchemaPromotionMutation builder.
+func (m *SchemaPromotionMutation) Where(ps ...predicate.SchemaPromotion) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SchemaPromotionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SchemaPromotionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SchemaPromotion, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SchemaPromotionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SchemaPromotionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SchemaPromotion).
+func (m *SchemaPromotionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SchemaPromotionMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.type_name != nil {
+		fields = append(fields, schemapromotion.FieldTypeName)
+	}
+	if m.promoted_at != nil {
+		fields = append(fields, schemapromotion.FieldPromotedAt)
+	}
+	if m.promotion_criteria != nil {
+		fields = append(fields, schemapromotion.FieldPromotionCriteria)
+	}
+	if m.entities_affected != nil {
+		fields = append(fields, schemapromotion.FieldEntitiesAffected)
+	}
+	if m.validation_failures != nil {
+		fields = append(fields, schemapromotion.FieldValidationFailures)
+	}
+	if m.schema_definition != nil {
+		fields = append(fields, schemapromotion.FieldSchemaDefinition)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SchemaPromotionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case schemapromotion.FieldTypeName:
+		return m.TypeName()
+	case schemapromotion.FieldPromotedAt:
+		return m.PromotedAt()
+	case schemapromotion.FieldPromotionCriteria:
+		return m.PromotionCriteria()
+	case schemapromotion.FieldEntitiesAffected:
+		return m.EntitiesAffected()
+	case schemapromotion.FieldValidationFailures:
+		return m.ValidationFailures()
+	case schemapromotion.FieldSchemaDefinition:
+		return m.SchemaDefinition()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SchemaPromotionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case schemapromotion.FieldTypeName:
+		return m.OldTypeName(ctx)
+	case schemapromotion.FieldPromotedAt:
+		return m.OldPromotedAt(ctx)
+	case schemapromotion.FieldPromotionCriteria:
+		return m.OldPromotionCriteria(ctx)
+	case schemapromotion.FieldEntitiesAffected:
+		return m.OldEntitiesAffected(ctx)
+	case schemapromotion.FieldValidationFailures:
+		return m.OldValidationFailures(ctx)
+	case schemapromotion.FieldSchemaDefinition:
+		return m.OldSchemaDefinition(ctx)
+	}
+	return nil, fmt.Errorf("unknown SchemaPromotion field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SchemaPromotionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case schemapromotion.FieldTypeName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTypeName(v)
+		return nil
+	case schemapromotion.FieldPromotedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetPromotedAt(v)
+		return nil
+	case schemapromotion.FieldPromotionCriteria:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPromotionCriteria(v)
+		return nil
+	case schemapromotion.FieldEntitiesAffected:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEntitiesAffected(v)
+		return nil
+	case schemapromotion.FieldValidationFailures:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValidationFailures(v)
+		return nil
+	case schemapromotion.FieldSchemaDefinition:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSchemaDefinition(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Relationship field %s", name)
+	return fmt.Errorf("unknown SchemaPromotion field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *RelationshipMutation) AddedFields() []string {
+func (m *SchemaPromotionMutation) AddedFields() []string {
 	var fields []string
-	if m.addfrom_id != nil {
-		fields = append(fields, relationship.FieldFromID)
-	}
-	if m.addto_id != nil {
-		fields = append(fields, relationship.FieldToID)
+	if m.addentities_affected != nil {
+		fields = append(fields, schemapromotion.FieldEntitiesAffected)
 	}
-	if m.addconfidence_score != nil {
-		fields = append(fields, relationship.FieldConfidenceScore)
+	if m.addvalidation_failures != nil {
+		fields = append(fields, schemapromotion.FieldValidationFailures)
 	}
 	return fields
 }
@@ -2448,14 +8626,12 @@ func (m *RelationshipMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *RelationshipMutation) AddedField(name string) (ent.Value, bool) {
+func (m *SchemaPromotionMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case relationship.FieldFromID:
-		return m.AddedFromID()
-	case relationship.FieldToID:
-		return m.AddedToID()
-	case relationship.FieldConfidenceScore:
-		return m.AddedConfidenceScore()
+	case schemapromotion.FieldEntitiesAffected:
+		return m.AddedEntitiesAffected()
+	case schemapromotion.FieldValidationFailures:
+		return m.AddedValidationFailures()
 	}
 	return nil, false
 }
@@ -2463,175 +8639,167 @@ func (m *RelationshipMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RelationshipMutation) AddField(name string, value ent.Value) error {
+func (m *SchemaPromotionMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case relationship.FieldFromID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddFromID(v)
-		return nil
-	case relationship.FieldToID:
+	case schemapromotion.FieldEntitiesAffected:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddToID(v)
+		m.AddEntitiesAffected(v)
 		return nil
-	case relationship.FieldConfidenceScore:
-		v, ok := value.(float64)
+	case schemapromotion.FieldValidationFailures:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddConfidenceScore(v)
+		m.AddValidationFailures(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Relationship numeric field %s", name)
+	return fmt.Errorf("unknown SchemaPromotion numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *RelationshipMutation) ClearedFields() []string {
+func (m *SchemaPromotionMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(relationship.FieldProperties) {
-		fields = append(fields, relationship.FieldProperties)
+	if m.FieldCleared(schemapromotion.FieldPromotionCriteria) {
+		fields = append(fields, schemapromotion.FieldPromotionCriteria)
+	}
+	if m.FieldCleared(schemapromotion.FieldSchemaDefinition) {
+		fields = append(fields, schemapromotion.FieldSchemaDefinition)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *RelationshipMutation) FieldCleared(name string) bool {
+func (m *SchemaPromotionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *RelationshipMutation) ClearField(name string) error {
+func (m *SchemaPromotionMutation) ClearField(name string) error {
 	switch name {
-	case relationship.FieldProperties:
-		m.ClearProperties()
+	case schemapromotion.FieldPromotionCriteria:
+		m.ClearPromotionCriteria()
+		return nil
+	case schemapromotion.FieldSchemaDefinition:
+		m.ClearSchemaDefinition()
 		return nil
 	}
-	return fmt.Errorf("unknown Relationship nullable field %s", name)
+	return fmt.Errorf("unknown SchemaPromotion nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *RelationshipMutation) ResetField(name string) error {
+func (m *SchemaPromotionMutation) ResetField(name string) error {
 	switch name {
-	case relationship.FieldType:
-		m.ResetType()
-		return nil
-	case relationship.FieldFromType:
-		m.ResetFromType()
-		return nil
-	case relationship.FieldFromID:
-		m.ResetFromID()
-		return nil
-	case relationship.FieldToType:
-		m.ResetToType()
+	case schemapromotion.FieldTypeName:
+		m.ResetTypeName()
 		return nil
-	case relationship.FieldToID:
-		m.ResetToID()
+	case schemapromotion.FieldPromotedAt:
+		m.ResetPromotedAt()
 		return nil
-	case relationship.FieldTimestamp:
-		m.ResetTimestamp()
+	case schemapromotion.FieldPromotionCriteria:
+		m.ResetPromotionCriteria()
 		return nil
-	case relationship.FieldConfidenceScore:
-		m.ResetConfidenceScore()
+	case schemapromotion.FieldEntitiesAffected:
+		m.ResetEntitiesAffected()
 		return nil
-	case relationship.FieldProperties:
-		m.ResetProperties()
+	case schemapromotion.FieldValidationFailures:
+		m.ResetValidationFailures()
 		return nil
-	case relationship.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case schemapromotion.FieldSchemaDefinition:
+		m.ResetSchemaDefinition()
 		return nil
 	}
-	return fmt.Errorf("unknown Relationship field %s", name)
+	return fmt.Errorf("unknown SchemaPromotion field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *RelationshipMutation) AddedEdges() []string {
+func (m *SchemaPromotionMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *RelationshipMutation) AddedIDs(name string) []ent.Value {
+func (m *SchemaPromotionMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *RelationshipMutation) RemovedEdges() []string {
+func (m *SchemaPromotionMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *RelationshipMutation) RemovedIDs(name string) []ent.Value {
+func (m *SchemaPromotionMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *RelationshipMutation) ClearedEdges() []string {
+func (m *SchemaPromotionMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *RelationshipMutation) EdgeCleared(name string) bool {
+func (m *SchemaPromotionMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *RelationshipMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Relationship unique edge %s", name)
+func (m *SchemaPromotionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SchemaPromotion unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *RelationshipMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Relationship edge %s", name)
+func (m *SchemaPromotionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SchemaPromotion edge %s", name)
 }
 
-// SchemaPromotionMutation represents an operation that mutates the SchemaPromotion nodes in the graph.
-type SchemaPromotionMutation struct {
+// ThreadMutation represents an operation that mutates the Thread nodes in the graph.
+type ThreadMutation struct {
 	config
-	op                     Op
-	typ                    string
-	id                     *int
-	type_name              *string
-	promoted_at            *time.Time
-	promotion_criteria     *map[string]interface{}
-	entities_affected      *int
-	addentities_affected   *int
-	validation_failures    *int
-	addvalidation_failures *int
-	schema_definition      *map[string]interface{}
-	clearedFields          map[string]struct{}
-	done                   bool
-	oldValue               func(context.Context) (*SchemaPromotion, error)
-	predicates             []predicate.SchemaPromotion
-}
-
-var _ ent.Mutation = (*SchemaPromotionMutation)(nil)
-
-// schemapromotionOption allows management of the mutation configuration using functional options.
-type schemapromotionOption func(*SchemaPromotionMutation)
-
-// newSchemaPromotionMutation creates new mutation for the SchemaPromotion entity.
-func newSchemaPromotionMutation(c config, op Op, opts ...schemapromotionOption) *SchemaPromotionMutation {
-	m := &SchemaPromotionMutation{
+	op                 Op
+	typ                string
+	id                 *int
+	root_message_id    *string
+	subject            *string
+	normalized_subject *string
+	message_count      *int
+	addmessage_count   *int
+	participants       *[]string
+	appendparticipants []string
+	first_date         *time.Time
+	last_date          *time.Time
+	created_at         *time.Time
+	clearedFields      map[string]struct{}
+	done               bool
+	oldValue           func(context.Context) (*Thread, error)
+	predicates         []predicate.Thread
+}
+
+var _ ent.Mutation = (*ThreadMutation)(nil)
+
+// threadOption allows management of the mutation configuration using functional options.
+type threadOption func(*ThreadMutation)
+
+// newThreadMutation creates new mutation for the Thread entity.
+func newThreadMutation(c config, op Op, opts ...threadOption) *ThreadMutation {
+	m := &ThreadMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSchemaPromotion,
+		typ:           TypeThread,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2640,20 +8808,20 @@ func newSchemaPromotionMutation(c config, op Op, opts ...schemapromotionOption)
 	return m
 }
 
-// withSchemaPromotionID sets the ID field of the mutation.
-func withSchemaPromotionID(id int) schemapromotionOption {
-	return func(m *SchemaPromotionMutation) {
+// withThreadID sets the ID field of the mutation.
+func withThreadID(id int) threadOption {
+	return func(m *ThreadMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *SchemaPromotion
+			value *Thread
 		)
-		m.oldValue = func(ctx context.Context) (*SchemaPromotion, error) {
+		m.oldValue = func(ctx context.Context) (*Thread, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().SchemaPromotion.Get(ctx, id)
+					value, err = m.Client().Thread.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2662,10 +8830,10 @@ func withSchemaPromotionID(id int) schemapromotionOption {
 	}
 }
 
-// withSchemaPromotion sets the old SchemaPromotion of the mutation.
-func withSchemaPromotion(node *SchemaPromotion) schemapromotionOption {
-	return func(m *SchemaPromotionMutation) {
-		m.oldValue = func(context.Context) (*SchemaPromotion, error) {
+// withThread sets the old Thread of the mutation.
+func withThread(node *Thread) threadOption {
+	return func(m *ThreadMutation) {
+		m.oldValue = func(context.Context) (*Thread, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2674,7 +8842,7 @@ func withSchemaPromotion(node *SchemaPromotion) schemapromotionOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SchemaPromotionMutation) Client() *Client {
+func (m ThreadMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2682,7 +8850,7 @@ func (m SchemaPromotionMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m SchemaPromotionMutation) Tx() (*Tx, error) {
+func (m ThreadMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2693,7 +8861,7 @@ func (m SchemaPromotionMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *SchemaPromotionMutation) ID() (id int, exists bool) {
+func (m *ThreadMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2704,7 +8872,7 @@ func (m *SchemaPromotionMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *SchemaPromotionMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ThreadMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2713,303 +8881,384 @@ func (m *SchemaPromotionMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().SchemaPromotion.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Thread.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetTypeName sets the "type_name" field.
-func (m *SchemaPromotionMutation) SetTypeName(s string) {
-	m.type_name = &s
+// SetRootMessageID sets the "root_message_id" field.
+func (m *ThreadMutation) SetRootMessageID(s string) {
+	m.root_message_id = &s
 }
 
-// TypeName returns the value of the "type_name" field in the mutation.
-func (m *SchemaPromotionMutation) TypeName() (r string, exists bool) {
-	v := m.type_name
+// RootMessageID returns the value of the "root_message_id" field in the mutation.
+func (m *ThreadMutation) RootMessageID() (r string, exists bool) {
+	v := m.root_message_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTypeName returns the old "type_name" field's value of the SchemaPromotion entity.
-// If the SchemaPromotion object wasn't provided to the builder, the object is fetched from the database.
+// OldRootMessageID returns the old "root_message_id" field's value of the Thread entity.
+// If the Thread object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SchemaPromotionMutation) OldTypeName(ctx context.Context) (v string, err error) {
+func (m *ThreadMutation) OldRootMessageID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTypeName is only allowed on UpdateOne operations")
+		return v, errors.New("OldRootMessageID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTypeName requires an ID field in the mutation")
+		return v, errors.New("OldRootMessageID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTypeName: %w", err)
+		return v, fmt.Errorf("querying old value for OldRootMessageID: %w", err)
 	}
-	return oldValue.TypeName, nil
+	return oldValue.RootMessageID, nil
 }
 
-// ResetTypeName resets all changes to the "type_name" field.
-func (m *SchemaPromotionMutation) ResetTypeName() {
-	m.type_name = nil
+// ResetRootMessageID resets all changes to the "root_message_id" field.
+func (m *ThreadMutation) ResetRootMessageID() {
+	m.root_message_id = nil
 }
 
-// SetPromotedAt sets the "promoted_at" field.
-func (m *SchemaPromotionMutation) SetPromotedAt(t time.Time) {
-	m.promoted_at = &t
+// SetSubject sets the "subject" field.
+func (m *ThreadMutation) SetSubject(s string) {
+	m.subject = &s
 }
 
-// PromotedAt returns the value of the "promoted_at" field in the mutation.
-func (m *SchemaPromotionMutation) PromotedAt() (r time.Time, exists bool) {
-	v := m.promoted_at
+// Subject returns the value of the "subject" field in the mutation.
+func (m *ThreadMutation) Subject() (r string, exists bool) {
+	v := m.subject
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPromotedAt returns the old "promoted_at" field's value of the SchemaPromotion entity.
-// If the SchemaPromotion object wasn't provided to the builder, the object is fetched from the database.
+// OldSubject returns the old "subject" field's value of the Thread entity.
+// If the Thread object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SchemaPromotionMutation) OldPromotedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ThreadMutation) OldSubject(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPromotedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldSubject is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPromotedAt requires an ID field in the mutation")
+		return v, errors.New("OldSubject requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPromotedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldSubject: %w", err)
 	}
-	return oldValue.PromotedAt, nil
+	return oldValue.Subject, nil
 }
 
-// ResetPromotedAt resets all changes to the "promoted_at" field.
-func (m *SchemaPromotionMutation) ResetPromotedAt() {
-	m.promoted_at = nil
+// ResetSubject resets all changes to the "subject" field.
+func (m *ThreadMutation) ResetSubject() {
+	m.subject = nil
 }
 
-// SetPromotionCriteria sets the "promotion_criteria" field.
-func (m *SchemaPromotionMutation) SetPromotionCriteria(value map[string]interface{}) {
-	m.promotion_criteria = &value
+// SetNormalizedSubject sets the "normalized_subject" field.
+func (m *ThreadMutation) SetNormalizedSubject(s string) {
+	m.normalized_subject = &s
 }
 
-// PromotionCriteria returns the value of the "promotion_criteria" field in the mutation.
-func (m *SchemaPromotionMutation) PromotionCriteria() (r map[string]interface{}, exists bool) {
-	v := m.promotion_criteria
+// NormalizedSubject returns the value of the "normalized_subject" field in the mutation.
+func (m *ThreadMutation) NormalizedSubject() (r string, exists bool) {
+	v := m.normalized_subject
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPromotionCriteria returns the old "promotion_criteria" field's value of the SchemaPromotion entity.
-// If the SchemaPromotion object wasn't provided to the builder, the object is fetched from the database.
+// OldNormalizedSubject returns the old "normalized_subject" field's value of the Thread entity.
+// If the Thread object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SchemaPromotionMutation) OldPromotionCriteria(ctx context.Context) (v map[string]interface{}, err error) {
+func (m *ThreadMutation) OldNormalizedSubject(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPromotionCriteria is only allowed on UpdateOne operations")
+		return v, errors.New("OldNormalizedSubject is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPromotionCriteria requires an ID field in the mutation")
+		return v, errors.New("OldNormalizedSubject requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPromotionCriteria: %w", err)
+		return v, fmt.Errorf("querying old value for OldNormalizedSubject: %w", err)
 	}
-	return oldValue.PromotionCriteria, nil
+	return oldValue.NormalizedSubject, nil
 }
 
-// ClearPromotionCriteria clears the value of the "promotion_criteria" field.
-func (m *SchemaPromotionMutation) ClearPromotionCriteria() {
-	m.promotion_criteria = nil
-	m.clearedFields[schemapromotion.FieldPromotionCriteria] = struct{}{}
+// ResetNormalizedSubject resets all changes to the "normalized_subject" field.
+func (m *ThreadMutation) ResetNormalizedSubject() {
+	m.normalized_subject = nil
 }
 
-// PromotionCriteriaCleared returns if the "promotion_criteria" field was cleared in this mutation.
-func (m *SchemaPromotionMutation) PromotionCriteriaCleared() bool {
-	_, ok := m.clearedFields[schemapromotion.FieldPromotionCriteria]
-	return ok
+// SetMessageCount sets the "message_count" field.
+func (m *ThreadMutation) SetMessageCount(i int) {
+	m.message_count = &i
+	m.addmessage_count = nil
 }
 
-// ResetPromotionCriteria resets all changes to the "promotion_criteria" field.
-func (m *SchemaPromotionMutation) ResetPromotionCriteria() {
-	m.promotion_criteria = nil
-	delete(m.clearedFields, schemapromotion.FieldPromotionCriteria)
+// MessageCount returns the value of the "message_count" field in the mutation.
+func (m *ThreadMutation) MessageCount() (r int, exists bool) {
+	v := m.message_count
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetEntitiesAffected sets the "entities_affected" field.
-func (m *SchemaPromotionMutation) SetEntitiesAffected(i int) {
-	m.entities_affected = &i
-	m.addentities_affected = nil
+// OldMessageCount returns the old "message_count" field's value of the Thread entity.
+// If the Thread object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ThreadMutation) OldMessageCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessageCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessageCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessageCount: %w", err)
+	}
+	return oldValue.MessageCount, nil
 }
 
-// EntitiesAffected returns the value of the "entities_affected" field in the mutation.
-func (m *SchemaPromotionMutation) EntitiesAffected() (r int, exists bool) {
-	v := m.entities_affected
+// AddMessageCount adds i to the "message_count" field.
+func (m *ThreadMutation) AddMessageCount(i int) {
+	if m.addmessage_count != nil {
+		*m.addmessage_count += i
+	} else {
+		m.addmessage_count = &i
+	}
+}
+
+// AddedMessageCount returns the value that was added to the "message_count" field in this mutation.
+func (m *ThreadMutation) AddedMessageCount() (r int, exists bool) {
+	v := m.addmessage_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMessageCount resets all changes to the "message_count" field.
+func (m *ThreadMutation) ResetMessageCount() {
+	m.message_count = nil
+	m.addmessage_count = nil
+}
+
+// SetParticipants sets the "participants" field.
+func (m *ThreadMutation) SetParticipants(s []string) {
+	m.participants = &s
+	m.appendparticipants = nil
+}
+
+// Participants returns the value of the "participants" field in the mutation.
+func (m *ThreadMutation) Participants() (r []string, exists bool) {
+	v := m.participants
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParticipants returns the old "participants" field's value of the Thread entity.
+// If the Thread object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ThreadMutation) OldParticipants(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParticipants is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParticipants requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParticipants: %w", err)
+	}
+	return oldValue.Participants, nil
+}
+
+// AppendParticipants adds s to the "participants" field.
+func (m *ThreadMutation) AppendParticipants(s []string) {
+	m.appendparticipants = append(m.appendparticipants, s...)
+}
+
+// AppendedParticipants returns the list of values that were appended to the "participants" field in this mutation.
+func (m *ThreadMutation) AppendedParticipants() ([]string, bool) {
+	if len(m.appendparticipants) == 0 {
+		return nil, false
+	}
+	return m.appendparticipants, true
+}
+
+// ClearParticipants clears the value of the "participants" field.
+func (m *ThreadMutation) ClearParticipants() {
+	m.participants = nil
+	m.appendparticipants = nil
+	m.clearedFields[thread.FieldParticipants] = struct{}{}
+}
+
+// ParticipantsCleared returns if the "participants" field was cleared in this mutation.
+func (m *ThreadMutation) ParticipantsCleared() bool {
+	_, ok := m.clearedFields[thread.FieldParticipants]
+	return ok
+}
+
+// ResetParticipants resets all changes to the "participants" field.
+func (m *ThreadMutation) ResetParticipants() {
+	m.participants = nil
+	m.appendparticipants = nil
+	delete(m.clearedFields, thread.FieldParticipants)
+}
+
+// SetFirstDate sets the "first_date" field.
+func (m *ThreadMutation) SetFirstDate(t time.Time) {
+	m.first_date = &t
+}
+
+// FirstDate returns the value of the "first_date" field in the mutation.
+func (m *ThreadMutation) FirstDate() (r time.Time, exists bool) {
+	v := m.first_date
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEntitiesAffected returns the old "entities_affected" field's value of the SchemaPromotion entity.
-// If the SchemaPromotion object wasn't provided to the builder, the object is fetched from the database.
+// OldFirstDate returns the old "first_date" field's value of the Thread entity.
+// If the Thread object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SchemaPromotionMutation) OldEntitiesAffected(ctx context.Context) (v int, err error) {
+func (m *ThreadMutation) OldFirstDate(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEntitiesAffected is only allowed on UpdateOne operations")
+		return v, errors.New("OldFirstDate is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEntitiesAffected requires an ID field in the mutation")
+		return v, errors.New("OldFirstDate requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEntitiesAffected: %w", err)
+		return v, fmt.Errorf("querying old value for OldFirstDate: %w", err)
 	}
-	return oldValue.EntitiesAffected, nil
+	return oldValue.FirstDate, nil
 }
 
-// AddEntitiesAffected adds i to the "entities_affected" field.
-func (m *SchemaPromotionMutation) AddEntitiesAffected(i int) {
-	if m.addentities_affected != nil {
-		*m.addentities_affected += i
-	} else {
-		m.addentities_affected = &i
-	}
+// ClearFirstDate clears the value of the "first_date" field.
+func (m *ThreadMutation) ClearFirstDate() {
+	m.first_date = nil
+	m.clearedFields[thread.FieldFirstDate] = struct{}{}
 }
 
-// AddedEntitiesAffected returns the value that was added to the "entities_affected" field in this mutation.
-func (m *SchemaPromotionMutation) AddedEntitiesAffected() (r int, exists bool) {
-	v := m.addentities_affected
-	if v == nil {
-		return
-	}
-	return *v, true
+// FirstDateCleared returns if the "first_date" field was cleared in this mutation.
+func (m *ThreadMutation) FirstDateCleared() bool {
+	_, ok := m.clearedFields[thread.FieldFirstDate]
+	return ok
 }
 
-// ResetEntitiesAffected resets all changes to the "entities_affected" field.
-func (m *SchemaPromotionMutation) ResetEntitiesAffected() {
-	m.entities_affected = nil
-	m.addentities_affected = nil
+// ResetFirstDate resets all changes to the "first_date" field.
+func (m *ThreadMutation) ResetFirstDate() {
+	m.first_date = nil
+	delete(m.clearedFields, thread.FieldFirstDate)
 }
 
-// SetValidationFailures sets the "validation_failures" field.
-func (m *SchemaPromotionMutation) SetValidationFailures(i int) {
-	m.validation_failures = &i
-	m.addvalidation_failures = nil
+// SetLastDate sets the "last_date" field.
+func (m *ThreadMutation) SetLastDate(t time.Time) {
+	m.last_date = &t
 }
 
-// ValidationFailures returns the value of the "validation_failures" field in the mutation.
-func (m *SchemaPromotionMutation) ValidationFailures() (r int, exists bool) {
-	v := m.validation_failures
+// LastDate returns the value of the "last_date" field in the mutation.
+func (m *ThreadMutation) LastDate() (r time.Time, exists bool) {
+	v := m.last_date
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldValidationFailures returns the old "validation_failures" field's value of the SchemaPromotion entity.
-// If the SchemaPromotion object wasn't provided to the builder, the object is fetched from the database.
+// OldLastDate returns the old "last_date" field's value of the Thread entity.
+// If the Thread object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SchemaPromotionMutation) OldValidationFailures(ctx context.Context) (v int, err error) {
+func (m *ThreadMutation) OldLastDate(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldValidationFailures is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastDate is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldValidationFailures requires an ID field in the mutation")
+		return v, errors.New("OldLastDate requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldValidationFailures: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastDate: %w", err)
 	}
-	return oldValue.ValidationFailures, nil
+	return oldValue.LastDate, nil
 }
 
-// AddValidationFailures adds i to the "validation_failures" field.
-func (m *SchemaPromotionMutation) AddValidationFailures(i int) {
-	if m.addvalidation_failures != nil {
-		*m.addvalidation_failures += i
-	} else {
-		m.addvalidation_failures = &i
-	}
+// ClearLastDate clears the value of the "last_date" field.
+func (m *ThreadMutation) ClearLastDate() {
+	m.last_date = nil
+	m.clearedFields[thread.FieldLastDate] = struct{}{}
 }
 
-// AddedValidationFailures returns the value that was added to the "validation_failures" field in this mutation.
-func (m *SchemaPromotionMutation) AddedValidationFailures() (r int, exists bool) {
-	v := m.addvalidation_failures
-	if v == nil {
-		return
-	}
-	return *v, true
+// LastDateCleared returns if the "last_date" field was cleared in this mutation.
+func (m *ThreadMutation) LastDateCleared() bool {
+	_, ok := m.clearedFields[thread.FieldLastDate]
+	return ok
 }
 
-// ResetValidationFailures resets all changes to the "validation_failures" field.
-func (m *SchemaPromotionMutation) ResetValidationFailures() {
-	m.validation_failures = nil
-	m.addvalidation_failures = nil
+// ResetLastDate resets all changes to the "last_date" field.
+func (m *ThreadMutation) ResetLastDate() {
+	m.last_date = nil
+	delete(m.clearedFields, thread.FieldLastDate)
 }
 
-// SetSchemaDefinition sets the "schema_definition" field.
-func (m *SchemaPromotionMutation) SetSchemaDefinition(value map[string]interface{}) {
-	m.schema_definition = &value
+// SetCreatedAt sets the "created_at" field.
+func (m *ThreadMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// SchemaDefinition returns the value of the "schema_definition" field in the mutation.
-func (m *SchemaPromotionMutation) SchemaDefinition() (r map[string]interface{}, exists bool) {
-	v := m.schema_definition
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ThreadMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSchemaDefinition returns the old "schema_definition" field's value of the SchemaPromotion entity.
-// If the SchemaPromotion object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Thread entity.
+// If the Thread object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SchemaPromotionMutation) OldSchemaDefinition(ctx context.Context) (v map[string]interface{}, err error) {
+func (m *ThreadMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSchemaDefinition is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSchemaDefinition requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSchemaDefinition: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.SchemaDefinition, nil
-}
-
-// ClearSchemaDefinition clears the value of the "schema_definition" field.
-func (m *SchemaPromotionMutation) ClearSchemaDefinition() {
-	m.schema_definition = nil
-	m.clearedFields[schemapromotion.FieldSchemaDefinition] = struct{}{}
-}
-
-// SchemaDefinitionCleared returns if the "schema_definition" field was cleared in this mutation.
-func (m *SchemaPromotionMutation) SchemaDefinitionCleared() bool {
-	_, ok := m.clearedFields[schemapromotion.FieldSchemaDefinition]
-	return ok
+	return oldValue.CreatedAt, nil
 }
 
-// ResetSchemaDefinition resets all changes to the "schema_definition" field.
-func (m *SchemaPromotionMutation) ResetSchemaDefinition() {
-	m.schema_definition = nil
-	delete(m.clearedFields, schemapromotion.FieldSchemaDefinition)
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ThreadMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// Where appends a list predicates to the SchemaPromotionMutation builder.
-func (m *SchemaPromotionMutation) Where(ps ...predicate.SchemaPromotion) {
+// Where appends a list predicates to the ThreadMutation builder.
+func (m *ThreadMutation) Where(ps ...predicate.Thread) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the SchemaPromotionMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ThreadMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SchemaPromotionMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.SchemaPromotion, len(ps))
+func (m *ThreadMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Thread, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3017,42 +9266,48 @@ func (m *SchemaPromotionMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *SchemaPromotionMutation) Op() Op {
+func (m *ThreadMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *SchemaPromotionMutation) SetOp(op Op) {
+func (m *ThreadMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (SchemaPromotion).
-func (m *SchemaPromotionMutation) Type() string {
+// Type returns the node type of this mutation (Thread).
+func (m *ThreadMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SchemaPromotionMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.type_name != nil {
-		fields = append(fields, schemapromotion.FieldTypeName)
+func (m *ThreadMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.root_message_id != nil {
+		fields = append(fields, thread.FieldRootMessageID)
 	}
-	if m.promoted_at != nil {
-		fields = append(fields, schemapromotion.FieldPromotedAt)
+	if m.subject != nil {
+		fields = append(fields, thread.FieldSubject)
 	}
-	if m.promotion_criteria != nil {
-		fields = append(fields, schemapromotion.FieldPromotionCriteria)
+	if m.normalized_subject != nil {
+		fields = append(fields, thread.FieldNormalizedSubject)
 	}
-	if m.entities_affected != nil {
-		fields = append(fields, schemapromotion.FieldEntitiesAffected)
+	if m.message_count != nil {
+		fields = append(fields, thread.FieldMessageCount)
 	}
-	if m.validation_failures != nil {
-		fields = append(fields, schemapromotion.FieldValidationFailures)
+	if m.participants != nil {
+		fields = append(fields, thread.FieldParticipants)
 	}
-	if m.schema_definition != nil {
-		fields = append(fields, schemapromotion.FieldSchemaDefinition)
+	if m.first_date != nil {
+		fields = append(fields, thread.FieldFirstDate)
+	}
+	if m.last_date != nil {
+		fields = append(fields, thread.FieldLastDate)
+	}
+	if m.created_at != nil {
+		fields = append(fields, thread.FieldCreatedAt)
 	}
 	return fields
 }
@@ -3060,20 +9315,24 @@ func (m *SchemaPromotionMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SchemaPromotionMutation) Field(name string) (ent.Value, bool) {
+func (m *ThreadMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case schemapromotion.FieldTypeName:
-		return m.TypeName()
-	case schemapromotion.FieldPromotedAt:
-		return m.PromotedAt()
-	case schemapromotion.FieldPromotionCriteria:
-		return m.PromotionCriteria()
-	case schemapromotion.FieldEntitiesAffected:
-		return m.EntitiesAffected()
-	case schemapromotion.FieldValidationFailures:
-		return m.ValidationFailures()
-	case schemapromotion.FieldSchemaDefinition:
-		return m.SchemaDefinition()
+	case thread.FieldRootMessageID:
+		return m.RootMessageID()
+	case thread.FieldSubject:
+		return m.Subject()
+	case thread.FieldNormalizedSubject:
+		return m.NormalizedSubject()
+	case thread.FieldMessageCount:
+		return m.MessageCount()
+	case thread.FieldParticipants:
+		return m.Participants()
+	case thread.FieldFirstDate:
+		return m.FirstDate()
+	case thread.FieldLastDate:
+		return m.LastDate()
+	case thread.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
@@ -3081,84 +9340,99 @@ func (m *SchemaPromotionMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SchemaPromotionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ThreadMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case schemapromotion.FieldTypeName:
-		return m.OldTypeName(ctx)
-	case schemapromotion.FieldPromotedAt:
-		return m.OldPromotedAt(ctx)
-	case schemapromotion.FieldPromotionCriteria:
-		return m.OldPromotionCriteria(ctx)
-	case schemapromotion.FieldEntitiesAffected:
-		return m.OldEntitiesAffected(ctx)
-	case schemapromotion.FieldValidationFailures:
-		return m.OldValidationFailures(ctx)
-	case schemapromotion.FieldSchemaDefinition:
-		return m.OldSchemaDefinition(ctx)
+	case thread.FieldRootMessageID:
+		return m.OldRootMessageID(ctx)
+	case thread.FieldSubject:
+		return m.OldSubject(ctx)
+	case thread.FieldNormalizedSubject:
+		return m.OldNormalizedSubject(ctx)
+	case thread.FieldMessageCount:
+		return m.OldMessageCount(ctx)
+	case thread.FieldParticipants:
+		return m.OldParticipants(ctx)
+	case thread.FieldFirstDate:
+		return m.OldFirstDate(ctx)
+	case thread.FieldLastDate:
+		return m.OldLastDate(ctx)
+	case thread.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown SchemaPromotion field %s", name)
+	return nil, fmt.Errorf("unknown Thread field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SchemaPromotionMutation) SetField(name string, value ent.Value) error {
+func (m *ThreadMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case schemapromotion.FieldTypeName:
+	case thread.FieldRootMessageID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTypeName(v)
+		m.SetRootMessageID(v)
 		return nil
-	case schemapromotion.FieldPromotedAt:
-		v, ok := value.(time.Time)
+	case thread.FieldSubject:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPromotedAt(v)
+		m.SetSubject(v)
 		return nil
-	case schemapromotion.FieldPromotionCriteria:
-		v, ok := value.(map[string]interface{})
+	case thread.FieldNormalizedSubject:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPromotionCriteria(v)
+		m.SetNormalizedSubject(v)
 		return nil
-	case schemapromotion.FieldEntitiesAffected:
+	case thread.FieldMessageCount:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEntitiesAffected(v)
+		m.SetMessageCount(v)
 		return nil
-	case schemapromotion.FieldValidationFailures:
-		v, ok := value.(int)
+	case thread.FieldParticipants:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetValidationFailures(v)
+		m.SetParticipants(v)
 		return nil
-	case schemapromotion.FieldSchemaDefinition:
-		v, ok := value.(map[string]interface{})
+	case thread.FieldFirstDate:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSchemaDefinition(v)
+		m.SetFirstDate(v)
+		return nil
+	case thread.FieldLastDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastDate(v)
+		return nil
+	case thread.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown SchemaPromotion field %s", name)
+	return fmt.Errorf("unknown Thread field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SchemaPromotionMutation) AddedFields() []string {
+func (m *ThreadMutation) AddedFields() []string {
 	var fields []string
-	if m.addentities_affected != nil {
-		fields = append(fields, schemapromotion.FieldEntitiesAffected)
-	}
-	if m.addvalidation_failures != nil {
-		fields = append(fields, schemapromotion.FieldValidationFailures)
+	if m.addmessage_count != nil {
+		fields = append(fields, thread.FieldMessageCount)
 	}
 	return fields
 }
@@ -3166,12 +9440,10 @@ func (m *SchemaPromotionMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SchemaPromotionMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ThreadMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case schemapromotion.FieldEntitiesAffected:
-		return m.AddedEntitiesAffected()
-	case schemapromotion.FieldValidationFailures:
-		return m.AddedValidationFailures()
+	case thread.FieldMessageCount:
+		return m.AddedMessageCount()
 	}
 	return nil, false
 }
@@ -3179,130 +9451,135 @@ func (m *SchemaPromotionMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SchemaPromotionMutation) AddField(name string, value ent.Value) error {
+func (m *ThreadMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case schemapromotion.FieldEntitiesAffected:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddEntitiesAffected(v)
-		return nil
-	case schemapromotion.FieldValidationFailures:
+	case thread.FieldMessageCount:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddValidationFailures(v)
+		m.AddMessageCount(v)
 		return nil
 	}
-	return fmt.Errorf("unknown SchemaPromotion numeric field %s", name)
+	return fmt.Errorf("unknown Thread numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SchemaPromotionMutation) ClearedFields() []string {
+func (m *ThreadMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(schemapromotion.FieldPromotionCriteria) {
-		fields = append(fields, schemapromotion.FieldPromotionCriteria)
+	if m.FieldCleared(thread.FieldParticipants) {
+		fields = append(fields, thread.FieldParticipants)
 	}
-	if m.FieldCleared(schemapromotion.FieldSchemaDefinition) {
-		fields = append(fields, schemapromotion.FieldSchemaDefinition)
+	if m.FieldCleared(thread.FieldFirstDate) {
+		fields = append(fields, thread.FieldFirstDate)
+	}
+	if m.FieldCleared(thread.FieldLastDate) {
+		fields = append(fields, thread.FieldLastDate)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SchemaPromotionMutation) FieldCleared(name string) bool {
+func (m *ThreadMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SchemaPromotionMutation) ClearField(name string) error {
+func (m *ThreadMutation) ClearField(name string) error {
 	switch name {
-	case schemapromotion.FieldPromotionCriteria:
-		m.ClearPromotionCriteria()
+	case thread.FieldParticipants:
+		m.ClearParticipants()
 		return nil
-	case schemapromotion.FieldSchemaDefinition:
-		m.ClearSchemaDefinition()
+	case thread.FieldFirstDate:
+		m.ClearFirstDate()
+		return nil
+	case thread.FieldLastDate:
+		m.ClearLastDate()
 		return nil
 	}
-	return fmt.Errorf("unknown SchemaPromotion nullable field %s", name)
+	return fmt.Errorf("unknown Thread nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SchemaPromotionMutation) ResetField(name string) error {
+func (m *ThreadMutation) ResetField(name string) error {
 	switch name {
-	case schemapromotion.FieldTypeName:
-		m.ResetTypeName()
+	case thread.FieldRootMessageID:
+		m.ResetRootMessageID()
 		return nil
-	case schemapromotion.FieldPromotedAt:
-		m.ResetPromotedAt()
+	case thread.FieldSubject:
+		m.ResetSubject()
 		return nil
-	case schemapromotion.FieldPromotionCriteria:
-		m.ResetPromotionCriteria()
+	case thread.FieldNormalizedSubject:
+		m.ResetNormalizedSubject()
 		return nil
-	case schemapromotion.FieldEntitiesAffected:
-		m.ResetEntitiesAffected()
+	case thread.FieldMessageCount:
+		m.ResetMessageCount()
 		return nil
-	case schemapromotion.FieldValidationFailures:
-		m.ResetValidationFailures()
+	case thread.FieldParticipants:
+		m.ResetParticipants()
 		return nil
-	case schemapromotion.FieldSchemaDefinition:
-		m.ResetSchemaDefinition()
+	case thread.FieldFirstDate:
+		m.ResetFirstDate()
+		return nil
+	case thread.FieldLastDate:
+		m.ResetLastDate()
+		return nil
+	case thread.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown SchemaPromotion field %s", name)
+	return fmt.Errorf("unknown Thread field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SchemaPromotionMutation) AddedEdges() []string {
+func (m *ThreadMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SchemaPromotionMutation) AddedIDs(name string) []ent.Value {
+func (m *ThreadMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SchemaPromotionMutation) RemovedEdges() []string {
+func (m *ThreadMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SchemaPromotionMutation) RemovedIDs(name string) []ent.Value {
+func (m *ThreadMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SchemaPromotionMutation) ClearedEdges() []string {
+func (m *ThreadMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SchemaPromotionMutation) EdgeCleared(name string) bool {
+func (m *ThreadMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SchemaPromotionMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown SchemaPromotion unique edge %s", name)
+func (m *ThreadMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Thread unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SchemaPromotionMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown SchemaPromotion edge %s", name)
+func (m *ThreadMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Thread edge %s", name)
 }
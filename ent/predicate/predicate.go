@@ -6,14 +6,35 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// CentralityScore is the predicate function for centralityscore builders.
+type CentralityScore func(*sql.Selector)
+
+// Community is the predicate function for community builders.
+type Community func(*sql.Selector)
+
 // DiscoveredEntity is the predicate function for discoveredentity builders.
 type DiscoveredEntity func(*sql.Selector)
 
 // Email is the predicate function for email builders.
 type Email func(*sql.Selector)
 
+// EntityAlias is the predicate function for entityalias builders.
+type EntityAlias func(*sql.Selector)
+
+// EntityAudit is the predicate function for entityaudit builders.
+type EntityAudit func(*sql.Selector)
+
+// ExtractionJob is the predicate function for extractionjob builders.
+type ExtractionJob func(*sql.Selector)
+
+// Provenance is the predicate function for provenance builders.
+type Provenance func(*sql.Selector)
+
 // Relationship is the predicate function for relationship builders.
 type Relationship func(*sql.Selector)
 
 // SchemaPromotion is the predicate function for schemapromotion builders.
 type SchemaPromotion func(*sql.Selector)
+
+// Thread is the predicate function for thread builders.
+type Thread func(*sql.Selector)
@@ -0,0 +1,210 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Blogem/enron-graph/ent/provenance"
+)
+
+// Provenance is the model entity for the Provenance schema.
+type Provenance struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Kind of fact: discovered_entity or relationship
+	SubjectType string `json:"subject_type,omitempty"`
+	// ID of the entity or relationship
+	SubjectID int `json:"subject_id,omitempty"`
+	// Source email ID
+	EmailID int `json:"email_id,omitempty"`
+	// Source email message ID
+	MessageID string `json:"message_id,omitempty"`
+	// Original file path of the source email in the dataset
+	FilePath string `json:"file_path,omitempty"`
+	// How the fact was obtained: header, content, merge
+	Source string `json:"source,omitempty"`
+	// LLM model used for extraction
+	Model string `json:"model,omitempty"`
+	// Version of the extraction prompt template
+	PromptVersion string `json:"prompt_version,omitempty"`
+	// When the extraction ran
+	ExtractedAt time.Time `json:"extracted_at,omitempty"`
+	// Additional lineage details (e.g., merged properties)
+	Properties   map[string]interface{} `json:"properties,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Provenance) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case provenance.FieldProperties:
+			values[i] = new([]byte)
+		case provenance.FieldID, provenance.FieldSubjectID, provenance.FieldEmailID:
+			values[i] = new(sql.NullInt64)
+		case provenance.FieldSubjectType, provenance.FieldMessageID, provenance.FieldFilePath, provenance.FieldSource, provenance.FieldModel, provenance.FieldPromptVersion:
+			values[i] = new(sql.NullString)
+		case provenance.FieldExtractedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Provenance fields.
+func (_m *Provenance) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case provenance.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case provenance.FieldSubjectType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subject_type", values[i])
+			} else if value.Valid {
+				_m.SubjectType = value.String
+			}
+		case provenance.FieldSubjectID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field subject_id", values[i])
+			} else if value.Valid {
+				_m.SubjectID = int(value.Int64)
+			}
+		case provenance.FieldEmailID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field email_id", values[i])
+			} else if value.Valid {
+				_m.EmailID = int(value.Int64)
+			}
+		case provenance.FieldMessageID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field message_id", values[i])
+			} else if value.Valid {
+				_m.MessageID = value.String
+			}
+		case provenance.FieldFilePath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field file_path", values[i])
+			} else if value.Valid {
+				_m.FilePath = value.String
+			}
+		case provenance.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				_m.Source = value.String
+			}
+		case provenance.FieldModel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field model", values[i])
+			} else if value.Valid {
+				_m.Model = value.String
+			}
+		case provenance.FieldPromptVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field prompt_version", values[i])
+			} else if value.Valid {
+				_m.PromptVersion = value.String
+			}
+		case provenance.FieldExtractedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field extracted_at", values[i])
+			} else if value.Valid {
+				_m.ExtractedAt = value.Time
+			}
+		case provenance.FieldProperties:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field properties", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Properties); err != nil {
+					return fmt.Errorf("unmarshal field properties: %w", err)
+				}
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Provenance.
+// This includes values selected through modifiers, order, etc.
+func (_m *Provenance) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Provenance.
+// Note that you need to call Provenance.Unwrap() before calling this method if this Provenance
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Provenance) Update() *ProvenanceUpdateOne {
+	return NewProvenanceClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Provenance entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Provenance) Unwrap() *Provenance {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Provenance is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Provenance) String() string {
+	var builder strings.Builder
+	builder.WriteString("Provenance(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("subject_type=")
+	builder.WriteString(_m.SubjectType)
+	builder.WriteString(", ")
+	builder.WriteString("subject_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SubjectID))
+	builder.WriteString(", ")
+	builder.WriteString("email_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EmailID))
+	builder.WriteString(", ")
+	builder.WriteString("message_id=")
+	builder.WriteString(_m.MessageID)
+	builder.WriteString(", ")
+	builder.WriteString("file_path=")
+	builder.WriteString(_m.FilePath)
+	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(_m.Source)
+	builder.WriteString(", ")
+	builder.WriteString("model=")
+	builder.WriteString(_m.Model)
+	builder.WriteString(", ")
+	builder.WriteString("prompt_version=")
+	builder.WriteString(_m.PromptVersion)
+	builder.WriteString(", ")
+	builder.WriteString("extracted_at=")
+	builder.WriteString(_m.ExtractedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("properties=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Properties))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Provenances is a parsable slice of Provenance.
+type Provenances []*Provenance
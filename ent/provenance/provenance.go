@@ -0,0 +1,127 @@
+// Code generated by ent, DO NOT EDIT.
+
+package provenance
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the provenance type in the database.
+	Label = "provenance"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldSubjectType holds the string denoting the subject_type field in the database.
+	FieldSubjectType = "subject_type"
+	// FieldSubjectID holds the string denoting the subject_id field in the database.
+	FieldSubjectID = "subject_id"
+	// FieldEmailID holds the string denoting the email_id field in the database.
+	FieldEmailID = "email_id"
+	// FieldMessageID holds the string denoting the message_id field in the database.
+	FieldMessageID = "message_id"
+	// FieldFilePath holds the string denoting the file_path field in the database.
+	FieldFilePath = "file_path"
+	// FieldSource holds the string denoting the source field in the database.
+	FieldSource = "source"
+	// FieldModel holds the string denoting the model field in the database.
+	FieldModel = "model"
+	// FieldPromptVersion holds the string denoting the prompt_version field in the database.
+	FieldPromptVersion = "prompt_version"
+	// FieldExtractedAt holds the string denoting the extracted_at field in the database.
+	FieldExtractedAt = "extracted_at"
+	// FieldProperties holds the string denoting the properties field in the database.
+	FieldProperties = "properties"
+	// Table holds the table name of the provenance in the database.
+	Table = "provenances"
+)
+
+// Columns holds all SQL columns for provenance fields.
+var Columns = []string{
+	FieldID,
+	FieldSubjectType,
+	FieldSubjectID,
+	FieldEmailID,
+	FieldMessageID,
+	FieldFilePath,
+	FieldSource,
+	FieldModel,
+	FieldPromptVersion,
+	FieldExtractedAt,
+	FieldProperties,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// SubjectTypeValidator is a validator for the "subject_type" field. It is called by the builders before save.
+	SubjectTypeValidator func(string) error
+	// SubjectIDValidator is a validator for the "subject_id" field. It is called by the builders before save.
+	SubjectIDValidator func(int) error
+	// SourceValidator is a validator for the "source" field. It is called by the builders before save.
+	SourceValidator func(string) error
+	// DefaultExtractedAt holds the default value on creation for the "extracted_at" field.
+	DefaultExtractedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the Provenance queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// BySubjectType orders the results by the subject_type field.
+func BySubjectType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubjectType, opts...).ToFunc()
+}
+
+// BySubjectID orders the results by the subject_id field.
+func BySubjectID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubjectID, opts...).ToFunc()
+}
+
+// ByEmailID orders the results by the email_id field.
+func ByEmailID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmailID, opts...).ToFunc()
+}
+
+// ByMessageID orders the results by the message_id field.
+func ByMessageID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMessageID, opts...).ToFunc()
+}
+
+// ByFilePath orders the results by the file_path field.
+func ByFilePath(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFilePath, opts...).ToFunc()
+}
+
+// BySource orders the results by the source field.
+func BySource(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSource, opts...).ToFunc()
+}
+
+// ByModel orders the results by the model field.
+func ByModel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModel, opts...).ToFunc()
+}
+
+// ByPromptVersion orders the results by the prompt_version field.
+func ByPromptVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPromptVersion, opts...).ToFunc()
+}
+
+// ByExtractedAt orders the results by the extracted_at field.
+func ByExtractedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExtractedAt, opts...).ToFunc()
+}
@@ -0,0 +1,685 @@
+// Code generated by ent, DO NOT EDIT.
+
+package provenance
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Blogem/enron-graph/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Provenance {
+	return predicate.Provenance(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Provenance {
+	return predicate.Provenance(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Provenance {
+	return predicate.Provenance(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Provenance {
+	return predicate.Provenance(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Provenance {
+	return predicate.Provenance(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Provenance {
+	return predicate.Provenance(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Provenance {
+	return predicate.Provenance(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Provenance {
+	return predicate.Provenance(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Provenance {
+	return predicate.Provenance(sql.FieldLTE(FieldID, id))
+}
+
+// SubjectType applies equality check predicate on the "subject_type" field. It's identical to SubjectTypeEQ.
+func SubjectType(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldEQ(FieldSubjectType, v))
+}
+
+// SubjectID applies equality check predicate on the "subject_id" field. It's identical to SubjectIDEQ.
+func SubjectID(v int) predicate.Provenance {
+	return predicate.Provenance(sql.FieldEQ(FieldSubjectID, v))
+}
+
+// EmailID applies equality check predicate on the "email_id" field. It's identical to EmailIDEQ.
+func EmailID(v int) predicate.Provenance {
+	return predicate.Provenance(sql.FieldEQ(FieldEmailID, v))
+}
+
+// MessageID applies equality check predicate on the "message_id" field. It's identical to MessageIDEQ.
+func MessageID(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldEQ(FieldMessageID, v))
+}
+
+// FilePath applies equality check predicate on the "file_path" field. It's identical to FilePathEQ.
+func FilePath(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldEQ(FieldFilePath, v))
+}
+
+// Source applies equality check predicate on the "source" field. It's identical to SourceEQ.
+func Source(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldEQ(FieldSource, v))
+}
+
+// Model applies equality check predicate on the "model" field. It's identical to ModelEQ.
+func Model(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldEQ(FieldModel, v))
+}
+
+// PromptVersion applies equality check predicate on the "prompt_version" field. It's identical to PromptVersionEQ.
+func PromptVersion(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldEQ(FieldPromptVersion, v))
+}
+
+// ExtractedAt applies equality check predicate on the "extracted_at" field. It's identical to ExtractedAtEQ.
+func ExtractedAt(v time.Time) predicate.Provenance {
+	return predicate.Provenance(sql.FieldEQ(FieldExtractedAt, v))
+}
+
+// SubjectTypeEQ applies the EQ predicate on the "subject_type" field.
+func SubjectTypeEQ(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldEQ(FieldSubjectType, v))
+}
+
+// SubjectTypeNEQ applies the NEQ predicate on the "subject_type" field.
+func SubjectTypeNEQ(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldNEQ(FieldSubjectType, v))
+}
+
+// SubjectTypeIn applies the In predicate on the "subject_type" field.
+func SubjectTypeIn(vs ...string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldIn(FieldSubjectType, vs...))
+}
+
+// SubjectTypeNotIn applies the NotIn predicate on the "subject_type" field.
+func SubjectTypeNotIn(vs ...string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldNotIn(FieldSubjectType, vs...))
+}
+
+// SubjectTypeGT applies the GT predicate on the "subject_type" field.
+func SubjectTypeGT(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldGT(FieldSubjectType, v))
+}
+
+// SubjectTypeGTE applies the GTE predicate on the "subject_type" field.
+func SubjectTypeGTE(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldGTE(FieldSubjectType, v))
+}
+
+// SubjectTypeLT applies the LT predicate on the "subject_type" field.
+func SubjectTypeLT(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldLT(FieldSubjectType, v))
+}
+
+// SubjectTypeLTE applies the LTE predicate on the "subject_type" field.
+func SubjectTypeLTE(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldLTE(FieldSubjectType, v))
+}
+
+// SubjectTypeContains applies the Contains predicate on the "subject_type" field.
+func SubjectTypeContains(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldContains(FieldSubjectType, v))
+}
+
+// SubjectTypeHasPrefix applies the HasPrefix predicate on the "subject_type" field.
+func SubjectTypeHasPrefix(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldHasPrefix(FieldSubjectType, v))
+}
+
+// SubjectTypeHasSuffix applies the HasSuffix predicate on the "subject_type" field.
+func SubjectTypeHasSuffix(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldHasSuffix(FieldSubjectType, v))
+}
+
+// SubjectTypeEqualFold applies the EqualFold predicate on the "subject_type" field.
+func SubjectTypeEqualFold(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldEqualFold(FieldSubjectType, v))
+}
+
+// SubjectTypeContainsFold applies the ContainsFold predicate on the "subject_type" field.
+func SubjectTypeContainsFold(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldContainsFold(FieldSubjectType, v))
+}
+
+// SubjectIDEQ applies the EQ predicate on the "subject_id" field.
+func SubjectIDEQ(v int) predicate.Provenance {
+	return predicate.Provenance(sql.FieldEQ(FieldSubjectID, v))
+}
+
+// SubjectIDNEQ applies the NEQ predicate on the "subject_id" field.
+func SubjectIDNEQ(v int) predicate.Provenance {
+	return predicate.Provenance(sql.FieldNEQ(FieldSubjectID, v))
+}
+
+// SubjectIDIn applies the In predicate on the "subject_id" field.
+func SubjectIDIn(vs ...int) predicate.Provenance {
+	return predicate.Provenance(sql.FieldIn(FieldSubjectID, vs...))
+}
+
+// SubjectIDNotIn applies the NotIn predicate on the "subject_id" field.
+func SubjectIDNotIn(vs ...int) predicate.Provenance {
+	return predicate.Provenance(sql.FieldNotIn(FieldSubjectID, vs...))
+}
+
+// SubjectIDGT applies the GT predicate on the "subject_id" field.
+func SubjectIDGT(v int) predicate.Provenance {
+	return predicate.Provenance(sql.FieldGT(FieldSubjectID, v))
+}
+
+// SubjectIDGTE applies the GTE predicate on the "subject_id" field.
+func SubjectIDGTE(v int) predicate.Provenance {
+	return predicate.Provenance(sql.FieldGTE(FieldSubjectID, v))
+}
+
+// SubjectIDLT applies the LT predicate on the "subject_id" field.
+func SubjectIDLT(v int) predicate.Provenance {
+	return predicate.Provenance(sql.FieldLT(FieldSubjectID, v))
+}
+
+// SubjectIDLTE applies the LTE predicate on the "subject_id" field.
+func SubjectIDLTE(v int) predicate.Provenance {
+	return predicate.Provenance(sql.FieldLTE(FieldSubjectID, v))
+}
+
+// EmailIDEQ applies the EQ predicate on the "email_id" field.
+func EmailIDEQ(v int) predicate.Provenance {
+	return predicate.Provenance(sql.FieldEQ(FieldEmailID, v))
+}
+
+// EmailIDNEQ applies the NEQ predicate on the "email_id" field.
+func EmailIDNEQ(v int) predicate.Provenance {
+	return predicate.Provenance(sql.FieldNEQ(FieldEmailID, v))
+}
+
+// EmailIDIn applies the In predicate on the "email_id" field.
+func EmailIDIn(vs ...int) predicate.Provenance {
+	return predicate.Provenance(sql.FieldIn(FieldEmailID, vs...))
+}
+
+// EmailIDNotIn applies the NotIn predicate on the "email_id" field.
+func EmailIDNotIn(vs ...int) predicate.Provenance {
+	return predicate.Provenance(sql.FieldNotIn(FieldEmailID, vs...))
+}
+
+// EmailIDGT applies the GT predicate on the "email_id" field.
+func EmailIDGT(v int) predicate.Provenance {
+	return predicate.Provenance(sql.FieldGT(FieldEmailID, v))
+}
+
+// EmailIDGTE applies the GTE predicate on the "email_id" field.
+func EmailIDGTE(v int) predicate.Provenance {
+	return predicate.Provenance(sql.FieldGTE(FieldEmailID, v))
+}
+
+// EmailIDLT applies the LT predicate on the "email_id" field.
+func EmailIDLT(v int) predicate.Provenance {
+	return predicate.Provenance(sql.FieldLT(FieldEmailID, v))
+}
+
+// EmailIDLTE applies the LTE predicate on the "email_id" field.
+func EmailIDLTE(v int) predicate.Provenance {
+	return predicate.Provenance(sql.FieldLTE(FieldEmailID, v))
+}
+
+// EmailIDIsNil applies the IsNil predicate on the "email_id" field.
+func EmailIDIsNil() predicate.Provenance {
+	return predicate.Provenance(sql.FieldIsNull(FieldEmailID))
+}
+
+// EmailIDNotNil applies the NotNil predicate on the "email_id" field.
+func EmailIDNotNil() predicate.Provenance {
+	return predicate.Provenance(sql.FieldNotNull(FieldEmailID))
+}
+
+// MessageIDEQ applies the EQ predicate on the "message_id" field.
+func MessageIDEQ(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldEQ(FieldMessageID, v))
+}
+
+// MessageIDNEQ applies the NEQ predicate on the "message_id" field.
+func MessageIDNEQ(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldNEQ(FieldMessageID, v))
+}
+
+// MessageIDIn applies the In predicate on the "message_id" field.
+func MessageIDIn(vs ...string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldIn(FieldMessageID, vs...))
+}
+
+// MessageIDNotIn applies the NotIn predicate on the "message_id" field.
+func MessageIDNotIn(vs ...string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldNotIn(FieldMessageID, vs...))
+}
+
+// MessageIDGT applies the GT predicate on the "message_id" field.
+func MessageIDGT(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldGT(FieldMessageID, v))
+}
+
+// MessageIDGTE applies the GTE predicate on the "message_id" field.
+func MessageIDGTE(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldGTE(FieldMessageID, v))
+}
+
+// MessageIDLT applies the LT predicate on the "message_id" field.
+func MessageIDLT(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldLT(FieldMessageID, v))
+}
+
+// MessageIDLTE applies the LTE predicate on the "message_id" field.
+func MessageIDLTE(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldLTE(FieldMessageID, v))
+}
+
+// MessageIDContains applies the Contains predicate on the "message_id" field.
+func MessageIDContains(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldContains(FieldMessageID, v))
+}
+
+// MessageIDHasPrefix applies the HasPrefix predicate on the "message_id" field.
+func MessageIDHasPrefix(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldHasPrefix(FieldMessageID, v))
+}
+
+// MessageIDHasSuffix applies the HasSuffix predicate on the "message_id" field.
+func MessageIDHasSuffix(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldHasSuffix(FieldMessageID, v))
+}
+
+// MessageIDIsNil applies the IsNil predicate on the "message_id" field.
+func MessageIDIsNil() predicate.Provenance {
+	return predicate.Provenance(sql.FieldIsNull(FieldMessageID))
+}
+
+// MessageIDNotNil applies the NotNil predicate on the "message_id" field.
+func MessageIDNotNil() predicate.Provenance {
+	return predicate.Provenance(sql.FieldNotNull(FieldMessageID))
+}
+
+// MessageIDEqualFold applies the EqualFold predicate on the "message_id" field.
+func MessageIDEqualFold(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldEqualFold(FieldMessageID, v))
+}
+
+// MessageIDContainsFold applies the ContainsFold predicate on the "message_id" field.
+func MessageIDContainsFold(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldContainsFold(FieldMessageID, v))
+}
+
+// FilePathEQ applies the EQ predicate on the "file_path" field.
+func FilePathEQ(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldEQ(FieldFilePath, v))
+}
+
+// FilePathNEQ applies the NEQ predicate on the "file_path" field.
+func FilePathNEQ(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldNEQ(FieldFilePath, v))
+}
+
+// FilePathIn applies the In predicate on the "file_path" field.
+func FilePathIn(vs ...string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldIn(FieldFilePath, vs...))
+}
+
+// FilePathNotIn applies the NotIn predicate on the "file_path" field.
+func FilePathNotIn(vs ...string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldNotIn(FieldFilePath, vs...))
+}
+
+// FilePathGT applies the GT predicate on the "file_path" field.
+func FilePathGT(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldGT(FieldFilePath, v))
+}
+
+// FilePathGTE applies the GTE predicate on the "file_path" field.
+func FilePathGTE(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldGTE(FieldFilePath, v))
+}
+
+// FilePathLT applies the LT predicate on the "file_path" field.
+func FilePathLT(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldLT(FieldFilePath, v))
+}
+
+// FilePathLTE applies the LTE predicate on the "file_path" field.
+func FilePathLTE(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldLTE(FieldFilePath, v))
+}
+
+// FilePathContains applies the Contains predicate on the "file_path" field.
+func FilePathContains(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldContains(FieldFilePath, v))
+}
+
+// FilePathHasPrefix applies the HasPrefix predicate on the "file_path" field.
+func FilePathHasPrefix(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldHasPrefix(FieldFilePath, v))
+}
+
+// FilePathHasSuffix applies the HasSuffix predicate on the "file_path" field.
+func FilePathHasSuffix(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldHasSuffix(FieldFilePath, v))
+}
+
+// FilePathIsNil applies the IsNil predicate on the "file_path" field.
+func FilePathIsNil() predicate.Provenance {
+	return predicate.Provenance(sql.FieldIsNull(FieldFilePath))
+}
+
+// FilePathNotNil applies the NotNil predicate on the "file_path" field.
+func FilePathNotNil() predicate.Provenance {
+	return predicate.Provenance(sql.FieldNotNull(FieldFilePath))
+}
+
+// FilePathEqualFold applies the EqualFold predicate on the "file_path" field.
+func FilePathEqualFold(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldEqualFold(FieldFilePath, v))
+}
+
+// FilePathContainsFold applies the ContainsFold predicate on the "file_path" field.
+func FilePathContainsFold(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldContainsFold(FieldFilePath, v))
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldEQ(FieldSource, v))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldNEQ(FieldSource, v))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldIn(FieldSource, vs...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldNotIn(FieldSource, vs...))
+}
+
+// SourceGT applies the GT predicate on the "source" field.
+func SourceGT(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldGT(FieldSource, v))
+}
+
+// SourceGTE applies the GTE predicate on the "source" field.
+func SourceGTE(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldGTE(FieldSource, v))
+}
+
+// SourceLT applies the LT predicate on the "source" field.
+func SourceLT(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldLT(FieldSource, v))
+}
+
+// SourceLTE applies the LTE predicate on the "source" field.
+func SourceLTE(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldLTE(FieldSource, v))
+}
+
+// SourceContains applies the Contains predicate on the "source" field.
+func SourceContains(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldContains(FieldSource, v))
+}
+
+// SourceHasPrefix applies the HasPrefix predicate on the "source" field.
+func SourceHasPrefix(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldHasPrefix(FieldSource, v))
+}
+
+// SourceHasSuffix applies the HasSuffix predicate on the "source" field.
+func SourceHasSuffix(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldHasSuffix(FieldSource, v))
+}
+
+// SourceEqualFold applies the EqualFold predicate on the "source" field.
+func SourceEqualFold(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldEqualFold(FieldSource, v))
+}
+
+// SourceContainsFold applies the ContainsFold predicate on the "source" field.
+func SourceContainsFold(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldContainsFold(FieldSource, v))
+}
+
+// ModelEQ applies the EQ predicate on the "model" field.
+func ModelEQ(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldEQ(FieldModel, v))
+}
+
+// ModelNEQ applies the NEQ predicate on the "model" field.
+func ModelNEQ(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldNEQ(FieldModel, v))
+}
+
+// ModelIn applies the In predicate on the "model" field.
+func ModelIn(vs ...string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldIn(FieldModel, vs...))
+}
+
+// ModelNotIn applies the NotIn predicate on the "model" field.
+func ModelNotIn(vs ...string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldNotIn(FieldModel, vs...))
+}
+
+// ModelGT applies the GT predicate on the "model" field.
+func ModelGT(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldGT(FieldModel, v))
+}
+
+// ModelGTE applies the GTE predicate on the "model" field.
+func ModelGTE(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldGTE(FieldModel, v))
+}
+
+// ModelLT applies the LT predicate on the "model" field.
+func ModelLT(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldLT(FieldModel, v))
+}
+
+// ModelLTE applies the LTE predicate on the "model" field.
+func ModelLTE(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldLTE(FieldModel, v))
+}
+
+// ModelContains applies the Contains predicate on the "model" field.
+func ModelContains(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldContains(FieldModel, v))
+}
+
+// ModelHasPrefix applies the HasPrefix predicate on the "model" field.
+func ModelHasPrefix(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldHasPrefix(FieldModel, v))
+}
+
+// ModelHasSuffix applies the HasSuffix predicate on the "model" field.
+func ModelHasSuffix(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldHasSuffix(FieldModel, v))
+}
+
+// ModelIsNil applies the IsNil predicate on the "model" field.
+func ModelIsNil() predicate.Provenance {
+	return predicate.Provenance(sql.FieldIsNull(FieldModel))
+}
+
+// ModelNotNil applies the NotNil predicate on the "model" field.
+func ModelNotNil() predicate.Provenance {
+	return predicate.Provenance(sql.FieldNotNull(FieldModel))
+}
+
+// ModelEqualFold applies the EqualFold predicate on the "model" field.
+func ModelEqualFold(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldEqualFold(FieldModel, v))
+}
+
+// ModelContainsFold applies the ContainsFold predicate on the "model" field.
+func ModelContainsFold(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldContainsFold(FieldModel, v))
+}
+
+// PromptVersionEQ applies the EQ predicate on the "prompt_version" field.
+func PromptVersionEQ(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldEQ(FieldPromptVersion, v))
+}
+
+// PromptVersionNEQ applies the NEQ predicate on the "prompt_version" field.
+func PromptVersionNEQ(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldNEQ(FieldPromptVersion, v))
+}
+
+// PromptVersionIn applies the In predicate on the "prompt_version" field.
+func PromptVersionIn(vs ...string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldIn(FieldPromptVersion, vs...))
+}
+
+// PromptVersionNotIn applies the NotIn predicate on the "prompt_version" field.
+func PromptVersionNotIn(vs ...string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldNotIn(FieldPromptVersion, vs...))
+}
+
+// PromptVersionGT applies the GT predicate on the "prompt_version" field.
+func PromptVersionGT(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldGT(FieldPromptVersion, v))
+}
+
+// PromptVersionGTE applies the GTE predicate on the "prompt_version" field.
+func PromptVersionGTE(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldGTE(FieldPromptVersion, v))
+}
+
+// PromptVersionLT applies the LT predicate on the "prompt_version" field.
+func PromptVersionLT(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldLT(FieldPromptVersion, v))
+}
+
+// PromptVersionLTE applies the LTE predicate on the "prompt_version" field.
+func PromptVersionLTE(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldLTE(FieldPromptVersion, v))
+}
+
+// PromptVersionContains applies the Contains predicate on the "prompt_version" field.
+func PromptVersionContains(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldContains(FieldPromptVersion, v))
+}
+
+// PromptVersionHasPrefix applies the HasPrefix predicate on the "prompt_version" field.
+func PromptVersionHasPrefix(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldHasPrefix(FieldPromptVersion, v))
+}
+
+// PromptVersionHasSuffix applies the HasSuffix predicate on the "prompt_version" field.
+func PromptVersionHasSuffix(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldHasSuffix(FieldPromptVersion, v))
+}
+
+// PromptVersionIsNil applies the IsNil predicate on the "prompt_version" field.
+func PromptVersionIsNil() predicate.Provenance {
+	return predicate.Provenance(sql.FieldIsNull(FieldPromptVersion))
+}
+
+// PromptVersionNotNil applies the NotNil predicate on the "prompt_version" field.
+func PromptVersionNotNil() predicate.Provenance {
+	return predicate.Provenance(sql.FieldNotNull(FieldPromptVersion))
+}
+
+// PromptVersionEqualFold applies the EqualFold predicate on the "prompt_version" field.
+func PromptVersionEqualFold(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldEqualFold(FieldPromptVersion, v))
+}
+
+// PromptVersionContainsFold applies the ContainsFold predicate on the "prompt_version" field.
+func PromptVersionContainsFold(v string) predicate.Provenance {
+	return predicate.Provenance(sql.FieldContainsFold(FieldPromptVersion, v))
+}
+
+// ExtractedAtEQ applies the EQ predicate on the "extracted_at" field.
+func ExtractedAtEQ(v time.Time) predicate.Provenance {
+	return predicate.Provenance(sql.FieldEQ(FieldExtractedAt, v))
+}
+
+// ExtractedAtNEQ applies the NEQ predicate on the "extracted_at" field.
+func ExtractedAtNEQ(v time.Time) predicate.Provenance {
+	return predicate.Provenance(sql.FieldNEQ(FieldExtractedAt, v))
+}
+
+// ExtractedAtIn applies the In predicate on the "extracted_at" field.
+func ExtractedAtIn(vs ...time.Time) predicate.Provenance {
+	return predicate.Provenance(sql.FieldIn(FieldExtractedAt, vs...))
+}
+
+// ExtractedAtNotIn applies the NotIn predicate on the "extracted_at" field.
+func ExtractedAtNotIn(vs ...time.Time) predicate.Provenance {
+	return predicate.Provenance(sql.FieldNotIn(FieldExtractedAt, vs...))
+}
+
+// ExtractedAtGT applies the GT predicate on the "extracted_at" field.
+func ExtractedAtGT(v time.Time) predicate.Provenance {
+	return predicate.Provenance(sql.FieldGT(FieldExtractedAt, v))
+}
+
+// ExtractedAtGTE applies the GTE predicate on the "extracted_at" field.
+func ExtractedAtGTE(v time.Time) predicate.Provenance {
+	return predicate.Provenance(sql.FieldGTE(FieldExtractedAt, v))
+}
+
+// ExtractedAtLT applies the LT predicate on the "extracted_at" field.
+func ExtractedAtLT(v time.Time) predicate.Provenance {
+	return predicate.Provenance(sql.FieldLT(FieldExtractedAt, v))
+}
+
+// ExtractedAtLTE applies the LTE predicate on the "extracted_at" field.
+func ExtractedAtLTE(v time.Time) predicate.Provenance {
+	return predicate.Provenance(sql.FieldLTE(FieldExtractedAt, v))
+}
+
+// PropertiesIsNil applies the IsNil predicate on the "properties" field.
+func PropertiesIsNil() predicate.Provenance {
+	return predicate.Provenance(sql.FieldIsNull(FieldProperties))
+}
+
+// PropertiesNotNil applies the NotNil predicate on the "properties" field.
+func PropertiesNotNil() predicate.Provenance {
+	return predicate.Provenance(sql.FieldNotNull(FieldProperties))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Provenance) predicate.Provenance {
+	return predicate.Provenance(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Provenance) predicate.Provenance {
+	return predicate.Provenance(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Provenance) predicate.Provenance {
+	return predicate.Provenance(sql.NotPredicates(p))
+}
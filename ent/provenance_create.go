@@ -0,0 +1,356 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Blogem/enron-graph/ent/provenance"
+)
+
+// ProvenanceCreate is the builder for creating a Provenance entity.
+type ProvenanceCreate struct {
+	config
+	mutation *ProvenanceMutation
+	hooks    []Hook
+}
+
+// SetSubjectType sets the "subject_type" field.
+func (_c *ProvenanceCreate) SetSubjectType(v string) *ProvenanceCreate {
+	_c.mutation.SetSubjectType(v)
+	return _c
+}
+
+// SetSubjectID sets the "subject_id" field.
+func (_c *ProvenanceCreate) SetSubjectID(v int) *ProvenanceCreate {
+	_c.mutation.SetSubjectID(v)
+	return _c
+}
+
+// SetEmailID sets the "email_id" field.
+func (_c *ProvenanceCreate) SetEmailID(v int) *ProvenanceCreate {
+	_c.mutation.SetEmailID(v)
+	return _c
+}
+
+// SetNillableEmailID sets the "email_id" field if the given value is not nil.
+func (_c *ProvenanceCreate) SetNillableEmailID(v *int) *ProvenanceCreate {
+	if v != nil {
+		_c.SetEmailID(*v)
+	}
+	return _c
+}
+
+// SetMessageID sets the "message_id" field.
+func (_c *ProvenanceCreate) SetMessageID(v string) *ProvenanceCreate {
+	_c.mutation.SetMessageID(v)
+	return _c
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (_c *ProvenanceCreate) SetNillableMessageID(v *string) *ProvenanceCreate {
+	if v != nil {
+		_c.SetMessageID(*v)
+	}
+	return _c
+}
+
+// SetFilePath sets the "file_path" field.
+func (_c *ProvenanceCreate) SetFilePath(v string) *ProvenanceCreate {
+	_c.mutation.SetFilePath(v)
+	return _c
+}
+
+// SetNillableFilePath sets the "file_path" field if the given value is not nil.
+func (_c *ProvenanceCreate) SetNillableFilePath(v *string) *ProvenanceCreate {
+	if v != nil {
+		_c.SetFilePath(*v)
+	}
+	return _c
+}
+
+// SetSource sets the "source" field.
+func (_c *ProvenanceCreate) SetSource(v string) *ProvenanceCreate {
+	_c.mutation.SetSource(v)
+	return _c
+}
+
+// SetModel sets the "model" field.
+func (_c *ProvenanceCreate) SetModel(v string) *ProvenanceCreate {
+	_c.mutation.SetModel(v)
+	return _c
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (_c *ProvenanceCreate) SetNillableModel(v *string) *ProvenanceCreate {
+	if v != nil {
+		_c.SetModel(*v)
+	}
+	return _c
+}
+
+// SetPromptVersion sets the "prompt_version" field.
+func (_c *ProvenanceCreate) SetPromptVersion(v string) *ProvenanceCreate {
+	_c.mutation.SetPromptVersion(v)
+	return _c
+}
+
+// SetNillablePromptVersion sets the "prompt_version" field if the given value is not nil.
+func (_c *ProvenanceCreate) SetNillablePromptVersion(v *string) *ProvenanceCreate {
+	if v != nil {
+		_c.SetPromptVersion(*v)
+	}
+	return _c
+}
+
+// SetExtractedAt sets the "extracted_at" field.
+func (_c *ProvenanceCreate) SetExtractedAt(v time.Time) *ProvenanceCreate {
+	_c.mutation.SetExtractedAt(v)
+	return _c
+}
+
+// SetNillableExtractedAt sets the "extracted_at" field if the given value is not nil.
+func (_c *ProvenanceCreate) SetNillableExtractedAt(v *time.Time) *ProvenanceCreate {
+	if v != nil {
+		_c.SetExtractedAt(*v)
+	}
+	return _c
+}
+
+// SetProperties sets the "properties" field.
+func (_c *ProvenanceCreate) SetProperties(v map[string]interface{}) *ProvenanceCreate {
+	_c.mutation.SetProperties(v)
+	return _c
+}
+
+// Mutation returns the ProvenanceMutation object of the builder.
+func (_c *ProvenanceCreate) Mutation() *ProvenanceMutation {
+	return _c.mutation
+}
+
+// Save creates the Provenance in the database.
+func (_c *ProvenanceCreate) Save(ctx context.Context) (*Provenance, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ProvenanceCreate) SaveX(ctx context.Context) *Provenance {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ProvenanceCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ProvenanceCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ProvenanceCreate) defaults() {
+	if _, ok := _c.mutation.ExtractedAt(); !ok {
+		v := provenance.DefaultExtractedAt()
+		_c.mutation.SetExtractedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ProvenanceCreate) check() error {
+	if _, ok := _c.mutation.SubjectType(); !ok {
+		return &ValidationError{Name: "subject_type", err: errors.New(`ent: missing required field "Provenance.subject_type"`)}
+	}
+	if v, ok := _c.mutation.SubjectType(); ok {
+		if err := provenance.SubjectTypeValidator(v); err != nil {
+			return &ValidationError{Name: "subject_type", err: fmt.Errorf(`ent: validator failed for field "Provenance.subject_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SubjectID(); !ok {
+		return &ValidationError{Name: "subject_id", err: errors.New(`ent: missing required field "Provenance.subject_id"`)}
+	}
+	if v, ok := _c.mutation.SubjectID(); ok {
+		if err := provenance.SubjectIDValidator(v); err != nil {
+			return &ValidationError{Name: "subject_id", err: fmt.Errorf(`ent: validator failed for field "Provenance.subject_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "Provenance.source"`)}
+	}
+	if v, ok := _c.mutation.Source(); ok {
+		if err := provenance.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "Provenance.source": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ExtractedAt(); !ok {
+		return &ValidationError{Name: "extracted_at", err: errors.New(`ent: missing required field "Provenance.extracted_at"`)}
+	}
+	return nil
+}
+
+func (_c *ProvenanceCreate) sqlSave(ctx context.Context) (*Provenance, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ProvenanceCreate) createSpec() (*Provenance, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Provenance{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(provenance.Table, sqlgraph.NewFieldSpec(provenance.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.SubjectType(); ok {
+		_spec.SetField(provenance.FieldSubjectType, field.TypeString, value)
+		_node.SubjectType = value
+	}
+	if value, ok := _c.mutation.SubjectID(); ok {
+		_spec.SetField(provenance.FieldSubjectID, field.TypeInt, value)
+		_node.SubjectID = value
+	}
+	if value, ok := _c.mutation.EmailID(); ok {
+		_spec.SetField(provenance.FieldEmailID, field.TypeInt, value)
+		_node.EmailID = value
+	}
+	if value, ok := _c.mutation.MessageID(); ok {
+		_spec.SetField(provenance.FieldMessageID, field.TypeString, value)
+		_node.MessageID = value
+	}
+	if value, ok := _c.mutation.FilePath(); ok {
+		_spec.SetField(provenance.FieldFilePath, field.TypeString, value)
+		_node.FilePath = value
+	}
+	if value, ok := _c.mutation.Source(); ok {
+		_spec.SetField(provenance.FieldSource, field.TypeString, value)
+		_node.Source = value
+	}
+	if value, ok := _c.mutation.Model(); ok {
+		_spec.SetField(provenance.FieldModel, field.TypeString, value)
+		_node.Model = value
+	}
+	if value, ok := _c.mutation.PromptVersion(); ok {
+		_spec.SetField(provenance.FieldPromptVersion, field.TypeString, value)
+		_node.PromptVersion = value
+	}
+	if value, ok := _c.mutation.ExtractedAt(); ok {
+		_spec.SetField(provenance.FieldExtractedAt, field.TypeTime, value)
+		_node.ExtractedAt = value
+	}
+	if value, ok := _c.mutation.Properties(); ok {
+		_spec.SetField(provenance.FieldProperties, field.TypeJSON, value)
+		_node.Properties = value
+	}
+	return _node, _spec
+}
+
+// ProvenanceCreateBulk is the builder for creating many Provenance entities in bulk.
+type ProvenanceCreateBulk struct {
+	config
+	err      error
+	builders []*ProvenanceCreate
+}
+
+// Save creates the Provenance entities in the database.
+func (_c *ProvenanceCreateBulk) Save(ctx context.Context) ([]*Provenance, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Provenance, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProvenanceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ProvenanceCreateBulk) SaveX(ctx context.Context) []*Provenance {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ProvenanceCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ProvenanceCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,694 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Blogem/enron-graph/ent/predicate"
+	"github.com/Blogem/enron-graph/ent/provenance"
+)
+
+// ProvenanceUpdate is the builder for updating Provenance entities.
+type ProvenanceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ProvenanceMutation
+}
+
+// Where appends a list predicates to the ProvenanceUpdate builder.
+func (_u *ProvenanceUpdate) Where(ps ...predicate.Provenance) *ProvenanceUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetSubjectType sets the "subject_type" field.
+func (_u *ProvenanceUpdate) SetSubjectType(v string) *ProvenanceUpdate {
+	_u.mutation.SetSubjectType(v)
+	return _u
+}
+
+// SetNillableSubjectType sets the "subject_type" field if the given value is not nil.
+func (_u *ProvenanceUpdate) SetNillableSubjectType(v *string) *ProvenanceUpdate {
+	if v != nil {
+		_u.SetSubjectType(*v)
+	}
+	return _u
+}
+
+// SetSubjectID sets the "subject_id" field.
+func (_u *ProvenanceUpdate) SetSubjectID(v int) *ProvenanceUpdate {
+	_u.mutation.ResetSubjectID()
+	_u.mutation.SetSubjectID(v)
+	return _u
+}
+
+// SetNillableSubjectID sets the "subject_id" field if the given value is not nil.
+func (_u *ProvenanceUpdate) SetNillableSubjectID(v *int) *ProvenanceUpdate {
+	if v != nil {
+		_u.SetSubjectID(*v)
+	}
+	return _u
+}
+
+// AddSubjectID adds value to the "subject_id" field.
+func (_u *ProvenanceUpdate) AddSubjectID(v int) *ProvenanceUpdate {
+	_u.mutation.AddSubjectID(v)
+	return _u
+}
+
+// SetEmailID sets the "email_id" field.
+func (_u *ProvenanceUpdate) SetEmailID(v int) *ProvenanceUpdate {
+	_u.mutation.ResetEmailID()
+	_u.mutation.SetEmailID(v)
+	return _u
+}
+
+// SetNillableEmailID sets the "email_id" field if the given value is not nil.
+func (_u *ProvenanceUpdate) SetNillableEmailID(v *int) *ProvenanceUpdate {
+	if v != nil {
+		_u.SetEmailID(*v)
+	}
+	return _u
+}
+
+// AddEmailID adds value to the "email_id" field.
+func (_u *ProvenanceUpdate) AddEmailID(v int) *ProvenanceUpdate {
+	_u.mutation.AddEmailID(v)
+	return _u
+}
+
+// ClearEmailID clears the value of the "email_id" field.
+func (_u *ProvenanceUpdate) ClearEmailID() *ProvenanceUpdate {
+	_u.mutation.ClearEmailID()
+	return _u
+}
+
+// SetMessageID sets the "message_id" field.
+func (_u *ProvenanceUpdate) SetMessageID(v string) *ProvenanceUpdate {
+	_u.mutation.SetMessageID(v)
+	return _u
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (_u *ProvenanceUpdate) SetNillableMessageID(v *string) *ProvenanceUpdate {
+	if v != nil {
+		_u.SetMessageID(*v)
+	}
+	return _u
+}
+
+// ClearMessageID clears the value of the "message_id" field.
+func (_u *ProvenanceUpdate) ClearMessageID() *ProvenanceUpdate {
+	_u.mutation.ClearMessageID()
+	return _u
+}
+
+// SetFilePath sets the "file_path" field.
+func (_u *ProvenanceUpdate) SetFilePath(v string) *ProvenanceUpdate {
+	_u.mutation.SetFilePath(v)
+	return _u
+}
+
+// SetNillableFilePath sets the "file_path" field if the given value is not nil.
+func (_u *ProvenanceUpdate) SetNillableFilePath(v *string) *ProvenanceUpdate {
+	if v != nil {
+		_u.SetFilePath(*v)
+	}
+	return _u
+}
+
+// ClearFilePath clears the value of the "file_path" field.
+func (_u *ProvenanceUpdate) ClearFilePath() *ProvenanceUpdate {
+	_u.mutation.ClearFilePath()
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *ProvenanceUpdate) SetSource(v string) *ProvenanceUpdate {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *ProvenanceUpdate) SetNillableSource(v *string) *ProvenanceUpdate {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// SetModel sets the "model" field.
+func (_u *ProvenanceUpdate) SetModel(v string) *ProvenanceUpdate {
+	_u.mutation.SetModel(v)
+	return _u
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (_u *ProvenanceUpdate) SetNillableModel(v *string) *ProvenanceUpdate {
+	if v != nil {
+		_u.SetModel(*v)
+	}
+	return _u
+}
+
+// ClearModel clears the value of the "model" field.
+func (_u *ProvenanceUpdate) ClearModel() *ProvenanceUpdate {
+	_u.mutation.ClearModel()
+	return _u
+}
+
+// SetPromptVersion sets the "prompt_version" field.
+func (_u *ProvenanceUpdate) SetPromptVersion(v string) *ProvenanceUpdate {
+	_u.mutation.SetPromptVersion(v)
+	return _u
+}
+
+// SetNillablePromptVersion sets the "prompt_version" field if the given value is not nil.
+func (_u *ProvenanceUpdate) SetNillablePromptVersion(v *string) *ProvenanceUpdate {
+	if v != nil {
+		_u.SetPromptVersion(*v)
+	}
+	return _u
+}
+
+// ClearPromptVersion clears the value of the "prompt_version" field.
+func (_u *ProvenanceUpdate) ClearPromptVersion() *ProvenanceUpdate {
+	_u.mutation.ClearPromptVersion()
+	return _u
+}
+
+// SetExtractedAt sets the "extracted_at" field.
+func (_u *ProvenanceUpdate) SetExtractedAt(v time.Time) *ProvenanceUpdate {
+	_u.mutation.SetExtractedAt(v)
+	return _u
+}
+
+// SetNillableExtractedAt sets the "extracted_at" field if the given value is not nil.
+func (_u *ProvenanceUpdate) SetNillableExtractedAt(v *time.Time) *ProvenanceUpdate {
+	if v != nil {
+		_u.SetExtractedAt(*v)
+	}
+	return _u
+}
+
+// SetProperties sets the "properties" field.
+func (_u *ProvenanceUpdate) SetProperties(v map[string]interface{}) *ProvenanceUpdate {
+	_u.mutation.SetProperties(v)
+	return _u
+}
+
+// ClearProperties clears the value of the "properties" field.
+func (_u *ProvenanceUpdate) ClearProperties() *ProvenanceUpdate {
+	_u.mutation.ClearProperties()
+	return _u
+}
+
+// Mutation returns the ProvenanceMutation object of the builder.
+func (_u *ProvenanceUpdate) Mutation() *ProvenanceMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ProvenanceUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ProvenanceUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ProvenanceUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ProvenanceUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ProvenanceUpdate) check() error {
+	if v, ok := _u.mutation.SubjectType(); ok {
+		if err := provenance.SubjectTypeValidator(v); err != nil {
+			return &ValidationError{Name: "subject_type", err: fmt.Errorf(`ent: validator failed for field "Provenance.subject_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SubjectID(); ok {
+		if err := provenance.SubjectIDValidator(v); err != nil {
+			return &ValidationError{Name: "subject_id", err: fmt.Errorf(`ent: validator failed for field "Provenance.subject_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Source(); ok {
+		if err := provenance.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "Provenance.source": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ProvenanceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(provenance.Table, provenance.Columns, sqlgraph.NewFieldSpec(provenance.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.SubjectType(); ok {
+		_spec.SetField(provenance.FieldSubjectType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SubjectID(); ok {
+		_spec.SetField(provenance.FieldSubjectID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSubjectID(); ok {
+		_spec.AddField(provenance.FieldSubjectID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EmailID(); ok {
+		_spec.SetField(provenance.FieldEmailID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEmailID(); ok {
+		_spec.AddField(provenance.FieldEmailID, field.TypeInt, value)
+	}
+	if _u.mutation.EmailIDCleared() {
+		_spec.ClearField(provenance.FieldEmailID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.MessageID(); ok {
+		_spec.SetField(provenance.FieldMessageID, field.TypeString, value)
+	}
+	if _u.mutation.MessageIDCleared() {
+		_spec.ClearField(provenance.FieldMessageID, field.TypeString)
+	}
+	if value, ok := _u.mutation.FilePath(); ok {
+		_spec.SetField(provenance.FieldFilePath, field.TypeString, value)
+	}
+	if _u.mutation.FilePathCleared() {
+		_spec.ClearField(provenance.FieldFilePath, field.TypeString)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(provenance.FieldSource, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Model(); ok {
+		_spec.SetField(provenance.FieldModel, field.TypeString, value)
+	}
+	if _u.mutation.ModelCleared() {
+		_spec.ClearField(provenance.FieldModel, field.TypeString)
+	}
+	if value, ok := _u.mutation.PromptVersion(); ok {
+		_spec.SetField(provenance.FieldPromptVersion, field.TypeString, value)
+	}
+	if _u.mutation.PromptVersionCleared() {
+		_spec.ClearField(provenance.FieldPromptVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExtractedAt(); ok {
+		_spec.SetField(provenance.FieldExtractedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Properties(); ok {
+		_spec.SetField(provenance.FieldProperties, field.TypeJSON, value)
+	}
+	if _u.mutation.PropertiesCleared() {
+		_spec.ClearField(provenance.FieldProperties, field.TypeJSON)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{provenance.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ProvenanceUpdateOne is the builder for updating a single Provenance entity.
+type ProvenanceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProvenanceMutation
+}
+
+// SetSubjectType sets the "subject_type" field.
+func (_u *ProvenanceUpdateOne) SetSubjectType(v string) *ProvenanceUpdateOne {
+	_u.mutation.SetSubjectType(v)
+	return _u
+}
+
+// SetNillableSubjectType sets the "subject_type" field if the given value is not nil.
+func (_u *ProvenanceUpdateOne) SetNillableSubjectType(v *string) *ProvenanceUpdateOne {
+	if v != nil {
+		_u.SetSubjectType(*v)
+	}
+	return _u
+}
+
+// SetSubjectID sets the "subject_id" field.
+func (_u *ProvenanceUpdateOne) SetSubjectID(v int) *ProvenanceUpdateOne {
+	_u.mutation.ResetSubjectID()
+	_u.mutation.SetSubjectID(v)
+	return _u
+}
+
+// SetNillableSubjectID sets the "subject_id" field if the given value is not nil.
+func (_u *ProvenanceUpdateOne) SetNillableSubjectID(v *int) *ProvenanceUpdateOne {
+	if v != nil {
+		_u.SetSubjectID(*v)
+	}
+	return _u
+}
+
+// AddSubjectID adds value to the "subject_id" field.
+func (_u *ProvenanceUpdateOne) AddSubjectID(v int) *ProvenanceUpdateOne {
+	_u.mutation.AddSubjectID(v)
+	return _u
+}
+
+// SetEmailID sets the "email_id" field.
+func (_u *ProvenanceUpdateOne) SetEmailID(v int) *ProvenanceUpdateOne {
+	_u.mutation.ResetEmailID()
+	_u.mutation.SetEmailID(v)
+	return _u
+}
+
+// SetNillableEmailID sets the "email_id" field if the given value is not nil.
+func (_u *ProvenanceUpdateOne) SetNillableEmailID(v *int) *ProvenanceUpdateOne {
+	if v != nil {
+		_u.SetEmailID(*v)
+	}
+	return _u
+}
+
+// AddEmailID adds value to the "email_id" field.
+func (_u *ProvenanceUpdateOne) AddEmailID(v int) *ProvenanceUpdateOne {
+	_u.mutation.AddEmailID(v)
+	return _u
+}
+
+// ClearEmailID clears the value of the "email_id" field.
+func (_u *ProvenanceUpdateOne) ClearEmailID() *ProvenanceUpdateOne {
+	_u.mutation.ClearEmailID()
+	return _u
+}
+
+// SetMessageID sets the "message_id" field.
+func (_u *ProvenanceUpdateOne) SetMessageID(v string) *ProvenanceUpdateOne {
+	_u.mutation.SetMessageID(v)
+	return _u
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (_u *ProvenanceUpdateOne) SetNillableMessageID(v *string) *ProvenanceUpdateOne {
+	if v != nil {
+		_u.SetMessageID(*v)
+	}
+	return _u
+}
+
+// ClearMessageID clears the value of the "message_id" field.
+func (_u *ProvenanceUpdateOne) ClearMessageID() *ProvenanceUpdateOne {
+	_u.mutation.ClearMessageID()
+	return _u
+}
+
+// SetFilePath sets the "file_path" field.
+func (_u *ProvenanceUpdateOne) SetFilePath(v string) *ProvenanceUpdateOne {
+	_u.mutation.SetFilePath(v)
+	return _u
+}
+
+// SetNillableFilePath sets the "file_path" field if the given value is not nil.
+func (_u *ProvenanceUpdateOne) SetNillableFilePath(v *string) *ProvenanceUpdateOne {
+	if v != nil {
+		_u.SetFilePath(*v)
+	}
+	return _u
+}
+
+// ClearFilePath clears the value of the "file_path" field.
+func (_u *ProvenanceUpdateOne) ClearFilePath() *ProvenanceUpdateOne {
+	_u.mutation.ClearFilePath()
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *ProvenanceUpdateOne) SetSource(v string) *ProvenanceUpdateOne {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *ProvenanceUpdateOne) SetNillableSource(v *string) *ProvenanceUpdateOne {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// SetModel sets the "model" field.
+func (_u *ProvenanceUpdateOne) SetModel(v string) *ProvenanceUpdateOne {
+	_u.mutation.SetModel(v)
+	return _u
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (_u *ProvenanceUpdateOne) SetNillableModel(v *string) *ProvenanceUpdateOne {
+	if v != nil {
+		_u.SetModel(*v)
+	}
+	return _u
+}
+
+// ClearModel clears the value of the "model" field.
+func (_u *ProvenanceUpdateOne) ClearModel() *ProvenanceUpdateOne {
+	_u.mutation.ClearModel()
+	return _u
+}
+
+// SetPromptVersion sets the "prompt_version" field.
+func (_u *ProvenanceUpdateOne) SetPromptVersion(v string) *ProvenanceUpdateOne {
+	_u.mutation.SetPromptVersion(v)
+	return _u
+}
+
+// SetNillablePromptVersion sets the "prompt_version" field if the given value is not nil.
+func (_u *ProvenanceUpdateOne) SetNillablePromptVersion(v *string) *ProvenanceUpdateOne {
+	if v != nil {
+		_u.SetPromptVersion(*v)
+	}
+	return _u
+}
+
+// ClearPromptVersion clears the value of the "prompt_version" field.
+func (_u *ProvenanceUpdateOne) ClearPromptVersion() *ProvenanceUpdateOne {
+	_u.mutation.ClearPromptVersion()
+	return _u
+}
+
+// SetExtractedAt sets the "extracted_at" field.
+func (_u *ProvenanceUpdateOne) SetExtractedAt(v time.Time) *ProvenanceUpdateOne {
+	_u.mutation.SetExtractedAt(v)
+	return _u
+}
+
+// SetNillableExtractedAt sets the "extracted_at" field if the given value is not nil.
+func (_u *ProvenanceUpdateOne) SetNillableExtractedAt(v *time.Time) *ProvenanceUpdateOne {
+	if v != nil {
+		_u.SetExtractedAt(*v)
+	}
+	return _u
+}
+
+// SetProperties sets the "properties" field.
+func (_u *ProvenanceUpdateOne) SetProperties(v map[string]interface{}) *ProvenanceUpdateOne {
+	_u.mutation.SetProperties(v)
+	return _u
+}
+
+// ClearProperties clears the value of the "properties" field.
+func (_u *ProvenanceUpdateOne) ClearProperties() *ProvenanceUpdateOne {
+	_u.mutation.ClearProperties()
+	return _u
+}
+
+// Mutation returns the ProvenanceMutation object of the builder.
+func (_u *ProvenanceUpdateOne) Mutation() *ProvenanceMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ProvenanceUpdate builder.
+func (_u *ProvenanceUpdateOne) Where(ps ...predicate.Provenance) *ProvenanceUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ProvenanceUpdateOne) Select(field string, fields ...string) *ProvenanceUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Provenance entity.
+func (_u *ProvenanceUpdateOne) Save(ctx context.Context) (*Provenance, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ProvenanceUpdateOne) SaveX(ctx context.Context) *Provenance {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ProvenanceUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ProvenanceUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ProvenanceUpdateOne) check() error {
+	if v, ok := _u.mutation.SubjectType(); ok {
+		if err := provenance.SubjectTypeValidator(v); err != nil {
+			return &ValidationError{Name: "subject_type", err: fmt.Errorf(`ent: validator failed for field "Provenance.subject_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SubjectID(); ok {
+		if err := provenance.SubjectIDValidator(v); err != nil {
+			return &ValidationError{Name: "subject_id", err: fmt.Errorf(`ent: validator failed for field "Provenance.subject_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Source(); ok {
+		if err := provenance.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "Provenance.source": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ProvenanceUpdateOne) sqlSave(ctx context.Context) (_node *Provenance, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(provenance.Table, provenance.Columns, sqlgraph.NewFieldSpec(provenance.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Provenance.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, provenance.FieldID)
+		for _, f := range fields {
+			if !provenance.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != provenance.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.SubjectType(); ok {
+		_spec.SetField(provenance.FieldSubjectType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SubjectID(); ok {
+		_spec.SetField(provenance.FieldSubjectID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSubjectID(); ok {
+		_spec.AddField(provenance.FieldSubjectID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EmailID(); ok {
+		_spec.SetField(provenance.FieldEmailID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEmailID(); ok {
+		_spec.AddField(provenance.FieldEmailID, field.TypeInt, value)
+	}
+	if _u.mutation.EmailIDCleared() {
+		_spec.ClearField(provenance.FieldEmailID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.MessageID(); ok {
+		_spec.SetField(provenance.FieldMessageID, field.TypeString, value)
+	}
+	if _u.mutation.MessageIDCleared() {
+		_spec.ClearField(provenance.FieldMessageID, field.TypeString)
+	}
+	if value, ok := _u.mutation.FilePath(); ok {
+		_spec.SetField(provenance.FieldFilePath, field.TypeString, value)
+	}
+	if _u.mutation.FilePathCleared() {
+		_spec.ClearField(provenance.FieldFilePath, field.TypeString)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(provenance.FieldSource, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Model(); ok {
+		_spec.SetField(provenance.FieldModel, field.TypeString, value)
+	}
+	if _u.mutation.ModelCleared() {
+		_spec.ClearField(provenance.FieldModel, field.TypeString)
+	}
+	if value, ok := _u.mutation.PromptVersion(); ok {
+		_spec.SetField(provenance.FieldPromptVersion, field.TypeString, value)
+	}
+	if _u.mutation.PromptVersionCleared() {
+		_spec.ClearField(provenance.FieldPromptVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExtractedAt(); ok {
+		_spec.SetField(provenance.FieldExtractedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Properties(); ok {
+		_spec.SetField(provenance.FieldProperties, field.TypeJSON, value)
+	}
+	if _u.mutation.PropertiesCleared() {
+		_spec.ClearField(provenance.FieldProperties, field.TypeJSON)
+	}
+	_node = &Provenance{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{provenance.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
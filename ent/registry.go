@@ -11,6 +11,132 @@ import (
 	"github.com/Blogem/enron-graph/ent/discoveredentity"
 )
 
+// createCentralityScore creates a CentralityScore entity from a property map.
+//
+// This function is called by the extractor when routing entities to promoted schemas.
+// It extracts the Ent client from the context and maps properties from the data map
+// to the appropriate Ent builder setter methods.
+//
+// Supported field types: string, int, float64, bool
+// TODO: Add support for edge/relationship fields, JSON fields, and time fields
+func createCentralityScore(ctx context.Context, data map[string]any) (any, error) {
+	// Extract Ent client from context
+	client, ok := ctx.Value("entClient").(*Client)
+	if !ok || client == nil {
+		return nil, fmt.Errorf("ent client not found in context")
+	}
+
+	// Create builder
+	builder := client.CentralityScore.Create()
+
+	if val, ok := data["scope"]; ok && val != nil {
+		if strVal, ok := val.(string); ok {
+			builder.SetScope(strVal)
+		}
+	}
+
+	if val, ok := data["entity_id"]; ok && val != nil {
+		if intVal, ok := val.(int); ok {
+			builder.SetEntityID(intVal)
+		}
+	}
+
+	if val, ok := data["degree"]; ok && val != nil {
+		if intVal, ok := val.(int); ok {
+			builder.SetDegree(intVal)
+		}
+	}
+
+	if val, ok := data["weighted_degree"]; ok && val != nil {
+		if floatVal, ok := val.(float64); ok {
+			builder.SetWeightedDegree(floatVal)
+		}
+	}
+
+	if val, ok := data["pagerank"]; ok && val != nil {
+		if floatVal, ok := val.(float64); ok {
+			builder.SetPagerank(floatVal)
+		}
+	}
+
+	if val, ok := data["betweenness"]; ok && val != nil {
+		if floatVal, ok := val.(float64); ok {
+			builder.SetBetweenness(floatVal)
+		}
+	}
+
+	if val, ok := data["closeness"]; ok && val != nil {
+		if floatVal, ok := val.(float64); ok {
+			builder.SetCloseness(floatVal)
+		}
+	}
+
+	// Save the entity
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create CentralityScore: %w", err)
+	}
+
+	return entity, nil
+}
+
+// createCommunity creates a Community entity from a property map.
+//
+// This function is called by the extractor when routing entities to promoted schemas.
+// It extracts the Ent client from the context and maps properties from the data map
+// to the appropriate Ent builder setter methods.
+//
+// Supported field types: string, int, float64, bool
+// TODO: Add support for edge/relationship fields, JSON fields, and time fields
+func createCommunity(ctx context.Context, data map[string]any) (any, error) {
+	// Extract Ent client from context
+	client, ok := ctx.Value("entClient").(*Client)
+	if !ok || client == nil {
+		return nil, fmt.Errorf("ent client not found in context")
+	}
+
+	// Create builder
+	builder := client.Community.Create()
+
+	if val, ok := data["label"]; ok && val != nil {
+		if strVal, ok := val.(string); ok {
+			builder.SetLabel(strVal)
+		}
+	}
+
+	if val, ok := data["size"]; ok && val != nil {
+		if intVal, ok := val.(int); ok {
+			builder.SetSize(intVal)
+		}
+	}
+
+	if val, ok := data["internal_weight"]; ok && val != nil {
+		if floatVal, ok := val.(float64); ok {
+			builder.SetInternalWeight(floatVal)
+		}
+	}
+
+	if val, ok := data["modularity"]; ok && val != nil {
+		if floatVal, ok := val.(float64); ok {
+			builder.SetModularity(floatVal)
+		}
+	}
+
+	if val, ok := data["resolution"]; ok && val != nil {
+		if floatVal, ok := val.(float64); ok {
+			builder.SetResolution(floatVal)
+		}
+	}
+
+	// Save the entity
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Community: %w", err)
+	}
+
+	return entity, nil
+}
+
 // createDiscoveredEntity creates a DiscoveredEntity entity from a property map.
 //
 // This function is called by the extractor when routing entities to promoted schemas.
@@ -92,6 +218,12 @@ func createEmail(ctx context.Context, data map[string]any) (any, error) {
 		}
 	}
 
+	if val, ok := data["in_reply_to"]; ok && val != nil {
+		if strVal, ok := val.(string); ok {
+			builder.SetInReplyTo(strVal)
+		}
+	}
+
 	if val, ok := data["subject"]; ok && val != nil {
 		if strVal, ok := val.(string); ok {
 			builder.SetSubject(strVal)
@@ -119,6 +251,264 @@ func createEmail(ctx context.Context, data map[string]any) (any, error) {
 	return entity, nil
 }
 
+// createEntityAlias creates a EntityAlias entity from a property map.
+//
+// This function is called by the extractor when routing entities to promoted schemas.
+// It extracts the Ent client from the context and maps properties from the data map
+// to the appropriate Ent builder setter methods.
+//
+// Supported field types: string, int, float64, bool
+// TODO: Add support for edge/relationship fields, JSON fields, and time fields
+func createEntityAlias(ctx context.Context, data map[string]any) (any, error) {
+	// Extract Ent client from context
+	client, ok := ctx.Value("entClient").(*Client)
+	if !ok || client == nil {
+		return nil, fmt.Errorf("ent client not found in context")
+	}
+
+	// Create builder
+	builder := client.EntityAlias.Create()
+
+	if val, ok := data["entity_id"]; ok && val != nil {
+		if intVal, ok := val.(int); ok {
+			builder.SetEntityID(intVal)
+		}
+	}
+
+	if val, ok := data["alias"]; ok && val != nil {
+		if strVal, ok := val.(string); ok {
+			builder.SetAlias(strVal)
+		}
+	}
+
+	if val, ok := data["normalized"]; ok && val != nil {
+		if strVal, ok := val.(string); ok {
+			builder.SetNormalized(strVal)
+		}
+	}
+
+	if val, ok := data["kind"]; ok && val != nil {
+		if strVal, ok := val.(string); ok {
+			builder.SetKind(strVal)
+		}
+	}
+
+	if val, ok := data["source"]; ok && val != nil {
+		if strVal, ok := val.(string); ok {
+			builder.SetSource(strVal)
+		}
+	}
+
+	// Save the entity
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create EntityAlias: %w", err)
+	}
+
+	return entity, nil
+}
+
+// createEntityAudit creates a EntityAudit entity from a property map.
+//
+// This function is called by the extractor when routing entities to promoted schemas.
+// It extracts the Ent client from the context and maps properties from the data map
+// to the appropriate Ent builder setter methods.
+//
+// Supported field types: string, int, float64, bool
+// TODO: Add support for edge/relationship fields, JSON fields, and time fields
+func createEntityAudit(ctx context.Context, data map[string]any) (any, error) {
+	// Extract Ent client from context
+	client, ok := ctx.Value("entClient").(*Client)
+	if !ok || client == nil {
+		return nil, fmt.Errorf("ent client not found in context")
+	}
+
+	// Create builder
+	builder := client.EntityAudit.Create()
+
+	if val, ok := data["operation"]; ok && val != nil {
+		if strVal, ok := val.(string); ok {
+			builder.SetOperation(strVal)
+		}
+	}
+
+	if val, ok := data["entity_id"]; ok && val != nil {
+		if intVal, ok := val.(int); ok {
+			builder.SetEntityID(intVal)
+		}
+	}
+
+	if val, ok := data["actor"]; ok && val != nil {
+		if strVal, ok := val.(string); ok {
+			builder.SetActor(strVal)
+		}
+	}
+
+	if val, ok := data["reason"]; ok && val != nil {
+		if strVal, ok := val.(string); ok {
+			builder.SetReason(strVal)
+		}
+	}
+
+	// Save the entity
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create EntityAudit: %w", err)
+	}
+
+	return entity, nil
+}
+
+// createExtractionJob creates a ExtractionJob entity from a property map.
+//
+// This function is called by the extractor when routing entities to promoted schemas.
+// It extracts the Ent client from the context and maps properties from the data map
+// to the appropriate Ent builder setter methods.
+//
+// Supported field types: string, int, float64, bool
+// TODO: Add support for edge/relationship fields, JSON fields, and time fields
+func createExtractionJob(ctx context.Context, data map[string]any) (any, error) {
+	// Extract Ent client from context
+	client, ok := ctx.Value("entClient").(*Client)
+	if !ok || client == nil {
+		return nil, fmt.Errorf("ent client not found in context")
+	}
+
+	// Create builder
+	builder := client.ExtractionJob.Create()
+
+	if val, ok := data["email_id"]; ok && val != nil {
+		if intVal, ok := val.(int); ok {
+			builder.SetEmailID(intVal)
+		}
+	}
+
+	if val, ok := data["status"]; ok && val != nil {
+		if strVal, ok := val.(string); ok {
+			builder.SetStatus(strVal)
+		}
+	}
+
+	if val, ok := data["attempts"]; ok && val != nil {
+		if intVal, ok := val.(int); ok {
+			builder.SetAttempts(intVal)
+		}
+	}
+
+	if val, ok := data["last_error"]; ok && val != nil {
+		if strVal, ok := val.(string); ok {
+			builder.SetLastError(strVal)
+		}
+	}
+
+	if val, ok := data["model"]; ok && val != nil {
+		if strVal, ok := val.(string); ok {
+			builder.SetModel(strVal)
+		}
+	}
+
+	if val, ok := data["prompt_version"]; ok && val != nil {
+		if strVal, ok := val.(string); ok {
+			builder.SetPromptVersion(strVal)
+		}
+	}
+
+	if val, ok := data["batch"]; ok && val != nil {
+		if strVal, ok := val.(string); ok {
+			builder.SetBatch(strVal)
+		}
+	}
+
+	if val, ok := data["worker"]; ok && val != nil {
+		if strVal, ok := val.(string); ok {
+			builder.SetWorker(strVal)
+		}
+	}
+
+	// Save the entity
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create ExtractionJob: %w", err)
+	}
+
+	return entity, nil
+}
+
+// createProvenance creates a Provenance entity from a property map.
+//
+// This function is called by the extractor when routing entities to promoted schemas.
+// It extracts the Ent client from the context and maps properties from the data map
+// to the appropriate Ent builder setter methods.
+//
+// Supported field types: string, int, float64, bool
+// TODO: Add support for edge/relationship fields, JSON fields, and time fields
+func createProvenance(ctx context.Context, data map[string]any) (any, error) {
+	// Extract Ent client from context
+	client, ok := ctx.Value("entClient").(*Client)
+	if !ok || client == nil {
+		return nil, fmt.Errorf("ent client not found in context")
+	}
+
+	// Create builder
+	builder := client.Provenance.Create()
+
+	if val, ok := data["subject_type"]; ok && val != nil {
+		if strVal, ok := val.(string); ok {
+			builder.SetSubjectType(strVal)
+		}
+	}
+
+	if val, ok := data["subject_id"]; ok && val != nil {
+		if intVal, ok := val.(int); ok {
+			builder.SetSubjectID(intVal)
+		}
+	}
+
+	if val, ok := data["email_id"]; ok && val != nil {
+		if intVal, ok := val.(int); ok {
+			builder.SetEmailID(intVal)
+		}
+	}
+
+	if val, ok := data["message_id"]; ok && val != nil {
+		if strVal, ok := val.(string); ok {
+			builder.SetMessageID(strVal)
+		}
+	}
+
+	if val, ok := data["file_path"]; ok && val != nil {
+		if strVal, ok := val.(string); ok {
+			builder.SetFilePath(strVal)
+		}
+	}
+
+	if val, ok := data["source"]; ok && val != nil {
+		if strVal, ok := val.(string); ok {
+			builder.SetSource(strVal)
+		}
+	}
+
+	if val, ok := data["model"]; ok && val != nil {
+		if strVal, ok := val.(string); ok {
+			builder.SetModel(strVal)
+		}
+	}
+
+	if val, ok := data["prompt_version"]; ok && val != nil {
+		if strVal, ok := val.(string); ok {
+			builder.SetPromptVersion(strVal)
+		}
+	}
+
+	// Save the entity
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Provenance: %w", err)
+	}
+
+	return entity, nil
+}
+
 // createRelationship creates a Relationship entity from a property map.
 //
 // This function is called by the extractor when routing entities to promoted schemas.
@@ -227,6 +617,57 @@ func createSchemaPromotion(ctx context.Context, data map[string]any) (any, error
 	return entity, nil
 }
 
+// createThread creates a Thread entity from a property map.
+//
+// This function is called by the extractor when routing entities to promoted schemas.
+// It extracts the Ent client from the context and maps properties from the data map
+// to the appropriate Ent builder setter methods.
+//
+// Supported field types: string, int, float64, bool
+// TODO: Add support for edge/relationship fields, JSON fields, and time fields
+func createThread(ctx context.Context, data map[string]any) (any, error) {
+	// Extract Ent client from context
+	client, ok := ctx.Value("entClient").(*Client)
+	if !ok || client == nil {
+		return nil, fmt.Errorf("ent client not found in context")
+	}
+
+	// Create builder
+	builder := client.Thread.Create()
+
+	if val, ok := data["root_message_id"]; ok && val != nil {
+		if strVal, ok := val.(string); ok {
+			builder.SetRootMessageID(strVal)
+		}
+	}
+
+	if val, ok := data["subject"]; ok && val != nil {
+		if strVal, ok := val.(string); ok {
+			builder.SetSubject(strVal)
+		}
+	}
+
+	if val, ok := data["normalized_subject"]; ok && val != nil {
+		if strVal, ok := val.(string); ok {
+			builder.SetNormalizedSubject(strVal)
+		}
+	}
+
+	if val, ok := data["message_count"]; ok && val != nil {
+		if intVal, ok := val.(int); ok {
+			builder.SetMessageCount(intVal)
+		}
+	}
+
+	// Save the entity
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Thread: %w", err)
+	}
+
+	return entity, nil
+}
+
 // findDiscoveredEntity finds a DiscoveredEntity entity by unique_id.
 //
 // This function is called by the repository when performing type-aware lookups.
@@ -263,14 +704,28 @@ func findDiscoveredEntity(ctx context.Context, uniqueID string) (any, error) {
 // and enables the repository to find entities in promoted tables.
 func init() {
 
+	registry.Register("CentralityScore", createCentralityScore)
+
+	registry.Register("Community", createCommunity)
+
 	registry.Register("DiscoveredEntity", createDiscoveredEntity)
 
 	registry.RegisterFinder("DiscoveredEntity", findDiscoveredEntity)
 
 	registry.Register("Email", createEmail)
 
+	registry.Register("EntityAlias", createEntityAlias)
+
+	registry.Register("EntityAudit", createEntityAudit)
+
+	registry.Register("ExtractionJob", createExtractionJob)
+
+	registry.Register("Provenance", createProvenance)
+
 	registry.Register("Relationship", createRelationship)
 
 	registry.Register("SchemaPromotion", createSchemaPromotion)
 
+	registry.Register("Thread", createThread)
+
 }
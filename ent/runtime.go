@@ -5,17 +5,84 @@ package ent
 import (
 	"time"
 
+	"github.com/Blogem/enron-graph/ent/centralityscore"
+	"github.com/Blogem/enron-graph/ent/community"
 	"github.com/Blogem/enron-graph/ent/discoveredentity"
 	"github.com/Blogem/enron-graph/ent/email"
+	"github.com/Blogem/enron-graph/ent/entityalias"
+	"github.com/Blogem/enron-graph/ent/entityaudit"
+	"github.com/Blogem/enron-graph/ent/extractionjob"
+	"github.com/Blogem/enron-graph/ent/provenance"
 	"github.com/Blogem/enron-graph/ent/relationship"
 	"github.com/Blogem/enron-graph/ent/schema"
 	"github.com/Blogem/enron-graph/ent/schemapromotion"
+	"github.com/Blogem/enron-graph/ent/thread"
 )
 
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	centralityscoreFields := schema.CentralityScore{}.Fields()
+	_ = centralityscoreFields
+	// centralityscoreDescScope is the schema descriptor for scope field.
+	centralityscoreDescScope := centralityscoreFields[0].Descriptor()
+	// centralityscore.ScopeValidator is a validator for the "scope" field. It is called by the builders before save.
+	centralityscore.ScopeValidator = centralityscoreDescScope.Validators[0].(func(string) error)
+	// centralityscoreDescEntityID is the schema descriptor for entity_id field.
+	centralityscoreDescEntityID := centralityscoreFields[1].Descriptor()
+	// centralityscore.EntityIDValidator is a validator for the "entity_id" field. It is called by the builders before save.
+	centralityscore.EntityIDValidator = centralityscoreDescEntityID.Validators[0].(func(int) error)
+	// centralityscoreDescDegree is the schema descriptor for degree field.
+	centralityscoreDescDegree := centralityscoreFields[2].Descriptor()
+	// centralityscore.DefaultDegree holds the default value on creation for the degree field.
+	centralityscore.DefaultDegree = centralityscoreDescDegree.Default.(int)
+	// centralityscoreDescWeightedDegree is the schema descriptor for weighted_degree field.
+	centralityscoreDescWeightedDegree := centralityscoreFields[3].Descriptor()
+	// centralityscore.DefaultWeightedDegree holds the default value on creation for the weighted_degree field.
+	centralityscore.DefaultWeightedDegree = centralityscoreDescWeightedDegree.Default.(float64)
+	// centralityscoreDescPagerank is the schema descriptor for pagerank field.
+	centralityscoreDescPagerank := centralityscoreFields[4].Descriptor()
+	// centralityscore.DefaultPagerank holds the default value on creation for the pagerank field.
+	centralityscore.DefaultPagerank = centralityscoreDescPagerank.Default.(float64)
+	// centralityscoreDescBetweenness is the schema descriptor for betweenness field.
+	centralityscoreDescBetweenness := centralityscoreFields[5].Descriptor()
+	// centralityscore.DefaultBetweenness holds the default value on creation for the betweenness field.
+	centralityscore.DefaultBetweenness = centralityscoreDescBetweenness.Default.(float64)
+	// centralityscoreDescCloseness is the schema descriptor for closeness field.
+	centralityscoreDescCloseness := centralityscoreFields[6].Descriptor()
+	// centralityscore.DefaultCloseness holds the default value on creation for the closeness field.
+	centralityscore.DefaultCloseness = centralityscoreDescCloseness.Default.(float64)
+	// centralityscoreDescComputedAt is the schema descriptor for computed_at field.
+	centralityscoreDescComputedAt := centralityscoreFields[7].Descriptor()
+	// centralityscore.DefaultComputedAt holds the default value on creation for the computed_at field.
+	centralityscore.DefaultComputedAt = centralityscoreDescComputedAt.Default.(func() time.Time)
+	communityFields := schema.Community{}.Fields()
+	_ = communityFields
+	// communityDescLabel is the schema descriptor for label field.
+	communityDescLabel := communityFields[0].Descriptor()
+	// community.DefaultLabel holds the default value on creation for the label field.
+	community.DefaultLabel = communityDescLabel.Default.(string)
+	// communityDescSize is the schema descriptor for size field.
+	communityDescSize := communityFields[1].Descriptor()
+	// community.DefaultSize holds the default value on creation for the size field.
+	community.DefaultSize = communityDescSize.Default.(int)
+	// communityDescInternalWeight is the schema descriptor for internal_weight field.
+	communityDescInternalWeight := communityFields[3].Descriptor()
+	// community.DefaultInternalWeight holds the default value on creation for the internal_weight field.
+	community.DefaultInternalWeight = communityDescInternalWeight.Default.(float64)
+	// communityDescModularity is the schema descriptor for modularity field.
+	communityDescModularity := communityFields[4].Descriptor()
+	// community.DefaultModularity holds the default value on creation for the modularity field.
+	community.DefaultModularity = communityDescModularity.Default.(float64)
+	// communityDescResolution is the schema descriptor for resolution field.
+	communityDescResolution := communityFields[5].Descriptor()
+	// community.DefaultResolution holds the default value on creation for the resolution field.
+	community.DefaultResolution = communityDescResolution.Default.(float64)
+	// communityDescComputedAt is the schema descriptor for computed_at field.
+	communityDescComputedAt := communityFields[6].Descriptor()
+	// community.DefaultComputedAt holds the default value on creation for the computed_at field.
+	community.DefaultComputedAt = communityDescComputedAt.Default.(func() time.Time)
 	discoveredentityFields := schema.DiscoveredEntity{}.Fields()
 	_ = discoveredentityFields
 	// discoveredentityDescUniqueID is the schema descriptor for unique_id field.
@@ -65,21 +132,115 @@ func init() {
 	// email.FromValidator is a validator for the "from" field. It is called by the builders before save.
 	email.FromValidator = emailDescFrom.Validators[0].(func(string) error)
 	// emailDescSubject is the schema descriptor for subject field.
-	emailDescSubject := emailFields[5].Descriptor()
+	emailDescSubject := emailFields[8].Descriptor()
 	// email.DefaultSubject holds the default value on creation for the subject field.
 	email.DefaultSubject = emailDescSubject.Default.(string)
 	// emailDescDate is the schema descriptor for date field.
-	emailDescDate := emailFields[6].Descriptor()
+	emailDescDate := emailFields[9].Descriptor()
 	// email.DefaultDate holds the default value on creation for the date field.
 	email.DefaultDate = emailDescDate.Default.(func() time.Time)
 	// emailDescBody is the schema descriptor for body field.
-	emailDescBody := emailFields[7].Descriptor()
+	emailDescBody := emailFields[10].Descriptor()
 	// email.DefaultBody holds the default value on creation for the body field.
 	email.DefaultBody = emailDescBody.Default.(string)
 	// emailDescCreatedAt is the schema descriptor for created_at field.
-	emailDescCreatedAt := emailFields[9].Descriptor()
+	emailDescCreatedAt := emailFields[12].Descriptor()
 	// email.DefaultCreatedAt holds the default value on creation for the created_at field.
 	email.DefaultCreatedAt = emailDescCreatedAt.Default.(func() time.Time)
+	entityaliasFields := schema.EntityAlias{}.Fields()
+	_ = entityaliasFields
+	// entityaliasDescEntityID is the schema descriptor for entity_id field.
+	entityaliasDescEntityID := entityaliasFields[0].Descriptor()
+	// entityalias.EntityIDValidator is a validator for the "entity_id" field. It is called by the builders before save.
+	entityalias.EntityIDValidator = entityaliasDescEntityID.Validators[0].(func(int) error)
+	// entityaliasDescAlias is the schema descriptor for alias field.
+	entityaliasDescAlias := entityaliasFields[1].Descriptor()
+	// entityalias.AliasValidator is a validator for the "alias" field. It is called by the builders before save.
+	entityalias.AliasValidator = entityaliasDescAlias.Validators[0].(func(string) error)
+	// entityaliasDescNormalized is the schema descriptor for normalized field.
+	entityaliasDescNormalized := entityaliasFields[2].Descriptor()
+	// entityalias.NormalizedValidator is a validator for the "normalized" field. It is called by the builders before save.
+	entityalias.NormalizedValidator = entityaliasDescNormalized.Validators[0].(func(string) error)
+	// entityaliasDescKind is the schema descriptor for kind field.
+	entityaliasDescKind := entityaliasFields[3].Descriptor()
+	// entityalias.DefaultKind holds the default value on creation for the kind field.
+	entityalias.DefaultKind = entityaliasDescKind.Default.(string)
+	// entityaliasDescSource is the schema descriptor for source field.
+	entityaliasDescSource := entityaliasFields[4].Descriptor()
+	// entityalias.SourceValidator is a validator for the "source" field. It is called by the builders before save.
+	entityalias.SourceValidator = entityaliasDescSource.Validators[0].(func(string) error)
+	// entityaliasDescCreatedAt is the schema descriptor for created_at field.
+	entityaliasDescCreatedAt := entityaliasFields[5].Descriptor()
+	// entityalias.DefaultCreatedAt holds the default value on creation for the created_at field.
+	entityalias.DefaultCreatedAt = entityaliasDescCreatedAt.Default.(func() time.Time)
+	entityauditFields := schema.EntityAudit{}.Fields()
+	_ = entityauditFields
+	// entityauditDescOperation is the schema descriptor for operation field.
+	entityauditDescOperation := entityauditFields[0].Descriptor()
+	// entityaudit.OperationValidator is a validator for the "operation" field. It is called by the builders before save.
+	entityaudit.OperationValidator = entityauditDescOperation.Validators[0].(func(string) error)
+	// entityauditDescEntityID is the schema descriptor for entity_id field.
+	entityauditDescEntityID := entityauditFields[1].Descriptor()
+	// entityaudit.EntityIDValidator is a validator for the "entity_id" field. It is called by the builders before save.
+	entityaudit.EntityIDValidator = entityauditDescEntityID.Validators[0].(func(int) error)
+	// entityauditDescCreatedAt is the schema descriptor for created_at field.
+	entityauditDescCreatedAt := entityauditFields[6].Descriptor()
+	// entityaudit.DefaultCreatedAt holds the default value on creation for the created_at field.
+	entityaudit.DefaultCreatedAt = entityauditDescCreatedAt.Default.(func() time.Time)
+	extractionjobFields := schema.ExtractionJob{}.Fields()
+	_ = extractionjobFields
+	// extractionjobDescEmailID is the schema descriptor for email_id field.
+	extractionjobDescEmailID := extractionjobFields[0].Descriptor()
+	// extractionjob.EmailIDValidator is a validator for the "email_id" field. It is called by the builders before save.
+	extractionjob.EmailIDValidator = extractionjobDescEmailID.Validators[0].(func(int) error)
+	// extractionjobDescStatus is the schema descriptor for status field.
+	extractionjobDescStatus := extractionjobFields[1].Descriptor()
+	// extractionjob.DefaultStatus holds the default value on creation for the status field.
+	extractionjob.DefaultStatus = extractionjobDescStatus.Default.(string)
+	// extractionjobDescAttempts is the schema descriptor for attempts field.
+	extractionjobDescAttempts := extractionjobFields[2].Descriptor()
+	// extractionjob.DefaultAttempts holds the default value on creation for the attempts field.
+	extractionjob.DefaultAttempts = extractionjobDescAttempts.Default.(int)
+	// extractionjobDescModel is the schema descriptor for model field.
+	extractionjobDescModel := extractionjobFields[4].Descriptor()
+	// extractionjob.DefaultModel holds the default value on creation for the model field.
+	extractionjob.DefaultModel = extractionjobDescModel.Default.(string)
+	// extractionjobDescPromptVersion is the schema descriptor for prompt_version field.
+	extractionjobDescPromptVersion := extractionjobFields[5].Descriptor()
+	// extractionjob.DefaultPromptVersion holds the default value on creation for the prompt_version field.
+	extractionjob.DefaultPromptVersion = extractionjobDescPromptVersion.Default.(string)
+	// extractionjobDescBatch is the schema descriptor for batch field.
+	extractionjobDescBatch := extractionjobFields[6].Descriptor()
+	// extractionjob.DefaultBatch holds the default value on creation for the batch field.
+	extractionjob.DefaultBatch = extractionjobDescBatch.Default.(string)
+	// extractionjobDescCreatedAt is the schema descriptor for created_at field.
+	extractionjobDescCreatedAt := extractionjobFields[10].Descriptor()
+	// extractionjob.DefaultCreatedAt holds the default value on creation for the created_at field.
+	extractionjob.DefaultCreatedAt = extractionjobDescCreatedAt.Default.(func() time.Time)
+	// extractionjobDescUpdatedAt is the schema descriptor for updated_at field.
+	extractionjobDescUpdatedAt := extractionjobFields[11].Descriptor()
+	// extractionjob.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	extractionjob.DefaultUpdatedAt = extractionjobDescUpdatedAt.Default.(func() time.Time)
+	// extractionjob.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	extractionjob.UpdateDefaultUpdatedAt = extractionjobDescUpdatedAt.UpdateDefault.(func() time.Time)
+	provenanceFields := schema.Provenance{}.Fields()
+	_ = provenanceFields
+	// provenanceDescSubjectType is the schema descriptor for subject_type field.
+	provenanceDescSubjectType := provenanceFields[0].Descriptor()
+	// provenance.SubjectTypeValidator is a validator for the "subject_type" field. It is called by the builders before save.
+	provenance.SubjectTypeValidator = provenanceDescSubjectType.Validators[0].(func(string) error)
+	// provenanceDescSubjectID is the schema descriptor for subject_id field.
+	provenanceDescSubjectID := provenanceFields[1].Descriptor()
+	// provenance.SubjectIDValidator is a validator for the "subject_id" field. It is called by the builders before save.
+	provenance.SubjectIDValidator = provenanceDescSubjectID.Validators[0].(func(int) error)
+	// provenanceDescSource is the schema descriptor for source field.
+	provenanceDescSource := provenanceFields[5].Descriptor()
+	// provenance.SourceValidator is a validator for the "source" field. It is called by the builders before save.
+	provenance.SourceValidator = provenanceDescSource.Validators[0].(func(string) error)
+	// provenanceDescExtractedAt is the schema descriptor for extracted_at field.
+	provenanceDescExtractedAt := provenanceFields[8].Descriptor()
+	// provenance.DefaultExtractedAt holds the default value on creation for the extracted_at field.
+	provenance.DefaultExtractedAt = provenanceDescExtractedAt.Default.(func() time.Time)
 	relationshipFields := schema.Relationship{}.Fields()
 	_ = relationshipFields
 	// relationshipDescType is the schema descriptor for type field.
@@ -152,4 +313,26 @@ func init() {
 	schemapromotion.DefaultValidationFailures = schemapromotionDescValidationFailures.Default.(int)
 	// schemapromotion.ValidationFailuresValidator is a validator for the "validation_failures" field. It is called by the builders before save.
 	schemapromotion.ValidationFailuresValidator = schemapromotionDescValidationFailures.Validators[0].(func(int) error)
+	threadFields := schema.Thread{}.Fields()
+	_ = threadFields
+	// threadDescRootMessageID is the schema descriptor for root_message_id field.
+	threadDescRootMessageID := threadFields[0].Descriptor()
+	// thread.RootMessageIDValidator is a validator for the "root_message_id" field. It is called by the builders before save.
+	thread.RootMessageIDValidator = threadDescRootMessageID.Validators[0].(func(string) error)
+	// threadDescSubject is the schema descriptor for subject field.
+	threadDescSubject := threadFields[1].Descriptor()
+	// thread.DefaultSubject holds the default value on creation for the subject field.
+	thread.DefaultSubject = threadDescSubject.Default.(string)
+	// threadDescNormalizedSubject is the schema descriptor for normalized_subject field.
+	threadDescNormalizedSubject := threadFields[2].Descriptor()
+	// thread.DefaultNormalizedSubject holds the default value on creation for the normalized_subject field.
+	thread.DefaultNormalizedSubject = threadDescNormalizedSubject.Default.(string)
+	// threadDescMessageCount is the schema descriptor for message_count field.
+	threadDescMessageCount := threadFields[3].Descriptor()
+	// thread.DefaultMessageCount holds the default value on creation for the message_count field.
+	thread.DefaultMessageCount = threadDescMessageCount.Default.(int)
+	// threadDescCreatedAt is the schema descriptor for created_at field.
+	threadDescCreatedAt := threadFields[7].Descriptor()
+	// thread.DefaultCreatedAt holds the default value on creation for the created_at field.
+	thread.DefaultCreatedAt = threadDescCreatedAt.Default.(func() time.Time)
 }
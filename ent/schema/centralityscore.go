@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// CentralityScore holds the schema definition for the CentralityScore entity.
+// Each row holds the centrality of one entity within a scope: the relationship
+// types and time window the graph analytics were computed over.
+type CentralityScore struct {
+	ent.Schema
+}
+
+// Fields of the CentralityScore.
+func (CentralityScore) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("scope").
+			NotEmpty().
+			Comment("Relationship filter the scores were computed over, e.g. all or types=COMMUNICATES_WITH"),
+		field.Int("entity_id").
+			Positive().
+			Comment("Discovered entity ID"),
+		field.Int("degree").
+			Default(0).
+			Comment("Number of distinct neighbors"),
+		field.Float("weighted_degree").
+			Default(0).
+			Comment("Summed confidence of the entity's relationships"),
+		field.Float("pagerank").
+			Default(0),
+		field.Float("betweenness").
+			Default(0).
+			Comment("Normalized betweenness centrality (0-1)"),
+		field.Float("closeness").
+			Default(0).
+			Comment("Normalized harmonic closeness centrality (0-1)"),
+		field.Time("computed_at").
+			Default(time.Now),
+	}
+}
+
+// Edges of the CentralityScore.
+func (CentralityScore) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the CentralityScore.
+func (CentralityScore) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("scope", "entity_id").Unique(),
+		index.Fields("entity_id"),
+	}
+}
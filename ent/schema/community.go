@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// Community holds the schema definition for the Community entity.
+// A community is a densely connected group of entities found by community
+// detection over the communication graph; entities are linked to it with
+// MEMBER_OF relationships. Every run replaces all communities.
+type Community struct {
+	ent.Schema
+}
+
+// Fields of the Community.
+func (Community) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("label").
+			Default("").
+			Comment("Name of the most connected member"),
+		field.Int("size").
+			Default(0).
+			Comment("Number of member entities"),
+		field.JSON("top_members", []int{}).
+			Optional().
+			Comment("Entity IDs of the members with the most connections inside the community, most first"),
+		field.Float("internal_weight").
+			Default(0).
+			Comment("Summed weight of the relationships between members"),
+		field.Float("modularity").
+			Default(0).
+			Comment("Modularity of the partition the community is part of"),
+		field.Float("resolution").
+			Default(1).
+			Comment("Resolution the partition was computed with; higher values give smaller communities"),
+		field.Time("computed_at").
+			Default(time.Now),
+	}
+}
+
+// Edges of the Community.
+func (Community) Edges() []ent.Edge {
+	return nil
+}
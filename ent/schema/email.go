@@ -32,6 +32,15 @@ func (Email) Fields() []ent.Field {
 		field.JSON("bcc", []string{}).
 			Optional().
 			Comment("BCC email addresses"),
+		field.JSON("display_names", map[string]string{}).
+			Optional().
+			Comment("Display names seen in the headers, keyed by email address"),
+		field.String("in_reply_to").
+			Optional().
+			Comment("Message ID from the In-Reply-To header"),
+		field.JSON("references", []string{}).
+			Optional().
+			Comment("Message IDs from the References header, oldest first"),
 		field.String("subject").
 			Default("").
 			Comment("Email subject line"),
@@ -60,5 +69,6 @@ func (Email) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("date"),
 		index.Fields("from"),
+		index.Fields("in_reply_to"),
 	}
 }
@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// EntityAlias holds the schema definition for the EntityAlias entity.
+// Each row records a name variant or email address under which an entity
+// has been seen, so that later mentions resolve to the same node.
+type EntityAlias struct {
+	ent.Schema
+}
+
+// Fields of the EntityAlias.
+func (EntityAlias) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("entity_id").
+			Positive().
+			Comment("ID of the discovered entity the alias belongs to"),
+		field.String("alias").
+			NotEmpty().
+			Comment("Alias as it was seen"),
+		field.String("normalized").
+			NotEmpty().
+			Comment("Lowercased, whitespace-collapsed alias used for lookups"),
+		field.String("kind").
+			Default("name").
+			Comment("Kind of alias: name or email"),
+		field.String("source").
+			NotEmpty().
+			Comment("Where the alias was seen: header, signature, content, merge"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the EntityAlias.
+func (EntityAlias) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the EntityAlias.
+func (EntityAlias) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("entity_id", "normalized").Unique(),
+		index.Fields("normalized"),
+	}
+}
@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// EntityAudit holds the schema definition for the EntityAudit entity.
+// Each row records a manual curation of the graph (merging duplicate
+// entities or splitting one apart) with enough detail to reverse it.
+type EntityAudit struct {
+	ent.Schema
+}
+
+// Fields of the EntityAudit.
+func (EntityAudit) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("operation").
+			NotEmpty().
+			Comment("Curation operation: merge or split"),
+		field.Int("entity_id").
+			Positive().
+			Comment("Surviving entity of a merge, or source entity of a split"),
+		field.JSON("related_ids", []int{}).
+			Optional().
+			Comment("Entities merged away, or the entity created by a split"),
+		field.String("actor").
+			Optional().
+			Comment("Who performed the operation (CLI user, api, explorer)"),
+		field.String("reason").
+			Optional().
+			Comment("Free-text justification"),
+		field.JSON("details", map[string]interface{}{}).
+			Optional().
+			SchemaType(map[string]string{
+				dialect.Postgres: "jsonb",
+			}).
+			Comment("Snapshots of the affected entities and moved relationship IDs"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the EntityAudit.
+func (EntityAudit) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the EntityAudit.
+func (EntityAudit) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("entity_id"),
+		index.Fields("created_at"),
+	}
+}
@@ -0,0 +1,73 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ExtractionJob holds the schema definition for the ExtractionJob entity.
+// Each row tracks entity extraction for one email so that long runs can be
+// shared between processes and resumed after a crash.
+type ExtractionJob struct {
+	ent.Schema
+}
+
+// Fields of the ExtractionJob.
+func (ExtractionJob) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("email_id").
+			Unique().
+			Positive().
+			Comment("Email to extract entities from"),
+		field.String("status").
+			Default("pending").
+			Comment("Job status: pending, running, done or failed"),
+		field.Int("attempts").
+			Default(0).
+			Comment("Number of times the job was claimed"),
+		field.Text("last_error").
+			Optional().
+			Comment("Error of the most recent failed attempt"),
+		field.String("model").
+			Default("").
+			Comment("LLM model the extraction was requested with, or ran with once done"),
+		field.String("prompt_version").
+			Default("").
+			Comment("Extraction prompt version the job completed with"),
+		field.String("batch").
+			Default("").
+			Comment("Run that queued the job; a run that selects emails claims only its own jobs"),
+		field.String("worker").
+			Optional().
+			Comment("Process that claimed the job most recently"),
+		field.Time("claimed_at").
+			Optional().
+			Nillable().
+			Comment("When the job was last claimed; running jobs claimed long ago are considered abandoned"),
+		field.Time("completed_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the ExtractionJob.
+func (ExtractionJob) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the ExtractionJob.
+func (ExtractionJob) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "claimed_at"),
+		index.Fields("batch", "status"),
+	}
+}
@@ -0,0 +1,70 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Provenance holds the schema definition for the Provenance entity.
+// Each row links a graph fact (entity or relationship) to the email and
+// extraction run that produced or confirmed it.
+type Provenance struct {
+	ent.Schema
+}
+
+// Fields of the Provenance.
+func (Provenance) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("subject_type").
+			NotEmpty().
+			Comment("Kind of fact: discovered_entity or relationship"),
+		field.Int("subject_id").
+			Positive().
+			Comment("ID of the entity or relationship"),
+		field.Int("email_id").
+			Optional().
+			Comment("Source email ID"),
+		field.String("message_id").
+			Optional().
+			Comment("Source email message ID"),
+		field.String("file_path").
+			Optional().
+			Comment("Original file path of the source email in the dataset"),
+		field.String("source").
+			NotEmpty().
+			Comment("How the fact was obtained: header, content, merge"),
+		field.String("model").
+			Optional().
+			Comment("LLM model used for extraction"),
+		field.String("prompt_version").
+			Optional().
+			Comment("Version of the extraction prompt template"),
+		field.Time("extracted_at").
+			Default(time.Now).
+			Comment("When the extraction ran"),
+		field.JSON("properties", map[string]interface{}{}).
+			Optional().
+			SchemaType(map[string]string{
+				dialect.Postgres: "jsonb",
+			}).
+			Comment("Additional lineage details (e.g., merged properties)"),
+	}
+}
+
+// Edges of the Provenance.
+func (Provenance) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the Provenance.
+func (Provenance) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("subject_type", "subject_id"),
+		index.Fields("email_id"),
+		index.Fields("extracted_at"),
+	}
+}
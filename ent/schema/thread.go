@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Thread holds the schema definition for the Thread entity.
+// A thread groups the emails of one conversation; emails are linked to it
+// with PART_OF_THREAD relationships and to their parent with REPLY_TO.
+type Thread struct {
+	ent.Schema
+}
+
+// Fields of the Thread.
+func (Thread) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("root_message_id").
+			Unique().
+			NotEmpty().
+			Comment("Message ID of the email that started the conversation"),
+		field.String("subject").
+			Default("").
+			Comment("Subject of the conversation without Re:/Fw: prefixes"),
+		field.String("normalized_subject").
+			Default("").
+			Comment("Lowercased subject used to match replies without reply headers"),
+		field.Int("message_count").
+			Default(0).
+			Comment("Number of emails in the thread"),
+		field.JSON("participants", []string{}).
+			Optional().
+			Comment("Email addresses of everyone who sent or received a message"),
+		field.Time("first_date").
+			Optional().
+			Comment("Date of the earliest email"),
+		field.Time("last_date").
+			Optional().
+			Comment("Date of the latest email"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the Thread.
+func (Thread) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the Thread.
+func (Thread) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("normalized_subject", "last_date"),
+	}
+}
@@ -0,0 +1,188 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Blogem/enron-graph/ent/thread"
+)
+
+// Thread is the model entity for the Thread schema.
+type Thread struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Message ID of the email that started the conversation
+	RootMessageID string `json:"root_message_id,omitempty"`
+	// Subject of the conversation without Re:/Fw: prefixes
+	Subject string `json:"subject,omitempty"`
+	// Lowercased subject used to match replies without reply headers
+	NormalizedSubject string `json:"normalized_subject,omitempty"`
+	// Number of emails in the thread
+	MessageCount int `json:"message_count,omitempty"`
+	// Email addresses of everyone who sent or received a message
+	Participants []string `json:"participants,omitempty"`
+	// Date of the earliest email
+	FirstDate time.Time `json:"first_date,omitempty"`
+	// Date of the latest email
+	LastDate time.Time `json:"last_date,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Thread) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case thread.FieldParticipants:
+			values[i] = new([]byte)
+		case thread.FieldID, thread.FieldMessageCount:
+			values[i] = new(sql.NullInt64)
+		case thread.FieldRootMessageID, thread.FieldSubject, thread.FieldNormalizedSubject:
+			values[i] = new(sql.NullString)
+		case thread.FieldFirstDate, thread.FieldLastDate, thread.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Thread fields.
+func (_m *Thread) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case thread.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case thread.FieldRootMessageID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field root_message_id", values[i])
+			} else if value.Valid {
+				_m.RootMessageID = value.String
+			}
+		case thread.FieldSubject:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subject", values[i])
+			} else if value.Valid {
+				_m.Subject = value.String
+			}
+		case thread.FieldNormalizedSubject:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field normalized_subject", values[i])
+			} else if value.Valid {
+				_m.NormalizedSubject = value.String
+			}
+		case thread.FieldMessageCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field message_count", values[i])
+			} else if value.Valid {
+				_m.MessageCount = int(value.Int64)
+			}
+		case thread.FieldParticipants:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field participants", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Participants); err != nil {
+					return fmt.Errorf("unmarshal field participants: %w", err)
+				}
+			}
+		case thread.FieldFirstDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_date", values[i])
+			} else if value.Valid {
+				_m.FirstDate = value.Time
+			}
+		case thread.FieldLastDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_date", values[i])
+			} else if value.Valid {
+				_m.LastDate = value.Time
+			}
+		case thread.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Thread.
+// This includes values selected through modifiers, order, etc.
+func (_m *Thread) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Thread.
+// Note that you need to call Thread.Unwrap() before calling this method if this Thread
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Thread) Update() *ThreadUpdateOne {
+	return NewThreadClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Thread entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Thread) Unwrap() *Thread {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Thread is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Thread) String() string {
+	var builder strings.Builder
+	builder.WriteString("Thread(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("root_message_id=")
+	builder.WriteString(_m.RootMessageID)
+	builder.WriteString(", ")
+	builder.WriteString("subject=")
+	builder.WriteString(_m.Subject)
+	builder.WriteString(", ")
+	builder.WriteString("normalized_subject=")
+	builder.WriteString(_m.NormalizedSubject)
+	builder.WriteString(", ")
+	builder.WriteString("message_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MessageCount))
+	builder.WriteString(", ")
+	builder.WriteString("participants=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Participants))
+	builder.WriteString(", ")
+	builder.WriteString("first_date=")
+	builder.WriteString(_m.FirstDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("last_date=")
+	builder.WriteString(_m.LastDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Threads is a parsable slice of Thread.
+type Threads []*Thread
@@ -0,0 +1,113 @@
+// Code generated by ent, DO NOT EDIT.
+
+package thread
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the thread type in the database.
+	Label = "thread"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldRootMessageID holds the string denoting the root_message_id field in the database.
+	FieldRootMessageID = "root_message_id"
+	// FieldSubject holds the string denoting the subject field in the database.
+	FieldSubject = "subject"
+	// FieldNormalizedSubject holds the string denoting the normalized_subject field in the database.
+	FieldNormalizedSubject = "normalized_subject"
+	// FieldMessageCount holds the string denoting the message_count field in the database.
+	FieldMessageCount = "message_count"
+	// FieldParticipants holds the string denoting the participants field in the database.
+	FieldParticipants = "participants"
+	// FieldFirstDate holds the string denoting the first_date field in the database.
+	FieldFirstDate = "first_date"
+	// FieldLastDate holds the string denoting the last_date field in the database.
+	FieldLastDate = "last_date"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the thread in the database.
+	Table = "threads"
+)
+
+// Columns holds all SQL columns for thread fields.
+var Columns = []string{
+	FieldID,
+	FieldRootMessageID,
+	FieldSubject,
+	FieldNormalizedSubject,
+	FieldMessageCount,
+	FieldParticipants,
+	FieldFirstDate,
+	FieldLastDate,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// RootMessageIDValidator is a validator for the "root_message_id" field. It is called by the builders before save.
+	RootMessageIDValidator func(string) error
+	// DefaultSubject holds the default value on creation for the "subject" field.
+	DefaultSubject string
+	// DefaultNormalizedSubject holds the default value on creation for the "normalized_subject" field.
+	DefaultNormalizedSubject string
+	// DefaultMessageCount holds the default value on creation for the "message_count" field.
+	DefaultMessageCount int
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the Thread queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByRootMessageID orders the results by the root_message_id field.
+func ByRootMessageID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRootMessageID, opts...).ToFunc()
+}
+
+// BySubject orders the results by the subject field.
+func BySubject(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubject, opts...).ToFunc()
+}
+
+// ByNormalizedSubject orders the results by the normalized_subject field.
+func ByNormalizedSubject(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNormalizedSubject, opts...).ToFunc()
+}
+
+// ByMessageCount orders the results by the message_count field.
+func ByMessageCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMessageCount, opts...).ToFunc()
+}
+
+// ByFirstDate orders the results by the first_date field.
+func ByFirstDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFirstDate, opts...).ToFunc()
+}
+
+// ByLastDate orders the results by the last_date field.
+func ByLastDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastDate, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
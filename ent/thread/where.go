@@ -0,0 +1,490 @@
+// Code generated by ent, DO NOT EDIT.
+
+package thread
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Blogem/enron-graph/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Thread {
+	return predicate.Thread(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Thread {
+	return predicate.Thread(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Thread {
+	return predicate.Thread(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Thread {
+	return predicate.Thread(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Thread {
+	return predicate.Thread(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Thread {
+	return predicate.Thread(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Thread {
+	return predicate.Thread(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Thread {
+	return predicate.Thread(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Thread {
+	return predicate.Thread(sql.FieldLTE(FieldID, id))
+}
+
+// RootMessageID applies equality check predicate on the "root_message_id" field. It's identical to RootMessageIDEQ.
+func RootMessageID(v string) predicate.Thread {
+	return predicate.Thread(sql.FieldEQ(FieldRootMessageID, v))
+}
+
+// Subject applies equality check predicate on the "subject" field. It's identical to SubjectEQ.
+func Subject(v string) predicate.Thread {
+	return predicate.Thread(sql.FieldEQ(FieldSubject, v))
+}
+
+// NormalizedSubject applies equality check predicate on the "normalized_subject" field. It's identical to NormalizedSubjectEQ.
+func NormalizedSubject(v string) predicate.Thread {
+	return predicate.Thread(sql.FieldEQ(FieldNormalizedSubject, v))
+}
+
+// MessageCount applies equality check predicate on the "message_count" field. It's identical to MessageCountEQ.
+func MessageCount(v int) predicate.Thread {
+	return predicate.Thread(sql.FieldEQ(FieldMessageCount, v))
+}
+
+// FirstDate applies equality check predicate on the "first_date" field. It's identical to FirstDateEQ.
+func FirstDate(v time.Time) predicate.Thread {
+	return predicate.Thread(sql.FieldEQ(FieldFirstDate, v))
+}
+
+// LastDate applies equality check predicate on the "last_date" field. It's identical to LastDateEQ.
+func LastDate(v time.Time) predicate.Thread {
+	return predicate.Thread(sql.FieldEQ(FieldLastDate, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Thread {
+	return predicate.Thread(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// RootMessageIDEQ applies the EQ predicate on the "root_message_id" field.
+func RootMessageIDEQ(v string) predicate.Thread {
+	return predicate.Thread(sql.FieldEQ(FieldRootMessageID, v))
+}
+
+// RootMessageIDNEQ applies the NEQ predicate on the "root_message_id" field.
+func RootMessageIDNEQ(v string) predicate.Thread {
+	return predicate.Thread(sql.FieldNEQ(FieldRootMessageID, v))
+}
+
+// RootMessageIDIn applies the In predicate on the "root_message_id" field.
+func RootMessageIDIn(vs ...string) predicate.Thread {
+	return predicate.Thread(sql.FieldIn(FieldRootMessageID, vs...))
+}
+
+// RootMessageIDNotIn applies the NotIn predicate on the "root_message_id" field.
+func RootMessageIDNotIn(vs ...string) predicate.Thread {
+	return predicate.Thread(sql.FieldNotIn(FieldRootMessageID, vs...))
+}
+
+// RootMessageIDGT applies the GT predicate on the "root_message_id" field.
+func RootMessageIDGT(v string) predicate.Thread {
+	return predicate.Thread(sql.FieldGT(FieldRootMessageID, v))
+}
+
+// RootMessageIDGTE applies the GTE predicate on the "root_message_id" field.
+func RootMessageIDGTE(v string) predicate.Thread {
+	return predicate.Thread(sql.FieldGTE(FieldRootMessageID, v))
+}
+
+// RootMessageIDLT applies the LT predicate on the "root_message_id" field.
+func RootMessageIDLT(v string) predicate.Thread {
+	return predicate.Thread(sql.FieldLT(FieldRootMessageID, v))
+}
+
+// RootMessageIDLTE applies the LTE predicate on the "root_message_id" field.
+func RootMessageIDLTE(v string) predicate.Thread {
+	return predicate.Thread(sql.FieldLTE(FieldRootMessageID, v))
+}
+
+// RootMessageIDContains applies the Contains predicate on the "root_message_id" field.
+func RootMessageIDContains(v string) predicate.Thread {
+	return predicate.Thread(sql.FieldContains(FieldRootMessageID, v))
+}
+
+// RootMessageIDHasPrefix applies the HasPrefix predicate on the "root_message_id" field.
+func RootMessageIDHasPrefix(v string) predicate.Thread {
+	return predicate.Thread(sql.FieldHasPrefix(FieldRootMessageID, v))
+}
+
+// RootMessageIDHasSuffix applies the HasSuffix predicate on the "root_message_id" field.
+func RootMessageIDHasSuffix(v string) predicate.Thread {
+	return predicate.Thread(sql.FieldHasSuffix(FieldRootMessageID, v))
+}
+
+// RootMessageIDEqualFold applies the EqualFold predicate on the "root_message_id" field.
+func RootMessageIDEqualFold(v string) predicate.Thread {
+	return predicate.Thread(sql.FieldEqualFold(FieldRootMessageID, v))
+}
+
+// RootMessageIDContainsFold applies the ContainsFold predicate on the "root_message_id" field.
+func RootMessageIDContainsFold(v string) predicate.Thread {
+	return predicate.Thread(sql.FieldContainsFold(FieldRootMessageID, v))
+}
+
+// SubjectEQ applies the EQ predicate on the "subject" field.
+func SubjectEQ(v string) predicate.Thread {
+	return predicate.Thread(sql.FieldEQ(FieldSubject, v))
+}
+
+// SubjectNEQ applies the NEQ predicate on the "subject" field.
+func SubjectNEQ(v string) predicate.Thread {
+	return predicate.Thread(sql.FieldNEQ(FieldSubject, v))
+}
+
+// SubjectIn applies the In predicate on the "subject" field.
+func SubjectIn(vs ...string) predicate.Thread {
+	return predicate.Thread(sql.FieldIn(FieldSubject, vs...))
+}
+
+// SubjectNotIn applies the NotIn predicate on the "subject" field.
+func SubjectNotIn(vs ...string) predicate.Thread {
+	return predicate.Thread(sql.FieldNotIn(FieldSubject, vs...))
+}
+
+// SubjectGT applies the GT predicate on the "subject" field.
+func SubjectGT(v string) predicate.Thread {
+	return predicate.Thread(sql.FieldGT(FieldSubject, v))
+}
+
+// SubjectGTE applies the GTE predicate on the "subject" field.
+func SubjectGTE(v string) predicate.Thread {
+	return predicate.Thread(sql.FieldGTE(FieldSubject, v))
+}
+
+// SubjectLT applies the LT predicate on the "subject" field.
+func SubjectLT(v string) predicate.Thread {
+	return predicate.Thread(sql.FieldLT(FieldSubject, v))
+}
+
+// SubjectLTE applies the LTE predicate on the "subject" field.
+func SubjectLTE(v string) predicate.Thread {
+	return predicate.Thread(sql.FieldLTE(FieldSubject, v))
+}
+
+// SubjectContains applies the Contains predicate on the "subject" field.
+func SubjectContains(v string) predicate.Thread {
+	return predicate.Thread(sql.FieldContains(FieldSubject, v))
+}
+
+// SubjectHasPrefix applies the HasPrefix predicate on the "subject" field.
+func SubjectHasPrefix(v string) predicate.Thread {
+	return predicate.Thread(sql.FieldHasPrefix(FieldSubject, v))
+}
+
+// SubjectHasSuffix applies the HasSuffix predicate on the "subject" field.
+func SubjectHasSuffix(v string) predicate.Thread {
+	return predicate.Thread(sql.FieldHasSuffix(FieldSubject, v))
+}
+
+// SubjectEqualFold applies the EqualFold predicate on the "subject" field.
+func SubjectEqualFold(v string) predicate.Thread {
+	return predicate.Thread(sql.FieldEqualFold(FieldSubject, v))
+}
+
+// SubjectContainsFold applies the ContainsFold predicate on the "subject" field.
+func SubjectContainsFold(v string) predicate.Thread {
+	return predicate.Thread(sql.FieldContainsFold(FieldSubject, v))
+}
+
+// NormalizedSubjectEQ applies the EQ predicate on the "normalized_subject" field.
+func NormalizedSubjectEQ(v string) predicate.Thread {
+	return predicate.Thread(sql.FieldEQ(FieldNormalizedSubject, v))
+}
+
+// NormalizedSubjectNEQ applies the NEQ predicate on the "normalized_subject" field.
+func NormalizedSubjectNEQ(v string) predicate.Thread {
+	return predicate.Thread(sql.FieldNEQ(FieldNormalizedSubject, v))
+}
+
+// NormalizedSubjectIn applies the In predicate on the "normalized_subject" field.
+func NormalizedSubjectIn(vs ...string) predicate.Thread {
+	return predicate.Thread(sql.FieldIn(FieldNormalizedSubject, vs...))
+}
+
+// NormalizedSubjectNotIn applies the NotIn predicate on the "normalized_subject" field.
+func NormalizedSubjectNotIn(vs ...string) predicate.Thread {
+	return predicate.Thread(sql.FieldNotIn(FieldNormalizedSubject, vs...))
+}
+
+// NormalizedSubjectGT applies the GT predicate on the "normalized_subject" field.
+func NormalizedSubjectGT(v string) predicate.Thread {
+	return predicate.Thread(sql.FieldGT(FieldNormalizedSubject, v))
+}
+
+// NormalizedSubjectGTE applies the GTE predicate on the "normalized_subject" field.
+func NormalizedSubjectGTE(v string) predicate.Thread {
+	return predicate.Thread(sql.FieldGTE(FieldNormalizedSubject, v))
+}
+
+// NormalizedSubjectLT applies the LT predicate on the "normalized_subject" field.
+func NormalizedSubjectLT(v string) predicate.Thread {
+	return predicate.Thread(sql.FieldLT(FieldNormalizedSubject, v))
+}
+
+// NormalizedSubjectLTE applies the LTE predicate on the "normalized_subject" field.
+func NormalizedSubjectLTE(v string) predicate.Thread {
+	return predicate.Thread(sql.FieldLTE(FieldNormalizedSubject, v))
+}
+
+// NormalizedSubjectContains applies the Contains predicate on the "normalized_subject" field.
+func NormalizedSubjectContains(v string) predicate.Thread {
+	return predicate.Thread(sql.FieldContains(FieldNormalizedSubject, v))
+}
+
+// NormalizedSubjectHasPrefix applies the HasPrefix predicate on the "normalized_subject" field.
+func NormalizedSubjectHasPrefix(v string) predicate.Thread {
+	return predicate.Thread(sql.FieldHasPrefix(FieldNormalizedSubject, v))
+}
+
+// NormalizedSubjectHasSuffix applies the HasSuffix predicate on the "normalized_subject" field.
+func NormalizedSubjectHasSuffix(v string) predicate.Thread {
+	return predicate.Thread(sql.FieldHasSuffix(FieldNormalizedSubject, v))
+}
+
+// NormalizedSubjectEqualFold applies the EqualFold predicate on the "normalized_subject" field.
+func NormalizedSubjectEqualFold(v string) predicate.Thread {
+	return predicate.Thread(sql.FieldEqualFold(FieldNormalizedSubject, v))
+}
+
+// NormalizedSubjectContainsFold applies the ContainsFold predicate on the "normalized_subject" field.
+func NormalizedSubjectContainsFold(v string) predicate.Thread {
+	return predicate.Thread(sql.FieldContainsFold(FieldNormalizedSubject, v))
+}
+
+// MessageCountEQ applies the EQ predicate on the "message_count" field.
+func MessageCountEQ(v int) predicate.Thread {
+	return predicate.Thread(sql.FieldEQ(FieldMessageCount, v))
+}
+
+// MessageCountNEQ applies the NEQ predicate on the "message_count" field.
+func MessageCountNEQ(v int) predicate.Thread {
+	return predicate.Thread(sql.FieldNEQ(FieldMessageCount, v))
+}
+
+// MessageCountIn applies the In predicate on the "message_count" field.
+func MessageCountIn(vs ...int) predicate.Thread {
+	return predicate.Thread(sql.FieldIn(FieldMessageCount, vs...))
+}
+
+// MessageCountNotIn applies the NotIn predicate on the "message_count" field.
+func MessageCountNotIn(vs ...int) predicate.Thread {
+	return predicate.Thread(sql.FieldNotIn(FieldMessageCount, vs...))
+}
+
+// MessageCountGT applies the GT predicate on the "message_count" field.
+func MessageCountGT(v int) predicate.Thread {
+	return predicate.Thread(sql.FieldGT(FieldMessageCount, v))
+}
+
+// MessageCountGTE applies the GTE predicate on the "message_count" field.
+func MessageCountGTE(v int) predicate.Thread {
+	return predicate.Thread(sql.FieldGTE(FieldMessageCount, v))
+}
+
+// MessageCountLT applies the LT predicate on the "message_count" field.
+func MessageCountLT(v int) predicate.Thread {
+	return predicate.Thread(sql.FieldLT(FieldMessageCount, v))
+}
+
+// MessageCountLTE applies the LTE predicate on the "message_count" field.
+func MessageCountLTE(v int) predicate.Thread {
+	return predicate.Thread(sql.FieldLTE(FieldMessageCount, v))
+}
+
+// ParticipantsIsNil applies the IsNil predicate on the "participants" field.
+func ParticipantsIsNil() predicate.Thread {
+	return predicate.Thread(sql.FieldIsNull(FieldParticipants))
+}
+
+// ParticipantsNotNil applies the NotNil predicate on the "participants" field.
+func ParticipantsNotNil() predicate.Thread {
+	return predicate.Thread(sql.FieldNotNull(FieldParticipants))
+}
+
+// FirstDateEQ applies the EQ predicate on the "first_date" field.
+func FirstDateEQ(v time.Time) predicate.Thread {
+	return predicate.Thread(sql.FieldEQ(FieldFirstDate, v))
+}
+
+// FirstDateNEQ applies the NEQ predicate on the "first_date" field.
+func FirstDateNEQ(v time.Time) predicate.Thread {
+	return predicate.Thread(sql.FieldNEQ(FieldFirstDate, v))
+}
+
+// FirstDateIn applies the In predicate on the "first_date" field.
+func FirstDateIn(vs ...time.Time) predicate.Thread {
+	return predicate.Thread(sql.FieldIn(FieldFirstDate, vs...))
+}
+
+// FirstDateNotIn applies the NotIn predicate on the "first_date" field.
+func FirstDateNotIn(vs ...time.Time) predicate.Thread {
+	return predicate.Thread(sql.FieldNotIn(FieldFirstDate, vs...))
+}
+
+// FirstDateGT applies the GT predicate on the "first_date" field.
+func FirstDateGT(v time.Time) predicate.Thread {
+	return predicate.Thread(sql.FieldGT(FieldFirstDate, v))
+}
+
+// FirstDateGTE applies the GTE predicate on the "first_date" field.
+func FirstDateGTE(v time.Time) predicate.Thread {
+	return predicate.Thread(sql.FieldGTE(FieldFirstDate, v))
+}
+
+// FirstDateLT applies the LT predicate on the "first_date" field.
+func FirstDateLT(v time.Time) predicate.Thread {
+	return predicate.Thread(sql.FieldLT(FieldFirstDate, v))
+}
+
+// FirstDateLTE applies the LTE predicate on the "first_date" field.
+func FirstDateLTE(v time.Time) predicate.Thread {
+	return predicate.Thread(sql.FieldLTE(FieldFirstDate, v))
+}
+
+// FirstDateIsNil applies the IsNil predicate on the "first_date" field.
+func FirstDateIsNil() predicate.Thread {
+	return predicate.Thread(sql.FieldIsNull(FieldFirstDate))
+}
+
+// FirstDateNotNil applies the NotNil predicate on the "first_date" field.
+func FirstDateNotNil() predicate.Thread {
+	return predicate.Thread(sql.FieldNotNull(FieldFirstDate))
+}
+
+// LastDateEQ applies the EQ predicate on the "last_date" field.
+func LastDateEQ(v time.Time) predicate.Thread {
+	return predicate.Thread(sql.FieldEQ(FieldLastDate, v))
+}
+
+// LastDateNEQ applies the NEQ predicate on the "last_date" field.
+func LastDateNEQ(v time.Time) predicate.Thread {
+	return predicate.Thread(sql.FieldNEQ(FieldLastDate, v))
+}
+
+// LastDateIn applies the In predicate on the "last_date" field.
+func LastDateIn(vs ...time.Time) predicate.Thread {
+	return predicate.Thread(sql.FieldIn(FieldLastDate, vs...))
+}
+
+// LastDateNotIn applies the NotIn predicate on the "last_date" field.
+func LastDateNotIn(vs ...time.Time) predicate.Thread {
+	return predicate.Thread(sql.FieldNotIn(FieldLastDate, vs...))
+}
+
+// LastDateGT applies the GT predicate on the "last_date" field.
+func LastDateGT(v time.Time) predicate.Thread {
+	return predicate.Thread(sql.FieldGT(FieldLastDate, v))
+}
+
+// LastDateGTE applies the GTE predicate on the "last_date" field.
+func LastDateGTE(v time.Time) predicate.Thread {
+	return predicate.Thread(sql.FieldGTE(FieldLastDate, v))
+}
+
+// LastDateLT applies the LT predicate on the "last_date" field.
+func LastDateLT(v time.Time) predicate.Thread {
+	return predicate.Thread(sql.FieldLT(FieldLastDate, v))
+}
+
+// LastDateLTE applies the LTE predicate on the "last_date" field.
+func LastDateLTE(v time.Time) predicate.Thread {
+	return predicate.Thread(sql.FieldLTE(FieldLastDate, v))
+}
+
+// LastDateIsNil applies the IsNil predicate on the "last_date" field.
+func LastDateIsNil() predicate.Thread {
+	return predicate.Thread(sql.FieldIsNull(FieldLastDate))
+}
+
+// LastDateNotNil applies the NotNil predicate on the "last_date" field.
+func LastDateNotNil() predicate.Thread {
+	return predicate.Thread(sql.FieldNotNull(FieldLastDate))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Thread {
+	return predicate.Thread(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Thread {
+	return predicate.Thread(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Thread {
+	return predicate.Thread(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Thread {
+	return predicate.Thread(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Thread {
+	return predicate.Thread(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Thread {
+	return predicate.Thread(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Thread {
+	return predicate.Thread(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Thread {
+	return predicate.Thread(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Thread) predicate.Thread {
+	return predicate.Thread(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Thread) predicate.Thread {
+	return predicate.Thread(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Thread) predicate.Thread {
+	return predicate.Thread(sql.NotPredicates(p))
+}
@@ -0,0 +1,341 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Blogem/enron-graph/ent/thread"
+)
+
+// ThreadCreate is the builder for creating a Thread entity.
+type ThreadCreate struct {
+	config
+	mutation *ThreadMutation
+	hooks    []Hook
+}
+
+// SetRootMessageID sets the "root_message_id" field.
+func (_c *ThreadCreate) SetRootMessageID(v string) *ThreadCreate {
+	_c.mutation.SetRootMessageID(v)
+	return _c
+}
+
+// SetSubject sets the "subject" field.
+func (_c *ThreadCreate) SetSubject(v string) *ThreadCreate {
+	_c.mutation.SetSubject(v)
+	return _c
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (_c *ThreadCreate) SetNillableSubject(v *string) *ThreadCreate {
+	if v != nil {
+		_c.SetSubject(*v)
+	}
+	return _c
+}
+
+// SetNormalizedSubject sets the "normalized_subject" field.
+func (_c *ThreadCreate) SetNormalizedSubject(v string) *ThreadCreate {
+	_c.mutation.SetNormalizedSubject(v)
+	return _c
+}
+
+// SetNillableNormalizedSubject sets the "normalized_subject" field if the given value is not nil.
+func (_c *ThreadCreate) SetNillableNormalizedSubject(v *string) *ThreadCreate {
+	if v != nil {
+		_c.SetNormalizedSubject(*v)
+	}
+	return _c
+}
+
+// SetMessageCount sets the "message_count" field.
+func (_c *ThreadCreate) SetMessageCount(v int) *ThreadCreate {
+	_c.mutation.SetMessageCount(v)
+	return _c
+}
+
+// SetNillableMessageCount sets the "message_count" field if the given value is not nil.
+func (_c *ThreadCreate) SetNillableMessageCount(v *int) *ThreadCreate {
+	if v != nil {
+		_c.SetMessageCount(*v)
+	}
+	return _c
+}
+
+// SetParticipants sets the "participants" field.
+func (_c *ThreadCreate) SetParticipants(v []string) *ThreadCreate {
+	_c.mutation.SetParticipants(v)
+	return _c
+}
+
+// SetFirstDate sets the "first_date" field.
+func (_c *ThreadCreate) SetFirstDate(v time.Time) *ThreadCreate {
+	_c.mutation.SetFirstDate(v)
+	return _c
+}
+
+// SetNillableFirstDate sets the "first_date" field if the given value is not nil.
+func (_c *ThreadCreate) SetNillableFirstDate(v *time.Time) *ThreadCreate {
+	if v != nil {
+		_c.SetFirstDate(*v)
+	}
+	return _c
+}
+
+// SetLastDate sets the "last_date" field.
+func (_c *ThreadCreate) SetLastDate(v time.Time) *ThreadCreate {
+	_c.mutation.SetLastDate(v)
+	return _c
+}
+
+// SetNillableLastDate sets the "last_date" field if the given value is not nil.
+func (_c *ThreadCreate) SetNillableLastDate(v *time.Time) *ThreadCreate {
+	if v != nil {
+		_c.SetLastDate(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ThreadCreate) SetCreatedAt(v time.Time) *ThreadCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ThreadCreate) SetNillableCreatedAt(v *time.Time) *ThreadCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ThreadMutation object of the builder.
+func (_c *ThreadCreate) Mutation() *ThreadMutation {
+	return _c.mutation
+}
+
+// Save creates the Thread in the database.
+func (_c *ThreadCreate) Save(ctx context.Context) (*Thread, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ThreadCreate) SaveX(ctx context.Context) *Thread {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ThreadCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ThreadCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ThreadCreate) defaults() {
+	if _, ok := _c.mutation.Subject(); !ok {
+		v := thread.DefaultSubject
+		_c.mutation.SetSubject(v)
+	}
+	if _, ok := _c.mutation.NormalizedSubject(); !ok {
+		v := thread.DefaultNormalizedSubject
+		_c.mutation.SetNormalizedSubject(v)
+	}
+	if _, ok := _c.mutation.MessageCount(); !ok {
+		v := thread.DefaultMessageCount
+		_c.mutation.SetMessageCount(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := thread.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ThreadCreate) check() error {
+	if _, ok := _c.mutation.RootMessageID(); !ok {
+		return &ValidationError{Name: "root_message_id", err: errors.New(`ent: missing required field "Thread.root_message_id"`)}
+	}
+	if v, ok := _c.mutation.RootMessageID(); ok {
+		if err := thread.RootMessageIDValidator(v); err != nil {
+			return &ValidationError{Name: "root_message_id", err: fmt.Errorf(`ent: validator failed for field "Thread.root_message_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Subject(); !ok {
+		return &ValidationError{Name: "subject", err: errors.New(`ent: missing required field "Thread.subject"`)}
+	}
+	if _, ok := _c.mutation.NormalizedSubject(); !ok {
+		return &ValidationError{Name: "normalized_subject", err: errors.New(`ent: missing required field "Thread.normalized_subject"`)}
+	}
+	if _, ok := _c.mutation.MessageCount(); !ok {
+		return &ValidationError{Name: "message_count", err: errors.New(`ent: missing required field "Thread.message_count"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Thread.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *ThreadCreate) sqlSave(ctx context.Context) (*Thread, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ThreadCreate) createSpec() (*Thread, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Thread{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(thread.Table, sqlgraph.NewFieldSpec(thread.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.RootMessageID(); ok {
+		_spec.SetField(thread.FieldRootMessageID, field.TypeString, value)
+		_node.RootMessageID = value
+	}
+	if value, ok := _c.mutation.Subject(); ok {
+		_spec.SetField(thread.FieldSubject, field.TypeString, value)
+		_node.Subject = value
+	}
+	if value, ok := _c.mutation.NormalizedSubject(); ok {
+		_spec.SetField(thread.FieldNormalizedSubject, field.TypeString, value)
+		_node.NormalizedSubject = value
+	}
+	if value, ok := _c.mutation.MessageCount(); ok {
+		_spec.SetField(thread.FieldMessageCount, field.TypeInt, value)
+		_node.MessageCount = value
+	}
+	if value, ok := _c.mutation.Participants(); ok {
+		_spec.SetField(thread.FieldParticipants, field.TypeJSON, value)
+		_node.Participants = value
+	}
+	if value, ok := _c.mutation.FirstDate(); ok {
+		_spec.SetField(thread.FieldFirstDate, field.TypeTime, value)
+		_node.FirstDate = value
+	}
+	if value, ok := _c.mutation.LastDate(); ok {
+		_spec.SetField(thread.FieldLastDate, field.TypeTime, value)
+		_node.LastDate = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(thread.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// ThreadCreateBulk is the builder for creating many Thread entities in bulk.
+type ThreadCreateBulk struct {
+	config
+	err      error
+	builders []*ThreadCreate
+}
+
+// Save creates the Thread entities in the database.
+func (_c *ThreadCreateBulk) Save(ctx context.Context) ([]*Thread, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Thread, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ThreadMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ThreadCreateBulk) SaveX(ctx context.Context) []*Thread {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ThreadCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ThreadCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
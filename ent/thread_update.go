@@ -0,0 +1,521 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/Blogem/enron-graph/ent/predicate"
+	"github.com/Blogem/enron-graph/ent/thread"
+)
+
+// ThreadUpdate is the builder for updating Thread entities.
+type ThreadUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ThreadMutation
+}
+
+// Where appends a list predicates to the ThreadUpdate builder.
+func (_u *ThreadUpdate) Where(ps ...predicate.Thread) *ThreadUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetRootMessageID sets the "root_message_id" field.
+func (_u *ThreadUpdate) SetRootMessageID(v string) *ThreadUpdate {
+	_u.mutation.SetRootMessageID(v)
+	return _u
+}
+
+// SetNillableRootMessageID sets the "root_message_id" field if the given value is not nil.
+func (_u *ThreadUpdate) SetNillableRootMessageID(v *string) *ThreadUpdate {
+	if v != nil {
+		_u.SetRootMessageID(*v)
+	}
+	return _u
+}
+
+// SetSubject sets the "subject" field.
+func (_u *ThreadUpdate) SetSubject(v string) *ThreadUpdate {
+	_u.mutation.SetSubject(v)
+	return _u
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (_u *ThreadUpdate) SetNillableSubject(v *string) *ThreadUpdate {
+	if v != nil {
+		_u.SetSubject(*v)
+	}
+	return _u
+}
+
+// SetNormalizedSubject sets the "normalized_subject" field.
+func (_u *ThreadUpdate) SetNormalizedSubject(v string) *ThreadUpdate {
+	_u.mutation.SetNormalizedSubject(v)
+	return _u
+}
+
+// SetNillableNormalizedSubject sets the "normalized_subject" field if the given value is not nil.
+func (_u *ThreadUpdate) SetNillableNormalizedSubject(v *string) *ThreadUpdate {
+	if v != nil {
+		_u.SetNormalizedSubject(*v)
+	}
+	return _u
+}
+
+// SetMessageCount sets the "message_count" field.
+func (_u *ThreadUpdate) SetMessageCount(v int) *ThreadUpdate {
+	_u.mutation.ResetMessageCount()
+	_u.mutation.SetMessageCount(v)
+	return _u
+}
+
+// SetNillableMessageCount sets the "message_count" field if the given value is not nil.
+func (_u *ThreadUpdate) SetNillableMessageCount(v *int) *ThreadUpdate {
+	if v != nil {
+		_u.SetMessageCount(*v)
+	}
+	return _u
+}
+
+// AddMessageCount adds value to the "message_count" field.
+func (_u *ThreadUpdate) AddMessageCount(v int) *ThreadUpdate {
+	_u.mutation.AddMessageCount(v)
+	return _u
+}
+
+// SetParticipants sets the "participants" field.
+func (_u *ThreadUpdate) SetParticipants(v []string) *ThreadUpdate {
+	_u.mutation.SetParticipants(v)
+	return _u
+}
+
+// AppendParticipants appends value to the "participants" field.
+func (_u *ThreadUpdate) AppendParticipants(v []string) *ThreadUpdate {
+	_u.mutation.AppendParticipants(v)
+	return _u
+}
+
+// ClearParticipants clears the value of the "participants" field.
+func (_u *ThreadUpdate) ClearParticipants() *ThreadUpdate {
+	_u.mutation.ClearParticipants()
+	return _u
+}
+
+// SetFirstDate sets the "first_date" field.
+func (_u *ThreadUpdate) SetFirstDate(v time.Time) *ThreadUpdate {
+	_u.mutation.SetFirstDate(v)
+	return _u
+}
+
+// SetNillableFirstDate sets the "first_date" field if the given value is not nil.
+func (_u *ThreadUpdate) SetNillableFirstDate(v *time.Time) *ThreadUpdate {
+	if v != nil {
+		_u.SetFirstDate(*v)
+	}
+	return _u
+}
+
+// ClearFirstDate clears the value of the "first_date" field.
+func (_u *ThreadUpdate) ClearFirstDate() *ThreadUpdate {
+	_u.mutation.ClearFirstDate()
+	return _u
+}
+
+// SetLastDate sets the "last_date" field.
+func (_u *ThreadUpdate) SetLastDate(v time.Time) *ThreadUpdate {
+	_u.mutation.SetLastDate(v)
+	return _u
+}
+
+// SetNillableLastDate sets the "last_date" field if the given value is not nil.
+func (_u *ThreadUpdate) SetNillableLastDate(v *time.Time) *ThreadUpdate {
+	if v != nil {
+		_u.SetLastDate(*v)
+	}
+	return _u
+}
+
+// ClearLastDate clears the value of the "last_date" field.
+func (_u *ThreadUpdate) ClearLastDate() *ThreadUpdate {
+	_u.mutation.ClearLastDate()
+	return _u
+}
+
+// Mutation returns the ThreadMutation object of the builder.
+func (_u *ThreadUpdate) Mutation() *ThreadMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ThreadUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ThreadUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ThreadUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ThreadUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ThreadUpdate) check() error {
+	if v, ok := _u.mutation.RootMessageID(); ok {
+		if err := thread.RootMessageIDValidator(v); err != nil {
+			return &ValidationError{Name: "root_message_id", err: fmt.Errorf(`ent: validator failed for field "Thread.root_message_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ThreadUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(thread.Table, thread.Columns, sqlgraph.NewFieldSpec(thread.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.RootMessageID(); ok {
+		_spec.SetField(thread.FieldRootMessageID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Subject(); ok {
+		_spec.SetField(thread.FieldSubject, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.NormalizedSubject(); ok {
+		_spec.SetField(thread.FieldNormalizedSubject, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MessageCount(); ok {
+		_spec.SetField(thread.FieldMessageCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMessageCount(); ok {
+		_spec.AddField(thread.FieldMessageCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Participants(); ok {
+		_spec.SetField(thread.FieldParticipants, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedParticipants(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, thread.FieldParticipants, value)
+		})
+	}
+	if _u.mutation.ParticipantsCleared() {
+		_spec.ClearField(thread.FieldParticipants, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.FirstDate(); ok {
+		_spec.SetField(thread.FieldFirstDate, field.TypeTime, value)
+	}
+	if _u.mutation.FirstDateCleared() {
+		_spec.ClearField(thread.FieldFirstDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastDate(); ok {
+		_spec.SetField(thread.FieldLastDate, field.TypeTime, value)
+	}
+	if _u.mutation.LastDateCleared() {
+		_spec.ClearField(thread.FieldLastDate, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{thread.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ThreadUpdateOne is the builder for updating a single Thread entity.
+type ThreadUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ThreadMutation
+}
+
+// SetRootMessageID sets the "root_message_id" field.
+func (_u *ThreadUpdateOne) SetRootMessageID(v string) *ThreadUpdateOne {
+	_u.mutation.SetRootMessageID(v)
+	return _u
+}
+
+// SetNillableRootMessageID sets the "root_message_id" field if the given value is not nil.
+func (_u *ThreadUpdateOne) SetNillableRootMessageID(v *string) *ThreadUpdateOne {
+	if v != nil {
+		_u.SetRootMessageID(*v)
+	}
+	return _u
+}
+
+// SetSubject sets the "subject" field.
+func (_u *ThreadUpdateOne) SetSubject(v string) *ThreadUpdateOne {
+	_u.mutation.SetSubject(v)
+	return _u
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (_u *ThreadUpdateOne) SetNillableSubject(v *string) *ThreadUpdateOne {
+	if v != nil {
+		_u.SetSubject(*v)
+	}
+	return _u
+}
+
+// SetNormalizedSubject sets the "normalized_subject" field.
+func (_u *ThreadUpdateOne) SetNormalizedSubject(v string) *ThreadUpdateOne {
+	_u.mutation.SetNormalizedSubject(v)
+	return _u
+}
+
+// SetNillableNormalizedSubject sets the "normalized_subject" field if the given value is not nil.
+func (_u *ThreadUpdateOne) SetNillableNormalizedSubject(v *string) *ThreadUpdateOne {
+	if v != nil {
+		_u.SetNormalizedSubject(*v)
+	}
+	return _u
+}
+
+// SetMessageCount sets the "message_count" field.
+func (_u *ThreadUpdateOne) SetMessageCount(v int) *ThreadUpdateOne {
+	_u.mutation.ResetMessageCount()
+	_u.mutation.SetMessageCount(v)
+	return _u
+}
+
+// SetNillableMessageCount sets the "message_count" field if the given value is not nil.
+func (_u *ThreadUpdateOne) SetNillableMessageCount(v *int) *ThreadUpdateOne {
+	if v != nil {
+		_u.SetMessageCount(*v)
+	}
+	return _u
+}
+
+// AddMessageCount adds value to the "message_count" field.
+func (_u *ThreadUpdateOne) AddMessageCount(v int) *ThreadUpdateOne {
+	_u.mutation.AddMessageCount(v)
+	return _u
+}
+
+// SetParticipants sets the "participants" field.
+func (_u *ThreadUpdateOne) SetParticipants(v []string) *ThreadUpdateOne {
+	_u.mutation.SetParticipants(v)
+	return _u
+}
+
+// AppendParticipants appends value to the "participants" field.
+func (_u *ThreadUpdateOne) AppendParticipants(v []string) *ThreadUpdateOne {
+	_u.mutation.AppendParticipants(v)
+	return _u
+}
+
+// ClearParticipants clears the value of the "participants" field.
+func (_u *ThreadUpdateOne) ClearParticipants() *ThreadUpdateOne {
+	_u.mutation.ClearParticipants()
+	return _u
+}
+
+// SetFirstDate sets the "first_date" field.
+func (_u *ThreadUpdateOne) SetFirstDate(v time.Time) *ThreadUpdateOne {
+	_u.mutation.SetFirstDate(v)
+	return _u
+}
+
+// SetNillableFirstDate sets the "first_date" field if the given value is not nil.
+func (_u *ThreadUpdateOne) SetNillableFirstDate(v *time.Time) *ThreadUpdateOne {
+	if v != nil {
+		_u.SetFirstDate(*v)
+	}
+	return _u
+}
+
+// ClearFirstDate clears the value of the "first_date" field.
+func (_u *ThreadUpdateOne) ClearFirstDate() *ThreadUpdateOne {
+	_u.mutation.ClearFirstDate()
+	return _u
+}
+
+// SetLastDate sets the "last_date" field.
+func (_u *ThreadUpdateOne) SetLastDate(v time.Time) *ThreadUpdateOne {
+	_u.mutation.SetLastDate(v)
+	return _u
+}
+
+// SetNillableLastDate sets the "last_date" field if the given value is not nil.
+func (_u *ThreadUpdateOne) SetNillableLastDate(v *time.Time) *ThreadUpdateOne {
+	if v != nil {
+		_u.SetLastDate(*v)
+	}
+	return _u
+}
+
+// ClearLastDate clears the value of the "last_date" field.
+func (_u *ThreadUpdateOne) ClearLastDate() *ThreadUpdateOne {
+	_u.mutation.ClearLastDate()
+	return _u
+}
+
+// Mutation returns the ThreadMutation object of the builder.
+func (_u *ThreadUpdateOne) Mutation() *ThreadMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ThreadUpdate builder.
+func (_u *ThreadUpdateOne) Where(ps ...predicate.Thread) *ThreadUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ThreadUpdateOne) Select(field string, fields ...string) *ThreadUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Thread entity.
+func (_u *ThreadUpdateOne) Save(ctx context.Context) (*Thread, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ThreadUpdateOne) SaveX(ctx context.Context) *Thread {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ThreadUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ThreadUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ThreadUpdateOne) check() error {
+	if v, ok := _u.mutation.RootMessageID(); ok {
+		if err := thread.RootMessageIDValidator(v); err != nil {
+			return &ValidationError{Name: "root_message_id", err: fmt.Errorf(`ent: validator failed for field "Thread.root_message_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ThreadUpdateOne) sqlSave(ctx context.Context) (_node *Thread, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(thread.Table, thread.Columns, sqlgraph.NewFieldSpec(thread.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Thread.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, thread.FieldID)
+		for _, f := range fields {
+			if !thread.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != thread.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.RootMessageID(); ok {
+		_spec.SetField(thread.FieldRootMessageID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Subject(); ok {
+		_spec.SetField(thread.FieldSubject, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.NormalizedSubject(); ok {
+		_spec.SetField(thread.FieldNormalizedSubject, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MessageCount(); ok {
+		_spec.SetField(thread.FieldMessageCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMessageCount(); ok {
+		_spec.AddField(thread.FieldMessageCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Participants(); ok {
+		_spec.SetField(thread.FieldParticipants, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedParticipants(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, thread.FieldParticipants, value)
+		})
+	}
+	if _u.mutation.ParticipantsCleared() {
+		_spec.ClearField(thread.FieldParticipants, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.FirstDate(); ok {
+		_spec.SetField(thread.FieldFirstDate, field.TypeTime, value)
+	}
+	if _u.mutation.FirstDateCleared() {
+		_spec.ClearField(thread.FieldFirstDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastDate(); ok {
+		_spec.SetField(thread.FieldLastDate, field.TypeTime, value)
+	}
+	if _u.mutation.LastDateCleared() {
+		_spec.ClearField(thread.FieldLastDate, field.TypeTime)
+	}
+	_node = &Thread{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{thread.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -12,14 +12,28 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// CentralityScore is the client for interacting with the CentralityScore builders.
+	CentralityScore *CentralityScoreClient
+	// Community is the client for interacting with the Community builders.
+	Community *CommunityClient
 	// DiscoveredEntity is the client for interacting with the DiscoveredEntity builders.
 	DiscoveredEntity *DiscoveredEntityClient
 	// Email is the client for interacting with the Email builders.
 	Email *EmailClient
+	// EntityAlias is the client for interacting with the EntityAlias builders.
+	EntityAlias *EntityAliasClient
+	// EntityAudit is the client for interacting with the EntityAudit builders.
+	EntityAudit *EntityAuditClient
+	// ExtractionJob is the client for interacting with the ExtractionJob builders.
+	ExtractionJob *ExtractionJobClient
+	// Provenance is the client for interacting with the Provenance builders.
+	Provenance *ProvenanceClient
 	// Relationship is the client for interacting with the Relationship builders.
 	Relationship *RelationshipClient
 	// SchemaPromotion is the client for interacting with the SchemaPromotion builders.
 	SchemaPromotion *SchemaPromotionClient
+	// Thread is the client for interacting with the Thread builders.
+	Thread *ThreadClient
 
 	// lazily loaded.
 	client     *Client
@@ -151,10 +165,17 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.CentralityScore = NewCentralityScoreClient(tx.config)
+	tx.Community = NewCommunityClient(tx.config)
 	tx.DiscoveredEntity = NewDiscoveredEntityClient(tx.config)
 	tx.Email = NewEmailClient(tx.config)
+	tx.EntityAlias = NewEntityAliasClient(tx.config)
+	tx.EntityAudit = NewEntityAuditClient(tx.config)
+	tx.ExtractionJob = NewExtractionJobClient(tx.config)
+	tx.Provenance = NewProvenanceClient(tx.config)
 	tx.Relationship = NewRelationshipClient(tx.config)
 	tx.SchemaPromotion = NewSchemaPromotionClient(tx.config)
+	tx.Thread = NewThreadClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -164,7 +185,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: DiscoveredEntity.QueryXXX(), the query will be executed
+// applies a query, for example: CentralityScore.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
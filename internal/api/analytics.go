@@ -0,0 +1,483 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/Blogem/enron-graph/ent"
+	"github.com/Blogem/enron-graph/internal/graph/analytics"
+	"github.com/Blogem/enron-graph/pkg/utils"
+)
+
+// CentralityRankingResponse represents the entities ranked highest by a centrality metric
+type CentralityRankingResponse struct {
+	Scope    string                   `json:"scope"`
+	Metric   string                   `json:"metric"`
+	Entities []analytics.RankedEntity `json:"entities"`
+}
+
+// CentralityRequest represents a request to compute centrality over a filtered graph
+type CentralityRequest struct {
+	Types   []string `json:"types,omitempty"`
+	Since   string   `json:"since,omitempty"`
+	Until   string   `json:"until,omitempty"`
+	Samples int      `json:"samples,omitempty"` // Sources betweenness and closeness are estimated from, 0 for exact
+}
+
+// CentralityRunResponse represents the result of a centrality computation
+type CentralityRunResponse struct {
+	Scope         string                   `json:"scope"`
+	Entities      int                      `json:"entities"`
+	Relationships int                      `json:"relationships"`
+	ComputedAt    string                   `json:"computed_at"`
+	DurationMS    int64                    `json:"duration_ms"`
+	Top           []analytics.RankedEntity `json:"top"` // Highest PageRank
+}
+
+// EntityCentralityResponse represents the centrality of one entity
+type EntityCentralityResponse struct {
+	EntityID int               `json:"entity_id"`
+	Scope    string            `json:"scope"`
+	Scores   *analytics.Scores `json:"scores"` // Null when the entity has no relationships in the scope
+}
+
+// GetCentralityRanking handles GET /analytics/centrality
+func (h *Handler) GetCentralityRanking(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	filter, err := analytics.ParseFilter(query.Get("types"), query.Get("since"), query.Get("until"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid query parameter", err.Error())
+		return
+	}
+	metric := query.Get("metric")
+	if metric == "" {
+		metric = analytics.MetricPageRank
+	}
+	if err := analytics.ValidateMetric(metric); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid query parameter", err.Error())
+		return
+	}
+	limit, ok := limitParam(w, r, 20)
+	if !ok {
+		return
+	}
+
+	client := h.repo.GetClient()
+	if client == nil {
+		respondError(w, http.StatusServiceUnavailable, "graph analytics not available", "")
+		return
+	}
+	ranked, err := analytics.TopEntities(r.Context(), client, filter.Scope(), metric, limit)
+	if err != nil {
+		respondError(w, http.StatusInternalServerError, "failed to rank entities", err.Error())
+		return
+	}
+
+	respondJSON(w, http.StatusOK, CentralityRankingResponse{
+		Scope:    filter.Scope(),
+		Metric:   metric,
+		Entities: ranked,
+	})
+}
+
+// ComputeCentrality handles POST /analytics/centrality. Exact betweenness and closeness can take
+// far longer than a request may, so the scores are computed in the background; the response is
+// the run, whose result is served by GET /analytics/runs/:id once it is done.
+func (h *Handler) ComputeCentrality(w http.ResponseWriter, r *http.Request) {
+	var req CentralityRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request", "failed to parse request body")
+		return
+	}
+	filter, err := analytics.ParseFilter("", req.Since, req.Until)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request", err.Error())
+		return
+	}
+	filter.Types = req.Types
+	if req.Samples < 0 {
+		respondError(w, http.StatusBadRequest, "invalid request", "samples must be non-negative")
+		return
+	}
+
+	client := h.repo.GetClient()
+	if client == nil {
+		respondError(w, http.StatusServiceUnavailable, "graph analytics not available", "")
+		return
+	}
+	h.startAnalyticsRun(w, runCentrality, func(ctx context.Context) (interface{}, error) {
+		result, err := analytics.Run(ctx, client, filter, analytics.Options{Samples: req.Samples}, utils.Logger)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compute centrality: %w", err)
+		}
+		top, err := analytics.TopEntities(ctx, client, result.Scope, analytics.MetricPageRank, 20)
+		if err != nil {
+			return nil, fmt.Errorf("failed to rank entities: %w", err)
+		}
+		return CentralityRunResponse{
+			Scope:         result.Scope,
+			Entities:      result.Entities,
+			Relationships: result.Relationships,
+			ComputedAt:    result.ComputedAt.Format(time.RFC3339),
+			DurationMS:    result.Duration.Milliseconds(),
+			Top:           top,
+		}, nil
+	})
+}
+
+// GetEntityCentrality handles GET /entities/:id/centrality
+func (h *Handler) GetEntityCentrality(w http.ResponseWriter, r *http.Request) {
+	id, err := entityIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid entity id", "")
+		return
+	}
+	query := r.URL.Query()
+	filter, err := analytics.ParseFilter(query.Get("types"), query.Get("since"), query.Get("until"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid query parameter", err.Error())
+		return
+	}
+	if !h.entityExists(w, r, id) {
+		return
+	}
+
+	client := h.repo.GetClient()
+	if client == nil {
+		respondError(w, http.StatusServiceUnavailable, "graph analytics not available", "")
+		return
+	}
+	scores, err := analytics.FindScores(r.Context(), client, filter.Scope(), []int{id})
+	if err != nil {
+		respondError(w, http.StatusInternalServerError, "failed to fetch centrality", err.Error())
+		return
+	}
+
+	response := EntityCentralityResponse{EntityID: id, Scope: filter.Scope()}
+	if s, ok := scores[id]; ok {
+		response.Scores = &s
+	}
+	respondJSON(w, http.StatusOK, response)
+}
+
+// CommunitiesResponse represents the detected communities, largest first
+type CommunitiesResponse struct {
+	Communities []analytics.CommunitySummary `json:"communities"`
+}
+
+// CommunityRequest represents a request to detect communities
+type CommunityRequest struct {
+	Resolution float64 `json:"resolution,omitempty"` // Higher values give more, smaller communities; 1 when 0
+	Seed       int64   `json:"seed,omitempty"`
+}
+
+// CommunityRunResponse represents the result of community detection
+type CommunityRunResponse struct {
+	Communities   int                          `json:"communities"`
+	Entities      int                          `json:"entities"`
+	Relationships int                          `json:"relationships"`
+	Modularity    float64                      `json:"modularity"`
+	ComputedAt    string                       `json:"computed_at"`
+	DurationMS    int64                        `json:"duration_ms"`
+	Largest       []analytics.CommunitySummary `json:"largest"`
+}
+
+// CommunityResponse represents a community and its members
+type CommunityResponse struct {
+	analytics.CommunitySummary
+	Members []analytics.CommunityMember `json:"members"`
+}
+
+// EntityCommunityResponse represents the community of one entity
+type EntityCommunityResponse struct {
+	EntityID  int                         `json:"entity_id"`
+	Community *analytics.CommunitySummary `json:"community"` // Null when the entity has no community
+}
+
+// ListCommunities handles GET /analytics/communities
+func (h *Handler) ListCommunities(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	minSize := 2
+	if minSizeStr := query.Get("min_size"); minSizeStr != "" {
+		var err error
+		minSize, err = strconv.Atoi(minSizeStr)
+		if err != nil || minSize < 1 {
+			respondError(w, http.StatusBadRequest, "invalid query parameter", "min_size must be a positive integer")
+			return
+		}
+	}
+	limit, ok := limitParam(w, r, 50)
+	if !ok {
+		return
+	}
+
+	client := h.repo.GetClient()
+	if client == nil {
+		respondError(w, http.StatusServiceUnavailable, "graph analytics not available", "")
+		return
+	}
+	communities, err := analytics.ListCommunities(r.Context(), client, minSize, limit)
+	if err != nil {
+		respondError(w, http.StatusInternalServerError, "failed to list communities", err.Error())
+		return
+	}
+
+	respondJSON(w, http.StatusOK, CommunitiesResponse{Communities: communities})
+}
+
+// DetectCommunities handles POST /analytics/communities. Like ComputeCentrality, it detects
+// the communities in the background and responds with the run.
+func (h *Handler) DetectCommunities(w http.ResponseWriter, r *http.Request) {
+	var req CommunityRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request", "failed to parse request body")
+		return
+	}
+	if req.Resolution < 0 {
+		respondError(w, http.StatusBadRequest, "invalid request", "resolution must be non-negative")
+		return
+	}
+
+	client := h.repo.GetClient()
+	if client == nil {
+		respondError(w, http.StatusServiceUnavailable, "graph analytics not available", "")
+		return
+	}
+	opts := analytics.CommunityOptions{Resolution: req.Resolution, Seed: req.Seed}
+	h.startAnalyticsRun(w, runCommunities, func(ctx context.Context) (interface{}, error) {
+		result, err := analytics.DetectCommunities(ctx, client, opts, utils.Logger)
+		if err != nil {
+			return nil, fmt.Errorf("failed to detect communities: %w", err)
+		}
+		largest, err := analytics.ListCommunities(ctx, client, 1, 10)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list communities: %w", err)
+		}
+		return CommunityRunResponse{
+			Communities:   result.Communities,
+			Entities:      result.Entities,
+			Relationships: result.Relationships,
+			Modularity:    result.Modularity,
+			ComputedAt:    result.ComputedAt.Format(time.RFC3339),
+			DurationMS:    result.Duration.Milliseconds(),
+			Largest:       largest,
+		}, nil
+	})
+}
+
+// GetCommunity handles GET /communities/:id
+func (h *Handler) GetCommunity(w http.ResponseWriter, r *http.Request) {
+	id, err := pathIDParam(r, "communities")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid community id", "")
+		return
+	}
+	limit, ok := limitParam(w, r, 100)
+	if !ok {
+		return
+	}
+
+	client := h.repo.GetClient()
+	if client == nil {
+		respondError(w, http.StatusServiceUnavailable, "graph analytics not available", "")
+		return
+	}
+	community, err := analytics.GetCommunity(r.Context(), client, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			respondError(w, http.StatusNotFound, "community not found", "")
+			return
+		}
+		respondError(w, http.StatusInternalServerError, "failed to fetch community", err.Error())
+		return
+	}
+	members, err := analytics.CommunityMembers(r.Context(), client, id, limit)
+	if err != nil {
+		respondError(w, http.StatusInternalServerError, "failed to fetch community members", err.Error())
+		return
+	}
+
+	respondJSON(w, http.StatusOK, CommunityResponse{CommunitySummary: *community, Members: members})
+}
+
+// GetEntityCommunity handles GET /entities/:id/community
+func (h *Handler) GetEntityCommunity(w http.ResponseWriter, r *http.Request) {
+	id, err := entityIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid entity id", "")
+		return
+	}
+	if !h.entityExists(w, r, id) {
+		return
+	}
+
+	client := h.repo.GetClient()
+	if client == nil {
+		respondError(w, http.StatusServiceUnavailable, "graph analytics not available", "")
+		return
+	}
+	assigned, err := analytics.FindCommunities(r.Context(), client, []int{id})
+	if err != nil {
+		respondError(w, http.StatusInternalServerError, "failed to fetch community", err.Error())
+		return
+	}
+
+	response := EntityCommunityResponse{EntityID: id}
+	if communityID, ok := assigned[id]; ok {
+		community, err := analytics.GetCommunity(r.Context(), client, communityID)
+		if err != nil {
+			respondError(w, http.StatusInternalServerError, "failed to fetch community", err.Error())
+			return
+		}
+		response.Community = community
+	}
+	respondJSON(w, http.StatusOK, response)
+}
+
+// Analytics run kinds and statuses
+const (
+	runCentrality  = "centrality"
+	runCommunities = "communities"
+
+	runRunning = "running"
+	runDone    = "done"
+	runFailed  = "failed"
+)
+
+// maxAnalyticsRuns bounds the finished runs kept for GET /analytics/runs/:id
+const maxAnalyticsRuns = 100
+
+// AnalyticsRunResponse represents a centrality or community detection run in the background
+type AnalyticsRunResponse struct {
+	ID         int         `json:"id"`
+	Kind       string      `json:"kind"`   // "centrality" or "communities"
+	Status     string      `json:"status"` // "running", "done" or "failed"
+	StartedAt  string      `json:"started_at"`
+	FinishedAt string      `json:"finished_at,omitempty"`
+	Error      string      `json:"error,omitempty"`
+	Result     interface{} `json:"result,omitempty"` // CentralityRunResponse or CommunityRunResponse once done
+}
+
+// analyticsRuns tracks the analytics runs of a handler. Only one run of each kind runs at a
+// time, since a run replaces the stored results of the previous one.
+type analyticsRuns struct {
+	mu    sync.Mutex
+	next  int
+	runs  map[int]*AnalyticsRunResponse
+	order []int // Run IDs, oldest first
+}
+
+// start runs fn in the background as a new run of kind. When a run of kind is already running,
+// it returns that run and false instead.
+func (a *analyticsRuns) start(kind string, fn func(ctx context.Context) (interface{}, error)) (AnalyticsRunResponse, bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.runs == nil {
+		a.runs = map[int]*AnalyticsRunResponse{}
+	}
+	for _, run := range a.runs {
+		if run.Kind == kind && run.Status == runRunning {
+			return *run, false
+		}
+	}
+	a.prune()
+
+	a.next++
+	run := &AnalyticsRunResponse{
+		ID:        a.next,
+		Kind:      kind,
+		Status:    runRunning,
+		StartedAt: time.Now().Format(time.RFC3339),
+	}
+	a.runs[run.ID] = run
+	a.order = append(a.order, run.ID)
+
+	go func() {
+		// The run outlives the request that started it
+		result, err := fn(context.Background())
+
+		a.mu.Lock()
+		defer a.mu.Unlock()
+		run.FinishedAt = time.Now().Format(time.RFC3339)
+		if err != nil {
+			utils.Error("Analytics run failed", "id", run.ID, "kind", run.Kind, "error", err)
+			run.Status = runFailed
+			run.Error = err.Error()
+			return
+		}
+		run.Status = runDone
+		run.Result = result
+	}()
+	return *run, true
+}
+
+// get returns a copy of a run
+func (a *analyticsRuns) get(id int) (AnalyticsRunResponse, bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	run, ok := a.runs[id]
+	if !ok {
+		return AnalyticsRunResponse{}, false
+	}
+	return *run, true
+}
+
+// prune forgets the oldest finished runs beyond maxAnalyticsRuns; a.mu must be held
+func (a *analyticsRuns) prune() {
+	for excess := len(a.order) - maxAnalyticsRuns + 1; excess > 0; excess-- {
+		for i, id := range a.order {
+			if a.runs[id].Status != runRunning {
+				delete(a.runs, id)
+				a.order = append(a.order[:i], a.order[i+1:]...)
+				break
+			}
+		}
+	}
+}
+
+// startAnalyticsRun starts fn as a run of kind and responds with 202 and the run, or with 409
+// and the running run when one of kind is already running
+func (h *Handler) startAnalyticsRun(w http.ResponseWriter, kind string, fn func(ctx context.Context) (interface{}, error)) {
+	run, started := h.runs.start(kind, fn)
+	w.Header().Set("Location", fmt.Sprintf("/api/v1/analytics/runs/%d", run.ID))
+	if !started {
+		respondJSON(w, http.StatusConflict, run)
+		return
+	}
+	respondJSON(w, http.StatusAccepted, run)
+}
+
+// GetAnalyticsRun handles GET /analytics/runs/:id
+func (h *Handler) GetAnalyticsRun(w http.ResponseWriter, r *http.Request) {
+	id, err := pathIDParam(r, "runs")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid run id", "")
+		return
+	}
+	run, ok := h.runs.get(id)
+	if !ok {
+		respondError(w, http.StatusNotFound, "analytics run not found", "")
+		return
+	}
+	respondJSON(w, http.StatusOK, run)
+}
+
+// limitParam reads the limit query parameter, responding with an error when it is not between 1 and 1000
+func limitParam(w http.ResponseWriter, r *http.Request, fallback int) (int, bool) {
+	limitStr := r.URL.Query().Get("limit")
+	if limitStr == "" {
+		return fallback, true
+	}
+	limit, err := strconv.Atoi(limitStr)
+	if err != nil || limit < 1 || limit > 1000 {
+		respondError(w, http.StatusBadRequest, "invalid query parameter", "limit must be between 1 and 1000")
+		return 0, false
+	}
+	return limit, true
+}
@@ -0,0 +1,248 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/Blogem/enron-graph/ent"
+	"github.com/Blogem/enron-graph/internal/graph"
+	"github.com/go-chi/chi/v5"
+)
+
+// auditActor is recorded on audit entries for curation done through the API
+const auditActor = "api"
+
+// MergeRequest represents a request to merge duplicate entities into the entity in the URL
+type MergeRequest struct {
+	MergeIDs []int  `json:"merge_ids"`
+	Reason   string `json:"reason,omitempty"`
+}
+
+// MergeResponse represents the result of a merge
+type MergeResponse struct {
+	Survivor             EntityResponse `json:"survivor"`
+	MergedIDs            []int          `json:"merged_ids"`
+	RelationshipsMoved   int            `json:"relationships_moved"`
+	RelationshipsRemoved int            `json:"relationships_removed"`
+	ProvenanceMoved      int            `json:"provenance_moved"`
+	AuditID              int            `json:"audit_id,omitempty"`
+}
+
+// SplitRequest represents a request to carve a new entity out of the entity in the URL
+type SplitRequest struct {
+	UniqueID        string   `json:"unique_id"`
+	Name            string   `json:"name"`
+	TypeCategory    string   `json:"type_category,omitempty"`
+	RelationshipIDs []int    `json:"relationship_ids,omitempty"`
+	ProvenanceIDs   []int    `json:"provenance_ids,omitempty"`
+	PropertyKeys    []string `json:"property_keys,omitempty"`
+	Aliases         []string `json:"aliases,omitempty"`
+	Reason          string   `json:"reason,omitempty"`
+}
+
+// SplitResponse represents the result of a split
+type SplitResponse struct {
+	Source             EntityResponse `json:"source"`
+	Created            EntityResponse `json:"created"`
+	RelationshipsMoved int            `json:"relationships_moved"`
+	ProvenanceMoved    int            `json:"provenance_moved"`
+	AuditID            int            `json:"audit_id,omitempty"`
+}
+
+// AuditResponse represents a single curation audit entry
+type AuditResponse struct {
+	ID         int                    `json:"id"`
+	Operation  string                 `json:"operation"`
+	EntityID   int                    `json:"entity_id"`
+	RelatedIDs []int                  `json:"related_ids"`
+	Actor      string                 `json:"actor,omitempty"`
+	Reason     string                 `json:"reason,omitempty"`
+	Details    map[string]interface{} `json:"details,omitempty"`
+	CreatedAt  string                 `json:"created_at"`
+}
+
+// AuditListResponse represents the curation history of an entity
+type AuditListResponse struct {
+	EntityID int             `json:"entity_id"`
+	Entries  []AuditResponse `json:"entries"`
+	Total    int             `json:"total"`
+}
+
+// MergeEntities handles POST /entities/:id/merge
+// The entities listed in merge_ids are folded into the entity in the URL.
+func (h *Handler) MergeEntities(w http.ResponseWriter, r *http.Request) {
+	id, err := entityIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid entity id", "")
+		return
+	}
+
+	var req MergeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request", "failed to parse request body")
+		return
+	}
+
+	if len(req.MergeIDs) == 0 {
+		respondError(w, http.StatusBadRequest, "invalid request", "merge_ids is required")
+		return
+	}
+	for _, mergeID := range req.MergeIDs {
+		if mergeID == id {
+			respondError(w, http.StatusBadRequest, "invalid request", "an entity cannot be merged into itself")
+			return
+		}
+	}
+
+	if !h.entityExists(w, r, id) {
+		return
+	}
+
+	result, err := h.repo.MergeEntities(graph.WithAuditActor(r.Context(), auditActor), id, req.MergeIDs, req.Reason)
+	if err != nil {
+		respondError(w, http.StatusInternalServerError, "failed to merge entities", err.Error())
+		return
+	}
+
+	response := MergeResponse{
+		MergedIDs:            result.MergedIDs,
+		RelationshipsMoved:   result.RelationshipsMoved,
+		RelationshipsRemoved: result.RelationshipsRemoved,
+		ProvenanceMoved:      result.ProvenanceMoved,
+	}
+	if result.Survivor != nil {
+		response.Survivor = toEntityResponse(result.Survivor)
+	}
+	if result.Audit != nil {
+		response.AuditID = result.Audit.ID
+	}
+
+	respondJSON(w, http.StatusOK, response)
+}
+
+// SplitEntity handles POST /entities/:id/split
+func (h *Handler) SplitEntity(w http.ResponseWriter, r *http.Request) {
+	id, err := entityIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid entity id", "")
+		return
+	}
+
+	var req SplitRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request", "failed to parse request body")
+		return
+	}
+
+	if strings.TrimSpace(req.UniqueID) == "" || strings.TrimSpace(req.Name) == "" {
+		respondError(w, http.StatusBadRequest, "invalid request", "unique_id and name are required")
+		return
+	}
+
+	if !h.entityExists(w, r, id) {
+		return
+	}
+
+	result, err := h.repo.SplitEntity(graph.WithAuditActor(r.Context(), auditActor), id, &graph.SplitInput{
+		UniqueID:        req.UniqueID,
+		Name:            req.Name,
+		TypeCategory:    req.TypeCategory,
+		RelationshipIDs: req.RelationshipIDs,
+		ProvenanceIDs:   req.ProvenanceIDs,
+		PropertyKeys:    req.PropertyKeys,
+		Aliases:         req.Aliases,
+		Reason:          req.Reason,
+	})
+	if err != nil {
+		respondError(w, http.StatusInternalServerError, "failed to split entity", err.Error())
+		return
+	}
+
+	response := SplitResponse{
+		RelationshipsMoved: result.RelationshipsMoved,
+		ProvenanceMoved:    result.ProvenanceMoved,
+	}
+	if result.Source != nil {
+		response.Source = toEntityResponse(result.Source)
+	}
+	if result.Created != nil {
+		response.Created = toEntityResponse(result.Created)
+	}
+	if result.Audit != nil {
+		response.AuditID = result.Audit.ID
+	}
+
+	respondJSON(w, http.StatusCreated, response)
+}
+
+// GetEntityAudit handles GET /entities/:id/audit
+func (h *Handler) GetEntityAudit(w http.ResponseWriter, r *http.Request) {
+	id, err := entityIDParam(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid entity id", "")
+		return
+	}
+
+	// Merged-away entities no longer exist, so their history is still served, ending with the
+	// merge that folded them away
+	entries, err := h.repo.FindEntityAudits(r.Context(), id)
+	if err != nil {
+		respondError(w, http.StatusInternalServerError, "failed to fetch audit entries", err.Error())
+		return
+	}
+
+	results := make([]AuditResponse, len(entries))
+	for i, entry := range entries {
+		results[i] = toAuditResponse(entry)
+	}
+
+	respondJSON(w, http.StatusOK, AuditListResponse{
+		EntityID: id,
+		Entries:  results,
+		Total:    len(results),
+	})
+}
+
+// entityExists writes a 404 or 500 response and returns false when the entity cannot be loaded
+func (h *Handler) entityExists(w http.ResponseWriter, r *http.Request, id int) bool {
+	if _, err := h.repo.FindEntityByID(r.Context(), id); err != nil {
+		if ent.IsNotFound(err) {
+			respondError(w, http.StatusNotFound, "entity not found", "")
+			return false
+		}
+		respondError(w, http.StatusInternalServerError, "failed to fetch entity", err.Error())
+		return false
+	}
+	return true
+}
+
+// entityIDParam extracts the entity ID from the URL
+func entityIDParam(r *http.Request) (int, error) {
+	idStr := chi.URLParam(r, "id")
+	if idStr == "" {
+		// Fallback for tests that don't use chi router
+		parts := strings.Split(r.URL.Path, "/")
+		for i, part := range parts {
+			if part == "entities" && i+1 < len(parts) {
+				idStr = parts[i+1]
+				break
+			}
+		}
+	}
+	return strconv.Atoi(idStr)
+}
+
+func toAuditResponse(entry *ent.EntityAudit) AuditResponse {
+	return AuditResponse{
+		ID:         entry.ID,
+		Operation:  entry.Operation,
+		EntityID:   entry.EntityID,
+		RelatedIDs: entry.RelatedIds,
+		Actor:      entry.Actor,
+		Reason:     entry.Reason,
+		Details:    entry.Details,
+		CreatedAt:  entry.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/Blogem/enron-graph/ent"
 	"github.com/Blogem/enron-graph/internal/graph"
@@ -18,6 +19,7 @@ import (
 type Handler struct {
 	repo      graph.Repository
 	llmClient llm.Client
+	runs      analyticsRuns
 }
 
 // NewHandler creates a new API handler
@@ -104,11 +106,25 @@ type NeighborsResponse struct {
 	Total          int              `json:"total"`
 }
 
-// PathRequest represents a shortest path request
+// PathRequest represents a path request. Without options it asks for the path with the fewest
+// relationships; k asks for several paths, cheapest first, and weight for what a relationship
+// costs: hops, confidence or frequency.
 type PathRequest struct {
-	SourceID int `json:"source_id"`
-	TargetID int `json:"target_id"`
-	MaxDepth int `json:"max_depth"`
+	SourceID int    `json:"source_id"`
+	TargetID int    `json:"target_id"`
+	MaxDepth int    `json:"max_depth"`
+	K        int    `json:"k,omitempty"`
+	Weight   string `json:"weight,omitempty"`
+	Since    string `json:"since,omitempty"`
+	Until    string `json:"until,omitempty"`
+	AsOf     string `json:"as_of,omitempty"`
+
+	RelationshipTypes        []string `json:"relationship_types,omitempty"`
+	ExcludeRelationshipTypes []string `json:"exclude_relationship_types,omitempty"`
+	EntityTypes              []string `json:"entity_types,omitempty"`
+	ExcludeEntityTypes       []string `json:"exclude_entity_types,omitempty"`
+	ExcludeEntityIDs         []int    `json:"exclude_entity_ids,omitempty"`
+	MaxDegree                int      `json:"max_degree,omitempty"`
 }
 
 // PathElement represents an element in a path (entity or relationship)
@@ -125,6 +141,15 @@ type PathResponse struct {
 	SourceID   int           `json:"source_id"`
 	TargetID   int           `json:"target_id"`
 	PathLength int           `json:"path_length"`
+	Cost       float64       `json:"cost"`
+	Path       []PathElement `json:"path"`
+	Paths      []RankedPath  `json:"paths,omitempty"` // Every path found when k > 1, cheapest first
+}
+
+// RankedPath is one of several paths found
+type RankedPath struct {
+	PathLength int           `json:"path_length"`
+	Cost       float64       `json:"cost"`
 	Path       []PathElement `json:"path"`
 }
 
@@ -149,6 +174,28 @@ type SemanticSearchResponse struct {
 	Total   int            `json:"total"`
 }
 
+// ProvenanceResponse represents a single provenance record in API responses
+type ProvenanceResponse struct {
+	ID            int                    `json:"id"`
+	SubjectType   string                 `json:"subject_type"`
+	SubjectID     int                    `json:"subject_id"`
+	EmailID       int                    `json:"email_id,omitempty"`
+	MessageID     string                 `json:"message_id,omitempty"`
+	FilePath      string                 `json:"file_path,omitempty"`
+	Source        string                 `json:"source"`
+	Model         string                 `json:"model,omitempty"`
+	PromptVersion string                 `json:"prompt_version,omitempty"`
+	ExtractedAt   string                 `json:"extracted_at"`
+	Properties    map[string]interface{} `json:"properties,omitempty"`
+}
+
+// ProvenanceListResponse represents the response for entity provenance queries
+type ProvenanceListResponse struct {
+	EntityID int                  `json:"entity_id"`
+	Records  []ProvenanceResponse `json:"records"`
+	Total    int                  `json:"total"`
+}
+
 // GetEntity handles GET /entities/:id
 func (h *Handler) GetEntity(w http.ResponseWriter, r *http.Request) {
 	// Extract ID from URL
@@ -246,11 +293,25 @@ func (h *Handler) SearchEntities(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Entities known under the searched name or address also match, e.g. a person
+	// searched by one of their secondary email addresses
+	aliased := make(map[int]bool)
+	if name != "" {
+		matches, err := h.repo.FindEntitiesByAlias(ctx, name, typeCategory)
+		if err != nil {
+			respondError(w, http.StatusInternalServerError, "failed to search aliases", err.Error())
+			return
+		}
+		for _, entity := range matches {
+			aliased[entity.ID] = true
+		}
+	}
+
 	// Filter by name and confidence
 	filtered := make([]*ent.DiscoveredEntity, 0)
 	for _, entity := range entities {
-		// Filter by name (case-insensitive partial match)
-		if name != "" && !strings.Contains(strings.ToLower(entity.Name), strings.ToLower(name)) {
+		// Filter by name (case-insensitive partial match) or exact alias
+		if name != "" && !aliased[entity.ID] && !strings.Contains(strings.ToLower(entity.Name), strings.ToLower(name)) {
 			continue
 		}
 		// Filter by confidence
@@ -289,6 +350,7 @@ func (h *Handler) SearchEntities(w http.ResponseWriter, r *http.Request) {
 }
 
 // GetEntityRelationships handles GET /entities/:id/relationships
+// since, until and as_of restrict the relationships to a time window.
 func (h *Handler) GetEntityRelationships(w http.ResponseWriter, r *http.Request) {
 	// Extract ID from URL
 	idStr := chi.URLParam(r, "id")
@@ -319,8 +381,13 @@ func (h *Handler) GetEntityRelationships(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	window, ok := timeWindowParam(w, r)
+	if !ok {
+		return
+	}
+
 	// Get relationships
-	relationships, err := h.repo.FindRelationshipsByEntity(r.Context(), "discovered_entity", id)
+	relationships, err := h.repo.FindRelationshipsByEntity(r.Context(), "discovered_entity", id, window)
 	if err != nil {
 		respondError(w, http.StatusInternalServerError, "failed to fetch relationships", err.Error())
 		return
@@ -390,7 +457,75 @@ func (h *Handler) GetEntityRelationships(w http.ResponseWriter, r *http.Request)
 	})
 }
 
+// GetEntityProvenance handles GET /entities/:id/provenance
+// With include_relationships=true the provenance of the entity's relationships
+// is returned as well.
+func (h *Handler) GetEntityProvenance(w http.ResponseWriter, r *http.Request) {
+	// Extract ID from URL
+	idStr := chi.URLParam(r, "id")
+	if idStr == "" {
+		parts := strings.Split(r.URL.Path, "/")
+		for i, part := range parts {
+			if part == "entities" && i+1 < len(parts) {
+				idStr = parts[i+1]
+				break
+			}
+		}
+	}
+
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid entity id", "")
+		return
+	}
+
+	// Check if entity exists
+	_, err = h.repo.FindEntityByID(r.Context(), id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			respondError(w, http.StatusNotFound, "entity not found", "")
+			return
+		}
+		respondError(w, http.StatusInternalServerError, "failed to fetch entity", err.Error())
+		return
+	}
+
+	records, err := h.repo.FindProvenance(r.Context(), graph.SubjectEntity, id)
+	if err != nil {
+		respondError(w, http.StatusInternalServerError, "failed to fetch provenance", err.Error())
+		return
+	}
+
+	if r.URL.Query().Get("include_relationships") == "true" {
+		relationships, err := h.repo.FindRelationshipsByEntity(r.Context(), "discovered_entity", id, graph.TimeWindow{})
+		if err != nil {
+			respondError(w, http.StatusInternalServerError, "failed to fetch relationships", err.Error())
+			return
+		}
+		for _, rel := range relationships {
+			relRecords, err := h.repo.FindProvenance(r.Context(), graph.SubjectRelationship, rel.ID)
+			if err != nil {
+				respondError(w, http.StatusInternalServerError, "failed to fetch provenance", err.Error())
+				return
+			}
+			records = append(records, relRecords...)
+		}
+	}
+
+	results := make([]ProvenanceResponse, len(records))
+	for i, record := range records {
+		results[i] = toProvenanceResponse(record)
+	}
+
+	respondJSON(w, http.StatusOK, ProvenanceListResponse{
+		EntityID: id,
+		Records:  results,
+		Total:    len(results),
+	})
+}
+
 // GetEntityNeighbors handles GET /entities/:id/neighbors
+// since, until and as_of restrict the traversal to the relationships in a time window.
 func (h *Handler) GetEntityNeighbors(w http.ResponseWriter, r *http.Request) {
 	// Extract ID from URL
 	idStr := chi.URLParam(r, "id")
@@ -434,6 +569,11 @@ func (h *Handler) GetEntityNeighbors(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	window, ok := timeWindowParam(w, r)
+	if !ok {
+		return
+	}
+
 	// Check if source entity exists
 	_, err = h.repo.FindEntityByID(r.Context(), id)
 	if err != nil {
@@ -446,7 +586,7 @@ func (h *Handler) GetEntityNeighbors(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Traverse relationships
-	neighbors, err := h.repo.TraverseRelationships(r.Context(), id, relType, depth)
+	neighbors, err := h.repo.TraverseRelationships(r.Context(), id, relType, depth, window)
 	if err != nil {
 		respondError(w, http.StatusInternalServerError, "failed to traverse relationships", err.Error())
 		return
@@ -481,6 +621,9 @@ func (h *Handler) GetEntityNeighbors(w http.ResponseWriter, r *http.Request) {
 }
 
 // FindPath handles POST /entities/path
+// since, until and as_of in the body restrict the path to the relationships in a time window;
+// the type filters, exclude_entity_ids and max_degree keep paths away from hubs such as
+// mailing lists.
 func (h *Handler) FindPath(w http.ResponseWriter, r *http.Request) {
 	var req PathRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -498,74 +641,100 @@ func (h *Handler) FindPath(w http.ResponseWriter, r *http.Request) {
 		req.MaxDepth = 6
 	}
 
-	if req.MaxDepth < 1 || req.MaxDepth > 10 {
-		respondError(w, http.StatusBadRequest, "invalid parameter", "max_depth must be between 1 and 10")
+	if req.MaxDepth < 1 || req.MaxDepth > graph.MaxPathDepth {
+		respondError(w, http.StatusBadRequest, "invalid parameter", fmt.Sprintf("max_depth must be between 1 and %d", graph.MaxPathDepth))
 		return
 	}
 
-	// Find shortest path
-	path, err := h.repo.FindShortestPath(r.Context(), req.SourceID, req.TargetID)
+	window, err := graph.ParseTimeWindow(req.Since, req.Until, req.AsOf)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid parameter", err.Error())
+		return
+	}
+
+	opts := graph.PathOptions{
+		Window:                   window,
+		MaxDepth:                 req.MaxDepth,
+		K:                        req.K,
+		Weight:                   req.Weight,
+		RelationshipTypes:        req.RelationshipTypes,
+		ExcludeRelationshipTypes: req.ExcludeRelationshipTypes,
+		EntityTypes:              req.EntityTypes,
+		ExcludeEntityTypes:       req.ExcludeEntityTypes,
+		ExcludeEntityIDs:         req.ExcludeEntityIDs,
+		MaxDegree:                req.MaxDegree,
+	}
+	if err := opts.Validate(); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid parameter", err.Error())
+		return
+	}
+
+	paths, err := h.repo.FindPaths(r.Context(), req.SourceID, req.TargetID, opts)
 	if err != nil {
-		if ent.IsNotFound(err) {
-			respondError(w, http.StatusNotFound, "no path found", fmt.Sprintf("no path exists between entities %d and %d within max_depth %d", req.SourceID, req.TargetID, req.MaxDepth))
-			return
-		}
 		respondError(w, http.StatusInternalServerError, "failed to find path", err.Error())
 		return
 	}
+	if len(paths) == 0 {
+		respondError(w, http.StatusNotFound, "no path found", fmt.Sprintf("no path exists between entities %d and %d within max_depth %d", req.SourceID, req.TargetID, req.MaxDepth))
+		return
+	}
 
-	// Build path response
-	pathElements := make([]PathElement, 0)
+	ranked := make([]RankedPath, len(paths))
+	for i, path := range paths {
+		ranked[i] = RankedPath{
+			PathLength: len(path.Relationships),
+			Cost:       path.Cost,
+			Path:       h.pathElements(r.Context(), path),
+		}
+	}
 
-	if len(path) == 0 {
-		// Source and target are the same
-		sourceEntity, _ := h.repo.FindEntityByID(r.Context(), req.SourceID)
-		pathElements = append(pathElements, PathElement{
-			EntityID:   sourceEntity.ID,
-			EntityName: sourceEntity.Name,
-			EntityType: sourceEntity.TypeCategory,
-		})
-	} else {
-		// Add source entity
-		sourceEntity, _ := h.repo.FindEntityByID(r.Context(), req.SourceID)
-		pathElements = append(pathElements, PathElement{
-			EntityID:   sourceEntity.ID,
-			EntityName: sourceEntity.Name,
-			EntityType: sourceEntity.TypeCategory,
-		})
-
-		// Add relationships and intermediate entities
-		for _, rel := range path {
-			pathElements = append(pathElements, PathElement{
+	response := PathResponse{
+		SourceID:   req.SourceID,
+		TargetID:   req.TargetID,
+		PathLength: ranked[0].PathLength,
+		Cost:       ranked[0].Cost,
+		Path:       ranked[0].Path,
+	}
+	if req.K > 1 {
+		response.Paths = ranked
+	}
+	respondJSON(w, http.StatusOK, response)
+}
+
+// pathElements lists the nodes of a path with the relationships between them. Entities are
+// named; other nodes, such as emails, carry their node type.
+func (h *Handler) pathElements(ctx context.Context, path graph.Path) []PathElement {
+	elements := make([]PathElement, 0, len(path.Nodes)+len(path.Relationships))
+	for i, node := range path.Nodes {
+		if i > 0 {
+			rel := path.Relationships[i-1]
+			elements = append(elements, PathElement{
 				RelationshipID:   rel.ID,
 				RelationshipType: rel.Type,
 			})
-
-			// Determine next entity ID
-			var nextID int
-			if rel.FromID == req.SourceID || (len(pathElements) > 2 && rel.FromID == pathElements[len(pathElements)-3].EntityID) {
-				nextID = rel.ToID
-			} else {
-				nextID = rel.FromID
-			}
-
-			nextEntity, _ := h.repo.FindEntityByID(r.Context(), nextID)
-			if nextEntity != nil {
-				pathElements = append(pathElements, PathElement{
-					EntityID:   nextEntity.ID,
-					EntityName: nextEntity.Name,
-					EntityType: nextEntity.TypeCategory,
-				})
+		}
+		element := PathElement{EntityID: node.ID, EntityType: node.Type}
+		if node.Type == "discovered_entity" {
+			if entity, err := h.repo.FindEntityByID(ctx, node.ID); err == nil {
+				element.EntityName = entity.Name
+				element.EntityType = entity.TypeCategory
 			}
 		}
+		elements = append(elements, element)
 	}
+	return elements
+}
 
-	respondJSON(w, http.StatusOK, PathResponse{
-		SourceID:   req.SourceID,
-		TargetID:   req.TargetID,
-		PathLength: len(path),
-		Path:       pathElements,
-	})
+// timeWindowParam parses the since, until and as_of query parameters, writing a 400 response
+// when they are invalid
+func timeWindowParam(w http.ResponseWriter, r *http.Request) (graph.TimeWindow, bool) {
+	query := r.URL.Query()
+	window, err := graph.ParseTimeWindow(query.Get("since"), query.Get("until"), query.Get("as_of"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid query parameter", err.Error())
+		return graph.TimeWindow{}, false
+	}
+	return window, true
 }
 
 // SemanticSearch handles POST /entities/search
@@ -683,6 +852,22 @@ func toRelationshipResponse(rel *ent.Relationship) RelationshipResponse {
 	}
 }
 
+func toProvenanceResponse(record *ent.Provenance) ProvenanceResponse {
+	return ProvenanceResponse{
+		ID:            record.ID,
+		SubjectType:   record.SubjectType,
+		SubjectID:     record.SubjectID,
+		EmailID:       record.EmailID,
+		MessageID:     record.MessageID,
+		FilePath:      record.FilePath,
+		Source:        record.Source,
+		Model:         record.Model,
+		PromptVersion: record.PromptVersion,
+		ExtractedAt:   record.ExtractedAt.Format("2006-01-02T15:04:05Z07:00"),
+		Properties:    record.Properties,
+	}
+}
+
 func respondJSON(w http.ResponseWriter, status int, data interface{}) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(status)
@@ -719,29 +904,38 @@ func (m *mockRepoWrapper) FindEntitiesByType(ctx context.Context, typeCategory s
 	return nil, fmt.Errorf("method not implemented")
 }
 
-func (m *mockRepoWrapper) FindRelationshipsByEntity(ctx context.Context, entityType string, entityID int) ([]*ent.Relationship, error) {
+func (m *mockRepoWrapper) FindRelationshipsByEntity(ctx context.Context, entityType string, entityID int, window graph.TimeWindow) ([]*ent.Relationship, error) {
 	if finder, ok := m.mock.(interface {
-		FindRelationshipsByEntity(context.Context, string, int) ([]*ent.Relationship, error)
+		FindRelationshipsByEntity(context.Context, string, int, graph.TimeWindow) ([]*ent.Relationship, error)
 	}); ok {
-		return finder.FindRelationshipsByEntity(ctx, entityType, entityID)
+		return finder.FindRelationshipsByEntity(ctx, entityType, entityID, window)
 	}
 	return nil, fmt.Errorf("method not implemented")
 }
 
-func (m *mockRepoWrapper) TraverseRelationships(ctx context.Context, fromID int, relType string, depth int) ([]*ent.DiscoveredEntity, error) {
+func (m *mockRepoWrapper) TraverseRelationships(ctx context.Context, fromID int, relType string, depth int, window graph.TimeWindow) ([]*ent.DiscoveredEntity, error) {
 	if finder, ok := m.mock.(interface {
-		TraverseRelationships(context.Context, int, string, int) ([]*ent.DiscoveredEntity, error)
+		TraverseRelationships(context.Context, int, string, int, graph.TimeWindow) ([]*ent.DiscoveredEntity, error)
 	}); ok {
-		return finder.TraverseRelationships(ctx, fromID, relType, depth)
+		return finder.TraverseRelationships(ctx, fromID, relType, depth, window)
 	}
 	return nil, fmt.Errorf("method not implemented")
 }
 
-func (m *mockRepoWrapper) FindShortestPath(ctx context.Context, fromID, toID int) ([]*ent.Relationship, error) {
+func (m *mockRepoWrapper) FindShortestPath(ctx context.Context, fromID, toID int, window graph.TimeWindow) ([]*ent.Relationship, error) {
 	if finder, ok := m.mock.(interface {
-		FindShortestPath(context.Context, int, int) ([]*ent.Relationship, error)
+		FindShortestPath(context.Context, int, int, graph.TimeWindow) ([]*ent.Relationship, error)
 	}); ok {
-		return finder.FindShortestPath(ctx, fromID, toID)
+		return finder.FindShortestPath(ctx, fromID, toID, window)
+	}
+	return nil, fmt.Errorf("method not implemented")
+}
+
+func (m *mockRepoWrapper) FindPaths(ctx context.Context, fromID, toID int, opts graph.PathOptions) ([]graph.Path, error) {
+	if finder, ok := m.mock.(interface {
+		FindPaths(context.Context, int, int, graph.PathOptions) ([]graph.Path, error)
+	}); ok {
+		return finder.FindPaths(ctx, fromID, toID, opts)
 	}
 	return nil, fmt.Errorf("method not implemented")
 }
@@ -755,6 +949,87 @@ func (m *mockRepoWrapper) SimilaritySearch(ctx context.Context, embedding []floa
 	return nil, fmt.Errorf("method not implemented")
 }
 
+func (m *mockRepoWrapper) FindProvenance(ctx context.Context, subjectType string, subjectID int) ([]*ent.Provenance, error) {
+	if finder, ok := m.mock.(interface {
+		FindProvenance(context.Context, string, int) ([]*ent.Provenance, error)
+	}); ok {
+		return finder.FindProvenance(ctx, subjectType, subjectID)
+	}
+	return nil, fmt.Errorf("method not implemented")
+}
+
+func (m *mockRepoWrapper) MergeEntities(ctx context.Context, survivorID int, victimIDs []int, reason string) (*graph.MergeResult, error) {
+	if merger, ok := m.mock.(interface {
+		MergeEntities(context.Context, int, []int, string) (*graph.MergeResult, error)
+	}); ok {
+		return merger.MergeEntities(ctx, survivorID, victimIDs, reason)
+	}
+	return nil, fmt.Errorf("method not implemented")
+}
+
+func (m *mockRepoWrapper) SplitEntity(ctx context.Context, entityID int, input *graph.SplitInput) (*graph.SplitResult, error) {
+	if splitter, ok := m.mock.(interface {
+		SplitEntity(context.Context, int, *graph.SplitInput) (*graph.SplitResult, error)
+	}); ok {
+		return splitter.SplitEntity(ctx, entityID, input)
+	}
+	return nil, fmt.Errorf("method not implemented")
+}
+
+func (m *mockRepoWrapper) FindEntityAudits(ctx context.Context, entityID int) ([]*ent.EntityAudit, error) {
+	if finder, ok := m.mock.(interface {
+		FindEntityAudits(context.Context, int) ([]*ent.EntityAudit, error)
+	}); ok {
+		return finder.FindEntityAudits(ctx, entityID)
+	}
+	return nil, fmt.Errorf("method not implemented")
+}
+
+func (m *mockRepoWrapper) FindAliases(ctx context.Context, entityID int) ([]*ent.EntityAlias, error) {
+	if finder, ok := m.mock.(interface {
+		FindAliases(context.Context, int) ([]*ent.EntityAlias, error)
+	}); ok {
+		return finder.FindAliases(ctx, entityID)
+	}
+	return nil, fmt.Errorf("method not implemented")
+}
+
+func (m *mockRepoWrapper) FindEntitiesByAlias(ctx context.Context, alias string, typeHint ...string) ([]*ent.DiscoveredEntity, error) {
+	if finder, ok := m.mock.(interface {
+		FindEntitiesByAlias(context.Context, string, ...string) ([]*ent.DiscoveredEntity, error)
+	}); ok {
+		return finder.FindEntitiesByAlias(ctx, alias, typeHint...)
+	}
+	return nil, fmt.Errorf("method not implemented")
+}
+
+func (m *mockRepoWrapper) GetThread(ctx context.Context, id int) (*ent.Thread, error) {
+	if finder, ok := m.mock.(interface {
+		GetThread(context.Context, int) (*ent.Thread, error)
+	}); ok {
+		return finder.GetThread(ctx, id)
+	}
+	return nil, fmt.Errorf("method not implemented")
+}
+
+func (m *mockRepoWrapper) FindThreadByEmail(ctx context.Context, emailID int) (*ent.Thread, error) {
+	if finder, ok := m.mock.(interface {
+		FindThreadByEmail(context.Context, int) (*ent.Thread, error)
+	}); ok {
+		return finder.FindThreadByEmail(ctx, emailID)
+	}
+	return nil, fmt.Errorf("method not implemented")
+}
+
+func (m *mockRepoWrapper) FindThreadEmails(ctx context.Context, threadID int) ([]*graph.ThreadMessage, error) {
+	if finder, ok := m.mock.(interface {
+		FindThreadEmails(context.Context, int) ([]*graph.ThreadMessage, error)
+	}); ok {
+		return finder.FindThreadEmails(ctx, threadID)
+	}
+	return nil, fmt.Errorf("method not implemented")
+}
+
 func (m *mockRepoWrapper) Close() error {
 	if closer, ok := m.mock.(interface{ Close() error }); ok {
 		return closer.Close()
@@ -771,6 +1046,22 @@ func (m *mockRepoWrapper) FindEmailByMessageID(ctx context.Context, messageID st
 	return nil, fmt.Errorf("not implemented")
 }
 
+func (m *mockRepoWrapper) GetEmail(ctx context.Context, id int) (*ent.Email, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (m *mockRepoWrapper) FindEmailIDs(ctx context.Context, filter *graph.EmailFilter) ([]int, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (m *mockRepoWrapper) ThreadEmail(ctx context.Context, input *graph.ThreadInput) (*ent.Thread, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (m *mockRepoWrapper) FindThreadBySubject(ctx context.Context, subject string, since time.Time) (*ent.Thread, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
 func (m *mockRepoWrapper) CreateDiscoveredEntity(ctx context.Context, entity *graph.EntityInput) (*ent.DiscoveredEntity, error) {
 	return nil, fmt.Errorf("not implemented")
 }
@@ -787,10 +1078,50 @@ func (m *mockRepoWrapper) GetDistinctRelationshipTypes(ctx context.Context) ([]s
 	return nil, fmt.Errorf("not implemented")
 }
 
+func (m *mockRepoWrapper) RecordProvenance(ctx context.Context, input *graph.ProvenanceInput) (*ent.Provenance, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (m *mockRepoWrapper) RecordAlias(ctx context.Context, input *graph.AliasInput) (*ent.EntityAlias, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
 func (m *mockRepoWrapper) CreateRelationship(ctx context.Context, rel *graph.RelationshipInput) (*ent.Relationship, error) {
 	return nil, fmt.Errorf("not implemented")
 }
 
+func (m *mockRepoWrapper) EnqueueExtractionJobs(ctx context.Context, emailIDs []int, model, batch string) (int, error) {
+	return 0, fmt.Errorf("not implemented")
+}
+
+func (m *mockRepoWrapper) ClaimExtractionJobs(ctx context.Context, worker, batch string, limit int) ([]*ent.ExtractionJob, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (m *mockRepoWrapper) RequeueExtractionJobs(ctx context.Context, emailIDs []int, model, batch string) (int, error) {
+	return 0, fmt.Errorf("not implemented")
+}
+
+func (m *mockRepoWrapper) CompleteExtractionJob(ctx context.Context, id int, model, promptVersion string) error {
+	return fmt.Errorf("not implemented")
+}
+
+func (m *mockRepoWrapper) FailExtractionJob(ctx context.Context, id int, cause string, maxAttempts int) (*ent.ExtractionJob, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (m *mockRepoWrapper) ReleaseStaleExtractionJobs(ctx context.Context, claimedBefore time.Time) (int, error) {
+	return 0, fmt.Errorf("not implemented")
+}
+
+func (m *mockRepoWrapper) CountExtractionJobs(ctx context.Context) (map[string]int, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (m *mockRepoWrapper) ClearEmailExtraction(ctx context.Context, emailID int) (*graph.ClearResult, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
 func (m *mockRepoWrapper) GetClient() *ent.Client {
 	return nil // Mock wrapper doesn't have a real client
 }
@@ -4,11 +4,16 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"slices"
 	"testing"
+	"time"
 
 	"github.com/Blogem/enron-graph/ent"
+	"github.com/Blogem/enron-graph/internal/graph"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -17,6 +22,11 @@ import (
 type mockRepository struct {
 	entities      map[int]*ent.DiscoveredEntity
 	relationships map[int]*ent.Relationship
+	provenance    []*ent.Provenance
+	audits        []*ent.EntityAudit
+	aliases       map[string][]int
+	threads       map[int]*ent.Thread
+	threadEmails  map[int][]*graph.ThreadMessage
 	nextID        int
 }
 
@@ -24,6 +34,9 @@ func newMockRepository() *mockRepository {
 	return &mockRepository{
 		entities:      make(map[int]*ent.DiscoveredEntity),
 		relationships: make(map[int]*ent.Relationship),
+		aliases:       make(map[string][]int),
+		threads:       make(map[int]*ent.Thread),
+		threadEmails:  make(map[int][]*graph.ThreadMessage),
 		nextID:        1,
 	}
 }
@@ -45,17 +58,17 @@ func (m *mockRepository) FindEntitiesByType(ctx context.Context, typeCategory st
 	return results, nil
 }
 
-func (m *mockRepository) FindRelationshipsByEntity(ctx context.Context, entityType string, entityID int) ([]*ent.Relationship, error) {
+func (m *mockRepository) FindRelationshipsByEntity(ctx context.Context, entityType string, entityID int, window graph.TimeWindow) ([]*ent.Relationship, error) {
 	var results []*ent.Relationship
 	for _, rel := range m.relationships {
-		if rel.FromID == entityID || rel.ToID == entityID {
+		if (rel.FromID == entityID || rel.ToID == entityID) && window.Contains(rel.Timestamp) {
 			results = append(results, rel)
 		}
 	}
 	return results, nil
 }
 
-func (m *mockRepository) TraverseRelationships(ctx context.Context, fromID int, relType string, depth int) ([]*ent.DiscoveredEntity, error) {
+func (m *mockRepository) TraverseRelationships(ctx context.Context, fromID int, relType string, depth int, window graph.TimeWindow) ([]*ent.DiscoveredEntity, error) {
 	visited := make(map[int]bool)
 	var results []*ent.DiscoveredEntity
 
@@ -63,6 +76,9 @@ func (m *mockRepository) TraverseRelationships(ctx context.Context, fromID int,
 		visited[fromID] = true
 
 		for _, rel := range m.relationships {
+			if !window.Contains(rel.Timestamp) {
+				continue
+			}
 			var neighborID int
 			if rel.FromID == fromID {
 				neighborID = rel.ToID
@@ -82,7 +98,7 @@ func (m *mockRepository) TraverseRelationships(ctx context.Context, fromID int,
 	return results, nil
 }
 
-func (m *mockRepository) FindShortestPath(ctx context.Context, fromID, toID int) ([]*ent.Relationship, error) {
+func (m *mockRepository) FindShortestPath(ctx context.Context, fromID, toID int, window graph.TimeWindow) ([]*ent.Relationship, error) {
 	if fromID == toID {
 		return []*ent.Relationship{}, nil
 	}
@@ -97,6 +113,9 @@ func (m *mockRepository) FindShortestPath(ctx context.Context, fromID, toID int)
 		queue = queue[1:]
 
 		for _, rel := range m.relationships {
+			if !window.Contains(rel.Timestamp) {
+				continue
+			}
 			var nextID int
 			var pathRel *ent.Relationship
 
@@ -134,6 +153,29 @@ func (m *mockRepository) FindShortestPath(ctx context.Context, fromID, toID int)
 	return nil, &ent.NotFoundError{}
 }
 
+// FindPaths returns the shortest path only, ignoring every option but the window
+func (m *mockRepository) FindPaths(ctx context.Context, fromID, toID int, opts graph.PathOptions) ([]graph.Path, error) {
+	rels, err := m.FindShortestPath(ctx, fromID, toID, opts.Window)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	path := graph.Path{
+		Nodes:         []graph.PathNode{{Type: "discovered_entity", ID: fromID}},
+		Relationships: rels,
+		Cost:          float64(len(rels)),
+	}
+	current := fromID
+	for _, rel := range rels {
+		if rel.FromID == current {
+			current = rel.ToID
+		} else {
+			current = rel.FromID
+		}
+		path.Nodes = append(path.Nodes, graph.PathNode{Type: "discovered_entity", ID: current})
+	}
+	return []graph.Path{path}, nil
+}
+
 func (m *mockRepository) SimilaritySearch(ctx context.Context, embedding []float32, topK int, threshold float64) ([]*ent.DiscoveredEntity, error) {
 	var results []*ent.DiscoveredEntity
 	count := 0
@@ -147,6 +189,96 @@ func (m *mockRepository) SimilaritySearch(ctx context.Context, embedding []float
 	return results, nil
 }
 
+func (m *mockRepository) FindProvenance(ctx context.Context, subjectType string, subjectID int) ([]*ent.Provenance, error) {
+	var results []*ent.Provenance
+	for _, record := range m.provenance {
+		if record.SubjectType == subjectType && record.SubjectID == subjectID {
+			results = append(results, record)
+		}
+	}
+	return results, nil
+}
+
+func (m *mockRepository) MergeEntities(ctx context.Context, survivorID int, victimIDs []int, reason string) (*graph.MergeResult, error) {
+	survivor := m.entities[survivorID]
+	moved := 0
+	for _, victimID := range victimIDs {
+		for _, rel := range m.relationships {
+			if rel.FromID == victimID {
+				rel.FromID = survivorID
+				moved++
+			} else if rel.ToID == victimID {
+				rel.ToID = survivorID
+				moved++
+			}
+		}
+		delete(m.entities, victimID)
+	}
+	audit := &ent.EntityAudit{ID: len(m.audits) + 1, Operation: graph.AuditMerge, EntityID: survivorID, RelatedIds: victimIDs, Reason: reason}
+	m.audits = append(m.audits, audit)
+	return &graph.MergeResult{Survivor: survivor, MergedIDs: victimIDs, RelationshipsMoved: moved, Audit: audit}, nil
+}
+
+func (m *mockRepository) SplitEntity(ctx context.Context, entityID int, input *graph.SplitInput) (*graph.SplitResult, error) {
+	m.nextID++
+	created := &ent.DiscoveredEntity{ID: 100 + m.nextID, UniqueID: input.UniqueID, Name: input.Name, TypeCategory: input.TypeCategory}
+	m.entities[created.ID] = created
+	for _, relID := range input.RelationshipIDs {
+		if rel, ok := m.relationships[relID]; ok {
+			if rel.FromID == entityID {
+				rel.FromID = created.ID
+			} else {
+				rel.ToID = created.ID
+			}
+		}
+	}
+	audit := &ent.EntityAudit{ID: len(m.audits) + 1, Operation: graph.AuditSplit, EntityID: entityID, RelatedIds: []int{created.ID}}
+	m.audits = append(m.audits, audit)
+	return &graph.SplitResult{Source: m.entities[entityID], Created: created, RelationshipsMoved: len(input.RelationshipIDs), Audit: audit}, nil
+}
+
+func (m *mockRepository) FindEntityAudits(ctx context.Context, entityID int) ([]*ent.EntityAudit, error) {
+	var results []*ent.EntityAudit
+	for _, audit := range m.audits {
+		if audit.EntityID == entityID || slices.Contains(audit.RelatedIds, entityID) {
+			results = append(results, audit)
+		}
+	}
+	return results, nil
+}
+
+func (m *mockRepository) FindEntitiesByAlias(ctx context.Context, alias string, typeHint ...string) ([]*ent.DiscoveredEntity, error) {
+	var results []*ent.DiscoveredEntity
+	for _, id := range m.aliases[graph.NormalizeAlias(alias)] {
+		if entity, ok := m.entities[id]; ok {
+			results = append(results, entity)
+		}
+	}
+	return results, nil
+}
+
+func (m *mockRepository) GetThread(ctx context.Context, id int) (*ent.Thread, error) {
+	if thread, ok := m.threads[id]; ok {
+		return thread, nil
+	}
+	return nil, &ent.NotFoundError{}
+}
+
+func (m *mockRepository) FindThreadByEmail(ctx context.Context, emailID int) (*ent.Thread, error) {
+	for threadID, messages := range m.threadEmails {
+		for _, message := range messages {
+			if message.Email.ID == emailID {
+				return m.GetThread(ctx, threadID)
+			}
+		}
+	}
+	return nil, &ent.NotFoundError{}
+}
+
+func (m *mockRepository) FindThreadEmails(ctx context.Context, threadID int) ([]*graph.ThreadMessage, error) {
+	return m.threadEmails[threadID], nil
+}
+
 func (m *mockRepository) Close() error {
 	return nil
 }
@@ -284,6 +416,40 @@ func TestSearchEntities_FilterByName(t *testing.T) {
 	assert.Equal(t, "John Doe", entity["name"])
 }
 
+func TestSearchEntities_MatchesAliases(t *testing.T) {
+	repo := newMockRepository()
+	repo.entities[1] = &ent.DiscoveredEntity{
+		ID:           1,
+		UniqueID:     "jeff.skilling@enron.com",
+		TypeCategory: "person",
+		Name:         "Jeff Skilling",
+	}
+	repo.entities[2] = &ent.DiscoveredEntity{
+		ID:           2,
+		UniqueID:     "person2",
+		TypeCategory: "person",
+		Name:         "Jane Smith",
+	}
+	repo.aliases["jskilling@enron.com"] = []int{1}
+
+	handler := NewHandler(repo)
+
+	req := httptest.NewRequest(http.MethodGet, "/entities?name=JSkilling@enron.com", nil)
+	w := httptest.NewRecorder()
+
+	handler.SearchEntities(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]interface{}
+	err := json.NewDecoder(w.Body).Decode(&response)
+	require.NoError(t, err)
+
+	entities := response["entities"].([]interface{})
+	require.Equal(t, 1, len(entities))
+	assert.Equal(t, "Jeff Skilling", entities[0].(map[string]interface{})["name"])
+}
+
 func TestSearchEntities_InvalidParameters(t *testing.T) {
 	repo := newMockRepository()
 	handler := NewHandler(repo)
@@ -407,6 +573,85 @@ func TestGetEntityRelationships_Success(t *testing.T) {
 	assert.Equal(t, float64(2), rel["to_id"])
 }
 
+func TestGetEntityProvenance_Success(t *testing.T) {
+	repo := newMockRepository()
+	repo.entities[1] = &ent.DiscoveredEntity{
+		ID:           1,
+		UniqueID:     "enron-corp",
+		TypeCategory: "organization",
+		Name:         "Enron Corp",
+	}
+	repo.relationships[7] = &ent.Relationship{
+		ID:       7,
+		Type:     "MENTIONS",
+		FromType: "email",
+		FromID:   42,
+		ToType:   "discovered_entity",
+		ToID:     1,
+	}
+	repo.provenance = []*ent.Provenance{
+		{
+			ID:            1,
+			SubjectType:   "discovered_entity",
+			SubjectID:     1,
+			EmailID:       42,
+			MessageID:     "<msg-42@enron.com>",
+			FilePath:      "lay-k/inbox/42.",
+			Source:        "content",
+			Model:         "llama3.1:8b",
+			PromptVersion: "v2",
+			ExtractedAt:   time.Date(2001, 10, 1, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:          2,
+			SubjectType: "relationship",
+			SubjectID:   7,
+			EmailID:     42,
+			Source:      "content",
+			ExtractedAt: time.Date(2001, 10, 1, 12, 0, 0, 0, time.UTC),
+		},
+	}
+
+	handler := NewHandler(repo)
+
+	req := httptest.NewRequest(http.MethodGet, "/entities/1/provenance", nil)
+	w := httptest.NewRecorder()
+	handler.GetEntityProvenance(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]interface{}
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+
+	records := response["records"].([]interface{})
+	require.Len(t, records, 1)
+	record := records[0].(map[string]interface{})
+	assert.Equal(t, "<msg-42@enron.com>", record["message_id"])
+	assert.Equal(t, "lay-k/inbox/42.", record["file_path"])
+	assert.Equal(t, "llama3.1:8b", record["model"])
+	assert.Equal(t, "v2", record["prompt_version"])
+	assert.Equal(t, "2001-10-01T12:00:00Z", record["extracted_at"])
+
+	// Relationship provenance is opt-in
+	req = httptest.NewRequest(http.MethodGet, "/entities/1/provenance?include_relationships=true", nil)
+	w = httptest.NewRecorder()
+	handler.GetEntityProvenance(w, req)
+
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+	assert.Equal(t, float64(2), response["total"])
+}
+
+func TestGetEntityProvenance_NotFound(t *testing.T) {
+	repo := newMockRepository()
+	handler := NewHandler(repo)
+
+	req := httptest.NewRequest(http.MethodGet, "/entities/999/provenance", nil)
+	w := httptest.NewRecorder()
+	handler.GetEntityProvenance(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
 func TestGetEntityNeighbors_Depth1(t *testing.T) {
 	repo := newMockRepository()
 
@@ -495,6 +740,54 @@ func TestGetEntityNeighbors_DepthValidation(t *testing.T) {
 	}
 }
 
+func TestGetEntityNeighbors_TimeWindow(t *testing.T) {
+	repo := newMockRepository()
+
+	repo.entities[1] = &ent.DiscoveredEntity{ID: 1, UniqueID: "e1", TypeCategory: "person", Name: "Entity 1"}
+	repo.entities[2] = &ent.DiscoveredEntity{ID: 2, UniqueID: "e2", TypeCategory: "person", Name: "Entity 2"}
+	repo.entities[3] = &ent.DiscoveredEntity{ID: 3, UniqueID: "e3", TypeCategory: "person", Name: "Entity 3"}
+
+	repo.relationships[1] = &ent.Relationship{ID: 1, Type: "KNOWS", FromID: 1, ToID: 2, Timestamp: time.Date(2001, 5, 1, 0, 0, 0, 0, time.UTC)}
+	repo.relationships[2] = &ent.Relationship{ID: 2, Type: "KNOWS", FromID: 1, ToID: 3, Timestamp: time.Date(2001, 8, 15, 0, 0, 0, 0, time.UTC)}
+
+	handler := NewHandler(repo)
+
+	testCases := []struct {
+		name     string
+		query    string
+		code     int
+		expected []string
+	}{
+		{"no window", "", http.StatusOK, []string{"Entity 2", "Entity 3"}},
+		{"window", "&since=2001-07-01&until=2001-10-01", http.StatusOK, []string{"Entity 3"}},
+		{"snapshot", "&as_of=2001-06-01", http.StatusOK, []string{"Entity 2"}},
+		{"invalid since", "&since=Q3", http.StatusBadRequest, nil},
+		{"until and as_of", "&until=2001-10-01&as_of=2001-10-01", http.StatusBadRequest, nil},
+		{"empty window", "&since=2001-10-01&until=2001-07-01", http.StatusBadRequest, nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/entities/1/neighbors?depth=1"+tc.query, nil)
+			w := httptest.NewRecorder()
+
+			handler.GetEntityNeighbors(w, req)
+
+			require.Equal(t, tc.code, w.Code)
+			if tc.code != http.StatusOK {
+				return
+			}
+			var response NeighborsResponse
+			require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+			var names []string
+			for _, neighbor := range response.Neighbors {
+				names = append(names, neighbor.Name)
+			}
+			assert.ElementsMatch(t, tc.expected, names)
+		})
+	}
+}
+
 // T057: Contract tests for path finding
 func TestFindPath_Success(t *testing.T) {
 	repo := newMockRepository()
@@ -565,6 +858,33 @@ func TestFindPath_NoPathExists(t *testing.T) {
 	assert.Contains(t, response["error"], "path")
 }
 
+func TestFindPath_AsOf(t *testing.T) {
+	repo := newMockRepository()
+
+	repo.entities[1] = &ent.DiscoveredEntity{ID: 1, UniqueID: "e1", TypeCategory: "person", Name: "Entity 1"}
+	repo.entities[2] = &ent.DiscoveredEntity{ID: 2, UniqueID: "e2", TypeCategory: "person", Name: "Entity 2"}
+	repo.entities[3] = &ent.DiscoveredEntity{ID: 3, UniqueID: "e3", TypeCategory: "person", Name: "Entity 3"}
+
+	repo.relationships[1] = &ent.Relationship{ID: 1, Type: "KNOWS", FromID: 1, ToID: 2, Timestamp: time.Date(2001, 5, 1, 0, 0, 0, 0, time.UTC)}
+	repo.relationships[2] = &ent.Relationship{ID: 2, Type: "KNOWS", FromID: 2, ToID: 3, Timestamp: time.Date(2001, 12, 10, 0, 0, 0, 0, time.UTC)}
+
+	handler := NewHandler(repo)
+
+	findPath := func(body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/entities/path", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		handler.FindPath(w, req)
+		return w
+	}
+
+	// The path only exists after the second relationship
+	assert.Equal(t, http.StatusOK, findPath(`{"source_id": 1, "target_id": 3}`).Code)
+	assert.Equal(t, http.StatusNotFound, findPath(`{"source_id": 1, "target_id": 3, "as_of": "2001-12-02"}`).Code)
+	assert.Equal(t, http.StatusOK, findPath(`{"source_id": 1, "target_id": 2, "as_of": "2001-12-02"}`).Code)
+	assert.Equal(t, http.StatusOK, findPath(`{"source_id": 2, "target_id": 3, "since": "2001-12-01T00:00:00Z"}`).Code)
+}
+
 func TestFindPath_InvalidRequestBody(t *testing.T) {
 	repo := newMockRepository()
 	handler := NewHandler(repo)
@@ -578,6 +898,11 @@ func TestFindPath_InvalidRequestBody(t *testing.T) {
 		{"missing source_id", `{"target_id": 2}`},
 		{"missing target_id", `{"source_id": 1}`},
 		{"invalid source_id type", `{"source_id": "abc", "target_id": 2}`},
+		{"invalid as_of", `{"source_id": 1, "target_id": 2, "as_of": "bankruptcy"}`},
+		{"until and as_of", `{"source_id": 1, "target_id": 2, "until": "2001-12-02", "as_of": "2001-12-02"}`},
+		{"unknown weight", `{"source_id": 1, "target_id": 2, "weight": "salary"}`},
+		{"too many paths", `{"source_id": 1, "target_id": 2, "k": 50}`},
+		{"negative max_degree", `{"source_id": 1, "target_id": 2, "max_degree": -1}`},
 	}
 
 	for _, tc := range testCases {
@@ -734,3 +1059,307 @@ func TestSemanticSearch_RankedResults(t *testing.T) {
 		}
 	}
 }
+
+func TestMergeEntities_Success(t *testing.T) {
+	repo := newMockRepository()
+	repo.entities[1] = &ent.DiscoveredEntity{ID: 1, UniqueID: "jeff.skilling@enron.com", TypeCategory: "person", Name: "Jeff Skilling"}
+	repo.entities[2] = &ent.DiscoveredEntity{ID: 2, UniqueID: "person:jeffrey skilling", TypeCategory: "person", Name: "Jeffrey Skilling"}
+	repo.entities[3] = &ent.DiscoveredEntity{ID: 3, UniqueID: "enron-corp", TypeCategory: "organization", Name: "Enron Corp"}
+	repo.relationships[1] = &ent.Relationship{ID: 1, Type: "WORKS_AT", FromType: "discovered_entity", FromID: 2, ToType: "discovered_entity", ToID: 3}
+
+	handler := NewHandler(repo)
+
+	body := bytes.NewBufferString(`{"merge_ids": [2], "reason": "same person"}`)
+	req := httptest.NewRequest(http.MethodPost, "/entities/1/merge", body)
+	w := httptest.NewRecorder()
+	handler.MergeEntities(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response MergeResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+	assert.Equal(t, 1, response.Survivor.ID)
+	assert.Equal(t, []int{2}, response.MergedIDs)
+	assert.Equal(t, 1, response.RelationshipsMoved)
+	assert.NotZero(t, response.AuditID)
+	assert.Equal(t, 1, repo.relationships[1].FromID)
+
+	// The audit trail is kept on the survivor
+	req = httptest.NewRequest(http.MethodGet, "/entities/1/audit", nil)
+	w = httptest.NewRecorder()
+	handler.GetEntityAudit(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var audit AuditListResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&audit))
+	require.Len(t, audit.Entries, 1)
+	assert.Equal(t, "merge", audit.Entries[0].Operation)
+	assert.Equal(t, []int{2}, audit.Entries[0].RelatedIDs)
+	assert.Equal(t, "same person", audit.Entries[0].Reason)
+
+	// ... and served for the merged-away entity
+	req = httptest.NewRequest(http.MethodGet, "/entities/2/audit", nil)
+	w = httptest.NewRecorder()
+	handler.GetEntityAudit(w, req)
+
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&audit))
+	require.Len(t, audit.Entries, 1)
+	assert.Equal(t, 1, audit.Entries[0].EntityID)
+}
+
+func TestMergeEntities_Validation(t *testing.T) {
+	repo := newMockRepository()
+	repo.entities[1] = &ent.DiscoveredEntity{ID: 1, UniqueID: "person1", TypeCategory: "person", Name: "Jeff Skilling"}
+	handler := NewHandler(repo)
+
+	testCases := []struct {
+		name     string
+		path     string
+		body     string
+		expected int
+	}{
+		{"missing merge ids", "/entities/1/merge", `{}`, http.StatusBadRequest},
+		{"merge into itself", "/entities/1/merge", `{"merge_ids": [1]}`, http.StatusBadRequest},
+		{"invalid body", "/entities/1/merge", `not json`, http.StatusBadRequest},
+		{"invalid id", "/entities/abc/merge", `{"merge_ids": [2]}`, http.StatusBadRequest},
+		{"unknown survivor", "/entities/99/merge", `{"merge_ids": [1]}`, http.StatusNotFound},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, tc.path, bytes.NewBufferString(tc.body))
+			w := httptest.NewRecorder()
+			handler.MergeEntities(w, req)
+			assert.Equal(t, tc.expected, w.Code)
+		})
+	}
+}
+
+func TestSplitEntity_Success(t *testing.T) {
+	repo := newMockRepository()
+	repo.entities[1] = &ent.DiscoveredEntity{ID: 1, UniqueID: "jeff.skilling@enron.com", TypeCategory: "person", Name: "Jeff Skilling"}
+	repo.entities[3] = &ent.DiscoveredEntity{ID: 3, UniqueID: "enron-corp", TypeCategory: "organization", Name: "Enron Corp"}
+	repo.relationships[1] = &ent.Relationship{ID: 1, Type: "WORKS_AT", FromType: "discovered_entity", FromID: 1, ToType: "discovered_entity", ToID: 3}
+
+	handler := NewHandler(repo)
+
+	body := bytes.NewBufferString(`{"unique_id": "jeff.shankman@enron.com", "name": "Jeff Shankman", "relationship_ids": [1]}`)
+	req := httptest.NewRequest(http.MethodPost, "/entities/1/split", body)
+	w := httptest.NewRecorder()
+	handler.SplitEntity(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	var response SplitResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+	assert.Equal(t, 1, response.Source.ID)
+	assert.Equal(t, "Jeff Shankman", response.Created.Name)
+	assert.Equal(t, 1, response.RelationshipsMoved)
+	assert.Equal(t, response.Created.ID, repo.relationships[1].FromID)
+}
+
+func TestSplitEntity_RequiresIdentity(t *testing.T) {
+	repo := newMockRepository()
+	repo.entities[1] = &ent.DiscoveredEntity{ID: 1, UniqueID: "person1", TypeCategory: "person", Name: "Jeff Skilling"}
+	handler := NewHandler(repo)
+
+	req := httptest.NewRequest(http.MethodPost, "/entities/1/split", bytes.NewBufferString(`{"name": "Jeff Shankman"}`))
+	w := httptest.NewRecorder()
+	handler.SplitEntity(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestGetThread_Success(t *testing.T) {
+	repo := newMockRepository()
+	date := time.Date(2001, 5, 14, 9, 0, 0, 0, time.UTC)
+	repo.threads[1] = &ent.Thread{
+		ID:            1,
+		RootMessageID: "root@enron.com",
+		Subject:       "Q2 forecast",
+		MessageCount:  2,
+		Participants:  []string{"jeff.skilling@enron.com", "kenneth.lay@enron.com"},
+		FirstDate:     date,
+		LastDate:      date.Add(time.Hour),
+	}
+	repo.threadEmails[1] = []*graph.ThreadMessage{
+		{Email: &ent.Email{ID: 10, MessageID: "root@enron.com", From: "kenneth.lay@enron.com", Subject: "Q2 forecast", Date: date}},
+		{
+			Email:      &ent.Email{ID: 11, MessageID: "reply@enron.com", From: "jeff.skilling@enron.com", Subject: "RE: Q2 forecast", Date: date.Add(time.Hour)},
+			ParentID:   10,
+			Method:     graph.ThreadByInReplyTo,
+			Confidence: 1.0,
+		},
+	}
+	handler := NewHandler(repo)
+
+	req := httptest.NewRequest(http.MethodGet, "/threads/1", nil)
+	w := httptest.NewRecorder()
+	handler.GetThread(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var response ThreadResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+	assert.Equal(t, "Q2 forecast", response.Subject)
+	assert.Equal(t, 2, response.MessageCount)
+	require.Len(t, response.Messages, 2)
+	assert.Zero(t, response.Messages[0].ParentID)
+	assert.Equal(t, 10, response.Messages[1].ParentID)
+	assert.Equal(t, graph.ThreadByInReplyTo, response.Messages[1].Method)
+
+	// The same thread is reachable from any of its emails
+	req = httptest.NewRequest(http.MethodGet, "/emails/11/thread", nil)
+	w = httptest.NewRecorder()
+	handler.GetEmailThread(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var byEmail ThreadResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&byEmail))
+	assert.Equal(t, 1, byEmail.ID)
+}
+
+func TestGetThread_NotFound(t *testing.T) {
+	handler := NewHandler(newMockRepository())
+
+	testCases := []struct {
+		name     string
+		path     string
+		handle   http.HandlerFunc
+		expected int
+	}{
+		{"unknown thread", "/threads/99", handler.GetThread, http.StatusNotFound},
+		{"invalid thread id", "/threads/abc", handler.GetThread, http.StatusBadRequest},
+		{"unthreaded email", "/emails/99/thread", handler.GetEmailThread, http.StatusNotFound},
+		{"invalid email id", "/emails/abc/thread", handler.GetEmailThread, http.StatusBadRequest},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tc.path, nil)
+			w := httptest.NewRecorder()
+			tc.handle(w, req)
+			assert.Equal(t, tc.expected, w.Code)
+		})
+	}
+}
+
+func TestCentralityEndpoints_Validation(t *testing.T) {
+	repo := newMockRepository()
+	repo.entities[1] = &ent.DiscoveredEntity{ID: 1, UniqueID: "jeff@enron.com", Name: "Jeff"}
+	handler := NewHandler(repo)
+
+	testCases := []struct {
+		name     string
+		method   string
+		path     string
+		body     string
+		handle   http.HandlerFunc
+		expected int
+	}{
+		{"unknown metric", http.MethodGet, "/analytics/centrality?metric=fame", "", handler.GetCentralityRanking, http.StatusBadRequest},
+		{"limit out of range", http.MethodGet, "/analytics/centrality?limit=0", "", handler.GetCentralityRanking, http.StatusBadRequest},
+		{"invalid since", http.MethodGet, "/analytics/centrality?since=yesterday", "", handler.GetCentralityRanking, http.StatusBadRequest},
+		{"empty window", http.MethodGet, "/analytics/centrality?since=2001-06-01&until=2001-01-01", "", handler.GetCentralityRanking, http.StatusBadRequest},
+		{"ranking without database", http.MethodGet, "/analytics/centrality", "", handler.GetCentralityRanking, http.StatusServiceUnavailable},
+		{"malformed body", http.MethodPost, "/analytics/centrality", "{", handler.ComputeCentrality, http.StatusBadRequest},
+		{"negative samples", http.MethodPost, "/analytics/centrality", `{"samples": -1}`, handler.ComputeCentrality, http.StatusBadRequest},
+		{"compute without database", http.MethodPost, "/analytics/centrality", `{}`, handler.ComputeCentrality, http.StatusServiceUnavailable},
+		{"invalid entity id", http.MethodGet, "/entities/abc/centrality", "", handler.GetEntityCentrality, http.StatusBadRequest},
+		{"unknown entity", http.MethodGet, "/entities/99/centrality", "", handler.GetEntityCentrality, http.StatusNotFound},
+		{"entity without database", http.MethodGet, "/entities/1/centrality", "", handler.GetEntityCentrality, http.StatusServiceUnavailable},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(tc.method, tc.path, bytes.NewBufferString(tc.body))
+			w := httptest.NewRecorder()
+			tc.handle(w, req)
+			assert.Equal(t, tc.expected, w.Code)
+		})
+	}
+}
+
+func TestAnalyticsRuns(t *testing.T) {
+	handler := NewHandler(newMockRepository())
+	release := make(chan struct{})
+
+	w := httptest.NewRecorder()
+	handler.startAnalyticsRun(w, runCommunities, func(ctx context.Context) (interface{}, error) {
+		<-release
+		return CommunityRunResponse{Communities: 3}, nil
+	})
+	require.Equal(t, http.StatusAccepted, w.Code)
+	var run AnalyticsRunResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&run))
+	assert.Equal(t, runRunning, run.Status)
+	assert.Equal(t, fmt.Sprintf("/api/v1/analytics/runs/%d", run.ID), w.Header().Get("Location"))
+
+	// A second run of the same kind is refused while the first one runs
+	w = httptest.NewRecorder()
+	handler.startAnalyticsRun(w, runCommunities, func(ctx context.Context) (interface{}, error) {
+		t.Error("Expected the second run not to start")
+		return nil, nil
+	})
+	assert.Equal(t, http.StatusConflict, w.Code)
+
+	close(release)
+	require.Eventually(t, func() bool {
+		finished, _ := handler.runs.get(run.ID)
+		return finished.Status == runDone
+	}, time.Second, time.Millisecond)
+
+	w = httptest.NewRecorder()
+	handler.GetAnalyticsRun(w, httptest.NewRequest(http.MethodGet, fmt.Sprintf("/analytics/runs/%d", run.ID), nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"communities":3`)
+
+	// Failures are reported on the run
+	w = httptest.NewRecorder()
+	handler.startAnalyticsRun(w, runCentrality, func(ctx context.Context) (interface{}, error) {
+		return nil, errors.New("graph too large")
+	})
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&run))
+	require.Eventually(t, func() bool {
+		failed, _ := handler.runs.get(run.ID)
+		return failed.Status == runFailed && failed.Error == "graph too large"
+	}, time.Second, time.Millisecond)
+
+	w = httptest.NewRecorder()
+	handler.GetAnalyticsRun(w, httptest.NewRequest(http.MethodGet, "/analytics/runs/99", nil))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestCommunityEndpoints_Validation(t *testing.T) {
+	repo := newMockRepository()
+	repo.entities[1] = &ent.DiscoveredEntity{ID: 1, UniqueID: "jeff@enron.com", Name: "Jeff"}
+	handler := NewHandler(repo)
+
+	testCases := []struct {
+		name     string
+		method   string
+		path     string
+		body     string
+		handle   http.HandlerFunc
+		expected int
+	}{
+		{"invalid min_size", http.MethodGet, "/analytics/communities?min_size=0", "", handler.ListCommunities, http.StatusBadRequest},
+		{"limit out of range", http.MethodGet, "/analytics/communities?limit=5000", "", handler.ListCommunities, http.StatusBadRequest},
+		{"list without database", http.MethodGet, "/analytics/communities", "", handler.ListCommunities, http.StatusServiceUnavailable},
+		{"malformed body", http.MethodPost, "/analytics/communities", "{", handler.DetectCommunities, http.StatusBadRequest},
+		{"negative resolution", http.MethodPost, "/analytics/communities", `{"resolution": -1}`, handler.DetectCommunities, http.StatusBadRequest},
+		{"detect without database", http.MethodPost, "/analytics/communities", `{}`, handler.DetectCommunities, http.StatusServiceUnavailable},
+		{"invalid community id", http.MethodGet, "/communities/abc", "", handler.GetCommunity, http.StatusBadRequest},
+		{"community without database", http.MethodGet, "/communities/3", "", handler.GetCommunity, http.StatusServiceUnavailable},
+		{"unknown entity", http.MethodGet, "/entities/99/community", "", handler.GetEntityCommunity, http.StatusNotFound},
+		{"entity without database", http.MethodGet, "/entities/1/community", "", handler.GetEntityCommunity, http.StatusServiceUnavailable},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(tc.method, tc.path, bytes.NewBufferString(tc.body))
+			w := httptest.NewRecorder()
+			tc.handle(w, req)
+			assert.Equal(t, tc.expected, w.Code)
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Blogem/enron-graph/ent"
+	"github.com/go-chi/chi/v5"
+)
+
+// ThreadMessageResponse represents an email within a thread
+type ThreadMessageResponse struct {
+	ID         int      `json:"id"`
+	MessageID  string   `json:"message_id"`
+	From       string   `json:"from"`
+	To         []string `json:"to"`
+	Subject    string   `json:"subject"`
+	Date       string   `json:"date"`
+	Body       string   `json:"body"`
+	ParentID   int      `json:"parent_id,omitempty"`
+	Method     string   `json:"method,omitempty"`
+	Confidence float64  `json:"confidence,omitempty"`
+}
+
+// ThreadResponse represents a conversation thread with its messages, oldest first
+type ThreadResponse struct {
+	ID            int                     `json:"id"`
+	RootMessageID string                  `json:"root_message_id"`
+	Subject       string                  `json:"subject"`
+	MessageCount  int                     `json:"message_count"`
+	Participants  []string                `json:"participants"`
+	FirstDate     string                  `json:"first_date,omitempty"`
+	LastDate      string                  `json:"last_date,omitempty"`
+	Messages      []ThreadMessageResponse `json:"messages"`
+}
+
+// GetThread handles GET /threads/:id
+func (h *Handler) GetThread(w http.ResponseWriter, r *http.Request) {
+	id, err := pathIDParam(r, "threads")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid thread id", "")
+		return
+	}
+
+	thread, err := h.repo.GetThread(r.Context(), id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			respondError(w, http.StatusNotFound, "thread not found", "")
+			return
+		}
+		respondError(w, http.StatusInternalServerError, "failed to fetch thread", err.Error())
+		return
+	}
+
+	h.respondThread(w, r, thread)
+}
+
+// GetEmailThread handles GET /emails/:id/thread
+func (h *Handler) GetEmailThread(w http.ResponseWriter, r *http.Request) {
+	id, err := pathIDParam(r, "emails")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid email id", "")
+		return
+	}
+
+	thread, err := h.repo.FindThreadByEmail(r.Context(), id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			respondError(w, http.StatusNotFound, "email is not part of a thread", "")
+			return
+		}
+		respondError(w, http.StatusInternalServerError, "failed to fetch thread", err.Error())
+		return
+	}
+
+	h.respondThread(w, r, thread)
+}
+
+// respondThread writes a thread together with its messages
+func (h *Handler) respondThread(w http.ResponseWriter, r *http.Request, thread *ent.Thread) {
+	messages, err := h.repo.FindThreadEmails(r.Context(), thread.ID)
+	if err != nil {
+		respondError(w, http.StatusInternalServerError, "failed to fetch thread messages", err.Error())
+		return
+	}
+
+	response := ThreadResponse{
+		ID:            thread.ID,
+		RootMessageID: thread.RootMessageID,
+		Subject:       thread.Subject,
+		MessageCount:  thread.MessageCount,
+		Participants:  thread.Participants,
+		Messages:      make([]ThreadMessageResponse, len(messages)),
+	}
+	if !thread.FirstDate.IsZero() {
+		response.FirstDate = thread.FirstDate.Format(time.RFC3339)
+	}
+	if !thread.LastDate.IsZero() {
+		response.LastDate = thread.LastDate.Format(time.RFC3339)
+	}
+	if response.Participants == nil {
+		response.Participants = []string{}
+	}
+
+	for i, message := range messages {
+		response.Messages[i] = ThreadMessageResponse{
+			ID:         message.Email.ID,
+			MessageID:  message.Email.MessageID,
+			From:       message.Email.From,
+			To:         message.Email.To,
+			Subject:    message.Email.Subject,
+			Date:       message.Email.Date.Format(time.RFC3339),
+			Body:       message.Email.Body,
+			ParentID:   message.ParentID,
+			Method:     message.Method,
+			Confidence: message.Confidence,
+		}
+	}
+
+	respondJSON(w, http.StatusOK, response)
+}
+
+// pathIDParam extracts the ID following a path segment, such as the 7 in /threads/7
+func pathIDParam(r *http.Request, segment string) (int, error) {
+	idStr := chi.URLParam(r, "id")
+	if idStr == "" {
+		// Fallback for tests that don't use chi router
+		parts := strings.Split(r.URL.Path, "/")
+		for i, part := range parts {
+			if part == segment && i+1 < len(parts) {
+				idStr = parts[i+1]
+				break
+			}
+		}
+	}
+	return strconv.Atoi(idStr)
+}
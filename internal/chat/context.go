@@ -26,10 +26,17 @@ func NewContext() Context {
 
 // AddQuery adds a query and response to the conversation history
 func (c *chatContext) AddQuery(query, response string) {
+	c.AddQueryWithPrompt(query, response, "")
+}
+
+// AddQueryWithPrompt adds a query and response to the conversation history, recording the
+// version of the prompt template the response was generated with
+func (c *chatContext) AddQueryWithPrompt(query, response, promptVersion string) {
 	entry := HistoryEntry{
-		Query:     query,
-		Response:  response,
-		Timestamp: time.Now(),
+		Query:         query,
+		Response:      response,
+		Timestamp:     time.Now(),
+		PromptVersion: promptVersion,
 	}
 
 	c.history = append(c.history, entry)
@@ -5,8 +5,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/Blogem/enron-graph/internal/extractor"
+	"github.com/Blogem/enron-graph/internal/prompts"
+	"github.com/Blogem/enron-graph/pkg/llm"
 )
 
 // chatHandler implements the Handler interface for processing chat queries
@@ -14,14 +17,45 @@ type chatHandler struct {
 	llm       LLMClient
 	repo      Repository
 	formatter ResponseFormatter
+	maxSteps  int
+	prompt    *prompts.Prompt
+
+	schemaMu sync.RWMutex
+	schema   *SchemaSummary
 }
 
 // NewHandler creates a new chat handler
 func NewHandler(llm LLMClient, repo Repository) Handler {
+	return NewHandlerWithMaxSteps(llm, repo, DefaultMaxSteps)
+}
+
+// NewHandlerWithMaxSteps creates a new chat handler that makes at most maxSteps
+// graph tool calls per query
+func NewHandlerWithMaxSteps(llm LLMClient, repo Repository, maxSteps int) Handler {
+	return newChatHandler(llm, repo, maxSteps, prompts.DefaultPrompt(prompts.ChatSystem))
+}
+
+// NewHandlerWithPrompts creates a new chat handler whose system prompt is the chat_system
+// template selected in registry. Its version is recorded with every query in the chat history.
+func NewHandlerWithPrompts(llm LLMClient, repo Repository, maxSteps int, registry *prompts.Registry) (Handler, error) {
+	prompt, err := registry.Get(prompts.ChatSystem)
+	if err != nil {
+		return nil, err
+	}
+	return newChatHandler(llm, repo, maxSteps, prompt), nil
+}
+
+// newChatHandler creates a chat handler with the given system prompt
+func newChatHandler(llm LLMClient, repo Repository, maxSteps int, prompt *prompts.Prompt) *chatHandler {
+	if maxSteps < 1 {
+		maxSteps = 1
+	}
 	return &chatHandler{
 		llm:       llm,
 		repo:      repo,
 		formatter: NewResponseFormatter(),
+		maxSteps:  maxSteps,
+		prompt:    prompt,
 	}
 }
 
@@ -45,71 +79,91 @@ func (h *chatHandler) ProcessQuery(ctx context.Context, query string, chatContex
 	promptContext := chatContext.BuildPromptContext(query)
 
 	// Create system prompt with schema information
-	systemPrompt := h.buildSystemPrompt()
-
-	// Combine system prompt and user context
-	fullPrompt := fmt.Sprintf("%s\n\n%s", systemPrompt, promptContext)
-
-	// Call LLM to process the query
-	llmOutput, err := h.llm.GenerateCompletion(ctx, fullPrompt)
+	systemPrompt, err := h.buildSystemPrompt()
 	if err != nil {
-		return "", fmt.Errorf("LLM error: %w", err)
+		return "", err
 	}
 
-	// Clean JSON response (remove markdown code fences if present)
-	cleanedOutput := extractor.CleanJSONResponse(llmOutput)
-
-	// Try to parse as JSON for structured query
-	var llmResp llmResponse
-	if err := json.Unmarshal([]byte(cleanedOutput), &llmResp); err != nil {
-		// If not valid JSON, treat as direct text response
-		response := "Unable to parse output as JSON: \n " + llmOutput
-		chatContext.AddQuery(query, response)
-		return response, nil
-	}
+	// Combine system prompt and user context
+	fullPrompt := fmt.Sprintf("%s\n\n%s", systemPrompt, promptContext)
 
-	// Execute the appropriate action based on LLM response
-	response, err := h.executeAction(ctx, &llmResp, chatContext)
+	// Let the LLM chain graph tool calls until it can answer
+	response, err := h.runPlanner(ctx, fullPrompt, chatContext)
 	if err != nil {
-		// Handle errors gracefully - some errors like "entity not found" should return a user-friendly message
-		if strings.Contains(err.Error(), "not found") {
-			response = fmt.Sprintf("I couldn't find that entity. %s", err.Error())
-			chatContext.AddQuery(query, response)
-			return response, nil
-		}
 		return "", err
 	}
 
-	// Add to conversation history
-	chatContext.AddQuery(query, response)
+	// Add to conversation history, noting which prompt produced the response
+	chatContext.AddQueryWithPrompt(query, response, h.prompt.Version)
 
 	return response, nil
 }
 
-// TODO: replace with tools. Also make the rel_type dynamic based on actual relationships in the graph.
-// buildSystemPrompt creates the system prompt with schema information
-func (h *chatHandler) buildSystemPrompt() string {
-	return `You are a graph database assistant. You help users query a knowledge graph of emails and entities.
+// requestAction asks the LLM for the next action. Clients that support structured output
+// are constrained to actionSchema; others fall back to cleaning a free-form completion.
+// A nil action with a nil error means the raw output could not be parsed.
+func (h *chatHandler) requestAction(ctx context.Context, prompt string) (*llmResponse, string, error) {
+	if structured, ok := h.llm.(StructuredLLMClient); ok {
+		payload, err := structured.GenerateStructured(ctx, prompt, actionSchema())
+		if err != nil {
+			return nil, "", err
+		}
+		var resp llmResponse
+		if err := json.Unmarshal(payload, &resp); err != nil {
+			return nil, string(payload), nil
+		}
+		return &resp, string(payload), nil
+	}
 
-Available actions:
-- entity_lookup: Find an entity by name (respond with JSON: {"action": "entity_lookup", "entity": "name"})
-- relationship: Find relationships for an entity (respond with JSON: {"action": "relationship", "entity": "name", "rel_type": "SENT|RECEIVED|MENTIONS|COMMUNICATES_WITH"})
-- path_finding: Find the shortest path between two entities (respond with JSON: {"action": "path_finding", "source": "name1", "target": "name2"})
-- semantic_search: Search for entities by concept (respond with JSON: {"action": "semantic_search", "text": "search text"})
-- aggregation: Count relationships (respond with JSON: {"action": "aggregation", "entity": "name", "rel_type": "SENT|RECEIVED"})
+	llmOutput, err := h.llm.GenerateCompletion(ctx, prompt)
+	if err != nil {
+		return nil, "", err
+	}
 
-Entity types: person, organization, concept
-Relationship types: SENT, RECEIVED, MENTIONS, COMMUNICATES_WITH
+	// Clean JSON response (remove markdown code fences if present)
+	cleanedOutput := extractor.CleanJSONResponse(llmOutput)
 
-IMPORTANT QUERY PATTERNS:
-- "what is X?" or "who is X?" -> entity_lookup for X
-- "what is the relationship between X and Y?" or "how are X and Y connected?" -> path_finding with source=X and target=Y
-- "what did X send?" or "who did X communicate with?" -> relationship traversal for X
-- Questions asking about connections between TWO entities should ALWAYS use path_finding
+	var resp llmResponse
+	if err := json.Unmarshal([]byte(cleanedOutput), &resp); err != nil {
+		return nil, llmOutput, nil
+	}
+	return &resp, llmOutput, nil
+}
 
-When the query is a simple question that can be answered directly without database lookup, respond with JSON: {"action": "answer", "answer": "your response"}
+// actionSchema returns the response schema for chat actions, mirroring llmResponse
+func actionSchema() *llm.ResponseSchema {
+	stringField := map[string]interface{}{"type": "string"}
+	return &llm.ResponseSchema{
+		Name:        "graph_action",
+		Description: "Select the graph operation that answers the user's query",
+		Schema: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"action": map[string]interface{}{
+					"type": "string",
+					"enum": []interface{}{"entity_lookup", "relationship", "path_finding", "semantic_search", "aggregation", "answer"},
+				},
+				"entity":      stringField,
+				"source":      stringField,
+				"target":      stringField,
+				"rel_type":    stringField,
+				"text":        stringField,
+				"answer":      stringField,
+				"entity_type": stringField,
+			},
+			"required": []string{"action"},
+		},
+	}
+}
 
-Always respond with valid JSON.`
+// buildSystemPrompt renders the chat_system prompt template with schema information.
+// The ontology comes from the live graph when the repository can describe it.
+func (h *chatHandler) buildSystemPrompt() (string, error) {
+	summary := h.schemaSummary()
+	return h.prompt.Render(prompts.ChatSystemData{
+		RelationshipTypes: strings.Join(summary.relationshipTypeNames(), "|"),
+		Ontology:          formatOntology(summary),
+	})
 }
 
 // executeAction executes the action specified by the LLM response
@@ -149,9 +203,10 @@ func (h *chatHandler) executeEntityLookup(entityName string, chatContext Context
 	chatContext.TrackEntity(entity.Name, entity.Type, entity.ID)
 
 	response := h.formatter.FormatEntities([]*Entity{entity})
+	h.citeEntities(&response, []*Entity{entity})
 
 	fmt.Printf("[executeEntityLookup] FormattedResponse before JSON marshal:\n")
-	fmt.Printf("  Text: %q\n", response.Text[:50])
+	fmt.Printf("  Text: %q\n", response.Text[:min(len(response.Text), 50)])
 	fmt.Printf("  Entities count: %d\n", len(response.Entities))
 	for i, e := range response.Entities {
 		fmt.Printf("  Entity[%d]: ID=%d, Name=%q, Type=%q, UniqueID=%q\n", i, e.ID, e.Name, e.Type, e.UniqueID)
@@ -190,6 +245,7 @@ func (h *chatHandler) executeRelationship(entityName, relType string, chatContex
 	}
 
 	response := h.formatter.FormatEntities(relatedEntities)
+	h.citeRelationships(&response, entity, relatedEntities, relType)
 
 	fmt.Printf("[executeRelationship] FormattedResponse before JSON marshal:\n")
 	fmt.Printf("  Entities count: %d\n", len(response.Entities))
@@ -232,6 +288,7 @@ func (h *chatHandler) executePathFinding(sourceName, targetName string, chatCont
 	}
 
 	response := h.formatter.FormatPath(path)
+	h.citePath(&response, path)
 	jsonBytes, err := json.Marshal(response)
 	if err != nil {
 		return "", fmt.Errorf("failed to serialize response: %w", err)
@@ -254,6 +311,7 @@ func (h *chatHandler) executeSemanticSearch(ctx context.Context, searchText stri
 	}
 
 	response := h.formatter.FormatEntities(entities)
+	h.citeEntities(&response, entities)
 	jsonBytes, err := json.Marshal(response)
 	if err != nil {
 		return "", fmt.Errorf("failed to serialize response: %w", err)
@@ -280,6 +338,7 @@ func (h *chatHandler) executeAggregation(entityName, relType string, chatContext
 
 	description := fmt.Sprintf("%s %s relationships for %s", relType, entity.Type, entity.Name)
 	response := h.formatter.FormatCount(count, description)
+	h.citeEntities(&response, []*Entity{entity})
 	jsonBytes, err := json.Marshal(response)
 	if err != nil {
 		return "", fmt.Errorf("failed to serialize response: %w", err)
@@ -287,6 +346,91 @@ func (h *chatHandler) executeAggregation(entityName, relType string, chatContext
 	return string(jsonBytes), nil
 }
 
+// maxCitationsPerSubject caps the supporting emails listed for each entity or relationship
+const maxCitationsPerSubject = 3
+
+// citeEntities attaches the source emails of each entity to the response.
+// Citations are best effort: lookup failures leave the answer without them.
+func (h *chatHandler) citeEntities(response *FormattedResponse, entities []*Entity) {
+	repo, ok := h.repo.(CitationRepository)
+	if !ok {
+		return
+	}
+
+	for _, entity := range entities {
+		if entity == nil {
+			continue
+		}
+		citations, err := repo.FindEntityCitations(entity.ID, maxCitationsPerSubject)
+		if err != nil {
+			continue
+		}
+		response.addCitations(citations)
+	}
+}
+
+// citeRelationships attaches the emails supporting the relationships from source to each related entity
+func (h *chatHandler) citeRelationships(response *FormattedResponse, source *Entity, related []*Entity, relType string) {
+	repo, ok := h.repo.(CitationRepository)
+	if !ok {
+		return
+	}
+
+	for _, target := range related {
+		if target == nil {
+			continue
+		}
+		citations, err := repo.FindRelationshipCitations(source.ID, target.ID, relType, maxCitationsPerSubject)
+		if err != nil {
+			continue
+		}
+		response.addCitations(citations)
+	}
+}
+
+// citePath attaches the emails supporting each hop of a path
+func (h *chatHandler) citePath(response *FormattedResponse, path []*PathNode) {
+	repo, ok := h.repo.(CitationRepository)
+	if !ok {
+		return
+	}
+
+	if len(path) == 1 && path[0].Entity != nil {
+		h.citeEntities(response, []*Entity{path[0].Entity})
+		return
+	}
+
+	for i := 0; i+1 < len(path); i++ {
+		from, to := path[i].Entity, path[i+1].Entity
+		if from == nil || to == nil {
+			continue
+		}
+		// Repository adapters disagree on which node carries the hop's type,
+		// so let the repository match any relationship between the pair
+		citations, err := repo.FindRelationshipCitations(from.ID, to.ID, "", maxCitationsPerSubject)
+		if err != nil {
+			continue
+		}
+		response.addCitations(citations)
+	}
+}
+
+// addCitations appends citations, skipping ones already present for the same subject
+func (r *FormattedResponse) addCitations(citations []Citation) {
+	for _, citation := range citations {
+		duplicate := false
+		for _, existing := range r.Citations {
+			if existing == citation {
+				duplicate = true
+				break
+			}
+		}
+		if !duplicate {
+			r.Citations = append(r.Citations, citation)
+		}
+	}
+}
+
 // responseFormatter implements the ResponseFormatter interface
 type responseFormatter struct{}
 
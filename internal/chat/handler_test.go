@@ -2,8 +2,15 @@ package chat
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+
+	"github.com/Blogem/enron-graph/internal/prompts"
+	"github.com/Blogem/enron-graph/pkg/llm"
 )
 
 // MockLLMClient is a mock implementation of the LLM client for testing
@@ -70,6 +77,21 @@ func (m *MockRepository) CountRelationships(entityID int, relType string) (int,
 	return 0, errors.New("not implemented")
 }
 
+// MockCitationRepository adds citation lookups to MockRepository
+type MockCitationRepository struct {
+	MockRepository
+	EntityCitations       map[int][]Citation
+	RelationshipCitations map[[2]int][]Citation
+}
+
+func (m *MockCitationRepository) FindEntityCitations(entityID int, limit int) ([]Citation, error) {
+	return m.EntityCitations[entityID], nil
+}
+
+func (m *MockCitationRepository) FindRelationshipCitations(fromID, toID int, relType string, limit int) ([]Citation, error) {
+	return m.RelationshipCitations[[2]int{fromID, toID}], nil
+}
+
 // TestProcessQueryWithMockLLM tests query processing with mock LLM responses
 func TestProcessQueryWithMockLLM(t *testing.T) {
 	mockLLM := &MockLLMClient{
@@ -400,6 +422,83 @@ func TestPathFindingQuery(t *testing.T) {
 	}
 }
 
+// TestEntityLookupIncludesCitations tests that answers cite their source emails
+func TestEntityLookupIncludesCitations(t *testing.T) {
+	mockLLM := &MockLLMClient{
+		GenerateCompletionFunc: func(ctx context.Context, prompt string) (string, error) {
+			return `{"action": "entity_lookup", "entity": "Jeff Skilling"}`, nil
+		},
+	}
+
+	citation := Citation{MessageID: "<1@enron.com>", Subject: "Q3 results", Date: "2001-10-16", EntityID: 1}
+	mockRepo := &MockCitationRepository{
+		MockRepository: MockRepository{
+			FindEntityByNameFunc: func(name string) (*Entity, error) {
+				return &Entity{ID: 1, Name: "Jeff Skilling", Type: "person", UniqueID: "jeff.skilling@enron.com"}, nil
+			},
+		},
+		// The same email listed twice is cited once
+		EntityCitations: map[int][]Citation{1: {citation, citation}},
+	}
+
+	handler := NewHandler(mockLLM, mockRepo)
+	response, err := handler.ProcessQuery(context.Background(), "Who is Jeff Skilling?", NewContext())
+	if err != nil {
+		t.Fatalf("ProcessQuery() error = %v", err)
+	}
+
+	var formatted FormattedResponse
+	if err := json.Unmarshal([]byte(response), &formatted); err != nil {
+		t.Fatalf("Response is not a FormattedResponse: %v", err)
+	}
+	if len(formatted.Citations) != 1 || formatted.Citations[0] != citation {
+		t.Errorf("Expected one citation %+v, got %+v", citation, formatted.Citations)
+	}
+}
+
+// TestPathFindingIncludesCitations tests that each hop of a path is cited
+func TestPathFindingIncludesCitations(t *testing.T) {
+	mockLLM := &MockLLMClient{
+		GenerateCompletionFunc: func(ctx context.Context, prompt string) (string, error) {
+			return `{"action": "path_finding", "source": "Jeff Skilling", "target": "Kenneth Lay"}`, nil
+		},
+	}
+
+	mockRepo := &MockCitationRepository{
+		MockRepository: MockRepository{
+			FindEntityByNameFunc: func(name string) (*Entity, error) {
+				if name == "Jeff Skilling" {
+					return &Entity{ID: 1, Name: name, Type: "person"}, nil
+				}
+				return &Entity{ID: 2, Name: name, Type: "person"}, nil
+			},
+			FindShortestPathFunc: func(sourceID, targetID int) ([]*PathNode, error) {
+				return []*PathNode{
+					{Entity: &Entity{ID: 1, Name: "Jeff Skilling", Type: "person"}, Relationship: "COMMUNICATES_WITH"},
+					{Entity: &Entity{ID: 2, Name: "Kenneth Lay", Type: "person"}},
+				}, nil
+			},
+		},
+		RelationshipCitations: map[[2]int][]Citation{
+			{1, 2}: {{MessageID: "<2@enron.com>", Subject: "Board meeting", Date: "2001-08-14", Relationship: "COMMUNICATES_WITH"}},
+		},
+	}
+
+	handler := NewHandler(mockLLM, mockRepo)
+	response, err := handler.ProcessQuery(context.Background(), "How are Jeff Skilling and Kenneth Lay connected?", NewContext())
+	if err != nil {
+		t.Fatalf("ProcessQuery() error = %v", err)
+	}
+
+	var formatted FormattedResponse
+	if err := json.Unmarshal([]byte(response), &formatted); err != nil {
+		t.Fatalf("Response is not a FormattedResponse: %v", err)
+	}
+	if len(formatted.Citations) != 1 || formatted.Citations[0].MessageID != "<2@enron.com>" {
+		t.Errorf("Expected citation for the COMMUNICATES_WITH hop, got %+v", formatted.Citations)
+	}
+}
+
 // TestSemanticSearchQuery tests semantic/concept search execution
 func TestSemanticSearchQuery(t *testing.T) {
 	mockLLM := &MockLLMClient{
@@ -460,3 +559,110 @@ func TestAggregationQuery(t *testing.T) {
 		t.Error("ProcessQuery() returned empty response")
 	}
 }
+
+// MockStructuredLLMClient is a mock LLM client that supports schema-constrained output
+type MockStructuredLLMClient struct {
+	MockLLMClient
+	GenerateStructuredFunc func(ctx context.Context, prompt string, schema *llm.ResponseSchema) (json.RawMessage, error)
+}
+
+func (m *MockStructuredLLMClient) GenerateStructured(ctx context.Context, prompt string, schema *llm.ResponseSchema) (json.RawMessage, error) {
+	if m.GenerateStructuredFunc != nil {
+		return m.GenerateStructuredFunc(ctx, prompt, schema)
+	}
+	return nil, errors.New("not implemented")
+}
+
+// TestStructuredActionPreferred tests that structured output is used when the client supports it
+func TestStructuredActionPreferred(t *testing.T) {
+	var gotSchema *llm.ResponseSchema
+	mockLLM := &MockStructuredLLMClient{
+		MockLLMClient: MockLLMClient{
+			GenerateCompletionFunc: func(ctx context.Context, prompt string) (string, error) {
+				t.Error("GenerateCompletion should not be called for structured clients")
+				return "", nil
+			},
+		},
+		GenerateStructuredFunc: func(ctx context.Context, prompt string, schema *llm.ResponseSchema) (json.RawMessage, error) {
+			gotSchema = schema
+			return json.RawMessage(`{"action": "entity_lookup", "entity": "Jeff Skilling"}`), nil
+		},
+	}
+
+	var lookedUp string
+	mockRepo := &MockRepository{
+		FindEntityByNameFunc: func(name string) (*Entity, error) {
+			lookedUp = name
+			return &Entity{ID: 1, Name: name, Type: "person"}, nil
+		},
+	}
+
+	handler := NewHandler(mockLLM, mockRepo)
+	response, err := handler.ProcessQuery(context.Background(), "Who is Jeff Skilling?", NewContext())
+	if err != nil {
+		t.Fatalf("ProcessQuery() error = %v", err)
+	}
+	if gotSchema == nil || gotSchema.Name != "graph_action" {
+		t.Errorf("Expected graph_action schema, got %+v", gotSchema)
+	}
+	if lookedUp != "Jeff Skilling" {
+		t.Errorf("Expected lookup of Jeff Skilling, got %q", lookedUp)
+	}
+	if !strings.Contains(response, "Jeff Skilling") {
+		t.Errorf("Expected response to mention Jeff Skilling, got %s", response)
+	}
+}
+
+// TestActionSchemaAcceptsAllActions tests that every executable action validates against the schema
+func TestActionSchemaAcceptsAllActions(t *testing.T) {
+	for _, action := range []string{"entity_lookup", "relationship", "path_finding", "semantic_search", "aggregation", "answer"} {
+		payload := []byte(`{"action": "` + action + `"}`)
+		if err := llm.ValidateJSON(actionSchema(), payload); err != nil {
+			t.Errorf("action %s rejected: %v", action, err)
+		}
+	}
+
+	if err := llm.ValidateJSON(actionSchema(), []byte(`{"action": "drop_tables"}`)); err == nil {
+		t.Error("Expected unknown action to be rejected")
+	}
+}
+
+// TestPromptTemplateVersionRecorded tests that a configured system prompt is used and its version is kept in the history
+func TestPromptTemplateVersionRecorded(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, prompts.ChatSystem), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	template := `Answer as JSON. Relationships: {{.RelationshipTypes}}`
+	if err := os.WriteFile(filepath.Join(dir, prompts.ChatSystem, "v2.tmpl"), []byte(template), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	registry, err := prompts.NewRegistry(dir, nil)
+	if err != nil {
+		t.Fatalf("NewRegistry failed: %v", err)
+	}
+
+	var gotPrompt string
+	mockLLM := &MockLLMClient{
+		GenerateCompletionFunc: func(ctx context.Context, prompt string) (string, error) {
+			gotPrompt = prompt
+			return `{"action": "answer", "answer": "Hello"}`, nil
+		},
+	}
+	handler, err := NewHandlerWithPrompts(mockLLM, &MockRepository{}, DefaultMaxSteps, registry)
+	if err != nil {
+		t.Fatalf("NewHandlerWithPrompts failed: %v", err)
+	}
+
+	chatContext := NewContext()
+	if _, err := handler.ProcessQuery(context.Background(), "Hi", chatContext); err != nil {
+		t.Fatalf("ProcessQuery() error = %v", err)
+	}
+	if !strings.HasPrefix(gotPrompt, "Answer as JSON. Relationships: SENT|") {
+		t.Errorf("Expected the v2 system prompt, got %q", gotPrompt)
+	}
+	history := chatContext.GetHistory()
+	if len(history) != 1 || history[0].PromptVersion != "v2" {
+		t.Errorf("Expected the history to record prompt version v2, got %+v", history)
+	}
+}
@@ -0,0 +1,210 @@
+package chat
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// DefaultMaxSteps is the number of graph tool calls the planner may make per query
+const DefaultMaxSteps = 5
+
+// maxObservationLength truncates tool output fed back into the planner prompt
+const maxObservationLength = 1500
+
+// plannerRun accumulates the tool calls made while answering a single query
+type plannerRun struct {
+	trace     []ToolCall
+	responses []FormattedResponse
+}
+
+// runPlanner lets the LLM call graph tools repeatedly until it answers, repeats itself,
+// or runs out of steps. Each tool result is fed back to the LLM as an observation.
+func (h *chatHandler) runPlanner(ctx context.Context, basePrompt string, chatContext Context) (string, error) {
+	run := &plannerRun{}
+
+	for step := 1; step <= h.maxSteps; step++ {
+		llmResp, llmOutput, err := h.requestAction(ctx, basePrompt+run.observationsPrompt(h.maxSteps-step+1))
+		if err != nil {
+			return "", fmt.Errorf("LLM error: %w", err)
+		}
+		if llmResp == nil {
+			if len(run.trace) == 0 {
+				// If not valid JSON, treat as direct text response
+				return "Unable to parse output as JSON: \n " + llmOutput, nil
+			}
+			break
+		}
+
+		if llmResp.Action == "answer" {
+			return run.answer(llmResp.Answer)
+		}
+
+		args := actionArgs(llmResp)
+		if run.repeats(llmResp.Action, args) {
+			// The LLM has nothing new to look up; answer with what we have
+			break
+		}
+
+		observation, err := h.executeAction(ctx, llmResp, chatContext)
+		if err != nil {
+			// Lookups that find nothing are observations the LLM can recover from
+			if !strings.Contains(err.Error(), "not found") {
+				return "", err
+			}
+			run.record(step, llmResp.Action, args, "", fmt.Sprintf("I couldn't find that entity. %s", err.Error()))
+			continue
+		}
+		run.record(step, llmResp.Action, args, observation, "")
+	}
+
+	return run.conclude()
+}
+
+// record stores a tool call and its observation
+func (r *plannerRun) record(step int, action string, args map[string]string, output string, errMsg string) {
+	call := ToolCall{
+		Step:   step,
+		Action: action,
+		Args:   args,
+		Error:  errMsg,
+	}
+
+	if output != "" {
+		var formatted FormattedResponse
+		if err := json.Unmarshal([]byte(output), &formatted); err == nil && formatted.Text != "" {
+			call.Observation = formatted.Text
+			r.responses = append(r.responses, formatted)
+		} else {
+			call.Observation = output
+			r.responses = append(r.responses, FormattedResponse{Text: output, Entities: []EntityReference{}})
+		}
+	}
+
+	r.trace = append(r.trace, call)
+}
+
+// repeats reports whether the same tool was already called with the same arguments
+func (r *plannerRun) repeats(action string, args map[string]string) bool {
+	for _, call := range r.trace {
+		if call.Action != action || len(call.Args) != len(args) {
+			continue
+		}
+		same := true
+		for k, v := range args {
+			if call.Args[k] != v {
+				same = false
+				break
+			}
+		}
+		if same {
+			return true
+		}
+	}
+	return false
+}
+
+// observationsPrompt renders previous tool calls so the LLM can choose the next step
+func (r *plannerRun) observationsPrompt(stepsLeft int) string {
+	if len(r.trace) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString("\n\nPrevious steps:\n")
+	for _, call := range r.trace {
+		argsJSON, _ := json.Marshal(call.Args)
+		b.WriteString(fmt.Sprintf("Step %d: %s %s\n", call.Step, call.Action, argsJSON))
+		observation := call.Observation
+		if call.Error != "" {
+			observation = call.Error
+		}
+		if len(observation) > maxObservationLength {
+			observation = observation[:maxObservationLength] + "..."
+		}
+		b.WriteString(fmt.Sprintf("Observation: %s\n", observation))
+	}
+	b.WriteString(fmt.Sprintf("\nYou have %d step(s) left. Choose the next action, or respond with {\"action\": \"answer\", \"answer\": \"...\"} once the observations answer the question.", stepsLeft))
+
+	return b.String()
+}
+
+// answer builds the final response from the LLM's answer and everything the tools returned
+func (r *plannerRun) answer(text string) (string, error) {
+	if len(r.trace) == 0 {
+		// Answered directly without touching the graph
+		return text, nil
+	}
+
+	response := r.merge()
+	response.Text = text
+	return marshalResponse(response)
+}
+
+// conclude answers with the tool output when the LLM stopped without an explicit answer
+func (r *plannerRun) conclude() (string, error) {
+	if len(r.responses) == 0 {
+		if len(r.trace) > 0 {
+			return r.trace[len(r.trace)-1].Error, nil
+		}
+		return "I wasn't able to answer that question.", nil
+	}
+
+	response := r.merge()
+	response.Text = r.responses[len(r.responses)-1].Text
+	return marshalResponse(response)
+}
+
+// merge combines the entities and citations of every tool response and attaches the trace
+func (r *plannerRun) merge() FormattedResponse {
+	merged := FormattedResponse{
+		Entities: []EntityReference{},
+		Trace:    r.trace,
+	}
+
+	seen := make(map[int]bool)
+	for _, response := range r.responses {
+		for _, entity := range response.Entities {
+			if seen[entity.ID] {
+				continue
+			}
+			seen[entity.ID] = true
+			merged.Entities = append(merged.Entities, entity)
+		}
+		merged.addCitations(response.Citations)
+	}
+
+	return merged
+}
+
+// actionArgs collects the non-empty arguments of an LLM action for tracing
+func actionArgs(resp *llmResponse) map[string]string {
+	relType := resp.RelType
+	if relType == "" {
+		relType = resp.Relationship
+	}
+
+	args := make(map[string]string)
+	for key, value := range map[string]string{
+		"entity":      resp.Entity,
+		"source":      resp.Source,
+		"target":      resp.Target,
+		"rel_type":    relType,
+		"text":        resp.Text,
+		"entity_type": resp.EntityType,
+	} {
+		if value != "" {
+			args[key] = value
+		}
+	}
+	return args
+}
+
+func marshalResponse(response FormattedResponse) (string, error) {
+	jsonBytes, err := json.Marshal(response)
+	if err != nil {
+		return "", fmt.Errorf("failed to serialize response: %w", err)
+	}
+	return string(jsonBytes), nil
+}
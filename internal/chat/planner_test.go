@@ -0,0 +1,172 @@
+package chat
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// TestPlannerChainsToolCalls tests that observations are fed back until the LLM answers
+func TestPlannerChainsToolCalls(t *testing.T) {
+	var prompts []string
+	mockLLM := &MockLLMClient{
+		GenerateCompletionFunc: func(ctx context.Context, prompt string) (string, error) {
+			prompts = append(prompts, prompt)
+			switch len(prompts) {
+			case 1:
+				return `{"action": "relationship", "entity": "Kenneth Lay", "rel_type": "COMMUNICATES_WITH"}`, nil
+			case 2:
+				return `{"action": "relationship", "entity": "Jeff Skilling", "rel_type": "MENTIONS"}`, nil
+			default:
+				return `{"action": "answer", "answer": "Jeff Skilling mostly wrote about California."}`, nil
+			}
+		},
+	}
+
+	mockRepo := &MockRepository{
+		FindEntityByNameFunc: func(name string) (*Entity, error) {
+			if name == "Kenneth Lay" {
+				return &Entity{ID: 1, Name: name, Type: "person"}, nil
+			}
+			return &Entity{ID: 2, Name: name, Type: "person"}, nil
+		},
+		TraverseRelationshipsFunc: func(entityID int, relType string) ([]*Entity, error) {
+			if entityID == 1 {
+				return []*Entity{{ID: 2, Name: "Jeff Skilling", Type: "person"}}, nil
+			}
+			return []*Entity{{ID: 3, Name: "California", Type: "location"}}, nil
+		},
+	}
+
+	handler := NewHandler(mockLLM, mockRepo)
+	response, err := handler.ProcessQuery(context.Background(), "Who did Ken Lay's contacts email most about California?", NewContext())
+	if err != nil {
+		t.Fatalf("ProcessQuery() error = %v", err)
+	}
+
+	if len(prompts) != 3 {
+		t.Fatalf("Expected 3 LLM calls, got %d", len(prompts))
+	}
+	if !strings.Contains(prompts[1], "Observation: Jeff Skilling is a person") {
+		t.Errorf("Expected second prompt to include the first observation, got:\n%s", prompts[1])
+	}
+
+	var formatted FormattedResponse
+	if err := json.Unmarshal([]byte(response), &formatted); err != nil {
+		t.Fatalf("Response is not a FormattedResponse: %v", err)
+	}
+	if formatted.Text != "Jeff Skilling mostly wrote about California." {
+		t.Errorf("Unexpected answer text: %q", formatted.Text)
+	}
+	if len(formatted.Trace) != 2 {
+		t.Fatalf("Expected 2 traced tool calls, got %d", len(formatted.Trace))
+	}
+	if formatted.Trace[0].Action != "relationship" || formatted.Trace[0].Args["entity"] != "Kenneth Lay" {
+		t.Errorf("Unexpected first tool call: %+v", formatted.Trace[0])
+	}
+	if len(formatted.Entities) != 2 {
+		t.Errorf("Expected entities from both observations, got %+v", formatted.Entities)
+	}
+}
+
+// TestPlannerStepBudget tests that the planner stops after the configured number of tool calls
+func TestPlannerStepBudget(t *testing.T) {
+	calls := 0
+	mockLLM := &MockLLMClient{
+		GenerateCompletionFunc: func(ctx context.Context, prompt string) (string, error) {
+			calls++
+			return fmt.Sprintf(`{"action": "entity_lookup", "entity": "Person %d"}`, calls), nil
+		},
+	}
+
+	mockRepo := &MockRepository{
+		FindEntityByNameFunc: func(name string) (*Entity, error) {
+			return &Entity{ID: calls, Name: name, Type: "person"}, nil
+		},
+	}
+
+	handler := NewHandlerWithMaxSteps(mockLLM, mockRepo, 2)
+	response, err := handler.ProcessQuery(context.Background(), "Keep looking", NewContext())
+	if err != nil {
+		t.Fatalf("ProcessQuery() error = %v", err)
+	}
+
+	if calls != 2 {
+		t.Errorf("Expected 2 LLM calls, got %d", calls)
+	}
+
+	var formatted FormattedResponse
+	if err := json.Unmarshal([]byte(response), &formatted); err != nil {
+		t.Fatalf("Response is not a FormattedResponse: %v", err)
+	}
+	if len(formatted.Trace) != 2 {
+		t.Errorf("Expected 2 traced tool calls, got %d", len(formatted.Trace))
+	}
+	if !strings.Contains(formatted.Text, "Person 2") {
+		t.Errorf("Expected the last observation as answer, got %q", formatted.Text)
+	}
+}
+
+// TestPlannerStopsOnRepeatedCall tests that a repeated tool call ends the loop
+func TestPlannerStopsOnRepeatedCall(t *testing.T) {
+	calls := 0
+	mockLLM := &MockLLMClient{
+		GenerateCompletionFunc: func(ctx context.Context, prompt string) (string, error) {
+			calls++
+			return `{"action": "entity_lookup", "entity": "Jeff Skilling"}`, nil
+		},
+	}
+
+	mockRepo := &MockRepository{
+		FindEntityByNameFunc: func(name string) (*Entity, error) {
+			return &Entity{ID: 1, Name: name, Type: "person"}, nil
+		},
+	}
+
+	handler := NewHandler(mockLLM, mockRepo)
+	if _, err := handler.ProcessQuery(context.Background(), "Who is Jeff Skilling?", NewContext()); err != nil {
+		t.Fatalf("ProcessQuery() error = %v", err)
+	}
+
+	if calls != 2 {
+		t.Errorf("Expected the loop to stop after the repeated call, got %d LLM calls", calls)
+	}
+}
+
+// TestPlannerRecoversFromNotFound tests that a failed lookup is observed rather than fatal
+func TestPlannerRecoversFromNotFound(t *testing.T) {
+	calls := 0
+	mockLLM := &MockLLMClient{
+		GenerateCompletionFunc: func(ctx context.Context, prompt string) (string, error) {
+			calls++
+			if calls == 1 {
+				return `{"action": "entity_lookup", "entity": "Ken Lay"}`, nil
+			}
+			if !strings.Contains(prompt, "couldn't find that entity") {
+				t.Errorf("Expected the failed lookup in the prompt, got:\n%s", prompt)
+			}
+			return `{"action": "entity_lookup", "entity": "Kenneth Lay"}`, nil
+		},
+	}
+
+	mockRepo := &MockRepository{
+		FindEntityByNameFunc: func(name string) (*Entity, error) {
+			if name == "Ken Lay" {
+				return nil, errors.New("entity not found: Ken Lay")
+			}
+			return &Entity{ID: 1, Name: name, Type: "person"}, nil
+		},
+	}
+
+	handler := NewHandlerWithMaxSteps(mockLLM, mockRepo, 2)
+	response, err := handler.ProcessQuery(context.Background(), "Who is Ken Lay?", NewContext())
+	if err != nil {
+		t.Fatalf("ProcessQuery() error = %v", err)
+	}
+	if !strings.Contains(response, "Kenneth Lay") {
+		t.Errorf("Expected response about Kenneth Lay, got %s", response)
+	}
+}
@@ -3,133 +3,8 @@ package chat
 import (
 	"strings"
 	"testing"
-	"time"
 )
 
-// TestDefaultPromptTemplate tests the default prompt template structure
-func TestDefaultPromptTemplate(t *testing.T) {
-	template := DefaultPromptTemplate()
-
-	if template.SystemRole == "" {
-		t.Error("SystemRole should not be empty")
-	}
-	if template.AvailableOperations == "" {
-		t.Error("AvailableOperations should not be empty")
-	}
-	if template.Schema == "" {
-		t.Error("Schema should not be empty")
-	}
-	if template.ResponseFormat == "" {
-		t.Error("ResponseFormat should not be empty")
-	}
-
-	// Check that system role mentions graph database
-	if !strings.Contains(strings.ToLower(template.SystemRole), "graph") {
-		t.Error("SystemRole should mention graph database")
-	}
-
-	// Check that schema includes entity types
-	if !strings.Contains(template.Schema, "person") ||
-		!strings.Contains(template.Schema, "organization") ||
-		!strings.Contains(template.Schema, "concept") {
-		t.Error("Schema should include all entity types")
-	}
-
-	// Check that operations are documented
-	if !strings.Contains(template.AvailableOperations, "entity_lookup") ||
-		!strings.Contains(template.AvailableOperations, "relationship") ||
-		!strings.Contains(template.AvailableOperations, "path_finding") {
-		t.Error("AvailableOperations should include all query types")
-	}
-}
-
-// TestBuildPrompt tests building a complete prompt
-func TestBuildPrompt(t *testing.T) {
-	template := DefaultPromptTemplate()
-	history := []HistoryEntry{
-		{
-			Query:     "Who is Jeff Skilling?",
-			Response:  "Jeff Skilling is the CEO...",
-			Timestamp: time.Now(),
-		},
-	}
-	entities := map[string]TrackedEntity{
-		"Jeff Skilling": {
-			Name: "Jeff Skilling",
-			Type: "person",
-			ID:   1,
-		},
-	}
-	query := "What did he do?"
-
-	prompt := BuildPrompt(template, history, entities, query)
-
-	// Check that all components are included
-	if !strings.Contains(prompt, template.SystemRole) {
-		t.Error("Prompt should include system role")
-	}
-	if !strings.Contains(prompt, "Previous conversation") {
-		t.Error("Prompt should include conversation history")
-	}
-	if !strings.Contains(prompt, "Jeff Skilling") {
-		t.Error("Prompt should include tracked entities")
-	}
-	if !strings.Contains(prompt, query) {
-		t.Error("Prompt should include user query")
-	}
-}
-
-// TestBuildPromptWithoutHistory tests prompt building without history
-func TestBuildPromptWithoutHistory(t *testing.T) {
-	template := DefaultPromptTemplate()
-	query := "Who is Kenneth Lay?"
-
-	prompt := BuildPrompt(template, nil, nil, query)
-
-	// Should include system components but not history
-	if !strings.Contains(prompt, template.SystemRole) {
-		t.Error("Prompt should include system role")
-	}
-	if strings.Contains(prompt, "Previous conversation") {
-		t.Error("Prompt should not include conversation history when empty")
-	}
-	if !strings.Contains(prompt, query) {
-		t.Error("Prompt should include user query")
-	}
-}
-
-// TestBuildExtractionPrompt tests extraction prompt building
-func TestBuildExtractionPrompt(t *testing.T) {
-	subject := "Q3 Earnings Discussion"
-	body := "We need to discuss the quarterly earnings with the accounting team."
-	sender := "jeff.skilling@enron.com"
-	recipients := []string{"kenneth.lay@enron.com", "andrew.fastow@enron.com"}
-
-	prompt := BuildExtractionPrompt(subject, body, sender, recipients)
-
-	// Check that email details are included
-	if !strings.Contains(prompt, subject) {
-		t.Error("Prompt should include email subject")
-	}
-	if !strings.Contains(prompt, body) {
-		t.Error("Prompt should include email body")
-	}
-	if !strings.Contains(prompt, sender) {
-		t.Error("Prompt should include sender")
-	}
-	if !strings.Contains(prompt, recipients[0]) {
-		t.Error("Prompt should include recipients")
-	}
-
-	// Check that extraction instructions are present
-	if !strings.Contains(prompt, "Extract") {
-		t.Error("Prompt should include extraction instructions")
-	}
-	if !strings.Contains(prompt, "JSON") {
-		t.Error("Prompt should request JSON format")
-	}
-}
-
 // TestBuildDisambiguationPrompt tests disambiguation prompt building
 func TestBuildDisambiguationPrompt(t *testing.T) {
 	query := "Who is John?"
@@ -271,49 +146,3 @@ func TestSummarizeResults(t *testing.T) {
 		})
 	}
 }
-
-// TestPromptTemplateConsistency tests that prompts are consistent
-func TestPromptTemplateConsistency(t *testing.T) {
-	template1 := DefaultPromptTemplate()
-	template2 := DefaultPromptTemplate()
-
-	// Templates should be identical when created multiple times
-	if template1.SystemRole != template2.SystemRole {
-		t.Error("Default templates should have consistent SystemRole")
-	}
-	if template1.AvailableOperations != template2.AvailableOperations {
-		t.Error("Default templates should have consistent AvailableOperations")
-	}
-}
-
-// TestPromptLength tests that prompts don't exceed reasonable lengths
-func TestPromptLength(t *testing.T) {
-	template := DefaultPromptTemplate()
-
-	// Create a large history
-	history := make([]HistoryEntry, 5)
-	for i := 0; i < 5; i++ {
-		history[i] = HistoryEntry{
-			Query:     "Sample query " + string(rune(i)),
-			Response:  "Sample response " + string(rune(i)),
-			Timestamp: time.Now(),
-		}
-	}
-
-	entities := make(map[string]TrackedEntity)
-	for i := 0; i < 10; i++ {
-		name := "Entity " + string(rune(i))
-		entities[name] = TrackedEntity{
-			Name: name,
-			Type: "person",
-			ID:   i,
-		}
-	}
-
-	prompt := BuildPrompt(template, history, entities, "Test query")
-
-	// Prompt should be under 10KB for reasonable LLM context
-	if len(prompt) > 10000 {
-		t.Errorf("Prompt length %d exceeds reasonable limit of 10000 characters", len(prompt))
-	}
-}
@@ -0,0 +1,133 @@
+package chat
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Blogem/enron-graph/ent"
+	"github.com/Blogem/enron-graph/internal/graph"
+)
+
+// maxSchemaExamples limits the example names listed per type in the system prompt
+const maxSchemaExamples = 3
+
+// defaultRelationshipTypes are offered to the LLM when the live schema is unavailable
+var defaultRelationshipTypes = []string{"SENT", "RECEIVED", "MENTIONS", "COMMUNICATES_WITH"}
+
+// TypeSummary describes an entity or relationship type present in the graph
+type TypeSummary struct {
+	Name     string
+	Count    int
+	Examples []string
+}
+
+// SchemaSummary describes the live graph schema used to build the chat system prompt
+type SchemaSummary struct {
+	EntityTypes       []TypeSummary
+	RelationshipTypes []TypeSummary
+	PromotedTypes     []string
+}
+
+// DescribeGraphSchema summarizes the types in the graph behind client for repositories
+// implementing SchemaRepository
+func DescribeGraphSchema(ctx context.Context, client *ent.Client) (*SchemaSummary, error) {
+	described, err := graph.DescribeSchema(ctx, client, maxSchemaExamples)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &SchemaSummary{PromotedTypes: described.PromotedTypes}
+	for _, t := range described.EntityTypes {
+		summary.EntityTypes = append(summary.EntityTypes, TypeSummary(t))
+	}
+	for _, t := range described.RelationshipTypes {
+		summary.RelationshipTypes = append(summary.RelationshipTypes, TypeSummary(t))
+	}
+	return summary, nil
+}
+
+// relationshipTypeNames returns the relationship type names, falling back to the defaults
+func (s *SchemaSummary) relationshipTypeNames() []string {
+	if s == nil || len(s.RelationshipTypes) == 0 {
+		return defaultRelationshipTypes
+	}
+	names := make([]string, 0, len(s.RelationshipTypes))
+	for _, t := range s.RelationshipTypes {
+		names = append(names, t.Name)
+	}
+	return names
+}
+
+// formatOntology renders the entity and relationship types for the system prompt
+func formatOntology(summary *SchemaSummary) string {
+	if summary == nil || (len(summary.EntityTypes) == 0 && len(summary.RelationshipTypes) == 0) {
+		return "Entity types: person, organization, concept\nRelationship types: " + strings.Join(defaultRelationshipTypes, ", ")
+	}
+
+	var b strings.Builder
+	b.WriteString("Entity types in the graph:\n")
+	for _, t := range summary.EntityTypes {
+		b.WriteString(formatTypeSummary(t))
+	}
+	if len(summary.PromotedTypes) > 0 {
+		b.WriteString(fmt.Sprintf("Promoted types (with their own schema): %s\n", strings.Join(summary.PromotedTypes, ", ")))
+	}
+
+	b.WriteString("\nRelationship types in the graph:\n")
+	for _, t := range summary.RelationshipTypes {
+		b.WriteString(formatTypeSummary(t))
+	}
+
+	return strings.TrimRight(b.String(), "\n")
+}
+
+func formatTypeSummary(t TypeSummary) string {
+	line := "- " + t.Name
+	if t.Count > 0 {
+		line += fmt.Sprintf(" (%d)", t.Count)
+	}
+	if len(t.Examples) > 0 {
+		examples := t.Examples
+		if len(examples) > maxSchemaExamples {
+			examples = examples[:maxSchemaExamples]
+		}
+		line += fmt.Sprintf(", e.g. %s", strings.Join(examples, ", "))
+	}
+	return line + "\n"
+}
+
+// schemaSummary returns the cached schema summary, loading it from the repository
+// on first use. Repositories that cannot describe the schema yield nil.
+func (h *chatHandler) schemaSummary() *SchemaSummary {
+	h.schemaMu.RLock()
+	if h.schema != nil {
+		defer h.schemaMu.RUnlock()
+		return h.schema
+	}
+	h.schemaMu.RUnlock()
+
+	describer, ok := h.repo.(SchemaRepository)
+	if !ok {
+		return nil
+	}
+
+	summary, err := describer.DescribeSchema()
+	if err != nil {
+		// Fall back to the default ontology; failures aren't cached so the next query retries
+		return nil
+	}
+
+	h.schemaMu.Lock()
+	h.schema = summary
+	h.schemaMu.Unlock()
+
+	return summary
+}
+
+// InvalidateSchema drops the cached schema so the next query reloads it
+func (h *chatHandler) InvalidateSchema() {
+	h.schemaMu.Lock()
+	h.schema = nil
+	h.schemaMu.Unlock()
+}
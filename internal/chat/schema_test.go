@@ -0,0 +1,133 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// MockSchemaRepository adds schema description to MockRepository
+type MockSchemaRepository struct {
+	MockRepository
+	Summary *SchemaSummary
+	Err     error
+	Calls   int
+}
+
+func (m *MockSchemaRepository) DescribeSchema() (*SchemaSummary, error) {
+	m.Calls++
+	return m.Summary, m.Err
+}
+
+func capturePrompt(prompts *[]string) *MockLLMClient {
+	return &MockLLMClient{
+		GenerateCompletionFunc: func(ctx context.Context, prompt string) (string, error) {
+			*prompts = append(*prompts, prompt)
+			return `{"action": "answer", "answer": "ok"}`, nil
+		},
+	}
+}
+
+// TestSystemPromptUsesLiveSchema tests that the ontology section lists the types in the graph
+func TestSystemPromptUsesLiveSchema(t *testing.T) {
+	var prompts []string
+	mockRepo := &MockSchemaRepository{
+		Summary: &SchemaSummary{
+			EntityTypes: []TypeSummary{
+				{Name: "person", Count: 120, Examples: []string{"Kenneth Lay", "Jeff Skilling", "Andrew Fastow", "Sherron Watkins"}},
+				{Name: "project", Count: 7, Examples: []string{"Raptor"}},
+			},
+			RelationshipTypes: []TypeSummary{
+				{Name: "COMMUNICATES_WITH", Count: 300},
+				{Name: "WORKS_ON", Count: 12},
+			},
+			PromotedTypes: []string{"Person"},
+		},
+	}
+
+	handler := NewHandler(capturePrompt(&prompts), mockRepo)
+	if _, err := handler.ProcessQuery(context.Background(), "hello", NewContext()); err != nil {
+		t.Fatalf("ProcessQuery() error = %v", err)
+	}
+
+	prompt := prompts[0]
+	for _, want := range []string{
+		"- person (120), e.g. Kenneth Lay, Jeff Skilling, Andrew Fastow",
+		"- project (7), e.g. Raptor",
+		"- WORKS_ON (12)",
+		"Promoted types (with their own schema): Person",
+		`"rel_type": "COMMUNICATES_WITH|WORKS_ON"`,
+	} {
+		if !strings.Contains(prompt, want) {
+			t.Errorf("Expected prompt to contain %q, got:\n%s", want, prompt)
+		}
+	}
+	if strings.Contains(prompt, "Sherron Watkins") {
+		t.Errorf("Expected examples to be limited to %d", maxSchemaExamples)
+	}
+	if strings.Contains(prompt, "MENTIONS") {
+		t.Errorf("Expected hardcoded relationship types to be replaced, got:\n%s", prompt)
+	}
+}
+
+// TestSystemPromptSchemaCache tests that the schema is cached until invalidated
+func TestSystemPromptSchemaCache(t *testing.T) {
+	var prompts []string
+	mockRepo := &MockSchemaRepository{
+		Summary: &SchemaSummary{
+			EntityTypes:       []TypeSummary{{Name: "person", Count: 1}},
+			RelationshipTypes: []TypeSummary{{Name: "SENT", Count: 1}},
+		},
+	}
+
+	handler := NewHandler(capturePrompt(&prompts), mockRepo)
+	for i := 0; i < 2; i++ {
+		if _, err := handler.ProcessQuery(context.Background(), "hello", NewContext()); err != nil {
+			t.Fatalf("ProcessQuery() error = %v", err)
+		}
+	}
+	if mockRepo.Calls != 1 {
+		t.Errorf("Expected schema to be described once, got %d calls", mockRepo.Calls)
+	}
+
+	mockRepo.Summary = &SchemaSummary{
+		EntityTypes:       []TypeSummary{{Name: "person", Count: 1}},
+		RelationshipTypes: []TypeSummary{{Name: "SENT", Count: 1}, {Name: "REPORTS_TO", Count: 4}},
+	}
+	invalidator, ok := handler.(SchemaInvalidator)
+	if !ok {
+		t.Fatal("Expected handler to implement SchemaInvalidator")
+	}
+	invalidator.InvalidateSchema()
+
+	if _, err := handler.ProcessQuery(context.Background(), "hello", NewContext()); err != nil {
+		t.Fatalf("ProcessQuery() error = %v", err)
+	}
+	if mockRepo.Calls != 2 {
+		t.Errorf("Expected schema to be reloaded after invalidation, got %d calls", mockRepo.Calls)
+	}
+	if !strings.Contains(prompts[len(prompts)-1], "REPORTS_TO") {
+		t.Errorf("Expected refreshed prompt to include REPORTS_TO")
+	}
+}
+
+// TestSystemPromptFallsBackToDefaults tests the static ontology when the schema is unavailable
+func TestSystemPromptFallsBackToDefaults(t *testing.T) {
+	var prompts []string
+	mockRepo := &MockSchemaRepository{Err: errors.New("database unavailable")}
+
+	handler := NewHandler(capturePrompt(&prompts), mockRepo)
+	for i := 0; i < 2; i++ {
+		if _, err := handler.ProcessQuery(context.Background(), "hello", NewContext()); err != nil {
+			t.Fatalf("ProcessQuery() error = %v", err)
+		}
+	}
+
+	if !strings.Contains(prompts[0], "Relationship types: SENT, RECEIVED, MENTIONS, COMMUNICATES_WITH") {
+		t.Errorf("Expected default ontology, got:\n%s", prompts[0])
+	}
+	if mockRepo.Calls != 2 {
+		t.Errorf("Expected failed schema lookups not to be cached, got %d calls", mockRepo.Calls)
+	}
+}
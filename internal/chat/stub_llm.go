@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+
+	"github.com/Blogem/enron-graph/pkg/llm"
 )
 
 // stubLLMClient is a simple stub implementation for development
@@ -184,6 +186,15 @@ func (s *stubLLMClient) GenerateCompletion(ctx context.Context, prompt string) (
 	return string(jsonResp), nil
 }
 
+// GenerateStructured returns the stub completion, which is already a JSON action payload
+func (s *stubLLMClient) GenerateStructured(ctx context.Context, prompt string, schema *llm.ResponseSchema) (json.RawMessage, error) {
+	response, err := s.GenerateCompletion(ctx, prompt)
+	if err != nil {
+		return nil, err
+	}
+	return json.RawMessage(response), nil
+}
+
 // GenerateEmbedding generates a stub embedding (zeros) for development
 func (s *stubLLMClient) GenerateEmbedding(ctx context.Context, text string) ([]float32, error) {
 	// Return a stub embedding of zeros with dimension 384 (common for sentence transformers)
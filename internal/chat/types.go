@@ -2,7 +2,10 @@ package chat
 
 import (
 	"context"
+	"encoding/json"
 	"time"
+
+	"github.com/Blogem/enron-graph/pkg/llm"
 )
 
 // QueryType represents the type of query pattern matched
@@ -33,9 +36,10 @@ type PatternMatcher interface {
 
 // HistoryEntry represents a conversation history entry
 type HistoryEntry struct {
-	Query     string
-	Response  string
-	Timestamp time.Time
+	Query         string
+	Response      string
+	Timestamp     time.Time
+	PromptVersion string // Version of the chat_system prompt that produced the response
 }
 
 // TrackedEntity represents an entity mentioned in conversation
@@ -64,6 +68,7 @@ type PathNode struct {
 // Context interface for conversation context management
 type Context interface {
 	AddQuery(query, response string)
+	AddQueryWithPrompt(query, response, promptVersion string)
 	GetHistory() []HistoryEntry
 	TrackEntity(name, entityType string, id int)
 	GetTrackedEntities() map[string]TrackedEntity
@@ -81,6 +86,13 @@ type LLMClient interface {
 	GenerateEmbedding(ctx context.Context, text string) ([]float32, error)
 }
 
+// StructuredLLMClient is implemented by LLM clients that support schema-constrained output.
+// The handler uses it when available and falls back to parsing free-form completions otherwise.
+type StructuredLLMClient interface {
+	LLMClient
+	GenerateStructured(ctx context.Context, prompt string, schema *llm.ResponseSchema) (json.RawMessage, error)
+}
+
 // Repository interface for graph operations
 type Repository interface {
 	FindEntityByName(name string) (*Entity, error)
@@ -90,6 +102,26 @@ type Repository interface {
 	CountRelationships(entityID int, relType string) (int, error)
 }
 
+// CitationRepository is implemented by repositories that can point at the source emails
+// behind entities and relationships. The handler attaches citations when available.
+type CitationRepository interface {
+	Repository
+	FindEntityCitations(entityID int, limit int) ([]Citation, error)
+	FindRelationshipCitations(fromID, toID int, relType string, limit int) ([]Citation, error)
+}
+
+// SchemaRepository is implemented by repositories that can describe the live graph schema.
+// The handler uses it to list the actual entity and relationship types in the system prompt.
+type SchemaRepository interface {
+	Repository
+	DescribeSchema() (*SchemaSummary, error)
+}
+
+// SchemaInvalidator is implemented by handlers that cache the graph schema
+type SchemaInvalidator interface {
+	InvalidateSchema()
+}
+
 // Handler interface for chat query processing
 type Handler interface {
 	ProcessQuery(ctx context.Context, query string, chatContext Context) (string, error)
@@ -103,10 +135,30 @@ type EntityReference struct {
 	UniqueID string `json:"unique_id"`
 }
 
+// Citation points at an email supporting an entity or relationship in a chat response
+type Citation struct {
+	MessageID    string `json:"message_id"`
+	Subject      string `json:"subject"`
+	Date         string `json:"date"`
+	EntityID     int    `json:"entity_id,omitempty"`
+	Relationship string `json:"relationship,omitempty"`
+}
+
+// ToolCall records one planner step: the graph tool the LLM chose and what it observed
+type ToolCall struct {
+	Step        int               `json:"step"`
+	Action      string            `json:"action"`
+	Args        map[string]string `json:"args,omitempty"`
+	Observation string            `json:"observation,omitempty"`
+	Error       string            `json:"error,omitempty"`
+}
+
 // FormattedResponse represents a chat response with embedded entity metadata
 type FormattedResponse struct {
-	Text     string            `json:"text"`
-	Entities []EntityReference `json:"entities"`
+	Text      string            `json:"text"`
+	Entities  []EntityReference `json:"entities"`
+	Citations []Citation        `json:"citations,omitempty"`
+	Trace     []ToolCall        `json:"trace,omitempty"`
 }
 
 // ResponseFormatter interface for formatting responses
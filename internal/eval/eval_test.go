@@ -0,0 +1,176 @@
+package eval
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Blogem/enron-graph/internal/extractor"
+)
+
+const fixtures = "../../tests/fixtures/eval"
+
+func TestScoreEmail_MatchesAliasesTypesAndAlternatives(t *testing.T) {
+	gold := &GoldEmail{
+		MessageID: "1@enron.com",
+		Entities: []GoldEntity{
+			{Type: "person", Name: "Kenneth Lay", Aliases: []string{"Ken"}},
+			{Type: "organization", Name: "Dynegy"},
+			{Type: "project", Name: "Raptor"},
+		},
+		Relationships: []GoldRelationship{
+			{Source: "Kenneth Lay", Predicate: "NEGOTIATES_WITH", Target: "Dynegy", Alternatives: []string{"MEETS_WITH"}},
+		},
+	}
+	ken := PredictedEntity{Type: "person", Name: "kenneth.lay", Aliases: []string{"Ken"}}
+	dynegy := PredictedEntity{Type: "Organization", Name: " dynegy "}
+	prediction := Prediction{
+		Entities: []PredictedEntity{ken, dynegy, {Type: "financial_instrument", Name: "Raptor"}},
+		Relationships: []PredictedRelationship{
+			{Source: ken, Predicate: "meets with", Target: dynegy},
+			{Source: dynegy, Predicate: "MEETS_WITH", Target: ken},
+		},
+	}
+
+	result, types, predicates := scoreEmail(gold, prediction)
+
+	if result.Entities.TruePositives != 2 || result.Entities.FalsePositives != 1 || result.Entities.FalseNegatives != 1 {
+		t.Errorf("Unexpected entity counts: %+v", result.Entities)
+	}
+	if types["project"].FalseNegatives != 1 || types["financial_instrument"].FalsePositives != 1 {
+		t.Errorf("Expected the wrong type to count against both types, got %v %v", types["project"], types["financial_instrument"])
+	}
+	if result.Relationships.TruePositives != 1 || result.Relationships.FalsePositives != 1 || result.Relationships.FalseNegatives != 0 {
+		t.Errorf("Unexpected relationship counts: %+v", result.Relationships)
+	}
+	if predicates["NEGOTIATES_WITH"].TruePositives != 1 || predicates["MEETS_WITH"].FalsePositives != 1 {
+		t.Errorf("Expected alternative matches under the gold predicate and reversed ones as spurious, got %v %v",
+			predicates["NEGOTIATES_WITH"], predicates["MEETS_WITH"])
+	}
+	if result.Entities.Precision != 2.0/3 || result.Entities.Recall != 2.0/3 {
+		t.Errorf("Unexpected precision/recall: %+v", result.Entities)
+	}
+}
+
+func TestLoadGold_RejectsUnknownRelationshipEntities(t *testing.T) {
+	email := GoldEmail{
+		Entities:      []GoldEntity{{Type: "person", Name: "Kenneth Lay"}},
+		Relationships: []GoldRelationship{{Source: "Kenneth Lay", Predicate: "LEADS", Target: "Enron"}},
+	}
+	if err := email.validate(); err == nil {
+		t.Error("Expected a relationship to an unannotated entity to be rejected")
+	}
+}
+
+func runFixtures(t *testing.T, cacheDir string) *Report {
+	t.Helper()
+	gold, err := LoadGold(filepath.Join(fixtures, "annotations.json"))
+	if err != nil {
+		t.Fatalf("LoadGold failed: %v", err)
+	}
+	emails, err := LoadEmails(filepath.Join(fixtures, "emails.csv"))
+	if err != nil {
+		t.Fatalf("LoadEmails failed: %v", err)
+	}
+	cache, err := extractor.NewDiskCache(cacheDir)
+	if err != nil {
+		t.Fatalf("NewDiskCache failed: %v", err)
+	}
+
+	report, err := Run(context.Background(), &OfflineClient{Dimensions: 1024}, gold, emails, Options{
+		Model:  "llama3.1:8b",
+		Cache:  cache,
+		Replay: true,
+	}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	if err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	return report
+}
+
+// TestRun_ReplaysRecordedResponses scores the recorded responses offline, so that changes to the
+// extractor's handling of LLM output show up as metric changes in CI
+func TestRun_ReplaysRecordedResponses(t *testing.T) {
+	report := runFixtures(t, filepath.Join(fixtures, "responses"))
+
+	if report.Emails != 6 {
+		t.Fatalf("Expected 6 evaluated emails, got %d", report.Emails)
+	}
+	for _, email := range report.PerEmail {
+		if email.Error != "" {
+			t.Errorf("%s: %s", email.MessageID, email.Error)
+		}
+	}
+	if got := report.Entities; got.TruePositives != 33 || got.FalsePositives != 5 || got.FalseNegatives != 5 {
+		t.Errorf("Unexpected entity counts: %+v", got)
+	}
+	if got := report.Relationships; got.TruePositives != 15 || got.FalsePositives != 4 || got.FalseNegatives != 2 {
+		t.Errorf("Unexpected relationship counts: %+v", got)
+	}
+
+	var person *LabelScore
+	for i := range report.EntityTypes {
+		if report.EntityTypes[i].Label == "person" {
+			person = &report.EntityTypes[i]
+		}
+	}
+	if person == nil || person.F1 != 1 {
+		t.Errorf("Expected every person to be found, got %+v", person)
+	}
+}
+
+func TestRun_FlagsMissingResponses(t *testing.T) {
+	report := runFixtures(t, t.TempDir())
+
+	if report.Entities.TruePositives != 0 {
+		t.Errorf("Expected nothing extracted without responses, got %+v", report.Entities)
+	}
+	for _, email := range report.PerEmail {
+		if email.Error == "" {
+			t.Errorf("Expected %s to report its missing response", email.MessageID)
+		}
+	}
+}
+
+func TestReport_WriteAndCompare(t *testing.T) {
+	report := runFixtures(t, filepath.Join(fixtures, "responses"))
+
+	path := filepath.Join(t.TempDir(), "report.json")
+	var buf bytes.Buffer
+	if err := report.WriteJSON(&buf); err != nil {
+		t.Fatalf("WriteJSON failed: %v", err)
+	}
+	if err := os.WriteFile(path, buf.Bytes(), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	baseline, err := LoadReport(path)
+	if err != nil {
+		t.Fatalf("LoadReport failed: %v", err)
+	}
+	if baseline.Relationships != report.Relationships || len(baseline.Predicates) != len(report.Predicates) {
+		t.Errorf("Expected the report to round-trip through JSON")
+	}
+
+	var text strings.Builder
+	if err := report.WriteText(&text, baseline); err != nil {
+		t.Fatalf("WriteText failed: %v", err)
+	}
+	if !strings.Contains(text.String(), "+0.000") {
+		t.Errorf("Expected zero deltas against the same run:\n%s", text.String())
+	}
+
+	var html strings.Builder
+	if err := report.WriteHTML(&html, baseline); err != nil {
+		t.Fatalf("WriteHTML failed: %v", err)
+	}
+	for _, want := range []string{"<h2>Predicates</h2>", "Baseline F1", "financial_instrument: Raptor vehicles"} {
+		if !strings.Contains(html.String(), want) {
+			t.Errorf("Expected HTML report to contain %q", want)
+		}
+	}
+}
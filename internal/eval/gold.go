@@ -0,0 +1,108 @@
+// Package eval measures extraction quality against hand-annotated emails.
+//
+// A gold set lists, per email, the entities (type and name) and relationships
+// (source, predicate, target) a good extraction should produce. Running the
+// extractor over the same emails and matching its output against the gold set
+// gives precision, recall and F1 overall, per entity type and per predicate.
+package eval
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// GoldSet holds the annotations of an evaluation fixture set
+type GoldSet struct {
+	Description string      `json:"description,omitempty"`
+	Emails      []GoldEmail `json:"emails"`
+}
+
+// GoldEmail holds the expected extraction of one email, identified by its Message-ID
+type GoldEmail struct {
+	MessageID     string             `json:"message_id"`
+	Entities      []GoldEntity       `json:"entities"`
+	Relationships []GoldRelationship `json:"relationships"`
+}
+
+// GoldEntity is an expected entity. Extracted entities match on type and on the name or any alias.
+type GoldEntity struct {
+	Type    string   `json:"type"`
+	Name    string   `json:"name"`
+	Aliases []string `json:"aliases,omitempty"`
+}
+
+// GoldRelationship is an expected relationship between two gold entities, referenced by name.
+// Alternatives are predicates accepted in place of Predicate; matches count towards Predicate.
+type GoldRelationship struct {
+	Source       string   `json:"source"`
+	Predicate    string   `json:"predicate"`
+	Target       string   `json:"target"`
+	Alternatives []string `json:"alternatives,omitempty"`
+}
+
+// LoadGold reads a gold set from a JSON file
+func LoadGold(path string) (*GoldSet, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read annotations: %w", err)
+	}
+
+	var gold GoldSet
+	if err := json.Unmarshal(data, &gold); err != nil {
+		return nil, fmt.Errorf("failed to parse annotations: %w", err)
+	}
+	for i, email := range gold.Emails {
+		gold.Emails[i].MessageID = normalizeMessageID(email.MessageID)
+		if gold.Emails[i].MessageID == "" {
+			return nil, fmt.Errorf("annotation %d has no message_id", i+1)
+		}
+		if err := email.validate(); err != nil {
+			return nil, fmt.Errorf("annotation %s: %w", gold.Emails[i].MessageID, err)
+		}
+	}
+	return &gold, nil
+}
+
+// Email returns the annotations of the email with the Message-ID, or nil
+func (g *GoldSet) Email(messageID string) *GoldEmail {
+	messageID = normalizeMessageID(messageID)
+	for i := range g.Emails {
+		if g.Emails[i].MessageID == messageID {
+			return &g.Emails[i]
+		}
+	}
+	return nil
+}
+
+// validate checks that relationships only reference annotated entities
+func (e GoldEmail) validate() error {
+	names := map[string]bool{}
+	for _, entity := range e.Entities {
+		names[normalizeName(entity.Name)] = true
+	}
+	for _, rel := range e.Relationships {
+		for _, name := range []string{rel.Source, rel.Target} {
+			if !names[normalizeName(name)] {
+				return fmt.Errorf("relationship %s references unknown entity %q", rel.Predicate, name)
+			}
+		}
+	}
+	return nil
+}
+
+// normalizeMessageID strips the angle brackets Message-ID headers carry
+func normalizeMessageID(id string) string {
+	return strings.Trim(strings.TrimSpace(id), "<>")
+}
+
+// normalizeName folds case and whitespace so that names compare loosely
+func normalizeName(name string) string {
+	return strings.Join(strings.Fields(strings.ToLower(name)), " ")
+}
+
+// normalizeLabel folds entity types and predicates to the form the extractor uses
+func normalizeLabel(label string) string {
+	return strings.Join(strings.Fields(strings.ToLower(label)), "_")
+}
@@ -0,0 +1,207 @@
+package eval
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+)
+
+// Report is the result of an evaluation run. Reports are written as JSON so that runs with
+// different models or prompt versions can be compared against each other later.
+type Report struct {
+	Model         string        `json:"model"`
+	PromptVersion string        `json:"prompt_version"`
+	Replay        bool          `json:"replay"`
+	GeneratedAt   time.Time     `json:"generated_at"`
+	Emails        int           `json:"emails"`
+	Entities      Score         `json:"entities"`
+	Relationships Score         `json:"relationships"`
+	EntityTypes   []LabelScore  `json:"entity_types"`
+	Predicates    []LabelScore  `json:"predicates"`
+	PerEmail      []EmailResult `json:"per_email"`
+}
+
+// LoadReport reads a JSON report written by WriteJSON
+func LoadReport(path string) (*Report, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read report: %w", err)
+	}
+	var report Report
+	if err := json.Unmarshal(data, &report); err != nil {
+		return nil, fmt.Errorf("failed to parse report: %w", err)
+	}
+	return &report, nil
+}
+
+// WriteJSON writes the report as indented JSON
+func (r *Report) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(r)
+}
+
+// comparisonRow is one line of a report table, with the F1 of the baseline run if there is one
+type comparisonRow struct {
+	Label    string
+	Score    Score
+	Baseline *float64
+}
+
+// Delta returns the F1 change against the baseline, formatted with its sign
+func (c comparisonRow) Delta() string {
+	if c.Baseline == nil {
+		return ""
+	}
+	return fmt.Sprintf("%+.3f", c.Score.F1-*c.Baseline)
+}
+
+// BaselineF1 returns the F1 of the baseline run, or "-" when the label was not in it
+func (c comparisonRow) BaselineF1() string {
+	if c.Baseline == nil {
+		return "-"
+	}
+	return fmt.Sprintf("%.3f", *c.Baseline)
+}
+
+// comparisonTable holds the rows of one section of a report
+type comparisonTable struct {
+	Title string
+	Rows  []comparisonRow
+}
+
+// tables lays out the overall, per-type and per-predicate scores against an optional baseline
+func (r *Report) tables(baseline *Report) []comparisonTable {
+	find := func(scores []LabelScore, label string) *float64 {
+		for _, s := range scores {
+			if s.Label == label {
+				f1 := s.F1
+				return &f1
+			}
+		}
+		return nil
+	}
+	rows := func(scores, baselineScores []LabelScore) []comparisonRow {
+		var out []comparisonRow
+		for _, s := range scores {
+			row := comparisonRow{Label: s.Label, Score: s.Score}
+			if baseline != nil {
+				row.Baseline = find(baselineScores, s.Label)
+			}
+			out = append(out, row)
+		}
+		return out
+	}
+
+	overall := []comparisonRow{
+		{Label: "entities", Score: r.Entities},
+		{Label: "relationships", Score: r.Relationships},
+	}
+	var entityTypes, predicates []LabelScore
+	if baseline != nil {
+		overall[0].Baseline = &baseline.Entities.F1
+		overall[1].Baseline = &baseline.Relationships.F1
+		entityTypes, predicates = baseline.EntityTypes, baseline.Predicates
+	}
+
+	return []comparisonTable{
+		{Title: "Overall", Rows: overall},
+		{Title: "Entity types", Rows: rows(r.EntityTypes, entityTypes)},
+		{Title: "Predicates", Rows: rows(r.Predicates, predicates)},
+	}
+}
+
+// WriteText writes the scores as aligned tables, with F1 deltas when a baseline is given
+func (r *Report) WriteText(w io.Writer, baseline *Report) error {
+	fmt.Fprintf(w, "Model: %s  Prompt: %s  Emails: %d\n", r.Model, r.PromptVersion, r.Emails)
+	if baseline != nil {
+		fmt.Fprintf(w, "Baseline: %s  Prompt: %s  (%s)\n", baseline.Model, baseline.PromptVersion,
+			baseline.GeneratedAt.Format(time.RFC3339))
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	for _, table := range r.tables(baseline) {
+		fmt.Fprintf(tw, "\n%s\tTP\tFP\tFN\tPrecision\tRecall\tF1\t", table.Title)
+		if baseline != nil {
+			fmt.Fprint(tw, "Baseline\tDelta\t")
+		}
+		fmt.Fprintln(tw)
+		for _, row := range table.Rows {
+			s := row.Score
+			fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%.3f\t%.3f\t%.3f\t", row.Label,
+				s.TruePositives, s.FalsePositives, s.FalseNegatives, s.Precision, s.Recall, s.F1)
+			if baseline != nil {
+				fmt.Fprintf(tw, "%s\t%s\t", row.BaselineF1(), row.Delta())
+			}
+			fmt.Fprintln(tw)
+		}
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	for _, email := range r.PerEmail {
+		if email.Error != "" {
+			fmt.Fprintf(w, "\n%s: %s\n", email.MessageID, email.Error)
+		}
+	}
+	return nil
+}
+
+// WriteHTML writes a standalone HTML page with the scores and the per-email misses,
+// comparing against the baseline when one is given
+func (r *Report) WriteHTML(w io.Writer, baseline *Report) error {
+	return htmlReport.Execute(w, map[string]any{
+		"Report":   r,
+		"Baseline": baseline,
+		"Tables":   r.tables(baseline),
+	})
+}
+
+var htmlReport = template.Must(template.New("report").Funcs(template.FuncMap{
+	"f3": func(v float64) string { return fmt.Sprintf("%.3f", v) },
+}).Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Extraction evaluation: {{.Report.Model}} {{.Report.PromptVersion}}</title>
+<style>
+body { font-family: sans-serif; margin: 2em; }
+table { border-collapse: collapse; margin-bottom: 1.5em; }
+th, td { border: 1px solid #ccc; padding: 4px 8px; text-align: right; }
+th:first-child, td:first-child { text-align: left; }
+.error { color: #b00; }
+ul { margin: 0; }
+</style>
+</head>
+<body>
+<h1>Extraction evaluation</h1>
+<p>Model <b>{{.Report.Model}}</b>, prompt <b>{{.Report.PromptVersion}}</b>, {{.Report.Emails}} emails{{if .Report.Replay}}, replayed from recorded responses{{end}}, generated {{.Report.GeneratedAt.Format "2006-01-02 15:04"}}.</p>
+{{- with .Baseline}}
+<p>Compared with model <b>{{.Model}}</b>, prompt <b>{{.PromptVersion}}</b>, generated {{.GeneratedAt.Format "2006-01-02 15:04"}}.</p>
+{{- end}}
+{{- range .Tables}}
+<h2>{{.Title}}</h2>
+<table>
+<tr><th></th><th>TP</th><th>FP</th><th>FN</th><th>Precision</th><th>Recall</th><th>F1</th>{{if $.Baseline}}<th>Baseline F1</th><th>Delta</th>{{end}}</tr>
+{{- range .Rows}}
+<tr><td>{{.Label}}</td><td>{{.Score.TruePositives}}</td><td>{{.Score.FalsePositives}}</td><td>{{.Score.FalseNegatives}}</td><td>{{f3 .Score.Precision}}</td><td>{{f3 .Score.Recall}}</td><td>{{f3 .Score.F1}}</td>{{if $.Baseline}}<td>{{.BaselineF1}}</td><td>{{.Delta}}</td>{{end}}</tr>
+{{- end}}
+</table>
+{{- end}}
+<h2>Emails</h2>
+<table>
+<tr><th>Message-ID</th><th>Entity F1</th><th>Relationship F1</th><th>Missed</th><th>Spurious</th></tr>
+{{- range .Report.PerEmail}}
+<tr><td>{{.MessageID}}{{if .Error}}<br><span class="error">{{.Error}}</span>{{end}}</td><td>{{f3 .Entities.F1}}</td><td>{{f3 .Relationships.F1}}</td>
+<td><ul>{{range .MissedEntities}}<li>{{.}}</li>{{end}}{{range .MissedRelationships}}<li>{{.}}</li>{{end}}</ul></td>
+<td><ul>{{range .SpuriousEntities}}<li>{{.}}</li>{{end}}{{range .SpuriousRelationships}}<li>{{.}}</li>{{end}}</ul></td></tr>
+{{- end}}
+</table>
+</body>
+</html>
+`))
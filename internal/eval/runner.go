@@ -0,0 +1,270 @@
+package eval
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/Blogem/enron-graph/ent"
+	"github.com/Blogem/enron-graph/internal/extractor"
+	"github.com/Blogem/enron-graph/internal/graph"
+	"github.com/Blogem/enron-graph/internal/loader"
+	"github.com/Blogem/enron-graph/internal/prompts"
+	"github.com/Blogem/enron-graph/pkg/llm"
+)
+
+// Options configures an evaluation run
+type Options struct {
+	Model   string            // Completion model, part of the cache key
+	Prompts *prompts.Registry // Prompt templates; the built-in ones when nil
+	Cache   extractor.ResponseCache
+	Replay  bool // Only use cached responses, for runs without an LLM
+}
+
+// LoadEmails reads the emails of a fixture file in any format the loader supports
+func LoadEmails(path string) ([]*graph.EmailInput, error) {
+	source, err := loader.NewEmailSource(loader.SourceAuto, path)
+	if err != nil {
+		return nil, err
+	}
+	records, errs, err := source.Stream()
+	if err != nil {
+		return nil, err
+	}
+
+	var emails []*graph.EmailInput
+	for record := range records {
+		metadata, err := loader.ParseEmailHeaders(record.Message)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", record.File, err)
+		}
+		emails = append(emails, &graph.EmailInput{
+			MessageID:    metadata.MessageID,
+			From:         metadata.From,
+			To:           metadata.To,
+			CC:           metadata.CC,
+			BCC:          metadata.BCC,
+			Subject:      metadata.Subject,
+			Date:         metadata.Date,
+			Body:         metadata.Body,
+			FilePath:     record.File,
+			DisplayNames: metadata.DisplayNames,
+			InReplyTo:    metadata.InReplyTo,
+			References:   metadata.References,
+		})
+	}
+	for err := range errs {
+		if err != nil {
+			return nil, fmt.Errorf("failed to read emails: %w", err)
+		}
+	}
+	return emails, nil
+}
+
+// Run extracts every annotated email into its own in-memory graph and scores what the LLM
+// extracted from the content against the gold set. Header entities are left out of the score:
+// they come from the addresses, not from the model. Emails without annotations are skipped.
+func Run(ctx context.Context, client llm.Client, gold *GoldSet, emails []*graph.EmailInput, opts Options, logger *slog.Logger) (*Report, error) {
+	registry := opts.Prompts
+	if registry == nil {
+		registry = prompts.Default()
+	}
+
+	report := &Report{
+		Model:         opts.Model,
+		PromptVersion: registry.Version(prompts.Extraction),
+		Replay:        opts.Replay,
+		GeneratedAt:   time.Now().UTC(),
+	}
+	types, predicates := labelCounts{}, labelCounts{}
+
+	for _, input := range emails {
+		annotations := gold.Email(input.MessageID)
+		if annotations == nil {
+			logger.Debug("Skipping email without annotations", "message_id", input.MessageID)
+			continue
+		}
+
+		prediction, misses, err := extract(ctx, client, input, opts, registry, logger)
+		if err != nil {
+			return nil, fmt.Errorf("failed to extract %s: %w", input.MessageID, err)
+		}
+
+		result, emailTypes, emailPredicates := scoreEmail(annotations, prediction)
+		if opts.Replay && misses > 0 {
+			result.Error = fmt.Sprintf("%d prompt(s) had no recorded response", misses)
+		}
+		report.PerEmail = append(report.PerEmail, result)
+		report.Entities.add(result.Entities)
+		report.Relationships.add(result.Relationships)
+		types.merge(emailTypes)
+		predicates.merge(emailPredicates)
+	}
+
+	report.Emails = len(report.PerEmail)
+	if report.Emails < len(gold.Emails) {
+		return nil, fmt.Errorf("%d annotated emails not found in the fixtures", len(gold.Emails)-report.Emails)
+	}
+	report.EntityTypes = types.sorted()
+	report.Predicates = predicates.sorted()
+	return report, nil
+}
+
+// extract runs the extractor over one email and collects what it extracted from the content,
+// along with the number of cache misses
+func extract(ctx context.Context, client llm.Client, input *graph.EmailInput, opts Options, registry *prompts.Registry, logger *slog.Logger) (Prediction, int, error) {
+	repo := newRecordingRepository()
+	email, err := repo.CreateEmail(ctx, input)
+	if err != nil {
+		return Prediction{}, 0, err
+	}
+
+	ext := extractor.NewExtractor(client, repo, logger)
+	ext.SetModel(opts.Model)
+	if err := ext.SetPrompts(registry); err != nil {
+		return Prediction{}, 0, err
+	}
+	cache := &countingCache{ResponseCache: opts.Cache}
+	if opts.Cache != nil {
+		ext.SetCache(cache, opts.Replay)
+	}
+
+	// A replayed email without recorded responses is scored as far as it got and flagged
+	if _, err := ext.ExtractFromEmail(ctx, email); err != nil && !(opts.Replay && errors.Is(err, extractor.ErrCacheMiss)) {
+		return Prediction{}, 0, err
+	}
+	prediction, err := repo.prediction(ctx)
+	return prediction, cache.misses, err
+}
+
+// countingCache counts the misses of the cache it wraps
+type countingCache struct {
+	extractor.ResponseCache
+	misses int
+}
+
+func (c *countingCache) Get(key string) (json.RawMessage, bool, error) {
+	response, ok, err := c.ResponseCache.Get(key)
+	if err == nil && !ok {
+		c.misses++
+	}
+	return response, ok, err
+}
+
+// recordingRepository is an in-memory graph that remembers which entities and relationships
+// the extractor attributed to the email content
+type recordingRepository struct {
+	*graph.MockRepository
+	entities      []int
+	relationships []*ent.Relationship
+	created       map[int]*ent.Relationship
+}
+
+func newRecordingRepository() *recordingRepository {
+	return &recordingRepository{
+		MockRepository: graph.NewMockRepository(),
+		created:        map[int]*ent.Relationship{},
+	}
+}
+
+func (r *recordingRepository) CreateRelationship(ctx context.Context, input *graph.RelationshipInput) (*ent.Relationship, error) {
+	rel, err := r.MockRepository.CreateRelationship(ctx, input)
+	if err == nil {
+		r.created[rel.ID] = rel
+	}
+	return rel, err
+}
+
+func (r *recordingRepository) RecordProvenance(ctx context.Context, input *graph.ProvenanceInput) (*ent.Provenance, error) {
+	if input.Source == "content" {
+		switch input.SubjectType {
+		case graph.SubjectEntity:
+			r.entities = append(r.entities, input.SubjectID)
+		case graph.SubjectRelationship:
+			// MENTIONS links from the email itself are not extracted facts
+			if rel, ok := r.created[input.SubjectID]; ok && rel.FromType != "email" && rel.ToType != "email" {
+				r.relationships = append(r.relationships, rel)
+			}
+		}
+	}
+	return r.MockRepository.RecordProvenance(ctx, input)
+}
+
+// prediction resolves the recorded IDs to the entities and relationships they identify
+func (r *recordingRepository) prediction(ctx context.Context) (Prediction, error) {
+	var prediction Prediction
+	for _, id := range r.entities {
+		entity, err := r.entity(ctx, id)
+		if err != nil {
+			return Prediction{}, err
+		}
+		prediction.Entities = append(prediction.Entities, entity)
+	}
+	for _, rel := range r.relationships {
+		source, err := r.entity(ctx, rel.FromID)
+		if err != nil {
+			return Prediction{}, err
+		}
+		target, err := r.entity(ctx, rel.ToID)
+		if err != nil {
+			return Prediction{}, err
+		}
+		prediction.Relationships = append(prediction.Relationships, PredictedRelationship{
+			Source:    source,
+			Predicate: rel.Type,
+			Target:    target,
+		})
+	}
+	return prediction, nil
+}
+
+func (r *recordingRepository) entity(ctx context.Context, id int) (PredictedEntity, error) {
+	entity, err := r.FindEntityByID(ctx, id)
+	if err != nil {
+		return PredictedEntity{}, fmt.Errorf("failed to find extracted entity %d: %w", id, err)
+	}
+	aliases, err := r.FindAliases(ctx, id)
+	if err != nil {
+		return PredictedEntity{}, err
+	}
+	predicted := PredictedEntity{Type: entity.TypeCategory, Name: entity.Name}
+	for _, alias := range aliases {
+		predicted.Aliases = append(predicted.Aliases, alias.Alias)
+	}
+	return predicted, nil
+}
+
+// OfflineClient is an LLM client for replaying recorded responses without a model server.
+// Completions fail, and embeddings are zero vectors of the configured dimensions.
+type OfflineClient struct {
+	Dimensions int
+}
+
+var errOffline = errors.New("LLM is not available offline")
+
+func (c *OfflineClient) GenerateCompletion(ctx context.Context, prompt string) (string, error) {
+	return "", errOffline
+}
+
+func (c *OfflineClient) GenerateStructured(ctx context.Context, prompt string, schema *llm.ResponseSchema) (json.RawMessage, error) {
+	return nil, errOffline
+}
+
+func (c *OfflineClient) GenerateEmbedding(ctx context.Context, text string) ([]float32, error) {
+	return make([]float32, c.Dimensions), nil
+}
+
+func (c *OfflineClient) GenerateEmbeddings(ctx context.Context, texts []string) ([][]float32, error) {
+	embeddings := make([][]float32, len(texts))
+	for i := range texts {
+		embeddings[i] = make([]float32, c.Dimensions)
+	}
+	return embeddings, nil
+}
+
+func (c *OfflineClient) Close() error {
+	return nil
+}
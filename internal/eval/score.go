@@ -0,0 +1,252 @@
+package eval
+
+import (
+	"sort"
+	"strings"
+)
+
+// Score counts extracted facts matched against the gold set and the resulting metrics
+type Score struct {
+	TruePositives  int     `json:"true_positives"`
+	FalsePositives int     `json:"false_positives"`
+	FalseNegatives int     `json:"false_negatives"`
+	Precision      float64 `json:"precision"`
+	Recall         float64 `json:"recall"`
+	F1             float64 `json:"f1"`
+}
+
+// LabelScore is the score of one entity type or predicate
+type LabelScore struct {
+	Label string `json:"label"`
+	Score
+}
+
+// add accumulates the counts of another score and recomputes the metrics
+func (s *Score) add(other Score) {
+	s.TruePositives += other.TruePositives
+	s.FalsePositives += other.FalsePositives
+	s.FalseNegatives += other.FalseNegatives
+	s.compute()
+}
+
+// compute derives precision, recall and F1 from the counts. Metrics without any
+// predictions or gold facts to divide by are 0.
+func (s *Score) compute() {
+	s.Precision, s.Recall, s.F1 = 0, 0, 0
+	if predicted := s.TruePositives + s.FalsePositives; predicted > 0 {
+		s.Precision = float64(s.TruePositives) / float64(predicted)
+	}
+	if expected := s.TruePositives + s.FalseNegatives; expected > 0 {
+		s.Recall = float64(s.TruePositives) / float64(expected)
+	}
+	if s.Precision+s.Recall > 0 {
+		s.F1 = 2 * s.Precision * s.Recall / (s.Precision + s.Recall)
+	}
+}
+
+// Prediction is what the extractor produced for one email
+type Prediction struct {
+	Entities      []PredictedEntity
+	Relationships []PredictedRelationship
+}
+
+// PredictedEntity is an extracted entity with the aliases recorded for it
+type PredictedEntity struct {
+	Type    string
+	Name    string
+	Aliases []string
+}
+
+// names returns the normalized name and aliases of the entity
+func (p PredictedEntity) names() []string {
+	names := []string{normalizeName(p.Name)}
+	for _, alias := range p.Aliases {
+		if alias = normalizeName(alias); alias != "" {
+			names = append(names, alias)
+		}
+	}
+	return names
+}
+
+// PredictedRelationship is an extracted relationship between two entities
+type PredictedRelationship struct {
+	Source    PredictedEntity
+	Predicate string
+	Target    PredictedEntity
+}
+
+// EmailResult is the score of one email, with the facts that were missed or wrongly extracted
+type EmailResult struct {
+	MessageID             string   `json:"message_id"`
+	Entities              Score    `json:"entities"`
+	Relationships         Score    `json:"relationships"`
+	MissedEntities        []string `json:"missed_entities,omitempty"`
+	SpuriousEntities      []string `json:"spurious_entities,omitempty"`
+	MissedRelationships   []string `json:"missed_relationships,omitempty"`
+	SpuriousRelationships []string `json:"spurious_relationships,omitempty"`
+	Error                 string   `json:"error,omitempty"`
+}
+
+// labelCounts accumulates scores per entity type or predicate
+type labelCounts map[string]*Score
+
+func (c labelCounts) count(label string) *Score {
+	if c[label] == nil {
+		c[label] = &Score{}
+	}
+	return c[label]
+}
+
+func (c labelCounts) merge(other labelCounts) {
+	for label, score := range other {
+		c.count(label).add(*score)
+	}
+}
+
+// sorted returns the scores ordered by label
+func (c labelCounts) sorted() []LabelScore {
+	scores := make([]LabelScore, 0, len(c))
+	for label, score := range c {
+		score.compute()
+		scores = append(scores, LabelScore{Label: label, Score: *score})
+	}
+	sort.Slice(scores, func(i, j int) bool { return scores[i].Label < scores[j].Label })
+	return scores
+}
+
+// scoreEmail matches an email's predictions against its annotations. Entities match on type and
+// on name or alias; relationships match on their endpoints, resolved through the gold names and
+// aliases, and on the predicate or one of its alternatives. Each gold fact is matched at most once.
+func scoreEmail(gold *GoldEmail, prediction Prediction) (EmailResult, labelCounts, labelCounts) {
+	result := EmailResult{MessageID: gold.MessageID}
+	types, predicates := labelCounts{}, labelCounts{}
+
+	// Names and aliases resolve to the canonical gold name
+	canonical := map[string]string{}
+	for _, entity := range gold.Entities {
+		for _, name := range append([]string{entity.Name}, entity.Aliases...) {
+			if _, taken := canonical[normalizeName(name)]; !taken {
+				canonical[normalizeName(name)] = normalizeName(entity.Name)
+			}
+		}
+	}
+	resolve := func(entity PredictedEntity) string {
+		for _, name := range entity.names() {
+			if resolved, ok := canonical[name]; ok {
+				return resolved
+			}
+		}
+		return normalizeName(entity.Name)
+	}
+
+	matched := make([]bool, len(gold.Entities))
+	seen := map[string]bool{}
+	for _, entity := range prediction.Entities {
+		entityType, name := normalizeLabel(entity.Type), normalizeName(entity.Name)
+		if seen[entityType+":"+name] {
+			continue
+		}
+		seen[entityType+":"+name] = true
+
+		found := false
+		for i, expected := range gold.Entities {
+			if matched[i] || normalizeLabel(expected.Type) != entityType || !entityNamed(expected, entity.names()) {
+				continue
+			}
+			matched[i], found = true, true
+			types.count(entityType).TruePositives++
+			result.Entities.TruePositives++
+			break
+		}
+		if !found {
+			types.count(entityType).FalsePositives++
+			result.Entities.FalsePositives++
+			result.SpuriousEntities = append(result.SpuriousEntities, entityType+": "+entity.Name)
+		}
+	}
+	for i, expected := range gold.Entities {
+		if !matched[i] {
+			entityType := normalizeLabel(expected.Type)
+			types.count(entityType).FalseNegatives++
+			result.Entities.FalseNegatives++
+			result.MissedEntities = append(result.MissedEntities, entityType+": "+expected.Name)
+		}
+	}
+
+	matched = make([]bool, len(gold.Relationships))
+	seen = map[string]bool{}
+	for _, rel := range prediction.Relationships {
+		source, predicate, target := resolve(rel.Source), normalizePredicate(rel.Predicate), resolve(rel.Target)
+		key := source + "|" + predicate + "|" + target
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		found := false
+		for i, expected := range gold.Relationships {
+			if matched[i] || canonical[normalizeName(expected.Source)] != source ||
+				canonical[normalizeName(expected.Target)] != target || !acceptsPredicate(expected, predicate) {
+				continue
+			}
+			matched[i], found = true, true
+			predicates.count(normalizePredicate(expected.Predicate)).TruePositives++
+			result.Relationships.TruePositives++
+			break
+		}
+		if !found {
+			predicates.count(predicate).FalsePositives++
+			result.Relationships.FalsePositives++
+			result.SpuriousRelationships = append(result.SpuriousRelationships, formatRelationship(rel.Source.Name, predicate, rel.Target.Name))
+		}
+	}
+	for i, expected := range gold.Relationships {
+		if !matched[i] {
+			predicate := normalizePredicate(expected.Predicate)
+			predicates.count(predicate).FalseNegatives++
+			result.Relationships.FalseNegatives++
+			result.MissedRelationships = append(result.MissedRelationships, formatRelationship(expected.Source, predicate, expected.Target))
+		}
+	}
+
+	result.Entities.compute()
+	result.Relationships.compute()
+	return result, types, predicates
+}
+
+// entityNamed reports whether any of the normalized names is the gold entity's name or alias
+func entityNamed(entity GoldEntity, names []string) bool {
+	for _, name := range names {
+		if normalizeName(entity.Name) == name {
+			return true
+		}
+		for _, alias := range entity.Aliases {
+			if normalizeName(alias) == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// acceptsPredicate reports whether a normalized predicate is the gold predicate or an alternative
+func acceptsPredicate(rel GoldRelationship, predicate string) bool {
+	if normalizePredicate(rel.Predicate) == predicate {
+		return true
+	}
+	for _, alternative := range rel.Alternatives {
+		if normalizePredicate(alternative) == predicate {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizePredicate folds predicates to the VERB_FORM the extraction prompt asks for
+func normalizePredicate(predicate string) string {
+	return strings.ToUpper(normalizeLabel(predicate))
+}
+
+func formatRelationship(source, predicate, target string) string {
+	return source + " -[" + predicate + "]-> " + target
+}
@@ -10,23 +10,55 @@ import (
 
 	esql "entgo.io/ent/dialect/sql"
 	"github.com/Blogem/enron-graph/ent"
+	"github.com/Blogem/enron-graph/ent/community"
 	"github.com/Blogem/enron-graph/ent/discoveredentity"
 	"github.com/Blogem/enron-graph/ent/email"
+	"github.com/Blogem/enron-graph/ent/predicate"
+	"github.com/Blogem/enron-graph/ent/provenance"
 	"github.com/Blogem/enron-graph/ent/relationship"
+	"github.com/Blogem/enron-graph/internal/graph"
+	"github.com/Blogem/enron-graph/internal/graph/analytics"
 	"github.com/Blogem/enron-graph/pkg/llm"
 )
 
+// nonEntityRelationshipTypes link emails to each other and to threads rather than entities to
+// each other
+var nonEntityRelationshipTypes = []string{graph.RelationshipReplyTo, graph.RelationshipPartOfThread}
+
+// entityRelationships selects the relationships between entities, leaving out those between
+// emails and threads and the memberships of entities in their community
+func entityRelationships() predicate.Relationship {
+	return relationship.And(
+		relationship.TypeNotIn(nonEntityRelationshipTypes...),
+		relationship.Not(graph.CommunityMembership()),
+	)
+}
+
+// embeddingVectorColumn is the native pgvector column maintained alongside the JSON embedding
+const embeddingVectorColumn = "embedding_vector"
+
+// semanticSearchThreshold is the least similarity of a semantic search match
+const semanticSearchThreshold = 0.3
+
 type GraphService struct {
 	client    *ent.Client
 	db        *sql.DB
 	llmClient llm.Client
+	metric    graph.VectorMetric
 }
 
 func NewGraphService(client *ent.Client, db *sql.DB, llmClient llm.Client) *GraphService {
+	return NewGraphServiceWithVectorConfig(client, db, llmClient, graph.DefaultVectorConfig())
+}
+
+// NewGraphServiceWithVectorConfig creates a graph service whose semantic search uses the
+// metric the embedding index was built for (see graph.MigrateVectorColumn)
+func NewGraphServiceWithVectorConfig(client *ent.Client, db *sql.DB, llmClient llm.Client, cfg graph.VectorConfig) *GraphService {
 	return &GraphService{
 		client:    client,
 		db:        db,
 		llmClient: llmClient,
+		metric:    cfg.Metric,
 	}
 }
 
@@ -78,6 +110,7 @@ func (s *GraphService) GetRandomNodes(ctx context.Context, limit int) (*GraphRes
 	if err != nil {
 		return nil, fmt.Errorf("failed to get connecting edges: %w", err)
 	}
+	s.attachAnalytics(ctx, nodes)
 
 	return &GraphResponse{
 		Nodes:      nodes,
@@ -148,23 +181,23 @@ func (s *GraphService) GetNodes(ctx context.Context, filter NodeFilter) (*GraphR
 					if len(filter.Types) > 0 {
 						semanticQuery = semanticQuery.Where(discoveredentity.TypeCategoryIn(filter.Types...))
 					}
+					// Search the indexed pgvector column (see graph.MigrateVectorColumn) with the
+					// operator of its index
+					operator := s.metric.Operator()
+					maxDistance := s.metric.MaxDistance(semanticSearchThreshold)
 					semanticQuery = semanticQuery.Where(func(s *esql.Selector) {
 						s.Where(esql.P(func(b *esql.Builder) {
-							b.Ident(s.C(discoveredentity.FieldEmbedding))
+							b.Ident(s.C(embeddingVectorColumn))
 							b.WriteString(" IS NOT NULL AND ")
-							b.Ident(s.C(discoveredentity.FieldEmbedding))
-							b.WriteString(" != 'null'::jsonb AND ")
-							b.WriteString("(1 - (")
-							b.Ident(s.C(discoveredentity.FieldEmbedding))
-							b.WriteString("::text::vector <=> '")
-							b.WriteString(string(embeddingJSON))
-							b.WriteString("'::vector)) > 0.3")
+							b.Ident(s.C(embeddingVectorColumn))
+							b.WriteString(fmt.Sprintf(" %s '%s'::vector < %g", operator, embeddingJSON, maxDistance))
 						}))
 					})
 					semanticQuery = semanticQuery.Order(func(s *esql.Selector) {
 						s.OrderExpr(esql.Expr(fmt.Sprintf(
-							"%s::text::vector <=> '%s'::vector",
-							s.C(discoveredentity.FieldEmbedding),
+							"%s %s '%s'::vector",
+							s.C(embeddingVectorColumn),
+							operator,
 							string(embeddingJSON),
 						)))
 					})
@@ -277,6 +310,7 @@ func (s *GraphService) GetNodes(ctx context.Context, filter NodeFilter) (*GraphR
 
 	// T080b: Add ghost nodes to response
 	nodes = append(nodes, ghostNodes...)
+	s.attachAnalytics(ctx, nodes)
 
 	return &GraphResponse{
 		Nodes:      nodes,
@@ -302,6 +336,7 @@ func (s *GraphService) GetRelationships(ctx context.Context, nodeID string, offs
 		Query().
 		Where(
 			relationship.FromIDEQ(entity.ID),
+			entityRelationships(),
 		).
 		Count(ctx)
 	if err != nil {
@@ -312,6 +347,7 @@ func (s *GraphService) GetRelationships(ctx context.Context, nodeID string, offs
 		Query().
 		Where(
 			relationship.ToIDEQ(entity.ID),
+			entityRelationships(),
 		).
 		Count(ctx)
 	if err != nil {
@@ -325,6 +361,7 @@ func (s *GraphService) GetRelationships(ctx context.Context, nodeID string, offs
 		Query().
 		Where(
 			relationship.FromIDEQ(entity.ID),
+			entityRelationships(),
 		).
 		Offset(offset).
 		Limit(limit).
@@ -346,6 +383,7 @@ func (s *GraphService) GetRelationships(ctx context.Context, nodeID string, offs
 			Query().
 			Where(
 				relationship.ToIDEQ(entity.ID),
+				entityRelationships(),
 			).
 			Offset(adjustedOffset).
 			Limit(remainingLimit).
@@ -431,6 +469,7 @@ func (s *GraphService) GetRelationships(ctx context.Context, nodeID string, offs
 		}
 	}
 
+	s.attachAnalytics(ctx, nodes)
 	hasMore := offset+limit < totalCount
 
 	return &RelationshipsResponse{
@@ -462,14 +501,22 @@ func (s *GraphService) GetNodeDetails(ctx context.Context, nodeID string) (*Grap
 			props["name"] = entity.Name
 		}
 
-		return &GraphNode{
+		lineage, err := s.getNodeProvenance(ctx, entity.ID)
+		if err != nil {
+			log.Printf("Warning: failed to load provenance for %s: %v", nodeID, err)
+		}
+
+		nodes := []GraphNode{{
 			ID:         entity.UniqueID,
 			Type:       entity.TypeCategory,
 			Category:   "discovered",
 			Properties: props,
 			IsGhost:    false,
 			Degree:     degree,
-		}, nil
+			Provenance: lineage,
+		}}
+		s.attachAnalytics(ctx, nodes)
+		return &nodes[0], nil
 	}
 
 	// Try to find as email (promoted type)
@@ -526,6 +573,7 @@ func (s *GraphService) getEdgesBetweenNodes(ctx context.Context, entities []*ent
 		Query().
 		Where(
 			relationship.FromIDIn(entityIDs...),
+			entityRelationships(),
 			relationship.ToIDIn(entityIDs...),
 		).
 		All(ctx)
@@ -571,6 +619,7 @@ func (s *GraphService) getEdgesWithGhostNodes(ctx context.Context, entities []*e
 		Query().
 		Where(
 			relationship.FromIDIn(entityIDs...),
+			entityRelationships(),
 		).
 		All(ctx)
 	if err != nil {
@@ -644,11 +693,40 @@ func (s *GraphService) getEdgesWithGhostNodes(ctx context.Context, entities []*e
 	return edges, ghostNodes, nil
 }
 
+func (s *GraphService) getNodeProvenance(ctx context.Context, entityID int) ([]ProvenanceRecord, error) {
+	records, err := s.client.Provenance.
+		Query().
+		Where(
+			provenance.SubjectTypeEQ("discovered_entity"),
+			provenance.SubjectIDEQ(entityID),
+		).
+		Order(ent.Asc(provenance.FieldExtractedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]ProvenanceRecord, len(records))
+	for i, record := range records {
+		result[i] = ProvenanceRecord{
+			MessageID:     record.MessageID,
+			FilePath:      record.FilePath,
+			Source:        record.Source,
+			Model:         record.Model,
+			PromptVersion: record.PromptVersion,
+			ExtractedAt:   record.ExtractedAt.Format("2006-01-02T15:04:05Z07:00"),
+		}
+	}
+
+	return result, nil
+}
+
 func (s *GraphService) getNodeDegree(ctx context.Context, entityID int) (int, error) {
 	outgoing, err := s.client.Relationship.
 		Query().
 		Where(
 			relationship.FromIDEQ(entityID),
+			entityRelationships(),
 		).
 		Count(ctx)
 	if err != nil {
@@ -659,6 +737,7 @@ func (s *GraphService) getNodeDegree(ctx context.Context, entityID int) (int, er
 		Query().
 		Where(
 			relationship.ToIDEQ(entityID),
+			entityRelationships(),
 		).
 		Count(ctx)
 	if err != nil {
@@ -668,6 +747,91 @@ func (s *GraphService) getNodeDegree(ctx context.Context, entityID int) (int, er
 	return outgoing + incoming, nil
 }
 
+// attachAnalytics adds the stored centrality scores of the "all" scope and the detected
+// community to discovered nodes. Both are optional, so nodes are left without them when they
+// cannot be loaded.
+func (s *GraphService) attachAnalytics(ctx context.Context, nodes []GraphNode) {
+	uniqueIDs := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		if node.Category == "discovered" {
+			uniqueIDs = append(uniqueIDs, node.ID)
+		}
+	}
+	if len(uniqueIDs) == 0 {
+		return
+	}
+
+	entities, err := s.client.DiscoveredEntity.
+		Query().
+		Where(discoveredentity.UniqueIDIn(uniqueIDs...)).
+		Select(discoveredentity.FieldID, discoveredentity.FieldUniqueID).
+		All(ctx)
+	if err != nil {
+		log.Printf("Warning: failed to look up entities for graph analytics: %v", err)
+		return
+	}
+	entityIDs := make(map[string]int, len(entities))
+	ids := make([]int, len(entities))
+	for i, e := range entities {
+		entityIDs[e.UniqueID] = e.ID
+		ids[i] = e.ID
+	}
+
+	scores, err := analytics.FindScores(ctx, s.client, analytics.Filter{}.Scope(), ids)
+	if err != nil {
+		log.Printf("Warning: failed to load centrality: %v", err)
+	}
+	communities, err := analytics.FindCommunities(ctx, s.client, ids)
+	if err != nil {
+		log.Printf("Warning: failed to load communities: %v", err)
+	}
+	labels, err := s.communityLabels(ctx, communities)
+	if err != nil {
+		log.Printf("Warning: failed to load community labels: %v", err)
+	}
+
+	for i := range nodes {
+		id, ok := entityIDs[nodes[i].ID]
+		if !ok || nodes[i].Category != "discovered" {
+			continue
+		}
+		if score, ok := scores[id]; ok {
+			nodes[i].Centrality = make(map[string]float64, len(analytics.Metrics))
+			for _, metric := range analytics.Metrics {
+				nodes[i].Centrality[metric] = score.Value(metric)
+			}
+		}
+		if communityID, ok := communities[id]; ok {
+			nodes[i].Community = communityID
+			nodes[i].CommunityLabel = labels[communityID]
+		}
+	}
+}
+
+// communityLabels returns the labels of the communities entities belong to, by community ID
+func (s *GraphService) communityLabels(ctx context.Context, communities map[int]int) (map[int]string, error) {
+	if len(communities) == 0 {
+		return nil, nil
+	}
+	ids := make([]int, 0, len(communities))
+	for _, id := range communities {
+		ids = append(ids, id)
+	}
+	rows, err := s.client.Community.
+		Query().
+		Where(community.IDIn(ids...)).
+		Select(community.FieldID, community.FieldLabel).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	labels := make(map[int]string, len(rows))
+	for _, row := range rows {
+		labels[row.ID] = row.Label
+	}
+	return labels, nil
+}
+
 // getPromotedNodes returns nodes from promoted types (dynamically discovered from database tables)
 func (s *GraphService) getPromotedNodes(ctx context.Context, filter NodeFilter) (*GraphResponse, error) {
 	nodes := []GraphNode{}
@@ -685,7 +849,7 @@ func (s *GraphService) getPromotedNodes(ctx context.Context, filter NodeFilter)
 		FROM information_schema.tables
 		WHERE table_schema = 'public'
 		AND table_type = 'BASE TABLE'
-		AND table_name NOT IN ('relationships', 'discovered_entities', 'schema_promotions')
+		AND table_name NOT IN (` + graph.MetaTables + `)
 		ORDER BY table_name
 	`
 
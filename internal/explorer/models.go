@@ -7,6 +7,21 @@ type GraphNode struct {
 	Properties map[string]interface{} `json:"properties"`
 	IsGhost    bool                   `json:"is_ghost"`
 	Degree     int                    `json:"degree,omitempty"`
+	Provenance []ProvenanceRecord     `json:"provenance,omitempty"`
+	Centrality map[string]float64     `json:"centrality,omitempty"` // Stored scores over all relationships, by metric
+	Community  int                    `json:"community,omitempty"`  // Detected community ID
+	// CommunityLabel names the community after its most connected member
+	CommunityLabel string `json:"community_label,omitempty"`
+}
+
+// ProvenanceRecord describes where a node's information was extracted from
+type ProvenanceRecord struct {
+	MessageID     string `json:"message_id,omitempty"`
+	FilePath      string `json:"file_path,omitempty"`
+	Source        string `json:"source"`
+	Model         string `json:"model,omitempty"`
+	PromptVersion string `json:"prompt_version,omitempty"`
+	ExtractedAt   string `json:"extracted_at"`
 }
 
 type GraphEdge struct {
@@ -7,6 +7,7 @@ import (
 
 	"github.com/Blogem/enron-graph/ent"
 	"github.com/Blogem/enron-graph/ent/discoveredentity"
+	"github.com/Blogem/enron-graph/internal/graph"
 )
 
 type SchemaService struct {
@@ -14,6 +15,8 @@ type SchemaService struct {
 	db     *sql.DB
 	mu     sync.RWMutex
 	cache  *SchemaResponse
+
+	onRefresh []func()
 }
 
 func NewSchemaService(client *ent.Client, db *sql.DB) *SchemaService {
@@ -76,7 +79,7 @@ func (s *SchemaService) getPromotedTypes(ctx context.Context) ([]SchemaType, err
 		LEFT JOIN pg_stat_user_tables pg_stat ON pg_stat.relname = t.table_name
 		WHERE t.table_schema = 'public'
 		AND t.table_type = 'BASE TABLE'
-		AND t.table_name NOT IN ('relationships', 'discovered_entities', 'schema_promotions')
+		AND t.table_name NOT IN (` + graph.MetaTables + `)
 		ORDER BY t.table_name
 	`
 
@@ -220,7 +223,7 @@ func (s *SchemaService) GetTypeDetails(ctx context.Context, typeName string) (*S
 			WHERE table_schema = 'public' 
 			AND table_name = $1
 			AND table_type = 'BASE TABLE'
-			AND table_name NOT IN ('relationships', 'discovered_entities', 'schema_promotions')
+			AND table_name NOT IN (` + graph.MetaTables + `)
 		)
 	`
 	err := s.db.QueryRowContext(ctx, tableQuery, typeName).Scan(&tableExists)
@@ -312,11 +315,23 @@ func (s *SchemaService) getDiscoveredTypeDetails(ctx context.Context, typeName s
 	}, nil
 }
 
+// OnRefresh registers a callback that runs whenever the schema cache is refreshed
+func (s *SchemaService) OnRefresh(fn func()) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.onRefresh = append(s.onRefresh, fn)
+}
+
 func (s *SchemaService) RefreshSchema(ctx context.Context) error {
 	s.mu.Lock()
 	s.cache = nil
+	listeners := append([]func(){}, s.onRefresh...)
 	s.mu.Unlock()
 
+	for _, fn := range listeners {
+		fn()
+	}
+
 	_, err := s.GetSchema(ctx)
 	return err
 }
@@ -9,6 +9,7 @@ import (
 
 	"github.com/Blogem/enron-graph/ent"
 	"github.com/Blogem/enron-graph/internal/graph"
+	"github.com/Blogem/enron-graph/internal/prompts"
 	"github.com/Blogem/enron-graph/pkg/llm"
 )
 
@@ -41,6 +42,26 @@ func NewBatchExtractor(llmClient llm.Client, repo graph.Repository, logger *slog
 	}
 }
 
+// SetModel records the LLM model name stamped on provenance records
+func (b *BatchExtractor) SetModel(model string) {
+	b.extractor.SetModel(model)
+}
+
+// SetPrompts selects the extraction prompt template; see Extractor.SetPrompts
+func (b *BatchExtractor) SetPrompts(registry *prompts.Registry) error {
+	return b.extractor.SetPrompts(registry)
+}
+
+// PromptVersion returns the version of the extraction prompt template in use
+func (b *BatchExtractor) PromptVersion() string {
+	return b.extractor.PromptVersion()
+}
+
+// SetCache makes extraction consult cache before calling the LLM; see Extractor.SetCache
+func (b *BatchExtractor) SetCache(cache ResponseCache, replay bool) {
+	b.extractor.SetCache(cache, replay)
+}
+
 // ProcessBatch processes multiple emails concurrently
 func (b *BatchExtractor) ProcessBatch(ctx context.Context, emails []*ent.Email) error {
 	var wg sync.WaitGroup
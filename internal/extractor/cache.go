@@ -0,0 +1,100 @@
+package extractor
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+)
+
+// ErrCacheMiss is returned when replaying from the cache and no response is cached for a prompt
+var ErrCacheMiss = errors.New("no cached extraction response")
+
+// ResponseCache stores raw LLM extraction responses by content-addressed key
+type ResponseCache interface {
+	// Get returns the cached response for key, or false when there is none
+	Get(key string) (json.RawMessage, bool, error)
+	// Put stores the response for key, replacing any previous one
+	Put(key string, response json.RawMessage) error
+}
+
+// CacheKey identifies an extraction by the text it was run on, the model and the prompt version.
+// The text is the chunk together with the headers it is presented with, since those change the
+// response. The types and relationships already in the graph are deliberately left out: they only
+// hint the LLM, and including them would make every rebuild miss the cache.
+func CacheKey(from, to, subject, text, model, promptVersion string) string {
+	body := sha256.Sum256([]byte(from + "\x00" + to + "\x00" + subject + "\x00" + text))
+	key := sha256.Sum256([]byte(hex.EncodeToString(body[:]) + "\x00" + model + "\x00" + promptVersion))
+	return hex.EncodeToString(key[:])
+}
+
+// DiskCache is a ResponseCache of JSON files in a local directory. It lives outside the database
+// so that it survives dropping and rebuilding the graph.
+type DiskCache struct {
+	dir    string
+	hits   atomic.Int64
+	misses atomic.Int64
+}
+
+// NewDiskCache creates a disk cache in dir, creating the directory if needed
+func NewDiskCache(dir string) (*DiskCache, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create cache directory: %w", err)
+	}
+	return &DiskCache{dir: dir}, nil
+}
+
+// Get reads the cached response for key
+func (c *DiskCache) Get(key string) (json.RawMessage, bool, error) {
+	data, err := os.ReadFile(c.path(key))
+	if errors.Is(err, os.ErrNotExist) {
+		c.misses.Add(1)
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to read cached response: %w", err)
+	}
+	c.hits.Add(1)
+	return json.RawMessage(data), true, nil
+}
+
+// Put writes the response for key. The file is written under a temporary name and renamed, so
+// concurrent workers and interrupted runs never leave a partial response behind.
+func (c *DiskCache) Put(key string, response json.RawMessage) error {
+	path := c.path(key)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create cache directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), key+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create cache file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(response); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write cache file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write cache file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to store cache file: %w", err)
+	}
+	return nil
+}
+
+// Stats returns the number of cache hits and misses so far
+func (c *DiskCache) Stats() (hits, misses int64) {
+	return c.hits.Load(), c.misses.Load()
+}
+
+// path spreads entries over subdirectories named after the first byte of the key
+func (c *DiskCache) path(key string) string {
+	return filepath.Join(c.dir, key[:2], key+".json")
+}
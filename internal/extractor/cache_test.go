@@ -0,0 +1,151 @@
+package extractor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/Blogem/enron-graph/ent"
+	"github.com/Blogem/enron-graph/internal/graph"
+)
+
+func TestCacheKey_DependsOnTextModelAndPromptVersion(t *testing.T) {
+	key := CacheKey("a@enron.com", "b@enron.com", "Raptor", "body", "llama3.1:8b", "v3")
+	if key != CacheKey("a@enron.com", "b@enron.com", "Raptor", "body", "llama3.1:8b", "v3") {
+		t.Error("Expected the same input to give the same key")
+	}
+	for name, other := range map[string]string{
+		"text":    CacheKey("a@enron.com", "b@enron.com", "Raptor", "other body", "llama3.1:8b", "v3"),
+		"header":  CacheKey("c@enron.com", "b@enron.com", "Raptor", "body", "llama3.1:8b", "v3"),
+		"model":   CacheKey("a@enron.com", "b@enron.com", "Raptor", "body", "llama3.1:70b", "v3"),
+		"version": CacheKey("a@enron.com", "b@enron.com", "Raptor", "body", "llama3.1:8b", "v2"),
+	} {
+		if other == key {
+			t.Errorf("Expected a different %s to change the key", name)
+		}
+	}
+}
+
+func TestDiskCache_GetPut(t *testing.T) {
+	cache, err := NewDiskCache(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewDiskCache failed: %v", err)
+	}
+	key := CacheKey("", "", "", "body", "model", "v3")
+
+	if _, ok, err := cache.Get(key); ok || err != nil {
+		t.Fatalf("Expected a miss on an empty cache, got %v (%v)", ok, err)
+	}
+	if err := cache.Put(key, json.RawMessage(`{"entities": []}`)); err != nil {
+		t.Fatalf("Put failed: %v", err)
+	}
+	response, ok, err := cache.Get(key)
+	if !ok || err != nil || string(response) != `{"entities": []}` {
+		t.Errorf("Expected the stored response, got %q %v (%v)", response, ok, err)
+	}
+	if hits, misses := cache.Stats(); hits != 1 || misses != 1 {
+		t.Errorf("Expected 1 hit and 1 miss, got %d and %d", hits, misses)
+	}
+}
+
+func TestExtractFromEmail_ReplaysCachedResponses(t *testing.T) {
+	cache, err := NewDiskCache(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewDiskCache failed: %v", err)
+	}
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	ctx := context.Background()
+	email := &ent.Email{
+		ID:        9,
+		MessageID: "<9@enron.com>",
+		From:      "andrew.fastow@enron.com",
+		Subject:   "Raptor",
+		Body:      "The Raptor vehicles need more capital.",
+	}
+
+	// First run calls the LLM and fills the cache
+	client := &MockLLMClient{
+		CompletionResponse: `{"entities": [{"id": "raptor", "type": "project", "name": "Raptor", "confidence": 0.9}], "relationships": []}`,
+		EmbeddingResponse:  []float32{0.1, 0.2},
+	}
+	extr := NewExtractor(client, graph.NewMockRepository(), logger)
+	extr.SetModel("llama3.1:8b")
+	extr.SetCache(cache, false)
+	if _, err := extr.ExtractFromEmail(ctx, email); err != nil {
+		t.Fatalf("ExtractFromEmail failed: %v", err)
+	}
+	if len(client.Prompts) != 1 {
+		t.Fatalf("Expected 1 LLM call, got %d", len(client.Prompts))
+	}
+
+	// Rebuilding into an empty graph replays the cached response without calling the LLM
+	replayClient := &MockLLMClient{EmbeddingResponse: []float32{0.1, 0.2}}
+	repo := graph.NewMockRepository()
+	replay := NewExtractor(replayClient, repo, logger)
+	replay.SetModel("llama3.1:8b")
+	replay.SetCache(cache, true)
+	summary, err := replay.ExtractFromEmail(ctx, email)
+	if err != nil {
+		t.Fatalf("Replay failed: %v", err)
+	}
+	if len(replayClient.Prompts) != 0 {
+		t.Errorf("Expected no LLM calls when replaying, got %d", len(replayClient.Prompts))
+	}
+	if projects, _ := repo.FindEntitiesByAlias(ctx, "Raptor"); len(projects) != 1 || summary.EntitiesCreated == 0 {
+		t.Errorf("Expected the cached entity to be created, got %d", len(projects))
+	}
+
+	// Another model misses the cache, which fails the content extraction when replaying
+	replay.SetModel("llama3.1:70b")
+	if _, err := replay.extractFromContent(ctx, email); !errors.Is(err, ErrCacheMiss) {
+		t.Errorf("Expected ErrCacheMiss for an uncached model, got %v", err)
+	}
+}
+
+// TestExtractFromEmail_ReplayMissFailsEmail tests that a rebuild never silently drops a chunk
+// without a recorded response while replaying the others
+func TestExtractFromEmail_ReplayMissFailsEmail(t *testing.T) {
+	cache, err := NewDiskCache(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewDiskCache failed: %v", err)
+	}
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	ctx := context.Background()
+
+	var paragraphs []string
+	for i := 0; i < 20; i++ {
+		paragraphs = append(paragraphs, fmt.Sprintf("Item %d: %s", i, strings.Repeat("The Raptor hedges need review. ", 8)))
+	}
+	body := strings.Join(paragraphs, "\n\n")
+	email := &ent.Email{ID: 10, MessageID: "<10@enron.com>", From: "andrew.fastow@enron.com", Subject: "Raptor", Body: body}
+
+	client := &MockLLMClient{
+		CompletionResponse: `{"entities": [{"id": "raptor", "type": "project", "name": "Raptor", "confidence": 0.9}], "relationships": []}`,
+		EmbeddingResponse:  []float32{0.1, 0.2},
+	}
+	record := NewExtractor(client, graph.NewMockRepository(), logger)
+	record.SetCache(cache, false)
+	if _, err := record.ExtractFromEmail(ctx, email); err != nil {
+		t.Fatalf("ExtractFromEmail failed: %v", err)
+	}
+
+	// A new closing paragraph changes only the last chunk, which has no recorded response
+	email.Body = body + "\n\nClosing note about LJM2."
+	replayClient := &MockLLMClient{EmbeddingResponse: []float32{0.1, 0.2}}
+	replay := NewExtractor(replayClient, graph.NewMockRepository(), logger)
+	replay.SetCache(cache, true)
+	if _, err := replay.ExtractFromEmail(ctx, email); !errors.Is(err, ErrCacheMiss) {
+		t.Errorf("Expected ErrCacheMiss for the unrecorded chunk, got %v", err)
+	}
+	if hits, _ := cache.Stats(); hits == 0 {
+		t.Errorf("Expected the other chunks to be replayed")
+	}
+	if len(replayClient.Prompts) != 0 {
+		t.Errorf("Expected no LLM calls when replaying, got %d", len(replayClient.Prompts))
+	}
+}
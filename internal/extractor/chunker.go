@@ -0,0 +1,168 @@
+package extractor
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// Chunking limits, in bytes of body text sent per LLM call
+const (
+	// MaxChunkChars is the largest body a single extraction prompt carries, overlap included
+	MaxChunkChars = 2000
+	// ChunkOverlapChars is how much trailing text of a chunk is repeated at the start of the
+	// next one, so that facts spanning a paragraph break are seen whole at least once
+	ChunkOverlapChars = 200
+)
+
+// paragraphBreak matches the blank lines between paragraphs
+var paragraphBreak = regexp.MustCompile(`\n[ \t]*\n`)
+
+// sentenceEnd matches the whitespace after a sentence-ending punctuation mark
+var sentenceEnd = regexp.MustCompile(`[.!?]["')\]]?\s+`)
+
+// ChunkText splits text into chunks of at most maxChars bytes on paragraph boundaries. Each chunk
+// after the first starts with up to overlap bytes of the end of the previous chunk. Paragraphs
+// that do not fit a chunk on their own are split on sentences, then on whitespace.
+func ChunkText(text string, maxChars, overlap int) []string {
+	text = strings.TrimSpace(strings.ReplaceAll(text, "\r\n", "\n"))
+	if len(text) <= maxChars {
+		return []string{text}
+	}
+	if overlap < 0 || overlap >= maxChars/2 {
+		overlap = 0
+	}
+
+	var pieces []string
+	for _, paragraph := range paragraphBreak.Split(text, -1) {
+		paragraph = strings.TrimSpace(paragraph)
+		if paragraph == "" {
+			continue
+		}
+		// Leave room for the overlap and the blank line joining it to the piece
+		pieces = append(pieces, splitPiece(paragraph, maxChars-overlap-2)...)
+	}
+
+	var chunks []string
+	var current []string
+	size := 0
+	for _, piece := range pieces {
+		if len(current) > 0 && size+2+len(piece) > maxChars {
+			chunks = append(chunks, strings.Join(current, "\n\n"))
+			current = overlapTail(current, overlap)
+			size = joinedLen(current)
+		}
+		if len(current) > 0 {
+			size += 2
+		}
+		current = append(current, piece)
+		size += len(piece)
+	}
+	if len(current) > 0 {
+		chunks = append(chunks, strings.Join(current, "\n\n"))
+	}
+	return chunks
+}
+
+// splitPiece breaks a paragraph longer than limit into sentences, and sentences longer than
+// limit at whitespace, packing them back together up to limit
+func splitPiece(paragraph string, limit int) []string {
+	if len(paragraph) <= limit {
+		return []string{paragraph}
+	}
+
+	var sentences []string
+	start := 0
+	for _, loc := range sentenceEnd.FindAllStringIndex(paragraph, -1) {
+		sentences = append(sentences, paragraph[start:loc[1]])
+		start = loc[1]
+	}
+	sentences = append(sentences, paragraph[start:])
+
+	var parts []string
+	current := ""
+	for _, sentence := range sentences {
+		for len(sentence) > limit {
+			cut := cutPoint(sentence, limit)
+			if current != "" {
+				parts = append(parts, strings.TrimSpace(current))
+				current = ""
+			}
+			parts = append(parts, strings.TrimSpace(sentence[:cut]))
+			sentence = sentence[cut:]
+		}
+		if current != "" && len(current)+len(sentence) > limit {
+			parts = append(parts, strings.TrimSpace(current))
+			current = ""
+		}
+		current += sentence
+	}
+	if strings.TrimSpace(current) != "" {
+		parts = append(parts, strings.TrimSpace(current))
+	}
+	return parts
+}
+
+// cutPoint returns where to cut s so the first part is at most limit bytes, preferring the last
+// whitespace and never splitting a UTF-8 sequence
+func cutPoint(s string, limit int) int {
+	if i := strings.LastIndexAny(s[:limit], " \t\n"); i > limit/2 {
+		return i + 1
+	}
+	cut := limit
+	for cut > 0 && !utf8.RuneStart(s[cut]) {
+		cut--
+	}
+	return cut
+}
+
+// overlapTail returns the trailing pieces of a chunk that fit in overlap bytes. When the last piece
+// alone is longer, its final overlap bytes are used, starting at a word boundary.
+func overlapTail(pieces []string, overlap int) []string {
+	if overlap == 0 || len(pieces) == 0 {
+		return nil
+	}
+
+	var tail []string
+	size := 0
+	for i := len(pieces) - 1; i >= 0; i-- {
+		added := len(pieces[i])
+		if len(tail) > 0 {
+			added += 2
+		}
+		if size+added > overlap {
+			break
+		}
+		tail = append([]string{pieces[i]}, tail...)
+		size += added
+	}
+	if len(tail) > 0 {
+		return tail
+	}
+
+	last := pieces[len(pieces)-1]
+	start := len(last) - overlap
+	if i := strings.IndexAny(last[start:], " \t\n"); i >= 0 {
+		start += i + 1
+	} else {
+		for start < len(last) && !utf8.RuneStart(last[start]) {
+			start++
+		}
+	}
+	if fragment := strings.TrimSpace(last[start:]); fragment != "" {
+		return []string{fragment}
+	}
+	return nil
+}
+
+// joinedLen is the length of pieces joined with blank lines
+func joinedLen(pieces []string) int {
+	size := 0
+	for i, piece := range pieces {
+		if i > 0 {
+			size += 2
+		}
+		size += len(piece)
+	}
+	return size
+}
@@ -0,0 +1,72 @@
+package extractor
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestChunkText_ShortBodyIsOneChunk(t *testing.T) {
+	chunks := ChunkText("  Short memo.\n", MaxChunkChars, ChunkOverlapChars)
+	if len(chunks) != 1 || chunks[0] != "Short memo." {
+		t.Errorf("Expected a single trimmed chunk, got %q", chunks)
+	}
+}
+
+func TestChunkText_ParagraphBoundariesWithOverlap(t *testing.T) {
+	var paragraphs []string
+	for i := 1; i <= 12; i++ {
+		paragraphs = append(paragraphs, fmt.Sprintf("Section %d. %s", i, strings.Repeat("word ", 18)))
+	}
+	body := strings.Join(paragraphs, "\n\n")
+
+	chunks := ChunkText(body, 400, 120)
+	if len(chunks) < 3 {
+		t.Fatalf("Expected several chunks, got %d", len(chunks))
+	}
+
+	for i, chunk := range chunks {
+		if len(chunk) > 400 {
+			t.Errorf("Chunk %d is %d bytes, over the limit", i, len(chunk))
+		}
+		// Chunks never start or end inside a paragraph
+		if !strings.HasPrefix(chunk, "Section ") || !strings.HasSuffix(chunk, "word") {
+			t.Errorf("Chunk %d does not follow paragraph boundaries: %q", i, chunk)
+		}
+	}
+
+	// Each chunk repeats the last paragraph of the one before it
+	for i := 1; i < len(chunks); i++ {
+		previous := strings.Split(chunks[i-1], "\n\n")
+		last := previous[len(previous)-1]
+		if !strings.HasPrefix(chunks[i], last) {
+			t.Errorf("Chunk %d does not start with the overlap %q", i, last)
+		}
+	}
+
+	// Every paragraph ends up in some chunk
+	joined := strings.Join(chunks, "\n\n")
+	for _, paragraph := range paragraphs {
+		if !strings.Contains(joined, strings.TrimSpace(paragraph)) {
+			t.Errorf("Paragraph lost: %q", paragraph)
+		}
+	}
+}
+
+func TestChunkText_SplitsLongParagraphs(t *testing.T) {
+	sentence := "The counterparty shall deliver the gas at the Henry Hub. "
+	body := strings.Repeat(sentence, 60) + strings.Repeat("x", 900)
+
+	chunks := ChunkText(body, 500, 100)
+	for i, chunk := range chunks {
+		if len(chunk) > 500 {
+			t.Errorf("Chunk %d is %d bytes, over the limit", i, len(chunk))
+		}
+	}
+	if !strings.HasSuffix(chunks[len(chunks)-1], "xxx") {
+		t.Errorf("Expected the unbreakable run to end the last chunk, got %q", chunks[len(chunks)-1])
+	}
+	if !strings.HasSuffix(chunks[0], "Henry Hub.") {
+		t.Errorf("Expected the first chunk to end on a sentence, got %q", chunks[0])
+	}
+}
@@ -30,22 +30,36 @@ func NewDeduplicator(repo graph.Repository, logger interface {
 }
 
 // DeduplicatePerson checks if a person entity already exists
-// Uses email address as the primary unique key
+// Uses email address as the primary unique key, including addresses recorded as aliases.
+// A new address whose display name is a known alias of exactly one person resolves to that person.
 func (d *Deduplicator) DeduplicatePerson(ctx context.Context, email, name string) (*ent.DiscoveredEntity, bool, error) {
 	if email == "" {
 		return nil, false, fmt.Errorf("email is required for person deduplication")
 	}
 
-	// Look up by unique ID (email address)
+	// Look up by unique ID (email address), which also resolves secondary addresses
 	existing, err := d.repo.FindEntityByUniqueID(ctx, email)
+	if err == nil && existing != nil {
+		d.logger.Debug("Found duplicate person entity", "email", email, "existing_id", existing.ID)
+		return existing, true, nil
+	}
+
+	// Single names ("Jeff") are too ambiguous to link addresses on
+	if len(strings.Fields(name)) < 2 || strings.Contains(name, "@") {
+		return nil, false, nil
+	}
+
+	candidates, err := d.repo.FindEntitiesByAlias(ctx, name, "person")
 	if err != nil {
-		// Entity not found, not a duplicate
+		d.logger.Warn("Alias lookup failed", "name", name, "error", err)
+		return nil, false, nil
+	}
+	if len(candidates) != 1 {
 		return nil, false, nil
 	}
 
-	// Entity exists
-	d.logger.Debug("Found duplicate person entity", "email", email, "existing_id", existing.ID)
-	return existing, true, nil
+	d.logger.Debug("Found person entity by name alias", "email", email, "name", name, "existing_id", candidates[0].ID)
+	return candidates[0], true, nil
 }
 
 // DeduplicateOrganization checks if an organization entity already exists
@@ -82,11 +96,9 @@ func (d *Deduplicator) DeduplicateConcept(ctx context.Context, name string, embe
 		return d.deduplicateByName(ctx, "concept", name)
 	}
 
-	// Check if any similar entity is a concept with high similarity
+	// The search only returns entities above the similarity threshold
 	for _, entity := range similarEntities {
 		if entity.TypeCategory == "concept" {
-			// Calculate cosine similarity (simplified check for POC)
-			// In production, would compute actual cosine similarity
 			d.logger.Debug("Found similar concept entity",
 				"name", name,
 				"similar_name", entity.Name,
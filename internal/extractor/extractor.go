@@ -6,29 +6,71 @@ import (
 	"fmt"
 	"log/slog"
 	"strings"
+	"time"
 
 	"github.com/Blogem/enron-graph/ent"
 	"github.com/Blogem/enron-graph/internal/graph"
+	"github.com/Blogem/enron-graph/internal/loader"
+	"github.com/Blogem/enron-graph/internal/prompts"
 	"github.com/Blogem/enron-graph/internal/registry"
 	"github.com/Blogem/enron-graph/pkg/llm"
 )
 
 // Extractor handles entity extraction from emails
 type Extractor struct {
-	llmClient llm.Client
-	repo      graph.Repository
-	logger    *slog.Logger
+	llmClient     llm.Client
+	repo          graph.Repository
+	dedup         *Deduplicator
+	logger        *slog.Logger
+	model         string
+	prompt        *prompts.Prompt
+	promptVersion string
+	cache         ResponseCache
+	replay        bool
 }
 
 // NewExtractor creates a new entity extractor
 func NewExtractor(llmClient llm.Client, repo graph.Repository, logger *slog.Logger) *Extractor {
+	prompt := prompts.DefaultPrompt(prompts.Extraction)
 	return &Extractor{
-		llmClient: llmClient,
-		repo:      repo,
-		logger:    logger,
+		llmClient:     llmClient,
+		repo:          repo,
+		dedup:         NewDeduplicator(repo, logger),
+		logger:        logger,
+		prompt:        prompt,
+		promptVersion: prompt.Version,
 	}
 }
 
+// SetPrompts selects the extraction prompt template from registry. Its version is stamped on
+// provenance records and extraction jobs, and is part of the extraction cache key.
+func (e *Extractor) SetPrompts(registry *prompts.Registry) error {
+	prompt, err := registry.Get(prompts.Extraction)
+	if err != nil {
+		return err
+	}
+	e.prompt = prompt
+	e.promptVersion = prompt.Version
+	return nil
+}
+
+// PromptVersion returns the version of the extraction prompt template in use
+func (e *Extractor) PromptVersion() string {
+	return e.promptVersion
+}
+
+// SetModel records the LLM model name stamped on provenance records
+func (e *Extractor) SetModel(model string) {
+	e.model = model
+}
+
+// SetCache makes extraction consult cache before calling the LLM and store new responses in it.
+// With replay set, the LLM is never called and prompts without a cached response fail with ErrCacheMiss.
+func (e *Extractor) SetCache(cache ResponseCache, replay bool) {
+	e.cache = cache
+	e.replay = replay
+}
+
 // ExtractFromEmail extracts entities and relationships from an email
 func (e *Extractor) ExtractFromEmail(ctx context.Context, email *ent.Email) (*ExtractionSummary, error) {
 	summary := &ExtractionSummary{}
@@ -40,19 +82,23 @@ func (e *Extractor) ExtractFromEmail(ctx context.Context, email *ent.Email) (*Ex
 	} else {
 		summary.EntitiesCreated += len(headerEntities)
 		e.logger.Debug("Header extraction complete", "entities", len(headerEntities))
+		for _, entity := range headerEntities {
+			e.recordProvenance(ctx, email, graph.SubjectEntity, entity.ID, "header", nil)
+		}
 	}
 
-	// Step 2: Use LLM to extract entities from email content
+	// Step 2: Use LLM to extract entities from email content. A failed segment or chunk fails
+	// the email, so that its job is retried instead of leaving part of a long body unextracted;
+	// the retry clears what this attempt stored.
 	llmEntities, err := e.extractFromContent(ctx, email)
 	if err != nil {
 		e.logger.Warn("Failed to extract from content",
 			"message_id", email.MessageID,
 			"error", err)
-		// Continue with header entities even if LLM fails
-	} else {
-		summary.EntitiesCreated += len(llmEntities)
-		e.logger.Debug("Content extraction complete", "entities", len(llmEntities))
+		return nil, fmt.Errorf("failed to extract from content of %s: %w", email.MessageID, err)
 	}
+	summary.EntitiesCreated += len(llmEntities)
+	e.logger.Debug("Content extraction complete", "entities", len(llmEntities))
 
 	// Step 3: Create relationships between entities and email
 	allEntities := append(headerEntities, llmEntities...)
@@ -66,17 +112,21 @@ func (e *Extractor) ExtractFromEmail(ctx context.Context, email *ent.Email) (*Ex
 	return summary, nil
 }
 
-// extractFromHeaders extracts person entities from email headers
+// extractFromHeaders extracts person entities from email headers.
+// Addresses and display names are recorded as aliases, as is the name the sender signed with.
 func (e *Extractor) extractFromHeaders(ctx context.Context, email *ent.Email) ([]*ent.DiscoveredEntity, error) {
 	var entities []*ent.DiscoveredEntity
 
 	// Extract sender
 	if email.From != "" {
-		entity, err := e.createPersonEntity(ctx, email.From, email.From, 1.0)
+		entity, err := e.createHeaderPerson(ctx, email, email.From)
 		if err != nil {
 			e.logger.Warn("Failed to create sender entity", "email", email.From, "error", err)
 		} else {
 			entities = append(entities, entity)
+			if name := SignatureName(email.Body); name != "" {
+				e.recordAliases(ctx, entity.ID, "signature", name)
+			}
 		}
 	}
 
@@ -89,7 +139,7 @@ func (e *Extractor) extractFromHeaders(ctx context.Context, email *ent.Email) ([
 			continue
 		}
 
-		entity, err := e.createPersonEntity(ctx, recipient, recipient, 1.0)
+		entity, err := e.createHeaderPerson(ctx, email, recipient)
 		if err != nil {
 			e.logger.Debug("Failed to create recipient entity", "email", recipient, "error", err)
 		} else {
@@ -100,7 +150,26 @@ func (e *Extractor) extractFromHeaders(ctx context.Context, email *ent.Email) ([
 	return entities, nil
 }
 
-// extractFromContent uses LLM to extract entities from email content
+// createHeaderPerson creates or resolves the person behind a header address and records
+// the address and its display name as aliases
+func (e *Extractor) createHeaderPerson(ctx context.Context, email *ent.Email, address string) (*ent.DiscoveredEntity, error) {
+	name := email.DisplayNames[address]
+	if name == "" {
+		name = address
+	}
+
+	entity, err := e.createPersonEntity(ctx, address, name, 1.0)
+	if err != nil {
+		return nil, err
+	}
+
+	e.recordAliases(ctx, entity.ID, "header", address, email.DisplayNames[address])
+	return entity, nil
+}
+
+// extractFromContent uses LLM to extract entities from the novel parts of an email: the text the
+// sender wrote and any messages they forwarded. Quoted replies are skipped because the messages they
+// quote are extracted on their own, and would otherwise be attributed to the wrong sender.
 func (e *Extractor) extractFromContent(ctx context.Context, email *ent.Email) ([]*ent.DiscoveredEntity, error) {
 	// Get previously discovered entity types to enrich the prompt
 	discoveredTypes, err := e.repo.GetDistinctEntityTypes(ctx)
@@ -116,26 +185,57 @@ func (e *Extractor) extractFromContent(ctx context.Context, email *ent.Email) ([
 		discoveredRelationships = []string{}
 	}
 
-	// Generate extraction prompt with discovered types
-	toStr := strings.Join(email.To, ", ")
-	prompt := EntityExtractionPrompt(email.From, toStr, email.Subject, email.Body, discoveredTypes, discoveredRelationships)
+	var entities []*ent.DiscoveredEntity
+	for _, segment := range loader.SegmentBody(email.Body) {
+		if segment.Kind == loader.SegmentQuoted || strings.TrimSpace(segment.Text) == "" {
+			continue
+		}
 
-	// Call LLM
-	response, err := e.llmClient.GenerateCompletion(ctx, prompt)
-	if err != nil {
-		return nil, fmt.Errorf("LLM completion failed: %w", err)
+		extracted, err := e.extractFromSegment(ctx, email, segment, discoveredTypes, discoveredRelationships)
+		if err != nil {
+			return nil, fmt.Errorf("%s segment: %w", segment.Kind, err)
+		}
+		entities = append(entities, extracted...)
 	}
+	return entities, nil
+}
 
-	// Parse JSON response
-	jsonStr := CleanJSONResponse("{" + response)
-	var result ExtractionResult
+// extractFromSegment runs LLM extraction on one segment of an email body. Forwarded segments are
+// presented to the LLM with their own embedded headers and their facts are attributed to their author.
+func (e *Extractor) extractFromSegment(ctx context.Context, email *ent.Email, segment loader.Segment, discoveredTypes, discoveredRelationships []string) ([]*ent.DiscoveredEntity, error) {
+	from, to, subject := email.From, strings.Join(email.To, ", "), email.Subject
+	var attribution map[string]interface{}
+	if segment.Kind == loader.SegmentForwarded {
+		from, to = segment.From, segment.To
+		if segment.Subject != "" {
+			subject = segment.Subject
+		}
+		attribution = map[string]interface{}{
+			"segment": segment.Kind,
+			"author":  segment.From,
+		}
+		if segment.Date != "" {
+			attribution["date"] = segment.Date
+		}
+	}
 
-	if err := json.Unmarshal([]byte(jsonStr), &result); err != nil {
-		e.logger.Warn("Failed to parse LLM response as JSON",
-			"response", jsonStr,
-			"error", err)
-		return nil, fmt.Errorf("failed to parse JSON: %w", err)
+	// Long bodies are extracted chunk by chunk and the chunk results reconciled into one. Every
+	// chunk must succeed: reconciling the others would silently drop the failed chunk's facts.
+	chunks := ChunkText(segment.Text, MaxChunkChars, ChunkOverlapChars)
+	results := make([]ExtractionResult, 0, len(chunks))
+	for i, chunk := range chunks {
+		prompt, err := renderExtractionPrompt(e.prompt, from, to, subject, chunk, discoveredTypes, discoveredRelationships)
+		if err != nil {
+			return nil, err
+		}
+		key := CacheKey(from, to, subject, chunk, e.model, e.promptVersion)
+		chunkResult, err := e.extractWithLLM(ctx, prompt, key)
+		if err != nil {
+			return nil, fmt.Errorf("chunk %d of %d: %w", i+1, len(chunks), err)
+		}
+		results = append(results, *chunkResult)
 	}
+	result := reconcileChunks(results)
 
 	var entities []*ent.DiscoveredEntity
 
@@ -148,7 +248,7 @@ func (e *Extractor) extractFromContent(ctx context.Context, email *ent.Email) ([
 		// Special handling for persons with email
 		uniqueID := generateUniqueID(entity.Type, entity.ID, entity.Properties)
 
-		created, err := e.createOrUpdateEntity(ctx, uniqueID, entity.Type, entity.Name, entity.Properties, entity.Confidence)
+		created, reused, err := e.createOrUpdateEntity(ctx, uniqueID, entity.Type, entity.Name, entity.Properties, entity.Confidence)
 		if err != nil {
 			e.logger.Debug("Failed to create entity",
 				"type", entity.Type,
@@ -156,6 +256,20 @@ func (e *Extractor) extractFromContent(ctx context.Context, email *ent.Email) ([
 				"error", err)
 			continue
 		}
+		e.recordProvenance(ctx, email, graph.SubjectEntity, created.ID, "content", attribution)
+		if reused {
+			// The existing entity keeps its values; record what this email said about it
+			e.recordProvenance(ctx, email, graph.SubjectEntity, created.ID, "merge", map[string]interface{}{
+				"name":              entity.Name,
+				"merged_properties": entity.Properties,
+				"old_confidence":    created.ConfidenceScore,
+				"new_confidence":    entity.Confidence,
+			})
+		}
+		if entity.Type == "person" {
+			address, _ := entity.Properties["email"].(string)
+			e.recordAliases(ctx, created.ID, "content", entity.Name, address)
+		}
 		entities = append(entities, created)
 	}
 
@@ -176,17 +290,22 @@ func (e *Extractor) extractFromContent(ctx context.Context, email *ent.Email) ([
 		}
 
 		if source != nil && target != nil {
-			_, err := e.createRelationship(ctx, &graph.RelationshipInput{
+			properties := map[string]interface{}{
+				"context": rel.Context,
+			}
+			if attribution != nil {
+				properties["author"] = segment.From
+			}
+			// Both ends are entity nodes; their type category stays on the entity
+			created, err := e.createRelationship(ctx, &graph.RelationshipInput{
 				Type:            rel.Predicate,
-				FromType:        source.TypeCategory,
+				FromType:        "discovered_entity",
 				FromID:          source.ID,
-				ToType:          target.TypeCategory,
+				ToType:          "discovered_entity",
 				ToID:            target.ID,
 				Timestamp:       email.Date,
 				ConfidenceScore: source.ConfidenceScore * target.ConfidenceScore,
-				Properties: map[string]interface{}{
-					"context": rel.Context,
-				},
+				Properties:      properties,
 			})
 			if err != nil {
 				e.logger.Debug("Failed to create extracted relationship",
@@ -194,6 +313,8 @@ func (e *Extractor) extractFromContent(ctx context.Context, email *ent.Email) ([
 					"target_id", rel.TargetID,
 					"predicate", rel.Predicate,
 					"error", err)
+			} else {
+				e.recordProvenance(ctx, email, graph.SubjectRelationship, created.ID, "content", attribution)
 			}
 		} else {
 			// Log when entities aren't matched for relationships
@@ -212,6 +333,104 @@ func (e *Extractor) extractFromContent(ctx context.Context, email *ent.Email) ([
 	return entities, nil
 }
 
+// extractWithLLM sends an extraction prompt to the LLM and parses the structured response. When a
+// cache is set, a response cached under key is used instead, and new responses are cached once parsed.
+func (e *Extractor) extractWithLLM(ctx context.Context, prompt, key string) (*ExtractionResult, error) {
+	if e.cache != nil {
+		cached, ok, err := e.cache.Get(key)
+		if err != nil {
+			e.logger.Warn("Failed to read extraction cache", "key", key, "error", err)
+		}
+		if ok {
+			var result ExtractionResult
+			if err := json.Unmarshal(cached, &result); err == nil {
+				return &result, nil
+			}
+			e.logger.Warn("Ignoring unparsable cached response", "key", key)
+		}
+		if e.replay {
+			return nil, ErrCacheMiss
+		}
+	}
+
+	response, err := e.llmClient.GenerateStructured(ctx, prompt, ExtractionSchema())
+	if err != nil {
+		return nil, fmt.Errorf("LLM completion failed: %w", err)
+	}
+
+	var result ExtractionResult
+	if err := json.Unmarshal(response, &result); err != nil {
+		e.logger.Warn("Failed to parse LLM response as JSON",
+			"response", string(response),
+			"error", err)
+		return nil, fmt.Errorf("failed to parse JSON: %w", err)
+	}
+
+	if e.cache != nil {
+		if err := e.cache.Put(key, response); err != nil {
+			e.logger.Warn("Failed to write extraction cache", "key", key, "error", err)
+		}
+	}
+	return &result, nil
+}
+
+// recordProvenance links a fact to the email and extraction run that produced it. Properties
+// attribute facts from forwarded messages to their author. Failures are logged and never abort extraction.
+func (e *Extractor) recordProvenance(ctx context.Context, email *ent.Email, subjectType string, subjectID int, source string, properties map[string]interface{}) {
+	if subjectID <= 0 {
+		return
+	}
+
+	input := &graph.ProvenanceInput{
+		SubjectType: subjectType,
+		SubjectID:   subjectID,
+		EmailID:     email.ID,
+		MessageID:   email.MessageID,
+		FilePath:    email.FilePath,
+		Source:      source,
+		Model:       e.model,
+		ExtractedAt: time.Now(),
+		Properties:  properties,
+	}
+	// Header-derived facts do not depend on the LLM prompt
+	if source != "header" {
+		input.PromptVersion = e.promptVersion
+	}
+
+	if _, err := e.repo.RecordProvenance(ctx, input); err != nil {
+		e.logger.Debug("Failed to record provenance",
+			"subject_type", subjectType,
+			"subject_id", subjectID,
+			"message_id", email.MessageID,
+			"error", err)
+	}
+}
+
+// recordAliases records the non-empty aliases of an entity.
+// Failures are logged and never abort extraction.
+func (e *Extractor) recordAliases(ctx context.Context, entityID int, source string, aliases ...string) {
+	if entityID <= 0 {
+		return
+	}
+
+	for _, alias := range aliases {
+		if strings.TrimSpace(alias) == "" {
+			continue
+		}
+		if _, err := e.repo.RecordAlias(ctx, &graph.AliasInput{
+			EntityID: entityID,
+			Alias:    alias,
+			Source:   source,
+		}); err != nil {
+			e.logger.Debug("Failed to record alias",
+				"entity_id", entityID,
+				"alias", alias,
+				"source", source,
+				"error", err)
+		}
+	}
+}
+
 // generateUniqueID generates a unique ID for an entity based on its type and properties
 func generateUniqueID(typeCategory, ID string, entityProperties map[string]interface{}) string {
 	if typeCategory == "person" {
@@ -226,9 +445,9 @@ func generateUniqueID(typeCategory, ID string, entityProperties map[string]inter
 
 // createPersonEntity creates a person entity with email as unique ID
 func (e *Extractor) createPersonEntity(ctx context.Context, email, name string, confidence float64) (*ent.DiscoveredEntity, error) {
-	// Check if entity already exists
-	existing, err := e.repo.FindEntityByUniqueID(ctx, email)
-	if err == nil && existing != nil {
+	// Check if entity already exists, under this address or another alias
+	existing, found, err := e.dedup.DeduplicatePerson(ctx, email, name)
+	if err == nil && found {
 		e.logger.Debug("Found existing person entity", "email", email, "id", existing.ID)
 		return existing, nil
 	}
@@ -271,13 +490,14 @@ func (e *Extractor) createPersonEntity(ctx context.Context, email, name string,
 	return entity, err
 }
 
-// createOrUpdateEntity creates or updates an entity with deduplication
-func (e *Extractor) createOrUpdateEntity(ctx context.Context, uniqueID, typeCategory, name string, properties map[string]interface{}, confidence float64) (*ent.DiscoveredEntity, error) {
+// createOrUpdateEntity creates or updates an entity with deduplication.
+// It reports whether an existing entity was re-used.
+func (e *Extractor) createOrUpdateEntity(ctx context.Context, uniqueID, typeCategory, name string, properties map[string]interface{}, confidence float64) (*ent.DiscoveredEntity, bool, error) {
 	// Check if entity already exists
 	existing, err := e.repo.FindEntityByUniqueID(ctx, uniqueID)
 	if err == nil && existing != nil {
 		// Entity exists, return it (could update confidence/properties here)
-		return existing, nil
+		return existing, true, nil
 	}
 
 	// Generate embedding for the name
@@ -336,7 +556,7 @@ func (e *Extractor) createOrUpdateEntity(ctx context.Context, uniqueID, typeCate
 			// we'll fetch it by uniqueID
 			existing, fetchErr := e.repo.FindEntityByUniqueID(ctx, uniqueID)
 			if fetchErr == nil && existing != nil {
-				return existing, nil
+				return existing, false, nil
 			}
 
 			// If we can't fetch it, log and fall through to DiscoveredEntity
@@ -361,11 +581,11 @@ func (e *Extractor) createOrUpdateEntity(ctx context.Context, uniqueID, typeCate
 	if err != nil && strings.Contains(err.Error(), "duplicate key") {
 		existing, fetchErr := e.repo.FindEntityByUniqueID(ctx, uniqueID)
 		if fetchErr == nil && existing != nil {
-			return existing, nil
+			return existing, true, nil
 		}
 	}
 
-	return entity, err
+	return entity, false, err
 }
 
 // ExtractionSummary summarizes the extraction results
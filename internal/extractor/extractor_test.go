@@ -3,7 +3,15 @@ package extractor
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
 	"testing"
+
+	"github.com/Blogem/enron-graph/ent"
+	"github.com/Blogem/enron-graph/internal/graph"
+	"github.com/Blogem/enron-graph/pkg/llm"
 )
 
 // T029: Unit tests for entity extractor
@@ -204,6 +212,380 @@ func TestExtractFromEmail_EmptyEmail(t *testing.T) {
 	}
 }
 
+func TestExtractFromEmail_RecordsProvenance(t *testing.T) {
+	repo := graph.NewMockRepository()
+	client := &MockLLMClient{
+		CompletionResponse: `{
+			"analysis": "Mentions Enron",
+			"entities": [{"type": "organization", "name": "Enron Corp", "confidence": 0.9}],
+			"relationships": []
+		}`,
+		EmbeddingResponse: []float32{0.1, 0.2},
+	}
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	extr := NewExtractor(client, repo, logger)
+	extr.SetModel("test-model")
+
+	email := &ent.Email{
+		ID:        42,
+		MessageID: "<test@enron.com>",
+		FilePath:  "lay-k/inbox/1.",
+		From:      "alice@enron.com",
+		To:        []string{"bob@enron.com"},
+		Subject:   "Enron",
+		Body:      "Enron Corp results",
+	}
+	if _, err := extr.ExtractFromEmail(context.Background(), email); err != nil {
+		t.Fatalf("ExtractFromEmail failed: %v", err)
+	}
+
+	// Entity 1 is the sender (from headers), entity 3 the organization (from content)
+	sender, _ := repo.FindProvenance(context.Background(), graph.SubjectEntity, 1)
+	if len(sender) != 1 {
+		t.Fatalf("Expected 1 provenance record for sender, got %d", len(sender))
+	}
+	if sender[0].Source != "header" || sender[0].EmailID != 42 || sender[0].FilePath != "lay-k/inbox/1." {
+		t.Errorf("Unexpected sender provenance: %+v", sender[0])
+	}
+	if sender[0].PromptVersion != "" {
+		t.Errorf("Header provenance should not carry a prompt version, got %q", sender[0].PromptVersion)
+	}
+
+	org, _ := repo.FindProvenance(context.Background(), graph.SubjectEntity, 3)
+	if len(org) != 1 {
+		t.Fatalf("Expected 1 provenance record for organization, got %d", len(org))
+	}
+	if org[0].Source != "content" || org[0].Model != "test-model" || org[0].PromptVersion != extr.PromptVersion() {
+		t.Errorf("Unexpected organization provenance: %+v", org[0])
+	}
+	if org[0].MessageID != "<test@enron.com>" || org[0].ExtractedAt.IsZero() {
+		t.Errorf("Provenance missing source email details: %+v", org[0])
+	}
+
+	rel, _ := repo.FindProvenance(context.Background(), graph.SubjectRelationship, 1)
+	if len(rel) != 1 || rel[0].EmailID != 42 {
+		t.Errorf("Expected provenance for created relationship, got %+v", rel)
+	}
+}
+
+func TestExtractFromEmail_RecordsMergeProvenance(t *testing.T) {
+	repo := graph.NewMockRepository()
+	client := &MockLLMClient{
+		CompletionResponse: `{
+			"entities": [{"id": "enron", "type": "organization", "name": "Enron Corp", "properties": {"ticker": "ENE"}, "confidence": 0.9}],
+			"relationships": []
+		}`,
+		EmbeddingResponse: []float32{0.1, 0.2},
+	}
+	extr := NewExtractor(client, repo, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	ctx := context.Background()
+
+	for i := 1; i <= 2; i++ {
+		email := &ent.Email{ID: i, MessageID: fmt.Sprintf("<%d@enron.com>", i), From: "alice@enron.com", Body: "Enron Corp results"}
+		if _, err := extr.ExtractFromEmail(ctx, email); err != nil {
+			t.Fatalf("ExtractFromEmail failed: %v", err)
+		}
+	}
+
+	org, err := repo.FindEntityByUniqueID(ctx, "organization:enron")
+	if err != nil {
+		t.Fatalf("Expected the organization to be stored: %v", err)
+	}
+	records, _ := repo.FindProvenance(ctx, graph.SubjectEntity, org.ID)
+	var merges []*ent.Provenance
+	for _, record := range records {
+		if record.Source == "merge" {
+			merges = append(merges, record)
+		}
+	}
+
+	// Only the second email re-used the entity
+	if len(merges) != 1 || merges[0].EmailID != 2 {
+		t.Fatalf("Expected one merge record from the second email, got %+v", merges)
+	}
+	merged, _ := merges[0].Properties["merged_properties"].(map[string]interface{})
+	if merged["ticker"] != "ENE" || merges[0].Properties["new_confidence"] != 0.9 {
+		t.Errorf("Unexpected merge provenance: %v", merges[0].Properties)
+	}
+
+	// Re-extracting the email removes its merge record with the rest of its provenance
+	if _, err := repo.ClearEmailExtraction(ctx, 2); err != nil {
+		t.Fatalf("ClearEmailExtraction failed: %v", err)
+	}
+	records, _ = repo.FindProvenance(ctx, graph.SubjectEntity, org.ID)
+	for _, record := range records {
+		if record.EmailID == 2 {
+			t.Errorf("Expected provenance of email 2 to be cleared, got %+v", record)
+		}
+	}
+}
+
+func TestExtractFromEmail_ContentRelationshipsConnectEntities(t *testing.T) {
+	repo := graph.NewMockRepository()
+	client := &MockLLMClient{
+		CompletionResponse: `{
+			"entities": [
+				{"id": "ken", "type": "person", "name": "Ken Lay", "properties": {"email": "ken.lay@enron.com"}, "confidence": 0.95},
+				{"id": "enron", "type": "organization", "name": "Enron Corp", "confidence": 0.9}
+			],
+			"relationships": [{"source_id": "ken", "target_id": "enron", "predicate": "WORKS_FOR"}]
+		}`,
+		EmbeddingResponse: []float32{0.1, 0.2},
+	}
+	extr := NewExtractor(client, repo, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	email := &ent.Email{ID: 1, MessageID: "<1@enron.com>", From: "alice@enron.com", Body: "Ken Lay runs Enron Corp"}
+	if _, err := extr.ExtractFromEmail(context.Background(), email); err != nil {
+		t.Fatalf("ExtractFromEmail failed: %v", err)
+	}
+
+	// Paths and filters key entities as discovered_entity, whatever their type category
+	rels, _ := repo.FindRelationshipsByEntity(context.Background(), "discovered_entity", 0, graph.TimeWindow{})
+	found := false
+	for _, rel := range rels {
+		if rel.Type != "WORKS_FOR" {
+			continue
+		}
+		found = true
+		if rel.FromType != "discovered_entity" || rel.ToType != "discovered_entity" {
+			t.Errorf("Expected WORKS_FOR between discovered entities, got %s -> %s", rel.FromType, rel.ToType)
+		}
+	}
+	if !found {
+		t.Fatal("Expected a WORKS_FOR relationship")
+	}
+}
+
+func TestExtractFromEmail_RecordsAliases(t *testing.T) {
+	repo := graph.NewMockRepository()
+	client := &MockLLMClient{
+		CompletionResponse: `{"entities": [], "relationships": []}`,
+		EmbeddingResponse:  []float32{0.1, 0.2},
+	}
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	extr := NewExtractor(client, repo, logger)
+	ctx := context.Background()
+
+	first := &ent.Email{
+		ID:           1,
+		MessageID:    "<1@enron.com>",
+		From:         "jeff.skilling@enron.com",
+		DisplayNames: map[string]string{"jeff.skilling@enron.com": "Jeff Skilling"},
+		Body:         "Numbers look fine.\n\nRegards,\nJeffrey K. Skilling\n",
+	}
+	if _, err := extr.ExtractFromEmail(ctx, first); err != nil {
+		t.Fatalf("ExtractFromEmail failed: %v", err)
+	}
+
+	aliases, _ := repo.FindAliases(ctx, 1)
+	seen := make(map[string]string)
+	for _, alias := range aliases {
+		seen[alias.Alias] = alias.Source
+	}
+	if seen["jeff.skilling@enron.com"] != "header" || seen["Jeff Skilling"] != "header" {
+		t.Errorf("Expected header address and display name as aliases, got %v", seen)
+	}
+	if seen["Jeffrey K. Skilling"] != "signature" {
+		t.Errorf("Expected signature name as alias, got %v", seen)
+	}
+
+	// A second address with a known name resolves to the same person
+	second := &ent.Email{
+		ID:           2,
+		MessageID:    "<2@enron.com>",
+		From:         "jskilling@enron.com",
+		DisplayNames: map[string]string{"jskilling@enron.com": "Jeffrey K. Skilling"},
+	}
+	if _, err := extr.ExtractFromEmail(ctx, second); err != nil {
+		t.Fatalf("ExtractFromEmail failed: %v", err)
+	}
+
+	people, _ := repo.FindEntitiesByType(ctx, "person")
+	if len(people) != 1 {
+		t.Fatalf("Expected both addresses to resolve to one person, got %d entities", len(people))
+	}
+	resolved, _ := repo.FindEntitiesByAlias(ctx, "JSkilling@enron.com")
+	if len(resolved) != 1 || resolved[0].ID != people[0].ID {
+		t.Errorf("Expected the second address to be recorded as an alias, got %v", resolved)
+	}
+
+	// The second email is still attributed to the person
+	rels, _ := repo.FindRelationshipsByEntity(ctx, "discovered_entity", people[0].ID, graph.TimeWindow{})
+	sent := 0
+	for _, rel := range rels {
+		if rel.Type == "SENT" && rel.FromID == people[0].ID && rel.ToID == second.ID {
+			sent++
+		}
+	}
+	if sent != 1 {
+		t.Errorf("Expected a SENT relationship for the aliased sender, got %d", sent)
+	}
+}
+
+func TestExtractFromEmail_SegmentsBody(t *testing.T) {
+	repo := graph.NewMockRepository()
+	client := &MockLLMClient{
+		CompletionResponse: `{"entities": [{"type": "organization", "name": "California PUC", "confidence": 0.9}], "relationships": []}`,
+		EmbeddingResponse:  []float32{0.1, 0.2},
+	}
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	extr := NewExtractor(client, repo, logger)
+	ctx := context.Background()
+
+	email := &ent.Email{
+		ID:        7,
+		MessageID: "<7@enron.com>",
+		From:      "jeff.dasovich@enron.com",
+		To:        []string{"richard.shapiro@enron.com"},
+		Subject:   "FW: California update",
+		Body: `Rick, see below.
+
+---------------------- Forwarded by Jeff Dasovich/NA/Enron on 05/14/2001 10:00 AM ---------------------------
+
+Steven J Kean
+05/14/2001 09:00 AM
+To:	Jeff Dasovich/NA/Enron@Enron
+Subject:	California update
+
+The California PUC meets on Thursday.
+
+ -----Original Message-----
+From: 	Lay, Kenneth
+Subject:	California
+
+What is the status of the PUC filing?`,
+	}
+	if _, err := extr.ExtractFromEmail(ctx, email); err != nil {
+		t.Fatalf("ExtractFromEmail failed: %v", err)
+	}
+
+	// The authored text and the forward are extracted separately; the quoted reply is skipped
+	if len(client.Prompts) != 2 {
+		t.Fatalf("Expected 2 extraction prompts, got %d", len(client.Prompts))
+	}
+	if !strings.Contains(client.Prompts[0], "Rick, see below.") || strings.Contains(client.Prompts[0], "meets on Thursday") {
+		t.Errorf("Expected first prompt to contain only the authored text")
+	}
+	if !strings.Contains(client.Prompts[1], "Steven J Kean") || !strings.Contains(client.Prompts[1], "meets on Thursday") {
+		t.Errorf("Expected forwarded prompt to be attributed to its author")
+	}
+	for _, prompt := range client.Prompts {
+		if strings.Contains(prompt, "status of the PUC filing") {
+			t.Errorf("Quoted text should not be extracted")
+		}
+	}
+
+	orgs, _ := repo.FindEntitiesByAlias(ctx, "California PUC")
+	if len(orgs) != 1 {
+		t.Fatalf("Expected one organization, got %d", len(orgs))
+	}
+	records, _ := repo.FindProvenance(ctx, graph.SubjectEntity, orgs[0].ID)
+	attributed := false
+	for _, record := range records {
+		if record.Properties["author"] == "Steven J Kean" && record.Properties["segment"] == "forwarded" {
+			attributed = true
+		}
+	}
+	if !attributed {
+		t.Errorf("Expected provenance attributing the forwarded fact to its author, got %+v", records)
+	}
+}
+
+func TestExtractFromEmail_ChunksLongBody(t *testing.T) {
+	repo := graph.NewMockRepository()
+	client := &MockLLMClient{
+		CompletionResponse: `{"entities": [{"id": "raptor", "type": "project", "name": "Raptor", "confidence": 0.9}], "relationships": []}`,
+		EmbeddingResponse:  []float32{0.1, 0.2},
+	}
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	extr := NewExtractor(client, repo, logger)
+	ctx := context.Background()
+
+	var paragraphs []string
+	for i := 0; i < 20; i++ {
+		paragraphs = append(paragraphs, fmt.Sprintf("Item %d: %s", i, strings.Repeat("The Raptor hedges need review. ", 8)))
+	}
+	paragraphs = append(paragraphs, "Closing note about LJM2.")
+	email := &ent.Email{
+		ID:        8,
+		MessageID: "<8@enron.com>",
+		From:      "andrew.fastow@enron.com",
+		Subject:   "Raptor restructuring",
+		Body:      strings.Join(paragraphs, "\n\n"),
+	}
+
+	if _, err := extr.ExtractFromEmail(ctx, email); err != nil {
+		t.Fatalf("ExtractFromEmail failed: %v", err)
+	}
+
+	if len(client.Prompts) < 2 {
+		t.Fatalf("Expected the long body to be extracted in several chunks, got %d prompts", len(client.Prompts))
+	}
+	for _, prompt := range client.Prompts {
+		if strings.Contains(prompt, "[truncated]") {
+			t.Errorf("Chunked prompts should not be truncated")
+		}
+	}
+	if !strings.Contains(client.Prompts[len(client.Prompts)-1], "Closing note about LJM2.") {
+		t.Errorf("Expected the end of the body to be extracted")
+	}
+
+	// The project seen in every chunk becomes one entity
+	projects, _ := repo.FindEntitiesByAlias(ctx, "Raptor")
+	if len(projects) != 1 {
+		t.Errorf("Expected one project entity across chunks, got %d", len(projects))
+	}
+}
+
+func TestExtractFromEmail_FailsWhenAChunkFails(t *testing.T) {
+	repo := graph.NewMockRepository()
+	client := &failingLLMClient{
+		MockLLMClient: MockLLMClient{
+			CompletionResponse: `{"entities": [{"id": "raptor", "type": "project", "name": "Raptor", "confidence": 0.9}], "relationships": []}`,
+			EmbeddingResponse:  []float32{0.1, 0.2},
+		},
+		failOn: "Closing note about LJM2.",
+	}
+	extr := NewExtractor(client, repo, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	var paragraphs []string
+	for i := 0; i < 20; i++ {
+		paragraphs = append(paragraphs, fmt.Sprintf("Item %d: %s", i, strings.Repeat("The Raptor hedges need review. ", 8)))
+	}
+	paragraphs = append(paragraphs, "Closing note about LJM2.")
+	email := &ent.Email{
+		ID:        8,
+		MessageID: "<8@enron.com>",
+		From:      "andrew.fastow@enron.com",
+		Subject:   "Raptor restructuring",
+		Body:      strings.Join(paragraphs, "\n\n"),
+	}
+
+	// The other chunks succeed, but the email must fail so that its job is retried
+	_, err := extr.ExtractFromEmail(context.Background(), email)
+	if err == nil || !strings.Contains(err.Error(), "chunk") {
+		t.Fatalf("Expected the failed chunk to fail the email, got %v", err)
+	}
+	if len(client.Prompts) < 2 {
+		t.Fatalf("Expected a chunked extraction, got %d prompts", len(client.Prompts))
+	}
+}
+
+// failingLLMClient fails the completions of prompts containing failOn
+type failingLLMClient struct {
+	MockLLMClient
+	failOn string
+}
+
+func (m *failingLLMClient) GenerateStructured(ctx context.Context, prompt string, schema *llm.ResponseSchema) (json.RawMessage, error) {
+	if strings.Contains(prompt, m.failOn) {
+		m.Prompts = append(m.Prompts, prompt)
+		return nil, fmt.Errorf("context deadline exceeded")
+	}
+	return m.MockLLMClient.GenerateStructured(ctx, prompt, schema)
+}
+
 // Helper function
 func containsAtSign(s string) bool {
 	for _, c := range s {
@@ -237,12 +619,18 @@ type TestEntity struct {
 type MockLLMClient struct {
 	CompletionResponse string
 	EmbeddingResponse  []float32
+	Prompts            []string
 }
 
 func (m *MockLLMClient) GenerateCompletion(ctx context.Context, prompt string) (string, error) {
 	return m.CompletionResponse, nil
 }
 
+func (m *MockLLMClient) GenerateStructured(ctx context.Context, prompt string, schema *llm.ResponseSchema) (json.RawMessage, error) {
+	m.Prompts = append(m.Prompts, prompt)
+	return json.RawMessage(m.CompletionResponse), nil
+}
+
 func (m *MockLLMClient) GenerateEmbedding(ctx context.Context, text string) ([]float32, error) {
 	return m.EmbeddingResponse, nil
 }
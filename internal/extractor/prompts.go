@@ -1,50 +1,37 @@
 package extractor
 
 import (
-	"fmt"
 	"regexp"
 	"strings"
+
+	"github.com/Blogem/enron-graph/internal/prompts"
+	"github.com/Blogem/enron-graph/pkg/llm"
 )
 
-// EntityExtractionPrompt generates a prompt for extracting entities from email
-// types contains previously identified entity types to guide the extraction
+// EntityExtractionPrompt renders the default extraction prompt template for an email.
+// types contains previously identified entity types to guide the extraction.
+// Bodies longer than MaxChunkChars should be split with ChunkText first; anything
+// beyond the limit is cut off.
 func EntityExtractionPrompt(from, to, subject, body string, types, relationships []string) string {
-	maxBodyLength := 2000
-	if len(body) > maxBodyLength {
-		body = body[:maxBodyLength] + "...[truncated]"
-	}
-
-	return fmt.Sprintf(`### ROLE
-You are a headless Knowledge Graph Extraction Service. You output ONLY valid JSON. No conversational filler, no preamble, no markdown formatting.
-
-### ONTOLOGY
-Types: [%s]
-Relationships: [%s]
-
-### INPUT EMAIL
-From: %s
-To: %s
-Subject: %s
-Content: %s
-
-### TASK
-1. Extract entities and relationships into the schema below.
-2. Normalize names (e.g., "John Doe").
-3. Use 'VERB_FORM' for predicates (e.g., 'WORKS_ON').
-4. If a type is missing from the ontology, create a specific one.
-
-### JSON SCHEMA
-{
-  "analysis": "1-sentence summary of the email intent",
-  "entities": [{"id": "slug", "type": "type", "name": "Name", "properties": {}, "confidence": 0.0-1.0}],
-  "relationships": [{"source_id": "slug", "target_id": "slug", "predicate": "VERB", "context": "reasoning"}]
+	// The built-in template is checked to render by prompts.Default
+	rendered, _ := renderExtractionPrompt(prompts.DefaultPrompt(prompts.Extraction), from, to, subject, body, types, relationships)
+	return rendered
 }
 
-### DATA OUTPUT
-{`, // <--- The "Prefix Force" starts here
-		strings.Join(types, ", "),
-		strings.Join(relationships, ", "),
-		from, to, subject, body)
+// renderExtractionPrompt renders an extraction prompt template, truncating bodies over MaxChunkChars
+func renderExtractionPrompt(prompt *prompts.Prompt, from, to, subject, body string, types, relationships []string) (string, error) {
+	if len(body) > MaxChunkChars {
+		body = body[:MaxChunkChars] + "...[truncated]"
+	}
+
+	return prompt.Render(prompts.ExtractionData{
+		From:          from,
+		To:            to,
+		Subject:       subject,
+		Body:          body,
+		Types:         strings.Join(types, ", "),
+		Relationships: strings.Join(relationships, ", "),
+	})
 }
 
 // ExtractionResult represents the structured output from entity extraction
@@ -71,7 +58,53 @@ type ExtractedRelationship struct {
 	Context   string `json:"context"`
 }
 
-// // CleanJSONResponse attempts to extract JSON from LLM response
+// ExtractionSchema returns the response schema the LLM must follow for entity extraction.
+// It mirrors ExtractionResult so structured output can be unmarshalled directly.
+func ExtractionSchema() *llm.ResponseSchema {
+	return &llm.ResponseSchema{
+		Name:        "record_extraction",
+		Description: "Record the entities and relationships extracted from an email",
+		Schema: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"analysis": map[string]interface{}{
+					"type":        "string",
+					"description": "1-sentence summary of the email intent",
+				},
+				"entities": map[string]interface{}{
+					"type": "array",
+					"items": map[string]interface{}{
+						"type": "object",
+						"properties": map[string]interface{}{
+							"id":         map[string]interface{}{"type": "string"},
+							"type":       map[string]interface{}{"type": "string"},
+							"name":       map[string]interface{}{"type": "string"},
+							"properties": map[string]interface{}{"type": "object"},
+							"confidence": map[string]interface{}{"type": "number"},
+						},
+						"required": []string{"id", "type", "name", "confidence"},
+					},
+				},
+				"relationships": map[string]interface{}{
+					"type": "array",
+					"items": map[string]interface{}{
+						"type": "object",
+						"properties": map[string]interface{}{
+							"source_id": map[string]interface{}{"type": "string"},
+							"target_id": map[string]interface{}{"type": "string"},
+							"predicate": map[string]interface{}{"type": "string"},
+							"context":   map[string]interface{}{"type": "string"},
+						},
+						"required": []string{"source_id", "target_id", "predicate"},
+					},
+				},
+			},
+			"required": []string{"analysis", "entities", "relationships"},
+		},
+	}
+}
+
+// CleanJSONResponse attempts to extract JSON from LLM response
 func CleanJSONResponse(response string) string {
 	// 1. Try to find a markdown code block first
 	re := regexp.MustCompile("(?s)```json\\s*(.*?)\\s*```")
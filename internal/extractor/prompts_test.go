@@ -1,8 +1,17 @@
 package extractor
 
 import (
+	"context"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
+
+	"github.com/Blogem/enron-graph/ent"
+	"github.com/Blogem/enron-graph/internal/graph"
+	"github.com/Blogem/enron-graph/internal/prompts"
 )
 
 // TestEntityExtractionPrompt tests the prompt generation
@@ -132,3 +141,45 @@ func TestExtractionResultStructure(t *testing.T) {
 		t.Errorf("Expected type 'project', got '%s'", result.Entities[3].Type)
 	}
 }
+
+func TestExtractFromEmail_UsesSelectedPromptVersion(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, prompts.Extraction), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	template := "Extract entities from: {{.Body}}"
+	if err := os.WriteFile(filepath.Join(dir, prompts.Extraction, "v4.tmpl"), []byte(template), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	registry, err := prompts.NewRegistry(dir, nil)
+	if err != nil {
+		t.Fatalf("NewRegistry failed: %v", err)
+	}
+
+	repo := graph.NewMockRepository()
+	client := &MockLLMClient{
+		CompletionResponse: `{"entities": [{"id": "raptor", "type": "project", "name": "Raptor", "confidence": 0.9}], "relationships": []}`,
+		EmbeddingResponse:  []float32{0.1, 0.2},
+	}
+	extr := NewExtractor(client, repo, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	if err := extr.SetPrompts(registry); err != nil {
+		t.Fatalf("SetPrompts failed: %v", err)
+	}
+	ctx := context.Background()
+	email := &ent.Email{ID: 10, MessageID: "<10@enron.com>", From: "andrew.fastow@enron.com", Body: "Raptor is underwater."}
+	if _, err := extr.ExtractFromEmail(ctx, email); err != nil {
+		t.Fatalf("ExtractFromEmail failed: %v", err)
+	}
+
+	if len(client.Prompts) != 1 || client.Prompts[0] != "Extract entities from: Raptor is underwater." {
+		t.Errorf("Expected the v4 template to be rendered, got %q", client.Prompts)
+	}
+	projects, _ := repo.FindEntitiesByAlias(ctx, "Raptor")
+	if len(projects) != 1 {
+		t.Fatalf("Expected one project, got %d", len(projects))
+	}
+	records, _ := repo.FindProvenance(ctx, graph.SubjectEntity, projects[0].ID)
+	if len(records) != 1 || records[0].PromptVersion != "v4" {
+		t.Errorf("Expected provenance stamped with v4, got %+v", records)
+	}
+}
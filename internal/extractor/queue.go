@@ -0,0 +1,173 @@
+package extractor
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Queue defaults
+const (
+	DefaultMaxAttempts = 3
+	DefaultStaleAfter  = 30 * time.Minute
+)
+
+// QueueOptions configures how jobs are taken from the extraction job queue
+type QueueOptions struct {
+	// Worker identifies this process on claimed jobs; defaults to hostname:pid
+	Worker string
+	// Batch restricts claiming to the jobs queued for one run (see SelectOptions.Batch);
+	// empty claims any pending job
+	Batch string
+	// MaxAttempts is how often a job is tried before it is marked failed
+	MaxAttempts int
+	// StaleAfter is how long a job may stay running before it is assumed abandoned
+	// by a crashed process and handed out again
+	StaleAfter time.Duration
+}
+
+// DefaultQueueOptions returns the queue options used by the loader
+func DefaultQueueOptions() QueueOptions {
+	return QueueOptions{
+		Worker:      defaultWorkerName(),
+		MaxAttempts: DefaultMaxAttempts,
+		StaleAfter:  DefaultStaleAfter,
+	}
+}
+
+// ProcessQueue extracts entities for queued jobs until none are pending. Jobs are
+// claimed one at a time per worker so that several processes can drain the same
+// queue; jobs abandoned by a crashed process are released first.
+func (b *BatchExtractor) ProcessQueue(ctx context.Context, opts QueueOptions) error {
+	if opts.Worker == "" {
+		opts.Worker = defaultWorkerName()
+	}
+	repo := b.extractor.repo
+
+	if opts.StaleAfter > 0 {
+		released, err := repo.ReleaseStaleExtractionJobs(ctx, time.Now().Add(-opts.StaleAfter))
+		if err != nil {
+			return err
+		}
+		if released > 0 {
+			b.logger.Info("Released abandoned extraction jobs", "count", released)
+		}
+	}
+
+	var wg sync.WaitGroup
+	var claimErr error
+	var claimErrOnce sync.Once
+
+	for i := 0; i < b.workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for ctx.Err() == nil {
+				jobs, err := repo.ClaimExtractionJobs(ctx, opts.Worker, opts.Batch, 1)
+				if err != nil {
+					if ctx.Err() == nil {
+						claimErrOnce.Do(func() { claimErr = err })
+					}
+					return
+				}
+				if len(jobs) == 0 {
+					return
+				}
+				b.runJob(ctx, jobs[0].ID, jobs[0].EmailID, opts)
+			}
+		}()
+	}
+
+	wg.Wait()
+	b.logProgress()
+
+	if err := ctx.Err(); err != nil {
+		b.logger.Info("Queue extraction cancelled", "reason", err)
+		return err
+	}
+	return claimErr
+}
+
+// runJob extracts one claimed job and records the outcome on it
+func (b *BatchExtractor) runJob(ctx context.Context, jobID, emailID int, opts QueueOptions) {
+	repo := b.extractor.repo
+
+	err := ctx.Err()
+	if err == nil {
+		err = b.extractEmailID(ctx, emailID)
+	}
+	if err == nil {
+		atomic.AddInt64(&b.stats.EmailsProcessed, 1)
+		// Record the result even when shutdown starts during the extraction
+		if err := repo.CompleteExtractionJob(context.WithoutCancel(ctx), jobID, b.extractor.model, b.extractor.promptVersion); err != nil {
+			b.logger.Error("Failed to mark extraction job done", "job_id", jobID, "error", err)
+		}
+		if atomic.LoadInt64(&b.stats.EmailsProcessed)%50 == 0 {
+			b.logProgress()
+		}
+		return
+	}
+
+	// An interrupted job goes back to the queue regardless of its attempts
+	maxAttempts := opts.MaxAttempts
+	if ctx.Err() != nil {
+		maxAttempts = 0
+	} else {
+		atomic.AddInt64(&b.stats.Failures, 1)
+	}
+
+	job, failErr := repo.FailExtractionJob(context.WithoutCancel(ctx), jobID, err.Error(), maxAttempts)
+	if failErr != nil {
+		b.logger.Error("Failed to record extraction job error", "job_id", jobID, "error", failErr)
+		return
+	}
+	if ctx.Err() == nil {
+		b.logger.Error("Failed to extract from email",
+			"email_id", emailID,
+			"attempt", job.Attempts,
+			"status", job.Status,
+			"error", err)
+	}
+}
+
+// extractEmailID loads an email and extracts entities from it. Results of an earlier or
+// interrupted extraction of the email are cleared first, so that rerunning a job never
+// duplicates relationships or provenance.
+func (b *BatchExtractor) extractEmailID(ctx context.Context, emailID int) error {
+	repo := b.extractor.repo
+	email, err := repo.GetEmail(ctx, emailID)
+	if err != nil {
+		return fmt.Errorf("failed to load email %d: %w", emailID, err)
+	}
+
+	cleared, err := repo.ClearEmailExtraction(ctx, emailID)
+	if err != nil {
+		return fmt.Errorf("failed to clear previous extraction: %w", err)
+	}
+	if cleared.ProvenanceRemoved > 0 {
+		b.logger.Debug("Cleared previous extraction",
+			"email_id", emailID,
+			"provenance", cleared.ProvenanceRemoved,
+			"relationships", cleared.RelationshipsRemoved,
+			"entities", cleared.EntitiesRemoved)
+	}
+
+	return b.processEmail(ctx, email)
+}
+
+// NewBatchName returns a batch name unique to this run, to queue and claim the jobs of a selection
+func NewBatchName() string {
+	return fmt.Sprintf("%s@%s", defaultWorkerName(), time.Now().UTC().Format("20060102T150405"))
+}
+
+// defaultWorkerName identifies the current process in the job table
+func defaultWorkerName() string {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "unknown"
+	}
+	return fmt.Sprintf("%s:%d", host, os.Getpid())
+}
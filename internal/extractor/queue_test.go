@@ -0,0 +1,91 @@
+package extractor
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/Blogem/enron-graph/internal/graph"
+)
+
+func TestProcessQueue_CompletesAndRetries(t *testing.T) {
+	ctx := context.Background()
+	repo := graph.NewMockRepository()
+	for _, id := range []string{"<1@enron.com>", "<2@enron.com>"} {
+		if _, err := repo.CreateEmail(ctx, &graph.EmailInput{MessageID: id, From: "alice@enron.com", Body: "Enron Corp"}); err != nil {
+			t.Fatalf("CreateEmail failed: %v", err)
+		}
+	}
+
+	// Email 99 does not exist, so its job fails on every attempt
+	queued, err := repo.EnqueueExtractionJobs(ctx, []int{1, 2, 99}, "test-model", "")
+	if err != nil || queued != 3 {
+		t.Fatalf("Expected 3 queued jobs, got %d (%v)", queued, err)
+	}
+
+	client := &MockLLMClient{
+		CompletionResponse: `{"entities": [], "relationships": []}`,
+		EmbeddingResponse:  []float32{0.1, 0.2},
+	}
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	batch := NewBatchExtractor(client, repo, logger, 1)
+
+	err = batch.ProcessQueue(ctx, QueueOptions{Worker: "test", MaxAttempts: 2})
+	if err != nil {
+		t.Fatalf("ProcessQueue failed: %v", err)
+	}
+
+	counts, _ := repo.CountExtractionJobs(ctx)
+	if counts[graph.JobDone] != 2 || counts[graph.JobFailed] != 1 {
+		t.Errorf("Expected 2 done and 1 failed job, got %v", counts)
+	}
+
+	stats := batch.GetStats()
+	if stats.EmailsProcessed != 2 || stats.Failures != 2 {
+		t.Errorf("Expected 2 processed emails and 2 failed attempts, got %+v", stats)
+	}
+
+	// Enqueueing again keeps the finished jobs
+	queued, _ = repo.EnqueueExtractionJobs(ctx, []int{1, 2, 99}, "test-model", "")
+	if queued != 0 {
+		t.Errorf("Expected no new jobs on re-enqueue, got %d", queued)
+	}
+}
+
+func TestProcessQueue_ReleasesAbandonedJobs(t *testing.T) {
+	ctx := context.Background()
+	repo := graph.NewMockRepository()
+	if _, err := repo.CreateEmail(ctx, &graph.EmailInput{MessageID: "<1@enron.com>", From: "alice@enron.com"}); err != nil {
+		t.Fatalf("CreateEmail failed: %v", err)
+	}
+	repo.EnqueueExtractionJobs(ctx, []int{1}, "test-model", "")
+
+	// A crashed process leaves its job running
+	if jobs, _ := repo.ClaimExtractionJobs(ctx, "crashed", "", 1); len(jobs) != 1 {
+		t.Fatalf("Expected to claim the job, got %d", len(jobs))
+	}
+
+	client := &MockLLMClient{
+		CompletionResponse: `{"entities": [], "relationships": []}`,
+		EmbeddingResponse:  []float32{0.1, 0.2},
+	}
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	batch := NewBatchExtractor(client, repo, logger, 1)
+
+	if err := batch.ProcessQueue(ctx, QueueOptions{Worker: "test", MaxAttempts: 3, StaleAfter: time.Hour}); err != nil {
+		t.Fatalf("ProcessQueue failed: %v", err)
+	}
+	if counts, _ := repo.CountExtractionJobs(ctx); counts[graph.JobRunning] != 1 {
+		t.Errorf("Expected a recently claimed job to stay running, got %v", counts)
+	}
+
+	if err := batch.ProcessQueue(ctx, QueueOptions{Worker: "test", MaxAttempts: 3, StaleAfter: time.Nanosecond}); err != nil {
+		t.Fatalf("ProcessQueue failed: %v", err)
+	}
+	jobs, _ := repo.CountExtractionJobs(ctx)
+	if jobs[graph.JobDone] != 1 {
+		t.Errorf("Expected the abandoned job to be picked up again, got %v", jobs)
+	}
+}
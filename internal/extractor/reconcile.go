@@ -0,0 +1,147 @@
+package extractor
+
+import (
+	"fmt"
+	"strings"
+)
+
+// reconcileChunks merges the extraction results of the chunks of one body into a single result.
+// An entity seen in several chunks, under the same slug, the same name or (for persons) the same
+// email address, becomes one entity with the union of its properties and its highest confidence.
+// Relationships are rewritten to the merged slugs and duplicates are dropped.
+func reconcileChunks(results []ExtractionResult) ExtractionResult {
+	if len(results) == 1 {
+		return results[0]
+	}
+
+	var merged ExtractionResult
+	byKey := map[string]int{}      // identity key -> index in merged.Entities
+	slugOwner := map[string]int{}  // canonical slug -> index in merged.Entities
+	anySlug := map[string]string{} // chunk slug -> canonical slug, first chunk wins
+
+	chunkSlugs := make([]map[string]string, len(results))
+	for i, result := range results {
+		if merged.Analysis == "" {
+			merged.Analysis = result.Analysis
+		}
+		chunkSlugs[i] = map[string]string{}
+
+		for _, entity := range result.Entities {
+			slug := strings.ToLower(entity.ID)
+			keys := entityKeys(entity)
+			index, found := -1, false
+			for _, key := range keys {
+				if index, found = byKey[key]; found {
+					break
+				}
+			}
+
+			if found {
+				mergeEntity(&merged.Entities[index], entity)
+			} else {
+				index = len(merged.Entities)
+				entity.ID = uniqueSlug(entity.ID, slugOwner)
+				entity.Properties = copyProperties(entity.Properties)
+				merged.Entities = append(merged.Entities, entity)
+				slugOwner[strings.ToLower(entity.ID)] = index
+			}
+			// Later sightings can be matched on this sighting's keys or the merged ones
+			for _, key := range append(keys, entityKeys(merged.Entities[index])...) {
+				if _, taken := byKey[key]; !taken {
+					byKey[key] = index
+				}
+			}
+
+			canonical := merged.Entities[index].ID
+			chunkSlugs[i][slug] = canonical
+			if _, seen := anySlug[slug]; !seen {
+				anySlug[slug] = canonical
+			}
+		}
+	}
+
+	seen := map[string]bool{}
+	for i, result := range results {
+		for _, rel := range result.Relationships {
+			rel.SourceID = resolveSlug(rel.SourceID, chunkSlugs[i], anySlug)
+			rel.TargetID = resolveSlug(rel.TargetID, chunkSlugs[i], anySlug)
+
+			key := strings.ToLower(rel.SourceID) + "|" + strings.ToUpper(rel.Predicate) + "|" + strings.ToLower(rel.TargetID)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			merged.Relationships = append(merged.Relationships, rel)
+		}
+	}
+
+	return merged
+}
+
+// entityKeys returns the identities an extracted entity can be matched on across chunks
+func entityKeys(entity ExtractedEntity) []string {
+	entityType := strings.ToLower(strings.TrimSpace(entity.Type))
+	keys := []string{"slug:" + entityType + ":" + strings.ToLower(strings.TrimSpace(entity.ID))}
+	if name := strings.Join(strings.Fields(strings.ToLower(entity.Name)), " "); name != "" {
+		keys = append(keys, "name:"+entityType+":"+name)
+	}
+	if entityType == "person" {
+		if email, ok := entity.Properties["email"].(string); ok && email != "" {
+			keys = append(keys, "email:"+strings.ToLower(strings.TrimSpace(email)))
+		}
+	}
+	return keys
+}
+
+// mergeEntity folds a later sighting of an entity into the merged one. The first value of a
+// property wins; the longer name is kept, as later chunks often use a short form.
+func mergeEntity(target *ExtractedEntity, other ExtractedEntity) {
+	if other.Confidence > target.Confidence {
+		target.Confidence = other.Confidence
+	}
+	if len(other.Name) > len(target.Name) {
+		target.Name = other.Name
+	}
+	for key, value := range other.Properties {
+		if target.Properties == nil {
+			target.Properties = map[string]interface{}{}
+		}
+		if _, exists := target.Properties[key]; !exists {
+			target.Properties[key] = value
+		}
+	}
+}
+
+// uniqueSlug returns slug, suffixed when another merged entity already uses it
+func uniqueSlug(slug string, owners map[string]int) string {
+	candidate := slug
+	for n := 2; ; n++ {
+		if _, taken := owners[strings.ToLower(candidate)]; !taken {
+			return candidate
+		}
+		candidate = fmt.Sprintf("%s_%d", slug, n)
+	}
+}
+
+// resolveSlug maps a slug used in a chunk to its merged slug, falling back to the entity with
+// that slug in another chunk, since relationships may refer to entities extracted nearby
+func resolveSlug(slug string, chunk, fallback map[string]string) string {
+	if canonical, ok := chunk[strings.ToLower(slug)]; ok {
+		return canonical
+	}
+	if canonical, ok := fallback[strings.ToLower(slug)]; ok {
+		return canonical
+	}
+	return slug
+}
+
+func copyProperties(properties map[string]interface{}) map[string]interface{} {
+	if properties == nil {
+		return nil
+	}
+	copied := make(map[string]interface{}, len(properties))
+	for key, value := range properties {
+		copied[key] = value
+	}
+	return copied
+}
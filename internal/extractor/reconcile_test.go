@@ -0,0 +1,61 @@
+package extractor
+
+import (
+	"testing"
+)
+
+func TestReconcileChunks_MergesEntitiesAcrossChunks(t *testing.T) {
+	results := []ExtractionResult{
+		{
+			Analysis: "Contract terms",
+			Entities: []ExtractedEntity{
+				{ID: "jeff_skilling", Type: "person", Name: "Jeffrey Skilling", Properties: map[string]interface{}{"email": "jeff.skilling@enron.com"}, Confidence: 0.8},
+				{ID: "raptor", Type: "project", Name: "Raptor", Confidence: 0.9},
+			},
+			Relationships: []ExtractedRelationship{
+				{SourceID: "jeff_skilling", TargetID: "raptor", Predicate: "APPROVED"},
+			},
+		},
+		{
+			Entities: []ExtractedEntity{
+				// Same person under another slug, matched on the email address
+				{ID: "skilling", Type: "person", Name: "Skilling", Properties: map[string]interface{}{"email": "Jeff.Skilling@enron.com", "title": "CEO"}, Confidence: 0.95},
+				// Same project, matched on the name
+				{ID: "project_raptor", Type: "project", Name: "raptor", Confidence: 0.7},
+				// Slug already used by another entity type
+				{ID: "raptor", Type: "organization", Name: "Raptor LLC", Confidence: 0.8},
+			},
+			Relationships: []ExtractedRelationship{
+				{SourceID: "skilling", TargetID: "project_raptor", Predicate: "approved"},
+				{SourceID: "raptor", TargetID: "skilling", Predicate: "PAID"},
+			},
+		},
+	}
+
+	merged := reconcileChunks(results)
+	if len(merged.Entities) != 3 {
+		t.Fatalf("Expected 3 entities, got %d: %+v", len(merged.Entities), merged.Entities)
+	}
+
+	person := merged.Entities[0]
+	if person.ID != "jeff_skilling" || person.Name != "Jeffrey Skilling" || person.Confidence != 0.95 {
+		t.Errorf("Unexpected merged person: %+v", person)
+	}
+	if person.Properties["title"] != "CEO" || person.Properties["email"] != "jeff.skilling@enron.com" {
+		t.Errorf("Expected properties to be unioned with the first value winning, got %v", person.Properties)
+	}
+	if merged.Entities[2].ID != "raptor_2" {
+		t.Errorf("Expected the colliding slug to be renamed, got %s", merged.Entities[2].ID)
+	}
+
+	if len(merged.Relationships) != 2 {
+		t.Fatalf("Expected the duplicate APPROVED relationship to be dropped, got %+v", merged.Relationships)
+	}
+	paid := merged.Relationships[1]
+	if paid.SourceID != "raptor_2" || paid.TargetID != "jeff_skilling" {
+		t.Errorf("Expected relationship slugs to be rewritten, got %+v", paid)
+	}
+	if merged.Analysis != "Contract terms" {
+		t.Errorf("Expected the first analysis to be kept, got %q", merged.Analysis)
+	}
+}
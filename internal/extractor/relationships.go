@@ -29,7 +29,7 @@ func (e *Extractor) createRelationships(ctx context.Context, email *ent.Email, e
 
 	// SENT relationship: person (from email) -> email
 	if email.From != "" {
-		if senderEntity, exists := entityMap[email.From]; exists {
+		if senderEntity := e.entityForAddress(ctx, entityMap, email.From); senderEntity != nil {
 			rel, err := e.repo.CreateRelationship(ctx, &graph.RelationshipInput{
 				Type:            "SENT",
 				FromType:        "discovered_entity",
@@ -44,6 +44,7 @@ func (e *Extractor) createRelationships(ctx context.Context, email *ent.Email, e
 			if err != nil {
 				e.logger.Warn("Failed to create SENT relationship", "error", err)
 			} else {
+				e.recordProvenance(ctx, email, graph.SubjectRelationship, rel.ID, "header", nil)
 				relationships = append(relationships, rel)
 			}
 		}
@@ -58,7 +59,7 @@ func (e *Extractor) createRelationships(ctx context.Context, email *ent.Email, e
 			continue
 		}
 
-		if recipientEntity, exists := entityMap[recipient]; exists {
+		if recipientEntity := e.entityForAddress(ctx, entityMap, recipient); recipientEntity != nil {
 			rel, err := e.repo.CreateRelationship(ctx, &graph.RelationshipInput{
 				Type:            "RECEIVED",
 				FromType:        "email",
@@ -73,6 +74,7 @@ func (e *Extractor) createRelationships(ctx context.Context, email *ent.Email, e
 			if err != nil {
 				e.logger.Debug("Failed to create RECEIVED relationship", "error", err)
 			} else {
+				e.recordProvenance(ctx, email, graph.SubjectRelationship, rel.ID, "header", nil)
 				relationships = append(relationships, rel)
 			}
 		}
@@ -99,20 +101,21 @@ func (e *Extractor) createRelationships(ctx context.Context, email *ent.Email, e
 		if err != nil {
 			e.logger.Debug("Failed to create MENTIONS relationship", "error", err)
 		} else {
+			e.recordProvenance(ctx, email, graph.SubjectRelationship, rel.ID, "content", nil)
 			relationships = append(relationships, rel)
 		}
 	}
 
 	// COMMUNICATES_WITH relationships: person <-> person (inferred from email)
 	if email.From != "" {
-		senderEntity := entityMap[email.From]
+		senderEntity := e.entityForAddress(ctx, entityMap, email.From)
 		if senderEntity != nil {
 			for _, recipient := range allRecipients {
 				if recipient == "" || recipient == email.From {
 					continue
 				}
 
-				recipientEntity := entityMap[recipient]
+				recipientEntity := e.entityForAddress(ctx, entityMap, recipient)
 				if recipientEntity == nil {
 					continue
 				}
@@ -135,6 +138,7 @@ func (e *Extractor) createRelationships(ctx context.Context, email *ent.Email, e
 				if err != nil {
 					e.logger.Debug("Failed to create COMMUNICATES_WITH relationship", "error", err)
 				} else {
+					e.recordProvenance(ctx, email, graph.SubjectRelationship, rel.ID, "header", nil)
 					relationships = append(relationships, rel)
 				}
 			}
@@ -148,7 +152,7 @@ func (e *Extractor) createRelationships(ctx context.Context, email *ent.Email, e
 // This is a helper for batch processing to avoid creating duplicate communication links
 func (e *Extractor) DeduplicateCommunications(ctx context.Context, person1ID, person2ID int) error {
 	// Find existing relationships between these two persons
-	rels, err := e.repo.FindRelationshipsByEntity(ctx, "discovered_entity", person1ID)
+	rels, err := e.repo.FindRelationshipsByEntity(ctx, "discovered_entity", person1ID, graph.TimeWindow{})
 	if err != nil {
 		return fmt.Errorf("failed to query relationships: %w", err)
 	}
@@ -175,3 +179,23 @@ func (e *Extractor) DeduplicateCommunications(ctx context.Context, person1ID, pe
 
 	return nil
 }
+
+// entityForAddress returns the extracted entity for a header address. Addresses recorded as
+// aliases belong to an entity with a different unique ID, so those are resolved and cached.
+func (e *Extractor) entityForAddress(ctx context.Context, entityMap map[string]*ent.DiscoveredEntity, address string) *ent.DiscoveredEntity {
+	if entity, exists := entityMap[address]; exists {
+		return entity
+	}
+
+	entity, err := e.repo.FindEntityByUniqueID(ctx, address)
+	if err != nil || entity == nil {
+		return nil
+	}
+	for _, extracted := range entityMap {
+		if extracted.ID == entity.ID {
+			entityMap[address] = extracted
+			return extracted
+		}
+	}
+	return nil
+}
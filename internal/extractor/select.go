@@ -0,0 +1,54 @@
+package extractor
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Blogem/enron-graph/internal/graph"
+)
+
+// SelectOptions chooses which stored emails to extract
+type SelectOptions struct {
+	Filter graph.EmailFilter
+	// Outdated restricts the selection to emails last extracted with another model or prompt version
+	Outdated bool
+	// Reextract queues emails again even when they were already extracted; their previous
+	// results are replaced when the job runs
+	Reextract bool
+	// Batch names the run the jobs are queued for, so that it claims only the selection
+	// (see QueueOptions.Batch)
+	Batch string
+}
+
+// SelectResult describes the emails queued by QueueStored
+type SelectResult struct {
+	Selected int
+	Queued   int
+}
+
+// QueueStored selects emails already in the database and queues them for extraction. Without
+// Reextract, emails that already have a finished job are left as they are.
+func (b *BatchExtractor) QueueStored(ctx context.Context, opts SelectOptions) (*SelectResult, error) {
+	filter := opts.Filter
+	if opts.Outdated {
+		filter.OutdatedModel = b.extractor.model
+		filter.OutdatedPromptVersion = b.extractor.promptVersion
+	}
+
+	repo := b.extractor.repo
+	emailIDs, err := repo.FindEmailIDs(ctx, &filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select emails: %w", err)
+	}
+
+	result := &SelectResult{Selected: len(emailIDs)}
+	if opts.Reextract || opts.Outdated {
+		result.Queued, err = repo.RequeueExtractionJobs(ctx, emailIDs, b.extractor.model, opts.Batch)
+	} else {
+		result.Queued, err = repo.EnqueueExtractionJobs(ctx, emailIDs, b.extractor.model, opts.Batch)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,144 @@
+package extractor
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/Blogem/enron-graph/internal/graph"
+)
+
+func TestQueueStored_SelectsAndReextracts(t *testing.T) {
+	ctx := context.Background()
+	repo := graph.NewMockRepository()
+	date := time.Date(2001, 5, 14, 9, 0, 0, 0, time.UTC)
+	inputs := []*graph.EmailInput{
+		{MessageID: "1@enron.com", From: "kenneth.lay@enron.com", Date: date, Body: "Enron Corp"},
+		{MessageID: "2@enron.com", From: "jeff.skilling@enron.com", Date: date.AddDate(0, 1, 0), Body: "Enron Corp"},
+		{MessageID: "3@enron.com", From: "Kenneth.Lay@enron.com", Date: date.AddDate(0, 2, 0), Body: "Enron Corp"},
+	}
+	for _, input := range inputs {
+		if _, err := repo.CreateEmail(ctx, input); err != nil {
+			t.Fatalf("CreateEmail failed: %v", err)
+		}
+	}
+
+	client := &MockLLMClient{
+		CompletionResponse: `{"entities": [{"type": "organization", "name": "Enron Corp", "confidence": 0.9}], "relationships": []}`,
+		EmbeddingResponse:  []float32{0.1, 0.2},
+	}
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	batch := NewBatchExtractor(client, repo, logger, 1)
+	batch.SetModel("llama3")
+
+	// Sender and date range select only the first email
+	selected, err := batch.QueueStored(ctx, SelectOptions{Filter: graph.EmailFilter{
+		Senders: []string{"kenneth.lay@enron.com"},
+		Until:   date.AddDate(0, 1, 0),
+	}})
+	if err != nil {
+		t.Fatalf("QueueStored failed: %v", err)
+	}
+	if selected.Selected != 1 || selected.Queued != 1 {
+		t.Fatalf("Expected 1 selected and queued email, got %+v", selected)
+	}
+	if err := batch.ProcessQueue(ctx, QueueOptions{Worker: "test"}); err != nil {
+		t.Fatalf("ProcessQueue failed: %v", err)
+	}
+
+	// The other two were never extracted
+	never, _ := repo.FindEmailIDs(ctx, &graph.EmailFilter{NeverExtracted: true})
+	if len(never) != 2 || never[0] != 2 || never[1] != 3 {
+		t.Errorf("Expected emails 2 and 3 to be never extracted, got %v", never)
+	}
+
+	mentions := func() int {
+		rels, _ := repo.FindRelationshipsByEntity(ctx, "email", 1, graph.TimeWindow{})
+		count := 0
+		for _, rel := range rels {
+			if rel.Type == "MENTIONS" && rel.FromID == 1 {
+				count++
+			}
+		}
+		return count
+	}
+	if mentions() != 1 {
+		t.Fatalf("Expected one MENTIONS relationship after the first extraction, got %d", mentions())
+	}
+
+	// Nothing is outdated for the current model
+	outdated, _ := batch.QueueStored(ctx, SelectOptions{Outdated: true})
+	if outdated.Selected != 0 {
+		t.Errorf("Expected no outdated emails, got %+v", outdated)
+	}
+
+	// A new model makes the extracted email outdated; re-extraction replaces its results
+	batch.SetModel("llama3.1")
+	outdated, err = batch.QueueStored(ctx, SelectOptions{Outdated: true})
+	if err != nil || outdated.Selected != 1 || outdated.Queued != 1 {
+		t.Fatalf("Expected the extracted email to be requeued, got %+v (%v)", outdated, err)
+	}
+	if err := batch.ProcessQueue(ctx, QueueOptions{Worker: "test"}); err != nil {
+		t.Fatalf("ProcessQueue failed: %v", err)
+	}
+	if mentions() != 1 {
+		t.Errorf("Expected re-extraction to replace the MENTIONS relationship, got %d", mentions())
+	}
+
+	provenance, _ := repo.FindProvenance(ctx, graph.SubjectEntity, 1)
+	for _, p := range provenance {
+		if p.EmailID == 1 && p.Model != "llama3.1" {
+			t.Errorf("Expected only provenance from the new model, got %s", p.Model)
+		}
+	}
+}
+
+func TestQueueStored_BatchClaimsOnlySelection(t *testing.T) {
+	ctx := context.Background()
+	repo := graph.NewMockRepository()
+	date := time.Date(2001, 5, 14, 9, 0, 0, 0, time.UTC)
+	for i, messageID := range []string{"1@enron.com", "2@enron.com", "3@enron.com"} {
+		input := &graph.EmailInput{MessageID: messageID, From: "kenneth.lay@enron.com", Date: date.AddDate(0, i, 0), Body: "Enron Corp"}
+		if _, err := repo.CreateEmail(ctx, input); err != nil {
+			t.Fatalf("CreateEmail failed: %v", err)
+		}
+	}
+
+	// The loader left jobs for emails 2 and 3 in the queue
+	if _, err := repo.EnqueueExtractionJobs(ctx, []int{2, 3}, "llama3", ""); err != nil {
+		t.Fatalf("EnqueueExtractionJobs failed: %v", err)
+	}
+
+	client := &MockLLMClient{
+		CompletionResponse: `{"entities": [{"type": "organization", "name": "Enron Corp", "confidence": 0.9}], "relationships": []}`,
+		EmbeddingResponse:  []float32{0.1, 0.2},
+	}
+	batch := NewBatchExtractor(client, repo, slog.New(slog.NewTextHandler(io.Discard, nil)), 1)
+	batch.SetModel("llama3.1")
+
+	// Selecting emails 1 and 2 queues a job for 1 and takes over the pending job of 2
+	selected, err := batch.QueueStored(ctx, SelectOptions{
+		Filter: graph.EmailFilter{MessageIDs: []string{"1@enron.com", "2@enron.com"}},
+		Batch:  "run",
+	})
+	if err != nil {
+		t.Fatalf("QueueStored failed: %v", err)
+	}
+	if selected.Selected != 2 || selected.Queued != 2 {
+		t.Fatalf("Expected 2 selected and queued emails, got %+v", selected)
+	}
+	if err := batch.ProcessQueue(ctx, QueueOptions{Worker: "test", Batch: "run"}); err != nil {
+		t.Fatalf("ProcessQueue failed: %v", err)
+	}
+
+	counts, _ := repo.CountExtractionJobs(ctx)
+	if counts[graph.JobDone] != 2 || counts[graph.JobPending] != 1 {
+		t.Errorf("Expected the selection done and the leftover job pending, got %v", counts)
+	}
+	never, _ := repo.FindEmailIDs(ctx, &graph.EmailFilter{NeverExtracted: true})
+	if len(never) != 1 || never[0] != 3 {
+		t.Errorf("Expected only email 3 to be never extracted, got %v", never)
+	}
+}
@@ -0,0 +1,79 @@
+package extractor
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/Blogem/enron-graph/internal/loader"
+)
+
+// signatureClosings are sign-offs that are followed by the sender's name
+var signatureClosings = map[string]bool{
+	"thanks":           true,
+	"thank you":        true,
+	"thanks again":     true,
+	"many thanks":      true,
+	"regards":          true,
+	"best regards":     true,
+	"kind regards":     true,
+	"best":             true,
+	"cheers":           true,
+	"sincerely":        true,
+	"take care":        true,
+	"talk to you soon": true,
+	"--":               true,
+}
+
+// maxSignatureLines bounds how far from the end of the sender's text a sign-off is looked for
+const maxSignatureLines = 8
+
+// SignatureName returns the full name the sender signed the email with, or "" when the
+// body does not end in a recognizable sign-off followed by a name. Single-word signatures
+// ("Jeff") are ignored because they are too ambiguous to identify anyone.
+func SignatureName(body string) string {
+	var lines []string
+	for _, line := range strings.Split(loader.AuthoredText(body), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if len(lines) > maxSignatureLines {
+		lines = lines[len(lines)-maxSignatureLines:]
+	}
+
+	for i := 0; i < len(lines)-1; i++ {
+		closing := strings.ToLower(strings.TrimRight(lines[i], ",.!- "))
+		if lines[i] == "--" {
+			closing = "--"
+		}
+		if !signatureClosings[closing] {
+			continue
+		}
+		if name := lines[i+1]; looksLikeFullName(name) {
+			return name
+		}
+	}
+
+	return ""
+}
+
+// looksLikeFullName reports whether a line is two to four capitalized words of letters
+func looksLikeFullName(line string) bool {
+	words := strings.Fields(line)
+	if len(words) < 2 || len(words) > 4 {
+		return false
+	}
+	for _, word := range words {
+		if !unicode.IsUpper([]rune(word)[0]) {
+			return false
+		}
+		for _, r := range word {
+			if !unicode.IsLetter(r) && r != '.' && r != '-' && r != '\'' {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,50 @@
+package extractor
+
+import "testing"
+
+func TestSignatureName(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		expected string
+	}{
+		{
+			name:     "Sign-off followed by full name",
+			body:     "Please review the attached model.\n\nThanks,\nJeff Skilling\n",
+			expected: "Jeff Skilling",
+		},
+		{
+			name:     "Delimiter followed by full name",
+			body:     "See you at 3.\n\n--\nSally Beck\nEnron Net Works\n",
+			expected: "Sally Beck",
+		},
+		{
+			name:     "Single-word signature is ignored",
+			body:     "Sounds good.\n\nThanks,\nJeff\n",
+			expected: "",
+		},
+		{
+			name:     "Signature inside quoted text is ignored",
+			body:     "Agreed.\n\n-----Original Message-----\nFrom: Kenneth Lay\n\nRegards,\nKenneth Lay\n",
+			expected: "",
+		},
+		{
+			name:     "Sentence after sign-off is not a name",
+			body:     "Thanks,\nlet me know what you think\n",
+			expected: "",
+		},
+		{
+			name:     "No sign-off",
+			body:     "Meeting moved to Friday.",
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SignatureName(tt.body); got != tt.expected {
+				t.Errorf("SignatureName() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
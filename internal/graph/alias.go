@@ -0,0 +1,226 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Blogem/enron-graph/ent"
+	"github.com/Blogem/enron-graph/ent/discoveredentity"
+	"github.com/Blogem/enron-graph/ent/entityalias"
+	"github.com/Blogem/enron-graph/ent/predicate"
+)
+
+// Alias kinds
+const (
+	AliasKindName  = "name"
+	AliasKindEmail = "email"
+)
+
+// NormalizeAlias returns the lookup key for an alias: lowercased, with surrounding quotes,
+// angle brackets and mailto: prefixes removed and internal whitespace collapsed
+func NormalizeAlias(alias string) string {
+	alias = strings.ToLower(strings.TrimSpace(alias))
+	alias = strings.Trim(alias, "\"'<> ")
+	alias = strings.TrimPrefix(alias, "mailto:")
+	return strings.Join(strings.Fields(alias), " ")
+}
+
+// AliasKind classifies an alias as an email address or a name
+func AliasKind(alias string) string {
+	normalized := NormalizeAlias(alias)
+	at := strings.LastIndex(normalized, "@")
+	if at > 0 && at < len(normalized)-1 && !strings.Contains(normalized, " ") {
+		return AliasKindEmail
+	}
+	return AliasKindName
+}
+
+// RecordAlias attaches a name variant or email address to an entity
+func (r *entRepository) RecordAlias(ctx context.Context, input *AliasInput) (*ent.EntityAlias, error) {
+	return insertAlias(ctx, r.client, input)
+}
+
+// FindAliases returns the aliases recorded for an entity, oldest first
+func (r *entRepository) FindAliases(ctx context.Context, entityID int) ([]*ent.EntityAlias, error) {
+	return r.client.EntityAlias.Query().
+		Where(entityalias.EntityIDEQ(entityID)).
+		Order(ent.Asc(entityalias.FieldCreatedAt), ent.Asc(entityalias.FieldID)).
+		All(ctx)
+}
+
+// FindEntitiesByAlias returns the entities whose name, unique ID or recorded aliases match,
+// most confident first
+func (r *entRepository) FindEntitiesByAlias(ctx context.Context, alias string, typeHint ...string) ([]*ent.DiscoveredEntity, error) {
+	normalized := NormalizeAlias(alias)
+	if normalized == "" {
+		return nil, nil
+	}
+
+	aliasedIDs, err := r.client.EntityAlias.Query().
+		Where(entityalias.NormalizedEQ(normalized)).
+		Select(entityalias.FieldEntityID).
+		Ints(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query aliases: %w", err)
+	}
+
+	matches := []predicate.DiscoveredEntity{
+		discoveredentity.UniqueIDEqualFold(normalized),
+		discoveredentity.NameEqualFold(normalized),
+	}
+	if len(aliasedIDs) > 0 {
+		matches = append(matches, discoveredentity.IDIn(aliasedIDs...))
+	}
+
+	query := r.client.DiscoveredEntity.Query().
+		Where(discoveredentity.Or(matches...))
+	if len(typeHint) > 0 && typeHint[0] != "" {
+		query = query.Where(discoveredentity.TypeCategoryEQ(typeHint[0]))
+	}
+
+	return query.
+		Order(ent.Desc(discoveredentity.FieldConfidenceScore), ent.Asc(discoveredentity.FieldID)).
+		All(ctx)
+}
+
+// resolveAlias looks up a unique ID that is not an entity's own, such as a second email
+// address or the ID of a merged-away entity. Only unambiguous matches are returned.
+func (r *entRepository) resolveAlias(ctx context.Context, uniqueID string) *ent.DiscoveredEntity {
+	candidates, err := r.FindEntitiesByAlias(ctx, uniqueID)
+	if err != nil {
+		r.logger.Debug("Alias lookup failed", "uniqueID", uniqueID, "error", err)
+		return nil
+	}
+	if len(candidates) == 1 {
+		return candidates[0]
+	}
+
+	// Generated IDs look like "<type>:<name>", so the name may be a known alias of an entity of that type
+	typeCategory, name, ok := strings.Cut(uniqueID, ":")
+	if len(candidates) == 0 && ok && AliasKind(uniqueID) == AliasKindName {
+		candidates, err = r.FindEntitiesByAlias(ctx, name, typeCategory)
+		if err != nil {
+			r.logger.Debug("Alias lookup failed", "uniqueID", uniqueID, "error", err)
+			return nil
+		}
+		if len(candidates) == 1 {
+			return candidates[0]
+		}
+	}
+
+	if len(candidates) > 1 {
+		r.logger.Debug("Alias is ambiguous, not resolving", "uniqueID", uniqueID, "candidates", len(candidates))
+	}
+	return nil
+}
+
+// insertAlias records an alias unless the entity already has it, in which case the
+// existing row is returned
+func insertAlias(ctx context.Context, client *ent.Client, input *AliasInput) (*ent.EntityAlias, error) {
+	alias := strings.TrimSpace(input.Alias)
+	normalized := NormalizeAlias(alias)
+	if input.EntityID <= 0 || normalized == "" {
+		return nil, fmt.Errorf("entity ID and alias are required")
+	}
+
+	existing, err := client.EntityAlias.Query().
+		Where(
+			entityalias.EntityIDEQ(input.EntityID),
+			entityalias.NormalizedEQ(normalized),
+		).
+		Only(ctx)
+	if err == nil {
+		return existing, nil
+	}
+	if !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to query aliases of entity %d: %w", input.EntityID, err)
+	}
+
+	kind := input.Kind
+	if kind == "" {
+		kind = AliasKind(alias)
+	}
+	source := input.Source
+	if source == "" {
+		source = "manual"
+	}
+
+	created, err := client.EntityAlias.Create().
+		SetEntityID(input.EntityID).
+		SetAlias(alias).
+		SetNormalized(normalized).
+		SetKind(kind).
+		SetSource(source).
+		Save(ctx)
+	if ent.IsConstraintError(err) {
+		// Recorded concurrently by another worker
+		return client.EntityAlias.Query().
+			Where(
+				entityalias.EntityIDEQ(input.EntityID),
+				entityalias.NormalizedEQ(normalized),
+			).
+			Only(ctx)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to record alias of entity %d: %w", input.EntityID, err)
+	}
+	return created, nil
+}
+
+// transferAliases reassigns the alias rows of an entity. When normalized is non-empty only those
+// aliases are moved. Aliases the target already has are dropped from the source instead.
+// It returns the moved aliases.
+func transferAliases(ctx context.Context, tx *ent.Tx, fromEntityID, toEntityID int, normalized []string) ([]string, error) {
+	query := tx.EntityAlias.Query().Where(entityalias.EntityIDEQ(fromEntityID))
+	if len(normalized) > 0 {
+		query = query.Where(entityalias.NormalizedIn(normalized...))
+	}
+	aliases, err := query.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query aliases of entity %d: %w", fromEntityID, err)
+	}
+	if len(aliases) == 0 {
+		return nil, nil
+	}
+
+	// The unique (entity_id, normalized) index forbids duplicates on the target
+	existing, err := tx.EntityAlias.Query().
+		Where(entityalias.EntityIDEQ(toEntityID)).
+		Select(entityalias.FieldNormalized).
+		Strings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query aliases of entity %d: %w", toEntityID, err)
+	}
+	present := make(map[string]bool, len(existing))
+	for _, key := range existing {
+		present[key] = true
+	}
+
+	var moveIDs, dropIDs []int
+	var moved []string
+	for _, alias := range aliases {
+		if present[alias.Normalized] {
+			dropIDs = append(dropIDs, alias.ID)
+			continue
+		}
+		moveIDs = append(moveIDs, alias.ID)
+		moved = append(moved, alias.Alias)
+	}
+
+	if len(dropIDs) > 0 {
+		if _, err := tx.EntityAlias.Delete().Where(entityalias.IDIn(dropIDs...)).Exec(ctx); err != nil {
+			return nil, fmt.Errorf("failed to drop duplicate aliases of entity %d: %w", fromEntityID, err)
+		}
+	}
+	if len(moveIDs) > 0 {
+		if _, err := tx.EntityAlias.Update().
+			Where(entityalias.IDIn(moveIDs...)).
+			SetEntityID(toEntityID).
+			Save(ctx); err != nil {
+			return nil, fmt.Errorf("failed to move aliases of entity %d: %w", fromEntityID, err)
+		}
+	}
+
+	return moved, nil
+}
@@ -0,0 +1,26 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestNormalizeAlias tests that case, quoting and whitespace differences map to the same key
+func TestNormalizeAlias(t *testing.T) {
+	assert.Equal(t, "jeff skilling", NormalizeAlias("  Jeff   Skilling "))
+	assert.Equal(t, "jeff skilling", NormalizeAlias(`"Jeff Skilling"`))
+	assert.Equal(t, "jeff.skilling@enron.com", NormalizeAlias("<Jeff.Skilling@ENRON.com>"))
+	assert.Equal(t, "jeff.skilling@enron.com", NormalizeAlias("mailto:jeff.skilling@enron.com"))
+	assert.Empty(t, NormalizeAlias(" <> "))
+}
+
+// TestAliasKind tests telling email addresses from names
+func TestAliasKind(t *testing.T) {
+	assert.Equal(t, AliasKindEmail, AliasKind("jskilling@enron.com"))
+	assert.Equal(t, AliasKindEmail, AliasKind("<JSkilling@Enron.com>"))
+	assert.Equal(t, AliasKindName, AliasKind("Jeff Skilling"))
+	assert.Equal(t, AliasKindName, AliasKind("person:jeff skilling"))
+	assert.Equal(t, AliasKindName, AliasKind("@enron"))
+	assert.Equal(t, AliasKindName, AliasKind("jeff @ enron.com"))
+}
@@ -0,0 +1,285 @@
+package analytics
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"runtime"
+	"sync"
+)
+
+// Centrality metrics
+const (
+	MetricDegree         = "degree"
+	MetricWeightedDegree = "weighted_degree"
+	MetricPageRank       = "pagerank"
+	MetricBetweenness    = "betweenness"
+	MetricCloseness      = "closeness"
+)
+
+// Metrics lists the centrality metrics in the order they are reported
+var Metrics = []string{MetricDegree, MetricWeightedDegree, MetricPageRank, MetricBetweenness, MetricCloseness}
+
+// ValidateMetric returns an error for names that are not a centrality metric
+func ValidateMetric(metric string) error {
+	for _, m := range Metrics {
+		if m == metric {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown centrality metric %q (available: degree, weighted_degree, pagerank, betweenness, closeness)", metric)
+}
+
+// Scores holds the centrality of one entity
+type Scores struct {
+	EntityID       int     `json:"entity_id"`
+	Degree         int     `json:"degree"`          // Distinct neighbors
+	WeightedDegree float64 `json:"weighted_degree"` // Summed confidence of the entity's relationships
+	PageRank       float64 `json:"pagerank"`        // Sums to 1 over the graph
+	Betweenness    float64 `json:"betweenness"`     // Share of shortest paths through the entity, 0-1
+	Closeness      float64 `json:"closeness"`       // Harmonic closeness, 0-1
+}
+
+// Value returns the score of a metric
+func (s Scores) Value(metric string) float64 {
+	switch metric {
+	case MetricDegree:
+		return float64(s.Degree)
+	case MetricWeightedDegree:
+		return s.WeightedDegree
+	case MetricPageRank:
+		return s.PageRank
+	case MetricBetweenness:
+		return s.Betweenness
+	case MetricCloseness:
+		return s.Closeness
+	}
+	return 0
+}
+
+// Options tunes the centrality computation
+type Options struct {
+	Damping    float64 // PageRank damping factor, 0.85 when 0
+	Iterations int     // Most PageRank iterations, 100 when 0
+	Tolerance  float64 // PageRank convergence threshold on the summed change, 1e-6 when 0
+	// Samples is the number of source nodes betweenness and closeness are estimated from. Exact
+	// computation takes a breadth-first search from every node, which is slow on large graphs;
+	// 0 computes exactly.
+	Samples int
+	Seed    int64 // Seed for choosing the sample sources
+	Workers int   // Parallel searches, the number of CPUs when 0
+}
+
+func (o Options) withDefaults() Options {
+	if o.Damping == 0 {
+		o.Damping = 0.85
+	}
+	if o.Iterations == 0 {
+		o.Iterations = 100
+	}
+	if o.Tolerance == 0 {
+		o.Tolerance = 1e-6
+	}
+	if o.Workers <= 0 {
+		o.Workers = runtime.NumCPU()
+	}
+	return o
+}
+
+// Compute calculates every centrality metric for the entities of the graph
+func Compute(g *Graph, opts Options) []Scores {
+	opts = opts.withDefaults()
+	pagerank := PageRank(g, opts.Damping, opts.Iterations, opts.Tolerance)
+	betweenness, closeness := paths(g, opts)
+
+	scores := make([]Scores, len(g.nodes))
+	for i, id := range g.nodes {
+		scores[i] = Scores{
+			EntityID:       id,
+			Degree:         len(g.adj[i]),
+			WeightedDegree: g.weighted[i],
+			PageRank:       pagerank[i],
+			Betweenness:    betweenness[i],
+			Closeness:      closeness[i],
+		}
+	}
+	return scores
+}
+
+// PageRank runs the power iteration over the directed, weighted graph. Nodes without outgoing
+// relationships spread their rank evenly, so the ranks keep summing to 1. Ranks are returned by
+// node index.
+func PageRank(g *Graph, damping float64, iterations int, tolerance float64) []float64 {
+	n := len(g.nodes)
+	if n == 0 {
+		return nil
+	}
+	outWeight := make([]float64, n)
+	for u, arcs := range g.out {
+		for _, a := range arcs {
+			outWeight[u] += a.weight
+		}
+	}
+
+	rank := make([]float64, n)
+	for i := range rank {
+		rank[i] = 1 / float64(n)
+	}
+	next := make([]float64, n)
+	for iter := 0; iter < iterations; iter++ {
+		dangling := 0.0
+		for u := range rank {
+			if outWeight[u] == 0 {
+				dangling += rank[u]
+			}
+		}
+		base := (1-damping)/float64(n) + damping*dangling/float64(n)
+		for i := range next {
+			next[i] = base
+		}
+		for u, arcs := range g.out {
+			if outWeight[u] == 0 {
+				continue
+			}
+			share := damping * rank[u] / outWeight[u]
+			for _, a := range arcs {
+				next[a.to] += share * a.weight
+			}
+		}
+
+		change := 0.0
+		for i := range rank {
+			change += math.Abs(next[i] - rank[i])
+		}
+		rank, next = next, rank
+		if change < tolerance {
+			break
+		}
+	}
+	return rank
+}
+
+// paths computes betweenness (Brandes' algorithm) and harmonic closeness from breadth-first
+// searches over the undirected graph, from every node or from a sample of them. Both are
+// normalized to 0-1 and returned by node index.
+func paths(g *Graph, opts Options) ([]float64, []float64) {
+	n := len(g.nodes)
+	betweenness := make([]float64, n)
+	closeness := make([]float64, n)
+	if n < 2 {
+		return betweenness, closeness
+	}
+
+	sources := make([]int, n)
+	for i := range sources {
+		sources[i] = i
+	}
+	if opts.Samples > 0 && opts.Samples < n {
+		random := rand.New(rand.NewSource(opts.Seed))
+		random.Shuffle(n, func(i, j int) { sources[i], sources[j] = sources[j], sources[i] })
+		sources = sources[:opts.Samples]
+	}
+
+	// Every worker accumulates into its own arrays, which are summed at the end
+	jobs := make(chan int)
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for w := 0; w < min(opts.Workers, len(sources)); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			search := newSearch(n)
+			for s := range jobs {
+				search.run(g, s)
+			}
+			mu.Lock()
+			for i := range betweenness {
+				betweenness[i] += search.betweenness[i]
+				closeness[i] += search.closeness[i]
+			}
+			mu.Unlock()
+		}()
+	}
+	for _, s := range sources {
+		jobs <- s
+	}
+	close(jobs)
+	wg.Wait()
+
+	// Every pair is counted from both ends, and samples stand in for all sources
+	scale := float64(n) / float64(len(sources))
+	pairs := float64(n-1) * float64(n-2)
+	for i := range betweenness {
+		if pairs > 0 {
+			betweenness[i] = betweenness[i] * scale / pairs
+		} else {
+			betweenness[i] = 0
+		}
+		closeness[i] = closeness[i] * scale / float64(n-1)
+	}
+	return betweenness, closeness
+}
+
+// search holds the state of Brandes' single-source shortest path accumulation
+type search struct {
+	dist        []int
+	sigma       []float64
+	delta       []float64
+	preds       [][]int
+	order       []int
+	queue       []int
+	betweenness []float64
+	closeness   []float64
+}
+
+func newSearch(n int) *search {
+	return &search{
+		dist:        make([]int, n),
+		sigma:       make([]float64, n),
+		delta:       make([]float64, n),
+		preds:       make([][]int, n),
+		betweenness: make([]float64, n),
+		closeness:   make([]float64, n),
+	}
+}
+
+// run adds the dependencies of source s to betweenness, and 1/distance to the closeness of
+// every node s reaches (distances are symmetric in an undirected graph)
+func (b *search) run(g *Graph, s int) {
+	for i := range b.dist {
+		b.dist[i] = -1
+		b.sigma[i] = 0
+		b.delta[i] = 0
+		b.preds[i] = b.preds[i][:0]
+	}
+	b.order = b.order[:0]
+	b.queue = append(b.queue[:0], s)
+	b.dist[s] = 0
+	b.sigma[s] = 1
+
+	for head := 0; head < len(b.queue); head++ {
+		v := b.queue[head]
+		b.order = append(b.order, v)
+		for _, w := range g.adj[v] {
+			if b.dist[w] < 0 {
+				b.dist[w] = b.dist[v] + 1
+				b.queue = append(b.queue, w)
+			}
+			if b.dist[w] == b.dist[v]+1 {
+				b.sigma[w] += b.sigma[v]
+				b.preds[w] = append(b.preds[w], v)
+			}
+		}
+	}
+
+	for i := len(b.order) - 1; i >= 0; i-- {
+		w := b.order[i]
+		for _, v := range b.preds[w] {
+			b.delta[v] += b.sigma[v] / b.sigma[w] * (1 + b.delta[w])
+		}
+		if w != s {
+			b.betweenness[w] += b.delta[w]
+			b.closeness[w] += 1 / float64(b.dist[w])
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package analytics
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func approx(a, b float64) bool {
+	return math.Abs(a-b) < 1e-6
+}
+
+// byEntity indexes scores by entity ID
+func byEntity(scores []Scores) map[int]Scores {
+	m := map[int]Scores{}
+	for _, s := range scores {
+		m[s.EntityID] = s
+	}
+	return m
+}
+
+// star connects entity 1 to entities 2..n
+func star(n int) *Graph {
+	var edges []Edge
+	for i := 2; i <= n; i++ {
+		edges = append(edges, Edge{From: 1, To: i, Weight: 1})
+	}
+	return NewGraph(edges)
+}
+
+func TestCompute_Star(t *testing.T) {
+	scores := byEntity(Compute(star(5), Options{}))
+
+	center, leaf := scores[1], scores[2]
+	if center.Degree != 4 || leaf.Degree != 1 {
+		t.Errorf("Expected degrees 4 and 1, got %d and %d", center.Degree, leaf.Degree)
+	}
+	if !approx(center.Betweenness, 1) || !approx(leaf.Betweenness, 0) {
+		t.Errorf("Expected betweenness 1 for the center and 0 for leaves, got %f and %f", center.Betweenness, leaf.Betweenness)
+	}
+	// A leaf reaches the center in one hop and the three other leaves in two
+	if !approx(center.Closeness, 1) || !approx(leaf.Closeness, (1+3*0.5)/4) {
+		t.Errorf("Unexpected closeness: center %f, leaf %f", center.Closeness, leaf.Closeness)
+	}
+}
+
+func TestCompute_PathBroker(t *testing.T) {
+	// Two pairs joined through entity 3, which brokers every path between them
+	g := NewGraph([]Edge{
+		{From: 1, To: 2, Weight: 1},
+		{From: 2, To: 3, Weight: 1},
+		{From: 3, To: 4, Weight: 1},
+		{From: 4, To: 5, Weight: 1},
+	})
+	scores := byEntity(Compute(g, Options{}))
+
+	// 3 lies on the paths 1-4, 1-5, 2-4 and 2-5: 4 of the 6 pairs it is not part of
+	if !approx(scores[3].Betweenness, 4.0/6) {
+		t.Errorf("Expected betweenness 2/3 for the broker, got %f", scores[3].Betweenness)
+	}
+	if scores[2].Betweenness >= scores[3].Betweenness || scores[1].Betweenness != 0 {
+		t.Errorf("Expected the broker to score highest and the ends 0, got %+v", scores)
+	}
+}
+
+func TestNewGraph_MergesParallelRelationships(t *testing.T) {
+	g := NewGraph([]Edge{
+		{From: 1, To: 2, Weight: 0.9},
+		{From: 1, To: 2, Weight: 0.9},
+		{From: 2, To: 1, Weight: 0.5},
+		{From: 1, To: 1, Weight: 1},
+	})
+	if g.Nodes() != 2 || g.Edges() != 3 {
+		t.Fatalf("Expected 2 entities and 3 relationships, got %d and %d", g.Nodes(), g.Edges())
+	}
+	scores := byEntity(Compute(g, Options{}))
+	if scores[1].Degree != 1 || !approx(scores[1].WeightedDegree, 2.3) {
+		t.Errorf("Expected one neighbor with weight 2.3, got %d and %f", scores[1].Degree, scores[1].WeightedDegree)
+	}
+}
+
+func TestPageRank(t *testing.T) {
+	// 2, 3 and 4 all point at 1; 1 points back at 2
+	g := NewGraph([]Edge{
+		{From: 2, To: 1, Weight: 1},
+		{From: 3, To: 1, Weight: 1},
+		{From: 4, To: 1, Weight: 1},
+		{From: 1, To: 2, Weight: 1},
+	})
+	scores := byEntity(Compute(g, Options{}))
+
+	total := 0.0
+	for _, s := range scores {
+		total += s.PageRank
+	}
+	if !approx(total, 1) {
+		t.Errorf("Expected ranks to sum to 1, got %f", total)
+	}
+	if scores[1].PageRank <= scores[2].PageRank || scores[2].PageRank <= scores[3].PageRank {
+		t.Errorf("Expected 1 > 2 > 3, got %f, %f, %f", scores[1].PageRank, scores[2].PageRank, scores[3].PageRank)
+	}
+	if !approx(scores[3].PageRank, scores[4].PageRank) {
+		t.Errorf("Expected equal ranks for 3 and 4, got %f and %f", scores[3].PageRank, scores[4].PageRank)
+	}
+}
+
+func TestCompute_Sampling(t *testing.T) {
+	g := star(50)
+	exact := byEntity(Compute(g, Options{}))
+	all := byEntity(Compute(g, Options{Samples: 50}))
+	if !approx(exact[1].Betweenness, all[1].Betweenness) || !approx(exact[7].Closeness, all[7].Closeness) {
+		t.Error("Expected sampling every node to match the exact computation")
+	}
+
+	sampled := byEntity(Compute(g, Options{Samples: 10, Seed: 1}))
+	for id, s := range sampled {
+		if id != 1 && s.Betweenness >= sampled[1].Betweenness {
+			t.Fatalf("Expected the center to keep the highest estimated betweenness, entity %d has %f", id, s.Betweenness)
+		}
+	}
+}
+
+func TestFilter_Scope(t *testing.T) {
+	tests := []struct {
+		filter Filter
+		want   string
+	}{
+		{Filter{}, "all"},
+		{Filter{Types: []string{"mentions", "COMMUNICATES_WITH"}}, "types=COMMUNICATES_WITH,MENTIONS"},
+		{
+			Filter{Since: time.Date(2001, 1, 1, 0, 0, 0, 0, time.UTC), Until: time.Date(2001, 7, 1, 12, 0, 0, 0, time.UTC)},
+			"since=2001-01-01;until=2001-07-01T12:00:00Z",
+		},
+	}
+	for _, tt := range tests {
+		if got := tt.filter.Scope(); got != tt.want {
+			t.Errorf("Expected scope %q, got %q", tt.want, got)
+		}
+	}
+}
@@ -0,0 +1,354 @@
+package analytics
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sort"
+
+	"github.com/Blogem/enron-graph/ent"
+	"github.com/Blogem/enron-graph/ent/relationship"
+)
+
+// CommunicationTypes are the relationships communities are detected over
+var CommunicationTypes = []string{"COMMUNICATES_WITH", "SENT", "RECEIVED"}
+
+// LoadCommunicationGraph builds the graph of who communicates with whom. SENT and RECEIVED
+// relationships are joined through their email into sender-recipient links. COMMUNICATES_WITH
+// records the same emails, so each pair of entities is weighted by the stronger of the two
+// rather than their sum. The LLM may extract these types between other nodes too; only
+// COMMUNICATES_WITH between entities, SENT from an entity to an email and RECEIVED from an
+// email to an entity are communication.
+func LoadCommunicationGraph(ctx context.Context, client *ent.Client) (*Graph, error) {
+	var rows []struct {
+		Type       string  `json:"type"`
+		FromID     int     `json:"from_id"`
+		ToID       int     `json:"to_id"`
+		Confidence float64 `json:"confidence_score"`
+	}
+	if err := client.Relationship.Query().
+		Where(relationship.Or(
+			relationship.And(
+				relationship.TypeEQ("COMMUNICATES_WITH"),
+				relationship.FromTypeNotIn(nonEntityTypes...),
+				relationship.ToTypeNotIn(nonEntityTypes...),
+			),
+			relationship.And(
+				relationship.TypeEQ("SENT"),
+				relationship.FromTypeEQ("discovered_entity"),
+				relationship.ToTypeEQ("email"),
+			),
+			relationship.And(
+				relationship.TypeEQ("RECEIVED"),
+				relationship.FromTypeEQ("email"),
+				relationship.ToTypeEQ("discovered_entity"),
+			),
+		)).
+		Select(relationship.FieldType, relationship.FieldFromID, relationship.FieldToID, relationship.FieldConfidenceScore).
+		Scan(ctx, &rows); err != nil {
+		return nil, fmt.Errorf("failed to load communication relationships: %w", err)
+	}
+
+	type sent struct {
+		sender     int
+		confidence float64
+	}
+	senders := map[int][]sent{} // Senders by email ID
+	for _, row := range rows {
+		if row.Type == "SENT" {
+			senders[row.ToID] = append(senders[row.ToID], sent{row.FromID, row.Confidence})
+		}
+	}
+
+	// Weights by undirected pair, smaller entity ID first
+	direct := map[[2]int]float64{}
+	viaEmail := map[[2]int]float64{}
+	pair := func(a, b int) [2]int { return [2]int{min(a, b), max(a, b)} }
+	for _, row := range rows {
+		switch row.Type {
+		case "COMMUNICATES_WITH":
+			direct[pair(row.FromID, row.ToID)] += row.Confidence
+		case "RECEIVED":
+			for _, s := range senders[row.FromID] {
+				viaEmail[pair(s.sender, row.ToID)] += min(s.confidence, row.Confidence)
+			}
+		}
+	}
+
+	edges := make([]Edge, 0, len(direct)+len(viaEmail))
+	for p, w := range direct {
+		edges = append(edges, Edge{From: p[0], To: p[1], Weight: max(w, viaEmail[p])})
+	}
+	for p, w := range viaEmail {
+		if _, ok := direct[p]; !ok {
+			edges = append(edges, Edge{From: p[0], To: p[1], Weight: w})
+		}
+	}
+	// Map iteration order is random; sort so node indexes, and with them the partition, are stable
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i].From != edges[j].From {
+			return edges[i].From < edges[j].From
+		}
+		return edges[i].To < edges[j].To
+	})
+	return NewGraph(edges), nil
+}
+
+// Partition assigns every node of a graph to a community
+type Partition struct {
+	Membership []int     // Community by node index; community 0 is the largest
+	Sizes      []int     // Members by community
+	Internal   []float64 // Summed weight of the relationships inside each community
+	Modularity float64
+}
+
+// Louvain detects communities by greedily moving nodes to the neighboring community that
+// raises modularity most, then merging communities into single nodes and repeating until
+// nothing moves. The graph is treated as undirected and weighted. A higher resolution
+// gives more, smaller communities; the seed fixes the order nodes are visited in.
+func Louvain(g *Graph, resolution float64, seed int64) Partition {
+	if resolution <= 0 {
+		resolution = 1
+	}
+	w := newWeightedGraph(g)
+	original := w
+
+	membership := make([]int, len(g.nodes))
+	for i := range membership {
+		membership[i] = i
+	}
+	random := rand.New(rand.NewSource(seed))
+	for w.total > 0 {
+		community, moved := w.moveNodes(resolution, random)
+		if !moved {
+			break
+		}
+		var labels []int
+		w, labels = w.aggregate(community)
+		for i := range membership {
+			membership[i] = labels[membership[i]]
+		}
+	}
+
+	return original.partition(membership, resolution)
+}
+
+// weightedEdge is an undirected connection to a node index
+type weightedEdge struct {
+	to     int
+	weight float64
+}
+
+// weightedGraph is the undirected graph Louvain works on; aggregated nodes carry the weight
+// of the relationships inside them as a self-loop
+type weightedGraph struct {
+	adj    [][]weightedEdge // Neighbors without self-loops
+	self   []float64        // Weight inside each node, counted from both ends
+	degree []float64        // Weighted degree, self-loop included
+	total  float64          // Summed degree: twice the total weight
+}
+
+func newWeightedGraph(g *Graph) *weightedGraph {
+	weights := make([]map[int]float64, len(g.nodes))
+	for i := range weights {
+		weights[i] = map[int]float64{}
+	}
+	for u, arcs := range g.out {
+		for _, a := range arcs {
+			weights[u][a.to] += a.weight
+			weights[a.to][u] += a.weight
+		}
+	}
+	return buildWeightedGraph(weights, make([]float64, len(g.nodes)))
+}
+
+func buildWeightedGraph(weights []map[int]float64, self []float64) *weightedGraph {
+	w := &weightedGraph{
+		adj:    make([][]weightedEdge, len(weights)),
+		self:   self,
+		degree: make([]float64, len(weights)),
+	}
+	for u, neighbors := range weights {
+		w.degree[u] = self[u]
+		for v, weight := range neighbors {
+			w.adj[u] = append(w.adj[u], weightedEdge{to: v, weight: weight})
+			w.degree[u] += weight
+		}
+		// Keep the visiting order of neighbors, and with it the result, deterministic
+		sort.Slice(w.adj[u], func(i, j int) bool { return w.adj[u][i].to < w.adj[u][j].to })
+		w.total += w.degree[u]
+	}
+	return w
+}
+
+// moveNodes runs the local moving phase from singleton communities. It returns the community
+// of every node and whether any node changed community.
+func (w *weightedGraph) moveNodes(resolution float64, random *rand.Rand) ([]int, bool) {
+	n := len(w.adj)
+	community := make([]int, n)
+	totals := make([]float64, n) // Summed degree of each community
+	for i := range community {
+		community[i] = i
+		totals[i] = w.degree[i]
+	}
+
+	linkWeight := make([]float64, n) // Weight from the current node to each community
+	seen := make([]bool, n)
+	var neighbors []int
+	order := random.Perm(n)
+	moved := false
+	for improved := true; improved; {
+		improved = false
+		for _, i := range order {
+			current := community[i]
+			neighbors = neighbors[:0]
+			for _, e := range w.adj[i] {
+				c := community[e.to]
+				if !seen[c] {
+					seen[c] = true
+					neighbors = append(neighbors, c)
+				}
+				linkWeight[c] += e.weight
+			}
+
+			// Gain of joining c, up to a constant factor: the weight gained minus the weight
+			// expected between the node and c at random
+			totals[current] -= w.degree[i]
+			gain := func(c int) float64 {
+				return linkWeight[c] - resolution*totals[c]*w.degree[i]/w.total
+			}
+			best, bestGain := current, gain(current)
+			for _, c := range neighbors {
+				if g := gain(c); g > bestGain+1e-12 {
+					best, bestGain = c, g
+				}
+			}
+			totals[best] += w.degree[i]
+			community[i] = best
+			if best != current {
+				improved, moved = true, true
+			}
+
+			for _, c := range neighbors {
+				seen[c] = false
+				linkWeight[c] = 0
+			}
+		}
+	}
+	return community, moved
+}
+
+// aggregate merges every community into a single node. It returns the new graph and the new
+// node of every old node.
+func (w *weightedGraph) aggregate(community []int) (*weightedGraph, []int) {
+	labels := make([]int, len(community))
+	index := map[int]int{}
+	for i, c := range community {
+		label, ok := index[c]
+		if !ok {
+			label = len(index)
+			index[c] = label
+		}
+		labels[i] = label
+	}
+
+	weights := make([]map[int]float64, len(index))
+	for i := range weights {
+		weights[i] = map[int]float64{}
+	}
+	self := make([]float64, len(index))
+	for u, edges := range w.adj {
+		self[labels[u]] += w.self[u]
+		for _, e := range edges {
+			if labels[u] == labels[e.to] {
+				self[labels[u]] += e.weight
+			} else {
+				weights[labels[u]][labels[e.to]] += e.weight
+			}
+		}
+	}
+	return buildWeightedGraph(weights, self), labels
+}
+
+// partition numbers the communities by size, largest first, and computes their statistics
+// and the modularity of the partition
+func (w *weightedGraph) partition(membership []int, resolution float64) Partition {
+	sizes := map[int]int{}
+	first := map[int]int{} // Lowest node index in each community, to break size ties
+	for i, c := range membership {
+		if _, ok := first[c]; !ok {
+			first[c] = i
+		}
+		sizes[c]++
+	}
+	communities := make([]int, 0, len(sizes))
+	for c := range sizes {
+		communities = append(communities, c)
+	}
+	sort.Slice(communities, func(i, j int) bool {
+		a, b := communities[i], communities[j]
+		if sizes[a] != sizes[b] {
+			return sizes[a] > sizes[b]
+		}
+		return first[a] < first[b]
+	})
+	rank := make(map[int]int, len(communities))
+	for r, c := range communities {
+		rank[c] = r
+	}
+
+	p := Partition{
+		Membership: make([]int, len(membership)),
+		Sizes:      make([]int, len(communities)),
+		Internal:   make([]float64, len(communities)),
+	}
+	totals := make([]float64, len(communities))
+	for i, c := range membership {
+		p.Membership[i] = rank[c]
+		p.Sizes[rank[c]]++
+		totals[rank[c]] += w.degree[i]
+	}
+	for u, edges := range w.adj {
+		for _, e := range edges {
+			if p.Membership[u] == p.Membership[e.to] {
+				p.Internal[p.Membership[u]] += e.weight / 2 // Every edge is seen from both ends
+			}
+		}
+	}
+
+	if w.total > 0 {
+		for c := range p.Sizes {
+			expected := totals[c] / w.total
+			p.Modularity += 2*p.Internal[c]/w.total - resolution*expected*expected
+		}
+	}
+	return p
+}
+
+// TopMembers returns the node indexes of every community's members with the most weight
+// inside their community, most first and at most limit per community
+func (p Partition) TopMembers(g *Graph, limit int) [][]int {
+	internal := make([]float64, len(p.Membership))
+	for u, arcs := range g.out {
+		for _, a := range arcs {
+			if p.Membership[u] == p.Membership[a.to] {
+				internal[u] += a.weight
+				internal[a.to] += a.weight
+			}
+		}
+	}
+
+	members := make([][]int, len(p.Sizes))
+	for u, c := range p.Membership {
+		members[c] = append(members[c], u)
+	}
+	for c := range members {
+		sort.SliceStable(members[c], func(i, j int) bool {
+			return internal[members[c][i]] > internal[members[c][j]]
+		})
+		if len(members[c]) > limit {
+			members[c] = members[c][:limit]
+		}
+	}
+	return members
+}
@@ -0,0 +1,309 @@
+package analytics
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/Blogem/enron-graph/ent"
+	"github.com/Blogem/enron-graph/ent/community"
+	"github.com/Blogem/enron-graph/ent/discoveredentity"
+	"github.com/Blogem/enron-graph/ent/relationship"
+	"github.com/Blogem/enron-graph/internal/graph"
+)
+
+// CommunityOptions tunes community detection
+type CommunityOptions struct {
+	Resolution float64 // Higher values give more, smaller communities; 1 when 0
+	Seed       int64   // Seed for the order nodes are visited in
+	TopMembers int     // Members stored as the top of each community, 10 when 0
+}
+
+// CommunityResult describes a community detection run
+type CommunityResult struct {
+	Communities   int
+	Entities      int
+	Relationships int
+	Modularity    float64
+	ComputedAt    time.Time
+	Duration      time.Duration
+}
+
+// CommunityMember is an entity in a community
+type CommunityMember struct {
+	ID           int    `json:"id"`
+	UniqueID     string `json:"unique_id"`
+	Name         string `json:"name"`
+	TypeCategory string `json:"type_category"`
+}
+
+// CommunitySummary is a community with its most connected members
+type CommunitySummary struct {
+	ID             int               `json:"id"`
+	Label          string            `json:"label"`
+	Size           int               `json:"size"`
+	InternalWeight float64           `json:"internal_weight"`
+	Modularity     float64           `json:"modularity"`
+	Resolution     float64           `json:"resolution"`
+	ComputedAt     time.Time         `json:"computed_at"`
+	TopMembers     []CommunityMember `json:"top_members"`
+}
+
+// DetectCommunities finds communities in the communication graph and replaces the stored ones
+func DetectCommunities(ctx context.Context, client *ent.Client, opts CommunityOptions, logger *slog.Logger) (*CommunityResult, error) {
+	if opts.Resolution <= 0 {
+		opts.Resolution = 1
+	}
+	if opts.TopMembers <= 0 {
+		opts.TopMembers = 10
+	}
+
+	start := time.Now()
+	g, err := LoadCommunicationGraph(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	logger.Info("Detecting communities",
+		"entities", g.Nodes(),
+		"relationships", g.Edges(),
+		"resolution", opts.Resolution)
+
+	partition := Louvain(g, opts.Resolution, opts.Seed)
+	result := &CommunityResult{
+		Communities:   len(partition.Sizes),
+		Entities:      g.Nodes(),
+		Relationships: g.Edges(),
+		Modularity:    partition.Modularity,
+		ComputedAt:    time.Now(),
+	}
+	if err := SaveCommunities(ctx, client, g, partition, opts, result.ComputedAt); err != nil {
+		return nil, err
+	}
+	result.Duration = time.Since(start)
+	logger.Info("Communities detected",
+		"communities", result.Communities,
+		"modularity", result.Modularity,
+		"duration", result.Duration)
+	return result, nil
+}
+
+// SaveCommunities replaces every stored community and MEMBER_OF relationship with the
+// partition in a single transaction
+func SaveCommunities(ctx context.Context, client *ent.Client, g *Graph, p Partition, opts CommunityOptions, computedAt time.Time) error {
+	top := p.TopMembers(g, opts.TopMembers)
+	labelIDs := make([]int, 0, len(top))
+	for _, members := range top {
+		labelIDs = append(labelIDs, g.nodes[members[0]])
+	}
+	names, err := entityNames(ctx, client, labelIDs)
+	if err != nil {
+		return err
+	}
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Relationship.Delete().
+		Where(graph.CommunityMembership()).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("failed to clear memberships: %w", err)
+	}
+	if _, err := tx.Community.Delete().Exec(ctx); err != nil {
+		return fmt.Errorf("failed to clear communities: %w", err)
+	}
+
+	communityIDs := make([]int, 0, len(p.Sizes))
+	for start := 0; start < len(p.Sizes); start += saveBatchSize {
+		end := min(start+saveBatchSize, len(p.Sizes))
+		builders := make([]*ent.CommunityCreate, 0, end-start)
+		for c := start; c < end; c++ {
+			members := make([]int, len(top[c]))
+			for i, node := range top[c] {
+				members[i] = g.nodes[node]
+			}
+			builders = append(builders, tx.Community.Create().
+				SetLabel(names[members[0]]).
+				SetSize(p.Sizes[c]).
+				SetTopMembers(members).
+				SetInternalWeight(p.Internal[c]).
+				SetModularity(p.Modularity).
+				SetResolution(opts.Resolution).
+				SetComputedAt(computedAt))
+		}
+		created, err := tx.Community.CreateBulk(builders...).Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to save communities: %w", err)
+		}
+		for _, c := range created {
+			communityIDs = append(communityIDs, c.ID)
+		}
+	}
+
+	for start := 0; start < len(p.Membership); start += saveBatchSize {
+		end := min(start+saveBatchSize, len(p.Membership))
+		builders := make([]*ent.RelationshipCreate, 0, end-start)
+		for node := start; node < end; node++ {
+			builders = append(builders, tx.Relationship.Create().
+				SetType(graph.RelationshipMemberOf).
+				SetFromType("discovered_entity").
+				SetFromID(g.nodes[node]).
+				SetToType(graph.CommunityNodeType).
+				SetToID(communityIDs[p.Membership[node]]).
+				SetTimestamp(computedAt).
+				SetConfidenceScore(1.0))
+		}
+		if err := tx.Relationship.CreateBulk(builders...).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to save memberships: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit communities: %w", err)
+	}
+	return nil
+}
+
+// ListCommunities returns the stored communities of at least minSize members, largest first
+func ListCommunities(ctx context.Context, client *ent.Client, minSize, limit int) ([]CommunitySummary, error) {
+	rows, err := client.Community.Query().
+		Where(community.SizeGTE(minSize)).
+		Order(ent.Desc(community.FieldSize), ent.Asc(community.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query communities: %w", err)
+	}
+	return summarize(ctx, client, rows)
+}
+
+// GetCommunity returns a stored community. The error satisfies ent.IsNotFound when there is
+// no community with the ID.
+func GetCommunity(ctx context.Context, client *ent.Client, id int) (*CommunitySummary, error) {
+	row, err := client.Community.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get community %d: %w", id, err)
+	}
+	summaries, err := summarize(ctx, client, []*ent.Community{row})
+	if err != nil {
+		return nil, err
+	}
+	return &summaries[0], nil
+}
+
+// CommunityMembers returns up to limit members of a community, by entity ID
+func CommunityMembers(ctx context.Context, client *ent.Client, id, limit int) ([]CommunityMember, error) {
+	var ids []int
+	if err := client.Relationship.Query().
+		Where(
+			graph.CommunityMembership(),
+			relationship.ToIDEQ(id),
+		).
+		Order(ent.Asc(relationship.FieldFromID)).
+		Limit(limit).
+		Select(relationship.FieldFromID).
+		Scan(ctx, &ids); err != nil {
+		return nil, fmt.Errorf("failed to query members of community %d: %w", id, err)
+	}
+	return members(ctx, client, ids)
+}
+
+// FindCommunities returns the community ID of the given entities, by entity ID. Entities
+// that did not take part in the last detection have no community.
+func FindCommunities(ctx context.Context, client *ent.Client, entityIDs []int) (map[int]int, error) {
+	var rows []struct {
+		FromID int `json:"from_id"`
+		ToID   int `json:"to_id"`
+	}
+	if err := client.Relationship.Query().
+		Where(
+			graph.CommunityMembership(),
+			relationship.FromTypeEQ("discovered_entity"),
+			relationship.FromIDIn(entityIDs...),
+		).
+		Select(relationship.FieldFromID, relationship.FieldToID).
+		Scan(ctx, &rows); err != nil {
+		return nil, fmt.Errorf("failed to query memberships: %w", err)
+	}
+	communities := make(map[int]int, len(rows))
+	for _, row := range rows {
+		communities[row.FromID] = row.ToID
+	}
+	return communities, nil
+}
+
+// summarize resolves the top members of communities
+func summarize(ctx context.Context, client *ent.Client, rows []*ent.Community) ([]CommunitySummary, error) {
+	var ids []int
+	for _, row := range rows {
+		ids = append(ids, row.TopMembers...)
+	}
+	resolved, err := members(ctx, client, ids)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int]CommunityMember, len(resolved))
+	for _, m := range resolved {
+		byID[m.ID] = m
+	}
+
+	summaries := make([]CommunitySummary, len(rows))
+	for i, row := range rows {
+		summaries[i] = CommunitySummary{
+			ID:             row.ID,
+			Label:          row.Label,
+			Size:           row.Size,
+			InternalWeight: row.InternalWeight,
+			Modularity:     row.Modularity,
+			Resolution:     row.Resolution,
+			ComputedAt:     row.ComputedAt,
+			TopMembers:     make([]CommunityMember, 0, len(row.TopMembers)),
+		}
+		for _, id := range row.TopMembers {
+			if m, ok := byID[id]; ok {
+				summaries[i].TopMembers = append(summaries[i].TopMembers, m)
+			}
+		}
+	}
+	return summaries, nil
+}
+
+// members looks up entities in the order of their IDs; entities deleted since are left out
+func members(ctx context.Context, client *ent.Client, ids []int) ([]CommunityMember, error) {
+	found := make(map[int]CommunityMember, len(ids))
+	for start := 0; start < len(ids); start += saveBatchSize {
+		entities, err := client.DiscoveredEntity.Query().
+			Where(discoveredentity.IDIn(ids[start:min(start+saveBatchSize, len(ids))]...)).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query community members: %w", err)
+		}
+		for _, e := range entities {
+			found[e.ID] = CommunityMember{ID: e.ID, UniqueID: e.UniqueID, Name: e.Name, TypeCategory: e.TypeCategory}
+		}
+	}
+
+	result := make([]CommunityMember, 0, len(found))
+	for _, id := range ids {
+		if m, ok := found[id]; ok {
+			result = append(result, m)
+		}
+	}
+	return result, nil
+}
+
+// entityNames returns the names of entities by ID
+func entityNames(ctx context.Context, client *ent.Client, ids []int) (map[int]string, error) {
+	resolved, err := members(ctx, client, ids)
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[int]string, len(resolved))
+	for _, m := range resolved {
+		names[m.ID] = m.Name
+	}
+	return names, nil
+}
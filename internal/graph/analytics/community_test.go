@@ -0,0 +1,238 @@
+package analytics
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/Blogem/enron-graph/ent"
+	"github.com/Blogem/enron-graph/ent/enttest"
+	"github.com/Blogem/enron-graph/ent/relationship"
+	"github.com/Blogem/enron-graph/internal/graph"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// cliques builds fully connected groups of the given sizes, numbered consecutively from 1 and
+// each joined to the next by one relationship
+func cliques(sizes ...int) *Graph {
+	var edges []Edge
+	first := 1
+	for i, size := range sizes {
+		for a := first; a < first+size; a++ {
+			for b := a + 1; b < first+size; b++ {
+				edges = append(edges, Edge{From: a, To: b, Weight: 1})
+			}
+		}
+		if i > 0 {
+			edges = append(edges, Edge{From: first - 1, To: first, Weight: 1})
+		}
+		first += size
+	}
+	return NewGraph(edges)
+}
+
+// communityOf returns the community of an entity
+func communityOf(g *Graph, p Partition, entityID int) int {
+	return p.Membership[g.index[entityID]]
+}
+
+func TestLouvain_SeparatesCliques(t *testing.T) {
+	g := cliques(5, 4, 3)
+	p := Louvain(g, 1, 0)
+
+	if len(p.Sizes) != 3 {
+		t.Fatalf("Expected 3 communities, got %d: %v", len(p.Sizes), p.Membership)
+	}
+	if p.Sizes[0] != 5 || p.Sizes[1] != 4 || p.Sizes[2] != 3 {
+		t.Errorf("Expected communities numbered largest first, got sizes %v", p.Sizes)
+	}
+	for id := 1; id <= 5; id++ {
+		if communityOf(g, p, id) != 0 {
+			t.Errorf("Expected entity %d in the largest community, got %d", id, communityOf(g, p, id))
+		}
+	}
+	if communityOf(g, p, 6) != 1 || communityOf(g, p, 12) != 2 {
+		t.Errorf("Expected the other cliques in their own communities, got %v", p.Membership)
+	}
+	if p.Internal[0] != 10 || p.Internal[2] != 3 {
+		t.Errorf("Expected internal weights 10 and 3, got %v", p.Internal)
+	}
+	if p.Modularity <= 0.4 {
+		t.Errorf("Expected a clearly modular partition, got modularity %f", p.Modularity)
+	}
+}
+
+func TestLouvain_Resolution(t *testing.T) {
+	g := cliques(4, 4, 4, 4)
+	coarse := Louvain(g, 0.05, 0)
+	fine := Louvain(g, 1, 0)
+	if len(coarse.Sizes) >= len(fine.Sizes) {
+		t.Errorf("Expected a low resolution to give fewer communities, got %d and %d", len(coarse.Sizes), len(fine.Sizes))
+	}
+}
+
+func TestLouvain_Deterministic(t *testing.T) {
+	g := cliques(6, 5, 5, 3)
+	first := Louvain(g, 1, 7)
+	for i := 0; i < 5; i++ {
+		again := Louvain(g, 1, 7)
+		for n := range first.Membership {
+			if first.Membership[n] != again.Membership[n] {
+				t.Fatalf("Expected the same partition for the same seed, got %v and %v", first.Membership, again.Membership)
+			}
+		}
+	}
+}
+
+func TestLouvain_EmptyGraph(t *testing.T) {
+	p := Louvain(NewGraph(nil), 1, 0)
+	if len(p.Sizes) != 0 || p.Modularity != 0 {
+		t.Errorf("Expected no communities, got %+v", p)
+	}
+}
+
+func TestPartition_TopMembers(t *testing.T) {
+	// 1 talks to everyone in its group, 4 only to 1
+	g := NewGraph([]Edge{
+		{From: 1, To: 2, Weight: 1},
+		{From: 1, To: 3, Weight: 1},
+		{From: 1, To: 4, Weight: 1},
+		{From: 2, To: 3, Weight: 1},
+	})
+	p := Partition{Membership: []int{0, 0, 0, 0}, Sizes: []int{4}}
+	top := p.TopMembers(g, 2)
+	if len(top) != 1 || len(top[0]) != 2 {
+		t.Fatalf("Expected the two top members of one community, got %v", top)
+	}
+	if g.nodes[top[0][0]] != 1 {
+		t.Errorf("Expected entity 1 to rank first, got %d", g.nodes[top[0][0]])
+	}
+}
+
+func TestLoadCommunicationGraph(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:communication?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	ctx := context.Background()
+
+	// Entity 1 emails entity 2
+	rel(t, client, "SENT", "discovered_entity", 1, "email", 10)
+	rel(t, client, "RECEIVED", "email", 10, "discovered_entity", 2)
+	// Extracted SENT and RECEIVED facts between entities are not emails, even when the IDs match
+	rel(t, client, "SENT", "person", 3, "document", 10)
+	rel(t, client, "RECEIVED", "person", 10, "person", 4)
+	rel(t, client, "COMMUNICATES_WITH", "email", 10, "thread", 5)
+
+	g, err := LoadCommunicationGraph(ctx, client)
+	if err != nil {
+		t.Fatalf("LoadCommunicationGraph failed: %v", err)
+	}
+	if g.Nodes() != 2 || g.Edges() != 1 {
+		t.Errorf("Expected only the link between entities 1 and 2, got %d nodes and %d edges", g.Nodes(), g.Edges())
+	}
+}
+
+func TestDetectCommunities(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:communities?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	ctx := context.Background()
+
+	// Two teams of three that email among themselves, and one email between the teams
+	ids := map[string]int{}
+	for _, name := range []string{"alice", "bob", "carol", "dave", "erin", "frank"} {
+		e := client.DiscoveredEntity.Create().
+			SetUniqueID(name + "@enron.com").
+			SetName(name).
+			SetTypeCategory("person").
+			SaveX(ctx)
+		ids[name] = e.ID
+	}
+	emailID := 100
+	send := func(from string, to ...string) {
+		emailID++
+		rel(t, client, "SENT", "discovered_entity", ids[from], "email", emailID)
+		for _, recipient := range to {
+			rel(t, client, "RECEIVED", "email", emailID, "discovered_entity", ids[recipient])
+		}
+	}
+	send("alice", "bob", "carol")
+	send("bob", "carol")
+	send("dave", "erin", "frank")
+	send("erin", "frank")
+	send("carol", "dave")
+	// Relationships outside the communication subgraph are ignored
+	rel(t, client, "MENTIONS", "email", 101, "discovered_entity", ids["frank"])
+	// An extracted MEMBER_OF fact is no community membership
+	enron := client.DiscoveredEntity.Create().
+		SetUniqueID("enron").
+		SetName("Enron").
+		SetTypeCategory("organization").
+		SaveX(ctx)
+	rel(t, client, "MEMBER_OF", "person", ids["alice"], "organization", enron.ID)
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	result, err := DetectCommunities(ctx, client, CommunityOptions{TopMembers: 2}, logger)
+	if err != nil {
+		t.Fatalf("DetectCommunities failed: %v", err)
+	}
+	if result.Communities != 2 || result.Entities != 6 {
+		t.Fatalf("Expected 2 communities of 6 entities, got %+v", result)
+	}
+
+	assigned, err := FindCommunities(ctx, client, []int{ids["alice"], ids["bob"], ids["frank"]})
+	if err != nil {
+		t.Fatalf("FindCommunities failed: %v", err)
+	}
+	if assigned[ids["alice"]] != assigned[ids["bob"]] || assigned[ids["alice"]] == assigned[ids["frank"]] {
+		t.Errorf("Expected alice and bob together and frank apart, got %v", assigned)
+	}
+
+	community, err := GetCommunity(ctx, client, assigned[ids["alice"]])
+	if err != nil {
+		t.Fatalf("GetCommunity failed: %v", err)
+	}
+	if community.Size != 3 || len(community.TopMembers) != 2 || community.Label != community.TopMembers[0].Name {
+		t.Errorf("Unexpected community: %+v", community)
+	}
+	members, err := CommunityMembers(ctx, client, community.ID, 10)
+	if err != nil {
+		t.Fatalf("CommunityMembers failed: %v", err)
+	}
+	if len(members) != 3 || members[0].Name != "alice" {
+		t.Errorf("Expected alice, bob and carol, got %+v", members)
+	}
+
+	// Detecting again replaces the communities and memberships
+	if _, err := DetectCommunities(ctx, client, CommunityOptions{}, logger); err != nil {
+		t.Fatalf("second DetectCommunities failed: %v", err)
+	}
+	if n := client.Community.Query().CountX(ctx); n != 2 {
+		t.Errorf("Expected 2 communities after recomputing, got %d", n)
+	}
+	if n := client.Relationship.Query().Where(graph.CommunityMembership()).CountX(ctx); n != 6 {
+		t.Errorf("Expected one membership per entity after recomputing, got %d", n)
+	}
+	if n := client.Relationship.Query().Where(relationship.TypeEQ(graph.RelationshipMemberOf)).CountX(ctx); n != 7 {
+		t.Errorf("Expected the extracted MEMBER_OF fact to be kept, got %d MEMBER_OF relationships", n)
+	}
+	listed, err := ListCommunities(ctx, client, 1, 10)
+	if err != nil {
+		t.Fatalf("ListCommunities failed: %v", err)
+	}
+	if len(listed) != 2 || listed[0].ID == community.ID {
+		t.Errorf("Expected 2 new communities, got %+v", listed)
+	}
+}
+
+func rel(t *testing.T, client *ent.Client, relType, fromType string, fromID int, toType string, toID int) {
+	t.Helper()
+	client.Relationship.Create().
+		SetType(relType).
+		SetFromType(fromType).
+		SetFromID(fromID).
+		SetToType(toType).
+		SetToID(toID).
+		SetTimestamp(time.Date(2001, 5, 1, 0, 0, 0, 0, time.UTC)).
+		SaveX(context.Background())
+}
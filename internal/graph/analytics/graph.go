@@ -0,0 +1,193 @@
+// Package analytics computes centrality metrics over the entity graph, so that the most
+// connected, influential and brokering entities can be ranked.
+package analytics
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Blogem/enron-graph/ent"
+	"github.com/Blogem/enron-graph/ent/predicate"
+	"github.com/Blogem/enron-graph/ent/relationship"
+	"github.com/Blogem/enron-graph/internal/graph"
+)
+
+// Node types at the ends of relationships that are not between entities
+var nonEntityTypes = []string{"email", "thread", graph.CommunityNodeType}
+
+// Filter selects the relationships a graph is built from; zero fields do not restrict the selection
+type Filter struct {
+	Types []string  // Relationship types, e.g. COMMUNICATES_WITH
+	Since time.Time // Relationships at or after
+	Until time.Time // Relationships before
+}
+
+// ParseFilter builds a filter from a comma-separated list of relationship types and window
+// bounds given as dates (2001-06-30) or RFC 3339 times; empty values do not restrict
+func ParseFilter(types, since, until string) (Filter, error) {
+	var filter Filter
+	for _, t := range strings.Split(types, ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			filter.Types = append(filter.Types, t)
+		}
+	}
+	window, err := graph.ParseTimeWindow(since, until, "")
+	if err != nil {
+		return Filter{}, err
+	}
+	filter.Since, filter.Until = window.Since, window.Until
+	return filter, nil
+}
+
+// Scope identifies the filter the scores were computed over: "all" for every relationship,
+// otherwise the types and time window, e.g. "types=COMMUNICATES_WITH;since=2001-01-01"
+func (f Filter) Scope() string {
+	var parts []string
+	if types := f.types(); len(types) > 0 {
+		parts = append(parts, "types="+strings.Join(types, ","))
+	}
+	if !f.Since.IsZero() {
+		parts = append(parts, "since="+formatTime(f.Since))
+	}
+	if !f.Until.IsZero() {
+		parts = append(parts, "until="+formatTime(f.Until))
+	}
+	if len(parts) == 0 {
+		return "all"
+	}
+	return strings.Join(parts, ";")
+}
+
+// types returns the relationship types upper-cased and sorted, as they are stored
+func (f Filter) types() []string {
+	var types []string
+	for _, t := range f.Types {
+		if t = strings.ToUpper(strings.TrimSpace(t)); t != "" {
+			types = append(types, t)
+		}
+	}
+	sort.Strings(types)
+	return types
+}
+
+// formatTime formats dates without their time of day, which is what windows usually are
+func formatTime(t time.Time) string {
+	t = t.UTC()
+	if t.Equal(t.Truncate(24 * time.Hour)) {
+		return t.Format("2006-01-02")
+	}
+	return t.Format(time.RFC3339)
+}
+
+// Edge is a relationship from one entity to another, weighted by its confidence
+type Edge struct {
+	From   int
+	To     int
+	Weight float64
+}
+
+// arc is a directed connection to a node index, with the weight of all parallel relationships
+type arc struct {
+	to     int
+	weight float64
+}
+
+// Graph is an in-memory entity graph. Relationships keep their direction for PageRank; degree,
+// betweenness and closeness treat the graph as undirected, since a broker links both ways.
+type Graph struct {
+	nodes    []int       // Entity IDs by node index
+	index    map[int]int // Node index by entity ID
+	out      [][]arc     // Directed arcs, parallel relationships merged
+	adj      [][]int     // Distinct undirected neighbors
+	weighted []float64   // Summed weight of the relationships at each node
+	edges    int
+}
+
+// NewGraph builds a graph from relationships. Parallel relationships add up their weights;
+// self-loops are ignored.
+func NewGraph(edges []Edge) *Graph {
+	g := &Graph{index: map[int]int{}}
+	node := func(id int) int {
+		i, ok := g.index[id]
+		if !ok {
+			i = len(g.nodes)
+			g.index[id] = i
+			g.nodes = append(g.nodes, id)
+			g.out = append(g.out, nil)
+			g.adj = append(g.adj, nil)
+			g.weighted = append(g.weighted, 0)
+		}
+		return i
+	}
+
+	arcs := map[[2]int]int{}       // Position of an arc in out[from]
+	neighbors := map[[2]int]bool{} // Undirected pairs, smaller index first
+	for _, e := range edges {
+		if e.From == e.To {
+			continue
+		}
+		from, to := node(e.From), node(e.To)
+		g.edges++
+		g.weighted[from] += e.Weight
+		g.weighted[to] += e.Weight
+
+		if pos, ok := arcs[[2]int{from, to}]; ok {
+			g.out[from][pos].weight += e.Weight
+		} else {
+			arcs[[2]int{from, to}] = len(g.out[from])
+			g.out[from] = append(g.out[from], arc{to: to, weight: e.Weight})
+		}
+
+		pair := [2]int{min(from, to), max(from, to)}
+		if !neighbors[pair] {
+			neighbors[pair] = true
+			g.adj[from] = append(g.adj[from], to)
+			g.adj[to] = append(g.adj[to], from)
+		}
+	}
+	return g
+}
+
+// Nodes returns the number of entities in the graph
+func (g *Graph) Nodes() int {
+	return len(g.nodes)
+}
+
+// Edges returns the number of relationships the graph was built from, without self-loops
+func (g *Graph) Edges() int {
+	return g.edges
+}
+
+// LoadGraph builds the graph of the relationships between entities that match the filter.
+// Relationships to emails and threads are left out.
+func LoadGraph(ctx context.Context, client *ent.Client, filter Filter) (*Graph, error) {
+	predicates := []predicate.Relationship{
+		relationship.FromTypeNotIn(nonEntityTypes...),
+		relationship.ToTypeNotIn(nonEntityTypes...),
+	}
+	if types := filter.types(); len(types) > 0 {
+		predicates = append(predicates, relationship.TypeIn(types...))
+	}
+	predicates = append(predicates, graph.TimeWindow{Since: filter.Since, Until: filter.Until}.Predicates()...)
+
+	var rows []struct {
+		FromID     int     `json:"from_id"`
+		ToID       int     `json:"to_id"`
+		Confidence float64 `json:"confidence_score"`
+	}
+	if err := client.Relationship.Query().
+		Where(predicates...).
+		Select(relationship.FieldFromID, relationship.FieldToID, relationship.FieldConfidenceScore).
+		Scan(ctx, &rows); err != nil {
+		return nil, fmt.Errorf("failed to load relationships: %w", err)
+	}
+
+	edges := make([]Edge, len(rows))
+	for i, row := range rows {
+		edges[i] = Edge{From: row.FromID, To: row.ToID, Weight: row.Confidence}
+	}
+	return NewGraph(edges), nil
+}
@@ -0,0 +1,179 @@
+package analytics
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/Blogem/enron-graph/ent"
+	"github.com/Blogem/enron-graph/ent/centralityscore"
+	"github.com/Blogem/enron-graph/ent/discoveredentity"
+)
+
+// saveBatchSize keeps bulk inserts well below the Postgres parameter limit
+const saveBatchSize = 1000
+
+// Result describes a centrality run
+type Result struct {
+	Scope         string
+	Entities      int
+	Relationships int
+	Scores        []Scores
+	ComputedAt    time.Time
+	Duration      time.Duration
+}
+
+// RankedEntity is an entity with its scores, as ranked by one metric
+type RankedEntity struct {
+	Scores
+	Rank         int       `json:"rank"`
+	UniqueID     string    `json:"unique_id"`
+	Name         string    `json:"name"`
+	TypeCategory string    `json:"type_category"`
+	ComputedAt   time.Time `json:"computed_at"`
+}
+
+// Run computes the centrality of every entity in the filtered graph and persists the scores,
+// replacing those computed earlier for the same scope
+func Run(ctx context.Context, client *ent.Client, filter Filter, opts Options, logger *slog.Logger) (*Result, error) {
+	start := time.Now()
+	g, err := LoadGraph(ctx, client, filter)
+	if err != nil {
+		return nil, err
+	}
+	logger.Info("Computing centrality",
+		"scope", filter.Scope(),
+		"entities", g.Nodes(),
+		"relationships", g.Edges(),
+		"samples", opts.Samples)
+
+	result := &Result{
+		Scope:         filter.Scope(),
+		Entities:      g.Nodes(),
+		Relationships: g.Edges(),
+		Scores:        Compute(g, opts),
+		ComputedAt:    time.Now(),
+	}
+	if err := SaveScores(ctx, client, result.Scope, result.Scores, result.ComputedAt); err != nil {
+		return nil, err
+	}
+	result.Duration = time.Since(start)
+	logger.Info("Centrality computed", "scope", result.Scope, "duration", result.Duration)
+	return result, nil
+}
+
+// SaveScores replaces the scores of a scope in a single transaction
+func SaveScores(ctx context.Context, client *ent.Client, scope string, scores []Scores, computedAt time.Time) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.CentralityScore.Delete().
+		Where(centralityscore.ScopeEQ(scope)).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("failed to clear scores of scope %q: %w", scope, err)
+	}
+
+	for start := 0; start < len(scores); start += saveBatchSize {
+		batch := scores[start:min(start+saveBatchSize, len(scores))]
+		builders := make([]*ent.CentralityScoreCreate, len(batch))
+		for i, s := range batch {
+			builders[i] = tx.CentralityScore.Create().
+				SetScope(scope).
+				SetEntityID(s.EntityID).
+				SetDegree(s.Degree).
+				SetWeightedDegree(s.WeightedDegree).
+				SetPagerank(s.PageRank).
+				SetBetweenness(s.Betweenness).
+				SetCloseness(s.Closeness).
+				SetComputedAt(computedAt)
+		}
+		if err := tx.CentralityScore.CreateBulk(builders...).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to save scores: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit scores: %w", err)
+	}
+	return nil
+}
+
+// TopEntities returns the highest scoring entities of a scope by metric, best first
+func TopEntities(ctx context.Context, client *ent.Client, scope, metric string, limit int) ([]RankedEntity, error) {
+	if err := ValidateMetric(metric); err != nil {
+		return nil, err
+	}
+	rows, err := client.CentralityScore.Query().
+		Where(centralityscore.ScopeEQ(scope)).
+		Order(ent.Desc(metric), ent.Asc(centralityscore.FieldEntityID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query scores: %w", err)
+	}
+
+	ids := make([]int, len(rows))
+	for i, row := range rows {
+		ids[i] = row.EntityID
+	}
+	entities, err := client.DiscoveredEntity.Query().
+		Where(discoveredentity.IDIn(ids...)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query ranked entities: %w", err)
+	}
+	byID := make(map[int]*ent.DiscoveredEntity, len(entities))
+	for _, e := range entities {
+		byID[e.ID] = e
+	}
+
+	ranked := make([]RankedEntity, 0, len(rows))
+	for _, row := range rows {
+		entry := RankedEntity{
+			Scores:     toScores(row),
+			Rank:       len(ranked) + 1,
+			ComputedAt: row.ComputedAt,
+		}
+		if e, ok := byID[row.EntityID]; ok {
+			entry.UniqueID = e.UniqueID
+			entry.Name = e.Name
+			entry.TypeCategory = e.TypeCategory
+		}
+		ranked = append(ranked, entry)
+	}
+	return ranked, nil
+}
+
+// FindScores returns the scores of the given entities in a scope, by entity ID. Entities
+// without relationships in the scope have no scores.
+func FindScores(ctx context.Context, client *ent.Client, scope string, entityIDs []int) (map[int]Scores, error) {
+	rows, err := client.CentralityScore.Query().
+		Where(
+			centralityscore.ScopeEQ(scope),
+			centralityscore.EntityIDIn(entityIDs...),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query scores: %w", err)
+	}
+	scores := make(map[int]Scores, len(rows))
+	for _, row := range rows {
+		scores[row.EntityID] = toScores(row)
+	}
+	return scores, nil
+}
+
+func toScores(row *ent.CentralityScore) Scores {
+	return Scores{
+		EntityID:       row.EntityID,
+		Degree:         row.Degree,
+		WeightedDegree: row.WeightedDegree,
+		PageRank:       row.Pagerank,
+		Betweenness:    row.Betweenness,
+		Closeness:      row.Closeness,
+	}
+}
@@ -0,0 +1,568 @@
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/Blogem/enron-graph/ent"
+	"github.com/Blogem/enron-graph/ent/discoveredentity"
+	"github.com/Blogem/enron-graph/ent/entityaudit"
+	"github.com/Blogem/enron-graph/ent/provenance"
+	"github.com/Blogem/enron-graph/ent/relationship"
+)
+
+// AliasesProperty is the entity property holding alternative names and identifiers
+const AliasesProperty = "aliases"
+
+// auditActorKey is the context key for the actor recorded on audit entries
+type auditActorKey struct{}
+
+// WithAuditActor returns a context whose curation operations are attributed to actor
+func WithAuditActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, auditActorKey{}, actor)
+}
+
+// entitySnapshot captures an entity as it was before a curation operation
+type entitySnapshot struct {
+	ID              int                    `json:"id"`
+	UniqueID        string                 `json:"unique_id"`
+	TypeCategory    string                 `json:"type_category"`
+	Name            string                 `json:"name"`
+	Properties      map[string]interface{} `json:"properties,omitempty"`
+	ConfidenceScore float64                `json:"confidence_score"`
+	RelationshipIDs []int                  `json:"relationship_ids,omitempty"`
+	ProvenanceIDs   []int                  `json:"provenance_ids,omitempty"`
+	Aliases         []string               `json:"aliases,omitempty"`
+}
+
+// mergeDetails is stored in the audit entry of a merge
+type mergeDetails struct {
+	Survivor             entitySnapshot      `json:"survivor"`
+	Merged               []entitySnapshot    `json:"merged"`
+	RemovedRelationships []*ent.Relationship `json:"removed_relationships,omitempty"`
+}
+
+// splitDetails is stored in the audit entry of a split
+type splitDetails struct {
+	Source  entitySnapshot `json:"source"`
+	Created entitySnapshot `json:"created"`
+}
+
+// MergeEntities folds the victims into the survivor in a single transaction
+func (r *entRepository) MergeEntities(ctx context.Context, survivorID int, victimIDs []int, reason string) (*MergeResult, error) {
+	victimIDs = distinctIDs(victimIDs, survivorID)
+	if len(victimIDs) == 0 {
+		return nil, fmt.Errorf("no entities to merge into %d", survivorID)
+	}
+
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	survivor, err := tx.DiscoveredEntity.Get(ctx, survivorID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load survivor %d: %w", survivorID, err)
+	}
+	victims, err := tx.DiscoveredEntity.Query().
+		Where(discoveredentity.IDIn(victimIDs...)).
+		Order(ent.Asc(discoveredentity.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load entities to merge: %w", err)
+	}
+	if len(victims) != len(victimIDs) {
+		return nil, fmt.Errorf("some entities to merge do not exist: %v", victimIDs)
+	}
+
+	details := mergeDetails{Survivor: snapshotEntity(survivor)}
+	result := &MergeResult{MergedIDs: victimIDs}
+
+	// Edges between the merged entities would become self-loops
+	group := append([]int{survivorID}, victimIDs...)
+	internal, err := tx.Relationship.Query().
+		Where(
+			relationship.FromTypeEQ("discovered_entity"),
+			relationship.FromIDIn(group...),
+			relationship.ToTypeEQ("discovered_entity"),
+			relationship.ToIDIn(group...),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query relationships between merged entities: %w", err)
+	}
+	if len(internal) > 0 {
+		ids := make([]int, len(internal))
+		for i, rel := range internal {
+			ids[i] = rel.ID
+		}
+		if _, err := tx.Relationship.Delete().Where(relationship.IDIn(ids...)).Exec(ctx); err != nil {
+			return nil, fmt.Errorf("failed to remove relationships between merged entities: %w", err)
+		}
+	}
+	details.RemovedRelationships = internal
+	result.RelationshipsRemoved = len(internal)
+
+	properties := copyProperties(survivor.Properties)
+	aliases := Aliases(survivor)
+	confidence := survivor.ConfidenceScore
+	embedding := survivor.Embedding
+
+	for _, victim := range victims {
+		snapshot := snapshotEntity(victim)
+
+		moved, err := rewireRelationships(ctx, tx, victim.ID, survivorID, nil)
+		if err != nil {
+			return nil, err
+		}
+		snapshot.RelationshipIDs = moved
+		result.RelationshipsMoved += len(moved)
+
+		movedProvenance, err := moveProvenance(ctx, tx, victim.ID, survivorID, nil)
+		if err != nil {
+			return nil, err
+		}
+		snapshot.ProvenanceIDs = movedProvenance
+		result.ProvenanceMoved += len(movedProvenance)
+
+		snapshot.Aliases, err = transferAliases(ctx, tx, victim.ID, survivorID, nil)
+		if err != nil {
+			return nil, err
+		}
+		// Later mentions of the victim's name or ID resolve to the survivor
+		for _, alias := range []string{victim.Name, victim.UniqueID} {
+			if _, err := insertAlias(ctx, tx.Client(), &AliasInput{EntityID: survivorID, Alias: alias, Source: "merge"}); err != nil {
+				return nil, err
+			}
+		}
+
+		// The survivor's values win; victims only fill in missing properties
+		for key, value := range victim.Properties {
+			if key == AliasesProperty {
+				continue
+			}
+			if _, exists := properties[key]; !exists {
+				properties[key] = value
+			}
+		}
+		aliases = append(aliases, victim.Name, victim.UniqueID)
+		aliases = append(aliases, Aliases(victim)...)
+
+		if victim.ConfidenceScore > confidence {
+			confidence = victim.ConfidenceScore
+		}
+		if len(embedding) == 0 && len(victim.Embedding) > 0 {
+			embedding = victim.Embedding
+		}
+
+		details.Merged = append(details.Merged, snapshot)
+	}
+
+	setAliases(properties, normalizeAliases(aliases, survivor.Name, survivor.UniqueID))
+
+	if _, err := tx.DiscoveredEntity.Delete().Where(discoveredentity.IDIn(victimIDs...)).Exec(ctx); err != nil {
+		return nil, fmt.Errorf("failed to delete merged entities: %w", err)
+	}
+
+	update := tx.DiscoveredEntity.UpdateOneID(survivorID).
+		SetProperties(properties).
+		SetConfidenceScore(confidence)
+	if len(embedding) > 0 {
+		update.SetEmbedding(embedding)
+	}
+	result.Survivor, err = update.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update survivor: %w", err)
+	}
+
+	result.Audit, err = recordAudit(ctx, tx, AuditMerge, survivorID, victimIDs, reason, details)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit merge: %w", err)
+	}
+
+	r.logger.Info("Merged entities",
+		"survivor_id", survivorID,
+		"merged_ids", victimIDs,
+		"relationships_moved", result.RelationshipsMoved,
+		"relationships_removed", result.RelationshipsRemoved)
+
+	return result, nil
+}
+
+// SplitEntity creates a new entity from part of an existing one in a single transaction
+func (r *entRepository) SplitEntity(ctx context.Context, entityID int, input *SplitInput) (*SplitResult, error) {
+	if input == nil || strings.TrimSpace(input.UniqueID) == "" || strings.TrimSpace(input.Name) == "" {
+		return nil, fmt.Errorf("unique ID and name are required to split an entity")
+	}
+
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	source, err := tx.DiscoveredEntity.Get(ctx, entityID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load entity %d: %w", entityID, err)
+	}
+
+	typeCategory := input.TypeCategory
+	if typeCategory == "" {
+		typeCategory = source.TypeCategory
+	}
+
+	// Move the selected properties and aliases
+	sourceProperties := copyProperties(source.Properties)
+	createdProperties := make(map[string]interface{})
+	for _, key := range input.PropertyKeys {
+		if value, exists := sourceProperties[key]; exists && key != AliasesProperty {
+			createdProperties[key] = value
+			delete(sourceProperties, key)
+		}
+	}
+
+	moveAliases := make(map[string]bool)
+	for _, alias := range input.Aliases {
+		moveAliases[strings.ToLower(alias)] = true
+	}
+	var kept, moved []string
+	for _, alias := range Aliases(source) {
+		if moveAliases[strings.ToLower(alias)] {
+			moved = append(moved, alias)
+		} else {
+			kept = append(kept, alias)
+		}
+	}
+	setAliases(sourceProperties, normalizeAliases(kept, source.Name, source.UniqueID))
+	setAliases(createdProperties, normalizeAliases(moved, input.Name, input.UniqueID))
+
+	created, err := tx.DiscoveredEntity.Create().
+		SetUniqueID(input.UniqueID).
+		SetTypeCategory(typeCategory).
+		SetName(input.Name).
+		SetProperties(createdProperties).
+		SetConfidenceScore(source.ConfidenceScore).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create split entity: %w", err)
+	}
+
+	result := &SplitResult{Created: created}
+
+	if len(input.RelationshipIDs) > 0 {
+		movedRels, err := rewireRelationships(ctx, tx, entityID, created.ID, input.RelationshipIDs)
+		if err != nil {
+			return nil, err
+		}
+		if len(movedRels) != len(distinctIDs(input.RelationshipIDs, 0)) {
+			return nil, fmt.Errorf("some relationships do not belong to entity %d: %v", entityID, input.RelationshipIDs)
+		}
+		result.RelationshipsMoved = len(movedRels)
+	}
+
+	if len(input.ProvenanceIDs) > 0 {
+		movedProvenance, err := moveProvenance(ctx, tx, entityID, created.ID, input.ProvenanceIDs)
+		if err != nil {
+			return nil, err
+		}
+		result.ProvenanceMoved = len(movedProvenance)
+	}
+
+	aliasKeys := []string{NormalizeAlias(input.Name), NormalizeAlias(input.UniqueID)}
+	for _, alias := range input.Aliases {
+		aliasKeys = append(aliasKeys, NormalizeAlias(alias))
+	}
+	if _, err := transferAliases(ctx, tx, entityID, created.ID, aliasKeys); err != nil {
+		return nil, err
+	}
+
+	result.Source, err = tx.DiscoveredEntity.UpdateOneID(entityID).
+		SetProperties(sourceProperties).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update source entity: %w", err)
+	}
+
+	createdSnapshot := snapshotEntity(created)
+	createdSnapshot.RelationshipIDs = input.RelationshipIDs
+	createdSnapshot.ProvenanceIDs = input.ProvenanceIDs
+	details := splitDetails{
+		Source:  snapshotEntity(source),
+		Created: createdSnapshot,
+	}
+
+	result.Audit, err = recordAudit(ctx, tx, AuditSplit, entityID, []int{created.ID}, input.Reason, details)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit split: %w", err)
+	}
+
+	r.logger.Info("Split entity",
+		"source_id", entityID,
+		"created_id", created.ID,
+		"relationships_moved", result.RelationshipsMoved)
+
+	return result, nil
+}
+
+// FindEntityAudits returns the curation history of an entity, newest first. It includes the
+// operations the entity was only related to, such as the merge that folded it away.
+func (r *entRepository) FindEntityAudits(ctx context.Context, entityID int) ([]*ent.EntityAudit, error) {
+	return r.client.EntityAudit.Query().
+		Where(entityaudit.Or(
+			entityaudit.EntityIDEQ(entityID),
+			func(s *sql.Selector) {
+				s.Where(sqljson.ValueContains(entityaudit.FieldRelatedIds, entityID))
+			},
+		)).
+		Order(ent.Desc(entityaudit.FieldCreatedAt)).
+		All(ctx)
+}
+
+// SplitInputFromMerge builds the SplitInput that restores an entity folded away by a merge,
+// using the snapshot stored in the merge's audit entry
+func SplitInputFromMerge(audit *ent.EntityAudit, mergedID int) (*SplitInput, error) {
+	if audit.Operation != AuditMerge {
+		return nil, fmt.Errorf("audit entry %d is a %s, not a merge", audit.ID, audit.Operation)
+	}
+
+	var details mergeDetails
+	raw, err := json.Marshal(audit.Details)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read audit details: %w", err)
+	}
+	if err := json.Unmarshal(raw, &details); err != nil {
+		return nil, fmt.Errorf("failed to read audit details: %w", err)
+	}
+
+	for _, merged := range details.Merged {
+		if merged.ID != mergedID {
+			continue
+		}
+
+		// Only properties the survivor did not already have were copied over
+		var keys []string
+		for key := range merged.Properties {
+			if _, exists := details.Survivor.Properties[key]; !exists && key != AliasesProperty {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+
+		aliases := []string{merged.Name, merged.UniqueID}
+		aliases = append(aliases, merged.Aliases...)
+		if list, ok := merged.Properties[AliasesProperty].([]interface{}); ok {
+			for _, alias := range list {
+				if s, ok := alias.(string); ok {
+					aliases = append(aliases, s)
+				}
+			}
+		}
+
+		return &SplitInput{
+			UniqueID:        merged.UniqueID,
+			Name:            merged.Name,
+			TypeCategory:    merged.TypeCategory,
+			RelationshipIDs: merged.RelationshipIDs,
+			ProvenanceIDs:   merged.ProvenanceIDs,
+			PropertyKeys:    keys,
+			Aliases:         aliases,
+			Reason:          fmt.Sprintf("undo merge %d", audit.ID),
+		}, nil
+	}
+
+	return nil, fmt.Errorf("entity %d was not merged by audit entry %d", mergedID, audit.ID)
+}
+
+// Aliases returns the alternative names and identifiers recorded on an entity
+func Aliases(entity *ent.DiscoveredEntity) []string {
+	switch list := entity.Properties[AliasesProperty].(type) {
+	case []string:
+		return append([]string(nil), list...)
+	case []interface{}:
+		aliases := make([]string, 0, len(list))
+		for _, alias := range list {
+			if s, ok := alias.(string); ok {
+				aliases = append(aliases, s)
+			}
+		}
+		return aliases
+	default:
+		return nil
+	}
+}
+
+// normalizeAliases removes blanks, case-insensitive duplicates and the entity's own name and ID
+func normalizeAliases(aliases []string, name, uniqueID string) []string {
+	seen := map[string]bool{
+		strings.ToLower(name):     true,
+		strings.ToLower(uniqueID): true,
+	}
+	var result []string
+	for _, alias := range aliases {
+		alias = strings.TrimSpace(alias)
+		key := strings.ToLower(alias)
+		if alias == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		result = append(result, alias)
+	}
+	return result
+}
+
+// setAliases stores aliases in a property map, dropping the key when there are none
+func setAliases(properties map[string]interface{}, aliases []string) {
+	if len(aliases) == 0 {
+		delete(properties, AliasesProperty)
+		return
+	}
+	properties[AliasesProperty] = aliases
+}
+
+// rewireRelationships points an entity's relationships at another entity. When ids is
+// non-empty only those relationships are moved. It returns the IDs of the moved relationships.
+func rewireRelationships(ctx context.Context, tx *ent.Tx, fromEntityID, toEntityID int, ids []int) ([]int, error) {
+	query := tx.Relationship.Query().
+		Where(relationship.Or(
+			relationship.And(relationship.FromTypeEQ("discovered_entity"), relationship.FromIDEQ(fromEntityID)),
+			relationship.And(relationship.ToTypeEQ("discovered_entity"), relationship.ToIDEQ(fromEntityID)),
+		))
+	if len(ids) > 0 {
+		query = query.Where(relationship.IDIn(ids...))
+	}
+	moved, err := query.IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query relationships of entity %d: %w", fromEntityID, err)
+	}
+	if len(moved) == 0 {
+		return nil, nil
+	}
+
+	if _, err := tx.Relationship.Update().
+		Where(
+			relationship.IDIn(moved...),
+			relationship.FromTypeEQ("discovered_entity"),
+			relationship.FromIDEQ(fromEntityID),
+		).
+		SetFromID(toEntityID).
+		Save(ctx); err != nil {
+		return nil, fmt.Errorf("failed to rewire outgoing relationships of entity %d: %w", fromEntityID, err)
+	}
+	if _, err := tx.Relationship.Update().
+		Where(
+			relationship.IDIn(moved...),
+			relationship.ToTypeEQ("discovered_entity"),
+			relationship.ToIDEQ(fromEntityID),
+		).
+		SetToID(toEntityID).
+		Save(ctx); err != nil {
+		return nil, fmt.Errorf("failed to rewire incoming relationships of entity %d: %w", fromEntityID, err)
+	}
+
+	return moved, nil
+}
+
+// moveProvenance reassigns an entity's provenance records. When ids is non-empty only those
+// records are moved. It returns the IDs of the moved records.
+func moveProvenance(ctx context.Context, tx *ent.Tx, fromEntityID, toEntityID int, ids []int) ([]int, error) {
+	query := tx.Provenance.Query().
+		Where(
+			provenance.SubjectTypeEQ(SubjectEntity),
+			provenance.SubjectIDEQ(fromEntityID),
+		)
+	if len(ids) > 0 {
+		query = query.Where(provenance.IDIn(ids...))
+	}
+	moved, err := query.IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query provenance of entity %d: %w", fromEntityID, err)
+	}
+	if len(moved) == 0 {
+		return nil, nil
+	}
+
+	if _, err := tx.Provenance.Update().
+		Where(provenance.IDIn(moved...)).
+		SetSubjectID(toEntityID).
+		Save(ctx); err != nil {
+		return nil, fmt.Errorf("failed to move provenance of entity %d: %w", fromEntityID, err)
+	}
+
+	return moved, nil
+}
+
+// recordAudit writes an audit entry for a curation operation
+func recordAudit(ctx context.Context, tx *ent.Tx, operation string, entityID int, relatedIDs []int, reason string, details interface{}) (*ent.EntityAudit, error) {
+	raw, err := json.Marshal(details)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal audit details: %w", err)
+	}
+	var detailsMap map[string]interface{}
+	if err := json.Unmarshal(raw, &detailsMap); err != nil {
+		return nil, fmt.Errorf("failed to marshal audit details: %w", err)
+	}
+
+	actor, _ := ctx.Value(auditActorKey{}).(string)
+
+	audit, err := tx.EntityAudit.Create().
+		SetOperation(operation).
+		SetActor(actor).
+		SetEntityID(entityID).
+		SetRelatedIds(relatedIDs).
+		SetReason(reason).
+		SetDetails(detailsMap).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to record %s audit entry: %w", operation, err)
+	}
+	return audit, nil
+}
+
+// snapshotEntity captures the fields needed to restore an entity
+func snapshotEntity(entity *ent.DiscoveredEntity) entitySnapshot {
+	return entitySnapshot{
+		ID:              entity.ID,
+		UniqueID:        entity.UniqueID,
+		TypeCategory:    entity.TypeCategory,
+		Name:            entity.Name,
+		Properties:      entity.Properties,
+		ConfidenceScore: entity.ConfidenceScore,
+	}
+}
+
+// copyProperties returns a shallow copy of a property map that is safe to modify
+func copyProperties(properties map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(properties))
+	for key, value := range properties {
+		result[key] = value
+	}
+	return result
+}
+
+// distinctIDs removes duplicates and the excluded ID, keeping the original order
+func distinctIDs(ids []int, exclude int) []int {
+	seen := map[int]bool{exclude: true}
+	var result []int
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		result = append(result, id)
+	}
+	return result
+}
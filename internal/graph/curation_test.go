@@ -0,0 +1,128 @@
+package graph
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/Blogem/enron-graph/ent"
+	"github.com/Blogem/enron-graph/ent/enttest"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAliases tests reading aliases stored as []string or decoded JSON arrays
+func TestAliases(t *testing.T) {
+	fromStrings := &ent.DiscoveredEntity{Properties: map[string]interface{}{
+		AliasesProperty: []string{"Jeffrey Skilling"},
+	}}
+	assert.Equal(t, []string{"Jeffrey Skilling"}, Aliases(fromStrings))
+
+	fromJSON := &ent.DiscoveredEntity{Properties: map[string]interface{}{
+		AliasesProperty: []interface{}{"Jeffrey Skilling", 42, "jeff.skilling@enron.com"},
+	}}
+	assert.Equal(t, []string{"Jeffrey Skilling", "jeff.skilling@enron.com"}, Aliases(fromJSON))
+
+	assert.Empty(t, Aliases(&ent.DiscoveredEntity{}))
+}
+
+// TestNormalizeAliases tests that aliases are trimmed, deduplicated and exclude the entity itself
+func TestNormalizeAliases(t *testing.T) {
+	aliases := normalizeAliases(
+		[]string{"Jeffrey Skilling", " jeffrey skilling ", "", "Jeff Skilling", "JEFF.SKILLING@ENRON.COM", "Skilling"},
+		"Jeff Skilling",
+		"jeff.skilling@enron.com",
+	)
+	assert.Equal(t, []string{"Jeffrey Skilling", "Skilling"}, aliases)
+}
+
+// TestDistinctIDs tests removing duplicates and the excluded ID
+func TestDistinctIDs(t *testing.T) {
+	assert.Equal(t, []int{3, 2}, distinctIDs([]int{3, 1, 2, 3, 1}, 1))
+	assert.Nil(t, distinctIDs([]int{1, 1}, 1))
+}
+
+// TestSplitInputFromMerge tests rebuilding a merged-away entity from the merge audit entry
+func TestSplitInputFromMerge(t *testing.T) {
+	// Details as they come back from the jsonb column
+	audit := &ent.EntityAudit{
+		ID:        7,
+		Operation: AuditMerge,
+		EntityID:  1,
+		Details: map[string]interface{}{
+			"survivor": map[string]interface{}{
+				"id":         float64(1),
+				"unique_id":  "jeff.skilling@enron.com",
+				"name":       "Jeff Skilling",
+				"properties": map[string]interface{}{"title": "CEO"},
+			},
+			"merged": []interface{}{
+				map[string]interface{}{
+					"id":            float64(2),
+					"unique_id":     "jeff.shankman@enron.com",
+					"type_category": "person",
+					"name":          "Jeff Shankman",
+					"properties": map[string]interface{}{
+						"title":         "COO",
+						"department":    "ENA",
+						AliasesProperty: []interface{}{"Shankman"},
+					},
+					"relationship_ids": []interface{}{float64(10), float64(11)},
+					"provenance_ids":   []interface{}{float64(5)},
+				},
+			},
+		},
+	}
+
+	input, err := SplitInputFromMerge(audit, 2)
+	require.NoError(t, err)
+	assert.Equal(t, "jeff.shankman@enron.com", input.UniqueID)
+	assert.Equal(t, "Jeff Shankman", input.Name)
+	assert.Equal(t, "person", input.TypeCategory)
+	assert.Equal(t, []int{10, 11}, input.RelationshipIDs)
+	assert.Equal(t, []int{5}, input.ProvenanceIDs)
+	// "title" was kept from the survivor, so only "department" moves back
+	assert.Equal(t, []string{"department"}, input.PropertyKeys)
+	assert.ElementsMatch(t, []string{"Jeff Shankman", "jeff.shankman@enron.com", "Shankman"}, input.Aliases)
+
+	_, err = SplitInputFromMerge(audit, 3)
+	assert.Error(t, err)
+
+	_, err = SplitInputFromMerge(&ent.EntityAudit{ID: 8, Operation: AuditSplit}, 2)
+	assert.Error(t, err)
+}
+
+// TestFindEntityAudits tests that merged-away entities keep the history of their merge
+func TestFindEntityAudits(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:audits?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	ctx := context.Background()
+	repo := NewRepository(client, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	var ids []int
+	for _, name := range []string{"Jeff Skilling", "Jeffrey Skilling", "Andy Fastow"} {
+		e := client.DiscoveredEntity.Create().
+			SetUniqueID(name).
+			SetName(name).
+			SetTypeCategory("person").
+			SaveX(ctx)
+		ids = append(ids, e.ID)
+	}
+
+	result, err := repo.MergeEntities(ctx, ids[0], []int{ids[1]}, "same person")
+	require.NoError(t, err)
+	assert.Equal(t, "same person", result.Audit.Reason)
+
+	for _, id := range ids[:2] {
+		audits, err := repo.FindEntityAudits(ctx, id)
+		require.NoError(t, err)
+		require.Len(t, audits, 1, "entity %d", id)
+		assert.Equal(t, result.Audit.ID, audits[0].ID)
+	}
+
+	audits, err := repo.FindEntityAudits(ctx, ids[2])
+	require.NoError(t, err)
+	assert.Empty(t, audits)
+}
@@ -0,0 +1,82 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	esql "entgo.io/ent/dialect/sql"
+	"github.com/Blogem/enron-graph/ent"
+	"github.com/Blogem/enron-graph/ent/discoveredentity"
+	"github.com/Blogem/enron-graph/ent/predicate"
+	"github.com/Blogem/enron-graph/ent/relationship"
+	"github.com/Blogem/enron-graph/ent/schemapromotion"
+)
+
+// MigrateEntityRelationships rewrites relationships that name a discovered entity by its type
+// category (e.g. person) instead of as discovered_entity, as extraction stored them before.
+// Types of promoted entities are left alone, since their IDs refer to the promoted table.
+// It returns the number of relationship ends rewritten.
+func MigrateEntityRelationships(ctx context.Context, client *ent.Client, logger *slog.Logger) (int, error) {
+	promoted, err := client.SchemaPromotion.Query().Select(schemapromotion.FieldTypeName).Strings(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to load promoted types: %w", err)
+	}
+	skip := append([]string{"discovered_entity", emailNodeType, threadNodeType, CommunityNodeType}, promoted...)
+
+	from, err := migrateEntityEnd(ctx, client, relationship.FieldFromType, relationship.FieldFromID, skip, logger)
+	if err != nil {
+		return from, err
+	}
+	to, err := migrateEntityEnd(ctx, client, relationship.FieldToType, relationship.FieldToID, skip, logger)
+	return from + to, err
+}
+
+// migrateEntityEnd rewrites one end of the relationships, where the type column holds the
+// type category of the discovered entity in the ID column
+func migrateEntityEnd(ctx context.Context, client *ent.Client, typeColumn, idColumn string, skip []string, logger *slog.Logger) (int, error) {
+	categories, err := client.Relationship.Query().
+		Where(func(s *esql.Selector) {
+			s.Where(esql.NotIn(s.C(typeColumn), stringArgs(skip)...))
+		}).
+		Unique(true).
+		Select(typeColumn).
+		Strings(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to load relationship %s values: %w", typeColumn, err)
+	}
+
+	total := 0
+	for _, category := range categories {
+		update := client.Relationship.Update().Where(
+			func(s *esql.Selector) { s.Where(esql.EQ(s.C(typeColumn), category)) },
+			entityOfCategory(idColumn, category),
+		)
+		if typeColumn == relationship.FieldFromType {
+			update.SetFromType("discovered_entity")
+		} else {
+			update.SetToType("discovered_entity")
+		}
+		n, err := update.Save(ctx)
+		if err != nil {
+			return total, fmt.Errorf("failed to migrate %s %s relationships: %w", typeColumn, category, err)
+		}
+		if n > 0 {
+			logger.Info("Migrated entity relationships", "column", typeColumn, "type_category", category, "count", n)
+		}
+		total += n
+	}
+	return total, nil
+}
+
+// entityOfCategory matches relationships whose ID column names a discovered entity of the category
+func entityOfCategory(column, category string) predicate.Relationship {
+	return func(s *esql.Selector) {
+		t := esql.Table(discoveredentity.Table)
+		s.Where(esql.In(s.C(column),
+			esql.Select(t.C(discoveredentity.FieldID)).
+				From(t).
+				Where(esql.EQ(t.C(discoveredentity.FieldTypeCategory), category)),
+		))
+	}
+}
@@ -0,0 +1,249 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	esql "entgo.io/ent/dialect/sql"
+	"github.com/Blogem/enron-graph/ent"
+	"github.com/Blogem/enron-graph/ent/discoveredentity"
+	"github.com/Blogem/enron-graph/ent/email"
+	"github.com/Blogem/enron-graph/ent/entityalias"
+	"github.com/Blogem/enron-graph/ent/entityaudit"
+	"github.com/Blogem/enron-graph/ent/extractionjob"
+	"github.com/Blogem/enron-graph/ent/predicate"
+	"github.com/Blogem/enron-graph/ent/provenance"
+	"github.com/Blogem/enron-graph/ent/relationship"
+	"github.com/lib/pq"
+)
+
+// FindEmailIDs returns the IDs of the stored emails matching the filter, oldest first
+func (r *entRepository) FindEmailIDs(ctx context.Context, filter *EmailFilter) ([]int, error) {
+	query := r.client.Email.Query()
+	if filter == nil {
+		filter = &EmailFilter{}
+	}
+
+	if !filter.Since.IsZero() {
+		query = query.Where(email.DateGTE(filter.Since))
+	}
+	if !filter.Until.IsZero() {
+		query = query.Where(email.DateLT(filter.Until))
+	}
+	if len(filter.Senders) > 0 {
+		senders := make([]string, len(filter.Senders))
+		for i, sender := range filter.Senders {
+			senders[i] = strings.ToLower(strings.TrimSpace(sender))
+		}
+		query = query.Where(func(s *esql.Selector) {
+			s.Where(esql.In(esql.Lower(s.C(email.FieldFrom)), stringArgs(senders)...))
+		})
+	}
+	if len(filter.MessageIDs) > 0 {
+		query = query.Where(email.MessageIDIn(filter.MessageIDs...))
+	}
+
+	if filter.NeverExtracted {
+		query = query.Where(email.Not(extractedEmail()))
+	}
+	if filter.OutdatedModel != "" || filter.OutdatedPromptVersion != "" {
+		query = query.Where(
+			extractedEmail(),
+			email.Not(emailHasJob(
+				extractionjob.Status(JobDone),
+				extractionjob.Model(filter.OutdatedModel),
+				extractionjob.PromptVersion(filter.OutdatedPromptVersion),
+			)),
+		)
+	}
+
+	query = query.Order(ent.Asc(email.FieldDate), ent.Asc(email.FieldID))
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+	return query.IDs(ctx)
+}
+
+// extractedEmail matches emails with a finished extraction job, or with provenance from an
+// extraction that ran before jobs were tracked
+func extractedEmail() predicate.Email {
+	return email.Or(
+		emailHasJob(extractionjob.Status(JobDone)),
+		func(s *esql.Selector) {
+			t := esql.Table(provenance.Table)
+			s.Where(esql.Exists(
+				esql.Select(t.C(provenance.FieldID)).
+					From(t).
+					Where(esql.ColumnsEQ(t.C(provenance.FieldEmailID), s.C(email.FieldID))),
+			))
+		},
+	)
+}
+
+// emailHasJob matches emails with an extraction job satisfying all predicates
+func emailHasJob(preds ...predicate.ExtractionJob) predicate.Email {
+	return func(s *esql.Selector) {
+		t := esql.Table(extractionjob.Table)
+		sub := esql.Select(t.C(extractionjob.FieldID)).
+			From(t).
+			Where(esql.ColumnsEQ(t.C(extractionjob.FieldEmailID), s.C(email.FieldID)))
+		for _, p := range preds {
+			p(sub)
+		}
+		s.Where(esql.Exists(sub))
+	}
+}
+
+func stringArgs(values []string) []interface{} {
+	args := make([]interface{}, len(values))
+	for i, v := range values {
+		args[i] = v
+	}
+	return args
+}
+
+// RequeueExtractionJobs puts the emails back in the queue of the batch for a fresh extraction,
+// resetting their attempt count. Emails without a job get one; jobs that are running are left alone.
+func (r *entRepository) RequeueExtractionJobs(ctx context.Context, emailIDs []int, model, batch string) (int, error) {
+	if len(emailIDs) == 0 {
+		return 0, nil
+	}
+	if r.db == nil {
+		return 0, fmt.Errorf("database connection not available for raw SQL queries")
+	}
+
+	result, err := r.db.ExecContext(ctx, `
+		INSERT INTO extraction_jobs (email_id, status, attempts, model, batch, prompt_version, created_at, updated_at)
+		SELECT id, $2, 0, $3, $5, '', now(), now()
+		FROM unnest($1::bigint[]) AS id
+		ON CONFLICT (email_id) DO UPDATE
+		SET status = EXCLUDED.status, attempts = 0, model = EXCLUDED.model, batch = EXCLUDED.batch,
+		    last_error = NULL, completed_at = NULL, updated_at = now()
+		WHERE extraction_jobs.status <> $4
+	`, pq.Array(emailIDs), JobPending, model, JobRunning, batch)
+	if err != nil {
+		return 0, fmt.Errorf("failed to requeue extraction jobs: %w", err)
+	}
+
+	requeued, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to count requeued jobs: %w", err)
+	}
+	return int(requeued), nil
+}
+
+// ClearEmailExtraction removes what an earlier extraction derived from an email: its header,
+// content and merge provenance, relationships no other provenance supports, and entities left
+// without provenance or relationships. Curated entities are kept. Property values the email
+// contributed to entities that survive cannot be told apart and stay in place.
+func (r *entRepository) ClearEmailExtraction(ctx context.Context, emailID int) (*ClearResult, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	records, err := tx.Provenance.Query().
+		Where(
+			provenance.EmailIDEQ(emailID),
+			provenance.SourceIn("header", "content", "merge"),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query provenance: %w", err)
+	}
+
+	result := &ClearResult{EmailID: emailID}
+	if len(records) == 0 {
+		return result, tx.Commit()
+	}
+
+	var recordIDs, relIDs, entityIDs []int
+	for _, record := range records {
+		recordIDs = append(recordIDs, record.ID)
+		switch record.SubjectType {
+		case SubjectRelationship:
+			relIDs = append(relIDs, record.SubjectID)
+		case SubjectEntity:
+			entityIDs = append(entityIDs, record.SubjectID)
+		}
+	}
+
+	if result.ProvenanceRemoved, err = tx.Provenance.Delete().Where(provenance.IDIn(recordIDs...)).Exec(ctx); err != nil {
+		return nil, fmt.Errorf("failed to delete provenance: %w", err)
+	}
+
+	for _, id := range unique(relIDs) {
+		supported, err := tx.Provenance.Query().
+			Where(provenance.SubjectTypeEQ(SubjectRelationship), provenance.SubjectIDEQ(id)).
+			Exist(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check relationship provenance: %w", err)
+		}
+		if supported {
+			continue
+		}
+		removed, err := tx.Relationship.Delete().Where(relationship.IDEQ(id)).Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to delete relationship %d: %w", id, err)
+		}
+		result.RelationshipsRemoved += removed
+	}
+
+	for _, id := range unique(entityIDs) {
+		supported, err := tx.Provenance.Query().
+			Where(provenance.SubjectTypeEQ(SubjectEntity), provenance.SubjectIDEQ(id)).
+			Exist(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check entity provenance: %w", err)
+		}
+		if supported {
+			continue
+		}
+		// Relationships stored before MigrateEntityRelationships may name the type category instead
+		linked, err := tx.Relationship.Query().
+			Where(relationship.Or(
+				relationship.And(relationship.FromTypeNotIn(emailNodeType, threadNodeType), relationship.FromIDEQ(id)),
+				relationship.And(relationship.ToTypeNotIn(emailNodeType, threadNodeType), relationship.ToIDEQ(id)),
+			)).
+			Exist(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check entity relationships: %w", err)
+		}
+		curated, err := tx.EntityAudit.Query().Where(entityaudit.EntityIDEQ(id)).Exist(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check entity audits: %w", err)
+		}
+		if linked || curated {
+			continue
+		}
+
+		if _, err := tx.EntityAlias.Delete().Where(entityalias.EntityIDEQ(id)).Exec(ctx); err != nil {
+			return nil, fmt.Errorf("failed to delete aliases of entity %d: %w", id, err)
+		}
+		removed, err := tx.DiscoveredEntity.Delete().Where(discoveredentity.IDEQ(id)).Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to delete entity %d: %w", id, err)
+		}
+		result.EntitiesRemoved += removed
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit: %w", err)
+	}
+	return result, nil
+}
+
+// unique returns ids without duplicates, keeping the first occurrence
+func unique(ids []int) []int {
+	seen := make(map[int]bool, len(ids))
+	out := ids[:0:0]
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			out = append(out, id)
+		}
+	}
+	return out
+}
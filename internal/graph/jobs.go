@@ -0,0 +1,167 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Blogem/enron-graph/ent"
+	"github.com/Blogem/enron-graph/ent/extractionjob"
+	"github.com/lib/pq"
+)
+
+// EnqueueExtractionJobs adds a pending job for every email that has none yet.
+// Emails that already have a job keep it so that re-enqueueing after a crash does
+// not redo finished work; a named batch only takes over the jobs still pending.
+func (r *entRepository) EnqueueExtractionJobs(ctx context.Context, emailIDs []int, model, batch string) (int, error) {
+	if len(emailIDs) == 0 {
+		return 0, nil
+	}
+	if r.db == nil {
+		return 0, fmt.Errorf("database connection not available for raw SQL queries")
+	}
+
+	result, err := r.db.ExecContext(ctx, `
+		INSERT INTO extraction_jobs (email_id, status, attempts, model, batch, prompt_version, created_at, updated_at)
+		SELECT id, $2, 0, $3, $4, '', now(), now()
+		FROM unnest($1::bigint[]) AS id
+		ON CONFLICT (email_id) DO UPDATE
+		SET batch = EXCLUDED.batch, updated_at = now()
+		WHERE EXCLUDED.batch <> '' AND extraction_jobs.status = $2 AND extraction_jobs.batch <> EXCLUDED.batch
+	`, pq.Array(emailIDs), JobPending, model, batch)
+	if err != nil {
+		return 0, fmt.Errorf("failed to enqueue extraction jobs: %w", err)
+	}
+
+	added, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to count enqueued jobs: %w", err)
+	}
+	return int(added), nil
+}
+
+// ClaimExtractionJobs marks up to limit pending jobs of the batch as running and
+// returns them; an empty batch claims from the whole queue. Rows locked by a
+// concurrent claim are skipped, so several processes can work the same queue
+// without handing out a job twice.
+func (r *entRepository) ClaimExtractionJobs(ctx context.Context, worker, batch string, limit int) ([]*ent.ExtractionJob, error) {
+	if limit <= 0 {
+		return nil, nil
+	}
+	if r.db == nil {
+		return nil, fmt.Errorf("database connection not available for raw SQL queries")
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		UPDATE extraction_jobs
+		SET status = $1, attempts = attempts + 1, worker = $2, claimed_at = now(), updated_at = now()
+		WHERE id IN (
+			SELECT id FROM extraction_jobs
+			WHERE status = $3 AND ($5 = '' OR batch = $5)
+			ORDER BY id
+			LIMIT $4
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id
+	`, JobRunning, worker, JobPending, limit, batch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim extraction jobs: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan claimed job: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read claimed jobs: %w", err)
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	return r.client.ExtractionJob.Query().
+		Where(extractionjob.IDIn(ids...)).
+		Order(ent.Asc(extractionjob.FieldID)).
+		All(ctx)
+}
+
+// CompleteExtractionJob marks a job as done by the given model and prompt version
+func (r *entRepository) CompleteExtractionJob(ctx context.Context, id int, model, promptVersion string) error {
+	err := r.client.ExtractionJob.UpdateOneID(id).
+		SetStatus(JobDone).
+		SetModel(model).
+		SetPromptVersion(promptVersion).
+		SetCompletedAt(time.Now()).
+		ClearLastError().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to complete extraction job %d: %w", id, err)
+	}
+	return nil
+}
+
+// FailExtractionJob records the error of an attempt. The job goes back to pending
+// until it has been attempted maxAttempts times, after which it is marked failed.
+// A maxAttempts of 0 retries without limit.
+func (r *entRepository) FailExtractionJob(ctx context.Context, id int, cause string, maxAttempts int) (*ent.ExtractionJob, error) {
+	job, err := r.client.ExtractionJob.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get extraction job %d: %w", id, err)
+	}
+
+	status := JobPending
+	if maxAttempts > 0 && job.Attempts >= maxAttempts {
+		status = JobFailed
+	}
+
+	job, err = job.Update().
+		SetStatus(status).
+		SetLastError(cause).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update extraction job %d: %w", id, err)
+	}
+	return job, nil
+}
+
+// ReleaseStaleExtractionJobs returns running jobs claimed before the cutoff to the
+// queue. Such jobs belong to a process that crashed or was killed mid-extraction.
+func (r *entRepository) ReleaseStaleExtractionJobs(ctx context.Context, claimedBefore time.Time) (int, error) {
+	released, err := r.client.ExtractionJob.Update().
+		Where(
+			extractionjob.Status(JobRunning),
+			extractionjob.ClaimedAtLT(claimedBefore),
+		).
+		SetStatus(JobPending).
+		Save(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to release stale extraction jobs: %w", err)
+	}
+	return released, nil
+}
+
+// CountExtractionJobs returns the number of jobs per status
+func (r *entRepository) CountExtractionJobs(ctx context.Context) (map[string]int, error) {
+	var rows []struct {
+		Status string `json:"status"`
+		Count  int    `json:"count"`
+	}
+	err := r.client.ExtractionJob.Query().
+		GroupBy(extractionjob.FieldStatus).
+		Aggregate(ent.Count()).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count extraction jobs: %w", err)
+	}
+
+	counts := make(map[string]int, len(rows))
+	for _, row := range rows {
+		counts[row.Status] = row.Count
+	}
+	return counts, nil
+}
@@ -2,6 +2,9 @@ package graph
 
 import (
 	"context"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/Blogem/enron-graph/ent"
 )
@@ -11,6 +14,12 @@ type MockRepository struct {
 	emails           []*ent.Email
 	entities         []*ent.DiscoveredEntity
 	relationships    []*ent.Relationship
+	provenance       []*ent.Provenance
+	aliases          []*ent.EntityAlias
+	threads          []*ent.Thread
+	threadOf         map[int]int            // email ID -> thread ID
+	replies          map[int]*ThreadMessage // email ID -> parent link
+	jobs             []*ent.ExtractionJob
 	entityTypes      []string
 	createEmailFunc  func(ctx context.Context, email *EmailInput) (*ent.Email, error)
 	createEntityFunc func(ctx context.Context, entity *EntityInput) (*ent.DiscoveredEntity, error)
@@ -24,6 +33,8 @@ func NewMockRepository() *MockRepository {
 		entities:      []*ent.DiscoveredEntity{},
 		relationships: []*ent.Relationship{},
 		entityTypes:   []string{},
+		threadOf:      map[int]int{},
+		replies:       map[int]*ThreadMessage{},
 	}
 }
 
@@ -31,20 +42,110 @@ func (m *MockRepository) CreateEmail(ctx context.Context, email *EmailInput) (*e
 	if m.createEmailFunc != nil {
 		return m.createEmailFunc(ctx, email)
 	}
-	e := &ent.Email{ID: len(m.emails) + 1}
+	e := &ent.Email{
+		ID:         len(m.emails) + 1,
+		MessageID:  email.MessageID,
+		From:       email.From,
+		To:         email.To,
+		Cc:         email.CC,
+		Subject:    email.Subject,
+		Date:       email.Date,
+		Body:       email.Body,
+		InReplyTo:  email.InReplyTo,
+		References: email.References,
+	}
 	m.emails = append(m.emails, e)
 	return e, nil
 }
 
 func (m *MockRepository) FindEmailByMessageID(ctx context.Context, messageID string) (*ent.Email, error) {
+	for _, e := range m.emails {
+		if messageID != "" && e.MessageID == messageID {
+			return e, nil
+		}
+	}
 	return nil, nil
 }
 
+func (m *MockRepository) GetEmail(ctx context.Context, id int) (*ent.Email, error) {
+	for _, e := range m.emails {
+		if e.ID == id {
+			return e, nil
+		}
+	}
+	return nil, &ent.NotFoundError{}
+}
+
+func (m *MockRepository) FindEmailIDs(ctx context.Context, filter *EmailFilter) ([]int, error) {
+	if filter == nil {
+		filter = &EmailFilter{}
+	}
+	var ids []int
+	for _, e := range m.emails {
+		if !filter.Since.IsZero() && e.Date.Before(filter.Since) {
+			continue
+		}
+		if !filter.Until.IsZero() && !e.Date.Before(filter.Until) {
+			continue
+		}
+		if len(filter.Senders) > 0 && !containsFold(filter.Senders, e.From) {
+			continue
+		}
+		if len(filter.MessageIDs) > 0 && !containsFold(filter.MessageIDs, e.MessageID) {
+			continue
+		}
+		if filter.NeverExtracted && m.extracted(e.ID) {
+			continue
+		}
+		if filter.OutdatedModel != "" || filter.OutdatedPromptVersion != "" {
+			job := m.jobForEmail(e.ID)
+			current := job != nil && job.Status == JobDone && job.Model == filter.OutdatedModel && job.PromptVersion == filter.OutdatedPromptVersion
+			if !m.extracted(e.ID) || current {
+				continue
+			}
+		}
+		ids = append(ids, e.ID)
+		if filter.Limit > 0 && len(ids) == filter.Limit {
+			break
+		}
+	}
+	return ids, nil
+}
+
+// extracted reports whether an email has a finished job or provenance
+func (m *MockRepository) extracted(emailID int) bool {
+	if job := m.jobForEmail(emailID); job != nil && job.Status == JobDone {
+		return true
+	}
+	for _, p := range m.provenance {
+		if p.EmailID == emailID {
+			return true
+		}
+	}
+	return false
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(strings.TrimSpace(v), value) {
+			return true
+		}
+	}
+	return false
+}
+
 func (m *MockRepository) CreateDiscoveredEntity(ctx context.Context, entity *EntityInput) (*ent.DiscoveredEntity, error) {
 	if m.createEntityFunc != nil {
 		return m.createEntityFunc(ctx, entity)
 	}
-	e := &ent.DiscoveredEntity{ID: len(m.entities) + 1}
+	e := &ent.DiscoveredEntity{
+		ID:              len(m.entities) + 1,
+		UniqueID:        entity.UniqueID,
+		TypeCategory:    entity.TypeCategory,
+		Name:            entity.Name,
+		Properties:      entity.Properties,
+		ConfidenceScore: entity.ConfidenceScore,
+	}
 	m.entities = append(m.entities, e)
 	return e, nil
 }
@@ -66,7 +167,18 @@ func (m *MockRepository) FindEntityByID(ctx context.Context, id int, typeHint ..
 }
 
 func (m *MockRepository) FindEntityByUniqueID(ctx context.Context, uniqueID string, typeHint ...string) (*ent.DiscoveredEntity, error) {
-	return nil, nil
+	for _, entity := range m.entities {
+		if entity.UniqueID == uniqueID {
+			return entity, nil
+		}
+	}
+	normalized := NormalizeAlias(uniqueID)
+	for _, a := range m.aliases {
+		if a.Normalized == normalized {
+			return m.FindEntityByID(ctx, a.EntityID)
+		}
+	}
+	return nil, &ent.NotFoundError{}
 }
 
 func (m *MockRepository) FindEntitiesByType(ctx context.Context, typeCategory string, typeHint ...string) ([]*ent.DiscoveredEntity, error) {
@@ -81,27 +193,410 @@ func (m *MockRepository) CreateRelationship(ctx context.Context, rel *Relationsh
 	if m.createRelFunc != nil {
 		return m.createRelFunc(ctx, rel)
 	}
-	r := &ent.Relationship{ID: len(m.relationships) + 1}
+	r := &ent.Relationship{
+		ID:              len(m.relationships) + 1,
+		Type:            rel.Type,
+		FromType:        rel.FromType,
+		FromID:          rel.FromID,
+		ToType:          rel.ToType,
+		ToID:            rel.ToID,
+		Timestamp:       rel.Timestamp,
+		ConfidenceScore: rel.ConfidenceScore,
+		Properties:      rel.Properties,
+	}
 	m.relationships = append(m.relationships, r)
 	return r, nil
 }
 
-func (m *MockRepository) FindRelationshipsByEntity(ctx context.Context, entityType string, entityID int) ([]*ent.Relationship, error) {
-	return m.relationships, nil
+func (m *MockRepository) FindRelationshipsByEntity(ctx context.Context, entityType string, entityID int, window TimeWindow) ([]*ent.Relationship, error) {
+	if window.IsZero() {
+		return m.relationships, nil
+	}
+	var results []*ent.Relationship
+	for _, rel := range m.relationships {
+		if window.Contains(rel.Timestamp) {
+			results = append(results, rel)
+		}
+	}
+	return results, nil
 }
 
 func (m *MockRepository) GetDistinctRelationshipTypes(ctx context.Context) ([]string, error) {
 	return []string{}, nil
 }
 
-func (m *MockRepository) TraverseRelationships(ctx context.Context, fromID int, relType string, depth int) ([]*ent.DiscoveredEntity, error) {
+func (m *MockRepository) TraverseRelationships(ctx context.Context, fromID int, relType string, depth int, window TimeWindow) ([]*ent.DiscoveredEntity, error) {
 	return nil, nil
 }
 
-func (m *MockRepository) FindShortestPath(ctx context.Context, fromID, toID int) ([]*ent.Relationship, error) {
+func (m *MockRepository) FindShortestPath(ctx context.Context, fromID, toID int, window TimeWindow) ([]*ent.Relationship, error) {
 	return nil, nil
 }
 
+func (m *MockRepository) FindPaths(ctx context.Context, fromID, toID int, opts PathOptions) ([]Path, error) {
+	return nil, nil
+}
+
+func (m *MockRepository) RecordProvenance(ctx context.Context, input *ProvenanceInput) (*ent.Provenance, error) {
+	p := &ent.Provenance{
+		ID:            len(m.provenance) + 1,
+		SubjectType:   input.SubjectType,
+		SubjectID:     input.SubjectID,
+		EmailID:       input.EmailID,
+		MessageID:     input.MessageID,
+		FilePath:      input.FilePath,
+		Source:        input.Source,
+		Model:         input.Model,
+		PromptVersion: input.PromptVersion,
+		ExtractedAt:   input.ExtractedAt,
+		Properties:    input.Properties,
+	}
+	m.provenance = append(m.provenance, p)
+	return p, nil
+}
+
+func (m *MockRepository) FindProvenance(ctx context.Context, subjectType string, subjectID int) ([]*ent.Provenance, error) {
+	var records []*ent.Provenance
+	for _, p := range m.provenance {
+		if p.SubjectType == subjectType && p.SubjectID == subjectID {
+			records = append(records, p)
+		}
+	}
+	return records, nil
+}
+
+func (m *MockRepository) MergeEntities(ctx context.Context, survivorID int, victimIDs []int, reason string) (*MergeResult, error) {
+	survivor, _ := m.FindEntityByID(ctx, survivorID)
+	return &MergeResult{Survivor: survivor, MergedIDs: victimIDs}, nil
+}
+
+func (m *MockRepository) SplitEntity(ctx context.Context, entityID int, input *SplitInput) (*SplitResult, error) {
+	source, _ := m.FindEntityByID(ctx, entityID)
+	created, _ := m.CreateDiscoveredEntity(ctx, &EntityInput{UniqueID: input.UniqueID, Name: input.Name, TypeCategory: input.TypeCategory})
+	return &SplitResult{Source: source, Created: created}, nil
+}
+
+func (m *MockRepository) FindEntityAudits(ctx context.Context, entityID int) ([]*ent.EntityAudit, error) {
+	return nil, nil
+}
+
+func (m *MockRepository) RecordAlias(ctx context.Context, input *AliasInput) (*ent.EntityAlias, error) {
+	normalized := NormalizeAlias(input.Alias)
+	for _, a := range m.aliases {
+		if a.EntityID == input.EntityID && a.Normalized == normalized {
+			return a, nil
+		}
+	}
+	kind := input.Kind
+	if kind == "" {
+		kind = AliasKind(input.Alias)
+	}
+	a := &ent.EntityAlias{
+		ID:         len(m.aliases) + 1,
+		EntityID:   input.EntityID,
+		Alias:      input.Alias,
+		Normalized: normalized,
+		Kind:       kind,
+		Source:     input.Source,
+	}
+	m.aliases = append(m.aliases, a)
+	return a, nil
+}
+
+func (m *MockRepository) FindAliases(ctx context.Context, entityID int) ([]*ent.EntityAlias, error) {
+	var aliases []*ent.EntityAlias
+	for _, a := range m.aliases {
+		if a.EntityID == entityID {
+			aliases = append(aliases, a)
+		}
+	}
+	return aliases, nil
+}
+
+func (m *MockRepository) FindEntitiesByAlias(ctx context.Context, alias string, typeHint ...string) ([]*ent.DiscoveredEntity, error) {
+	normalized := NormalizeAlias(alias)
+	aliased := make(map[int]bool)
+	for _, a := range m.aliases {
+		if a.Normalized == normalized {
+			aliased[a.EntityID] = true
+		}
+	}
+	var entities []*ent.DiscoveredEntity
+	for _, entity := range m.entities {
+		if len(typeHint) > 0 && typeHint[0] != "" && entity.TypeCategory != typeHint[0] {
+			continue
+		}
+		if aliased[entity.ID] || NormalizeAlias(entity.Name) == normalized || NormalizeAlias(entity.UniqueID) == normalized {
+			entities = append(entities, entity)
+		}
+	}
+	return entities, nil
+}
+
+func (m *MockRepository) ThreadEmail(ctx context.Context, input *ThreadInput) (*ent.Thread, error) {
+	if id, ok := m.threadOf[input.Email.ID]; ok {
+		return m.GetThread(ctx, id)
+	}
+	newThread := func(root *ent.Email) *ent.Thread {
+		t := &ent.Thread{
+			ID:                len(m.threads) + 1,
+			RootMessageID:     root.MessageID,
+			Subject:           input.Subject,
+			NormalizedSubject: normalizeSubject(input.Subject),
+			FirstDate:         root.Date,
+			LastDate:          root.Date,
+		}
+		m.threads = append(m.threads, t)
+		return t
+	}
+
+	t := input.Thread
+	if input.Parent != nil {
+		if id, ok := m.threadOf[input.Parent.ID]; ok {
+			t, _ = m.GetThread(ctx, id)
+		} else {
+			t = newThread(input.Parent)
+			m.threadOf[input.Parent.ID] = t.ID
+			t.MessageCount++
+		}
+		m.replies[input.Email.ID] = &ThreadMessage{
+			Email:      input.Email,
+			ParentID:   input.Parent.ID,
+			Method:     input.Method,
+			Confidence: input.Confidence,
+		}
+	}
+	if t == nil {
+		t = newThread(input.Email)
+	}
+
+	m.threadOf[input.Email.ID] = t.ID
+	t.MessageCount++
+	if input.Email.Date.After(t.LastDate) {
+		t.LastDate = input.Email.Date
+	}
+	if input.Email.Date.Before(t.FirstDate) {
+		t.FirstDate = input.Email.Date
+	}
+	return t, nil
+}
+
+func (m *MockRepository) GetThread(ctx context.Context, id int) (*ent.Thread, error) {
+	for _, t := range m.threads {
+		if t.ID == id {
+			return t, nil
+		}
+	}
+	return nil, &ent.NotFoundError{}
+}
+
+func (m *MockRepository) FindThreadByEmail(ctx context.Context, emailID int) (*ent.Thread, error) {
+	if id, ok := m.threadOf[emailID]; ok {
+		return m.GetThread(ctx, id)
+	}
+	return nil, &ent.NotFoundError{}
+}
+
+func (m *MockRepository) FindThreadBySubject(ctx context.Context, subject string, since time.Time) (*ent.Thread, error) {
+	var found *ent.Thread
+	for _, t := range m.threads {
+		if t.NormalizedSubject == normalizeSubject(subject) && !t.LastDate.Before(since) {
+			if found == nil || t.LastDate.After(found.LastDate) {
+				found = t
+			}
+		}
+	}
+	if found == nil {
+		return nil, &ent.NotFoundError{}
+	}
+	return found, nil
+}
+
+func (m *MockRepository) FindThreadEmails(ctx context.Context, threadID int) ([]*ThreadMessage, error) {
+	var messages []*ThreadMessage
+	for _, e := range m.emails {
+		if id, ok := m.threadOf[e.ID]; !ok || id != threadID {
+			continue
+		}
+		message := &ThreadMessage{Email: e}
+		if reply, ok := m.replies[e.ID]; ok {
+			message.ParentID = reply.ParentID
+			message.Method = reply.Method
+			message.Confidence = reply.Confidence
+		}
+		messages = append(messages, message)
+	}
+	sort.SliceStable(messages, func(i, j int) bool {
+		return messages[i].Email.Date.Before(messages[j].Email.Date)
+	})
+	return messages, nil
+}
+
+func (m *MockRepository) EnqueueExtractionJobs(ctx context.Context, emailIDs []int, model, batch string) (int, error) {
+	queued := make(map[int]*ent.ExtractionJob, len(m.jobs))
+	for _, job := range m.jobs {
+		queued[job.EmailID] = job
+	}
+	added := 0
+	for _, id := range emailIDs {
+		if job, ok := queued[id]; ok {
+			if batch != "" && job.Status == JobPending && job.Batch != batch {
+				job.Batch = batch
+				added++
+			}
+			continue
+		}
+		now := time.Now()
+		job := &ent.ExtractionJob{
+			ID:        len(m.jobs) + 1,
+			EmailID:   id,
+			Status:    JobPending,
+			Model:     model,
+			Batch:     batch,
+			CreatedAt: now,
+			UpdatedAt: now,
+		}
+		queued[id] = job
+		m.jobs = append(m.jobs, job)
+		added++
+	}
+	return added, nil
+}
+
+func (m *MockRepository) ClaimExtractionJobs(ctx context.Context, worker, batch string, limit int) ([]*ent.ExtractionJob, error) {
+	var claimed []*ent.ExtractionJob
+	for _, job := range m.jobs {
+		if len(claimed) >= limit {
+			break
+		}
+		if job.Status != JobPending || (batch != "" && job.Batch != batch) {
+			continue
+		}
+		now := time.Now()
+		job.Status = JobRunning
+		job.Attempts++
+		job.Worker = worker
+		job.ClaimedAt = &now
+		claimed = append(claimed, job)
+	}
+	return claimed, nil
+}
+
+func (m *MockRepository) RequeueExtractionJobs(ctx context.Context, emailIDs []int, model, batch string) (int, error) {
+	requeued := 0
+	for _, id := range emailIDs {
+		job := m.jobForEmail(id)
+		if job == nil {
+			added, _ := m.EnqueueExtractionJobs(ctx, []int{id}, model, batch)
+			requeued += added
+			continue
+		}
+		if job.Status == JobRunning {
+			continue
+		}
+		job.Status = JobPending
+		job.Attempts = 0
+		job.Model = model
+		job.Batch = batch
+		job.LastError = ""
+		job.CompletedAt = nil
+		requeued++
+	}
+	return requeued, nil
+}
+
+func (m *MockRepository) CompleteExtractionJob(ctx context.Context, id int, model, promptVersion string) error {
+	job, err := m.job(id)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	job.Status = JobDone
+	job.Model = model
+	job.PromptVersion = promptVersion
+	job.CompletedAt = &now
+	job.LastError = ""
+	return nil
+}
+
+func (m *MockRepository) FailExtractionJob(ctx context.Context, id int, cause string, maxAttempts int) (*ent.ExtractionJob, error) {
+	job, err := m.job(id)
+	if err != nil {
+		return nil, err
+	}
+	job.Status = JobPending
+	if maxAttempts > 0 && job.Attempts >= maxAttempts {
+		job.Status = JobFailed
+	}
+	job.LastError = cause
+	return job, nil
+}
+
+func (m *MockRepository) ReleaseStaleExtractionJobs(ctx context.Context, claimedBefore time.Time) (int, error) {
+	released := 0
+	for _, job := range m.jobs {
+		if job.Status == JobRunning && job.ClaimedAt != nil && job.ClaimedAt.Before(claimedBefore) {
+			job.Status = JobPending
+			released++
+		}
+	}
+	return released, nil
+}
+
+func (m *MockRepository) CountExtractionJobs(ctx context.Context) (map[string]int, error) {
+	counts := map[string]int{}
+	for _, job := range m.jobs {
+		counts[job.Status]++
+	}
+	return counts, nil
+}
+
+func (m *MockRepository) ClearEmailExtraction(ctx context.Context, emailID int) (*ClearResult, error) {
+	result := &ClearResult{EmailID: emailID}
+	relIDs := map[int]bool{}
+	var kept []*ent.Provenance
+	for _, p := range m.provenance {
+		if p.EmailID == emailID && (p.Source == "header" || p.Source == "content" || p.Source == "merge") {
+			if p.SubjectType == SubjectRelationship {
+				relIDs[p.SubjectID] = true
+			}
+			result.ProvenanceRemoved++
+			continue
+		}
+		kept = append(kept, p)
+	}
+	m.provenance = kept
+
+	var rels []*ent.Relationship
+	for _, rel := range m.relationships {
+		if relIDs[rel.ID] {
+			result.RelationshipsRemoved++
+			continue
+		}
+		rels = append(rels, rel)
+	}
+	m.relationships = rels
+	return result, nil
+}
+
+func (m *MockRepository) jobForEmail(emailID int) *ent.ExtractionJob {
+	for _, job := range m.jobs {
+		if job.EmailID == emailID {
+			return job
+		}
+	}
+	return nil
+}
+
+func (m *MockRepository) job(id int) (*ent.ExtractionJob, error) {
+	for _, job := range m.jobs {
+		if job.ID == id {
+			return job, nil
+		}
+	}
+	return nil, &ent.NotFoundError{}
+}
+
 func (m *MockRepository) SimilaritySearch(ctx context.Context, embedding []float32, topK int, threshold float64) ([]*ent.DiscoveredEntity, error) {
 	return nil, nil
 }
@@ -1,93 +1,529 @@
 package graph
 
 import (
+	"container/heap"
 	"context"
 	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/Blogem/enron-graph/ent"
+	"github.com/Blogem/enron-graph/ent/discoveredentity"
+	"github.com/Blogem/enron-graph/ent/predicate"
 	"github.com/Blogem/enron-graph/ent/relationship"
 )
 
-// PathNode represents a node in the path search
+// Path weights: what following a relationship costs
+const (
+	WeightHops       = "hops"       // Every relationship costs 1
+	WeightConfidence = "confidence" // A relationship costs 1 / its confidence, so uncertain links are avoided
+	WeightFrequency  = "frequency"  // A relationship costs 1 / the relationships between its two nodes, so frequent contacts are close
+)
+
+const (
+	// MaxPathDepth is the most relationships a path can have
+	MaxPathDepth = 10
+	// MaxPaths is the most paths a single search returns
+	MaxPaths = 10
+
+	pathBatchSize = 500  // Nodes whose relationships are loaded in one query
+	minConfidence = 0.01 // Floor of the confidence weight, so unscored relationships don't cost infinitely much
+)
+
+// PathOptions constrains path finding. The zero value finds the path with the fewest
+// relationships over all relationships. Types and filters on nodes apply to the nodes a path
+// passes through, never to its two ends. The type of a node is the type category of an
+// entity and the node type, such as email, otherwise.
+type PathOptions struct {
+	Window   TimeWindow
+	MaxDepth int    // Most relationships in a path, MaxPathDepth when 0
+	K        int    // Paths to find, cheapest first; 1 when 0
+	Weight   string // WeightHops when empty
+
+	RelationshipTypes        []string // Only follow these relationship types when set
+	ExcludeRelationshipTypes []string // Never follow these relationship types
+	EntityTypes              []string // Only pass through nodes of these types when set
+	ExcludeEntityTypes       []string // Never pass through nodes of these types
+	ExcludeEntityIDs         []int    // Never pass through these entities
+	MaxDegree                int      // Never pass through nodes with more relationships, such as mailing lists; 0 for no limit
+}
+
+// Validate checks the options are in range
+func (o PathOptions) Validate() error {
+	switch o.Weight {
+	case "", WeightHops, WeightConfidence, WeightFrequency:
+	default:
+		return fmt.Errorf("unknown weight %q, expected %s, %s or %s", o.Weight, WeightHops, WeightConfidence, WeightFrequency)
+	}
+	if o.MaxDepth < 0 || o.MaxDepth > MaxPathDepth {
+		return fmt.Errorf("max depth must be between 1 and %d", MaxPathDepth)
+	}
+	if o.K < 0 || o.K > MaxPaths {
+		return fmt.Errorf("k must be between 1 and %d", MaxPaths)
+	}
+	if o.MaxDegree < 0 {
+		return fmt.Errorf("max degree must not be negative")
+	}
+	return nil
+}
+
+func (o PathOptions) withDefaults() PathOptions {
+	if o.MaxDepth == 0 {
+		o.MaxDepth = MaxPathDepth
+	}
+	if o.K == 0 {
+		o.K = 1
+	}
+	if o.Weight == "" {
+		o.Weight = WeightHops
+	}
+	return o
+}
+
+// relationshipPredicates selects the relationships a path may follow
+func (o PathOptions) relationshipPredicates() []predicate.Relationship {
+	// Communities are not part of the graph, a shared one is no path
+	predicates := append(o.Window.Predicates(), relationship.Not(CommunityMembership()))
+	if len(o.RelationshipTypes) > 0 {
+		predicates = append(predicates, relationship.TypeIn(o.RelationshipTypes...))
+	}
+	if len(o.ExcludeRelationshipTypes) > 0 {
+		predicates = append(predicates, relationship.TypeNotIn(o.ExcludeRelationshipTypes...))
+	}
+	return predicates
+}
+
+// filtersNodeTypes reports whether passing through a node depends on its type
+func (o PathOptions) filtersNodeTypes() bool {
+	return len(o.EntityTypes) > 0 || len(o.ExcludeEntityTypes) > 0
+}
+
+// allowsNodeType reports whether a path may pass through a node of the given type
+func (o PathOptions) allowsNodeType(nodeType string) bool {
+	if len(o.EntityTypes) > 0 && !slices.Contains(o.EntityTypes, nodeType) {
+		return false
+	}
+	return !slices.Contains(o.ExcludeEntityTypes, nodeType)
+}
+
+// cost is the cost of an edge represented by rel, one of parallel relationships between its nodes
+func (o PathOptions) cost(rel *ent.Relationship, parallel int) float64 {
+	switch o.Weight {
+	case WeightConfidence:
+		return 1 / max(rel.ConfidenceScore, minConfidence)
+	case WeightFrequency:
+		return 1 / float64(parallel)
+	default:
+		return 1
+	}
+}
+
+// PathNode is a node on a path: a discovered entity, or another node relationships point to,
+// such as an email
 type PathNode struct {
-	EntityID int
-	Parent   *PathNode
-	Relation *ent.Relationship
-	Depth    int
+	Type string
+	ID   int
+}
+
+func (n PathNode) key() string {
+	return n.Type + ":" + strconv.Itoa(n.ID)
+}
+
+// Path is a chain of relationships between two nodes
+type Path struct {
+	Nodes         []PathNode          // From the source to the target
+	Relationships []*ent.Relationship // Relationships[i] joins Nodes[i] and Nodes[i+1]
+	Cost          float64
+
+	costs []float64 // Cost of every relationship
 }
 
-// findShortestPathBFS uses breadth-first search to find the shortest path
-func (r *entRepository) findShortestPathBFS(ctx context.Context, fromID, toID int) ([]*ent.Relationship, error) {
+// FindPaths finds up to opts.K simple paths between two entities, cheapest first, with Yen's
+// algorithm. Relationships are followed in either direction, and parallel relationships
+// between two nodes count as one edge. A plain shortest path search runs as a bidirectional
+// breadth-first search inside Postgres when the repository has a SQL connection.
+func (r *entRepository) FindPaths(ctx context.Context, fromID, toID int, opts PathOptions) ([]Path, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	opts = opts.withDefaults()
+
+	from := PathNode{Type: "discovered_entity", ID: fromID}
+	to := PathNode{Type: "discovered_entity", ID: toID}
 	if fromID == toID {
-		return []*ent.Relationship{}, nil
+		return []Path{{Nodes: []PathNode{from}, Relationships: []*ent.Relationship{}}}, nil
 	}
 
-	visited := make(map[int]bool)
-	queue := []*PathNode{{EntityID: fromID, Parent: nil, Relation: nil, Depth: 0}}
-	visited[fromID] = true
+	if r.db != nil && opts.K == 1 && opts.Weight == WeightHops {
+		path, err := r.findShortestPathSQL(ctx, from, to, opts)
+		if err != nil || path == nil {
+			return nil, err
+		}
+		return []Path{*path}, nil
+	}
 
-	// Maximum depth to prevent infinite loops
-	maxDepth := 10
+	g := &pathGraph{
+		client: r.client,
+		opts:   opts,
+		from:   from,
+		to:     to,
+		edges:  map[PathNode][]pathEdge{},
+	}
+	return g.kShortest(ctx)
+}
 
-	for len(queue) > 0 {
-		current := queue[0]
-		queue = queue[1:]
+// pathEdge joins a node to a neighbor through the cheapest of their parallel relationships
+type pathEdge struct {
+	to   PathNode
+	rel  *ent.Relationship
+	cost float64
+}
 
-		// Check depth limit
-		if current.Depth >= maxDepth {
-			continue
+// pathGraph is the part of the relationship graph a search has reached. The relationships of
+// nodes are loaded in batches as the search reaches them, rather than with a query per node.
+type pathGraph struct {
+	client *ent.Client
+	opts   PathOptions
+	from   PathNode
+	to     PathNode
+	edges  map[PathNode][]pathEdge // Loaded nodes; nodes a path may not pass through have no edges
+}
+
+// kShortest runs Yen's algorithm: every next path is the cheapest deviation from the paths
+// found so far, branching off at one of their nodes
+func (g *pathGraph) kShortest(ctx context.Context) ([]Path, error) {
+	first, err := g.shortest(ctx, g.from, g.opts.MaxDepth, nil, nil)
+	if err != nil || first == nil {
+		return nil, err
+	}
+
+	paths := []Path{*first}
+	found := map[string]bool{first.key(): true}
+	var candidates []Path
+	for len(paths) < g.opts.K {
+		last := paths[len(paths)-1]
+		for i := range last.Relationships {
+			spur := last.Nodes[i]
+			removedEdges := map[[2]PathNode]bool{}
+			for _, p := range paths {
+				if len(p.Nodes) > i+1 && slices.Equal(p.Nodes[:i+1], last.Nodes[:i+1]) {
+					removedEdges[edgeKey(p.Nodes[i], p.Nodes[i+1])] = true
+				}
+			}
+			removedNodes := map[PathNode]bool{}
+			for _, n := range last.Nodes[:i] {
+				removedNodes[n] = true
+			}
+
+			spurPath, err := g.shortest(ctx, spur, g.opts.MaxDepth-i, removedNodes, removedEdges)
+			if err != nil {
+				return nil, err
+			}
+			if spurPath == nil {
+				continue
+			}
+			candidate := last.prefix(i).join(*spurPath)
+			if key := candidate.key(); !found[key] {
+				found[key] = true
+				candidates = append(candidates, candidate)
+			}
+		}
+		if len(candidates) == 0 {
+			break
 		}
 
-		// Get all relationships for current entity
-		rels, err := r.client.Relationship.Query().
-			Where(
-				relationship.Or(
-					relationship.FromIDEQ(current.EntityID),
-					relationship.ToIDEQ(current.EntityID),
-				),
-			).
-			All(ctx)
+		sort.SliceStable(candidates, func(a, b int) bool {
+			if candidates[a].Cost != candidates[b].Cost {
+				return candidates[a].Cost < candidates[b].Cost
+			}
+			return len(candidates[a].Relationships) < len(candidates[b].Relationships)
+		})
+		paths = append(paths, candidates[0])
+		candidates = candidates[1:]
+	}
+	return paths, nil
+}
 
-		if err != nil {
-			return nil, fmt.Errorf("failed to query relationships: %w", err)
+// pathLabel is a way of reaching a node: its cost, number of relationships and the label
+// it was reached from
+type pathLabel struct {
+	node   PathNode
+	hops   int
+	cost   float64
+	edge   pathEdge
+	parent *pathLabel
+}
+
+// visits reports whether the labelled path passes through node
+func (l *pathLabel) visits(node PathNode) bool {
+	for ; l != nil; l = l.parent {
+		if l.node == node {
+			return true
 		}
+	}
+	return false
+}
 
-		// Explore each relationship
-		for _, rel := range rels {
-			var neighborID int
+// labelQueue orders labels by cost, then by relationships
+type labelQueue []*pathLabel
 
-			// Determine neighbor ID
-			if rel.FromID == current.EntityID {
-				neighborID = rel.ToID
-			} else {
-				neighborID = rel.FromID
-			}
+func (q labelQueue) Len() int { return len(q) }
+func (q labelQueue) Less(i, j int) bool {
+	if q[i].cost != q[j].cost {
+		return q[i].cost < q[j].cost
+	}
+	return q[i].hops < q[j].hops
+}
+func (q labelQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+func (q *labelQueue) Push(x any)   { *q = append(*q, x.(*pathLabel)) }
+func (q *labelQueue) Pop() any {
+	old := *q
+	l := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return l
+}
 
-			// Check if we found the target
-			if neighborID == toID {
-				// Reconstruct path
-				path := []*ent.Relationship{rel}
-				node := current
-				for node.Parent != nil {
-					path = append([]*ent.Relationship{node.Relation}, path...)
-					node = node.Parent
-				}
-				return path, nil
+// shortest finds the cheapest path of at most maxDepth relationships from a node to the
+// target, avoiding the removed nodes and edges. Labels are kept per node and number of
+// relationships, so a cheap path that is too long does not hide a dearer one that fits.
+// It returns nil when there is no such path.
+func (g *pathGraph) shortest(ctx context.Context, from PathNode, maxDepth int, removedNodes map[PathNode]bool, removedEdges map[[2]PathNode]bool) (*Path, error) {
+	queue := &labelQueue{{node: from}}
+	reached := map[PathNode][]*pathLabel{}
+	for queue.Len() > 0 {
+		label := heap.Pop(queue).(*pathLabel)
+		if label.node == g.to {
+			return label.path(), nil
+		}
+		if label.hops == maxDepth {
+			continue
+		}
+
+		edges, err := g.expand(ctx, label.node, *queue)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range edges {
+			if removedNodes[e.to] || removedEdges[edgeKey(label.node, e.to)] || label.visits(e.to) {
+				continue
 			}
+			next := &pathLabel{node: e.to, hops: label.hops + 1, cost: label.cost + e.cost, edge: e, parent: label}
+			if dominated(reached[e.to], next) {
+				continue
+			}
+			reached[e.to] = append(reached[e.to], next)
+			heap.Push(queue, next)
+		}
+	}
+	return nil, nil
+}
 
-			// Add to queue if not visited
-			if !visited[neighborID] {
-				visited[neighborID] = true
-				queue = append(queue, &PathNode{
-					EntityID: neighborID,
-					Parent:   current,
-					Relation: rel,
-					Depth:    current.Depth + 1,
-				})
+// dominated reports whether a label reaches its node no cheaper and in no fewer relationships
+// than one already reached
+func dominated(reached []*pathLabel, l *pathLabel) bool {
+	for _, other := range reached {
+		if other.hops <= l.hops && other.cost <= l.cost {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *pathLabel) path() *Path {
+	p := &Path{Cost: l.cost}
+	for ; l.parent != nil; l = l.parent {
+		p.Nodes = append(p.Nodes, l.node)
+		p.Relationships = append(p.Relationships, l.edge.rel)
+		p.costs = append(p.costs, l.edge.cost)
+	}
+	p.Nodes = append(p.Nodes, l.node)
+	slices.Reverse(p.Nodes)
+	slices.Reverse(p.Relationships)
+	slices.Reverse(p.costs)
+	return p
+}
+
+// expand returns the edges of a node. An unloaded node is loaded together with the other
+// unloaded nodes waiting in the queue.
+func (g *pathGraph) expand(ctx context.Context, node PathNode, queue labelQueue) ([]pathEdge, error) {
+	if edges, ok := g.edges[node]; ok {
+		return edges, nil
+	}
+	batch := map[PathNode]bool{node: true}
+	for _, l := range queue {
+		if len(batch) == pathBatchSize {
+			break
+		}
+		// The target is never passed through, so its relationships are not needed
+		if _, ok := g.edges[l.node]; !ok && l.node != g.to {
+			batch[l.node] = true
+		}
+	}
+	if err := g.load(ctx, batch); err != nil {
+		return nil, err
+	}
+	return g.edges[node], nil
+}
+
+// load reads the relationships of a batch of nodes in one query per node type
+func (g *pathGraph) load(ctx context.Context, batch map[PathNode]bool) error {
+	idsByType := map[string][]int{}
+	for n := range batch {
+		idsByType[n.Type] = append(idsByType[n.Type], n.ID)
+	}
+
+	rels := map[PathNode][]*ent.Relationship{}
+	for nodeType, ids := range idsByType {
+		found, err := g.client.Relationship.Query().
+			Where(relationship.Or(
+				relationship.And(relationship.FromTypeEQ(nodeType), relationship.FromIDIn(ids...)),
+				relationship.And(relationship.ToTypeEQ(nodeType), relationship.ToIDIn(ids...)),
+			)).
+			Where(g.opts.relationshipPredicates()...).
+			Order(ent.Asc(relationship.FieldID)).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to query relationships: %w", err)
+		}
+		for _, rel := range found {
+			from := PathNode{Type: rel.FromType, ID: rel.FromID}
+			to := PathNode{Type: rel.ToType, ID: rel.ToID}
+			if from == to {
+				continue
+			}
+			if batch[from] {
+				rels[from] = append(rels[from], rel)
 			}
+			if batch[to] {
+				rels[to] = append(rels[to], rel)
+			}
+		}
+	}
+
+	blocked, err := g.blocked(ctx, batch, rels)
+	if err != nil {
+		return err
+	}
+	for n := range batch {
+		if blocked[n] {
+			g.edges[n] = nil
+			continue
 		}
+		g.edges[n] = g.collapse(n, rels[n])
+	}
+	return nil
+}
+
+// blocked returns the nodes of a batch a path may not pass through
+func (g *pathGraph) blocked(ctx context.Context, batch map[PathNode]bool, rels map[PathNode][]*ent.Relationship) (map[PathNode]bool, error) {
+	blocked := map[PathNode]bool{}
+	var entityIDs []int
+	for n := range batch {
+		if n == g.from || n == g.to {
+			continue
+		}
+		if g.opts.MaxDegree > 0 && len(rels[n]) > g.opts.MaxDegree {
+			blocked[n] = true
+			continue
+		}
+		if n.Type != "discovered_entity" {
+			blocked[n] = !g.opts.allowsNodeType(n.Type)
+			continue
+		}
+		if slices.Contains(g.opts.ExcludeEntityIDs, n.ID) {
+			blocked[n] = true
+		} else if g.opts.filtersNodeTypes() {
+			// The type of an entity is its type category, looked up below
+			entityIDs = append(entityIDs, n.ID)
+		}
+	}
+	if len(entityIDs) == 0 {
+		return blocked, nil
 	}
 
-	// No path found
-	return nil, fmt.Errorf("no path found between entities %d and %d", fromID, toID)
+	var entities []struct {
+		ID           int    `json:"id"`
+		TypeCategory string `json:"type_category"`
+	}
+	if err := g.client.DiscoveredEntity.Query().
+		Where(discoveredentity.IDIn(entityIDs...)).
+		Select(discoveredentity.FieldID, discoveredentity.FieldTypeCategory).
+		Scan(ctx, &entities); err != nil {
+		return nil, fmt.Errorf("failed to query entity types: %w", err)
+	}
+	for _, e := range entities {
+		if !g.opts.allowsNodeType(e.TypeCategory) {
+			blocked[PathNode{Type: "discovered_entity", ID: e.ID}] = true
+		}
+	}
+	return blocked, nil
+}
+
+// collapse turns the relationships of a node into one edge per neighbor, represented by the
+// most confident relationship
+func (g *pathGraph) collapse(node PathNode, rels []*ent.Relationship) []pathEdge {
+	index := map[PathNode]int{}
+	var edges []pathEdge
+	var parallel []int
+	for _, rel := range rels {
+		neighbor := PathNode{Type: rel.ToType, ID: rel.ToID}
+		if neighbor == node {
+			neighbor = PathNode{Type: rel.FromType, ID: rel.FromID}
+		}
+		i, ok := index[neighbor]
+		if !ok {
+			index[neighbor] = len(edges)
+			edges = append(edges, pathEdge{to: neighbor, rel: rel})
+			parallel = append(parallel, 1)
+			continue
+		}
+		parallel[i]++
+		if rel.ConfidenceScore > edges[i].rel.ConfidenceScore {
+			edges[i].rel = rel
+		}
+	}
+	for i := range edges {
+		edges[i].cost = g.opts.cost(edges[i].rel, parallel[i])
+	}
+	return edges
+}
+
+// prefix returns the first n relationships of the path
+func (p Path) prefix(n int) Path {
+	prefix := Path{
+		Nodes:         append([]PathNode(nil), p.Nodes[:n+1]...),
+		Relationships: append([]*ent.Relationship(nil), p.Relationships[:n]...),
+		costs:         append([]float64(nil), p.costs[:n]...),
+	}
+	for _, c := range prefix.costs {
+		prefix.Cost += c
+	}
+	return prefix
+}
+
+// join continues the path with one that starts at its last node
+func (p Path) join(next Path) Path {
+	p.Nodes = append(p.Nodes, next.Nodes[1:]...)
+	p.Relationships = append(p.Relationships, next.Relationships...)
+	p.costs = append(p.costs, next.costs...)
+	p.Cost += next.Cost
+	return p
+}
+
+func (p Path) key() string {
+	keys := make([]string, len(p.Nodes))
+	for i, n := range p.Nodes {
+		keys[i] = n.key()
+	}
+	return strings.Join(keys, ",")
+}
+
+// edgeKey identifies the edge between two nodes regardless of direction
+func edgeKey(a, b PathNode) [2]PathNode {
+	if b.Type < a.Type || (b.Type == a.Type && b.ID < a.ID) {
+		a, b = b, a
+	}
+	return [2]PathNode{a, b}
 }
@@ -0,0 +1,260 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/Blogem/enron-graph/ent"
+	"github.com/Blogem/enron-graph/ent/predicate"
+	"github.com/Blogem/enron-graph/ent/relationship"
+	"github.com/lib/pq"
+)
+
+// findShortestPathSQL finds a path with the fewest relationships with a bidirectional
+// breadth-first search that runs inside Postgres as one recursive query. Nodes are keyed as
+// type:id. Every step of the query expands the smaller of the two frontiers by one level and
+// the search stops when the sides meet, so only the levels are returned; the path is rebuilt
+// from them with one query per relationship. It returns nil when there is no path.
+func (r *entRepository) findShortestPathSQL(ctx context.Context, from, to PathNode, opts PathOptions) (*Path, error) {
+	var args sqlArgs
+	source := args.add(from.key())
+	target := args.add(to.key())
+	maxDepth := args.add(opts.MaxDepth)
+	relationships := pathRelationshipFilter("r", opts, &args)
+	passable := pathNodeFilter(opts, &args)
+
+	query := fmt.Sprintf(`
+		WITH RECURSIVE bfs(step, side, source_frontier, source_seen, target_frontier, target_seen) AS (
+			SELECT 0, ''::text, ARRAY[%[1]s::text], ARRAY[%[1]s::text], ARRAY[%[2]s::text], ARRAY[%[2]s::text]
+		UNION ALL
+			SELECT b.step + 1,
+				CASE WHEN side.from_source THEN 'source' ELSE 'target' END,
+				CASE WHEN side.from_source THEN expanded.frontier ELSE b.source_frontier END,
+				CASE WHEN side.from_source THEN b.source_seen || expanded.frontier ELSE b.source_seen END,
+				CASE WHEN side.from_source THEN b.target_frontier ELSE expanded.frontier END,
+				CASE WHEN side.from_source THEN b.target_seen ELSE b.target_seen || expanded.frontier END
+			FROM bfs b
+			CROSS JOIN LATERAL (
+				SELECT cardinality(b.source_frontier) <= cardinality(b.target_frontier) AS from_source
+			) side
+			CROSS JOIN LATERAL (
+				SELECT array_agg(DISTINCT n.type || ':' || n.id) AS frontier
+				FROM unnest(CASE WHEN side.from_source THEN b.source_frontier ELSE b.target_frontier END) AS f(node)
+				CROSS JOIN LATERAL (
+					SELECT split_part(f.node, ':', 1) AS type, split_part(f.node, ':', 2)::bigint AS id
+				) cur
+				JOIN relationships r
+					ON (r.from_type = cur.type AND r.from_id = cur.id)
+					OR (r.to_type = cur.type AND r.to_id = cur.id)
+				CROSS JOIN LATERAL (
+					SELECT
+						CASE WHEN r.from_type = cur.type AND r.from_id = cur.id THEN r.to_type ELSE r.from_type END AS type,
+						CASE WHEN r.from_type = cur.type AND r.from_id = cur.id THEN r.to_id ELSE r.from_id END AS id
+				) n
+				WHERE %[4]s
+				  AND n.type || ':' || n.id <> ALL(CASE WHEN side.from_source THEN b.source_seen ELSE b.target_seen END)
+				  AND (n.type || ':' || n.id = ANY(CASE WHEN side.from_source THEN b.target_seen ELSE b.source_seen END) OR (%[5]s))
+			) expanded
+			WHERE b.step < %[3]s
+			  AND NOT (b.source_seen && b.target_seen)
+			  AND expanded.frontier IS NOT NULL
+		)
+		SELECT side, source_frontier, target_frontier FROM bfs ORDER BY step
+	`, source, target, maxDepth, relationships, passable)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search path: %w", err)
+	}
+	defer rows.Close()
+
+	// Levels of each side by distance from its end
+	var sourceLevels, targetLevels [][]string
+	for rows.Next() {
+		var side string
+		var sourceFrontier, targetFrontier []string
+		if err := rows.Scan(&side, pq.Array(&sourceFrontier), pq.Array(&targetFrontier)); err != nil {
+			return nil, fmt.Errorf("failed to scan path search: %w", err)
+		}
+		switch side {
+		case "":
+			sourceLevels = append(sourceLevels, sourceFrontier)
+			targetLevels = append(targetLevels, targetFrontier)
+		case "source":
+			sourceLevels = append(sourceLevels, sourceFrontier)
+		case "target":
+			targetLevels = append(targetLevels, targetFrontier)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read path search: %w", err)
+	}
+
+	sourceDistance, targetDistance := levelIndex(sourceLevels), levelIndex(targetLevels)
+	meeting, best := "", -1
+	for node, b := range targetDistance {
+		if a, ok := sourceDistance[node]; ok && (best < 0 || a+b < best || (a+b == best && node < meeting)) {
+			meeting, best = node, a+b
+		}
+	}
+	if best < 0 {
+		return nil, nil
+	}
+
+	// Walk back from where the sides met to either end
+	middle, err := parseNodeKey(meeting)
+	if err != nil {
+		return nil, err
+	}
+	toSource, err := r.walkLevels(ctx, middle, sourceLevels[:sourceDistance[meeting]], opts)
+	if err != nil {
+		return nil, err
+	}
+	toTarget, err := r.walkLevels(ctx, middle, targetLevels[:targetDistance[meeting]], opts)
+	if err != nil {
+		return nil, err
+	}
+	slices.Reverse(toSource.Nodes)
+	slices.Reverse(toSource.Relationships)
+
+	path := &Path{
+		Nodes:         append(append(toSource.Nodes, middle), toTarget.Nodes...),
+		Relationships: append(toSource.Relationships, toTarget.Relationships...),
+		Cost:          float64(best),
+	}
+	path.costs = make([]float64, len(path.Relationships))
+	for i := range path.costs {
+		path.costs[i] = 1
+	}
+	return path, nil
+}
+
+// walkLevels follows the levels from a node down to level 0, choosing the most confident
+// relationship into each level. The path runs from the node, which it leaves out, to level 0.
+func (r *entRepository) walkLevels(ctx context.Context, current PathNode, levels [][]string, opts PathOptions) (*Path, error) {
+	path := &Path{}
+	for level := len(levels) - 1; level >= 0; level-- {
+		idsByType := map[string][]int{}
+		for _, key := range levels[level] {
+			n, err := parseNodeKey(key)
+			if err != nil {
+				return nil, err
+			}
+			idsByType[n.Type] = append(idsByType[n.Type], n.ID)
+		}
+		var links []predicate.Relationship
+		for nodeType, ids := range idsByType {
+			links = append(links,
+				relationship.And(
+					relationship.FromTypeEQ(current.Type), relationship.FromIDEQ(current.ID),
+					relationship.ToTypeEQ(nodeType), relationship.ToIDIn(ids...),
+				),
+				relationship.And(
+					relationship.ToTypeEQ(current.Type), relationship.ToIDEQ(current.ID),
+					relationship.FromTypeEQ(nodeType), relationship.FromIDIn(ids...),
+				),
+			)
+		}
+		rel, err := r.client.Relationship.Query().
+			Where(relationship.Or(links...)).
+			Where(opts.relationshipPredicates()...).
+			Order(ent.Desc(relationship.FieldConfidenceScore), ent.Asc(relationship.FieldID)).
+			First(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to rebuild path: %w", err)
+		}
+
+		next := PathNode{Type: rel.ToType, ID: rel.ToID}
+		if next == current {
+			next = PathNode{Type: rel.FromType, ID: rel.FromID}
+		}
+		path.Nodes = append(path.Nodes, next)
+		path.Relationships = append(path.Relationships, rel)
+		current = next
+	}
+	return path, nil
+}
+
+// pathRelationshipFilter is the SQL condition on the relationships a path may follow, for the
+// relationships table under alias
+func pathRelationshipFilter(alias string, opts PathOptions, args *sqlArgs) string {
+	// Communities are not part of the graph, a shared one is no path
+	conditions := []string{fmt.Sprintf("NOT (%s.type = %s AND %s.to_type = %s)",
+		alias, args.add(RelationshipMemberOf), alias, args.add(CommunityNodeType))}
+	if !opts.Window.Since.IsZero() {
+		conditions = append(conditions, fmt.Sprintf(`%s."timestamp" >= %s`, alias, args.add(opts.Window.Since)))
+	}
+	if !opts.Window.Until.IsZero() {
+		conditions = append(conditions, fmt.Sprintf(`%s."timestamp" < %s`, alias, args.add(opts.Window.Until)))
+	}
+	if len(opts.RelationshipTypes) > 0 {
+		conditions = append(conditions, fmt.Sprintf("%s.type = ANY(%s)", alias, args.add(pq.Array(opts.RelationshipTypes))))
+	}
+	if len(opts.ExcludeRelationshipTypes) > 0 {
+		conditions = append(conditions, fmt.Sprintf("%s.type <> ALL(%s)", alias, args.add(pq.Array(opts.ExcludeRelationshipTypes))))
+	}
+	return strings.Join(conditions, " AND ")
+}
+
+// pathNodeFilter is the SQL condition on the nodes n a path may pass through
+func pathNodeFilter(opts PathOptions, args *sqlArgs) string {
+	var conditions []string
+	if len(opts.ExcludeEntityIDs) > 0 {
+		conditions = append(conditions, fmt.Sprintf("NOT (n.type = 'discovered_entity' AND n.id = ANY(%s))", args.add(pq.Array(opts.ExcludeEntityIDs))))
+	}
+	nodeType := "COALESCE((SELECT e.type_category FROM discovered_entities e WHERE n.type = 'discovered_entity' AND e.id = n.id), n.type)"
+	if len(opts.EntityTypes) > 0 {
+		conditions = append(conditions, fmt.Sprintf("%s = ANY(%s)", nodeType, args.add(pq.Array(opts.EntityTypes))))
+	}
+	if len(opts.ExcludeEntityTypes) > 0 {
+		conditions = append(conditions, fmt.Sprintf("%s <> ALL(%s)", nodeType, args.add(pq.Array(opts.ExcludeEntityTypes))))
+	}
+	if opts.MaxDegree > 0 {
+		conditions = append(conditions, fmt.Sprintf(`(
+			SELECT count(*) FROM relationships d
+			WHERE ((d.from_type = n.type AND d.from_id = n.id) OR (d.to_type = n.type AND d.to_id = n.id))
+			  AND %s
+		) <= %s`, pathRelationshipFilter("d", opts, args), args.add(opts.MaxDegree)))
+	}
+	if len(conditions) == 0 {
+		return "true"
+	}
+	return strings.Join(conditions, " AND ")
+}
+
+// sqlArgs collects the arguments of a query as their placeholders are written
+type sqlArgs []any
+
+func (a *sqlArgs) add(value any) string {
+	*a = append(*a, value)
+	return fmt.Sprintf("$%d", len(*a))
+}
+
+// levelIndex returns the level every node was first reached at
+func levelIndex(levels [][]string) map[string]int {
+	index := map[string]int{}
+	for level, nodes := range levels {
+		for _, node := range nodes {
+			if _, ok := index[node]; !ok {
+				index[node] = level
+			}
+		}
+	}
+	return index
+}
+
+// parseNodeKey parses a node keyed as type:id
+func parseNodeKey(key string) (PathNode, error) {
+	i := strings.LastIndex(key, ":")
+	if i < 0 {
+		return PathNode{}, fmt.Errorf("invalid node %q", key)
+	}
+	id, err := strconv.Atoi(key[i+1:])
+	if err != nil {
+		return PathNode{}, fmt.Errorf("invalid node %q: %w", key, err)
+	}
+	return PathNode{Type: key[:i], ID: id}, nil
+}
@@ -0,0 +1,311 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/Blogem/enron-graph/ent"
+	"github.com/Blogem/enron-graph/ent/enttest"
+	"github.com/Blogem/enron-graph/ent/relationship"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pathFixture connects two executives, ken and jeff, in three ways:
+//   - through a mailing list with ten members, in November 2001 and with low confidence
+//   - through two colleagues, c1 and c2, who email each of their neighbors four times
+//   - through an email ken sent to andy, who knows bob, who knows jeff
+type pathFixture struct {
+	client *ent.Client
+	repo   Repository
+	nodes  map[string]PathNode
+	entity func(name, typeCategory string)
+	link   func(relType, from, to string, confidence float64, at time.Time, times int)
+}
+
+func newPathFixture(t *testing.T) *pathFixture {
+	client := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()))
+	t.Cleanup(func() { client.Close() })
+	ctx := context.Background()
+
+	f := &pathFixture{
+		client: client,
+		repo:   NewRepository(client, slog.New(slog.NewTextHandler(io.Discard, nil))),
+		nodes:  map[string]PathNode{},
+	}
+	f.entity = func(name, typeCategory string) {
+		e := client.DiscoveredEntity.Create().
+			SetUniqueID(name + "@enron.com").
+			SetName(name).
+			SetTypeCategory(typeCategory).
+			SaveX(ctx)
+		f.nodes[name] = PathNode{Type: "discovered_entity", ID: e.ID}
+	}
+	for _, name := range []string{"ken", "jeff", "c1", "c2", "andy", "bob"} {
+		f.entity(name, "person")
+	}
+	f.entity("list", "mailing_list")
+	// The email shares its ID with jeff, which must not make ken and jeff neighbors
+	f.nodes["email"] = PathNode{Type: "email", ID: f.nodes["jeff"].ID}
+
+	may := time.Date(2001, 5, 1, 0, 0, 0, 0, time.UTC)
+	november := time.Date(2001, 11, 15, 0, 0, 0, 0, time.UTC)
+	f.link = func(relType, from, to string, confidence float64, at time.Time, times int) {
+		for i := 0; i < times; i++ {
+			client.Relationship.Create().
+				SetType(relType).
+				SetFromType(f.nodes[from].Type).
+				SetFromID(f.nodes[from].ID).
+				SetToType(f.nodes[to].Type).
+				SetToID(f.nodes[to].ID).
+				SetConfidenceScore(confidence).
+				SetTimestamp(at).
+				SaveX(ctx)
+		}
+	}
+	f.link("COMMUNICATES_WITH", "ken", "list", 0.3, november, 1)
+	f.link("COMMUNICATES_WITH", "list", "jeff", 0.3, november, 1)
+	for i := 0; i < 8; i++ {
+		member := fmt.Sprintf("member%d", i)
+		f.entity(member, "person")
+		f.link("COMMUNICATES_WITH", "list", member, 0.3, november, 1)
+	}
+	f.link("COMMUNICATES_WITH", "ken", "c1", 1, may, 4)
+	f.link("COMMUNICATES_WITH", "c1", "c2", 1, may, 4)
+	f.link("COMMUNICATES_WITH", "c2", "jeff", 1, may, 4)
+	f.link("SENT", "ken", "email", 1, may, 1)
+	f.link("RECEIVED", "email", "andy", 1, may, 1)
+	f.link("COMMUNICATES_WITH", "andy", "bob", 1, may, 1)
+	f.link("COMMUNICATES_WITH", "bob", "jeff", 1, may, 1)
+	return f
+}
+
+// find returns the paths from ken to jeff as node names
+func (f *pathFixture) find(t *testing.T, opts PathOptions) [][]string {
+	t.Helper()
+	paths, err := f.repo.FindPaths(context.Background(), f.nodes["ken"].ID, f.nodes["jeff"].ID, opts)
+	require.NoError(t, err)
+
+	names := map[PathNode]string{}
+	for name, node := range f.nodes {
+		names[node] = name
+	}
+	var result [][]string
+	for _, p := range paths {
+		require.Len(t, p.Relationships, len(p.Nodes)-1)
+		var route []string
+		for _, n := range p.Nodes {
+			route = append(route, names[n])
+		}
+		result = append(result, route)
+	}
+	return result
+}
+
+var (
+	viaList       = []string{"ken", "list", "jeff"}
+	viaColleagues = []string{"ken", "c1", "c2", "jeff"}
+	viaEmail      = []string{"ken", "email", "andy", "bob", "jeff"}
+)
+
+func TestFindPaths_Shortest(t *testing.T) {
+	f := newPathFixture(t)
+	assert.Equal(t, [][]string{viaList}, f.find(t, PathOptions{}))
+
+	rels, err := f.repo.FindShortestPath(context.Background(), f.nodes["ken"].ID, f.nodes["jeff"].ID, TimeWindow{})
+	require.NoError(t, err)
+	assert.Len(t, rels, 2)
+
+	rels, err = f.repo.FindShortestPath(context.Background(), f.nodes["ken"].ID, f.nodes["ken"].ID, TimeWindow{})
+	require.NoError(t, err)
+	assert.Empty(t, rels)
+}
+
+func TestFindPaths_AvoidsHubs(t *testing.T) {
+	f := newPathFixture(t)
+	testCases := []struct {
+		name string
+		opts PathOptions
+	}{
+		{"max degree", PathOptions{MaxDegree: 9}},
+		{"excluded entity", PathOptions{ExcludeEntityIDs: []int{f.nodes["list"].ID}}},
+		{"excluded entity type", PathOptions{ExcludeEntityTypes: []string{"mailing_list"}}},
+		{"allowed entity types", PathOptions{EntityTypes: []string{"person"}}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, [][]string{viaColleagues}, f.find(t, tc.opts))
+		})
+	}
+
+	// The colleagues have 8 relationships each, so a lower limit leaves only the email
+	assert.Equal(t, [][]string{viaEmail}, f.find(t, PathOptions{MaxDegree: 4}))
+	// Emails are not people
+	assert.Empty(t, f.find(t, PathOptions{EntityTypes: []string{"person"}, MaxDegree: 4}))
+}
+
+func TestFindPaths_KShortest(t *testing.T) {
+	f := newPathFixture(t)
+	assert.Equal(t, [][]string{viaList, viaColleagues, viaEmail}, f.find(t, PathOptions{K: 5}))
+
+	paths, err := f.repo.FindPaths(context.Background(), f.nodes["ken"].ID, f.nodes["jeff"].ID, PathOptions{K: 3})
+	require.NoError(t, err)
+	require.Len(t, paths, 3)
+	for i, cost := range []float64{2, 3, 4} {
+		assert.Equal(t, cost, paths[i].Cost)
+	}
+}
+
+func TestFindPaths_Weights(t *testing.T) {
+	f := newPathFixture(t)
+
+	// The list's relationships cost 1 / 0.3 each
+	assert.Equal(t, [][]string{viaColleagues, viaEmail, viaList}, f.find(t, PathOptions{K: 3, Weight: WeightConfidence}))
+
+	// Four emails between every pair of colleagues make each step cost 1/4
+	paths, err := f.repo.FindPaths(context.Background(), f.nodes["ken"].ID, f.nodes["jeff"].ID, PathOptions{Weight: WeightFrequency})
+	require.NoError(t, err)
+	require.Len(t, paths, 1)
+	assert.Equal(t, 0.75, paths[0].Cost)
+	assert.Len(t, paths[0].Relationships, 3)
+}
+
+func TestFindPaths_RelationshipTypes(t *testing.T) {
+	f := newPathFixture(t)
+	assert.Equal(t, [][]string{viaList, viaColleagues}, f.find(t, PathOptions{K: 5, RelationshipTypes: []string{"COMMUNICATES_WITH"}}))
+	assert.Equal(t, [][]string{viaList, viaColleagues}, f.find(t, PathOptions{K: 5, ExcludeRelationshipTypes: []string{"SENT"}}))
+	assert.Empty(t, f.find(t, PathOptions{ExcludeRelationshipTypes: []string{"COMMUNICATES_WITH"}}))
+}
+
+func TestFindPaths_MemberOf(t *testing.T) {
+	f := newPathFixture(t)
+	may := time.Date(2001, 5, 1, 0, 0, 0, 0, time.UTC)
+	memberOf := PathOptions{K: 5, RelationshipTypes: []string{RelationshipMemberOf}}
+
+	// A shared community is no path
+	f.nodes["community"] = PathNode{Type: CommunityNodeType, ID: 1}
+	f.link(RelationshipMemberOf, "ken", "community", 1, may, 1)
+	f.link(RelationshipMemberOf, "jeff", "community", 1, may, 1)
+	assert.Empty(t, f.find(t, memberOf))
+
+	// Extracted MEMBER_OF facts are followed like any other relationship
+	f.link(RelationshipMemberOf, "ken", "list", 1, may, 1)
+	f.link(RelationshipMemberOf, "jeff", "list", 1, may, 1)
+	assert.Equal(t, [][]string{viaList}, f.find(t, memberOf))
+}
+
+func TestFindPaths_EntityTypeCategories(t *testing.T) {
+	f := newPathFixture(t)
+	ctx := context.Background()
+	may := time.Date(2001, 5, 1, 0, 0, 0, 0, time.UTC)
+	worksFor := PathOptions{K: 5, RelationshipTypes: []string{"WORKS_FOR"}}
+	viaEnron := []string{"ken", "enron", "jeff"}
+
+	// Extraction used to store the type categories of both ends
+	f.entity("enron", "organization")
+	legacy := func(from, to string) {
+		f.client.Relationship.Create().
+			SetType("WORKS_FOR").
+			SetFromType("person").
+			SetFromID(f.nodes[from].ID).
+			SetToType("organization").
+			SetToID(f.nodes[to].ID).
+			SetConfidenceScore(1).
+			SetTimestamp(may).
+			SaveX(ctx)
+	}
+	legacy("ken", "enron")
+	legacy("jeff", "enron")
+	assert.Empty(t, f.find(t, worksFor))
+
+	// Relationships of promoted types refer to the promoted table and are left alone
+	f.client.SchemaPromotion.Create().
+		SetTypeName("mailing_list").
+		SetPromotedAt(may).
+		SetEntitiesAffected(1).
+		SaveX(ctx)
+	f.client.Relationship.Create().
+		SetType("WORKS_FOR").
+		SetFromType("mailing_list").
+		SetFromID(f.nodes["list"].ID).
+		SetToType("organization").
+		SetToID(f.nodes["enron"].ID).
+		SetConfidenceScore(1).
+		SetTimestamp(may).
+		SaveX(ctx)
+
+	migrated, err := MigrateEntityRelationships(ctx, f.client, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	require.NoError(t, err)
+	assert.Equal(t, 5, migrated)
+	assert.Equal(t, 1, f.client.Relationship.Query().Where(relationship.FromType("mailing_list")).CountX(ctx))
+
+	// A person -> organization edge is followed and filtered like any other entity relationship
+	assert.Equal(t, [][]string{viaEnron}, f.find(t, worksFor))
+	assert.Equal(t, [][]string{viaEnron}, f.find(t, PathOptions{K: 5, RelationshipTypes: []string{"WORKS_FOR"}, EntityTypes: []string{"person", "organization"}}))
+	assert.Empty(t, f.find(t, PathOptions{K: 5, RelationshipTypes: []string{"WORKS_FOR"}, ExcludeEntityTypes: []string{"organization"}}))
+
+	// Migrating again changes nothing
+	migrated, err = MigrateEntityRelationships(ctx, f.client, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	require.NoError(t, err)
+	assert.Zero(t, migrated)
+}
+
+func TestFindPaths_TimeWindowAndDepth(t *testing.T) {
+	f := newPathFixture(t)
+	october := time.Date(2001, 10, 1, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, [][]string{viaColleagues, viaEmail}, f.find(t, PathOptions{K: 5, Window: AsOf(october)}))
+	assert.Equal(t, [][]string{viaList}, f.find(t, PathOptions{K: 5, Window: TimeWindow{Since: october}}))
+	assert.Equal(t, [][]string{viaList, viaColleagues}, f.find(t, PathOptions{K: 5, MaxDepth: 3}))
+}
+
+func TestPathOptions_Validate(t *testing.T) {
+	assert.NoError(t, PathOptions{}.Validate())
+	assert.NoError(t, PathOptions{K: MaxPaths, MaxDepth: MaxPathDepth, Weight: WeightFrequency}.Validate())
+	assert.Error(t, PathOptions{Weight: "salary"}.Validate())
+	assert.Error(t, PathOptions{K: MaxPaths + 1}.Validate())
+	assert.Error(t, PathOptions{MaxDepth: MaxPathDepth + 1}.Validate())
+	assert.Error(t, PathOptions{MaxDegree: -1}.Validate())
+
+	_, err := (&entRepository{}).FindPaths(context.Background(), 1, 2, PathOptions{K: -1})
+	assert.Error(t, err)
+}
+
+func TestEdgeKey(t *testing.T) {
+	a := PathNode{Type: "discovered_entity", ID: 7}
+	b := PathNode{Type: "email", ID: 3}
+	assert.Equal(t, edgeKey(a, b), edgeKey(b, a))
+	assert.NotEqual(t, edgeKey(a, b), edgeKey(a, PathNode{Type: "discovered_entity", ID: 3}))
+}
+
+// TestParseNodeKey tests reading the node keys of the SQL search back
+func TestParseNodeKey(t *testing.T) {
+	node := PathNode{Type: "discovered_entity", ID: 42}
+	parsed, err := parseNodeKey(node.key())
+	require.NoError(t, err)
+	assert.Equal(t, node, parsed)
+
+	_, err = parseNodeKey("42")
+	assert.Error(t, err)
+	_, err = parseNodeKey("email:abc")
+	assert.Error(t, err)
+}
+
+// TestPathRelationshipFilter tests the SQL conditions and their arguments
+func TestPathRelationshipFilter(t *testing.T) {
+	var args sqlArgs
+	filter := pathRelationshipFilter("r", PathOptions{
+		Window:                   AsOf(time.Date(2001, 12, 2, 0, 0, 0, 0, time.UTC)),
+		ExcludeRelationshipTypes: []string{"MENTIONS"},
+	}, &args)
+	assert.Equal(t, `NOT (r.type = $1 AND r.to_type = $2) AND r."timestamp" < $3 AND r.type <> ALL($4)`, filter)
+	assert.Equal(t, sqlArgs{RelationshipMemberOf, CommunityNodeType}, args[:2])
+	assert.Len(t, args, 4)
+
+	assert.Equal(t, "true", pathNodeFilter(PathOptions{}, &args))
+	assert.Contains(t, pathNodeFilter(PathOptions{MaxDegree: 50}, &args), "<= $7")
+}
@@ -5,6 +5,8 @@ import (
 	"time"
 
 	"github.com/Blogem/enron-graph/ent"
+	"github.com/Blogem/enron-graph/ent/predicate"
+	"github.com/Blogem/enron-graph/ent/relationship"
 )
 
 // Repository defines the interface for graph operations
@@ -12,6 +14,9 @@ type Repository interface {
 	// Email operations
 	CreateEmail(ctx context.Context, email *EmailInput) (*ent.Email, error)
 	FindEmailByMessageID(ctx context.Context, messageID string) (*ent.Email, error)
+	GetEmail(ctx context.Context, id int) (*ent.Email, error)
+	// FindEmailIDs returns the IDs of stored emails matching the filter, oldest first.
+	FindEmailIDs(ctx context.Context, filter *EmailFilter) ([]int, error)
 
 	// Entity operations
 	CreateDiscoveredEntity(ctx context.Context, entity *EntityInput) (*ent.DiscoveredEntity, error)
@@ -23,7 +28,7 @@ type Repository interface {
 
 	// FindEntityByUniqueID finds an entity by its unique identifier.
 	// Optional typeHint parameter enables O(1) direct table lookup when entity type is known.
-	// Fallback strategy: type hint → discovered_entities → relationships inference → parallel search.
+	// Fallback strategy: type hint → discovered_entities → aliases → relationships inference → parallel search.
 	FindEntityByUniqueID(ctx context.Context, uniqueID string, typeHint ...string) (*ent.DiscoveredEntity, error)
 
 	// FindEntitiesByType returns all entities of a given type.
@@ -35,12 +40,74 @@ type Repository interface {
 
 	// Relationship operations
 	CreateRelationship(ctx context.Context, rel *RelationshipInput) (*ent.Relationship, error)
-	FindRelationshipsByEntity(ctx context.Context, entityType string, entityID int) ([]*ent.Relationship, error)
+	FindRelationshipsByEntity(ctx context.Context, entityType string, entityID int, window TimeWindow) ([]*ent.Relationship, error)
 	GetDistinctRelationshipTypes(ctx context.Context) ([]string, error)
 
 	// Graph traversal
-	TraverseRelationships(ctx context.Context, fromID int, relType string, depth int) ([]*ent.DiscoveredEntity, error)
-	FindShortestPath(ctx context.Context, fromID, toID int) ([]*ent.Relationship, error)
+	// Only relationships inside the window are followed; the zero window follows all of them,
+	// AsOf(t) gives the graph as it stood at t.
+	TraverseRelationships(ctx context.Context, fromID int, relType string, depth int, window TimeWindow) ([]*ent.DiscoveredEntity, error)
+	FindShortestPath(ctx context.Context, fromID, toID int, window TimeWindow) ([]*ent.Relationship, error)
+	// FindPaths finds up to opts.K paths between two entities, cheapest first, and none when
+	// they are not connected within the constraints of opts.
+	FindPaths(ctx context.Context, fromID, toID int, opts PathOptions) ([]Path, error)
+
+	// Provenance operations
+	RecordProvenance(ctx context.Context, input *ProvenanceInput) (*ent.Provenance, error)
+	FindProvenance(ctx context.Context, subjectType string, subjectID int) ([]*ent.Provenance, error)
+
+	// Entity curation
+	// MergeEntities folds victims into the survivor: relationships and provenance are rewired,
+	// properties are unioned, victim names become aliases and an audit entry with the reason is recorded.
+	MergeEntities(ctx context.Context, survivorID int, victimIDs []int, reason string) (*MergeResult, error)
+	// SplitEntity moves the selected relationships, provenance, properties and aliases of an
+	// entity onto a newly created entity and records an audit entry.
+	SplitEntity(ctx context.Context, entityID int, input *SplitInput) (*SplitResult, error)
+	FindEntityAudits(ctx context.Context, entityID int) ([]*ent.EntityAudit, error)
+
+	// Alias operations
+	// RecordAlias attaches a name variant or email address to an entity; recording an alias
+	// the entity already has returns the existing row.
+	RecordAlias(ctx context.Context, input *AliasInput) (*ent.EntityAlias, error)
+	FindAliases(ctx context.Context, entityID int) ([]*ent.EntityAlias, error)
+	// FindEntitiesByAlias returns entities whose name, unique ID or recorded aliases match
+	// case-insensitively. Optional typeHint restricts the results to one type category.
+	FindEntitiesByAlias(ctx context.Context, alias string, typeHint ...string) ([]*ent.DiscoveredEntity, error)
+
+	// Thread operations
+	// ThreadEmail files an email into a conversation: it joins the thread of its parent, the
+	// given thread or a new one, and records PART_OF_THREAD and REPLY_TO relationships.
+	// Emails that are already threaded keep their thread.
+	ThreadEmail(ctx context.Context, input *ThreadInput) (*ent.Thread, error)
+	GetThread(ctx context.Context, id int) (*ent.Thread, error)
+	FindThreadByEmail(ctx context.Context, emailID int) (*ent.Thread, error)
+	// FindThreadBySubject returns the most recently active thread with the subject that was
+	// still active at or after since.
+	FindThreadBySubject(ctx context.Context, subject string, since time.Time) (*ent.Thread, error)
+	// FindThreadEmails returns the emails of a thread, oldest first, with their parents.
+	FindThreadEmails(ctx context.Context, threadID int) ([]*ThreadMessage, error)
+
+	// Extraction job queue
+	// EnqueueExtractionJobs adds pending jobs for emails without one to batch and returns how
+	// many were added. A named batch also takes over the pending jobs of the emails, which count
+	// as added.
+	EnqueueExtractionJobs(ctx context.Context, emailIDs []int, model, batch string) (int, error)
+	// ClaimExtractionJobs atomically moves up to limit pending jobs of batch to running for
+	// worker; an empty batch claims any pending job. Concurrent claims never return the same job.
+	ClaimExtractionJobs(ctx context.Context, worker, batch string, limit int) ([]*ent.ExtractionJob, error)
+	// RequeueExtractionJobs resets the jobs of the emails to pending in batch with no attempts,
+	// adding missing ones, so that they are extracted again. Running jobs are not touched.
+	RequeueExtractionJobs(ctx context.Context, emailIDs []int, model, batch string) (int, error)
+	// CompleteExtractionJob marks a job done with the model and prompt version it ran with.
+	CompleteExtractionJob(ctx context.Context, id int, model, promptVersion string) error
+	// FailExtractionJob records an error and requeues the job until maxAttempts is reached.
+	FailExtractionJob(ctx context.Context, id int, cause string, maxAttempts int) (*ent.ExtractionJob, error)
+	// ReleaseStaleExtractionJobs requeues running jobs claimed before the cutoff.
+	ReleaseStaleExtractionJobs(ctx context.Context, claimedBefore time.Time) (int, error)
+	CountExtractionJobs(ctx context.Context) (map[string]int, error)
+	// ClearEmailExtraction removes the provenance an email's extraction recorded, along with
+	// relationships and entities that nothing else supports, before the email is extracted again.
+	ClearEmailExtraction(ctx context.Context, emailID int) (*ClearResult, error)
 
 	// Vector search
 	SimilaritySearch(ctx context.Context, embedding []float32, topK int, threshold float64) ([]*ent.DiscoveredEntity, error)
@@ -63,6 +130,11 @@ type EmailInput struct {
 	Date      time.Time
 	Body      string
 	FilePath  string
+	// DisplayNames maps addresses to the names shown next to them in the headers
+	DisplayNames map[string]string
+	// InReplyTo and References hold the message IDs of the messages being replied to
+	InReplyTo  string
+	References []string
 }
 
 // EntityInput represents input data for creating a discovered entity
@@ -86,3 +158,143 @@ type RelationshipInput struct {
 	ConfidenceScore float64
 	Properties      map[string]interface{}
 }
+
+// AliasInput represents a name variant or email address seen for an entity
+type AliasInput struct {
+	EntityID int
+	Alias    string
+	Kind     string // name or email; detected from the alias when empty
+	Source   string // header, signature, content, merge
+}
+
+// Thread relationship types
+const (
+	RelationshipReplyTo      = "REPLY_TO"
+	RelationshipPartOfThread = "PART_OF_THREAD"
+)
+
+// RelationshipMemberOf links an entity to the community it was assigned to by community detection
+const RelationshipMemberOf = "MEMBER_OF"
+
+// CommunityNodeType is the node type of communities at the end of their MEMBER_OF relationships
+const CommunityNodeType = "community"
+
+// CommunityMembership selects the MEMBER_OF relationships of community detection. The LLM
+// extracts MEMBER_OF relationships between entities too, which are facts like any other.
+func CommunityMembership() predicate.Relationship {
+	return relationship.And(relationship.TypeEQ(RelationshipMemberOf), relationship.ToTypeEQ(CommunityNodeType))
+}
+
+// Ways a reply is matched to its conversation, recorded on REPLY_TO relationships
+const (
+	ThreadByInReplyTo  = "in_reply_to"
+	ThreadByReferences = "references"
+	ThreadBySubject    = "subject"
+	ThreadByQuote      = "quote"
+)
+
+// ThreadInput describes where an email belongs in a conversation
+type ThreadInput struct {
+	Email      *ent.Email
+	Parent     *ent.Email  // replied-to email, nil when unknown
+	Thread     *ent.Thread // thread to join when there is no parent, nil to start a new one
+	Subject    string      // subject without Re:/Fw: prefixes, used for new threads
+	Method     string      // how the parent or thread was found
+	Confidence float64
+}
+
+// ThreadMessage is an email in a thread together with the message it replies to
+type ThreadMessage struct {
+	Email      *ent.Email
+	ParentID   int    // email ID of the parent, 0 for the first message or when unknown
+	Method     string // how the parent was found
+	Confidence float64
+}
+
+// Extraction job statuses
+const (
+	JobPending = "pending"
+	JobRunning = "running"
+	JobDone    = "done"
+	JobFailed  = "failed"
+)
+
+// EmailFilter selects stored emails; zero fields do not restrict the selection
+type EmailFilter struct {
+	Since      time.Time // emails sent at or after
+	Until      time.Time // emails sent before
+	Senders    []string  // From addresses, matched case-insensitively
+	MessageIDs []string
+	// NeverExtracted selects emails without a finished extraction job or any provenance
+	NeverExtracted bool
+	// OutdatedModel and OutdatedPromptVersion, set together, select extracted emails whose
+	// last finished extraction did not use this model and prompt version
+	OutdatedModel         string
+	OutdatedPromptVersion string
+	Limit                 int
+}
+
+// ClearResult describes what ClearEmailExtraction removed
+type ClearResult struct {
+	EmailID              int
+	ProvenanceRemoved    int
+	RelationshipsRemoved int
+	EntitiesRemoved      int
+}
+
+// Provenance subject types
+const (
+	SubjectEntity       = "discovered_entity"
+	SubjectRelationship = "relationship"
+)
+
+// ProvenanceInput represents input data for recording where a fact came from
+type ProvenanceInput struct {
+	SubjectType   string
+	SubjectID     int
+	EmailID       int
+	MessageID     string
+	FilePath      string
+	Source        string
+	Model         string
+	PromptVersion string
+	ExtractedAt   time.Time
+	Properties    map[string]interface{}
+}
+
+// Entity audit operations
+const (
+	AuditMerge = "merge"
+	AuditSplit = "split"
+)
+
+// MergeResult describes the outcome of MergeEntities
+type MergeResult struct {
+	Survivor             *ent.DiscoveredEntity
+	MergedIDs            []int
+	RelationshipsMoved   int
+	RelationshipsRemoved int // Self-loops between the merged entities
+	ProvenanceMoved      int
+	Audit                *ent.EntityAudit
+}
+
+// SplitInput describes the entity to carve out of an existing one
+type SplitInput struct {
+	UniqueID        string
+	Name            string
+	TypeCategory    string // Defaults to the source entity's type
+	RelationshipIDs []int  // Relationships of the source entity to move to the new entity
+	ProvenanceIDs   []int  // Provenance records of the source entity to move
+	PropertyKeys    []string
+	Aliases         []string // Aliases to move from the source entity
+	Reason          string
+}
+
+// SplitResult describes the outcome of SplitEntity
+type SplitResult struct {
+	Source             *ent.DiscoveredEntity
+	Created            *ent.DiscoveredEntity
+	RelationshipsMoved int
+	ProvenanceMoved    int
+	Audit              *ent.EntityAudit
+}
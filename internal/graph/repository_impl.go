@@ -11,6 +11,7 @@ import (
 	"github.com/Blogem/enron-graph/ent"
 	"github.com/Blogem/enron-graph/ent/discoveredentity"
 	"github.com/Blogem/enron-graph/ent/email"
+	"github.com/Blogem/enron-graph/ent/provenance"
 	"github.com/Blogem/enron-graph/ent/relationship"
 	"github.com/Blogem/enron-graph/internal/registry"
 
@@ -22,6 +23,7 @@ type entRepository struct {
 	client *ent.Client
 	db     *sql.DB
 	logger *slog.Logger
+	metric VectorMetric
 }
 
 // NewRepository creates a new ent-based repository
@@ -30,16 +32,24 @@ func NewRepository(client *ent.Client, logger *slog.Logger) Repository {
 		client: client,
 		db:     nil, // No SQL DB for raw queries
 		logger: logger,
+		metric: MetricCosine,
 	}
 }
 
 // NewRepositoryWithDB creates a new ent-based repository with a direct SQL connection
 // The SQL connection is needed for raw pgvector queries
 func NewRepositoryWithDB(client *ent.Client, db *sql.DB, logger *slog.Logger) Repository {
+	return NewRepositoryWithVectorConfig(client, db, logger, DefaultVectorConfig())
+}
+
+// NewRepositoryWithVectorConfig creates a repository whose similarity search uses the
+// metric the embedding index was built for (see MigrateVectorColumn)
+func NewRepositoryWithVectorConfig(client *ent.Client, db *sql.DB, logger *slog.Logger, cfg VectorConfig) Repository {
 	return &entRepository{
 		client: client,
 		db:     db,
 		logger: logger,
+		metric: cfg.Metric,
 	}
 }
 
@@ -50,7 +60,7 @@ func (r *entRepository) GetClient() *ent.Client {
 
 // CreateEmail creates a new email entity
 func (r *entRepository) CreateEmail(ctx context.Context, input *EmailInput) (*ent.Email, error) {
-	return r.client.Email.Create().
+	create := r.client.Email.Create().
 		SetMessageID(input.MessageID).
 		SetFrom(input.From).
 		SetTo(input.To).
@@ -59,8 +69,19 @@ func (r *entRepository) CreateEmail(ctx context.Context, input *EmailInput) (*en
 		SetSubject(input.Subject).
 		SetDate(input.Date).
 		SetBody(input.Body).
-		SetNillableFilePath(&input.FilePath).
-		Save(ctx)
+		SetNillableFilePath(&input.FilePath)
+
+	if len(input.DisplayNames) > 0 {
+		create.SetDisplayNames(input.DisplayNames)
+	}
+	if input.InReplyTo != "" {
+		create.SetInReplyTo(input.InReplyTo)
+	}
+	if len(input.References) > 0 {
+		create.SetReferences(input.References)
+	}
+
+	return create.Save(ctx)
 }
 
 // FindEmailByMessageID finds an email by message ID
@@ -70,6 +91,11 @@ func (r *entRepository) FindEmailByMessageID(ctx context.Context, messageID stri
 		Only(ctx)
 }
 
+// GetEmail finds an email by its database ID
+func (r *entRepository) GetEmail(ctx context.Context, id int) (*ent.Email, error) {
+	return r.client.Email.Get(ctx, id)
+}
+
 // CreateDiscoveredEntity creates a new discovered entity
 func (r *entRepository) CreateDiscoveredEntity(ctx context.Context, input *EntityInput) (*ent.DiscoveredEntity, error) {
 	return r.client.DiscoveredEntity.Create().
@@ -99,7 +125,7 @@ func (r *entRepository) FindEntityByID(ctx context.Context, id int, typeHint ...
 
 // FindEntityByUniqueID finds an entity by unique ID.
 // Optional typeHint parameter enables O(1) direct table lookup when entity type is known.
-// Fallback strategy: type hint → discovered_entities → relationships inference → parallel search.
+// Fallback strategy: type hint → discovered_entities → aliases → relationships inference → parallel search.
 func (r *entRepository) FindEntityByUniqueID(ctx context.Context, uniqueID string, typeHint ...string) (*ent.DiscoveredEntity, error) {
 	// Tier 0: Try type hint if provided
 	if len(typeHint) > 0 && typeHint[0] != "" {
@@ -138,6 +164,12 @@ func (r *entRepository) FindEntityByUniqueID(ctx context.Context, uniqueID strin
 		return nil, err
 	}
 
+	// Tier 1b: Resolve through recorded aliases (alternative addresses, merged-away IDs)
+	if aliased := r.resolveAlias(ctx, uniqueID); aliased != nil {
+		r.logger.Debug("Found entity via alias", "uniqueID", uniqueID, "id", aliased.ID)
+		return aliased, nil
+	}
+
 	// Tier 2: Try relationships inference
 	// Query relationships table to infer type
 	r.logger.Debug("Entity not in discovered_entities, trying relationships inference", "uniqueID", uniqueID)
@@ -265,7 +297,7 @@ func (r *entRepository) GetDistinctEntityTypes(ctx context.Context) ([]string, e
 		FROM information_schema.tables
 		WHERE table_schema = 'public'
 		AND table_type = 'BASE TABLE'
-		AND table_name NOT IN ('relationships', 'discovered_entities', 'schema_promotions')
+		AND table_name NOT IN (` + MetaTables + `)
 		ORDER BY table_name
 	`
 
@@ -305,8 +337,8 @@ func (r *entRepository) CreateRelationship(ctx context.Context, input *Relations
 		Save(ctx)
 }
 
-// FindRelationshipsByEntity finds relationships for an entity
-func (r *entRepository) FindRelationshipsByEntity(ctx context.Context, entityType string, entityID int) ([]*ent.Relationship, error) {
+// FindRelationshipsByEntity finds relationships for an entity inside the window
+func (r *entRepository) FindRelationshipsByEntity(ctx context.Context, entityType string, entityID int, window TimeWindow) ([]*ent.Relationship, error) {
 	// Support both schema types: specific types (person, organization, etc.) and generic "discovered_entity"
 	return r.client.Relationship.Query().
 		Where(
@@ -331,6 +363,7 @@ func (r *entRepository) FindRelationshipsByEntity(ctx context.Context, entityTyp
 				),
 			),
 		).
+		Where(window.Predicates()...).
 		All(ctx)
 }
 
@@ -345,8 +378,43 @@ func (r *entRepository) GetDistinctRelationshipTypes(ctx context.Context) ([]str
 	return relationshipTypes, nil
 }
 
-// TraverseRelationships traverses relationships from an entity with BFS up to specified depth
-func (r *entRepository) TraverseRelationships(ctx context.Context, fromID int, relType string, depth int) ([]*ent.DiscoveredEntity, error) {
+// RecordProvenance records the source email and extraction run behind an entity or relationship
+func (r *entRepository) RecordProvenance(ctx context.Context, input *ProvenanceInput) (*ent.Provenance, error) {
+	create := r.client.Provenance.Create().
+		SetSubjectType(input.SubjectType).
+		SetSubjectID(input.SubjectID).
+		SetSource(input.Source).
+		SetMessageID(input.MessageID).
+		SetFilePath(input.FilePath).
+		SetModel(input.Model).
+		SetPromptVersion(input.PromptVersion)
+
+	if input.EmailID > 0 {
+		create.SetEmailID(input.EmailID)
+	}
+	if !input.ExtractedAt.IsZero() {
+		create.SetExtractedAt(input.ExtractedAt)
+	}
+	if input.Properties != nil {
+		create.SetProperties(input.Properties)
+	}
+
+	return create.Save(ctx)
+}
+
+// FindProvenance returns the provenance records for an entity or relationship, oldest first
+func (r *entRepository) FindProvenance(ctx context.Context, subjectType string, subjectID int) ([]*ent.Provenance, error) {
+	return r.client.Provenance.Query().
+		Where(
+			provenance.SubjectTypeEQ(subjectType),
+			provenance.SubjectIDEQ(subjectID),
+		).
+		Order(ent.Asc(provenance.FieldExtractedAt)).
+		All(ctx)
+}
+
+// TraverseRelationships traverses relationships inside the window from an entity with BFS up to specified depth
+func (r *entRepository) TraverseRelationships(ctx context.Context, fromID int, relType string, depth int, window TimeWindow) ([]*ent.DiscoveredEntity, error) {
 	if depth <= 0 {
 		return nil, nil
 	}
@@ -374,6 +442,7 @@ func (r *entRepository) TraverseRelationships(ctx context.Context, fromID int, r
 							relationship.ToIDEQ(currentID),
 						),
 					).
+					Where(window.Predicates()...).
 					All(ctx)
 			} else {
 				// Get relationships of specific type
@@ -387,6 +456,7 @@ func (r *entRepository) TraverseRelationships(ctx context.Context, fromID int, r
 							),
 						),
 					).
+					Where(window.Predicates()...).
 					All(ctx)
 			}
 
@@ -428,17 +498,26 @@ func (r *entRepository) TraverseRelationships(ctx context.Context, fromID int, r
 	return allEntities, nil
 }
 
-// FindShortestPath finds the shortest path between two entities using BFS
-func (r *entRepository) FindShortestPath(ctx context.Context, fromID, toID int) ([]*ent.Relationship, error) {
-	return r.findShortestPathBFS(ctx, fromID, toID)
+// FindShortestPath finds the path with the fewest relationships between two entities over the
+// relationships inside the window
+func (r *entRepository) FindShortestPath(ctx context.Context, fromID, toID int, window TimeWindow) ([]*ent.Relationship, error) {
+	paths, err := r.FindPaths(ctx, fromID, toID, PathOptions{Window: window})
+	if err != nil {
+		return nil, err
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no path found between entities %d and %d", fromID, toID)
+	}
+	return paths[0].Relationships, nil
 }
 
-// SimilaritySearch finds entities similar to the given embedding using pgvector
+// SimilaritySearch finds entities similar to the given embedding using pgvector.
+// It searches the indexed embedding_vector column with the repository's metric and,
+// when threshold > 0, only returns entities whose similarity is at least threshold.
 func (r *entRepository) SimilaritySearch(ctx context.Context, embedding []float32, topK int, threshold float64) ([]*ent.DiscoveredEntity, error) {
-	// Convert embedding to JSON array string for pgvector
-	embeddingJSON, err := json.Marshal(embedding)
+	literal, err := vectorLiteral(embedding)
 	if err != nil {
-		return nil, fmt.Errorf("failed to marshal embedding: %w", err)
+		return nil, err
 	}
 
 	// Check if we have access to the underlying database
@@ -446,18 +525,24 @@ func (r *entRepository) SimilaritySearch(ctx context.Context, embedding []float3
 		return nil, fmt.Errorf("database connection not available for raw SQL queries")
 	}
 
-	// Build the query with pgvector distance operator
-	// Convert JSONB to vector via text casting
-	query := `
-		SELECT id, unique_id, type_category, name, properties, embedding, confidence_score, created_at
+	operator := r.metric.Operator()
+	args := []interface{}{literal, topK}
+	thresholdClause := ""
+	if threshold > 0 {
+		thresholdClause = fmt.Sprintf("AND %s %s $1::vector <= $3", embeddingColumn, operator)
+		args = append(args, r.metric.MaxDistance(threshold))
+	}
+
+	query := fmt.Sprintf(`
+		SELECT id, unique_id, type_category, name, properties, confidence_score, created_at
 		FROM discovered_entities
-		WHERE embedding IS NOT NULL
-		ORDER BY embedding::text::vector <-> $1::vector
+		WHERE %s IS NOT NULL
+		  %s
+		ORDER BY %s %s $1::vector
 		LIMIT $2
-	`
+	`, embeddingColumn, thresholdClause, embeddingColumn, operator)
 
-	// Execute the raw SQL query
-	rows, err := r.db.QueryContext(ctx, query, string(embeddingJSON), topK)
+	rows, err := r.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute similarity search: %w", err)
 	}
@@ -472,12 +557,11 @@ func (r *entRepository) SimilaritySearch(ctx context.Context, embedding []float3
 			typeCategory    string
 			name            string
 			propertiesJSON  []byte
-			embeddingJSON   []byte
 			confidenceScore float64
 			createdAt       sql.NullTime
 		)
 
-		if err := rows.Scan(&id, &uniqueID, &typeCategory, &name, &propertiesJSON, &embeddingJSON, &confidenceScore, &createdAt); err != nil {
+		if err := rows.Scan(&id, &uniqueID, &typeCategory, &name, &propertiesJSON, &confidenceScore, &createdAt); err != nil {
 			return nil, fmt.Errorf("failed to scan row: %w", err)
 		}
 
@@ -510,75 +594,6 @@ func (r *entRepository) SimilaritySearch(ctx context.Context, embedding []float3
 		return nil, fmt.Errorf("error iterating rows: %w", err)
 	}
 
-	// Apply threshold filter if specified
-	// For cosine distance: 0 = identical, 2 = opposite
-	// threshold of 0.7 similarity means distance <= 0.3
-	if threshold > 0 {
-		maxDistance := 1.0 - threshold
-		filteredQuery := fmt.Sprintf(`
-			SELECT id, unique_id, type_category, name, properties, embedding, confidence_score, created_at,
-			       embedding::text::vector <-> $1::vector as distance
-			FROM discovered_entities
-			WHERE embedding IS NOT NULL
-			  AND embedding::text::vector <-> $1::vector <= %f
-			ORDER BY distance
-			LIMIT $2
-		`, maxDistance)
-
-		rows2, err := r.db.QueryContext(ctx, filteredQuery, string(embeddingJSON), topK)
-		if err != nil {
-			return nil, fmt.Errorf("failed to execute filtered similarity search: %w", err)
-		}
-		defer rows2.Close()
-
-		filtered := make([]*ent.DiscoveredEntity, 0)
-		for rows2.Next() {
-			var (
-				id              int
-				uniqueID        string
-				typeCategory    string
-				name            string
-				propertiesJSON  []byte
-				embeddingJSON   []byte
-				confidenceScore float64
-				createdAt       sql.NullTime
-				distance        float64
-			)
-
-			if err := rows2.Scan(&id, &uniqueID, &typeCategory, &name, &propertiesJSON, &embeddingJSON, &confidenceScore, &createdAt, &distance); err != nil {
-				return nil, fmt.Errorf("failed to scan filtered row: %w", err)
-			}
-
-			var properties map[string]interface{}
-			if len(propertiesJSON) > 0 {
-				if err := json.Unmarshal(propertiesJSON, &properties); err != nil {
-					return nil, fmt.Errorf("failed to unmarshal properties: %w", err)
-				}
-			}
-
-			entity := &ent.DiscoveredEntity{
-				ID:              id,
-				UniqueID:        uniqueID,
-				TypeCategory:    typeCategory,
-				Name:            name,
-				Properties:      properties,
-				ConfidenceScore: confidenceScore,
-			}
-
-			if createdAt.Valid {
-				entity.CreatedAt = createdAt.Time
-			}
-
-			filtered = append(filtered, entity)
-		}
-
-		if err := rows2.Err(); err != nil {
-			return nil, fmt.Errorf("error iterating filtered rows: %w", err)
-		}
-
-		return filtered, nil
-	}
-
 	return entities, nil
 }
 
@@ -0,0 +1,96 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/Blogem/enron-graph/ent"
+	"github.com/Blogem/enron-graph/ent/discoveredentity"
+	"github.com/Blogem/enron-graph/ent/relationship"
+	"github.com/Blogem/enron-graph/internal/registry"
+)
+
+// TypeSummary describes an entity or relationship type present in the graph
+type TypeSummary struct {
+	Name     string
+	Count    int
+	Examples []string
+}
+
+// SchemaSummary lists the entity and relationship types in the graph, most common first
+type SchemaSummary struct {
+	EntityTypes       []TypeSummary
+	RelationshipTypes []TypeSummary
+	PromotedTypes     []string
+}
+
+// DescribeSchema counts the entities of each type category and the relationships of each type,
+// with up to examples entity names per category. Counts are grouped in the database, so only
+// the example names are loaded.
+func DescribeSchema(ctx context.Context, client *ent.Client, examples int) (*SchemaSummary, error) {
+	var entityCounts []struct {
+		TypeCategory string `sql:"type_category"`
+		Count        int    `sql:"count"`
+	}
+	err := client.DiscoveredEntity.
+		Query().
+		GroupBy(discoveredentity.FieldTypeCategory).
+		Aggregate(ent.Count()).
+		Scan(ctx, &entityCounts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count entity types: %w", err)
+	}
+
+	var relCounts []struct {
+		Type  string `sql:"type"`
+		Count int    `sql:"count"`
+	}
+	err = client.Relationship.
+		Query().
+		GroupBy(relationship.FieldType).
+		Aggregate(ent.Count()).
+		Scan(ctx, &relCounts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count relationship types: %w", err)
+	}
+
+	summary := &SchemaSummary{}
+	for _, r := range entityCounts {
+		names, err := client.DiscoveredEntity.
+			Query().
+			Where(discoveredentity.TypeCategoryEQ(r.TypeCategory)).
+			Limit(examples).
+			Select(discoveredentity.FieldName).
+			Strings(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find %s examples: %w", r.TypeCategory, err)
+		}
+		summary.EntityTypes = append(summary.EntityTypes, TypeSummary{
+			Name:     r.TypeCategory,
+			Count:    r.Count,
+			Examples: names,
+		})
+	}
+	for _, r := range relCounts {
+		summary.RelationshipTypes = append(summary.RelationshipTypes, TypeSummary{
+			Name:  r.Type,
+			Count: r.Count,
+		})
+	}
+
+	// Most common types first
+	sort.SliceStable(summary.EntityTypes, func(i, j int) bool {
+		return summary.EntityTypes[i].Count > summary.EntityTypes[j].Count
+	})
+	sort.SliceStable(summary.RelationshipTypes, func(i, j int) bool {
+		return summary.RelationshipTypes[i].Count > summary.RelationshipTypes[j].Count
+	})
+
+	for typeName := range registry.PromotedTypes {
+		summary.PromotedTypes = append(summary.PromotedTypes, typeName)
+	}
+	sort.Strings(summary.PromotedTypes)
+
+	return summary, nil
+}
@@ -0,0 +1,65 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Blogem/enron-graph/ent/enttest"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDescribeSchema tests that types are counted, most common first, with a limited number of examples
+func TestDescribeSchema(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:schema?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	ctx := context.Background()
+
+	entity := func(name, typeCategory string) int {
+		return client.DiscoveredEntity.Create().
+			SetUniqueID(typeCategory + ":" + name).
+			SetName(name).
+			SetTypeCategory(typeCategory).
+			SaveX(ctx).ID
+	}
+	var people []int
+	for i := 0; i < 5; i++ {
+		people = append(people, entity(fmt.Sprintf("person%d", i), "person"))
+	}
+	enron := entity("Enron", "organization")
+	for _, id := range people {
+		client.Relationship.Create().
+			SetType("WORKS_FOR").
+			SetFromType("discovered_entity").
+			SetFromID(id).
+			SetToType("discovered_entity").
+			SetToID(enron).
+			SetTimestamp(time.Now()).
+			SaveX(ctx)
+	}
+	client.Relationship.Create().
+		SetType("MENTIONS").
+		SetFromType("email").
+		SetFromID(1).
+		SetToType("discovered_entity").
+		SetToID(enron).
+		SetTimestamp(time.Now()).
+		SaveX(ctx)
+
+	summary, err := DescribeSchema(ctx, client, 3)
+	require.NoError(t, err)
+
+	require.Len(t, summary.EntityTypes, 2)
+	assert.Equal(t, "person", summary.EntityTypes[0].Name)
+	assert.Equal(t, 5, summary.EntityTypes[0].Count)
+	assert.Len(t, summary.EntityTypes[0].Examples, 3)
+	assert.Equal(t, TypeSummary{Name: "organization", Count: 1, Examples: []string{"Enron"}}, summary.EntityTypes[1])
+
+	assert.Equal(t, []TypeSummary{
+		{Name: "WORKS_FOR", Count: 5},
+		{Name: "MENTIONS", Count: 1},
+	}, summary.RelationshipTypes)
+}
@@ -0,0 +1,7 @@
+package graph
+
+// MetaTables is the SQL list of the tables that hold the graph itself and its bookkeeping rather
+// than a promoted entity type. Queries that find promoted type tables in information_schema
+// leave them out with "table_name NOT IN (" + MetaTables + ")".
+const MetaTables = `'relationships', 'discovered_entities', 'schema_promotions', 'provenances', ` +
+	`'entity_audits', 'entity_alias', 'threads', 'extraction_jobs', 'centrality_scores', 'communities'`
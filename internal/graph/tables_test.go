@@ -0,0 +1,21 @@
+package graph
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Blogem/enron-graph/ent/migrate"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestMetaTables tests that every table of the ent schema is left out of the promoted types,
+// except emails, which is listed with them
+func TestMetaTables(t *testing.T) {
+	for _, table := range migrate.Tables {
+		if table.Name == "emails" {
+			continue
+		}
+		assert.Contains(t, MetaTables, "'"+table.Name+"'", "table %s is missing from MetaTables", table.Name)
+	}
+	assert.Equal(t, len(migrate.Tables)-1, strings.Count(MetaTables, ",")+1, "MetaTables lists a table the schema does not have")
+}
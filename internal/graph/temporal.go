@@ -0,0 +1,93 @@
+package graph
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Blogem/enron-graph/ent/predicate"
+	"github.com/Blogem/enron-graph/ent/relationship"
+)
+
+// TimeWindow restricts graph queries to the relationships whose timestamp falls inside it.
+// Zero bounds do not restrict, so the zero window considers every relationship.
+type TimeWindow struct {
+	Since time.Time // Relationships at or after
+	Until time.Time // Relationships before
+}
+
+// AsOf returns the window of a snapshot: the graph as it stood at t, with only the
+// relationships before it
+func AsOf(t time.Time) TimeWindow {
+	return TimeWindow{Until: t}
+}
+
+// IsZero reports whether the window considers every relationship
+func (w TimeWindow) IsZero() bool {
+	return w.Since.IsZero() && w.Until.IsZero()
+}
+
+// Contains reports whether a relationship timestamp falls inside the window
+func (w TimeWindow) Contains(t time.Time) bool {
+	if !w.Since.IsZero() && t.Before(w.Since) {
+		return false
+	}
+	if !w.Until.IsZero() && !t.Before(w.Until) {
+		return false
+	}
+	return true
+}
+
+// Predicates returns the conditions that select the relationships inside the window
+func (w TimeWindow) Predicates() []predicate.Relationship {
+	var predicates []predicate.Relationship
+	if !w.Since.IsZero() {
+		predicates = append(predicates, relationship.TimestampGTE(w.Since))
+	}
+	if !w.Until.IsZero() {
+		predicates = append(predicates, relationship.TimestampLT(w.Until))
+	}
+	return predicates
+}
+
+// ParseTimeWindow builds a window from bounds given as dates (2001-06-30) or RFC 3339 times;
+// empty values do not restrict. asOf is the snapshot form of until, and cannot be combined
+// with it.
+func ParseTimeWindow(since, until, asOf string) (TimeWindow, error) {
+	if until != "" && asOf != "" {
+		return TimeWindow{}, fmt.Errorf("until and as_of cannot be combined")
+	}
+	if asOf != "" {
+		until = asOf
+	}
+
+	var window TimeWindow
+	var err error
+	if window.Since, err = ParseTime(since); err != nil {
+		return TimeWindow{}, fmt.Errorf("invalid since: %w", err)
+	}
+	if window.Until, err = ParseTime(until); err != nil {
+		if asOf != "" {
+			return TimeWindow{}, fmt.Errorf("invalid as_of: %w", err)
+		}
+		return TimeWindow{}, fmt.Errorf("invalid until: %w", err)
+	}
+	if !window.Since.IsZero() && !window.Until.IsZero() && !window.Since.Before(window.Until) {
+		return TimeWindow{}, fmt.Errorf("since must be before until")
+	}
+	return window, nil
+}
+
+// ParseTime parses a date (2006-01-02) or an RFC 3339 time; the empty string is the zero time
+func ParseTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse("2006-01-02", value); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("expected a date (2006-01-02) or RFC 3339 time, got %q", value)
+	}
+	return t, nil
+}
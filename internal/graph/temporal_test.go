@@ -0,0 +1,117 @@
+package graph
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/Blogem/enron-graph/ent"
+	"github.com/Blogem/enron-graph/ent/enttest"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestParseTimeWindow tests parsing window bounds from dates and RFC 3339 times
+func TestParseTimeWindow(t *testing.T) {
+	window, err := ParseTimeWindow("2001-07-01", "2001-10-01T00:00:00Z", "")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2001, 7, 1, 0, 0, 0, 0, time.UTC), window.Since)
+	assert.Equal(t, time.Date(2001, 10, 1, 0, 0, 0, 0, time.UTC), window.Until)
+
+	snapshot, err := ParseTimeWindow("", "", "2001-12-02")
+	require.NoError(t, err)
+	assert.Equal(t, AsOf(time.Date(2001, 12, 2, 0, 0, 0, 0, time.UTC)), snapshot)
+
+	empty, err := ParseTimeWindow("", "", "")
+	require.NoError(t, err)
+	assert.True(t, empty.IsZero())
+
+	for _, tc := range []struct{ since, until, asOf string }{
+		{"yesterday", "", ""},
+		{"", "2001-13-01", ""},
+		{"", "", "Q3"},
+		{"", "2001-12-02", "2001-12-02"},
+		{"2001-10-01", "2001-07-01", ""},
+		{"2001-10-01", "2001-10-01", ""},
+	} {
+		_, err := ParseTimeWindow(tc.since, tc.until, tc.asOf)
+		assert.Error(t, err, "since=%q until=%q as_of=%q", tc.since, tc.until, tc.asOf)
+	}
+}
+
+// TestTimeWindowContains tests that since is inclusive and until exclusive
+func TestTimeWindowContains(t *testing.T) {
+	since := time.Date(2001, 7, 1, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2001, 10, 1, 0, 0, 0, 0, time.UTC)
+	window := TimeWindow{Since: since, Until: until}
+
+	assert.True(t, window.Contains(since))
+	assert.True(t, window.Contains(until.Add(-time.Second)))
+	assert.False(t, window.Contains(until))
+	assert.False(t, window.Contains(since.Add(-time.Second)))
+	assert.True(t, TimeWindow{}.Contains(time.Time{}))
+	assert.False(t, AsOf(since).Contains(since))
+}
+
+// TestRepository_TimeWindow tests that traversal and path finding only follow relationships
+// inside the window
+func TestRepository_TimeWindow(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:temporal?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	ctx := context.Background()
+	repo := NewRepository(client, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	ids := map[string]int{}
+	for _, name := range []string{"ken", "jeff", "andy"} {
+		e := client.DiscoveredEntity.Create().
+			SetUniqueID(name + "@enron.com").
+			SetName(name).
+			SetTypeCategory("person").
+			SaveX(ctx)
+		ids[name] = e.ID
+	}
+	communicate := func(from, to string, at time.Time) {
+		client.Relationship.Create().
+			SetType("COMMUNICATES_WITH").
+			SetFromType("discovered_entity").
+			SetFromID(ids[from]).
+			SetToType("discovered_entity").
+			SetToID(ids[to]).
+			SetTimestamp(at).
+			SaveX(ctx)
+	}
+	communicate("ken", "jeff", time.Date(2001, 5, 1, 0, 0, 0, 0, time.UTC))
+	communicate("jeff", "andy", time.Date(2001, 12, 10, 0, 0, 0, 0, time.UTC))
+
+	bankruptcy := AsOf(time.Date(2001, 12, 2, 0, 0, 0, 0, time.UTC))
+	q4 := TimeWindow{Since: time.Date(2001, 10, 1, 0, 0, 0, 0, time.UTC)}
+
+	path, err := repo.FindShortestPath(ctx, ids["ken"], ids["andy"], TimeWindow{})
+	require.NoError(t, err)
+	assert.Len(t, path, 2)
+	_, err = repo.FindShortestPath(ctx, ids["ken"], ids["andy"], bankruptcy)
+	assert.Error(t, err, "the path only exists after the snapshot")
+
+	neighbors, err := repo.TraverseRelationships(ctx, ids["jeff"], "", 1, q4)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"andy"}, names(neighbors))
+	neighbors, err = repo.TraverseRelationships(ctx, ids["jeff"], "COMMUNICATES_WITH", 2, bankruptcy)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ken"}, names(neighbors))
+
+	rels, err := repo.FindRelationshipsByEntity(ctx, "discovered_entity", ids["jeff"], q4)
+	require.NoError(t, err)
+	require.Len(t, rels, 1)
+	assert.Equal(t, ids["andy"], rels[0].ToID)
+}
+
+func names(entities []*ent.DiscoveredEntity) []string {
+	result := make([]string, len(entities))
+	for i, e := range entities {
+		result[i] = e.Name
+	}
+	return result
+}
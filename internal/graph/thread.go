@@ -0,0 +1,282 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Blogem/enron-graph/ent"
+	"github.com/Blogem/enron-graph/ent/email"
+	"github.com/Blogem/enron-graph/ent/relationship"
+	"github.com/Blogem/enron-graph/ent/thread"
+)
+
+// Node types used for thread relationships
+const (
+	emailNodeType  = "email"
+	threadNodeType = "thread"
+)
+
+// ThreadEmail files an email into a conversation in a single transaction
+func (r *entRepository) ThreadEmail(ctx context.Context, input *ThreadInput) (*ent.Thread, error) {
+	if input == nil || input.Email == nil {
+		return nil, fmt.Errorf("email is required to thread")
+	}
+	msg := input.Email
+
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+	client := tx.Client()
+
+	existing, err := threadOfEmail(ctx, client, msg.ID)
+	if err == nil {
+		return existing, nil
+	}
+	if !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	target := input.Thread
+	joined := []*ent.Email{msg}
+	if input.Parent != nil {
+		target, err = threadOfEmail(ctx, client, input.Parent.ID)
+		if ent.IsNotFound(err) {
+			// The parent was loaded before threading existed; it starts the conversation
+			target, err = createThread(ctx, client, input.Parent, input.Subject)
+			if err == nil {
+				err = joinThread(ctx, client, input.Parent, target.ID, 1.0)
+				joined = append(joined, input.Parent)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if target == nil {
+		target, err = createThread(ctx, client, msg, input.Subject)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	confidence := input.Confidence
+	if confidence == 0 {
+		confidence = 1.0
+	}
+	if err := joinThread(ctx, client, msg, target.ID, confidence); err != nil {
+		return nil, err
+	}
+
+	if input.Parent != nil {
+		if _, err := client.Relationship.Create().
+			SetType(RelationshipReplyTo).
+			SetFromType(emailNodeType).
+			SetFromID(msg.ID).
+			SetToType(emailNodeType).
+			SetToID(input.Parent.ID).
+			SetTimestamp(msg.Date).
+			SetConfidenceScore(confidence).
+			SetProperties(map[string]interface{}{"method": input.Method}).
+			Save(ctx); err != nil {
+			return nil, fmt.Errorf("failed to link email %d to its parent: %w", msg.ID, err)
+		}
+	}
+
+	updated, err := addToThread(ctx, client, target.ID, joined)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit thread: %w", err)
+	}
+	return updated, nil
+}
+
+// GetThread returns a thread by ID
+func (r *entRepository) GetThread(ctx context.Context, id int) (*ent.Thread, error) {
+	return r.client.Thread.Get(ctx, id)
+}
+
+// FindThreadByEmail returns the thread an email belongs to
+func (r *entRepository) FindThreadByEmail(ctx context.Context, emailID int) (*ent.Thread, error) {
+	return threadOfEmail(ctx, r.client, emailID)
+}
+
+// FindThreadBySubject returns the most recently active thread with the subject
+func (r *entRepository) FindThreadBySubject(ctx context.Context, subject string, since time.Time) (*ent.Thread, error) {
+	normalized := normalizeSubject(subject)
+	if normalized == "" {
+		return nil, &ent.NotFoundError{}
+	}
+
+	return r.client.Thread.Query().
+		Where(
+			thread.NormalizedSubjectEQ(normalized),
+			thread.LastDateGTE(since),
+		).
+		Order(ent.Desc(thread.FieldLastDate)).
+		First(ctx)
+}
+
+// FindThreadEmails returns the emails of a thread, oldest first, with their parents
+func (r *entRepository) FindThreadEmails(ctx context.Context, threadID int) ([]*ThreadMessage, error) {
+	emailIDs, err := r.client.Relationship.Query().
+		Where(
+			relationship.TypeEQ(RelationshipPartOfThread),
+			relationship.FromTypeEQ(emailNodeType),
+			relationship.ToTypeEQ(threadNodeType),
+			relationship.ToIDEQ(threadID),
+		).
+		Select(relationship.FieldFromID).
+		Ints(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query emails of thread %d: %w", threadID, err)
+	}
+	if len(emailIDs) == 0 {
+		return nil, nil
+	}
+
+	emails, err := r.client.Email.Query().
+		Where(email.IDIn(emailIDs...)).
+		Order(ent.Asc(email.FieldDate), ent.Asc(email.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load emails of thread %d: %w", threadID, err)
+	}
+
+	replies, err := r.client.Relationship.Query().
+		Where(
+			relationship.TypeEQ(RelationshipReplyTo),
+			relationship.FromTypeEQ(emailNodeType),
+			relationship.FromIDIn(emailIDs...),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query replies in thread %d: %w", threadID, err)
+	}
+	parents := make(map[int]*ent.Relationship, len(replies))
+	for _, reply := range replies {
+		parents[reply.FromID] = reply
+	}
+
+	messages := make([]*ThreadMessage, len(emails))
+	for i, e := range emails {
+		message := &ThreadMessage{Email: e}
+		if reply, ok := parents[e.ID]; ok {
+			message.ParentID = reply.ToID
+			message.Method, _ = reply.Properties["method"].(string)
+			message.Confidence = reply.ConfidenceScore
+		}
+		messages[i] = message
+	}
+	return messages, nil
+}
+
+// threadOfEmail follows an email's PART_OF_THREAD relationship
+func threadOfEmail(ctx context.Context, client *ent.Client, emailID int) (*ent.Thread, error) {
+	rel, err := client.Relationship.Query().
+		Where(
+			relationship.TypeEQ(RelationshipPartOfThread),
+			relationship.FromTypeEQ(emailNodeType),
+			relationship.FromIDEQ(emailID),
+			relationship.ToTypeEQ(threadNodeType),
+		).
+		First(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return client.Thread.Get(ctx, rel.ToID)
+}
+
+// createThread starts a thread rooted at an email
+func createThread(ctx context.Context, client *ent.Client, root *ent.Email, subject string) (*ent.Thread, error) {
+	if subject == "" {
+		subject = root.Subject
+	}
+
+	created, err := client.Thread.Create().
+		SetRootMessageID(root.MessageID).
+		SetSubject(subject).
+		SetNormalizedSubject(normalizeSubject(subject)).
+		SetFirstDate(root.Date).
+		SetLastDate(root.Date).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create thread for %s: %w", root.MessageID, err)
+	}
+	return created, nil
+}
+
+// joinThread records that an email is part of a thread
+func joinThread(ctx context.Context, client *ent.Client, msg *ent.Email, threadID int, confidence float64) error {
+	if _, err := client.Relationship.Create().
+		SetType(RelationshipPartOfThread).
+		SetFromType(emailNodeType).
+		SetFromID(msg.ID).
+		SetToType(threadNodeType).
+		SetToID(threadID).
+		SetTimestamp(msg.Date).
+		SetConfidenceScore(confidence).
+		Save(ctx); err != nil {
+		return fmt.Errorf("failed to add email %d to thread %d: %w", msg.ID, threadID, err)
+	}
+	return nil
+}
+
+// addToThread counts emails that joined a thread into its message count, date range and
+// participants. It only reads the thread itself, so filing a message does not reload the
+// conversation.
+func addToThread(ctx context.Context, client *ent.Client, threadID int, joined []*ent.Email) (*ent.Thread, error) {
+	current, err := client.Thread.Get(ctx, threadID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load thread %d: %w", threadID, err)
+	}
+
+	first, last := current.FirstDate, current.LastDate
+	seen := make(map[string]bool, len(current.Participants))
+	participants := append([]string(nil), current.Participants...)
+	for _, participant := range participants {
+		seen[participant] = true
+	}
+	for _, e := range joined {
+		if first.IsZero() || e.Date.Before(first) {
+			first = e.Date
+		}
+		if e.Date.After(last) {
+			last = e.Date
+		}
+		addresses := append([]string{e.From}, e.To...)
+		addresses = append(addresses, e.Cc...)
+		for _, address := range addresses {
+			key := strings.ToLower(strings.TrimSpace(address))
+			if key == "" || seen[key] {
+				continue
+			}
+			seen[key] = true
+			participants = append(participants, key)
+		}
+	}
+	sort.Strings(participants)
+
+	updated, err := client.Thread.UpdateOneID(threadID).
+		AddMessageCount(len(joined)).
+		SetFirstDate(first).
+		SetLastDate(last).
+		SetParticipants(participants).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update thread %d: %w", threadID, err)
+	}
+	return updated, nil
+}
+
+// normalizeSubject is the key replies are matched on
+func normalizeSubject(subject string) string {
+	return strings.ToLower(strings.Join(strings.Fields(subject), " "))
+}
@@ -0,0 +1,60 @@
+package graph
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/Blogem/enron-graph/ent"
+	"github.com/Blogem/enron-graph/ent/enttest"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestThreadEmail_Summary tests that the message count, date range and participants of a
+// thread follow the emails filed into it
+func TestThreadEmail_Summary(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:threads?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	ctx := context.Background()
+	repo := NewRepository(client, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	date := time.Date(2001, 5, 14, 9, 0, 0, 0, time.UTC)
+	create := func(messageID, from string, to []string, at time.Time) *ent.Email {
+		return client.Email.Create().
+			SetMessageID(messageID).
+			SetFrom(from).
+			SetTo(to).
+			SetSubject("Q2 forecast").
+			SetDate(at).
+			SaveX(ctx)
+	}
+	root := create("1@enron.com", "Ken.Lay@enron.com", []string{"jeff.skilling@enron.com"}, date)
+	reply := create("2@enron.com", "jeff.skilling@enron.com", []string{"ken.lay@enron.com", "andy.fastow@enron.com"}, date.Add(time.Hour))
+	late := create("3@enron.com", "andy.fastow@enron.com", nil, date.Add(48*time.Hour))
+	early := create("0@enron.com", "sherron.watkins@enron.com", nil, date.Add(-time.Hour))
+
+	// The root was not threaded yet, so the reply starts the thread with both
+	thread, err := repo.ThreadEmail(ctx, &ThreadInput{Email: reply, Parent: root, Subject: "Q2 forecast"})
+	require.NoError(t, err)
+	assert.Equal(t, 2, thread.MessageCount)
+	assert.Equal(t, []string{"andy.fastow@enron.com", "jeff.skilling@enron.com", "ken.lay@enron.com"}, thread.Participants)
+
+	for _, e := range []*ent.Email{late, early} {
+		thread, err = repo.ThreadEmail(ctx, &ThreadInput{Email: e, Thread: thread})
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 4, thread.MessageCount)
+	assert.True(t, early.Date.Equal(thread.FirstDate))
+	assert.True(t, late.Date.Equal(thread.LastDate))
+	assert.Contains(t, thread.Participants, "sherron.watkins@enron.com")
+	assert.Len(t, thread.Participants, 4)
+
+	// Filing an email twice does not count it again
+	again, err := repo.ThreadEmail(ctx, &ThreadInput{Email: late, Thread: thread})
+	require.NoError(t, err)
+	assert.Equal(t, 4, again.MessageCount)
+}
@@ -0,0 +1,274 @@
+package graph
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+)
+
+// VectorMetric selects the pgvector distance operator used for similarity search
+type VectorMetric string
+
+const (
+	MetricCosine       VectorMetric = "cosine"
+	MetricL2           VectorMetric = "l2"
+	MetricInnerProduct VectorMetric = "ip"
+)
+
+// Vector index types supported by pgvector
+const (
+	IndexHNSW    = "hnsw"
+	IndexIVFFlat = "ivfflat"
+)
+
+// embeddingColumn is the native pgvector column kept in sync with the JSON embedding
+const embeddingColumn = "embedding_vector"
+
+// backfillBatchSize bounds the rows updated per statement when backfilling embeddings
+const backfillBatchSize = 10000
+
+// VectorConfig describes the native embedding column and its index
+type VectorConfig struct {
+	Dimensions int
+	Metric     VectorMetric
+	IndexType  string
+	// IVFFlat only: number of inverted lists
+	Lists int
+}
+
+// DefaultVectorConfig returns the settings matching the default embedding model (mxbai-embed-large)
+func DefaultVectorConfig() VectorConfig {
+	return VectorConfig{
+		Dimensions: 1024,
+		Metric:     MetricCosine,
+		IndexType:  IndexHNSW,
+		Lists:      100,
+	}
+}
+
+// NewVectorConfig builds a VectorConfig from configuration values
+func NewVectorConfig(dimensions int, metric, indexType string) (VectorConfig, error) {
+	cfg := DefaultVectorConfig()
+
+	parsed, err := ParseVectorMetric(metric)
+	if err != nil {
+		return cfg, err
+	}
+	cfg.Metric = parsed
+
+	if dimensions > 0 {
+		cfg.Dimensions = dimensions
+	}
+
+	switch strings.ToLower(indexType) {
+	case IndexHNSW, "":
+		cfg.IndexType = IndexHNSW
+	case IndexIVFFlat:
+		cfg.IndexType = IndexIVFFlat
+	default:
+		return cfg, fmt.Errorf("unknown vector index type: %s", indexType)
+	}
+
+	return cfg, nil
+}
+
+// ParseVectorMetric parses a metric name from configuration
+func ParseVectorMetric(name string) (VectorMetric, error) {
+	switch VectorMetric(strings.ToLower(name)) {
+	case MetricCosine, "":
+		return MetricCosine, nil
+	case MetricL2, "euclidean":
+		return MetricL2, nil
+	case MetricInnerProduct, "inner_product":
+		return MetricInnerProduct, nil
+	default:
+		return "", fmt.Errorf("unknown vector metric: %s", name)
+	}
+}
+
+// Operator returns the pgvector distance operator for the metric
+func (m VectorMetric) Operator() string {
+	switch m {
+	case MetricL2:
+		return "<->"
+	case MetricInnerProduct:
+		return "<#>"
+	default:
+		return "<=>"
+	}
+}
+
+// opClass returns the pgvector index operator class for the metric
+func (m VectorMetric) opClass() string {
+	switch m {
+	case MetricL2:
+		return "vector_l2_ops"
+	case MetricInnerProduct:
+		return "vector_ip_ops"
+	default:
+		return "vector_cosine_ops"
+	}
+}
+
+// MaxDistance converts a similarity threshold (higher is more similar) into the largest
+// distance the metric's operator may return:
+//   - cosine: similarity = 1 - distance
+//   - l2: similarity = 1 / (1 + distance)
+//   - inner product: similarity = inner product; pgvector's <#> returns its negation
+func (m VectorMetric) MaxDistance(threshold float64) float64 {
+	switch m {
+	case MetricL2:
+		return 1/threshold - 1
+	case MetricInnerProduct:
+		return -threshold
+	default:
+		return 1 - threshold
+	}
+}
+
+// vectorLiteral formats an embedding as a pgvector input string
+func vectorLiteral(embedding []float32) (string, error) {
+	// pgvector accepts the same [a,b,c] notation as a JSON array
+	literal, err := json.Marshal(embedding)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal embedding: %w", err)
+	}
+	return string(literal), nil
+}
+
+// MigrateVectorColumn adds the native pgvector column to discovered_entities, keeps it in
+// sync with the JSON embedding through a trigger, backfills existing rows and creates the
+// similarity index. It is idempotent and safe to run after every ent migration.
+func MigrateVectorColumn(ctx context.Context, db *sql.DB, cfg VectorConfig, logger *slog.Logger) error {
+	if cfg.Dimensions <= 0 {
+		return fmt.Errorf("invalid embedding dimensions: %d", cfg.Dimensions)
+	}
+
+	if _, err := db.ExecContext(ctx, `CREATE EXTENSION IF NOT EXISTS vector`); err != nil {
+		return fmt.Errorf("failed to enable pgvector extension: %w", err)
+	}
+
+	if _, err := db.ExecContext(ctx, fmt.Sprintf(
+		`ALTER TABLE discovered_entities ADD COLUMN IF NOT EXISTS %s vector(%d)`,
+		embeddingColumn, cfg.Dimensions,
+	)); err != nil {
+		return fmt.Errorf("failed to add embedding column: %w", err)
+	}
+
+	// An existing column with different dimensions needs a manual rebuild
+	var dimensions int
+	err := db.QueryRowContext(ctx, `
+		SELECT atttypmod
+		FROM pg_attribute
+		WHERE attrelid = 'discovered_entities'::regclass AND attname = $1
+	`, embeddingColumn).Scan(&dimensions)
+	if err != nil {
+		return fmt.Errorf("failed to read embedding column type: %w", err)
+	}
+	if dimensions != cfg.Dimensions {
+		return fmt.Errorf("%s has %d dimensions but %d are configured; drop the column to rebuild it", embeddingColumn, dimensions, cfg.Dimensions)
+	}
+
+	// Embeddings with the wrong length (e.g. from another model) are left out of the index
+	syncFunction := fmt.Sprintf(`
+		CREATE OR REPLACE FUNCTION sync_discovered_entity_embedding() RETURNS trigger AS $$
+		BEGIN
+			IF NEW.embedding IS NOT NULL
+				AND jsonb_typeof(NEW.embedding::jsonb) = 'array'
+				AND jsonb_array_length(NEW.embedding::jsonb) = %d THEN
+				NEW.%s := NEW.embedding::text::vector;
+			ELSE
+				NEW.%s := NULL;
+			END IF;
+			RETURN NEW;
+		END;
+		$$ LANGUAGE plpgsql
+	`, cfg.Dimensions, embeddingColumn, embeddingColumn)
+	if _, err := db.ExecContext(ctx, syncFunction); err != nil {
+		return fmt.Errorf("failed to create embedding sync function: %w", err)
+	}
+
+	for _, stmt := range []string{
+		`DROP TRIGGER IF EXISTS discovered_entities_embedding_sync ON discovered_entities`,
+		`CREATE TRIGGER discovered_entities_embedding_sync
+			BEFORE INSERT OR UPDATE OF embedding ON discovered_entities
+			FOR EACH ROW EXECUTE FUNCTION sync_discovered_entity_embedding()`,
+	} {
+		if _, err := db.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("failed to create embedding sync trigger: %w", err)
+		}
+	}
+
+	backfilled, err := backfillVectorColumn(ctx, db, cfg.Dimensions)
+	if err != nil {
+		return err
+	}
+	logger.Info("Backfilled embedding vectors", "rows", backfilled)
+
+	if err := createVectorIndex(ctx, db, cfg); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// backfillVectorColumn copies JSON embeddings into the vector column in batches
+func backfillVectorColumn(ctx context.Context, db *sql.DB, dimensions int) (int64, error) {
+	query := fmt.Sprintf(`
+		UPDATE discovered_entities
+		SET %s = embedding::text::vector
+		WHERE id IN (
+			SELECT id FROM discovered_entities
+			WHERE %s IS NULL
+			  AND embedding IS NOT NULL
+			  AND jsonb_typeof(embedding::jsonb) = 'array'
+			  AND jsonb_array_length(embedding::jsonb) = $1
+			LIMIT $2
+		)
+	`, embeddingColumn, embeddingColumn)
+
+	var total int64
+	for {
+		result, err := db.ExecContext(ctx, query, dimensions, backfillBatchSize)
+		if err != nil {
+			return total, fmt.Errorf("failed to backfill embedding vectors: %w", err)
+		}
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return total, fmt.Errorf("failed to count backfilled rows: %w", err)
+		}
+		total += rows
+		if rows < backfillBatchSize {
+			return total, nil
+		}
+	}
+}
+
+// createVectorIndex creates the HNSW or IVFFlat index for the configured metric
+func createVectorIndex(ctx context.Context, db *sql.DB, cfg VectorConfig) error {
+	indexName := fmt.Sprintf("discovered_entities_embedding_%s_%s", cfg.IndexType, cfg.Metric)
+
+	var stmt string
+	switch cfg.IndexType {
+	case IndexHNSW:
+		stmt = fmt.Sprintf(`CREATE INDEX IF NOT EXISTS %s ON discovered_entities USING hnsw (%s %s)`,
+			indexName, embeddingColumn, cfg.Metric.opClass())
+	case IndexIVFFlat:
+		lists := cfg.Lists
+		if lists <= 0 {
+			lists = DefaultVectorConfig().Lists
+		}
+		stmt = fmt.Sprintf(`CREATE INDEX IF NOT EXISTS %s ON discovered_entities USING ivfflat (%s %s) WITH (lists = %d)`,
+			indexName, embeddingColumn, cfg.Metric.opClass(), lists)
+	default:
+		return fmt.Errorf("unknown vector index type: %s", cfg.IndexType)
+	}
+
+	if _, err := db.ExecContext(ctx, stmt); err != nil {
+		return fmt.Errorf("failed to create vector index: %w", err)
+	}
+	return nil
+}
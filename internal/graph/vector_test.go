@@ -0,0 +1,77 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNewVectorConfig tests parsing vector search settings from configuration
+func TestNewVectorConfig(t *testing.T) {
+	testCases := []struct {
+		name        string
+		dimensions  int
+		metric      string
+		indexType   string
+		expected    VectorConfig
+		expectError bool
+	}{
+		{
+			name:     "defaults",
+			expected: DefaultVectorConfig(),
+		},
+		{
+			name:       "l2 with ivfflat",
+			dimensions: 768,
+			metric:     "L2",
+			indexType:  "ivfflat",
+			expected:   VectorConfig{Dimensions: 768, Metric: MetricL2, IndexType: IndexIVFFlat, Lists: 100},
+		},
+		{
+			name:      "inner product alias",
+			metric:    "inner_product",
+			indexType: "hnsw",
+			expected:  VectorConfig{Dimensions: 1024, Metric: MetricInnerProduct, IndexType: IndexHNSW, Lists: 100},
+		},
+		{
+			name:        "unknown metric",
+			metric:      "manhattan",
+			expectError: true,
+		},
+		{
+			name:        "unknown index",
+			indexType:   "btree",
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg, err := NewVectorConfig(tc.dimensions, tc.metric, tc.indexType)
+			if tc.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, cfg)
+		})
+	}
+}
+
+// TestVectorMetricMaxDistance tests converting similarity thresholds into distance bounds
+func TestVectorMetricMaxDistance(t *testing.T) {
+	assert.InDelta(t, 0.15, MetricCosine.MaxDistance(0.85), 1e-9)
+	assert.InDelta(t, 1.0, MetricL2.MaxDistance(0.5), 1e-9)
+	assert.InDelta(t, -0.7, MetricInnerProduct.MaxDistance(0.7), 1e-9)
+}
+
+// TestVectorMetricOperators tests that each metric uses the matching pgvector operator and index class
+func TestVectorMetricOperators(t *testing.T) {
+	assert.Equal(t, "<=>", MetricCosine.Operator())
+	assert.Equal(t, "vector_cosine_ops", MetricCosine.opClass())
+	assert.Equal(t, "<->", MetricL2.Operator())
+	assert.Equal(t, "vector_l2_ops", MetricL2.opClass())
+	assert.Equal(t, "<#>", MetricInnerProduct.Operator())
+	assert.Equal(t, "vector_ip_ops", MetricInnerProduct.opClass())
+}
@@ -21,6 +21,12 @@ type EmailMetadata struct {
 	BCC       []string
 	Subject   string
 	Body      string
+	// DisplayNames maps addresses to the names shown next to them in the headers
+	DisplayNames map[string]string
+	// InReplyTo and References hold the message IDs of the messages being replied to,
+	// References oldest first
+	InReplyTo  string
+	References []string
 }
 
 // ParseEmailHeaders extracts metadata from a raw email message
@@ -78,6 +84,23 @@ func ParseEmailHeaders(message string) (*EmailMetadata, error) {
 	// Extract BCC
 	metadata.BCC = extractEmailList(msg.Header.Get("Bcc"))
 
+	// Extract display names, falling back to Enron's X-From header for the sender
+	metadata.DisplayNames = extractDisplayNames(msg.Header)
+	if xFrom := cleanDisplayName(msg.Header.Get("X-From")); metadata.From != "" && xFrom != "" {
+		if _, exists := metadata.DisplayNames[metadata.From]; !exists {
+			if metadata.DisplayNames == nil {
+				metadata.DisplayNames = make(map[string]string)
+			}
+			metadata.DisplayNames[metadata.From] = xFrom
+		}
+	}
+
+	// Extract reply headers
+	if parents := extractMessageIDs(msg.Header.Get("In-Reply-To")); len(parents) > 0 {
+		metadata.InReplyTo = parents[0]
+	}
+	metadata.References = extractMessageIDs(msg.Header.Get("References"))
+
 	// Extract Subject
 	metadata.Subject = msg.Header.Get("Subject")
 
@@ -157,6 +180,78 @@ func extractEmailList(field string) []string {
 	return emails
 }
 
+// extractDisplayNames collects the display names of all addresses in the From, To, Cc and Bcc headers
+func extractDisplayNames(header mail.Header) map[string]string {
+	var names map[string]string
+	for _, key := range []string{"From", "To", "Cc", "Bcc"} {
+		field := header.Get(key)
+		if field == "" {
+			continue
+		}
+		addresses, err := mail.ParseAddressList(field)
+		if err != nil {
+			continue
+		}
+		for _, addr := range addresses {
+			name := cleanDisplayName(addr.Name)
+			if addr.Address == "" || name == "" {
+				continue
+			}
+			if names == nil {
+				names = make(map[string]string)
+			}
+			names[addr.Address] = name
+		}
+	}
+	return names
+}
+
+// cleanDisplayName strips Exchange routing suffixes and quotes from a display name and turns
+// "Last, First" into "First Last". Names that are just addresses are dropped.
+func cleanDisplayName(name string) string {
+	if i := strings.Index(name, "<"); i >= 0 {
+		name = name[:i]
+	}
+	name = strings.Trim(strings.TrimSpace(name), "\"' ")
+	if name == "" || strings.Contains(name, "@") {
+		return ""
+	}
+
+	if last, first, ok := strings.Cut(name, ","); ok && !strings.Contains(first, ",") {
+		last, first = strings.TrimSpace(last), strings.TrimSpace(first)
+		if last != "" && first != "" && len(strings.Fields(last)) <= 2 {
+			name = first + " " + last
+		}
+	}
+
+	return strings.Join(strings.Fields(name), " ")
+}
+
+// extractMessageIDs extracts the message IDs from an In-Reply-To or References header.
+// IDs are normally enclosed in angle brackets; headers without brackets are split on whitespace.
+func extractMessageIDs(field string) []string {
+	field = strings.TrimSpace(field)
+	if field == "" {
+		return nil
+	}
+
+	var ids []string
+	if strings.Contains(field, "<") {
+		for _, part := range strings.Split(field, "<")[1:] {
+			id, _, found := strings.Cut(part, ">")
+			if id = strings.TrimSpace(id); found && id != "" {
+				ids = append(ids, id)
+			}
+		}
+		return ids
+	}
+
+	for _, id := range strings.Fields(field) {
+		ids = append(ids, strings.Trim(id, "<>,"))
+	}
+	return ids
+}
+
 // parseAlternativeDate tries alternative date formats
 func parseAlternativeDate(dateStr string) (time.Time, error) {
 	formats := []string{
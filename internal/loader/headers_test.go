@@ -243,3 +243,91 @@ Body`
 		t.Errorf("Expected at least 2 To addresses, got %d", len(headers.To))
 	}
 }
+
+func TestParseEmailHeaders_DisplayNames(t *testing.T) {
+	emailText := `Message-ID: <67890@enron.com>
+From: jeff.skilling@enron.com
+To: "Beck, Sally" <sally.beck@enron.com>, kenneth.lay@enron.com
+Subject: Names
+X-From: Skilling, Jeff </O=ENRON/OU=NA/CN=RECIPIENTS/CN=JSKILLIN>
+X-To: Sally Beck, Kenneth Lay
+
+Body`
+
+	headers, err := ParseEmailHeaders(emailText)
+	if err != nil {
+		t.Fatalf("ParseEmailHeaders failed: %v", err)
+	}
+
+	if got := headers.DisplayNames["jeff.skilling@enron.com"]; got != "Jeff Skilling" {
+		t.Errorf("Expected sender name from X-From 'Jeff Skilling', got '%s'", got)
+	}
+	if got := headers.DisplayNames["sally.beck@enron.com"]; got != "Sally Beck" {
+		t.Errorf("Expected recipient name 'Sally Beck', got '%s'", got)
+	}
+	if _, exists := headers.DisplayNames["kenneth.lay@enron.com"]; exists {
+		t.Error("Expected no display name for a bare address")
+	}
+}
+
+func TestCleanDisplayName(t *testing.T) {
+	tests := map[string]string{
+		"Jeff Skilling":                     "Jeff Skilling",
+		"Skilling, Jeff":                    "Jeff Skilling",
+		`"Lay, Kenneth L."`:                 "Kenneth L. Lay",
+		"Sally Beck <sally.beck@enron.com>": "Sally Beck",
+		"jeff.skilling@enron.com":           "",
+		"Enron North America, Corp, Legal":  "Enron North America, Corp, Legal",
+		"  ":                                "",
+	}
+
+	for input, expected := range tests {
+		if got := cleanDisplayName(input); got != expected {
+			t.Errorf("cleanDisplayName(%q) = %q, want %q", input, got, expected)
+		}
+	}
+}
+
+func TestParseEmailHeaders_ReplyHeaders(t *testing.T) {
+	emailText := `Message-ID: <3@enron.com>
+From: alice@enron.com
+To: bob@enron.com
+Subject: RE: Budget
+In-Reply-To: <2@enron.com>
+References: <1@enron.com>
+ <2@enron.com>
+
+Body`
+
+	headers, err := ParseEmailHeaders(emailText)
+	if err != nil {
+		t.Fatalf("ParseEmailHeaders failed: %v", err)
+	}
+
+	if headers.InReplyTo != "2@enron.com" {
+		t.Errorf("Expected InReplyTo '2@enron.com', got '%s'", headers.InReplyTo)
+	}
+	if len(headers.References) != 2 || headers.References[0] != "1@enron.com" || headers.References[1] != "2@enron.com" {
+		t.Errorf("Expected References [1@enron.com 2@enron.com], got %v", headers.References)
+	}
+}
+
+func TestExtractMessageIDs(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected []string
+	}{
+		{"<a@enron.com>", []string{"a@enron.com"}},
+		{"<a@enron.com> <b@enron.com>", []string{"a@enron.com", "b@enron.com"}},
+		{`"Jeff" <a@enron.com> (comment)`, []string{"a@enron.com"}},
+		{"a@enron.com b@enron.com", []string{"a@enron.com", "b@enron.com"}},
+		{"", nil},
+	}
+
+	for _, tt := range tests {
+		got := extractMessageIDs(tt.input)
+		if strings.Join(got, ",") != strings.Join(tt.expected, ",") {
+			t.Errorf("extractMessageIDs(%q) = %v, want %v", tt.input, got, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package loader
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// MaildirSource reads every message file below a directory, such as the CMU Enron release
+// (maildir/<custodian>/<folder>/<n>.) or a standard maildir with cur/ and new/ folders.
+// Records are identified by their path relative to the root, matching the "file" column of the
+// CSV export when the root is the top-level maildir directory.
+type MaildirSource struct {
+	Root string
+}
+
+// Stream implements EmailSource
+func (s *MaildirSource) Stream() (<-chan EmailRecord, <-chan error, error) {
+	return walkMessageFiles(s.Root, func(path string) bool {
+		// Hidden files such as .DS_Store and maildir index files are not messages
+		return !strings.HasPrefix(filepath.Base(path), ".")
+	})
+}
+
+// EMLSource reads a single .eml file or every .eml file below a directory
+type EMLSource struct {
+	Path string
+}
+
+// Stream implements EmailSource
+func (s *EMLSource) Stream() (<-chan EmailRecord, <-chan error, error) {
+	return walkMessageFiles(s.Path, func(path string) bool {
+		return strings.EqualFold(filepath.Ext(path), ".eml")
+	})
+}
+
+// walkMessageFiles streams each accepted file below root as one record. A root that is a
+// file is streamed on its own, identified by its base name.
+func walkMessageFiles(root string, accept func(path string) bool) (<-chan EmailRecord, <-chan error, error) {
+	info, err := os.Stat(root)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open source: %w", err)
+	}
+
+	records := make(chan EmailRecord, 100)
+	errors := make(chan error, 10)
+
+	go func() {
+		defer close(records)
+		defer close(errors)
+
+		if !info.IsDir() {
+			readMessageFile(root, filepath.Base(root), records, errors)
+			return
+		}
+
+		walkErr := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				errors <- fmt.Errorf("error reading %s: %w", path, err)
+				if d != nil && d.IsDir() {
+					return fs.SkipDir
+				}
+				return nil
+			}
+			if d.IsDir() {
+				// Maildir tmp/ folders hold messages that are still being delivered
+				if path != root && (d.Name() == "tmp" || strings.HasPrefix(d.Name(), ".")) {
+					return fs.SkipDir
+				}
+				return nil
+			}
+			if !d.Type().IsRegular() || !accept(path) {
+				return nil
+			}
+
+			rel, err := filepath.Rel(root, path)
+			if err != nil {
+				rel = path
+			}
+			readMessageFile(path, filepath.ToSlash(rel), records, errors)
+			return nil
+		})
+		if walkErr != nil {
+			errors <- fmt.Errorf("error walking %s: %w", root, walkErr)
+		}
+	}()
+
+	return records, errors, nil
+}
+
+// readMessageFile sends the content of one message file as a record
+func readMessageFile(path, id string, records chan<- EmailRecord, errors chan<- error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		errors <- fmt.Errorf("error reading %s: %w", path, err)
+		return
+	}
+	records <- EmailRecord{
+		File:    id,
+		Message: string(content),
+	}
+}
@@ -0,0 +1,92 @@
+package loader
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// mboxSeparator matches the "From sender date" line that starts a message. Requiring the
+// time of day keeps unescaped body lines such as "From now on..." from splitting a message.
+var mboxSeparator = regexp.MustCompile(`^From \S+\s.*\d{1,2}:\d{2}`)
+
+// mboxFromQuoted matches body lines that mbox writers escaped with ">" because they started with "From "
+var mboxFromQuoted = regexp.MustCompile(`^>+From `)
+
+// maxMboxLineSize bounds a single line of an mbox file
+const maxMboxLineSize = 10 * 1024 * 1024
+
+// MboxSource reads the messages of an mbox file. Messages are separated by "From " lines
+// and identified as "<file name>#<n>", counting from 1.
+type MboxSource struct {
+	Path string
+}
+
+// Stream implements EmailSource
+func (s *MboxSource) Stream() (<-chan EmailRecord, <-chan error, error) {
+	file, err := os.Open(s.Path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open mbox file: %w", err)
+	}
+
+	records := make(chan EmailRecord, 100)
+	errors := make(chan error, 10)
+	name := filepath.Base(s.Path)
+
+	go func() {
+		defer close(records)
+		defer close(errors)
+		defer file.Close()
+
+		scanner := bufio.NewScanner(file)
+		scanner.Buffer(make([]byte, 64*1024), maxMboxLineSize)
+
+		var message strings.Builder
+		count := 0
+		inMessage := false
+		previousBlank := true
+
+		flush := func() {
+			if !inMessage {
+				return
+			}
+			count++
+			records <- EmailRecord{
+				File:    fmt.Sprintf("%s#%d", name, count),
+				Message: strings.TrimSuffix(message.String(), "\n"),
+			}
+			message.Reset()
+		}
+
+		for scanner.Scan() {
+			line := strings.TrimSuffix(scanner.Text(), "\r")
+
+			// A "From " line after a blank line (or at the start) begins the next message
+			if previousBlank && mboxSeparator.MatchString(line) {
+				flush()
+				inMessage = true
+				previousBlank = false
+				continue
+			}
+			previousBlank = line == ""
+			if !inMessage {
+				continue
+			}
+
+			if mboxFromQuoted.MatchString(line) {
+				line = line[1:]
+			}
+			message.WriteString(line)
+			message.WriteString("\n")
+		}
+		if err := scanner.Err(); err != nil {
+			errors <- fmt.Errorf("error reading %s after message %d: %w", s.Path, count, err)
+		}
+		flush()
+	}()
+
+	return records, errors, nil
+}
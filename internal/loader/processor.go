@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	"github.com/Blogem/enron-graph/ent"
 	"github.com/Blogem/enron-graph/internal/graph"
 )
 
@@ -16,6 +18,7 @@ type ProcessorStats struct {
 	Processed int64
 	Failures  int64
 	Skipped   int64 // Duplicates
+	Threaded  int64
 	StartTime time.Time
 }
 
@@ -25,6 +28,11 @@ type Processor struct {
 	logger  *slog.Logger
 	workers int
 	stats   *ProcessorStats
+
+	threader *Threader
+	// loaded holds the emails created in this batch until they are threaded
+	loaded   []*ent.Email
+	loadedMu sync.Mutex
 }
 
 // NewProcessor creates a new email processor
@@ -36,6 +44,7 @@ func NewProcessor(repo graph.Repository, logger *slog.Logger, workers int) *Proc
 		stats: &ProcessorStats{
 			StartTime: time.Now(),
 		},
+		threader: NewThreader(repo, logger),
 	}
 }
 
@@ -50,10 +59,10 @@ func (p *Processor) ProcessBatch(ctx context.Context, records <-chan EmailRecord
 	var lastError error
 	var errorMu sync.Mutex
 
-	// Process errors from CSV parser
+	// Process errors from the email source
 	go func() {
 		for err := range errors {
-			p.logger.Warn("CSV parsing error", "error", err)
+			p.logger.Warn("Email source error", "error", err)
 		}
 	}()
 
@@ -96,6 +105,9 @@ func (p *Processor) ProcessBatch(ctx context.Context, records <-chan EmailRecord
 	// Wait for all workers to finish
 	wg.Wait()
 
+	// Reconstruct conversations once every email of the batch is loaded
+	p.threadLoaded(ctx)
+
 	// Final progress report
 	p.logProgress()
 
@@ -129,25 +141,72 @@ func (p *Processor) processEmail(ctx context.Context, record EmailRecord) error
 
 	// Create email entity
 	emailInput := &graph.EmailInput{
-		MessageID: metadata.MessageID,
-		From:      metadata.From,
-		To:        metadata.To,
-		CC:        metadata.CC,
-		BCC:       metadata.BCC,
-		Subject:   metadata.Subject,
-		Date:      metadata.Date,
-		Body:      metadata.Body,
-		FilePath:  record.File,
+		MessageID:    metadata.MessageID,
+		From:         metadata.From,
+		To:           metadata.To,
+		CC:           metadata.CC,
+		BCC:          metadata.BCC,
+		Subject:      metadata.Subject,
+		Date:         metadata.Date,
+		Body:         metadata.Body,
+		FilePath:     record.File,
+		DisplayNames: metadata.DisplayNames,
+		InReplyTo:    metadata.InReplyTo,
+		References:   metadata.References,
 	}
 
-	_, err = p.repo.CreateEmail(ctx, emailInput)
+	created, err := p.repo.CreateEmail(ctx, emailInput)
 	if err != nil {
 		return fmt.Errorf("failed to create email entity: %w", err)
 	}
 
+	// Only the quoted original message is needed from the body to thread the email
+	pending := *created
+	pending.Body = quotedOriginal(created.Body)
+	p.loadedMu.Lock()
+	p.loaded = append(p.loaded, &pending)
+	p.loadedMu.Unlock()
+
 	return nil
 }
 
+// threadLoaded files the emails created in this batch into threads, oldest first so replies
+// find their conversation. Threading failures are logged and do not fail the load.
+func (p *Processor) threadLoaded(ctx context.Context) {
+	p.loadedMu.Lock()
+	emails := p.loaded
+	p.loaded = nil
+	p.loadedMu.Unlock()
+
+	sort.SliceStable(emails, func(i, j int) bool {
+		return emails[i].Date.Before(emails[j].Date)
+	})
+
+	for _, email := range emails {
+		if ctx.Err() != nil {
+			p.logger.Info("Threading cancelled", "reason", ctx.Err())
+			return
+		}
+		if _, err := p.threader.ThreadEmail(ctx, email); err != nil {
+			p.logger.Warn("Failed to thread email", "message_id", email.MessageID, "error", err)
+			continue
+		}
+		atomic.AddInt64(&p.stats.Threaded, 1)
+	}
+
+	if len(emails) > 0 {
+		p.logger.Info("Threading complete", "threaded", atomic.LoadInt64(&p.stats.Threaded), "emails", len(emails))
+	}
+}
+
+// quotedOriginal returns the part of a body from the first quoted or forwarded message on, or ""
+func quotedOriginal(body string) string {
+	if loc := segmentMarker.FindStringIndex(body); loc != nil {
+		return body[loc[0]:]
+	}
+	return ""
+}
+
 // logProgress logs the current processing progress
 func (p *Processor) logProgress() {
 	elapsed := time.Since(p.stats.StartTime)
@@ -169,6 +228,7 @@ func (p *Processor) GetStats() ProcessorStats {
 		Processed: atomic.LoadInt64(&p.stats.Processed),
 		Failures:  atomic.LoadInt64(&p.stats.Failures),
 		Skipped:   atomic.LoadInt64(&p.stats.Skipped),
+		Threaded:  atomic.LoadInt64(&p.stats.Threaded),
 		StartTime: p.stats.StartTime,
 	}
 }
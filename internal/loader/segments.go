@@ -0,0 +1,181 @@
+package loader
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Segment kinds
+const (
+	SegmentAuthored  = "authored"  // text written by the sender of the email
+	SegmentQuoted    = "quoted"    // an earlier message of the conversation being replied to
+	SegmentForwarded = "forwarded" // a message passed on by the sender
+)
+
+// Segment is a part of an email body together with the headers embedded above it.
+// Header fields are empty for authored text and when the quoted block carries no headers.
+type Segment struct {
+	Kind    string
+	Text    string
+	From    string
+	To      string
+	Cc      string
+	Subject string
+	Date    string // as written, e.g. "Monday, May 14, 2001 9:00 AM"
+}
+
+// segmentMarker matches the separator lines Outlook and Lotus Notes put above quoted and
+// forwarded messages, such as "-----Original Message-----" and
+// "---------------------- Forwarded by Jeff Dasovich/NA/Enron on 05/14/2001 10:00 AM -----"
+var segmentMarker = regexp.MustCompile(`(?mi)^[ \t]*-{3,}[ \t]*(original message|forwarded (?:by|message))\b.*$`)
+
+// embeddedHeader matches a header line of a quoted or forwarded message
+var embeddedHeader = regexp.MustCompile(`(?i)^(from|sent|date|to|cc|bcc|subject)[ \t]*:[ \t]*(.*)$`)
+
+// authorDateSuffix matches the date Lotus Notes appends to an author line:
+// `"Smith, John" <jsmith@enron.com> on 05/14/2001 09:00:00 AM`
+var authorDateSuffix = regexp.MustCompile(`(?i)\s+on\s+(\d{1,2}/\d{1,2}/\d{2,4}.*)$`)
+
+// dateLine matches a Lotus Notes date line such as "05/14/2001 09:00 AM"
+var dateLine = regexp.MustCompile(`^\d{1,2}/\d{1,2}/\d{2,4}\b`)
+
+// maxAuthorLookahead bounds how far below a bare author line the first header may appear
+const maxAuthorLookahead = 3
+
+// SegmentBody splits an email body into the sender's authored text followed by the quoted and
+// forwarded messages below it, in order. The first segment is always the authored text, which
+// may be empty for a forward without comment. Lines quoted with ">" in the authored text are
+// moved to a quoted segment of their own.
+func SegmentBody(body string) []Segment {
+	body = strings.ReplaceAll(body, "\r\n", "\n")
+	markers := segmentMarker.FindAllStringSubmatchIndex(body, -1)
+
+	authoredEnd := len(body)
+	if len(markers) > 0 {
+		authoredEnd = markers[0][0]
+	}
+	authored, inline := splitInlineQuotes(body[:authoredEnd])
+
+	segments := []Segment{{Kind: SegmentAuthored, Text: authored}}
+	if inline != "" {
+		segments = append(segments, Segment{Kind: SegmentQuoted, Text: inline})
+	}
+
+	for i, marker := range markers {
+		end := len(body)
+		if i+1 < len(markers) {
+			end = markers[i+1][0]
+		}
+
+		kind := SegmentForwarded
+		if strings.EqualFold(body[marker[2]:marker[3]], "original message") {
+			kind = SegmentQuoted
+		}
+
+		segment := parseEmbeddedHeaders(body[marker[1]:end])
+		segment.Kind = kind
+		segments = append(segments, segment)
+	}
+
+	return segments
+}
+
+// AuthoredText returns the part of a body written by its sender
+func AuthoredText(body string) string {
+	return SegmentBody(body)[0].Text
+}
+
+// splitInlineQuotes separates ">"-prefixed lines from the rest of the text
+func splitInlineQuotes(text string) (authored, quoted string) {
+	var own, quotes []string
+	for _, line := range strings.Split(text, "\n") {
+		trimmed := strings.TrimLeft(line, " \t")
+		if strings.HasPrefix(trimmed, ">") {
+			quotes = append(quotes, strings.TrimPrefix(strings.TrimLeft(trimmed, ">"), " "))
+			continue
+		}
+		own = append(own, line)
+	}
+	return strings.TrimSpace(strings.Join(own, "\n")), strings.TrimSpace(strings.Join(quotes, "\n"))
+}
+
+// parseEmbeddedHeaders reads the header block at the top of a quoted or forwarded message and
+// returns it with the remaining text. Besides "Key: value" lines, the Lotus Notes layout with the
+// author and date on lines of their own above To: and Subject: is recognized.
+func parseEmbeddedHeaders(text string) Segment {
+	var segment Segment
+	lines := strings.Split(text, "\n")
+
+	i := 0
+	for i < len(lines) && strings.TrimSpace(lines[i]) == "" {
+		i++
+	}
+
+	if i < len(lines) && !embeddedHeader.MatchString(strings.TrimSpace(lines[i])) && headerFollows(lines, i+1) {
+		author := strings.TrimSpace(lines[i])
+		if match := authorDateSuffix.FindStringSubmatch(author); match != nil {
+			segment.Date = strings.TrimSpace(match[1])
+			author = strings.TrimSpace(author[:len(author)-len(match[0])])
+		}
+		segment.From = author
+		i++
+		if i < len(lines) && dateLine.MatchString(strings.TrimSpace(lines[i])) {
+			segment.Date = strings.TrimSpace(lines[i])
+			i++
+		}
+	}
+
+	var lastField *string
+	for ; i < len(lines); i++ {
+		line := lines[i]
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" {
+			if lastField != nil {
+				// A blank line ends the header block
+				i++
+				break
+			}
+			continue
+		}
+
+		match := embeddedHeader.FindStringSubmatch(trimmed)
+		if match == nil {
+			// Long To: and Cc: lists wrap onto indented continuation lines
+			if lastField != nil && (line[0] == ' ' || line[0] == '\t') {
+				*lastField = strings.TrimSpace(*lastField + " " + trimmed)
+				continue
+			}
+			break
+		}
+
+		value := strings.TrimSpace(match[2])
+		switch strings.ToLower(match[1]) {
+		case "from":
+			lastField = &segment.From
+		case "sent", "date":
+			lastField = &segment.Date
+		case "to":
+			lastField = &segment.To
+		case "cc", "bcc":
+			lastField = &segment.Cc
+		case "subject":
+			lastField = &segment.Subject
+		}
+		*lastField = value
+	}
+
+	if i < len(lines) {
+		segment.Text = strings.TrimSpace(strings.Join(lines[i:], "\n"))
+	}
+	return segment
+}
+
+// headerFollows reports whether a header line appears within maxAuthorLookahead lines of start
+func headerFollows(lines []string, start int) bool {
+	for i := start; i < len(lines) && i < start+maxAuthorLookahead; i++ {
+		if embeddedHeader.MatchString(strings.TrimSpace(lines[i])) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,130 @@
+package loader
+
+import (
+	"testing"
+)
+
+func TestSegmentBody_Reply(t *testing.T) {
+	body := `Ken, the numbers are attached.
+
+Jeff
+
+ -----Original Message-----
+From: 	Lay, Kenneth
+Sent:	Monday, May 14, 2001 9:00 AM
+To:	Skilling, Jeff
+Cc:	Beck, Sally;
+	Kean, Steven
+Subject:	Q2 forecast
+
+Can you send me the Q2 numbers?`
+
+	segments := SegmentBody(body)
+	if len(segments) != 2 {
+		t.Fatalf("Expected 2 segments, got %d: %+v", len(segments), segments)
+	}
+
+	if segments[0].Kind != SegmentAuthored || segments[0].Text != "Ken, the numbers are attached.\n\nJeff" {
+		t.Errorf("Unexpected authored segment: %+v", segments[0])
+	}
+
+	quoted := segments[1]
+	if quoted.Kind != SegmentQuoted {
+		t.Errorf("Expected quoted segment, got %s", quoted.Kind)
+	}
+	if quoted.From != "Lay, Kenneth" || quoted.To != "Skilling, Jeff" || quoted.Subject != "Q2 forecast" {
+		t.Errorf("Unexpected quoted headers: %+v", quoted)
+	}
+	if quoted.Date != "Monday, May 14, 2001 9:00 AM" {
+		t.Errorf("Expected quoted date, got '%s'", quoted.Date)
+	}
+	if quoted.Cc != "Beck, Sally; Kean, Steven" {
+		t.Errorf("Expected wrapped Cc to be joined, got '%s'", quoted.Cc)
+	}
+	if quoted.Text != "Can you send me the Q2 numbers?" {
+		t.Errorf("Unexpected quoted text: '%s'", quoted.Text)
+	}
+}
+
+func TestSegmentBody_LotusNotesForward(t *testing.T) {
+	body := `FYI
+---------------------- Forwarded by Jeff Dasovich/NA/Enron on 05/14/2001 10:00 AM ---------------------------
+
+
+Steven J Kean
+05/14/2001 09:00 AM
+To:	Jeff Dasovich/NA/Enron@Enron
+cc:
+Subject:	California update
+
+The PUC meets on Thursday.
+
+---------------------- Forwarded by Steven J Kean/NA/Enron on 05/14/2001 08:00 AM ---------------------------
+
+"Smith, John" <jsmith@pge.com> on 05/13/2001 05:00:00 PM
+To: Steven J Kean/NA/Enron@Enron
+Subject: PUC agenda
+
+Agenda attached.`
+
+	segments := SegmentBody(body)
+	if len(segments) != 3 {
+		t.Fatalf("Expected 3 segments, got %d: %+v", len(segments), segments)
+	}
+
+	if segments[0].Text != "FYI" {
+		t.Errorf("Expected authored text 'FYI', got '%s'", segments[0].Text)
+	}
+
+	first := segments[1]
+	if first.Kind != SegmentForwarded || first.From != "Steven J Kean" || first.Date != "05/14/2001 09:00 AM" {
+		t.Errorf("Unexpected first forwarded segment: %+v", first)
+	}
+	if first.Subject != "California update" || first.Text != "The PUC meets on Thursday." {
+		t.Errorf("Unexpected first forwarded content: %+v", first)
+	}
+
+	second := segments[2]
+	if second.From != `"Smith, John" <jsmith@pge.com>` || second.Date != "05/13/2001 05:00:00 PM" {
+		t.Errorf("Expected author and date from the Lotus Notes author line, got %+v", second)
+	}
+	if second.Subject != "PUC agenda" || second.Text != "Agenda attached." {
+		t.Errorf("Unexpected second forwarded content: %+v", second)
+	}
+}
+
+func TestSegmentBody_InlineQuotes(t *testing.T) {
+	body := "Agreed.\n\n> Should we move the meeting?\n> It clashes with the board call.\n\nSee you then."
+
+	segments := SegmentBody(body)
+	if len(segments) != 2 {
+		t.Fatalf("Expected 2 segments, got %d", len(segments))
+	}
+	if segments[0].Text != "Agreed.\n\n\nSee you then." {
+		t.Errorf("Unexpected authored text: %q", segments[0].Text)
+	}
+	if segments[1].Kind != SegmentQuoted || segments[1].Text != "Should we move the meeting?\nIt clashes with the board call." {
+		t.Errorf("Unexpected quoted segment: %+v", segments[1])
+	}
+}
+
+func TestSegmentBody_PlainAndEmpty(t *testing.T) {
+	tests := map[string]string{
+		"Just a note.\n":    "Just a note.",
+		"":                  "",
+		"---\nNot a marker": "---\nNot a marker",
+	}
+
+	for body, expected := range tests {
+		segments := SegmentBody(body)
+		if len(segments) != 1 || segments[0].Kind != SegmentAuthored || segments[0].Text != expected {
+			t.Errorf("SegmentBody(%q) = %+v, want single authored segment %q", body, segments, expected)
+		}
+	}
+
+	// A forward without comment has empty authored text
+	segments := SegmentBody("-----Original Message-----\nFrom: a@enron.com\n\nHello")
+	if len(segments) != 2 || segments[0].Text != "" || segments[1].From != "a@enron.com" || segments[1].Text != "Hello" {
+		t.Errorf("Unexpected segments for a bare quote: %+v", segments)
+	}
+}
@@ -0,0 +1,102 @@
+package loader
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Source kinds accepted by NewEmailSource
+const (
+	SourceAuto    = "auto"
+	SourceCSV     = "csv"
+	SourceMaildir = "maildir"
+	SourceMbox    = "mbox"
+	SourceEML     = "eml"
+)
+
+// SourceKinds lists the source kinds for flag help and validation
+var SourceKinds = []string{SourceAuto, SourceCSV, SourceMaildir, SourceMbox, SourceEML}
+
+// EmailSource streams raw emails from an input such as a CSV export or a mail directory
+type EmailSource interface {
+	// Stream starts reading in the background. Both channels are closed once the input is
+	// exhausted; errors for individual messages are sent on the error channel and reading continues.
+	Stream() (<-chan EmailRecord, <-chan error, error)
+}
+
+// NewEmailSource returns the reader for the given kind. With SourceAuto (or "") the kind is
+// detected from the path.
+func NewEmailSource(kind, path string) (EmailSource, error) {
+	if path == "" {
+		return nil, fmt.Errorf("source path is required")
+	}
+	if kind == "" || kind == SourceAuto {
+		detected, err := DetectSourceKind(path)
+		if err != nil {
+			return nil, err
+		}
+		kind = detected
+	}
+
+	switch kind {
+	case SourceCSV:
+		return &CSVSource{Path: path}, nil
+	case SourceMaildir:
+		return &MaildirSource{Root: path}, nil
+	case SourceMbox:
+		return &MboxSource{Path: path}, nil
+	case SourceEML:
+		return &EMLSource{Path: path}, nil
+	default:
+		return nil, fmt.Errorf("unknown source %q: expected one of %s", kind, strings.Join(SourceKinds, ", "))
+	}
+}
+
+// DetectSourceKind guesses the source kind of a path: directories of .eml files are eml, other
+// directories maildir; files are classified by extension, falling back to the mbox "From " line
+func DetectSourceKind(path string) (string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to open source: %w", err)
+	}
+
+	if info.IsDir() {
+		matches, _ := filepath.Glob(filepath.Join(path, "*.eml"))
+		if len(matches) > 0 {
+			return SourceEML, nil
+		}
+		return SourceMaildir, nil
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return SourceCSV, nil
+	case ".eml":
+		return SourceEML, nil
+	case ".mbox", ".mbx":
+		return SourceMbox, nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to open source: %w", err)
+	}
+	defer file.Close()
+	head := make([]byte, 5)
+	if n, _ := file.Read(head); n == 5 && string(head) == "From " {
+		return SourceMbox, nil
+	}
+	return "", fmt.Errorf("cannot detect source kind of %s, use --source", path)
+}
+
+// CSVSource reads the Kaggle-style CSV export with "file" and "message" columns
+type CSVSource struct {
+	Path string
+}
+
+// Stream implements EmailSource
+func (s *CSVSource) Stream() (<-chan EmailRecord, <-chan error, error) {
+	return ParseCSV(s.Path)
+}
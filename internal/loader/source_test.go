@@ -0,0 +1,172 @@
+package loader
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestMaildirSource(t *testing.T) {
+	root := t.TempDir()
+	writeTestFile(t, filepath.Join(root, "allen-p", "inbox", "1."), "Message-ID: <1@enron.com>\nSubject: One\n\nBody one")
+	writeTestFile(t, filepath.Join(root, "allen-p", "sent", "2."), "Message-ID: <2@enron.com>\nSubject: Two\n\nBody two")
+	writeTestFile(t, filepath.Join(root, "allen-p", ".DS_Store"), "not a message")
+	writeTestFile(t, filepath.Join(root, "lay-k", "tmp", "3."), "still being delivered")
+
+	records := collectRecords(t, &MaildirSource{Root: root})
+	if len(records) != 2 {
+		t.Fatalf("Expected 2 records, got %d: %v", len(records), records)
+	}
+	if records[0].File != "allen-p/inbox/1." || records[1].File != "allen-p/sent/2." {
+		t.Errorf("Expected paths relative to the root, got %s and %s", records[0].File, records[1].File)
+	}
+
+	metadata, err := ParseEmailHeaders(records[0].Message)
+	if err != nil {
+		t.Fatalf("ParseEmailHeaders failed: %v", err)
+	}
+	if metadata.MessageID != "1@enron.com" || metadata.Body != "Body one" {
+		t.Errorf("Unexpected message: %+v", metadata)
+	}
+}
+
+func TestMboxSource(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "custodian.mbox")
+	content := `From alice@enron.com Mon Jan  1 10:00:00 2001
+Message-ID: <1@enron.com>
+Subject: First
+
+Hello
+>From the desk of Alice
+
+From bob@enron.com Mon Jan  1 11:00:00 2001
+Message-ID: <2@enron.com>
+Subject: Second
+
+From now on we meet on Mondays.
+`
+	writeTestFile(t, path, content)
+
+	records := collectRecords(t, &MboxSource{Path: path})
+	if len(records) != 2 {
+		t.Fatalf("Expected 2 records, got %d", len(records))
+	}
+	if records[0].File != "custodian.mbox#1" || records[1].File != "custodian.mbox#2" {
+		t.Errorf("Unexpected record IDs: %s, %s", records[0].File, records[1].File)
+	}
+	if !strings.Contains(records[0].Message, "\nFrom the desk of Alice") {
+		t.Errorf("Expected escaped From line to be unquoted, got %q", records[0].Message)
+	}
+	// A "From " line that does not follow a blank line is body text
+	if !strings.Contains(records[1].Message, "From now on") {
+		t.Errorf("Expected body line starting with From to be kept, got %q", records[1].Message)
+	}
+}
+
+func TestEMLSource(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFile(t, filepath.Join(dir, "a.eml"), "Subject: A\n\nBody")
+	writeTestFile(t, filepath.Join(dir, "nested", "b.EML"), "Subject: B\n\nBody")
+	writeTestFile(t, filepath.Join(dir, "notes.txt"), "not a message")
+
+	records := collectRecords(t, &EMLSource{Path: dir})
+	if len(records) != 2 || records[0].File != "a.eml" || records[1].File != "nested/b.EML" {
+		t.Errorf("Expected the two .eml files, got %v", records)
+	}
+
+	single := collectRecords(t, &EMLSource{Path: filepath.Join(dir, "a.eml")})
+	if len(single) != 1 || single[0].File != "a.eml" {
+		t.Errorf("Expected a single record for an .eml file, got %v", single)
+	}
+}
+
+func TestNewEmailSource_Detection(t *testing.T) {
+	dir := t.TempDir()
+	csvPath := filepath.Join(dir, "emails.csv")
+	writeTestFile(t, csvPath, "file,message\n")
+	mboxPath := filepath.Join(dir, "archive")
+	writeTestFile(t, mboxPath, "From alice@enron.com Mon Jan  1 10:00:00 2001\nSubject: x\n\nBody\n")
+	emlDir := filepath.Join(dir, "eml")
+	writeTestFile(t, filepath.Join(emlDir, "a.eml"), "Subject: A\n\nBody")
+	maildir := filepath.Join(dir, "maildir")
+	writeTestFile(t, filepath.Join(maildir, "lay-k", "inbox", "1."), "Subject: A\n\nBody")
+
+	tests := []struct {
+		path     string
+		expected EmailSource
+	}{
+		{csvPath, &CSVSource{Path: csvPath}},
+		{mboxPath, &MboxSource{Path: mboxPath}},
+		{emlDir, &EMLSource{Path: emlDir}},
+		{maildir, &MaildirSource{Root: maildir}},
+	}
+	for _, tt := range tests {
+		source, err := NewEmailSource(SourceAuto, tt.path)
+		if err != nil {
+			t.Errorf("NewEmailSource(%s) failed: %v", tt.path, err)
+			continue
+		}
+		if got, want := describeSource(source), describeSource(tt.expected); got != want {
+			t.Errorf("NewEmailSource(%s) = %s, want %s", tt.path, got, want)
+		}
+	}
+
+	if _, err := NewEmailSource("pst", csvPath); err == nil {
+		t.Error("Expected an error for an unknown source kind")
+	}
+	if _, err := NewEmailSource(SourceAuto, filepath.Join(dir, "missing")); err == nil {
+		t.Error("Expected an error for a missing path")
+	}
+}
+
+func describeSource(source EmailSource) string {
+	switch s := source.(type) {
+	case *CSVSource:
+		return "csv:" + s.Path
+	case *MaildirSource:
+		return "maildir:" + s.Root
+	case *MboxSource:
+		return "mbox:" + s.Path
+	case *EMLSource:
+		return "eml:" + s.Path
+	}
+	return "unknown"
+}
+
+// collectRecords drains a source, failing on errors, and returns the records sorted by ID
+func collectRecords(t *testing.T, source EmailSource) []EmailRecord {
+	t.Helper()
+	records, errors, err := source.Stream()
+	if err != nil {
+		t.Fatalf("Stream failed: %v", err)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for err := range errors {
+			t.Errorf("Unexpected source error: %v", err)
+		}
+	}()
+
+	var collected []EmailRecord
+	for record := range records {
+		collected = append(collected, record)
+	}
+	<-done
+
+	sort.Slice(collected, func(i, j int) bool { return collected[i].File < collected[j].File })
+	return collected
+}
+
+func writeTestFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatalf("Failed to create directory: %v", err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+}
@@ -0,0 +1,160 @@
+package loader
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Blogem/enron-graph/ent"
+	"github.com/Blogem/enron-graph/internal/graph"
+)
+
+// DefaultThreadWindow is how long a conversation stays open for replies matched by subject
+const DefaultThreadWindow = 30 * 24 * time.Hour
+
+// Confidence of thread links that were inferred rather than read from reply headers
+const inferredThreadConfidence = 0.7
+
+// maxThreadDepth bounds how many ancestors are threaded before a reply
+const maxThreadDepth = 50
+
+// replyPrefix matches one or more Re:/Fw:/Fwd: prefixes, including counted forms like "Re[2]:"
+var replyPrefix = regexp.MustCompile(`(?i)^\s*((re|fw|fwd)\s*(\[\d+\]|\(\d+\))?\s*:\s*)+`)
+
+// StripSubjectPrefixes removes leading Re:/Fw:/Fwd: prefixes from a subject
+func StripSubjectPrefixes(subject string) string {
+	return strings.TrimSpace(replyPrefix.ReplaceAllString(subject, ""))
+}
+
+// IsReplySubject reports whether a subject carries a Re:/Fw:/Fwd: prefix
+func IsReplySubject(subject string) bool {
+	return replyPrefix.MatchString(subject)
+}
+
+// QuotedSubject returns the subject of the first quoted message with headers in a body, or ""
+// when the body quotes no earlier message
+func QuotedSubject(body string) string {
+	for _, segment := range SegmentBody(body) {
+		if segment.Kind == SegmentQuoted && segment.Subject != "" {
+			return segment.Subject
+		}
+	}
+	return ""
+}
+
+// Threader files emails into conversation threads. Replies are matched to their parent by
+// In-Reply-To and References headers; emails without usable headers fall back to a thread with
+// the same subject when they look like replies (Re:/Fw: prefix or a quoted original message).
+type Threader struct {
+	repo   graph.Repository
+	logger *slog.Logger
+	window time.Duration
+}
+
+// NewThreader creates a threader that matches replies by subject within DefaultThreadWindow
+func NewThreader(repo graph.Repository, logger *slog.Logger) *Threader {
+	return &Threader{
+		repo:   repo,
+		logger: logger,
+		window: DefaultThreadWindow,
+	}
+}
+
+// ThreadEmail files an email into its conversation and returns the thread. Emails should be
+// threaded oldest first so that subject matches can find the conversation they reply to.
+func (t *Threader) ThreadEmail(ctx context.Context, email *ent.Email) (*ent.Thread, error) {
+	return t.threadEmail(ctx, email, 0)
+}
+
+func (t *Threader) threadEmail(ctx context.Context, email *ent.Email, depth int) (*ent.Thread, error) {
+	if existing, err := t.repo.FindThreadByEmail(ctx, email.ID); err == nil && existing != nil {
+		return existing, nil
+	}
+
+	input := &graph.ThreadInput{
+		Email:   email,
+		Subject: StripSubjectPrefixes(email.Subject),
+	}
+
+	if parent, method := t.findParent(ctx, email); parent != nil {
+		// Thread the parent first so that the reply joins the conversation of its root
+		if depth < maxThreadDepth {
+			if _, err := t.threadEmail(ctx, parent, depth+1); err != nil {
+				return nil, err
+			}
+		}
+		input.Parent = parent
+		input.Method = method
+		input.Confidence = 1.0
+	} else if thread, method := t.findThreadBySubject(ctx, email); thread != nil {
+		messages, err := t.repo.FindThreadEmails(ctx, thread.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load thread %d: %w", thread.ID, err)
+		}
+		// The reply answers the latest message that was sent before it
+		for _, message := range messages {
+			if message.Email.ID != email.ID && !message.Email.Date.After(email.Date) {
+				input.Parent = message.Email
+			}
+		}
+		input.Thread = thread
+		input.Method = method
+		input.Confidence = inferredThreadConfidence
+	}
+
+	thread, err := t.repo.ThreadEmail(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to thread email %s: %w", email.MessageID, err)
+	}
+	return thread, nil
+}
+
+// findParent looks up the replied-to email through the In-Reply-To header, then through
+// References from newest to oldest
+func (t *Threader) findParent(ctx context.Context, email *ent.Email) (*ent.Email, string) {
+	if parent := t.findEmail(ctx, email, email.InReplyTo); parent != nil {
+		return parent, graph.ThreadByInReplyTo
+	}
+	for i := len(email.References) - 1; i >= 0; i-- {
+		if parent := t.findEmail(ctx, email, email.References[i]); parent != nil {
+			return parent, graph.ThreadByReferences
+		}
+	}
+	return nil, ""
+}
+
+func (t *Threader) findEmail(ctx context.Context, email *ent.Email, messageID string) *ent.Email {
+	if messageID == "" || messageID == email.MessageID {
+		return nil
+	}
+	parent, err := t.repo.FindEmailByMessageID(ctx, messageID)
+	if err != nil || parent == nil {
+		t.logger.Debug("Replied-to email not loaded", "message_id", email.MessageID, "parent", messageID)
+		return nil
+	}
+	return parent
+}
+
+// findThreadBySubject finds the open conversation a reply without usable headers belongs to
+func (t *Threader) findThreadBySubject(ctx context.Context, email *ent.Email) (*ent.Thread, string) {
+	subject, method := "", ""
+	if quoted := QuotedSubject(email.Body); quoted != "" {
+		subject, method = quoted, graph.ThreadByQuote
+	} else if IsReplySubject(email.Subject) {
+		subject, method = email.Subject, graph.ThreadBySubject
+	}
+
+	subject = StripSubjectPrefixes(subject)
+	if subject == "" {
+		return nil, ""
+	}
+
+	thread, err := t.repo.FindThreadBySubject(ctx, subject, email.Date.Add(-t.window))
+	if err != nil || thread == nil {
+		return nil, ""
+	}
+	return thread, method
+}
@@ -0,0 +1,128 @@
+package loader
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/Blogem/enron-graph/ent"
+	"github.com/Blogem/enron-graph/internal/graph"
+)
+
+func TestStripSubjectPrefixes(t *testing.T) {
+	tests := map[string]string{
+		"Budget":               "Budget",
+		"Re: Budget":           "Budget",
+		"RE: FW: Budget":       "Budget",
+		"Fwd:Budget":           "Budget",
+		"Re[2]: Budget":        "Budget",
+		"  re : Budget review": "Budget review",
+		"Regarding the budget": "Regarding the budget",
+		"RE:":                  "",
+	}
+
+	for input, expected := range tests {
+		if got := StripSubjectPrefixes(input); got != expected {
+			t.Errorf("StripSubjectPrefixes(%q) = %q, want %q", input, got, expected)
+		}
+	}
+}
+
+func TestIsReplySubject(t *testing.T) {
+	if !IsReplySubject("RE: Budget") || !IsReplySubject("Fw: Budget") {
+		t.Error("Expected Re:/Fw: subjects to be replies")
+	}
+	if IsReplySubject("Budget") || IsReplySubject("Reply needed") {
+		t.Error("Expected subjects without a prefix not to be replies")
+	}
+}
+
+func TestQuotedSubject(t *testing.T) {
+	body := `Sounds good.
+
+ -----Original Message-----
+From: 	Lay, Kenneth
+Sent:	Monday, May 14, 2001 9:00 AM
+To:	Skilling, Jeff
+Subject:	Q2 forecast
+
+Can you send the numbers?`
+
+	if got := QuotedSubject(body); got != "Q2 forecast" {
+		t.Errorf("Expected quoted subject 'Q2 forecast', got '%s'", got)
+	}
+	if got := QuotedSubject("No quoted text\nSubject: not a header"); got != "" {
+		t.Errorf("Expected no quoted subject, got '%s'", got)
+	}
+}
+
+func TestThreader_ThreadEmail(t *testing.T) {
+	ctx := context.Background()
+	repo := graph.NewMockRepository()
+	threader := NewThreader(repo, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	date := time.Date(2001, 5, 14, 9, 0, 0, 0, time.UTC)
+
+	create := func(input *graph.EmailInput) *ent.Email {
+		t.Helper()
+		email, err := repo.CreateEmail(ctx, input)
+		if err != nil {
+			t.Fatalf("CreateEmail failed: %v", err)
+		}
+		return email
+	}
+
+	root := create(&graph.EmailInput{MessageID: "1@enron.com", Subject: "Q2 forecast", Date: date})
+	byHeader := create(&graph.EmailInput{MessageID: "2@enron.com", Subject: "RE: Q2 forecast", Date: date.Add(time.Hour), InReplyTo: "1@enron.com"})
+	bySubject := create(&graph.EmailInput{MessageID: "3@enron.com", Subject: "Re: Q2 forecast", Date: date.Add(2 * time.Hour)})
+	unrelated := create(&graph.EmailInput{MessageID: "4@enron.com", Subject: "Q2 forecast", Date: date.Add(3 * time.Hour)})
+
+	// Threading the reply first also threads the parent it points at
+	thread, err := threader.ThreadEmail(ctx, byHeader)
+	if err != nil {
+		t.Fatalf("ThreadEmail failed: %v", err)
+	}
+	if thread.RootMessageID != "1@enron.com" || thread.Subject != "Q2 forecast" {
+		t.Errorf("Expected thread rooted at 1@enron.com about 'Q2 forecast', got %s '%s'", thread.RootMessageID, thread.Subject)
+	}
+
+	rootThread, err := threader.ThreadEmail(ctx, root)
+	if err != nil {
+		t.Fatalf("ThreadEmail failed: %v", err)
+	}
+	if rootThread.ID != thread.ID {
+		t.Errorf("Expected the parent in thread %d, got %d", thread.ID, rootThread.ID)
+	}
+
+	subjectThread, err := threader.ThreadEmail(ctx, bySubject)
+	if err != nil {
+		t.Fatalf("ThreadEmail failed: %v", err)
+	}
+	if subjectThread.ID != thread.ID {
+		t.Errorf("Expected the Re: reply in thread %d, got %d", thread.ID, subjectThread.ID)
+	}
+
+	// Without a reply prefix or quoted text the same subject starts a new conversation
+	newThread, err := threader.ThreadEmail(ctx, unrelated)
+	if err != nil {
+		t.Fatalf("ThreadEmail failed: %v", err)
+	}
+	if newThread.ID == thread.ID {
+		t.Error("Expected a non-reply email to start a new thread")
+	}
+
+	messages, err := repo.FindThreadEmails(ctx, thread.ID)
+	if err != nil {
+		t.Fatalf("FindThreadEmails failed: %v", err)
+	}
+	if len(messages) != 3 {
+		t.Fatalf("Expected 3 messages in the thread, got %d", len(messages))
+	}
+	if messages[1].ParentID != root.ID || messages[1].Method != graph.ThreadByInReplyTo {
+		t.Errorf("Expected header reply to point at %d via in_reply_to, got %d via %s", root.ID, messages[1].ParentID, messages[1].Method)
+	}
+	if messages[2].ParentID != byHeader.ID || messages[2].Method != graph.ThreadBySubject {
+		t.Errorf("Expected subject reply to point at %d via subject, got %d via %s", byHeader.ID, messages[2].ParentID, messages[2].Method)
+	}
+}
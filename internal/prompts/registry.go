@@ -0,0 +1,271 @@
+// Package prompts loads the versioned LLM prompt templates used for extraction and chat.
+//
+// Templates are Go text/template files named <name>/<version>.tmpl. The built-in templates
+// are embedded in the binary; a prompt directory can add new versions or override built-in
+// ones without rebuilding. Each prompt uses its latest numbered version unless one is pinned,
+// and the version in use is recorded on whatever the prompt produces (provenance records,
+// extraction jobs, chat history), so results can always be traced back to their prompt.
+//
+// Example layout of a prompt directory:
+//
+//	prompts/
+//	    extraction/v4.tmpl
+//	    chat_system/v2.tmpl
+package prompts
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"text/template"
+)
+
+// Prompt names
+const (
+	// Extraction is the entity and relationship extraction prompt, rendered with ExtractionData
+	Extraction = "extraction"
+	// ChatSystem is the system prompt of the chat planner, rendered with ChatSystemData
+	ChatSystem = "chat_system"
+)
+
+// ExtractionData is the input of the extraction prompt
+type ExtractionData struct {
+	From          string
+	To            string
+	Subject       string
+	Body          string
+	Types         string // Comma-separated entity types already in the graph
+	Relationships string // Comma-separated relationship types already in the graph
+}
+
+// ChatSystemData is the input of the chat system prompt
+type ChatSystemData struct {
+	RelationshipTypes string // Relationship types separated by "|"
+	Ontology          string // Entity and relationship types of the live graph
+}
+
+//go:embed templates
+var builtin embed.FS
+
+// Prompt is one version of a named template
+type Prompt struct {
+	Name     string
+	Version  string
+	template *template.Template
+}
+
+// Render executes the template with data
+func (p *Prompt) Render(data any) (string, error) {
+	var b strings.Builder
+	if err := p.template.Execute(&b, data); err != nil {
+		return "", fmt.Errorf("failed to render prompt %s/%s: %w", p.Name, p.Version, err)
+	}
+	return b.String(), nil
+}
+
+// Registry holds the loaded prompt templates and the version selected for each name
+type Registry struct {
+	prompts  map[string]map[string]*Prompt
+	selected map[string]string
+}
+
+var (
+	defaultOnce     sync.Once
+	defaultRegistry *Registry
+)
+
+// builtinData holds the input type of every built-in prompt, so Default can check that each
+// of them exists and renders
+var builtinData = map[string]any{
+	Extraction: ExtractionData{},
+	ChatSystem: ChatSystemData{},
+}
+
+// Default returns the registry of built-in templates at their latest versions. The built-in
+// templates are fixed at build time, so it panics if any of them is missing or fails to render.
+func Default() *Registry {
+	defaultOnce.Do(func() {
+		registry, err := NewRegistry("", nil)
+		if err != nil {
+			panic(fmt.Sprintf("invalid built-in prompt templates: %v", err))
+		}
+		for name, data := range builtinData {
+			prompt, err := registry.Get(name)
+			if err == nil {
+				_, err = prompt.Render(data)
+			}
+			if err != nil {
+				panic(fmt.Sprintf("invalid built-in prompt templates: %v", err))
+			}
+		}
+		defaultRegistry = registry
+	})
+	return defaultRegistry
+}
+
+// DefaultPrompt returns the latest built-in version of the named prompt, which Default has
+// checked to exist and render. It returns nil for names that are not built in.
+func DefaultPrompt(name string) *Prompt {
+	r := Default()
+	return r.prompts[name][r.selected[name]]
+}
+
+// Load creates a registry from the built-in templates and the templates in dir, with versions
+// pinned by a spec such as "extraction=v3,chat_system=v1". Both arguments may be empty.
+func Load(dir, versions string) (*Registry, error) {
+	pinned, err := ParseVersions(versions)
+	if err != nil {
+		return nil, err
+	}
+	return NewRegistry(dir, pinned)
+}
+
+// NewRegistry creates a registry from the built-in templates and the templates in dir, which
+// take precedence. versions pins prompt names to a version; other names use their latest one.
+func NewRegistry(dir string, versions map[string]string) (*Registry, error) {
+	r := &Registry{
+		prompts:  map[string]map[string]*Prompt{},
+		selected: map[string]string{},
+	}
+
+	sub, err := fs.Sub(builtin, "templates")
+	if err != nil {
+		return nil, err
+	}
+	if err := r.load(sub); err != nil {
+		return nil, err
+	}
+	if dir != "" {
+		if err := r.load(os.DirFS(dir)); err != nil {
+			return nil, err
+		}
+	}
+
+	for name, versions := range r.prompts {
+		r.selected[name] = latest(versions)
+	}
+	for name, version := range versions {
+		if _, ok := r.prompts[name][version]; !ok {
+			return nil, fmt.Errorf("prompt %s has no version %s (available: %s)",
+				name, version, strings.Join(r.Versions(name), ", "))
+		}
+		r.selected[name] = version
+	}
+	return r, nil
+}
+
+// load parses every <name>/<version>.tmpl file of fsys
+func (r *Registry) load(fsys fs.FS) error {
+	files, err := fs.Glob(fsys, "*/*.tmpl")
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		content, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return fmt.Errorf("failed to read prompt %s: %w", file, err)
+		}
+		name := path.Dir(file)
+		version := strings.TrimSuffix(path.Base(file), ".tmpl")
+
+		// Editors end files with a newline that is not part of the prompt
+		text := strings.TrimSuffix(strings.ReplaceAll(string(content), "\r\n", "\n"), "\n")
+		tmpl, err := template.New(file).Option("missingkey=error").Parse(text)
+		if err != nil {
+			return fmt.Errorf("failed to parse prompt %s: %w", file, err)
+		}
+
+		if r.prompts[name] == nil {
+			r.prompts[name] = map[string]*Prompt{}
+		}
+		r.prompts[name][version] = &Prompt{Name: name, Version: version, template: tmpl}
+	}
+	return nil
+}
+
+// Get returns the selected version of the named prompt
+func (r *Registry) Get(name string) (*Prompt, error) {
+	version, ok := r.selected[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown prompt %q", name)
+	}
+	return r.prompts[name][version], nil
+}
+
+// Version returns the selected version of the named prompt, or "" if there is no such prompt
+func (r *Registry) Version(name string) string {
+	return r.selected[name]
+}
+
+// Versions returns the available versions of the named prompt, oldest first
+func (r *Registry) Versions(name string) []string {
+	var versions []string
+	for version := range r.prompts[name] {
+		versions = append(versions, version)
+	}
+	sort.Slice(versions, func(i, j int) bool { return versionLess(versions[i], versions[j]) })
+	return versions
+}
+
+// ParseVersions parses a comma-separated list of name=version pins
+func ParseVersions(spec string) (map[string]string, error) {
+	versions := map[string]string{}
+	for _, item := range strings.Split(spec, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		name, version, ok := strings.Cut(item, "=")
+		name, version = strings.TrimSpace(name), strings.TrimSpace(version)
+		if !ok || name == "" || version == "" {
+			return nil, fmt.Errorf("invalid prompt version %q, expected name=version", item)
+		}
+		versions[name] = version
+	}
+	return versions, nil
+}
+
+// latest returns the highest numbered version of a prompt. Named versions such as
+// "experimental" are only used when pinned, unless a prompt has no numbered version.
+func latest(versions map[string]*Prompt) string {
+	best := ""
+	for version := range versions {
+		if numbered(version) && (best == "" || versionLess(best, version)) {
+			best = version
+		}
+	}
+	if best != "" {
+		return best
+	}
+	for version := range versions {
+		if best == "" || versionLess(best, version) {
+			best = version
+		}
+	}
+	return best
+}
+
+// numbered reports whether a version has the form v<number>
+func numbered(version string) bool {
+	_, err := strconv.Atoi(strings.TrimPrefix(version, "v"))
+	return err == nil
+}
+
+// versionLess orders versions like v2 < v10, with named versions after numbered ones
+func versionLess(a, b string) bool {
+	na, errA := strconv.Atoi(strings.TrimPrefix(a, "v"))
+	nb, errB := strconv.Atoi(strings.TrimPrefix(b, "v"))
+	if errA == nil && errB == nil && na != nb {
+		return na < nb
+	}
+	if (errA == nil) != (errB == nil) {
+		return errA == nil
+	}
+	return a < b
+}
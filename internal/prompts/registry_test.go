@@ -0,0 +1,110 @@
+package prompts
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writePrompt(t *testing.T, dir, name, version, text string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Join(dir, name), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, name, version+".tmpl"), []byte(text), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDefault_BuiltInPrompts(t *testing.T) {
+	registry := Default()
+	for _, name := range []string{Extraction, ChatSystem} {
+		if _, err := registry.Get(name); err != nil {
+			t.Errorf("Expected built-in prompt %s: %v", name, err)
+		}
+	}
+
+	prompt, _ := registry.Get(Extraction)
+	text, err := prompt.Render(ExtractionData{From: "kenneth.lay@enron.com", Subject: "Raptor", Body: "Call me."})
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	if !strings.Contains(text, "From: kenneth.lay@enron.com") || !strings.Contains(text, "Content: Call me.") {
+		t.Errorf("Unexpected extraction prompt: %s", text)
+	}
+	if strings.HasSuffix(text, "\n") {
+		t.Error("Expected the trailing newline of the template file to be dropped")
+	}
+}
+
+func TestNewRegistry_DirectoryAddsVersionsAndLatestWins(t *testing.T) {
+	dir := t.TempDir()
+	writePrompt(t, dir, Extraction, "v10", "Extract from {{.Subject}}\n")
+	writePrompt(t, dir, Extraction, "v9", "old")
+	writePrompt(t, dir, Extraction, "experimental", "try {{.Body}}")
+	writePrompt(t, dir, "summary", "v1", "Summarize")
+
+	registry, err := NewRegistry(dir, nil)
+	if err != nil {
+		t.Fatalf("NewRegistry failed: %v", err)
+	}
+	if version := registry.Version(Extraction); version != "v10" {
+		t.Errorf("Expected the latest numbered version v10, got %s", version)
+	}
+	if versions := strings.Join(registry.Versions(Extraction), ","); versions != "v3,v9,v10,experimental" {
+		t.Errorf("Unexpected version order: %s", versions)
+	}
+	if registry.Version("summary") != "v1" {
+		t.Error("Expected new prompt names to be loaded")
+	}
+
+	prompt, _ := registry.Get(Extraction)
+	if text, _ := prompt.Render(ExtractionData{Subject: "Raptor"}); text != "Extract from Raptor" {
+		t.Errorf("Unexpected render: %q", text)
+	}
+}
+
+func TestLoad_PinnedVersions(t *testing.T) {
+	dir := t.TempDir()
+	writePrompt(t, dir, Extraction, "experimental", "try {{.Body}}")
+	writePrompt(t, dir, ChatSystem, "v1", "Overridden {{.Ontology}}")
+
+	registry, err := Load(dir, "extraction=experimental, chat_system=v1")
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	prompt, _ := registry.Get(Extraction)
+	if prompt.Version != "experimental" {
+		t.Errorf("Expected the pinned version, got %s", prompt.Version)
+	}
+	chatPrompt, _ := registry.Get(ChatSystem)
+	if text, _ := chatPrompt.Render(ChatSystemData{Ontology: "types"}); text != "Overridden types" {
+		t.Errorf("Expected the directory to override the built-in version, got %q", text)
+	}
+
+	if _, err := Load(dir, "extraction=v99"); err == nil || !strings.Contains(err.Error(), "experimental") {
+		t.Errorf("Expected an error listing the available versions, got %v", err)
+	}
+	if _, err := Load("", "extraction"); err == nil {
+		t.Error("Expected an error for a pin without a version")
+	}
+}
+
+func TestPrompt_RenderUnknownField(t *testing.T) {
+	dir := t.TempDir()
+	writePrompt(t, dir, Extraction, "v4", "{{.Sender}}")
+	registry, err := NewRegistry(dir, nil)
+	if err != nil {
+		t.Fatalf("NewRegistry failed: %v", err)
+	}
+	prompt, _ := registry.Get(Extraction)
+	if _, err := prompt.Render(ExtractionData{}); err == nil {
+		t.Error("Expected rendering a template with an unknown field to fail")
+	}
+
+	writePrompt(t, dir, ChatSystem, "v2", "{{.Ontology")
+	if _, err := NewRegistry(dir, nil); err == nil {
+		t.Error("Expected a template syntax error to fail loading")
+	}
+}
@@ -8,8 +8,18 @@ import (
 // and convert loader.EmailRecord to sampler.EmailRecord.
 // Returns channels for streaming records and errors, plus initial error if file can't be opened.
 func ParseCSV(filePath string) (<-chan EmailRecord, <-chan error, error) {
-	// Call loader.ParseCSV to get loader.EmailRecord stream
-	loaderRecords, loaderErrors, err := loader.ParseCSV(filePath)
+	return ParseSource(loader.SourceCSV, filePath)
+}
+
+// ParseSource reads any source supported by loader.NewEmailSource (CSV, maildir, mbox or eml)
+// and converts loader.EmailRecord to sampler.EmailRecord.
+func ParseSource(kind, path string) (<-chan EmailRecord, <-chan error, error) {
+	source, err := loader.NewEmailSource(kind, path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	loaderRecords, loaderErrors, err := source.Stream()
 	if err != nil {
 		return nil, nil, err
 	}
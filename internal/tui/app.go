@@ -2,9 +2,11 @@ package tui
 
 import (
 	"context"
+	"log/slog"
 
 	"github.com/Blogem/enron-graph/internal/chat"
 	"github.com/Blogem/enron-graph/internal/graph"
+	"github.com/Blogem/enron-graph/internal/prompts"
 	"github.com/Blogem/enron-graph/pkg/llm"
 	tea "github.com/charmbracelet/bubbletea"
 )
@@ -29,6 +31,8 @@ type Model struct {
 	repo      graph.Repository
 	ctx       context.Context
 	llmClient llm.Client
+	prompts   *prompts.Registry
+	maxSteps  int
 
 	// View-specific states
 	entityList *EntityListModel
@@ -55,6 +59,7 @@ func NewModel(repo graph.Repository) Model {
 		repo:        repo,
 		ctx:         context.Background(),
 		llmClient:   nil, // Will be set later if available
+		maxSteps:    chat.DefaultMaxSteps,
 		entityList:  NewEntityListModel(),
 		graphView:   NewGraphViewModel(),
 		detailView:  NewDetailViewModel(),
@@ -73,10 +78,28 @@ func (m *Model) SetLLMClient(client llm.Client) {
 
 	// Create chat handler with LLM client and repository adapter
 	chatRepo := newChatRepositoryAdapter(m.repo)
-	chatHandler := chat.NewHandler(client, chatRepo)
+	chatHandler := chat.NewHandlerWithMaxSteps(client, chatRepo, m.maxSteps)
+	if m.prompts != nil {
+		handler, err := chat.NewHandlerWithPrompts(client, chatRepo, m.maxSteps, m.prompts)
+		if err != nil {
+			slog.Default().Warn("Failed to load prompt templates, using built-in prompts", "error", err)
+		} else {
+			chatHandler = handler
+		}
+	}
 	m.chatView.SetHandler(chatHandler)
 }
 
+// SetPrompts selects the prompt templates used by chat; call it before SetLLMClient
+func (m *Model) SetPrompts(registry *prompts.Registry) {
+	m.prompts = registry
+}
+
+// SetMaxSteps sets the most graph tool calls chat makes per query; call it before SetLLMClient
+func (m *Model) SetMaxSteps(maxSteps int) {
+	m.maxSteps = maxSteps
+}
+
 // Init initializes the model (required by Bubble Tea)
 func (m Model) Init() tea.Cmd {
 	return nil
@@ -241,7 +264,7 @@ func (m Model) updateEntityList(msg tea.Msg) (tea.Model, tea.Cmd) {
 				entity.Properties, entity.ConfidenceScore)
 
 			// Load relationships using the entity's type category
-			relationships, err := m.repo.FindRelationshipsByEntity(m.ctx, entity.TypeCategory, entity.ID)
+			relationships, err := m.repo.FindRelationshipsByEntity(m.ctx, entity.TypeCategory, entity.ID, graph.TimeWindow{})
 			if err == nil {
 				m.detailView.LoadRelationships(m.ctx, m.repo, relationships)
 			}
@@ -296,7 +319,7 @@ func (m Model) updateGraphView(msg tea.Msg) (tea.Model, tea.Cmd) {
 				entity.Properties, entity.ConfidenceScore)
 
 			// Load relationships using the entity's type category
-			relationships, err := m.repo.FindRelationshipsByEntity(m.ctx, entity.TypeCategory, entity.ID)
+			relationships, err := m.repo.FindRelationshipsByEntity(m.ctx, entity.TypeCategory, entity.ID, graph.TimeWindow{})
 			if err == nil {
 				m.detailView.LoadRelationships(m.ctx, m.repo, relationships)
 			}
@@ -327,7 +350,7 @@ func (m Model) updateDetailView(msg tea.Msg) (tea.Model, tea.Cmd) {
 				entity.Properties, entity.ConfidenceScore)
 
 			// Load relationships using the entity's type category
-			relationships, err := m.repo.FindRelationshipsByEntity(m.ctx, entity.TypeCategory, entity.ID)
+			relationships, err := m.repo.FindRelationshipsByEntity(m.ctx, entity.TypeCategory, entity.ID, graph.TimeWindow{})
 			if err == nil {
 				m.detailView.LoadRelationships(m.ctx, m.repo, relationships)
 			}
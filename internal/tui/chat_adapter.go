@@ -26,6 +26,15 @@ func newChatRepositoryAdapter(repo graph.Repository) chat.Repository {
 
 // FindEntityByName finds an entity by name
 func (a *chatRepositoryAdapter) FindEntityByName(name string) (*chat.Entity, error) {
+	// Exact names and recorded aliases (other spellings, email addresses) win
+	aliased, err := a.repo.FindEntitiesByAlias(a.ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search aliases: %w", err)
+	}
+	if len(aliased) > 0 {
+		return convertToEntity(aliased[0]), nil
+	}
+
 	// Query the ent client directly to search by name
 	// We'll search for entities whose name contains the query (case-insensitive)
 	entities, err := a.repo.FindEntitiesByType(a.ctx, "")
@@ -47,7 +56,7 @@ func (a *chatRepositoryAdapter) FindEntityByName(name string) (*chat.Entity, err
 // TraverseRelationships traverses relationships from an entity
 func (a *chatRepositoryAdapter) TraverseRelationships(entityID int, relType string) ([]*chat.Entity, error) {
 	// Use the repository's TraverseRelationships method
-	entities, err := a.repo.TraverseRelationships(a.ctx, entityID, relType, 1)
+	entities, err := a.repo.TraverseRelationships(a.ctx, entityID, relType, 1, graph.TimeWindow{})
 	if err != nil {
 		return nil, fmt.Errorf("failed to traverse relationships: %w", err)
 	}
@@ -64,7 +73,7 @@ func (a *chatRepositoryAdapter) TraverseRelationships(entityID int, relType stri
 // FindShortestPath finds the shortest path between two entities
 func (a *chatRepositoryAdapter) FindShortestPath(sourceID, targetID int) ([]*chat.PathNode, error) {
 	// Use the repository's FindShortestPath method
-	relationships, err := a.repo.FindShortestPath(a.ctx, sourceID, targetID)
+	relationships, err := a.repo.FindShortestPath(a.ctx, sourceID, targetID, graph.TimeWindow{})
 	if err != nil {
 		return nil, fmt.Errorf("failed to find path: %w", err)
 	}
@@ -124,7 +133,7 @@ func (a *chatRepositoryAdapter) SimilaritySearch(embedding []float32, limit int)
 // CountRelationships counts relationships for an entity
 func (a *chatRepositoryAdapter) CountRelationships(entityID int, relType string) (int, error) {
 	// Find all relationships for the entity
-	relationships, err := a.repo.FindRelationshipsByEntity(a.ctx, "discovered_entity", entityID)
+	relationships, err := a.repo.FindRelationshipsByEntity(a.ctx, "discovered_entity", entityID, graph.TimeWindow{})
 	if err != nil {
 		return 0, fmt.Errorf("failed to find relationships: %w", err)
 	}
@@ -144,6 +153,96 @@ func (a *chatRepositoryAdapter) CountRelationships(entityID int, relType string)
 	return count, nil
 }
 
+// FindEntityCitations returns the source emails an entity was extracted from
+func (a *chatRepositoryAdapter) FindEntityCitations(entityID int, limit int) ([]chat.Citation, error) {
+	records, err := a.repo.FindProvenance(a.ctx, graph.SubjectEntity, entityID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find provenance: %w", err)
+	}
+
+	messageIDs := make([]string, 0, len(records))
+	for _, record := range records {
+		messageIDs = append(messageIDs, record.MessageID)
+	}
+
+	return a.buildCitations(messageIDs, entityID, "", limit), nil
+}
+
+// FindRelationshipCitations returns the source emails of relationships between two entities
+func (a *chatRepositoryAdapter) FindRelationshipCitations(fromID, toID int, relType string, limit int) ([]chat.Citation, error) {
+	relationships, err := a.repo.FindRelationshipsByEntity(a.ctx, "discovered_entity", fromID, graph.TimeWindow{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find relationships: %w", err)
+	}
+
+	var citations []chat.Citation
+	for _, rel := range relationships {
+		if len(citations) >= limit {
+			break
+		}
+		if relType != "" && rel.Type != relType {
+			continue
+		}
+		connects := (rel.FromID == fromID && rel.ToID == toID) || (rel.FromID == toID && rel.ToID == fromID)
+		if !connects {
+			continue
+		}
+
+		records, err := a.repo.FindProvenance(a.ctx, graph.SubjectRelationship, rel.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find provenance: %w", err)
+		}
+		var messageIDs []string
+		for _, record := range records {
+			messageIDs = append(messageIDs, record.MessageID)
+		}
+		// Relationships created before provenance tracking still name their email
+		if viaEmail, ok := rel.Properties["via_email"].(string); ok && len(messageIDs) == 0 {
+			messageIDs = append(messageIDs, viaEmail)
+		}
+
+		citations = append(citations, a.buildCitations(messageIDs, 0, rel.Type, limit-len(citations))...)
+	}
+
+	return citations, nil
+}
+
+// buildCitations looks up the subject and date of each distinct message ID
+func (a *chatRepositoryAdapter) buildCitations(messageIDs []string, entityID int, relType string, limit int) []chat.Citation {
+	citations := make([]chat.Citation, 0)
+	seen := make(map[string]bool)
+	for _, messageID := range messageIDs {
+		if len(citations) >= limit {
+			break
+		}
+		if messageID == "" || seen[messageID] {
+			continue
+		}
+		seen[messageID] = true
+
+		citation := chat.Citation{
+			MessageID:    messageID,
+			EntityID:     entityID,
+			Relationship: relType,
+		}
+		if email, err := a.repo.FindEmailByMessageID(a.ctx, messageID); err == nil && email != nil {
+			citation.Subject = email.Subject
+			citation.Date = email.Date.Format("2006-01-02")
+		}
+		citations = append(citations, citation)
+	}
+	return citations
+}
+
+// DescribeSchema summarizes the entity and relationship types currently in the graph
+func (a *chatRepositoryAdapter) DescribeSchema() (*chat.SchemaSummary, error) {
+	client := a.repo.GetClient()
+	if client == nil {
+		return nil, fmt.Errorf("repository has no database client")
+	}
+	return chat.DescribeGraphSchema(a.ctx, client)
+}
+
 // convertToEntity converts ent.DiscoveredEntity to chat.Entity
 func convertToEntity(entity *ent.DiscoveredEntity) *chat.Entity {
 	if entity == nil {
@@ -2,6 +2,7 @@ package tui
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -30,6 +31,8 @@ type ChatMessage struct {
 	ResultType    string // "entity", "path", "count", "text"
 	Entities      []chat.Entity
 	Path          []chat.PathNode
+	Citations     []chat.Citation
+	Trace         []chat.ToolCall
 	ShowVisualize bool
 }
 
@@ -38,6 +41,8 @@ type llmResponseMsg struct {
 	response   string
 	entities   []chat.Entity
 	path       []chat.PathNode
+	citations  []chat.Citation
+	trace      []chat.ToolCall
 	resultType string
 	err        error
 }
@@ -121,6 +126,8 @@ func (m *ChatViewModel) Update(msg tea.Msg) (*ChatViewModel, tea.Cmd) {
 				ResultType: msg.resultType,
 				Entities:   msg.entities,
 				Path:       msg.path,
+				Citations:  msg.citations,
+				Trace:      msg.trace,
 			}
 
 			// Show visualize button for entity or path results
@@ -185,10 +192,22 @@ func (m *ChatViewModel) processQuery(userMessage string) tea.Cmd {
 			resultType = "count"
 		}
 
+		// Graph lookups return a FormattedResponse; show its text, tool calls and sources
+		var citations []chat.Citation
+		var trace []chat.ToolCall
+		var formatted chat.FormattedResponse
+		if err := json.Unmarshal([]byte(response), &formatted); err == nil && formatted.Text != "" {
+			response = formatted.Text
+			citations = formatted.Citations
+			trace = formatted.Trace
+		}
+
 		return llmResponseMsg{
 			response:   response,
 			entities:   entities,
 			path:       path,
+			citations:  citations,
+			trace:      trace,
 			resultType: resultType,
 			err:        nil,
 		}
@@ -247,6 +266,82 @@ func formatPathDisplay(path []chat.PathNode) string {
 	return b.String()
 }
 
+// formatTrace renders the graph tool calls the planner made for a response
+func formatTrace(trace []chat.ToolCall) string {
+	var b strings.Builder
+
+	headerStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("240")).
+		Bold(true)
+
+	stepStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("240")).
+		Italic(true)
+
+	errorStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("196"))
+
+	b.WriteString(headerStyle.Render("Steps:"))
+	b.WriteString("\n")
+	for _, call := range trace {
+		var args []string
+		for _, key := range []string{"entity", "source", "target", "rel_type", "text", "entity_type"} {
+			if value, ok := call.Args[key]; ok {
+				args = append(args, fmt.Sprintf("%s=%q", key, value))
+			}
+		}
+		b.WriteString(stepStyle.Render(fmt.Sprintf("  %d. %s(%s)", call.Step, call.Action, strings.Join(args, ", "))))
+		if call.Error != "" {
+			b.WriteString(" ")
+			b.WriteString(errorStyle.Render("✗"))
+		}
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
+
+// formatCitations renders numbered references to the emails supporting a response
+func formatCitations(citations []chat.Citation) string {
+	var b strings.Builder
+
+	headerStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("240")).
+		Bold(true)
+
+	refStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("86")).
+		Underline(true)
+
+	detailStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("240"))
+
+	b.WriteString(headerStyle.Render("Sources:"))
+	b.WriteString("\n")
+	for i, citation := range citations {
+		b.WriteString(fmt.Sprintf("  [%d] ", i+1))
+		b.WriteString(refStyle.Render(citation.MessageID))
+
+		var details []string
+		if citation.Subject != "" {
+			details = append(details, fmt.Sprintf("%q", citation.Subject))
+		}
+		if citation.Date != "" {
+			details = append(details, citation.Date)
+		}
+		if citation.Relationship != "" {
+			details = append(details, citation.Relationship)
+		}
+		if len(details) > 0 {
+			b.WriteString(" ")
+			b.WriteString(detailStyle.Render(strings.Join(details, " · ")))
+		}
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
+
 // View renders the chat view
 func (m *ChatViewModel) View(width, height int) string {
 	var b strings.Builder
@@ -274,6 +369,12 @@ func (m *ChatViewModel) View(width, height int) string {
 		if len(m.messages[i].Entities) > 0 {
 			msgLines += len(m.messages[i].Entities) * 4
 		}
+		if len(m.messages[i].Citations) > 0 {
+			msgLines += len(m.messages[i].Citations) + 2
+		}
+		if len(m.messages[i].Trace) > 0 {
+			msgLines += len(m.messages[i].Trace) + 2
+		}
 		if lineCount+msgLines > messageHeight {
 			startIdx = i + 1
 			break
@@ -315,6 +416,18 @@ func (m *ChatViewModel) View(width, height int) string {
 			b.WriteString("\n")
 		}
 
+		// Display planner tool calls if present
+		if len(msg.Trace) > 0 {
+			b.WriteString("\n")
+			b.WriteString(formatTrace(msg.Trace))
+		}
+
+		// Display supporting emails if present
+		if len(msg.Citations) > 0 {
+			b.WriteString("\n")
+			b.WriteString(formatCitations(msg.Citations))
+		}
+
 		// Show visualize button (T125)
 		if msg.ShowVisualize {
 			visualizeStyle := lipgloss.NewStyle().
@@ -138,7 +138,7 @@ func (m *GraphViewModel) LoadSubgraphData(ctx context.Context, repo graph.Reposi
 	})
 
 	// Load relationships using the entity's type category
-	relationships, err := repo.FindRelationshipsByEntity(ctx, entity.TypeCategory, entity.ID)
+	relationships, err := repo.FindRelationshipsByEntity(ctx, entity.TypeCategory, entity.ID, graph.TimeWindow{})
 	if err != nil {
 		return
 	}
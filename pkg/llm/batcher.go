@@ -0,0 +1,208 @@
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+)
+
+// EmbeddingBatcher is a Client decorator that coalesces GenerateEmbedding calls from many
+// goroutines into batch requests. Texts are queued until the batch is full or the oldest has
+// waited maxWait, then embedded with one GenerateEmbeddings call. A text that is already queued
+// or being embedded is not sent again; its callers share the result.
+type EmbeddingBatcher struct {
+	base     Client
+	maxBatch int
+	maxWait  time.Duration
+	logger   *slog.Logger
+
+	// ctx is the context of the batch requests, cancelled by Close
+	ctx          context.Context
+	cancel       context.CancelFunc
+	closeTimeout time.Duration
+
+	mu      sync.Mutex
+	pending []string
+	calls   map[string]*embeddingCall
+	timer   *time.Timer
+	flushes sync.WaitGroup
+}
+
+// batchCloseTimeout is how long Close waits for running batches before cancelling them
+const batchCloseTimeout = 30 * time.Second
+
+// embeddingCall is the shared result for a queued text
+type embeddingCall struct {
+	done      chan struct{}
+	embedding []float32
+	err       error
+}
+
+// NewEmbeddingBatcher wraps base, sending batches of up to maxBatch texts at least every maxWait
+func NewEmbeddingBatcher(base Client, maxBatch int, maxWait time.Duration, logger *slog.Logger) *EmbeddingBatcher {
+	if maxBatch < 1 {
+		maxBatch = 1
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &EmbeddingBatcher{
+		base:         base,
+		maxBatch:     maxBatch,
+		maxWait:      maxWait,
+		logger:       logger,
+		ctx:          ctx,
+		cancel:       cancel,
+		closeTimeout: batchCloseTimeout,
+		calls:        map[string]*embeddingCall{},
+	}
+}
+
+// GenerateCompletion passes the completion through
+func (b *EmbeddingBatcher) GenerateCompletion(ctx context.Context, prompt string) (string, error) {
+	return b.base.GenerateCompletion(ctx, prompt)
+}
+
+// GenerateStructured passes the structured completion through
+func (b *EmbeddingBatcher) GenerateStructured(ctx context.Context, prompt string, schema *ResponseSchema) (json.RawMessage, error) {
+	return b.base.GenerateStructured(ctx, prompt, schema)
+}
+
+// GenerateEmbedding queues text for the next batch and waits for its embedding
+func (b *EmbeddingBatcher) GenerateEmbedding(ctx context.Context, text string) ([]float32, error) {
+	return b.wait(ctx, b.enqueue(text))
+}
+
+// GenerateEmbeddings queues the texts alongside those of concurrent callers and waits for all
+func (b *EmbeddingBatcher) GenerateEmbeddings(ctx context.Context, texts []string) ([][]float32, error) {
+	calls := make([]*embeddingCall, len(texts))
+	for i, text := range texts {
+		calls[i] = b.enqueue(text)
+	}
+	embeddings := make([][]float32, len(texts))
+	for i, call := range calls {
+		embedding, err := b.wait(ctx, call)
+		if err != nil {
+			return nil, err
+		}
+		embeddings[i] = embedding
+	}
+	return embeddings, nil
+}
+
+// Close sends the queued texts, waits for running batches and closes the wrapped client.
+// Batches still running after the close timeout, for example while the model server is down,
+// are cancelled and fail their callers.
+func (b *EmbeddingBatcher) Close() error {
+	b.mu.Lock()
+	batch := b.takePending()
+	b.mu.Unlock()
+	if len(batch) > 0 {
+		b.flushes.Add(1)
+		go b.flush(batch)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		b.flushes.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(b.closeTimeout):
+		b.logger.Warn("Cancelling embedding batches still running on close", "timeout", b.closeTimeout)
+		b.cancel()
+		<-done
+	}
+	b.cancel()
+	return b.base.Close()
+}
+
+// enqueue returns the call embedding text, queueing the text unless it already is
+func (b *EmbeddingBatcher) enqueue(text string) *embeddingCall {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if call, ok := b.calls[text]; ok {
+		return call
+	}
+	call := &embeddingCall{done: make(chan struct{})}
+	b.calls[text] = call
+	b.pending = append(b.pending, text)
+
+	switch {
+	case len(b.pending) >= b.maxBatch:
+		batch := b.takePending()
+		b.flushes.Add(1)
+		go b.flush(batch)
+	case b.timer == nil:
+		b.timer = time.AfterFunc(b.maxWait, b.flushPending)
+	}
+	return call
+}
+
+// takePending empties the queue and stops its timer; b.mu must be held
+func (b *EmbeddingBatcher) takePending() []string {
+	batch := b.pending
+	b.pending = nil
+	if b.timer != nil {
+		b.timer.Stop()
+		b.timer = nil
+	}
+	return batch
+}
+
+// flushPending sends the queue once the oldest text has waited long enough
+func (b *EmbeddingBatcher) flushPending() {
+	b.mu.Lock()
+	batch := b.takePending()
+	b.mu.Unlock()
+	if len(batch) > 0 {
+		b.flushes.Add(1)
+		b.flush(batch)
+	}
+}
+
+// flush embeds a batch and hands every caller its result. The request serves many callers,
+// so it is not tied to the context of any of them, only to the batcher's own.
+func (b *EmbeddingBatcher) flush(batch []string) {
+	defer b.flushes.Done()
+
+	embeddings, err := b.base.GenerateEmbeddings(b.ctx, batch)
+	if err == nil && len(embeddings) != len(batch) {
+		err = fmt.Errorf("expected %d embeddings, got %d", len(batch), len(embeddings))
+	}
+	if err != nil {
+		b.logger.Warn("Embedding batch failed", "texts", len(batch), "error", err)
+	} else {
+		b.logger.Debug("Embedded batch", "texts", len(batch))
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for i, text := range batch {
+		call := b.calls[text]
+		delete(b.calls, text)
+		if err != nil {
+			call.err = err
+		} else {
+			call.embedding = embeddings[i]
+		}
+		close(call.done)
+	}
+}
+
+// wait returns a copy of the call's embedding, so callers sharing it cannot affect each other
+func (b *EmbeddingBatcher) wait(ctx context.Context, call *embeddingCall) ([]float32, error) {
+	select {
+	case <-call.done:
+		if call.err != nil {
+			return nil, call.err
+		}
+		return slices.Clone(call.embedding), nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
@@ -0,0 +1,192 @@
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// batchClient embeds each text as its length and records the batches it receives
+type batchClient struct {
+	scriptedClient
+	mu      sync.Mutex
+	batches [][]string
+	err     error
+}
+
+func (c *batchClient) GenerateEmbeddings(ctx context.Context, texts []string) ([][]float32, error) {
+	c.mu.Lock()
+	c.batches = append(c.batches, texts)
+	c.mu.Unlock()
+	if c.err != nil {
+		return nil, c.err
+	}
+	embeddings := make([][]float32, len(texts))
+	for i, text := range texts {
+		embeddings[i] = []float32{float32(len(text))}
+	}
+	return embeddings, nil
+}
+
+// embedConcurrently embeds every text from its own goroutine
+func embedConcurrently(client Client, texts []string) ([][]float32, []error) {
+	embeddings := make([][]float32, len(texts))
+	errs := make([]error, len(texts))
+	var wg sync.WaitGroup
+	for i, text := range texts {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			embeddings[i], errs[i] = client.GenerateEmbedding(context.Background(), text)
+		}()
+	}
+	wg.Wait()
+	return embeddings, errs
+}
+
+func TestEmbeddingBatcher_CoalescesConcurrentCalls(t *testing.T) {
+	base := &batchClient{}
+	batcher := NewEmbeddingBatcher(base, 8, 20*time.Millisecond, testLogger())
+
+	texts := make([]string, 20)
+	for i := range texts {
+		texts[i] = fmt.Sprintf("entity %*d", i+1, i)
+	}
+	embeddings, errs := embedConcurrently(batcher, texts)
+
+	for i, text := range texts {
+		if errs[i] != nil {
+			t.Fatalf("Unexpected error for %q: %v", text, errs[i])
+		}
+		if embeddings[i][0] != float32(len(text)) {
+			t.Errorf("Expected the embedding of %q, got %v", text, embeddings[i])
+		}
+	}
+	// Two full batches are sent right away, the remaining four when the wait runs out
+	if len(base.batches) != 3 {
+		t.Errorf("Expected 3 batch requests, got %d: %v", len(base.batches), base.batches)
+	}
+}
+
+func TestEmbeddingBatcher_DeduplicatesTexts(t *testing.T) {
+	base := &batchClient{}
+	batcher := NewEmbeddingBatcher(base, 32, 20*time.Millisecond, testLogger())
+
+	texts := []string{"Kenneth Lay", "Enron", "Kenneth Lay", "Kenneth Lay", "Enron"}
+	embeddings, errs := embedConcurrently(batcher, texts)
+	for i := range texts {
+		if errs[i] != nil || embeddings[i][0] != float32(len(texts[i])) {
+			t.Fatalf("Unexpected result for %q: %v, %v", texts[i], embeddings[i], errs[i])
+		}
+	}
+
+	batch, err := batcher.GenerateEmbeddings(context.Background(), []string{"Raptor", "Raptor"})
+	if err != nil || len(batch) != 2 || batch[1][0] != 6 {
+		t.Fatalf("Unexpected batch result: %v, %v", batch, err)
+	}
+
+	if len(base.batches) != 2 || len(base.batches[0]) != 2 || len(base.batches[1]) != 1 {
+		t.Errorf("Expected each distinct text to be embedded once, got %v", base.batches)
+	}
+}
+
+func TestEmbeddingBatcher_SharesErrors(t *testing.T) {
+	base := &batchClient{err: errors.New("embedding model not loaded")}
+	batcher := NewEmbeddingBatcher(base, 32, 5*time.Millisecond, testLogger())
+
+	_, errs := embedConcurrently(batcher, []string{"Enron", "Dynegy", "Enron"})
+	for _, err := range errs {
+		if !errors.Is(err, base.err) {
+			t.Errorf("Expected the batch error for every caller, got %v", err)
+		}
+	}
+
+	// Failed texts are not cached
+	base.err = nil
+	if embedding, err := batcher.GenerateEmbedding(context.Background(), "Enron"); err != nil || embedding[0] != 5 {
+		t.Errorf("Expected a retry to succeed, got %v, %v", embedding, err)
+	}
+}
+
+// blockingClient embeds only once its context is cancelled, like a client waiting on an open
+// circuit breaker
+type blockingClient struct {
+	scriptedClient
+}
+
+func (c *blockingClient) GenerateEmbeddings(ctx context.Context, texts []string) ([][]float32, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func TestEmbeddingBatcher_CloseCancelsStuckBatches(t *testing.T) {
+	batcher := NewEmbeddingBatcher(&blockingClient{}, 32, time.Hour, testLogger())
+	batcher.closeTimeout = 20 * time.Millisecond
+
+	result := make(chan error, 1)
+	go func() {
+		_, err := batcher.GenerateEmbedding(context.Background(), "Enron")
+		result <- err
+	}()
+	// Wait until the text is queued
+	for {
+		batcher.mu.Lock()
+		queued := len(batcher.pending)
+		batcher.mu.Unlock()
+		if queued > 0 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	closed := make(chan error, 1)
+	go func() { closed <- batcher.Close() }()
+	select {
+	case <-closed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected Close to return once the close timeout ran out")
+	}
+	if err := <-result; !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected the cancelled batch to fail its caller, got %v", err)
+	}
+}
+
+func TestOllamaClient_GenerateEmbeddings_UsesBatchEndpoint(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path != "/api/embed" {
+			t.Errorf("Expected path /api/embed, got %s", r.URL.Path)
+		}
+		var request struct {
+			Input []string `json:"input"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			t.Fatalf("Failed to decode request body: %v", err)
+		}
+		embeddings := [][]float64{}
+		for _, text := range request.Input {
+			embeddings = append(embeddings, []float64{float64(len(text)), 0.5})
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"embeddings": embeddings})
+	}))
+	defer server.Close()
+
+	client := NewOllamaClient(server.URL, "model", "embedder", testLogger())
+	embeddings, err := client.GenerateEmbeddings(context.Background(), []string{"Enron", "Dynegy", "Raptor I"})
+	if err != nil {
+		t.Fatalf("GenerateEmbeddings failed: %v", err)
+	}
+	if requests != 1 {
+		t.Errorf("Expected one request, got %d", requests)
+	}
+	if len(embeddings) != 3 || embeddings[0][0] != 5 || embeddings[2][0] != 8 {
+		t.Errorf("Unexpected embeddings: %v", embeddings)
+	}
+}
@@ -0,0 +1,419 @@
+package llm
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// Cassette modes
+const (
+	// CassetteRecord calls the wrapped client and records every request and response
+	CassetteRecord = "record"
+	// CassetteReplay answers requests from the cassette without calling any model server
+	CassetteReplay = "replay"
+)
+
+// Cassette matching strategies
+const (
+	// MatchStrict replays an interaction only for an identical request
+	MatchStrict = "strict"
+	// MatchFuzzy replays the most similar recorded request of the same kind, so that prompts
+	// which differ in details such as the types already in the graph still find their response.
+	// Only the lines in which two prompts differ are compared, so the fixed text of a prompt
+	// template cannot make the prompts of two different emails look alike.
+	MatchFuzzy = "fuzzy"
+)
+
+// DefaultFuzzyThreshold is the minimum word overlap (Jaccard similarity) of the differing
+// lines of a fuzzy match
+const DefaultFuzzyThreshold = 0.6
+
+// ErrNoRecording is returned when replaying and no recorded interaction matches the request
+var ErrNoRecording = errors.New("no recorded LLM interaction matches the request")
+
+// Interaction kinds
+const (
+	kindCompletion = "completion"
+	kindStructured = "structured"
+	kindEmbedding  = "embedding"
+	kindEmbeddings = "embeddings"
+)
+
+// Interaction is one recorded request and its response. A cassette is a JSON Lines file with
+// one interaction per line, written as requests complete so that an interrupted run keeps
+// everything recorded so far.
+type Interaction struct {
+	Kind       string          `json:"kind"`
+	Prompt     string          `json:"prompt,omitempty"`
+	Schema     string          `json:"schema,omitempty"` // Name of the response schema of a structured completion
+	Texts      []string        `json:"texts,omitempty"`  // Embedded texts
+	Completion string          `json:"completion,omitempty"`
+	Structured json.RawMessage `json:"structured,omitempty"`
+	Embeddings [][]float32     `json:"embeddings,omitempty"`
+	Error      string          `json:"error,omitempty"` // Recorded failures are replayed as errors
+}
+
+// CassetteClient is a Client decorator that records LLM traffic to a cassette file or replays it,
+// so tests and demos can run realistic LLM interactions without Ollama or LiteLLM
+type CassetteClient struct {
+	base      Client
+	mode      string
+	match     string
+	threshold float64
+	logger    *slog.Logger
+
+	mu           sync.Mutex
+	file         *os.File
+	encoder      *json.Encoder
+	interactions []Interaction
+	lines        [][]string
+	used         []bool
+}
+
+// NewRecordingClient wraps base and records its traffic to a new cassette at path,
+// replacing any existing file
+func NewRecordingClient(base Client, path string) (*CassetteClient, error) {
+	file, err := os.Create(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cassette: %w", err)
+	}
+	return &CassetteClient{
+		base:    base,
+		mode:    CassetteRecord,
+		file:    file,
+		encoder: json.NewEncoder(file),
+	}, nil
+}
+
+// NewReplayClient replays the cassette at path, matching requests with the given strategy.
+// Every fuzzy match is logged as a warning, since it replays a response to another request.
+func NewReplayClient(path, match string, logger *slog.Logger) (*CassetteClient, error) {
+	if match != MatchStrict && match != MatchFuzzy {
+		return nil, fmt.Errorf("unknown cassette match %q, expected %s or %s", match, MatchStrict, MatchFuzzy)
+	}
+	interactions, err := LoadCassette(path)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &CassetteClient{
+		mode:         CassetteReplay,
+		match:        match,
+		threshold:    DefaultFuzzyThreshold,
+		logger:       logger,
+		interactions: interactions,
+		lines:        make([][]string, len(interactions)),
+		used:         make([]bool, len(interactions)),
+	}
+	for i, interaction := range interactions {
+		c.lines[i] = strings.Split(interaction.requestText(), "\n")
+	}
+	return c, nil
+}
+
+// WithCassette wraps client according to the cassette settings. An empty path returns
+// client unchanged; mode is CassetteRecord or CassetteReplay.
+func WithCassette(client Client, path, mode, match string, logger *slog.Logger) (Client, error) {
+	switch {
+	case path == "":
+		return client, nil
+	case mode == CassetteRecord:
+		return NewRecordingClient(client, path)
+	case mode == CassetteReplay:
+		return NewReplayClient(path, match, logger)
+	default:
+		return nil, fmt.Errorf("unknown cassette mode %q, expected %s or %s", mode, CassetteRecord, CassetteReplay)
+	}
+}
+
+// LoadCassette reads the interactions of a cassette file
+func LoadCassette(path string) ([]Interaction, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open cassette: %w", err)
+	}
+	defer file.Close()
+
+	var interactions []Interaction
+	scanner := bufio.NewScanner(file)
+	// Embedding batches make for long lines
+	scanner.Buffer(make([]byte, 0, 64*1024), 64*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if len(strings.TrimSpace(scanner.Text())) == 0 {
+			continue
+		}
+		var interaction Interaction
+		if err := json.Unmarshal(scanner.Bytes(), &interaction); err != nil {
+			return nil, fmt.Errorf("invalid cassette line %d: %w", line, err)
+		}
+		interactions = append(interactions, interaction)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read cassette: %w", err)
+	}
+	return interactions, nil
+}
+
+// SetFuzzyThreshold sets the minimum similarity, between 0 and 1, of a fuzzy match
+func (c *CassetteClient) SetFuzzyThreshold(threshold float64) {
+	c.threshold = threshold
+}
+
+// GenerateCompletion records or replays a completion
+func (c *CassetteClient) GenerateCompletion(ctx context.Context, prompt string) (string, error) {
+	request := Interaction{Kind: kindCompletion, Prompt: prompt}
+	if c.mode == CassetteReplay {
+		recorded, err := c.replay(request)
+		if err != nil {
+			return "", err
+		}
+		return recorded.Completion, recorded.err()
+	}
+
+	response, err := c.base.GenerateCompletion(ctx, prompt)
+	request.Completion = response
+	return response, c.record(request, err)
+}
+
+// GenerateStructured records or replays a structured completion
+func (c *CassetteClient) GenerateStructured(ctx context.Context, prompt string, schema *ResponseSchema) (json.RawMessage, error) {
+	request := Interaction{Kind: kindStructured, Prompt: prompt}
+	if schema != nil {
+		request.Schema = schema.Name
+	}
+	if c.mode == CassetteReplay {
+		recorded, err := c.replay(request)
+		if err != nil {
+			return nil, err
+		}
+		return recorded.Structured, recorded.err()
+	}
+
+	response, err := c.base.GenerateStructured(ctx, prompt, schema)
+	request.Structured = response
+	return response, c.record(request, err)
+}
+
+// GenerateEmbedding records or replays an embedding
+func (c *CassetteClient) GenerateEmbedding(ctx context.Context, text string) ([]float32, error) {
+	request := Interaction{Kind: kindEmbedding, Texts: []string{text}}
+	if c.mode == CassetteReplay {
+		recorded, err := c.replay(request)
+		if err == nil {
+			if err := recorded.err(); err != nil {
+				return nil, err
+			}
+			if len(recorded.Embeddings) == 1 {
+				return recorded.Embeddings[0], nil
+			}
+		}
+		// The text may have been embedded as part of a batch
+		if embedding, ok := c.recordedEmbedding(text); ok {
+			return embedding, nil
+		}
+		return nil, noRecording(request)
+	}
+
+	embedding, err := c.base.GenerateEmbedding(ctx, text)
+	if err == nil {
+		request.Embeddings = [][]float32{embedding}
+	}
+	return embedding, c.record(request, err)
+}
+
+// GenerateEmbeddings records or replays a batch of embeddings. When replaying a batch that was
+// not recorded as such, each text is looked up among all recorded embeddings.
+func (c *CassetteClient) GenerateEmbeddings(ctx context.Context, texts []string) ([][]float32, error) {
+	request := Interaction{Kind: kindEmbeddings, Texts: texts}
+	if c.mode == CassetteReplay {
+		if recorded, err := c.replay(request); err == nil && slices.Equal(recorded.Texts, texts) {
+			return recorded.Embeddings, recorded.err()
+		}
+		embeddings := make([][]float32, len(texts))
+		for i, text := range texts {
+			embedding, ok := c.recordedEmbedding(text)
+			if !ok {
+				return nil, noRecording(Interaction{Kind: kindEmbedding, Texts: []string{text}})
+			}
+			embeddings[i] = embedding
+		}
+		return embeddings, nil
+	}
+
+	embeddings, err := c.base.GenerateEmbeddings(ctx, texts)
+	request.Embeddings = embeddings
+	return embeddings, c.record(request, err)
+}
+
+// Close closes the cassette file and the wrapped client
+func (c *CassetteClient) Close() error {
+	if c.mode == CassetteReplay {
+		return nil
+	}
+	c.mu.Lock()
+	err := c.file.Close()
+	c.mu.Unlock()
+	if baseErr := c.base.Close(); err == nil {
+		err = baseErr
+	}
+	return err
+}
+
+// record appends an interaction to the cassette and returns the error of the wrapped call
+func (c *CassetteClient) record(interaction Interaction, callErr error) error {
+	if callErr != nil {
+		interaction.Error = callErr.Error()
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.encoder.Encode(interaction); err != nil {
+		return errors.Join(callErr, fmt.Errorf("failed to record LLM interaction: %w", err))
+	}
+	return callErr
+}
+
+// replay finds the recorded interaction answering a request. Identical requests always match;
+// repeated requests are answered in recording order, and the last answer is reused once they
+// run out. Fuzzy matching falls back to the request of the same kind whose differing lines are
+// most similar.
+func (c *CassetteClient) replay(request Interaction) (*Interaction, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	exact := -1
+	for i := range c.interactions {
+		if c.interactions[i].sameRequest(request) {
+			exact = i
+			if !c.used[i] {
+				break
+			}
+		}
+	}
+	if exact >= 0 {
+		c.used[exact] = true
+		return &c.interactions[exact], nil
+	}
+	if c.match != MatchFuzzy {
+		return nil, noRecording(request)
+	}
+
+	lines := strings.Split(request.requestText(), "\n")
+	best, bestScore := -1, 0.0
+	for i := range c.interactions {
+		if c.interactions[i].Kind != request.Kind || c.interactions[i].Schema != request.Schema {
+			continue
+		}
+		requested, recorded := differingLines(lines, c.lines[i])
+		score := similarity(wordSet(requested), wordSet(recorded))
+		// Prefer unused interactions among equally similar ones
+		if best < 0 || score > bestScore || (score == bestScore && c.used[best] && !c.used[i]) {
+			best, bestScore = i, score
+		}
+	}
+	if best < 0 || bestScore < c.threshold {
+		return nil, noRecording(request)
+	}
+	requested, recorded := differingLines(lines, c.lines[best])
+	c.logger.Warn("Replaying a fuzzy cassette match",
+		"kind", request.Kind,
+		"similarity", bestScore,
+		"request", shorten(requested),
+		"recorded", shorten(recorded))
+	c.used[best] = true
+	return &c.interactions[best], nil
+}
+
+// recordedEmbedding looks a text up in every recorded embedding and embedding batch
+func (c *CassetteClient) recordedEmbedding(text string) ([]float32, bool) {
+	for _, interaction := range c.interactions {
+		if interaction.Kind != kindEmbedding && interaction.Kind != kindEmbeddings {
+			continue
+		}
+		for i, recorded := range interaction.Texts {
+			if recorded == text && i < len(interaction.Embeddings) {
+				return interaction.Embeddings[i], true
+			}
+		}
+	}
+	return nil, false
+}
+
+func (i *Interaction) sameRequest(request Interaction) bool {
+	return i.Kind == request.Kind && i.Schema == request.Schema &&
+		i.Prompt == request.Prompt && slices.Equal(i.Texts, request.Texts)
+}
+
+func (i *Interaction) requestText() string {
+	if i.Prompt != "" {
+		return i.Prompt
+	}
+	return strings.Join(i.Texts, "\n")
+}
+
+func (i *Interaction) err() error {
+	if i.Error == "" {
+		return nil
+	}
+	return errors.New(i.Error)
+}
+
+// noRecording describes the unmatched request, shortened so errors stay readable
+func noRecording(request Interaction) error {
+	return fmt.Errorf("%w: %s %q", ErrNoRecording, request.Kind, shorten(request.requestText()))
+}
+
+// shorten cuts a text to 80 bytes
+func shorten(text string) string {
+	if len(text) > 80 {
+		return text[:80] + "..."
+	}
+	return text
+}
+
+// differingLines returns the text of a and b without the lines they start and end with in
+// common, which for two prompts rendered from one template is the template text around them
+func differingLines(a, b []string) (string, string) {
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+	return strings.Join(a[prefix:len(a)-suffix], "\n"), strings.Join(b[prefix:len(b)-suffix], "\n")
+}
+
+// wordSet returns the distinct lower-case words of a text
+func wordSet(text string) map[string]struct{} {
+	words := map[string]struct{}{}
+	for _, word := range strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		words[word] = struct{}{}
+	}
+	return words
+}
+
+// similarity is the Jaccard similarity of two word sets
+func similarity(a, b map[string]struct{}) float64 {
+	if len(a) == 0 && len(b) == 0 {
+		return 1
+	}
+	shared := 0
+	for word := range a {
+		if _, ok := b[word]; ok {
+			shared++
+		}
+	}
+	return float64(shared) / float64(len(a)+len(b)-shared)
+}
@@ -0,0 +1,177 @@
+package llm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// scriptedClient answers every request from its prompt, counting the calls it serves
+type scriptedClient struct {
+	calls int
+}
+
+func (s *scriptedClient) GenerateCompletion(ctx context.Context, prompt string) (string, error) {
+	s.calls++
+	if strings.Contains(prompt, "fail") {
+		return "", errors.New("model overloaded")
+	}
+	return "answer to " + prompt, nil
+}
+
+func (s *scriptedClient) GenerateStructured(ctx context.Context, prompt string, schema *ResponseSchema) (json.RawMessage, error) {
+	s.calls++
+	return json.RawMessage(`{"kind":"person","people":[{"name":"Kenneth Lay"}]}`), nil
+}
+
+func (s *scriptedClient) GenerateEmbedding(ctx context.Context, text string) ([]float32, error) {
+	s.calls++
+	return []float32{float32(len(text)), 1}, nil
+}
+
+func (s *scriptedClient) GenerateEmbeddings(ctx context.Context, texts []string) ([][]float32, error) {
+	embeddings := make([][]float32, len(texts))
+	for i, text := range texts {
+		embeddings[i], _ = s.GenerateEmbedding(ctx, text)
+	}
+	return embeddings, nil
+}
+
+func (s *scriptedClient) Close() error { return nil }
+
+func recordCassette(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "session.jsonl")
+	recorder, err := NewRecordingClient(&scriptedClient{}, path)
+	if err != nil {
+		t.Fatalf("NewRecordingClient failed: %v", err)
+	}
+	ctx := context.Background()
+
+	recorder.GenerateCompletion(ctx, "Who is Kenneth Lay, the chairman of Enron Corporation in Houston?")
+	if _, err := recorder.GenerateCompletion(ctx, "please fail"); err == nil {
+		t.Fatal("Expected the wrapped client's error to be returned while recording")
+	}
+	recorder.GenerateStructured(ctx, "List the people", testSchema())
+	recorder.GenerateEmbedding(ctx, "Enron")
+	recorder.GenerateEmbeddings(ctx, []string{"Dynegy", "Raptor"})
+
+	if err := recorder.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+	return path
+}
+
+func TestCassetteClient_RecordsAndReplaysStrictly(t *testing.T) {
+	path := recordCassette(t)
+
+	interactions, err := LoadCassette(path)
+	if err != nil {
+		t.Fatalf("LoadCassette failed: %v", err)
+	}
+	if len(interactions) != 5 {
+		t.Fatalf("Expected 5 recorded interactions, got %d", len(interactions))
+	}
+
+	replay, err := NewReplayClient(path, MatchStrict, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	if err != nil {
+		t.Fatalf("NewReplayClient failed: %v", err)
+	}
+	ctx := context.Background()
+
+	answer, err := replay.GenerateCompletion(ctx, "Who is Kenneth Lay, the chairman of Enron Corporation in Houston?")
+	if err != nil || answer != "answer to Who is Kenneth Lay, the chairman of Enron Corporation in Houston?" {
+		t.Errorf("Unexpected replayed completion %q (%v)", answer, err)
+	}
+	if _, err := replay.GenerateCompletion(ctx, "please fail"); err == nil || err.Error() != "model overloaded" {
+		t.Errorf("Expected the recorded error to be replayed, got %v", err)
+	}
+	structured, err := replay.GenerateStructured(ctx, "List the people", testSchema())
+	if err != nil || !strings.Contains(string(structured), "Kenneth Lay") {
+		t.Errorf("Unexpected replayed structured response %s (%v)", structured, err)
+	}
+
+	// Texts embedded in a batch can be replayed one by one and the other way around
+	if embedding, err := replay.GenerateEmbedding(ctx, "Raptor"); err != nil || embedding[0] != 6 {
+		t.Errorf("Unexpected replayed embedding %v (%v)", embedding, err)
+	}
+	if embeddings, err := replay.GenerateEmbeddings(ctx, []string{"Enron", "Dynegy"}); err != nil || len(embeddings) != 2 || embeddings[1][0] != 6 {
+		t.Errorf("Unexpected replayed embeddings %v (%v)", embeddings, err)
+	}
+
+	if _, err := replay.GenerateCompletion(ctx, "Who is Kenneth Lay?"); !errors.Is(err, ErrNoRecording) {
+		t.Errorf("Expected ErrNoRecording for an unrecorded prompt in strict mode, got %v", err)
+	}
+}
+
+func TestCassetteClient_FuzzyMatching(t *testing.T) {
+	path := recordCassette(t)
+
+	var logs bytes.Buffer
+	replay, err := NewReplayClient(path, MatchFuzzy, slog.New(slog.NewTextHandler(&logs, nil)))
+	if err != nil {
+		t.Fatalf("NewReplayClient failed: %v", err)
+	}
+	ctx := context.Background()
+
+	answer, err := replay.GenerateCompletion(ctx, "Who is Kenneth Lay, chairman of Enron Corporation in Houston, Texas?")
+	if err != nil || !strings.HasPrefix(answer, "answer to Who is Kenneth Lay") {
+		t.Errorf("Expected a similar prompt to replay the recorded answer, got %q (%v)", answer, err)
+	}
+	if !strings.Contains(logs.String(), "level=WARN") {
+		t.Errorf("Expected the fuzzy match to be logged as a warning, got %q", logs.String())
+	}
+	if _, err := replay.GenerateCompletion(ctx, "Summarize the Dabhol power plant dispute"); !errors.Is(err, ErrNoRecording) {
+		t.Errorf("Expected an unrelated prompt not to match, got %v", err)
+	}
+}
+
+// TestCassetteClient_FuzzyMatchingIgnoresTemplate tests that the shared text of prompts rendered
+// from one template does not make the prompts of different emails match
+func TestCassetteClient_FuzzyMatchingIgnoresTemplate(t *testing.T) {
+	template := "### ROLE\nYou extract entities and relationships from emails and output only JSON.\n" +
+		"### ONTOLOGY\nTypes: [%s]\n### INPUT EMAIL\nFrom: %s\nContent: %s\n" +
+		"### TASK\nExtract entities and relationships into the schema below. Normalize names and use VERB_FORM predicates."
+	path := filepath.Join(t.TempDir(), "extraction.jsonl")
+	recorder, err := NewRecordingClient(&scriptedClient{}, path)
+	if err != nil {
+		t.Fatalf("NewRecordingClient failed: %v", err)
+	}
+	ctx := context.Background()
+	recorder.GenerateCompletion(ctx, fmt.Sprintf(template, "person", "ken.lay@enron.com", "The board meets on Monday about the Q2 forecast."))
+	recorder.Close()
+
+	replay, err := NewReplayClient(path, MatchFuzzy, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	if err != nil {
+		t.Fatalf("NewReplayClient failed: %v", err)
+	}
+
+	// The same email with more types already in the graph
+	if _, err := replay.GenerateCompletion(ctx, fmt.Sprintf(template, "person, organization", "ken.lay@enron.com", "The board meets on Monday about the Q2 forecast.")); err != nil {
+		t.Errorf("Expected the same email with other types to match, got %v", err)
+	}
+	// Another email
+	if _, err := replay.GenerateCompletion(ctx, fmt.Sprintf(template, "person", "jeff.skilling@enron.com", "Please send me the Raptor hedge numbers.")); !errors.Is(err, ErrNoRecording) {
+		t.Errorf("Expected another email not to match, got %v", err)
+	}
+}
+
+func TestWithCassette(t *testing.T) {
+	base := &scriptedClient{}
+	if client, err := WithCassette(base, "", CassetteReplay, MatchStrict, nil); err != nil || client != base {
+		t.Errorf("Expected the client to be returned unchanged without a cassette")
+	}
+	if _, err := WithCassette(base, "x.jsonl", "rewind", MatchStrict, nil); err == nil {
+		t.Error("Expected an unknown mode to be rejected")
+	}
+	if _, err := NewReplayClient(recordCassette(t), "loose", nil); err == nil {
+		t.Error("Expected an unknown match strategy to be rejected")
+	}
+}
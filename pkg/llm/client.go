@@ -2,6 +2,7 @@ package llm
 
 import (
 	"context"
+	"encoding/json"
 )
 
 // Client defines the interface for LLM operations
@@ -9,6 +10,10 @@ type Client interface {
 	// GenerateCompletion generates a text completion from a prompt
 	GenerateCompletion(ctx context.Context, prompt string) (string, error)
 
+	// GenerateStructured generates a completion constrained to the given schema
+	// and returns the validated JSON payload
+	GenerateStructured(ctx context.Context, prompt string, schema *ResponseSchema) (json.RawMessage, error)
+
 	// GenerateEmbedding generates a vector embedding for the given text
 	GenerateEmbedding(ctx context.Context, text string) ([]float32, error)
 
@@ -21,6 +21,9 @@ type LiteLLMClient struct {
 	logger          *slog.Logger
 	maxRetries      int
 	retryDelay      time.Duration
+	// Timeouts of completions and embedding batches, and of single embeddings
+	completionTimeout time.Duration
+	embeddingTimeout  time.Duration
 }
 
 // NewLiteLLMClient creates a new LiteLLM client
@@ -33,9 +36,11 @@ func NewLiteLLMClient(baseURL, completionModel, embeddingModel, apiKey string, l
 		httpClient: &http.Client{
 			Timeout: 60 * time.Second,
 		},
-		logger:     logger,
-		maxRetries: 3,
-		retryDelay: 2 * time.Second,
+		logger:            logger,
+		maxRetries:        3,
+		retryDelay:        2 * time.Second,
+		completionTimeout: 30 * time.Second,
+		embeddingTimeout:  10 * time.Second,
 	}
 }
 
@@ -78,7 +83,7 @@ func (c *LiteLLMClient) GenerateCompletion(ctx context.Context, prompt string) (
 			time.Sleep(c.retryDelay * time.Duration(attempt))
 		}
 
-		response, err := c.makeRequest(ctx, "/v1/chat/completions", requestBody, 30*time.Second)
+		response, err := c.makeRequest(ctx, "/v1/chat/completions", requestBody, c.completionTimeout)
 		if err != nil {
 			lastErr = err
 			continue
@@ -109,6 +114,94 @@ func (c *LiteLLMClient) GenerateCompletion(ctx context.Context, prompt string) (
 	return "", fmt.Errorf("failed after %d retries: %w", c.maxRetries, lastErr)
 }
 
+// GenerateStructured generates a schema-constrained completion by forcing a single
+// OpenAI-style tool call whose parameters are the requested schema
+func (c *LiteLLMClient) GenerateStructured(ctx context.Context, prompt string, schema *ResponseSchema) (json.RawMessage, error) {
+	requestBody := map[string]interface{}{
+		"model": c.completionModel,
+		"messages": []map[string]string{
+			{
+				"role":    "user",
+				"content": prompt,
+			},
+		},
+		"tools": []map[string]interface{}{
+			{
+				"type": "function",
+				"function": map[string]interface{}{
+					"name":        schema.Name,
+					"description": schema.Description,
+					"parameters":  schema.Schema,
+				},
+			},
+		},
+		"tool_choice": map[string]interface{}{
+			"type": "function",
+			"function": map[string]string{
+				"name": schema.Name,
+			},
+		},
+		"temperature": 0.0,
+		"stream":      false,
+	}
+
+	var lastErr error
+	for attempt := 0; attempt <= c.maxRetries; attempt++ {
+		if attempt > 0 {
+			c.logger.Debug("Retrying structured request",
+				"attempt", attempt,
+				"max_retries", c.maxRetries)
+			time.Sleep(c.retryDelay * time.Duration(attempt))
+		}
+
+		response, err := c.makeRequest(ctx, "/v1/chat/completions", requestBody, c.completionTimeout)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		var result struct {
+			Choices []struct {
+				Message struct {
+					Content   string `json:"content"`
+					ToolCalls []struct {
+						Function struct {
+							Name      string `json:"name"`
+							Arguments string `json:"arguments"`
+						} `json:"function"`
+					} `json:"tool_calls"`
+				} `json:"message"`
+			} `json:"choices"`
+		}
+
+		if err := json.Unmarshal(response, &result); err != nil {
+			lastErr = fmt.Errorf("failed to parse response: %w", err)
+			continue
+		}
+
+		if len(result.Choices) == 0 {
+			lastErr = fmt.Errorf("no choices in response")
+			continue
+		}
+
+		message := result.Choices[0].Message
+		if len(message.ToolCalls) == 0 {
+			lastErr = fmt.Errorf("model did not call tool %q", schema.Name)
+			continue
+		}
+
+		payload := []byte(message.ToolCalls[0].Function.Arguments)
+		if err := ValidateJSON(schema, payload); err != nil {
+			lastErr = fmt.Errorf("structured output failed validation: %w", err)
+			continue
+		}
+
+		return json.RawMessage(payload), nil
+	}
+
+	return nil, fmt.Errorf("failed after %d retries: %w", c.maxRetries, lastErr)
+}
+
 // GenerateEmbedding generates a vector embedding using LiteLLM
 func (c *LiteLLMClient) GenerateEmbedding(ctx context.Context, text string) ([]float32, error) {
 	requestBody := map[string]interface{}{
@@ -125,7 +218,7 @@ func (c *LiteLLMClient) GenerateEmbedding(ctx context.Context, text string) ([]f
 			time.Sleep(c.retryDelay * time.Duration(attempt))
 		}
 
-		response, err := c.makeRequest(ctx, "/v1/embeddings", requestBody, 10*time.Second)
+		response, err := c.makeRequest(ctx, "/v1/embeddings", requestBody, c.embeddingTimeout)
 		if err != nil {
 			lastErr = err
 			continue
@@ -176,7 +269,7 @@ func (c *LiteLLMClient) GenerateEmbeddings(ctx context.Context, texts []string)
 			time.Sleep(c.retryDelay * time.Duration(attempt))
 		}
 
-		response, err := c.makeRequest(ctx, "/v1/embeddings", requestBody, 30*time.Second)
+		response, err := c.makeRequest(ctx, "/v1/embeddings", requestBody, c.completionTimeout)
 		if err != nil {
 			lastErr = err
 			continue
@@ -261,12 +354,23 @@ func (c *LiteLLMClient) makeRequest(ctx context.Context, endpoint string, reques
 
 	// Check status code
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("request failed with status %d: %s", resp.StatusCode, string(body))
+		return nil, newStatusError(resp, body)
 	}
 
 	return body, nil
 }
 
+// SetTimeouts sets the request timeouts of completions and embedding batches, and of single embeddings
+func (c *LiteLLMClient) SetTimeouts(completion, embedding time.Duration) {
+	c.completionTimeout = completion
+	c.embeddingTimeout = embedding
+}
+
+// SetMaxRetries sets how often a failed request is retried; 0 disables retries
+func (c *LiteLLMClient) SetMaxRetries(maxRetries int) {
+	c.maxRetries = maxRetries
+}
+
 // Close releases resources (no-op for LiteLLM client)
 func (c *LiteLLMClient) Close() error {
 	return nil
@@ -20,6 +20,9 @@ type OllamaClient struct {
 	logger          *slog.Logger
 	maxRetries      int
 	retryDelay      time.Duration
+	// Timeouts of completions and embedding batches, and of single embeddings
+	completionTimeout time.Duration
+	embeddingTimeout  time.Duration
 }
 
 // NewOllamaClient creates a new Ollama client
@@ -31,9 +34,11 @@ func NewOllamaClient(baseURL, completionModel, embeddingModel string, logger *sl
 		httpClient: &http.Client{
 			Timeout: 60 * time.Second,
 		},
-		logger:     logger,
-		maxRetries: 3,
-		retryDelay: 2 * time.Second,
+		logger:            logger,
+		maxRetries:        3,
+		retryDelay:        2 * time.Second,
+		completionTimeout: 30 * time.Second,
+		embeddingTimeout:  10 * time.Second,
 	}
 }
 
@@ -58,7 +63,7 @@ func (c *OllamaClient) GenerateCompletion(ctx context.Context, prompt string) (s
 			time.Sleep(c.retryDelay * time.Duration(attempt))
 		}
 
-		response, err := c.makeRequest(ctx, "/api/generate", requestBody, 30*time.Second)
+		response, err := c.makeRequest(ctx, "/api/generate", requestBody, c.completionTimeout)
 		if err != nil {
 			lastErr = err
 			continue
@@ -85,30 +90,36 @@ func (c *OllamaClient) GenerateCompletion(ctx context.Context, prompt string) (s
 	return "", fmt.Errorf("failed after %d retries: %w", c.maxRetries, lastErr)
 }
 
-// GenerateEmbedding generates a vector embedding using Ollama
-func (c *OllamaClient) GenerateEmbedding(ctx context.Context, text string) ([]float32, error) {
+// GenerateStructured generates a schema-constrained completion using Ollama's format parameter
+func (c *OllamaClient) GenerateStructured(ctx context.Context, prompt string, schema *ResponseSchema) (json.RawMessage, error) {
 	requestBody := map[string]interface{}{
-		"model":  c.embeddingModel,
-		"prompt": text,
+		"model":  c.completionModel,
+		"prompt": prompt,
+		"stream": false,
+		"format": schema.Schema,
+		"options": map[string]interface{}{
+			"temperature": 0.0,
+		},
 	}
 
 	var lastErr error
 	for attempt := 0; attempt <= c.maxRetries; attempt++ {
 		if attempt > 0 {
-			c.logger.Debug("Retrying embedding request",
+			c.logger.Debug("Retrying structured request",
 				"attempt", attempt,
 				"max_retries", c.maxRetries)
 			time.Sleep(c.retryDelay * time.Duration(attempt))
 		}
 
-		response, err := c.makeRequest(ctx, "/api/embeddings", requestBody, 10*time.Second)
+		response, err := c.makeRequest(ctx, "/api/generate", requestBody, c.completionTimeout)
 		if err != nil {
 			lastErr = err
 			continue
 		}
 
 		var result struct {
-			Embedding []float64 `json:"embedding"`
+			Response string `json:"response"`
+			Done     bool   `json:"done"`
 		}
 
 		if err := json.Unmarshal(response, &result); err != nil {
@@ -116,49 +127,103 @@ func (c *OllamaClient) GenerateEmbedding(ctx context.Context, text string) ([]fl
 			continue
 		}
 
-		if len(result.Embedding) == 0 {
-			lastErr = fmt.Errorf("empty embedding returned")
+		if !result.Done {
+			lastErr = fmt.Errorf("incomplete response from Ollama")
 			continue
 		}
 
-		// Convert float64 to float32
-		embedding := make([]float32, len(result.Embedding))
-		for i, v := range result.Embedding {
-			embedding[i] = float32(v)
+		payload := []byte(result.Response)
+		if err := ValidateJSON(schema, payload); err != nil {
+			lastErr = fmt.Errorf("structured output failed validation: %w", err)
+			continue
 		}
 
-		return embedding, nil
+		return json.RawMessage(payload), nil
 	}
 
 	return nil, fmt.Errorf("failed after %d retries: %w", c.maxRetries, lastErr)
 }
 
-// GenerateEmbeddings generates embeddings for multiple texts in batch
+// GenerateEmbedding generates a vector embedding using Ollama
+func (c *OllamaClient) GenerateEmbedding(ctx context.Context, text string) ([]float32, error) {
+	embeddings, err := c.embed(ctx, text, 1, c.embeddingTimeout)
+	if err != nil {
+		return nil, err
+	}
+	return embeddings[0], nil
+}
+
+// GenerateEmbeddings generates embeddings for multiple texts in one request
 func (c *OllamaClient) GenerateEmbeddings(ctx context.Context, texts []string) ([][]float32, error) {
-	embeddings := make([][]float32, len(texts))
+	if len(texts) == 0 {
+		return [][]float32{}, nil
+	}
+	return c.embed(ctx, texts, len(texts), c.completionTimeout)
+}
 
-	// Process in batches to avoid overwhelming the API
-	batchSize := 10
-	for i := 0; i < len(texts); i += batchSize {
-		end := i + batchSize
-		if end > len(texts) {
-			end = len(texts)
+// embed calls /api/embed, which takes a string or a list of strings as input, so single texts
+// and batches are embedded the same way. Unlike the /api/embeddings endpoint used before, it
+// returns L2-normalized vectors, so embeddings stored by earlier versions only compare with new
+// ones under the cosine metric.
+func (c *OllamaClient) embed(ctx context.Context, input interface{}, count int, timeout time.Duration) ([][]float32, error) {
+	requestBody := map[string]interface{}{
+		"model": c.embeddingModel,
+		"input": input,
+	}
+
+	var lastErr error
+	for attempt := 0; attempt <= c.maxRetries; attempt++ {
+		if attempt > 0 {
+			c.logger.Debug("Retrying embedding request",
+				"attempt", attempt,
+				"max_retries", c.maxRetries)
+			time.Sleep(c.retryDelay * time.Duration(attempt))
 		}
 
-		for j := i; j < end; j++ {
-			embedding, err := c.GenerateEmbedding(ctx, texts[j])
-			if err != nil {
-				return nil, fmt.Errorf("failed to generate embedding for text %d: %w", j, err)
-			}
-			embeddings[j] = embedding
+		response, err := c.makeRequest(ctx, "/api/embed", requestBody, timeout)
+		if err != nil {
+			lastErr = err
+			continue
 		}
 
-		// Small delay between batches
-		if end < len(texts) {
-			time.Sleep(100 * time.Millisecond)
+		var result struct {
+			Embeddings [][]float64 `json:"embeddings"`
 		}
+
+		if err := json.Unmarshal(response, &result); err != nil {
+			lastErr = fmt.Errorf("failed to parse response: %w", err)
+			continue
+		}
+
+		if len(result.Embeddings) != count {
+			lastErr = fmt.Errorf("expected %d embeddings, got %d", count, len(result.Embeddings))
+			continue
+		}
+
+		embeddings, err := float32Embeddings(result.Embeddings)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		return embeddings, nil
 	}
 
+	return nil, fmt.Errorf("failed after %d retries: %w", c.maxRetries, lastErr)
+}
+
+// float32Embeddings converts a batch of embeddings to float32, rejecting empty ones
+func float32Embeddings(values [][]float64) ([][]float32, error) {
+	embeddings := make([][]float32, len(values))
+	for i, embedding := range values {
+		if len(embedding) == 0 {
+			return nil, fmt.Errorf("empty embedding returned for text %d", i)
+		}
+		embeddings[i] = make([]float32, len(embedding))
+		for j, v := range embedding {
+			embeddings[i][j] = float32(v)
+		}
+	}
 	return embeddings, nil
 }
 
@@ -197,12 +262,23 @@ func (c *OllamaClient) makeRequest(ctx context.Context, endpoint string, request
 
 	// Check status code
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("request failed with status %d: %s", resp.StatusCode, string(body))
+		return nil, newStatusError(resp, body)
 	}
 
 	return body, nil
 }
 
+// SetTimeouts sets the request timeouts of completions and embedding batches, and of single embeddings
+func (c *OllamaClient) SetTimeouts(completion, embedding time.Duration) {
+	c.completionTimeout = completion
+	c.embeddingTimeout = embedding
+}
+
+// SetMaxRetries sets how often a failed request is retried; 0 disables retries
+func (c *OllamaClient) SetMaxRetries(maxRetries int) {
+	c.maxRetries = maxRetries
+}
+
 // Close releases resources (no-op for Ollama client)
 func (c *OllamaClient) Close() error {
 	return nil
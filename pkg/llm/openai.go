@@ -0,0 +1,265 @@
+package llm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// OpenAIClient implements the Client interface against any server speaking the OpenAI API,
+// such as vLLM, the llama.cpp server or LM Studio. Completions and embeddings can be served
+// from different base URLs, since local setups often run one server per model.
+type OpenAIClient struct {
+	completionURL   string
+	embeddingURL    string
+	completionModel string
+	embeddingModel  string
+	apiKey          string
+	httpClient      *http.Client
+	logger          *slog.Logger
+	maxRetries      int
+	retryDelay      time.Duration
+	// Timeouts of completions and embedding batches, and of single embeddings
+	completionTimeout time.Duration
+	embeddingTimeout  time.Duration
+}
+
+// NewOpenAIClient creates a client for OpenAI-compatible servers. Base URLs include the API
+// version prefix, e.g. http://localhost:8000/v1; an empty embedding URL uses the completion URL.
+func NewOpenAIClient(completionURL, embeddingURL, completionModel, embeddingModel, apiKey string, logger *slog.Logger) *OpenAIClient {
+	if embeddingURL == "" {
+		embeddingURL = completionURL
+	}
+	return &OpenAIClient{
+		completionURL:   strings.TrimSuffix(completionURL, "/"),
+		embeddingURL:    strings.TrimSuffix(embeddingURL, "/"),
+		completionModel: completionModel,
+		embeddingModel:  embeddingModel,
+		apiKey:          apiKey,
+		// Requests time out through their context, see SetTimeouts
+		httpClient:        &http.Client{},
+		logger:            logger,
+		maxRetries:        3,
+		retryDelay:        2 * time.Second,
+		completionTimeout: 30 * time.Second,
+		embeddingTimeout:  10 * time.Second,
+	}
+}
+
+// chatResponse is the part of a chat completion response the client reads
+type chatResponse struct {
+	Choices []struct {
+		Message struct {
+			Content string `json:"content"`
+		} `json:"message"`
+	} `json:"choices"`
+}
+
+// GenerateCompletion generates a text completion through the chat completions endpoint
+func (c *OpenAIClient) GenerateCompletion(ctx context.Context, prompt string) (string, error) {
+	requestBody := map[string]interface{}{
+		"model": c.completionModel,
+		"messages": []map[string]string{
+			{"role": "user", "content": prompt},
+		},
+		"temperature": 0.7,
+		"top_p":       0.9,
+		"stream":      false,
+	}
+
+	var content string
+	err := c.retry("completion", func() error {
+		response, err := c.makeRequest(ctx, c.completionURL+"/chat/completions", requestBody, c.completionTimeout)
+		if err != nil {
+			return err
+		}
+		var result chatResponse
+		if err := json.Unmarshal(response, &result); err != nil {
+			return fmt.Errorf("failed to parse response: %w", err)
+		}
+		if len(result.Choices) == 0 {
+			return fmt.Errorf("no choices in response")
+		}
+		content = result.Choices[0].Message.Content
+		return nil
+	})
+	return content, err
+}
+
+// GenerateStructured generates a schema-constrained completion through the json_schema response
+// format, which vLLM, the llama.cpp server and LM Studio enforce with grammar-based sampling
+func (c *OpenAIClient) GenerateStructured(ctx context.Context, prompt string, schema *ResponseSchema) (json.RawMessage, error) {
+	requestBody := map[string]interface{}{
+		"model": c.completionModel,
+		"messages": []map[string]string{
+			{"role": "user", "content": prompt},
+		},
+		"response_format": map[string]interface{}{
+			"type": "json_schema",
+			"json_schema": map[string]interface{}{
+				"name":        schema.Name,
+				"description": schema.Description,
+				"schema":      schema.Schema,
+				"strict":      true,
+			},
+		},
+		"temperature": 0.0,
+		"stream":      false,
+	}
+
+	var payload json.RawMessage
+	err := c.retry("structured", func() error {
+		response, err := c.makeRequest(ctx, c.completionURL+"/chat/completions", requestBody, c.completionTimeout)
+		if err != nil {
+			return err
+		}
+		var result chatResponse
+		if err := json.Unmarshal(response, &result); err != nil {
+			return fmt.Errorf("failed to parse response: %w", err)
+		}
+		if len(result.Choices) == 0 {
+			return fmt.Errorf("no choices in response")
+		}
+		content := []byte(strings.TrimSpace(result.Choices[0].Message.Content))
+		if err := ValidateJSON(schema, content); err != nil {
+			return fmt.Errorf("structured output failed validation: %w", err)
+		}
+		payload = content
+		return nil
+	})
+	return payload, err
+}
+
+// GenerateEmbedding generates a vector embedding for one text
+func (c *OpenAIClient) GenerateEmbedding(ctx context.Context, text string) ([]float32, error) {
+	embeddings, err := c.embed(ctx, text, 1, c.embeddingTimeout)
+	if err != nil {
+		return nil, err
+	}
+	return embeddings[0], nil
+}
+
+// GenerateEmbeddings generates embeddings for multiple texts in one request
+func (c *OpenAIClient) GenerateEmbeddings(ctx context.Context, texts []string) ([][]float32, error) {
+	if len(texts) == 0 {
+		return [][]float32{}, nil
+	}
+	return c.embed(ctx, texts, len(texts), c.completionTimeout)
+}
+
+// embed calls the embeddings endpoint with a string or a list of strings as input
+func (c *OpenAIClient) embed(ctx context.Context, input interface{}, count int, timeout time.Duration) ([][]float32, error) {
+	requestBody := map[string]interface{}{
+		"model": c.embeddingModel,
+		"input": input,
+	}
+
+	var embeddings [][]float32
+	err := c.retry("embedding", func() error {
+		response, err := c.makeRequest(ctx, c.embeddingURL+"/embeddings", requestBody, timeout)
+		if err != nil {
+			return err
+		}
+		var result struct {
+			Data []struct {
+				Embedding []float64 `json:"embedding"`
+				Index     int       `json:"index"`
+			} `json:"data"`
+		}
+		if err := json.Unmarshal(response, &result); err != nil {
+			return fmt.Errorf("failed to parse response: %w", err)
+		}
+		if len(result.Data) != count {
+			return fmt.Errorf("expected %d embeddings, got %d", count, len(result.Data))
+		}
+
+		embeddings = make([][]float32, count)
+		for _, data := range result.Data {
+			if data.Index < 0 || data.Index >= count || len(data.Embedding) == 0 {
+				return fmt.Errorf("invalid embedding at index %d in response", data.Index)
+			}
+			embedding := make([]float32, len(data.Embedding))
+			for i, v := range data.Embedding {
+				embedding[i] = float32(v)
+			}
+			embeddings[data.Index] = embedding
+		}
+		return nil
+	})
+	return embeddings, err
+}
+
+// retry runs attempt until it succeeds or the retries run out, backing off linearly
+func (c *OpenAIClient) retry(kind string, attempt func() error) error {
+	var lastErr error
+	for i := 0; i <= c.maxRetries; i++ {
+		if i > 0 {
+			c.logger.Debug("Retrying request",
+				"kind", kind,
+				"attempt", i,
+				"max_retries", c.maxRetries)
+			time.Sleep(c.retryDelay * time.Duration(i))
+		}
+		if lastErr = attempt(); lastErr == nil {
+			return nil
+		}
+	}
+	return fmt.Errorf("failed after %d retries: %w", c.maxRetries, lastErr)
+}
+
+// makeRequest posts a JSON request to url and returns the response body
+func (c *OpenAIClient) makeRequest(ctx context.Context, url string, requestBody interface{}, timeout time.Duration) ([]byte, error) {
+	jsonData, err := json.Marshal(requestBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+c.apiKey)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, newStatusError(resp, body)
+	}
+	return body, nil
+}
+
+// SetTimeouts sets the request timeouts of completions and embedding batches, and of single embeddings
+func (c *OpenAIClient) SetTimeouts(completion, embedding time.Duration) {
+	c.completionTimeout = completion
+	c.embeddingTimeout = embedding
+}
+
+// SetMaxRetries sets how often a failed request is retried; 0 disables retries
+func (c *OpenAIClient) SetMaxRetries(maxRetries int) {
+	c.maxRetries = maxRetries
+}
+
+// Close releases resources (no-op for the OpenAI-compatible client)
+func (c *OpenAIClient) Close() error {
+	return nil
+}
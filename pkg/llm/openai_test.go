@@ -0,0 +1,163 @@
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+)
+
+func testLogger() *slog.Logger {
+	return slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelError}))
+}
+
+// newCompletionServer answers chat completions with content and records the last request body
+func newCompletionServer(t *testing.T, content string, request *map[string]interface{}) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/chat/completions" {
+			t.Errorf("Expected path /v1/chat/completions, got %s", r.URL.Path)
+		}
+		if err := json.NewDecoder(r.Body).Decode(request); err != nil {
+			t.Fatalf("Failed to decode request body: %v", err)
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"choices": []map[string]interface{}{
+				{"message": map[string]string{"content": content}},
+			},
+		})
+	}))
+}
+
+// newEmbeddingServer answers embedding requests with one vector per input, its first value the index
+func newEmbeddingServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/embeddings" {
+			t.Errorf("Expected path /v1/embeddings, got %s", r.URL.Path)
+		}
+		var request struct {
+			Input interface{} `json:"input"`
+		}
+		json.NewDecoder(r.Body).Decode(&request)
+		count := 1
+		if inputs, ok := request.Input.([]interface{}); ok {
+			count = len(inputs)
+		}
+		// Return the data in reverse order to check that indexes are honored
+		data := []map[string]interface{}{}
+		for i := count - 1; i >= 0; i-- {
+			data = append(data, map[string]interface{}{"index": i, "embedding": []float64{float64(i), 0.5}})
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"data": data})
+	}))
+}
+
+func TestOpenAIClient_GenerateCompletion(t *testing.T) {
+	var request map[string]interface{}
+	server := newCompletionServer(t, "Kenneth Lay was the chairman of Enron.", &request)
+	defer server.Close()
+
+	client := NewOpenAIClient(server.URL+"/v1/", "", "qwen2.5-7b", "nomic-embed", "", testLogger())
+	result, err := client.GenerateCompletion(context.Background(), "Who is Kenneth Lay?")
+	if err != nil {
+		t.Fatalf("GenerateCompletion failed: %v", err)
+	}
+	if result != "Kenneth Lay was the chairman of Enron." {
+		t.Errorf("Unexpected completion %q", result)
+	}
+	if request["model"] != "qwen2.5-7b" {
+		t.Errorf("Expected model qwen2.5-7b, got %v", request["model"])
+	}
+}
+
+func TestOpenAIClient_GenerateStructured_UsesJSONSchemaResponseFormat(t *testing.T) {
+	var request map[string]interface{}
+	server := newCompletionServer(t, ` {"kind":"person","people":[{"name":"Jeff Skilling"}]} `, &request)
+	defer server.Close()
+
+	client := NewOpenAIClient(server.URL+"/v1", "", "qwen2.5-7b", "nomic-embed", "", testLogger())
+	payload, err := client.GenerateStructured(context.Background(), "List the people", testSchema())
+	if err != nil {
+		t.Fatalf("GenerateStructured failed: %v", err)
+	}
+	if !strings.Contains(string(payload), "Jeff Skilling") {
+		t.Errorf("Unexpected payload %s", payload)
+	}
+
+	format, _ := request["response_format"].(map[string]interface{})
+	jsonSchema, _ := format["json_schema"].(map[string]interface{})
+	if format["type"] != "json_schema" || jsonSchema["name"] != "record_people" || jsonSchema["schema"] == nil {
+		t.Errorf("Expected a json_schema response format, got %v", request["response_format"])
+	}
+}
+
+func TestOpenAIClient_GenerateStructured_RejectsInvalidOutput(t *testing.T) {
+	var request map[string]interface{}
+	server := newCompletionServer(t, `{"kind":"place"}`, &request)
+	defer server.Close()
+
+	client := NewOpenAIClient(server.URL+"/v1", "", "qwen2.5-7b", "nomic-embed", "", testLogger())
+	client.retryDelay = 0
+	if _, err := client.GenerateStructured(context.Background(), "List the people", testSchema()); err == nil {
+		t.Error("Expected output violating the schema to fail")
+	}
+}
+
+func TestOpenAIClient_EmbeddingsUseSeparateServer(t *testing.T) {
+	var request map[string]interface{}
+	completions := newCompletionServer(t, "unused", &request)
+	defer completions.Close()
+	embeddings := newEmbeddingServer(t)
+	defer embeddings.Close()
+
+	client := NewOpenAIClient(completions.URL+"/v1", embeddings.URL+"/v1", "qwen2.5-7b", "nomic-embed", "", testLogger())
+	ctx := context.Background()
+
+	embedding, err := client.GenerateEmbedding(ctx, "Enron")
+	if err != nil || len(embedding) != 2 {
+		t.Fatalf("GenerateEmbedding failed: %v (%v)", err, embedding)
+	}
+	batch, err := client.GenerateEmbeddings(ctx, []string{"Enron", "Dynegy", "Raptor"})
+	if err != nil {
+		t.Fatalf("GenerateEmbeddings failed: %v", err)
+	}
+	for i, vector := range batch {
+		if vector[0] != float32(i) {
+			t.Errorf("Expected embedding %d in position %d, got %v", i, i, vector)
+		}
+	}
+}
+
+func TestNewClient_Providers(t *testing.T) {
+	embeddings := newEmbeddingServer(t)
+	defer embeddings.Close()
+
+	for _, name := range []string{"ollama", "litellm", "openai", "vllm", "llamacpp", "lmstudio"} {
+		if _, err := NewClient(name, ProviderConfig{}, testLogger()); err != nil {
+			t.Errorf("Expected provider %s to be registered: %v", name, err)
+		}
+	}
+	if _, err := NewClient("gpt4all", ProviderConfig{}, testLogger()); err == nil || !strings.Contains(err.Error(), "llamacpp") {
+		t.Errorf("Expected an unknown provider to list the available ones, got %v", err)
+	}
+
+	client, err := NewClient("llamacpp", ProviderConfig{EmbeddingURL: embeddings.URL + "/v1"}, testLogger())
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	if _, err := client.GenerateEmbedding(context.Background(), "Enron"); err != nil {
+		t.Errorf("Expected embeddings from the configured embedding server: %v", err)
+	}
+
+	// A configured completion server embeds too, instead of the default embedding server
+	client, err = NewClient("llamacpp", ProviderConfig{CompletionURL: embeddings.URL + "/v1"}, testLogger())
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	if _, err := client.GenerateEmbedding(context.Background(), "Enron"); err != nil {
+		t.Errorf("Expected embeddings from the configured completion server: %v", err)
+	}
+}
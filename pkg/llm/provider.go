@@ -0,0 +1,167 @@
+package llm
+
+import (
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Blogem/enron-graph/pkg/utils"
+)
+
+// ProviderConfig holds the settings a provider builds its client from
+type ProviderConfig struct {
+	CompletionURL   string
+	EmbeddingURL    string // Defaults to CompletionURL
+	CompletionModel string
+	EmbeddingModel  string
+	APIKey          string
+}
+
+// ProviderFactory creates a client for a provider
+type ProviderFactory func(cfg ProviderConfig, logger *slog.Logger) (Client, error)
+
+// tunableClient is implemented by the HTTP clients whose timeouts and retries can be configured
+type tunableClient interface {
+	SetTimeouts(completion, embedding time.Duration)
+	SetMaxRetries(maxRetries int)
+}
+
+// provider is a registered factory with the base URLs used when none are configured
+type provider struct {
+	factory              ProviderFactory
+	defaultCompletionURL string
+	defaultEmbeddingURL  string
+}
+
+var (
+	providersMu sync.RWMutex
+	providers   = map[string]provider{}
+)
+
+func init() {
+	RegisterProvider("ollama", "http://localhost:11434", "", func(cfg ProviderConfig, logger *slog.Logger) (Client, error) {
+		return NewOllamaClient(cfg.CompletionURL, cfg.CompletionModel, cfg.EmbeddingModel, logger), nil
+	})
+	RegisterProvider("litellm", "http://localhost:4000", "", func(cfg ProviderConfig, logger *slog.Logger) (Client, error) {
+		return NewLiteLLMClient(cfg.CompletionURL, cfg.CompletionModel, cfg.EmbeddingModel, cfg.APIKey, logger), nil
+	})
+
+	// OpenAI-compatible servers, with the ports they listen on by default. The llama.cpp server
+	// only embeds when started with --embedding, which is usually a second server.
+	openAI := func(cfg ProviderConfig, logger *slog.Logger) (Client, error) {
+		return NewOpenAIClient(cfg.CompletionURL, cfg.EmbeddingURL, cfg.CompletionModel, cfg.EmbeddingModel, cfg.APIKey, logger), nil
+	}
+	RegisterProvider("openai", "http://localhost:8000/v1", "", openAI)
+	RegisterProvider("vllm", "http://localhost:8000/v1", "", openAI)
+	RegisterProvider("llamacpp", "http://localhost:8080/v1", "http://localhost:8081/v1", openAI)
+	RegisterProvider("lmstudio", "http://localhost:1234/v1", "", openAI)
+}
+
+// RegisterProvider makes a provider available to NewClient under name, replacing any provider
+// registered under the same name. The default URLs apply when the config leaves the completion
+// URL empty; otherwise, and when the default embedding URL is empty, an empty embedding URL
+// falls back to the completion URL.
+func RegisterProvider(name, defaultCompletionURL, defaultEmbeddingURL string, factory ProviderFactory) {
+	providersMu.Lock()
+	defer providersMu.Unlock()
+	providers[name] = provider{
+		factory:              factory,
+		defaultCompletionURL: defaultCompletionURL,
+		defaultEmbeddingURL:  defaultEmbeddingURL,
+	}
+}
+
+// Providers returns the names of the registered providers, sorted
+func Providers() []string {
+	providersMu.RLock()
+	defer providersMu.RUnlock()
+	names := make([]string, 0, len(providers))
+	for name := range providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// NewClient creates a client for the named provider
+func NewClient(name string, cfg ProviderConfig, logger *slog.Logger) (Client, error) {
+	providersMu.RLock()
+	p, ok := providers[name]
+	providersMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown LLM provider %q (available: %s)", name, strings.Join(Providers(), ", "))
+	}
+
+	// The default embedding URL belongs with the default completion URL; a configured
+	// completion server embeds too unless an embedding URL is configured as well
+	if cfg.CompletionURL == "" {
+		cfg.CompletionURL = p.defaultCompletionURL
+		if cfg.EmbeddingURL == "" {
+			cfg.EmbeddingURL = p.defaultEmbeddingURL
+		}
+	}
+	if cfg.EmbeddingURL == "" {
+		cfg.EmbeddingURL = cfg.CompletionURL
+	}
+
+	logger.Info("Using LLM provider",
+		"provider", name,
+		"completion_url", cfg.CompletionURL,
+		"embedding_url", cfg.EmbeddingURL,
+		"completion_model", cfg.CompletionModel,
+		"embedding_model", cfg.EmbeddingModel)
+	return p.factory(cfg, logger)
+}
+
+// NewClientFromConfig creates the client for the configured provider (LLM_PROVIDER, "ollama" by
+// default) behind the configured rate limit, concurrency cap, retries and circuit breaker. Concurrent
+// embeddings are batched, and the client is wrapped in a cassette client when LLM_CASSETTE is set.
+func NewClientFromConfig(cfg *utils.Config, logger *slog.Logger) (Client, error) {
+	name := cfg.LLMProvider
+	if name == "" {
+		name = "ollama"
+	}
+
+	providerConfig := ProviderConfig{
+		CompletionModel: cfg.CompletionModel,
+		EmbeddingModel:  cfg.EmbeddingModel,
+	}
+	switch name {
+	case "ollama":
+		providerConfig.CompletionURL = cfg.OllamaURL
+	case "litellm":
+		providerConfig.CompletionURL = cfg.LiteLLMURL
+		providerConfig.APIKey = cfg.LiteLLMAPIKey
+	default:
+		providerConfig.CompletionURL = cfg.OpenAIBaseURL
+		providerConfig.EmbeddingURL = cfg.OpenAIEmbeddingURL
+		providerConfig.APIKey = cfg.OpenAIAPIKey
+	}
+
+	client, err := NewClient(name, providerConfig, logger)
+	if err != nil {
+		return nil, err
+	}
+	if tunable, ok := client.(tunableClient); ok {
+		tunable.SetTimeouts(cfg.LLMCompletionTimeout, cfg.LLMEmbeddingTimeout)
+		// The resilient client retries instead, with backoff and only what is worth retrying
+		tunable.SetMaxRetries(0)
+	}
+	client = NewResilientClient(client, ResilienceConfig{
+		RequestsPerSecond: cfg.LLMRateLimit,
+		Burst:             cfg.LLMRateBurst,
+		MaxInFlight:       cfg.LLMMaxInFlight,
+		MaxRetries:        cfg.LLMMaxRetries,
+		RetryBaseDelay:    cfg.LLMRetryBaseDelay,
+		RetryMaxDelay:     cfg.LLMRetryMaxDelay,
+		BreakerThreshold:  cfg.LLMBreakerThreshold,
+		BreakerCooldown:   cfg.LLMBreakerCooldown,
+	}, logger)
+	if cfg.EmbeddingBatchSize > 1 {
+		client = NewEmbeddingBatcher(client, cfg.EmbeddingBatchSize, cfg.EmbeddingBatchWait, logger)
+	}
+	return WithCassette(client, cfg.LLMCassette, cfg.LLMCassetteMode, cfg.LLMCassetteMatch, logger)
+}
@@ -0,0 +1,376 @@
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// StatusError is returned when a model server answers with a non-200 status
+type StatusError struct {
+	StatusCode int
+	Body       string
+	RetryAfter time.Duration // From the Retry-After header, 0 when absent
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("request failed with status %d: %s", e.StatusCode, e.Body)
+}
+
+// newStatusError builds the error for a failed response and its body
+func newStatusError(resp *http.Response, body []byte) *StatusError {
+	err := &StatusError{StatusCode: resp.StatusCode, Body: string(body)}
+	if value := resp.Header.Get("Retry-After"); value != "" {
+		if seconds, parseErr := strconv.Atoi(value); parseErr == nil && seconds > 0 {
+			err.RetryAfter = time.Duration(seconds) * time.Second
+		} else if at, parseErr := http.ParseTime(value); parseErr == nil {
+			err.RetryAfter = time.Until(at)
+		}
+	}
+	return err
+}
+
+// ErrCircuitOpen is returned when the context ends while calls are paused by an open circuit breaker
+var ErrCircuitOpen = errors.New("LLM circuit breaker is open")
+
+// ResilienceConfig configures how a ResilientClient protects the model server.
+// Zero values disable the corresponding protection.
+type ResilienceConfig struct {
+	RequestsPerSecond float64       // Sustained request rate
+	Burst             int           // Requests allowed at once above the sustained rate (at least 1)
+	MaxInFlight       int           // Requests running concurrently
+	MaxRetries        int           // Retries of rate-limited, server and network errors
+	RetryBaseDelay    time.Duration // Backoff before the first retry, doubling with every retry
+	RetryMaxDelay     time.Duration // Upper bound of the backoff
+	BreakerThreshold  int           // Consecutive failures that open the circuit breaker
+	BreakerCooldown   time.Duration // Pause before a probe request checks whether the server is back
+}
+
+// ResilientClient is a Client decorator that keeps callers from overloading the model server.
+// Requests wait for the rate limiter and a free in-flight slot; rate-limited (429), server (5xx)
+// and network errors are retried with jittered exponential backoff. Consecutive server and network
+// failures open a circuit breaker, which pauses all calls until a probe request succeeds.
+type ResilientClient struct {
+	base    Client
+	config  ResilienceConfig
+	logger  *slog.Logger
+	limiter *tokenBucket
+	slots   chan struct{}
+	breaker *circuitBreaker
+}
+
+// NewResilientClient wraps base with the protections in config
+func NewResilientClient(base Client, config ResilienceConfig, logger *slog.Logger) *ResilientClient {
+	c := &ResilientClient{
+		base:    base,
+		config:  config,
+		logger:  logger,
+		breaker: &circuitBreaker{threshold: config.BreakerThreshold, cooldown: config.BreakerCooldown, logger: logger},
+	}
+	if config.RequestsPerSecond > 0 {
+		c.limiter = newTokenBucket(config.RequestsPerSecond, config.Burst)
+	}
+	if config.MaxInFlight > 0 {
+		c.slots = make(chan struct{}, config.MaxInFlight)
+	}
+	return c
+}
+
+// GenerateCompletion generates a completion once the limits allow
+func (c *ResilientClient) GenerateCompletion(ctx context.Context, prompt string) (string, error) {
+	var response string
+	err := c.do(ctx, kindCompletion, func(ctx context.Context) (err error) {
+		response, err = c.base.GenerateCompletion(ctx, prompt)
+		return err
+	})
+	return response, err
+}
+
+// GenerateStructured generates a structured completion once the limits allow
+func (c *ResilientClient) GenerateStructured(ctx context.Context, prompt string, schema *ResponseSchema) (json.RawMessage, error) {
+	var response json.RawMessage
+	err := c.do(ctx, kindStructured, func(ctx context.Context) (err error) {
+		response, err = c.base.GenerateStructured(ctx, prompt, schema)
+		return err
+	})
+	return response, err
+}
+
+// GenerateEmbedding generates an embedding once the limits allow
+func (c *ResilientClient) GenerateEmbedding(ctx context.Context, text string) ([]float32, error) {
+	var embedding []float32
+	err := c.do(ctx, kindEmbedding, func(ctx context.Context) (err error) {
+		embedding, err = c.base.GenerateEmbedding(ctx, text)
+		return err
+	})
+	return embedding, err
+}
+
+// GenerateEmbeddings generates a batch of embeddings as one request once the limits allow
+func (c *ResilientClient) GenerateEmbeddings(ctx context.Context, texts []string) ([][]float32, error) {
+	var embeddings [][]float32
+	err := c.do(ctx, kindEmbeddings, func(ctx context.Context) (err error) {
+		embeddings, err = c.base.GenerateEmbeddings(ctx, texts)
+		return err
+	})
+	return embeddings, err
+}
+
+// Close closes the wrapped client
+func (c *ResilientClient) Close() error {
+	return c.base.Close()
+}
+
+// do runs call under the breaker, rate limit and concurrency cap, retrying transient failures
+func (c *ResilientClient) do(ctx context.Context, kind string, call func(ctx context.Context) error) error {
+	var err error
+	for attempt := 0; ; attempt++ {
+		if err = c.attempt(ctx, call); err == nil {
+			return nil
+		}
+		if ctx.Err() != nil || !retryable(err) || attempt >= c.config.MaxRetries {
+			return err
+		}
+
+		delay := c.backoff(attempt, err)
+		c.logger.Debug("Retrying LLM request",
+			"kind", kind,
+			"attempt", attempt+1,
+			"max_retries", c.config.MaxRetries,
+			"delay", delay,
+			"error", err)
+		if sleepErr := sleep(ctx, delay); sleepErr != nil {
+			return err
+		}
+	}
+}
+
+// attempt makes one call once the breaker is closed, a token is available and a slot is free
+func (c *ResilientClient) attempt(ctx context.Context, call func(ctx context.Context) error) error {
+	probe, err := c.breaker.wait(ctx)
+	if err != nil {
+		return err
+	}
+	if c.limiter != nil {
+		if err := c.limiter.wait(ctx); err != nil {
+			c.breaker.release(probe)
+			return err
+		}
+	}
+	if c.slots != nil {
+		select {
+		case c.slots <- struct{}{}:
+		case <-ctx.Done():
+			c.breaker.release(probe)
+			return ctx.Err()
+		}
+		defer func() { <-c.slots }()
+	}
+
+	err = call(ctx)
+	switch {
+	case err != nil && ctx.Err() != nil:
+		// Cancelled by the caller, which says nothing about the server
+		c.breaker.release(probe)
+	case err != nil && serverFailure(err):
+		c.breaker.failure(probe)
+	default:
+		c.breaker.success()
+	}
+	return err
+}
+
+// backoff returns the delay before retry attempt+1: a random duration up to the exponential
+// backoff ("full jitter"), or the server's Retry-After when it asks for longer
+func (c *ResilientClient) backoff(attempt int, err error) time.Duration {
+	ceiling := c.config.RetryBaseDelay << min(attempt, 30)
+	if ceiling < c.config.RetryBaseDelay || (c.config.RetryMaxDelay > 0 && ceiling > c.config.RetryMaxDelay) {
+		ceiling = c.config.RetryMaxDelay
+	}
+	var delay time.Duration
+	if ceiling > 0 {
+		delay = time.Duration(rand.Int63n(int64(ceiling) + 1))
+	}
+
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) && statusErr.RetryAfter > delay {
+		delay = statusErr.RetryAfter
+	}
+	return delay
+}
+
+// retryable reports whether a request may succeed when repeated: rate limiting, server and
+// network errors, and invalid model output. Other client errors (4xx) fail the same way again.
+func retryable(err error) bool {
+	if errors.Is(err, ErrCircuitOpen) {
+		return false
+	}
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.StatusCode == http.StatusTooManyRequests ||
+			statusErr.StatusCode == http.StatusRequestTimeout ||
+			statusErr.StatusCode >= 500
+	}
+	return true
+}
+
+// serverFailure reports whether an error means the server is down or failing, as opposed to
+// answering with an error of its own
+func serverFailure(err error) bool {
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.StatusCode >= 500
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) || errors.Is(err, context.DeadlineExceeded)
+}
+
+// sleep waits for d or until ctx is done
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// tokenBucket is a token bucket rate limiter: tokens accrue at rate per second up to burst,
+// and every request takes one
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+	return &tokenBucket{rate: rate, burst: float64(burst), tokens: float64(burst), last: time.Now()}
+}
+
+// wait takes a token, waiting until one is available or ctx is done
+func (b *tokenBucket) wait(ctx context.Context) error {
+	for {
+		b.mu.Lock()
+		now := time.Now()
+		b.tokens = min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+		b.last = now
+		if b.tokens >= 1 {
+			b.tokens--
+			b.mu.Unlock()
+			return nil
+		}
+		delay := time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+		b.mu.Unlock()
+
+		if err := sleep(ctx, delay); err != nil {
+			return err
+		}
+	}
+}
+
+// circuitBreaker counts consecutive server failures. At the threshold it opens, and callers
+// wait for the cooldown to pass; then a single probe request is let through, which closes the
+// breaker when it succeeds and reopens it when it fails.
+type circuitBreaker struct {
+	threshold int
+	cooldown  time.Duration
+	logger    *slog.Logger
+
+	mu        sync.Mutex
+	failures  int
+	openUntil time.Time
+	probing   bool
+}
+
+// breakerPoll is how often callers check whether a running probe has finished
+const breakerPoll = 100 * time.Millisecond
+
+// wait returns once a request may be made, reporting whether it is the probe
+func (b *circuitBreaker) wait(ctx context.Context) (bool, error) {
+	if b.threshold <= 0 {
+		return false, nil
+	}
+	for {
+		b.mu.Lock()
+		if b.failures < b.threshold {
+			b.mu.Unlock()
+			return false, nil
+		}
+		delay := time.Until(b.openUntil)
+		if delay <= 0 && !b.probing {
+			b.probing = true
+			b.mu.Unlock()
+			b.logger.Info("LLM circuit breaker half-open, probing the server")
+			return true, nil
+		}
+		if delay <= 0 {
+			delay = breakerPoll
+		}
+		b.mu.Unlock()
+
+		if err := sleep(ctx, delay); err != nil {
+			return false, fmt.Errorf("%w: %w", ErrCircuitOpen, err)
+		}
+	}
+}
+
+// success closes the breaker
+func (b *circuitBreaker) success() {
+	if b.threshold <= 0 {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.failures >= b.threshold {
+		b.logger.Info("LLM circuit breaker closed, resuming requests")
+	}
+	b.failures = 0
+	b.probing = false
+}
+
+// failure counts a server failure, opening the breaker at the threshold or when the probe fails
+func (b *circuitBreaker) failure(probe bool) {
+	if b.threshold <= 0 {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.failures++
+	if b.failures == b.threshold || probe {
+		b.openUntil = time.Now().Add(b.cooldown)
+		b.logger.Warn("LLM circuit breaker open, pausing requests",
+			"consecutive_failures", b.failures,
+			"cooldown", b.cooldown)
+	}
+	if probe {
+		b.probing = false
+	}
+}
+
+// release ends a request that never got an answer from the server
+func (b *circuitBreaker) release(probe bool) {
+	if !probe {
+		return
+	}
+	b.mu.Lock()
+	b.probing = false
+	b.mu.Unlock()
+}
@@ -0,0 +1,168 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// funcClient answers completions with complete
+type funcClient struct {
+	scriptedClient
+	complete func(ctx context.Context, prompt string) (string, error)
+}
+
+func (f *funcClient) GenerateCompletion(ctx context.Context, prompt string) (string, error) {
+	return f.complete(ctx, prompt)
+}
+
+func TestResilientClient_RetriesTransientErrors(t *testing.T) {
+	var requests atomic.Int32
+	server := newCompletionServer(t, "recovered", new(map[string]interface{}))
+	handler := server.Config.Handler
+	server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch requests.Add(1) {
+		case 1:
+			w.Header().Set("Retry-After", "0")
+			http.Error(w, "slow down", http.StatusTooManyRequests)
+		case 2:
+			http.Error(w, "upstream unavailable", http.StatusServiceUnavailable)
+		default:
+			handler.ServeHTTP(w, r)
+		}
+	})
+	defer server.Close()
+
+	base := NewOpenAIClient(server.URL+"/v1", "", "model", "embedder", "", testLogger())
+	base.SetMaxRetries(0)
+	client := NewResilientClient(base, ResilienceConfig{MaxRetries: 3, RetryBaseDelay: time.Millisecond}, testLogger())
+
+	response, err := client.GenerateCompletion(context.Background(), "prompt")
+	if err != nil {
+		t.Fatalf("Expected the request to succeed after retries, got %v", err)
+	}
+	if response != "recovered" || requests.Load() != 3 {
+		t.Errorf("Expected the third request to answer, got %q after %d requests", response, requests.Load())
+	}
+}
+
+func TestResilientClient_DoesNotRetryClientErrors(t *testing.T) {
+	var requests atomic.Int32
+	base := &funcClient{complete: func(ctx context.Context, prompt string) (string, error) {
+		requests.Add(1)
+		return "", &StatusError{StatusCode: http.StatusBadRequest, Body: "unknown model"}
+	}}
+	client := NewResilientClient(base, ResilienceConfig{MaxRetries: 3, RetryBaseDelay: time.Millisecond}, testLogger())
+
+	_, err := client.GenerateCompletion(context.Background(), "prompt")
+	var statusErr *StatusError
+	if !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusBadRequest {
+		t.Fatalf("Expected the 400 status error, got %v", err)
+	}
+	if requests.Load() != 1 {
+		t.Errorf("Expected no retries of a client error, got %d requests", requests.Load())
+	}
+}
+
+func TestResilientClient_CapsRequestsInFlight(t *testing.T) {
+	var inFlight, peak atomic.Int32
+	base := &funcClient{complete: func(ctx context.Context, prompt string) (string, error) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			previous := peak.Load()
+			if current <= previous || peak.CompareAndSwap(previous, current) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		return "ok", nil
+	}}
+	client := NewResilientClient(base, ResilienceConfig{MaxInFlight: 2}, testLogger())
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			client.GenerateCompletion(context.Background(), "prompt")
+		}()
+	}
+	wg.Wait()
+
+	if peak.Load() != 2 {
+		t.Errorf("Expected at most 2 requests in flight, got %d", peak.Load())
+	}
+}
+
+func TestResilientClient_RateLimits(t *testing.T) {
+	base := &funcClient{complete: func(ctx context.Context, prompt string) (string, error) {
+		return "ok", nil
+	}}
+	client := NewResilientClient(base, ResilienceConfig{RequestsPerSecond: 50, Burst: 1}, testLogger())
+
+	start := time.Now()
+	for i := 0; i < 6; i++ {
+		if _, err := client.GenerateCompletion(context.Background(), "prompt"); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+	// The first request takes the burst token, the other five wait 20ms each
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Errorf("Expected 6 requests at 50/s to take about 100ms, took %v", elapsed)
+	}
+}
+
+func TestResilientClient_CircuitBreakerPausesUntilServerRecovers(t *testing.T) {
+	var down atomic.Bool
+	var requests atomic.Int32
+	down.Store(true)
+	base := &funcClient{complete: func(ctx context.Context, prompt string) (string, error) {
+		requests.Add(1)
+		if down.Load() {
+			return "", &StatusError{StatusCode: http.StatusBadGateway, Body: "gateway down"}
+		}
+		return "ok", nil
+	}}
+	client := NewResilientClient(base, ResilienceConfig{BreakerThreshold: 2, BreakerCooldown: 50 * time.Millisecond}, testLogger())
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.GenerateCompletion(context.Background(), "prompt"); err == nil {
+			t.Fatal("Expected an error while the server is down")
+		}
+	}
+
+	// The breaker is open: calls wait instead of reaching the server
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := client.GenerateCompletion(ctx, "prompt"); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("Expected ErrCircuitOpen when the context ends during the pause, got %v", err)
+	}
+	if requests.Load() != 2 {
+		t.Fatalf("Expected no requests while the breaker is open, got %d", requests.Load())
+	}
+
+	// After the cooldown a failing probe reopens the breaker
+	time.Sleep(50 * time.Millisecond)
+	if _, err := client.GenerateCompletion(context.Background(), "prompt"); err == nil {
+		t.Fatal("Expected the probe to fail")
+	}
+
+	// A paused call resumes once the server is back and the next probe succeeds
+	down.Store(false)
+	start := time.Now()
+	response, err := client.GenerateCompletion(context.Background(), "prompt")
+	if err != nil || response != "ok" {
+		t.Fatalf("Expected the paused call to succeed, got %q, %v", response, err)
+	}
+	if elapsed := time.Since(start); elapsed < 30*time.Millisecond {
+		t.Errorf("Expected the call to wait for the cooldown, returned after %v", elapsed)
+	}
+	if requests.Load() != 4 {
+		t.Errorf("Expected 4 requests, got %d", requests.Load())
+	}
+}
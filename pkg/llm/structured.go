@@ -0,0 +1,174 @@
+package llm
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// ResponseSchema describes the JSON payload a structured completion must return.
+// Schema is a JSON Schema document (the subset understood by ValidateJSON).
+// Name and Description are used as the tool/function name and description for
+// providers that implement structured output through tool calling.
+type ResponseSchema struct {
+	Name        string
+	Description string
+	Schema      map[string]interface{}
+}
+
+// ValidateJSON checks that data is valid JSON and conforms to the given schema.
+// Supported keywords: type, properties, required, items, enum.
+func ValidateJSON(schema *ResponseSchema, data []byte) error {
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return fmt.Errorf("invalid JSON: %w", err)
+	}
+
+	if schema == nil || schema.Schema == nil {
+		return nil
+	}
+
+	return validateValue(schema.Schema, value, "$")
+}
+
+// validateValue recursively validates a decoded JSON value against a schema node
+func validateValue(schema map[string]interface{}, value interface{}, path string) error {
+	if enum, ok := schema["enum"].([]interface{}); ok {
+		matched := false
+		for _, allowed := range enum {
+			if allowed == value {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return fmt.Errorf("%s: value %v is not one of %v", path, value, enum)
+		}
+	}
+	if enum, ok := schema["enum"].([]string); ok {
+		s, isString := value.(string)
+		matched := false
+		for _, allowed := range enum {
+			if isString && allowed == s {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return fmt.Errorf("%s: value %v is not one of %v", path, value, enum)
+		}
+	}
+
+	schemaType, _ := schema["type"].(string)
+	switch schemaType {
+	case "":
+		return nil
+	case "object":
+		obj, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("%s: expected object, got %s", path, jsonTypeName(value))
+		}
+		for _, name := range requiredFields(schema) {
+			if _, present := obj[name]; !present {
+				return fmt.Errorf("%s: missing required field %q", path, name)
+			}
+		}
+		properties, _ := schema["properties"].(map[string]interface{})
+		// Validate in a stable order so errors are deterministic
+		keys := make([]string, 0, len(obj))
+		for key := range obj {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			propSchema, ok := properties[key].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if err := validateValue(propSchema, obj[key], path+"."+key); err != nil {
+				return err
+			}
+		}
+		return nil
+	case "array":
+		arr, ok := value.([]interface{})
+		if !ok {
+			return fmt.Errorf("%s: expected array, got %s", path, jsonTypeName(value))
+		}
+		itemSchema, ok := schema["items"].(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		for i, item := range arr {
+			if err := validateValue(itemSchema, item, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	case "string":
+		if _, ok := value.(string); !ok {
+			return fmt.Errorf("%s: expected string, got %s", path, jsonTypeName(value))
+		}
+		return nil
+	case "number":
+		if _, ok := value.(float64); !ok {
+			return fmt.Errorf("%s: expected number, got %s", path, jsonTypeName(value))
+		}
+		return nil
+	case "integer":
+		f, ok := value.(float64)
+		if !ok || f != float64(int64(f)) {
+			return fmt.Errorf("%s: expected integer, got %s", path, jsonTypeName(value))
+		}
+		return nil
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return fmt.Errorf("%s: expected boolean, got %s", path, jsonTypeName(value))
+		}
+		return nil
+	case "null":
+		if value != nil {
+			return fmt.Errorf("%s: expected null, got %s", path, jsonTypeName(value))
+		}
+		return nil
+	default:
+		return fmt.Errorf("%s: unsupported schema type %q", path, schemaType)
+	}
+}
+
+// requiredFields returns the required property names of an object schema
+func requiredFields(schema map[string]interface{}) []string {
+	switch required := schema["required"].(type) {
+	case []string:
+		return required
+	case []interface{}:
+		names := make([]string, 0, len(required))
+		for _, r := range required {
+			if name, ok := r.(string); ok {
+				names = append(names, name)
+			}
+		}
+		return names
+	}
+	return nil
+}
+
+// jsonTypeName returns the JSON type name of a decoded value for error messages
+func jsonTypeName(value interface{}) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case map[string]interface{}:
+		return "object"
+	case []interface{}:
+		return "array"
+	case string:
+		return "string"
+	case float64:
+		return "number"
+	case bool:
+		return "boolean"
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
@@ -0,0 +1,228 @@
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testSchema() *ResponseSchema {
+	return &ResponseSchema{
+		Name:        "record_people",
+		Description: "Record people",
+		Schema: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"kind": map[string]interface{}{
+					"type": "string",
+					"enum": []interface{}{"person", "organization"},
+				},
+				"people": map[string]interface{}{
+					"type": "array",
+					"items": map[string]interface{}{
+						"type": "object",
+						"properties": map[string]interface{}{
+							"name":       map[string]interface{}{"type": "string"},
+							"confidence": map[string]interface{}{"type": "number"},
+						},
+						"required": []string{"name"},
+					},
+				},
+			},
+			"required": []string{"kind", "people"},
+		},
+	}
+}
+
+func TestValidateJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr string
+	}{
+		{
+			name:  "valid payload",
+			input: `{"kind": "person", "people": [{"name": "Ken Lay", "confidence": 0.9}]}`,
+		},
+		{
+			name:    "malformed JSON",
+			input:   `{"kind": "person", "people": [`,
+			wantErr: "invalid JSON",
+		},
+		{
+			name:    "missing required field",
+			input:   `{"kind": "person"}`,
+			wantErr: `missing required field "people"`,
+		},
+		{
+			name:    "wrong nested type",
+			input:   `{"kind": "person", "people": [{"name": "Ken Lay", "confidence": "high"}]}`,
+			wantErr: "$.people[0].confidence: expected number",
+		},
+		{
+			name:    "value outside enum",
+			input:   `{"kind": "concept", "people": []}`,
+			wantErr: "$.kind: value concept is not one of",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateJSON(testSchema(), []byte(tt.input))
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Expected no error, got %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("Expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestOllamaClient_GenerateStructured_SendsFormat(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var reqBody map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+			t.Fatalf("Failed to decode request body: %v", err)
+		}
+
+		format, ok := reqBody["format"].(map[string]interface{})
+		if !ok || format["type"] != "object" {
+			t.Errorf("Expected JSON schema in format field, got %v", reqBody["format"])
+		}
+
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"response": `{"kind": "person", "people": [{"name": "Ken Lay"}]}`,
+			"done":     true,
+		})
+	}))
+	defer server.Close()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelError}))
+	client := NewOllamaClient(server.URL, "test-model", "test-embed-model", logger)
+
+	result, err := client.GenerateStructured(context.Background(), "Test prompt", testSchema())
+	if err != nil {
+		t.Fatalf("GenerateStructured failed: %v", err)
+	}
+
+	if !strings.Contains(string(result), "Ken Lay") {
+		t.Errorf("Expected payload to contain Ken Lay, got %s", result)
+	}
+}
+
+func TestOllamaClient_GenerateStructured_RetriesInvalidPayload(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		response := `{"kind": "person"}`
+		if attempts > 1 {
+			response = `{"kind": "person", "people": []}`
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"response": response,
+			"done":     true,
+		})
+	}))
+	defer server.Close()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelError}))
+	client := NewOllamaClient(server.URL, "test-model", "test-embed-model", logger)
+	client.retryDelay = 10 * time.Millisecond
+
+	if _, err := client.GenerateStructured(context.Background(), "Test prompt", testSchema()); err != nil {
+		t.Fatalf("GenerateStructured failed: %v", err)
+	}
+
+	if attempts != 2 {
+		t.Errorf("Expected 2 attempts, got %d", attempts)
+	}
+}
+
+func TestLiteLLMClient_GenerateStructured_ForcesToolCall(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var reqBody map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+			t.Fatalf("Failed to decode request body: %v", err)
+		}
+
+		tools, ok := reqBody["tools"].([]interface{})
+		if !ok || len(tools) != 1 {
+			t.Fatalf("Expected exactly one tool, got %v", reqBody["tools"])
+		}
+		choice, _ := reqBody["tool_choice"].(map[string]interface{})
+		function, _ := choice["function"].(map[string]interface{})
+		if function["name"] != "record_people" {
+			t.Errorf("Expected tool_choice to force record_people, got %v", reqBody["tool_choice"])
+		}
+
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"choices": []map[string]interface{}{
+				{
+					"message": map[string]interface{}{
+						"content": nil,
+						"tool_calls": []map[string]interface{}{
+							{
+								"type": "function",
+								"function": map[string]string{
+									"name":      "record_people",
+									"arguments": `{"kind": "organization", "people": []}`,
+								},
+							},
+						},
+					},
+					"finish_reason": "tool_calls",
+				},
+			},
+		})
+	}))
+	defer server.Close()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelError}))
+	client := NewLiteLLMClient(server.URL, "test-model", "test-embed-model", "", logger)
+
+	result, err := client.GenerateStructured(context.Background(), "Test prompt", testSchema())
+	if err != nil {
+		t.Fatalf("GenerateStructured failed: %v", err)
+	}
+
+	if string(result) != `{"kind": "organization", "people": []}` {
+		t.Errorf("Unexpected payload: %s", result)
+	}
+}
+
+func TestLiteLLMClient_GenerateStructured_NoToolCall(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"choices": []map[string]interface{}{
+				{
+					"message":       map[string]string{"content": "Sure! Here is the JSON..."},
+					"finish_reason": "stop",
+				},
+			},
+		})
+	}))
+	defer server.Close()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelError}))
+	client := NewLiteLLMClient(server.URL, "test-model", "test-embed-model", "", logger)
+	client.maxRetries = 1
+	client.retryDelay = 10 * time.Millisecond
+
+	_, err := client.GenerateStructured(context.Background(), "Test prompt", testSchema())
+	if err == nil {
+		t.Fatal("Expected error when model does not call the tool")
+	}
+	if !strings.Contains(err.Error(), "did not call tool") {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
@@ -3,6 +3,8 @@ package utils
 import (
 	"fmt"
 	"os"
+	"strconv"
+	"time"
 )
 
 type Config struct {
@@ -15,11 +17,44 @@ type Config struct {
 	DatabaseURL string
 	OllamaURL   string
 	// LLM Provider settings
-	LLMProvider     string // "ollama" (default) or "litellm"
+	LLMProvider     string // "ollama" (default), "litellm", or an OpenAI-compatible server: "openai", "vllm", "llamacpp", "lmstudio"
 	LiteLLMURL      string
 	LiteLLMAPIKey   string
 	CompletionModel string
 	EmbeddingModel  string
+	// OpenAI-compatible server settings; empty URLs use the provider's default port
+	OpenAIBaseURL      string // Completion base URL including the version prefix, e.g. http://localhost:8000/v1
+	OpenAIEmbeddingURL string // Embedding base URL, when embeddings are served separately
+	OpenAIAPIKey       string
+	// LLM cassette settings, for recording LLM traffic and replaying it without a model server
+	LLMCassette      string // Cassette file ("" disables recording and replay)
+	LLMCassetteMode  string // "replay" (default) or "record"
+	LLMCassetteMatch string // "strict" (default) or "fuzzy"
+	// LLM client protection settings; 0 disables a limit
+	LLMRateLimit         float64       // Requests per second to the model server
+	LLMRateBurst         int           // Requests allowed at once above the rate limit
+	LLMMaxInFlight       int           // Concurrent requests to the model server
+	LLMMaxRetries        int           // Retries of rate-limited (429), server (5xx) and network errors
+	LLMRetryBaseDelay    time.Duration // First retry backoff, doubled per retry and jittered
+	LLMRetryMaxDelay     time.Duration // Longest retry backoff
+	LLMCompletionTimeout time.Duration // Timeout of a completion or embedding batch request
+	LLMEmbeddingTimeout  time.Duration // Timeout of a single embedding request
+	LLMBreakerThreshold  int           // Consecutive failures that pause all LLM calls
+	LLMBreakerCooldown   time.Duration // Pause before checking whether the model server is back
+	// Embedding batching settings
+	EmbeddingBatchSize int           // Most texts per batched embedding request (1 disables batching)
+	EmbeddingBatchWait time.Duration // Longest a text waits for its batch to fill
+	// Extraction cache settings
+	ExtractionCacheDir string // Directory of cached LLM extraction responses ("" disables the cache)
+	// Prompt template settings
+	PromptDir      string // Directory of prompt templates adding to or overriding the built-in ones
+	PromptVersions string // Pinned prompt versions, e.g. "extraction=v3,chat_system=v1"
+	// Vector search settings
+	EmbeddingDimensions int    // Dimensions of the native pgvector column (must match the embedding model)
+	VectorMetric        string // "cosine" (default), "l2" or "ip"
+	VectorIndex         string // "hnsw" (default) or "ivfflat"
+	// Chat settings
+	ChatMaxSteps int // Maximum graph tool calls the chat planner makes per query
 }
 
 func LoadConfig() (*Config, error) {
@@ -37,6 +72,39 @@ func LoadConfig() (*Config, error) {
 		LiteLLMAPIKey:   getEnv("LITELLM_API_KEY", ""),
 		CompletionModel: getEnv("LLM_COMPLETION_MODEL", "llama3.1:8b"),
 		EmbeddingModel:  getEnv("LLM_EMBEDDING_MODEL", "mxbai-embed-large"),
+		// OpenAI-compatible server configuration
+		OpenAIBaseURL:      getEnv("OPENAI_BASE_URL", ""),
+		OpenAIEmbeddingURL: getEnv("OPENAI_EMBEDDING_URL", ""),
+		OpenAIAPIKey:       getEnv("OPENAI_API_KEY", ""),
+		// LLM cassette configuration
+		LLMCassette:      getEnv("LLM_CASSETTE", ""),
+		LLMCassetteMode:  getEnv("LLM_CASSETTE_MODE", "replay"),
+		LLMCassetteMatch: getEnv("LLM_CASSETTE_MATCH", "strict"),
+		// LLM client protection configuration
+		LLMRateLimit:         getEnvFloat("LLM_RATE_LIMIT", 0),
+		LLMRateBurst:         getEnvInt("LLM_RATE_BURST", 1),
+		LLMMaxInFlight:       getEnvInt("LLM_MAX_IN_FLIGHT", 8),
+		LLMMaxRetries:        getEnvInt("LLM_MAX_RETRIES", 3),
+		LLMRetryBaseDelay:    getEnvDuration("LLM_RETRY_BASE_DELAY", 500*time.Millisecond),
+		LLMRetryMaxDelay:     getEnvDuration("LLM_RETRY_MAX_DELAY", 30*time.Second),
+		LLMCompletionTimeout: getEnvDuration("LLM_COMPLETION_TIMEOUT", 30*time.Second),
+		LLMEmbeddingTimeout:  getEnvDuration("LLM_EMBEDDING_TIMEOUT", 10*time.Second),
+		LLMBreakerThreshold:  getEnvInt("LLM_BREAKER_THRESHOLD", 5),
+		LLMBreakerCooldown:   getEnvDuration("LLM_BREAKER_COOLDOWN", 30*time.Second),
+		// Embedding batching configuration
+		EmbeddingBatchSize: getEnvInt("EMBEDDING_BATCH_SIZE", 32),
+		EmbeddingBatchWait: getEnvDuration("EMBEDDING_BATCH_WAIT", 10*time.Millisecond),
+		// Extraction cache configuration
+		ExtractionCacheDir: getEnv("EXTRACTION_CACHE_DIR", ""),
+		// Prompt template configuration
+		PromptDir:      getEnv("PROMPT_DIR", ""),
+		PromptVersions: getEnv("PROMPT_VERSIONS", ""),
+		// Vector search configuration
+		EmbeddingDimensions: getEnvInt("EMBEDDING_DIMENSIONS", 1024),
+		VectorMetric:        getEnv("VECTOR_METRIC", "cosine"),
+		VectorIndex:         getEnv("VECTOR_INDEX", "hnsw"),
+		// Chat configuration
+		ChatMaxSteps: getEnvInt("CHAT_MAX_STEPS", 5),
 	}
 
 	// Build DatabaseURL
@@ -65,3 +133,31 @@ func getEnv(key, defaultValue string) string {
 	}
 	return defaultValue
 }
+
+func getEnvInt(key string, defaultValue int) int {
+	if value := os.Getenv(key); value != "" {
+		if parsed, err := strconv.Atoi(value); err == nil {
+			return parsed
+		}
+	}
+	return defaultValue
+}
+
+func getEnvFloat(key string, defaultValue float64) float64 {
+	if value := os.Getenv(key); value != "" {
+		if parsed, err := strconv.ParseFloat(value, 64); err == nil {
+			return parsed
+		}
+	}
+	return defaultValue
+}
+
+// getEnvDuration parses durations such as "500ms" or "30s"
+func getEnvDuration(key string, defaultValue time.Duration) time.Duration {
+	if value := os.Getenv(key); value != "" {
+		if parsed, err := time.ParseDuration(value); err == nil {
+			return parsed
+		}
+	}
+	return defaultValue
+}
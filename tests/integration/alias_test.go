@@ -0,0 +1,89 @@
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Blogem/enron-graph/internal/graph"
+	"github.com/Blogem/enron-graph/pkg/utils"
+)
+
+// TestAliasAwareLookup tests resolving secondary addresses, name variants and merged-away IDs
+func TestAliasAwareLookup(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	ctx := context.Background()
+	client, db := SetupTestDBWithSQL(t)
+	repo := graph.NewRepositoryWithDB(client, db, utils.NewLogger())
+
+	skilling, err := repo.CreateDiscoveredEntity(ctx, &graph.EntityInput{
+		UniqueID: "jeff.skilling@enron.com", TypeCategory: "person", Name: "Jeff Skilling", ConfidenceScore: 1.0,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create entity: %v", err)
+	}
+
+	for _, alias := range []string{"jskilling@enron.com", "Jeffrey K. Skilling", "JSKILLING@ENRON.COM"} {
+		if _, err := repo.RecordAlias(ctx, &graph.AliasInput{EntityID: skilling.ID, Alias: alias, Source: "header"}); err != nil {
+			t.Fatalf("RecordAlias failed: %v", err)
+		}
+	}
+	aliases, err := repo.FindAliases(ctx, skilling.ID)
+	if err != nil {
+		t.Fatalf("FindAliases failed: %v", err)
+	}
+	if len(aliases) != 2 {
+		t.Errorf("Expected aliases differing only in case to be recorded once, got %d", len(aliases))
+	}
+
+	found, err := repo.FindEntityByUniqueID(ctx, "jskilling@enron.com")
+	if err != nil || found.ID != skilling.ID {
+		t.Errorf("Expected secondary address to resolve to %d, got %v (%v)", skilling.ID, found, err)
+	}
+	found, err = repo.FindEntityByUniqueID(ctx, "person:jeffrey k. skilling")
+	if err != nil || found.ID != skilling.ID {
+		t.Errorf("Expected generated person ID to resolve through the name alias, got %v (%v)", found, err)
+	}
+
+	// Names shared by several people stay unresolved
+	other, err := repo.CreateDiscoveredEntity(ctx, &graph.EntityInput{
+		UniqueID: "jeff.dasovich@enron.com", TypeCategory: "person", Name: "Jeff Dasovich", ConfidenceScore: 1.0,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create entity: %v", err)
+	}
+	for _, id := range []int{skilling.ID, other.ID} {
+		if _, err := repo.RecordAlias(ctx, &graph.AliasInput{EntityID: id, Alias: "Jeff", Source: "signature"}); err != nil {
+			t.Fatalf("RecordAlias failed: %v", err)
+		}
+	}
+	if _, err := repo.FindEntityByUniqueID(ctx, "person:jeff"); err == nil {
+		t.Error("Expected an ambiguous alias not to resolve")
+	}
+	matches, err := repo.FindEntitiesByAlias(ctx, "jeff", "person")
+	if err != nil || len(matches) != 2 {
+		t.Errorf("Expected both people to match the shared alias, got %d (%v)", len(matches), err)
+	}
+
+	// Merged-away IDs and their aliases resolve to the survivor
+	duplicate, err := repo.CreateDiscoveredEntity(ctx, &graph.EntityInput{
+		UniqueID: "person:jeff skilling", TypeCategory: "person", Name: "Skilling", ConfidenceScore: 0.8,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create entity: %v", err)
+	}
+	if _, err := repo.RecordAlias(ctx, &graph.AliasInput{EntityID: duplicate.ID, Alias: "jeff@enron.com", Source: "content"}); err != nil {
+		t.Fatalf("RecordAlias failed: %v", err)
+	}
+	if _, err := repo.MergeEntities(ctx, skilling.ID, []int{duplicate.ID}, ""); err != nil {
+		t.Fatalf("MergeEntities failed: %v", err)
+	}
+	for _, id := range []string{"person:jeff skilling", "jeff@enron.com"} {
+		found, err = repo.FindEntityByUniqueID(ctx, id)
+		if err != nil || found.ID != skilling.ID {
+			t.Errorf("Expected %q to resolve to the survivor, got %v (%v)", id, found, err)
+		}
+	}
+}
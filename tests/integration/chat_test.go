@@ -2,6 +2,7 @@ package integration
 
 import (
 	"context"
+	"encoding/json"
 	"log/slog"
 	"os"
 	"testing"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/Blogem/enron-graph/internal/chat"
 	"github.com/Blogem/enron-graph/internal/graph"
+	"github.com/Blogem/enron-graph/pkg/llm"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -117,7 +119,7 @@ func TestChatIntegration_EntityLookup(t *testing.T) {
 
 	t.Run("PathFinding_JeffSkillingToKennethLay", func(t *testing.T) {
 		// Ensure relationship exists from previous test
-		relationships, err := repo.FindRelationshipsByEntity(ctx, "discovered_entity", jeffSkilling.ID)
+		relationships, err := repo.FindRelationshipsByEntity(ctx, "discovered_entity", jeffSkilling.ID, graph.TimeWindow{})
 		require.NoError(t, err, "Failed to find relationships")
 
 		if len(relationships) == 0 {
@@ -171,6 +173,14 @@ func (m *mockLLMClient) GenerateCompletion(ctx context.Context, prompt string) (
 	return `{"action": "unknown", "message": "I don't understand that query."}`, nil
 }
 
+func (m *mockLLMClient) GenerateStructured(ctx context.Context, prompt string, schema *llm.ResponseSchema) (json.RawMessage, error) {
+	response, err := m.GenerateCompletion(ctx, prompt)
+	if err != nil {
+		return nil, err
+	}
+	return json.RawMessage(response), nil
+}
+
 func (m *mockLLMClient) GenerateEmbedding(ctx context.Context, text string) ([]float32, error) {
 	// Return zero embedding for testing
 	return make([]float32, 768), nil
@@ -238,7 +248,7 @@ func (a *chatRepositoryAdapter) FindEntityByName(name string) (*chat.Entity, err
 
 func (a *chatRepositoryAdapter) TraverseRelationships(entityID int, relType string) ([]*chat.Entity, error) {
 	ctx := context.Background()
-	entities, err := a.repo.TraverseRelationships(ctx, entityID, relType, 1)
+	entities, err := a.repo.TraverseRelationships(ctx, entityID, relType, 1, graph.TimeWindow{})
 	if err != nil {
 		return nil, err
 	}
@@ -258,7 +268,7 @@ func (a *chatRepositoryAdapter) TraverseRelationships(entityID int, relType stri
 
 func (a *chatRepositoryAdapter) FindShortestPath(sourceID, targetID int) ([]*chat.PathNode, error) {
 	ctx := context.Background()
-	relationships, err := a.repo.FindShortestPath(ctx, sourceID, targetID)
+	relationships, err := a.repo.FindShortestPath(ctx, sourceID, targetID, graph.TimeWindow{})
 	if err != nil {
 		return nil, err
 	}
@@ -326,7 +336,7 @@ func (a *chatRepositoryAdapter) SimilaritySearch(embedding []float32, limit int)
 
 func (a *chatRepositoryAdapter) CountRelationships(entityID int, relType string) (int, error) {
 	ctx := context.Background()
-	relationships, err := a.repo.FindRelationshipsByEntity(ctx, "discovered_entity", entityID)
+	relationships, err := a.repo.FindRelationshipsByEntity(ctx, "discovered_entity", entityID, graph.TimeWindow{})
 	if err != nil {
 		return 0, err
 	}
@@ -0,0 +1,113 @@
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Blogem/enron-graph/ent/discoveredentity"
+	"github.com/Blogem/enron-graph/internal/graph"
+	"github.com/Blogem/enron-graph/pkg/utils"
+)
+
+// TestMergeAndUndoEntities tests merging duplicate people and splitting a bad merge back out
+func TestMergeAndUndoEntities(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	ctx := context.Background()
+	client, db := SetupTestDBWithSQL(t)
+	repo := graph.NewRepositoryWithDB(client, db, utils.NewLogger())
+
+	skilling, err := repo.CreateDiscoveredEntity(ctx, &graph.EntityInput{
+		UniqueID: "jeff.skilling@enron.com", TypeCategory: "person", Name: "Jeff Skilling",
+		Properties: map[string]interface{}{"title": "CEO"}, ConfidenceScore: 0.9,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create entity: %v", err)
+	}
+	jeffrey, err := repo.CreateDiscoveredEntity(ctx, &graph.EntityInput{
+		UniqueID: "person:jeffrey skilling", TypeCategory: "person", Name: "Jeffrey Skilling",
+		Properties: map[string]interface{}{"title": "President", "location": "Houston"}, ConfidenceScore: 0.95,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create entity: %v", err)
+	}
+	enron, err := repo.CreateDiscoveredEntity(ctx, &graph.EntityInput{
+		UniqueID: "enron.com", TypeCategory: "organization", Name: "Enron", ConfidenceScore: 0.9,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create entity: %v", err)
+	}
+
+	worksAt, err := repo.CreateRelationship(ctx, &graph.RelationshipInput{
+		Type: "WORKS_AT", FromType: "discovered_entity", FromID: jeffrey.ID,
+		ToType: "discovered_entity", ToID: enron.ID, Timestamp: time.Now(), ConfidenceScore: 0.8,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create relationship: %v", err)
+	}
+	if _, err := repo.CreateRelationship(ctx, &graph.RelationshipInput{
+		Type: "COMMUNICATES_WITH", FromType: "discovered_entity", FromID: skilling.ID,
+		ToType: "discovered_entity", ToID: jeffrey.ID, Timestamp: time.Now(), ConfidenceScore: 0.8,
+	}); err != nil {
+		t.Fatalf("Failed to create relationship: %v", err)
+	}
+
+	result, err := repo.MergeEntities(ctx, skilling.ID, []int{jeffrey.ID}, "same person")
+	if err != nil {
+		t.Fatalf("MergeEntities failed: %v", err)
+	}
+
+	if result.RelationshipsMoved != 1 || result.RelationshipsRemoved != 1 {
+		t.Errorf("Expected 1 moved and 1 removed relationship, got %d and %d", result.RelationshipsMoved, result.RelationshipsRemoved)
+	}
+	if result.Survivor.Properties["title"] != "CEO" || result.Survivor.Properties["location"] != "Houston" {
+		t.Errorf("Expected survivor properties to win and be unioned, got %v", result.Survivor.Properties)
+	}
+	if aliases := graph.Aliases(result.Survivor); len(aliases) != 2 {
+		t.Errorf("Expected the merged name and ID as aliases, got %v", aliases)
+	}
+	if result.Survivor.ConfidenceScore != 0.95 {
+		t.Errorf("Expected the highest confidence to be kept, got %f", result.Survivor.ConfidenceScore)
+	}
+	if exists, _ := client.DiscoveredEntity.Query().Where(discoveredentity.IDEQ(jeffrey.ID)).Exist(ctx); exists {
+		t.Error("Expected merged entity to be deleted")
+	}
+	rel, err := client.Relationship.Get(ctx, worksAt.ID)
+	if err != nil {
+		t.Fatalf("Failed to load relationship: %v", err)
+	}
+	if rel.FromID != skilling.ID {
+		t.Errorf("Expected relationship to be rewired to %d, got %d", skilling.ID, rel.FromID)
+	}
+
+	// Undo the merge from its audit entry
+	audits, err := repo.FindEntityAudits(ctx, skilling.ID)
+	if err != nil || len(audits) != 1 {
+		t.Fatalf("Expected one audit entry, got %d (%v)", len(audits), err)
+	}
+	input, err := graph.SplitInputFromMerge(audits[0], jeffrey.ID)
+	if err != nil {
+		t.Fatalf("SplitInputFromMerge failed: %v", err)
+	}
+	split, err := repo.SplitEntity(ctx, skilling.ID, input)
+	if err != nil {
+		t.Fatalf("SplitEntity failed: %v", err)
+	}
+
+	if split.Created.UniqueID != "person:jeffrey skilling" || split.Created.Properties["location"] != "Houston" {
+		t.Errorf("Expected the merged entity to be restored, got %+v", split.Created)
+	}
+	if len(graph.Aliases(split.Source)) != 0 {
+		t.Errorf("Expected restored aliases to be removed from the source, got %v", graph.Aliases(split.Source))
+	}
+	rel, err = client.Relationship.Get(ctx, worksAt.ID)
+	if err != nil {
+		t.Fatalf("Failed to load relationship: %v", err)
+	}
+	if rel.FromID != split.Created.ID {
+		t.Errorf("Expected relationship to move to the restored entity, got %d", rel.FromID)
+	}
+}
@@ -0,0 +1,186 @@
+package integration
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Blogem/enron-graph/internal/graph"
+	"github.com/Blogem/enron-graph/pkg/utils"
+)
+
+// TestExtractionJobQueue tests enqueueing, concurrent claiming, retries and crash recovery
+func TestExtractionJobQueue(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	ctx := context.Background()
+	client, db := SetupTestDBWithSQL(t)
+	repo := graph.NewRepositoryWithDB(client, db, utils.NewLogger())
+
+	var emailIDs []int
+	for i := 0; i < 20; i++ {
+		email, err := repo.CreateEmail(ctx, &graph.EmailInput{
+			MessageID: fmt.Sprintf("queue-%d@enron.com", i),
+			From:      "kenneth.lay@enron.com",
+			Date:      time.Now(),
+		})
+		if err != nil {
+			t.Fatalf("Failed to create email: %v", err)
+		}
+		emailIDs = append(emailIDs, email.ID)
+	}
+
+	queued, err := repo.EnqueueExtractionJobs(ctx, emailIDs, "llama3", "")
+	if err != nil || queued != 20 {
+		t.Fatalf("Expected 20 queued jobs, got %d (%v)", queued, err)
+	}
+	if again, _ := repo.EnqueueExtractionJobs(ctx, emailIDs, "llama3", ""); again != 0 {
+		t.Errorf("Expected re-enqueue to skip existing jobs, got %d", again)
+	}
+
+	// Concurrent workers never receive the same job
+	var mu sync.Mutex
+	claimed := map[int]string{}
+	var wg sync.WaitGroup
+	for w := 0; w < 4; w++ {
+		wg.Add(1)
+		go func(worker string) {
+			defer wg.Done()
+			for {
+				jobs, err := repo.ClaimExtractionJobs(ctx, worker, "", 3)
+				if err != nil {
+					t.Errorf("ClaimExtractionJobs failed: %v", err)
+					return
+				}
+				if len(jobs) == 0 {
+					return
+				}
+				mu.Lock()
+				for _, job := range jobs {
+					if previous, ok := claimed[job.ID]; ok {
+						t.Errorf("Job %d claimed by both %s and %s", job.ID, previous, worker)
+					}
+					claimed[job.ID] = worker
+				}
+				mu.Unlock()
+			}
+		}(fmt.Sprintf("worker-%d", w))
+	}
+	wg.Wait()
+
+	if len(claimed) != 20 {
+		t.Fatalf("Expected all 20 jobs to be claimed, got %d", len(claimed))
+	}
+
+	// Complete one, fail one for good and leave the rest running as if the process crashed
+	var ids []int
+	for id := range claimed {
+		ids = append(ids, id)
+	}
+	if err := repo.CompleteExtractionJob(ctx, ids[0], "llama3", "v2"); err != nil {
+		t.Fatalf("CompleteExtractionJob failed: %v", err)
+	}
+	failed, err := repo.FailExtractionJob(ctx, ids[1], "ollama unavailable", 1)
+	if err != nil {
+		t.Fatalf("FailExtractionJob failed: %v", err)
+	}
+	if failed.Status != graph.JobFailed || failed.LastError != "ollama unavailable" {
+		t.Errorf("Expected failed job with error, got %s (%s)", failed.Status, failed.LastError)
+	}
+
+	released, err := repo.ReleaseStaleExtractionJobs(ctx, time.Now().Add(time.Minute))
+	if err != nil {
+		t.Fatalf("ReleaseStaleExtractionJobs failed: %v", err)
+	}
+	if released != 18 {
+		t.Errorf("Expected 18 released jobs, got %d", released)
+	}
+
+	counts, err := repo.CountExtractionJobs(ctx)
+	if err != nil {
+		t.Fatalf("CountExtractionJobs failed: %v", err)
+	}
+	if counts[graph.JobDone] != 1 || counts[graph.JobFailed] != 1 || counts[graph.JobPending] != 18 {
+		t.Errorf("Unexpected job counts: %v", counts)
+	}
+
+	// Released jobs keep their attempt count when claimed again
+	jobs, err := repo.ClaimExtractionJobs(ctx, "restarted", "", 1)
+	if err != nil || len(jobs) != 1 {
+		t.Fatalf("Expected to reclaim a job, got %d (%v)", len(jobs), err)
+	}
+	if jobs[0].Attempts != 2 || jobs[0].Worker != "restarted" {
+		t.Errorf("Expected second attempt by restarted worker, got %d by %s", jobs[0].Attempts, jobs[0].Worker)
+	}
+}
+
+// TestSelectAndClearEmailExtraction tests selecting stored emails and clearing an email's results
+func TestSelectAndClearEmailExtraction(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	ctx := context.Background()
+	client, db := SetupTestDBWithSQL(t)
+	repo := graph.NewRepositoryWithDB(client, db, utils.NewLogger())
+	date := time.Date(2001, 5, 14, 9, 0, 0, 0, time.UTC)
+
+	first, _ := repo.CreateEmail(ctx, &graph.EmailInput{MessageID: "select-1@enron.com", From: "kenneth.lay@enron.com", Date: date})
+	second, _ := repo.CreateEmail(ctx, &graph.EmailInput{MessageID: "select-2@enron.com", From: "Jeff.Skilling@enron.com", Date: date.AddDate(0, 1, 0)})
+	if first == nil || second == nil {
+		t.Fatal("Failed to create emails")
+	}
+
+	ids, err := repo.FindEmailIDs(ctx, &graph.EmailFilter{Senders: []string{"jeff.skilling@enron.com"}})
+	if err != nil || len(ids) != 1 || ids[0] != second.ID {
+		t.Errorf("Expected sender filter to select email %d, got %v (%v)", second.ID, ids, err)
+	}
+	ids, _ = repo.FindEmailIDs(ctx, &graph.EmailFilter{Since: date.AddDate(0, 0, 1)})
+	if len(ids) != 1 || ids[0] != second.ID {
+		t.Errorf("Expected date filter to select email %d, got %v", second.ID, ids)
+	}
+
+	// Extract the first email: an entity only it mentions and one shared with another email
+	own, _ := repo.CreateDiscoveredEntity(ctx, &graph.EntityInput{UniqueID: "org:raptor", TypeCategory: "organization", Name: "Raptor"})
+	shared, _ := repo.CreateDiscoveredEntity(ctx, &graph.EntityInput{UniqueID: "kenneth.lay@enron.com", TypeCategory: "person", Name: "Kenneth Lay"})
+	rel, _ := repo.CreateRelationship(ctx, &graph.RelationshipInput{Type: "MENTIONS", FromType: "email", FromID: first.ID, ToType: "discovered_entity", ToID: own.ID, Timestamp: date})
+	for _, input := range []*graph.ProvenanceInput{
+		{SubjectType: graph.SubjectEntity, SubjectID: own.ID, EmailID: first.ID, Source: "content"},
+		{SubjectType: graph.SubjectEntity, SubjectID: shared.ID, EmailID: first.ID, Source: "header"},
+		{SubjectType: graph.SubjectEntity, SubjectID: shared.ID, EmailID: second.ID, Source: "header"},
+		{SubjectType: graph.SubjectRelationship, SubjectID: rel.ID, EmailID: first.ID, Source: "content"},
+	} {
+		if _, err := repo.RecordProvenance(ctx, input); err != nil {
+			t.Fatalf("RecordProvenance failed: %v", err)
+		}
+	}
+
+	ids, _ = repo.FindEmailIDs(ctx, &graph.EmailFilter{NeverExtracted: true})
+	if len(ids) != 0 {
+		t.Errorf("Expected both emails to count as extracted through provenance, got %v", ids)
+	}
+	ids, _ = repo.FindEmailIDs(ctx, &graph.EmailFilter{OutdatedModel: "llama3.1", OutdatedPromptVersion: "v2"})
+	if len(ids) != 2 {
+		t.Errorf("Expected emails extracted before jobs existed to be outdated, got %v", ids)
+	}
+
+	result, err := repo.ClearEmailExtraction(ctx, first.ID)
+	if err != nil {
+		t.Fatalf("ClearEmailExtraction failed: %v", err)
+	}
+	if result.ProvenanceRemoved != 3 || result.RelationshipsRemoved != 1 || result.EntitiesRemoved != 1 {
+		t.Errorf("Unexpected clear result: %+v", result)
+	}
+	if _, err := repo.FindEntityByID(ctx, shared.ID); err != nil {
+		t.Errorf("Expected entity supported by another email to remain: %v", err)
+	}
+
+	ids, _ = repo.FindEmailIDs(ctx, &graph.EmailFilter{NeverExtracted: true})
+	if len(ids) != 1 || ids[0] != first.ID {
+		t.Errorf("Expected the cleared email to be never extracted, got %v", ids)
+	}
+}
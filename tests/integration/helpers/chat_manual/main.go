@@ -38,7 +38,7 @@ func (a *chatRepoAdapter) FindEntityByName(name string) (*chat.Entity, error) {
 }
 
 func (a *chatRepoAdapter) TraverseRelationships(entityID int, relType string) ([]*chat.Entity, error) {
-	entities, err := a.repo.TraverseRelationships(a.ctx, entityID, relType, 1)
+	entities, err := a.repo.TraverseRelationships(a.ctx, entityID, relType, 1, graph.TimeWindow{})
 	if err != nil {
 		return nil, err
 	}
@@ -50,7 +50,7 @@ func (a *chatRepoAdapter) TraverseRelationships(entityID int, relType string) ([
 }
 
 func (a *chatRepoAdapter) FindShortestPath(sourceID, targetID int) ([]*chat.PathNode, error) {
-	rels, err := a.repo.FindShortestPath(a.ctx, sourceID, targetID)
+	rels, err := a.repo.FindShortestPath(a.ctx, sourceID, targetID, graph.TimeWindow{})
 	if err != nil {
 		return nil, err
 	}
@@ -87,7 +87,7 @@ func (a *chatRepoAdapter) SimilaritySearch(embedding []float32, limit int) ([]*c
 }
 
 func (a *chatRepoAdapter) CountRelationships(entityID int, relType string) (int, error) {
-	rels, err := a.repo.FindRelationshipsByEntity(a.ctx, "discovered_entity", entityID)
+	rels, err := a.repo.FindRelationshipsByEntity(a.ctx, "discovered_entity", entityID, graph.TimeWindow{})
 	if err != nil {
 		return 0, err
 	}
@@ -114,7 +114,13 @@ func main() {
 	logger := utils.NewLogger()
 	repo := graph.NewRepository(client, logger)
 	chatRepo := newChatRepo(repo)
-	llmClient := llm.NewOllamaClient(cfg.OllamaURL, "llama3.1:8b", "mxbai-embed-large", logger)
+	// LLM_CASSETTE replays a recorded session without Ollama
+	llmClient, err := llm.WithCassette(llm.NewOllamaClient(cfg.OllamaURL, "llama3.1:8b", "mxbai-embed-large", logger),
+		cfg.LLMCassette, cfg.LLMCassetteMode, cfg.LLMCassetteMatch, logger)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer llmClient.Close()
 
 	handler := chat.NewHandler(llmClient, chatRepo)
 	chatContext := chat.NewContext()
@@ -10,6 +10,8 @@ import (
 
 	"github.com/Blogem/enron-graph/ent"
 	"github.com/Blogem/enron-graph/ent/enttest"
+	"github.com/Blogem/enron-graph/internal/graph"
+	"github.com/Blogem/enron-graph/pkg/utils"
 	_ "github.com/lib/pq"
 )
 
@@ -157,6 +159,13 @@ func SetupTestDBWithSQL(t *testing.T) (*ent.Client, *sql.DB) {
 	// Create ent client with schema migration
 	client := enttest.Open(t, "postgres", testDSN)
 
+	// Add the native pgvector column used by similarity search
+	if err := graph.MigrateVectorColumn(context.Background(), testDB, graph.DefaultVectorConfig(), utils.NewLogger()); err != nil {
+		client.Close()
+		testDB.Close()
+		t.Fatalf("Failed to migrate embedding vectors: %v", err)
+	}
+
 	// Register cleanup function
 	t.Cleanup(func() {
 		client.Close()
@@ -0,0 +1,86 @@
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Blogem/enron-graph/internal/graph"
+	"github.com/Blogem/enron-graph/internal/loader"
+	"github.com/Blogem/enron-graph/pkg/utils"
+)
+
+// TestThreadReconstruction tests threading replies by header, subject and quoted text
+func TestThreadReconstruction(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	ctx := context.Background()
+	client, db := SetupTestDBWithSQL(t)
+	logger := utils.NewLogger()
+	repo := graph.NewRepositoryWithDB(client, db, logger)
+	threader := loader.NewThreader(repo, logger)
+	date := time.Date(2001, 5, 14, 9, 0, 0, 0, time.UTC)
+
+	inputs := []*graph.EmailInput{
+		{MessageID: "thread-1@enron.com", From: "kenneth.lay@enron.com", To: []string{"jeff.skilling@enron.com"}, Subject: "Q2 forecast", Date: date},
+		{MessageID: "thread-2@enron.com", From: "jeff.skilling@enron.com", To: []string{"kenneth.lay@enron.com"}, Subject: "RE: Q2 forecast", Date: date.Add(time.Hour), InReplyTo: "thread-1@enron.com"},
+		{MessageID: "thread-3@enron.com", From: "sally.beck@enron.com", To: []string{"jeff.skilling@enron.com"}, Subject: "Numbers",
+			Body: "Attached.\n\n -----Original Message-----\nFrom: Skilling, Jeff\nSubject: RE: Q2 forecast\n\nSee below.", Date: date.Add(2 * time.Hour)},
+	}
+
+	var threadID int
+	for i, input := range inputs {
+		email, err := repo.CreateEmail(ctx, input)
+		if err != nil {
+			t.Fatalf("Failed to create email: %v", err)
+		}
+		thread, err := threader.ThreadEmail(ctx, email)
+		if err != nil {
+			t.Fatalf("ThreadEmail failed: %v", err)
+		}
+		if i == 0 {
+			threadID = thread.ID
+		} else if thread.ID != threadID {
+			t.Errorf("Expected %s in thread %d, got %d", input.MessageID, threadID, thread.ID)
+		}
+	}
+
+	thread, err := repo.GetThread(ctx, threadID)
+	if err != nil {
+		t.Fatalf("GetThread failed: %v", err)
+	}
+	if thread.MessageCount != 3 || thread.RootMessageID != "thread-1@enron.com" {
+		t.Errorf("Expected 3 messages rooted at thread-1, got %d rooted at %s", thread.MessageCount, thread.RootMessageID)
+	}
+	if len(thread.Participants) != 3 {
+		t.Errorf("Expected 3 participants, got %v", thread.Participants)
+	}
+	if !thread.LastDate.Equal(date.Add(2 * time.Hour)) {
+		t.Errorf("Expected last date of the latest reply, got %v", thread.LastDate)
+	}
+
+	messages, err := repo.FindThreadEmails(ctx, threadID)
+	if err != nil {
+		t.Fatalf("FindThreadEmails failed: %v", err)
+	}
+	if len(messages) != 3 {
+		t.Fatalf("Expected 3 thread messages, got %d", len(messages))
+	}
+	if messages[1].ParentID != messages[0].Email.ID || messages[1].Method != graph.ThreadByInReplyTo {
+		t.Errorf("Expected header reply to the root, got parent %d via %s", messages[1].ParentID, messages[1].Method)
+	}
+	if messages[2].ParentID != messages[1].Email.ID || messages[2].Method != graph.ThreadByQuote || messages[2].Confidence >= 1.0 {
+		t.Errorf("Expected inferred reply to the latest message, got parent %d via %s (%.1f)", messages[2].ParentID, messages[2].Method, messages[2].Confidence)
+	}
+
+	// Threading again is a no-op
+	again, err := threader.ThreadEmail(ctx, messages[2].Email)
+	if err != nil || again.ID != threadID {
+		t.Errorf("Expected rethreading to keep thread %d, got %v (%v)", threadID, again, err)
+	}
+	if thread, _ := repo.GetThread(ctx, threadID); thread.MessageCount != 3 {
+		t.Errorf("Expected message count to stay 3, got %d", thread.MessageCount)
+	}
+}
@@ -125,7 +125,7 @@ func TestFullWorkflow(t *testing.T) {
 	// Test relationship traversal
 	if len(personEntities) > 0 {
 		firstPerson := personEntities[0]
-		related, err := repo.TraverseRelationships(ctx, firstPerson.ID, "", 1)
+		related, err := repo.TraverseRelationships(ctx, firstPerson.ID, "", 1, graph.TimeWindow{})
 		require.NoError(t, err, "Failed to traverse relationships")
 		t.Logf("✓ Person '%s' has %d 1-hop relationships", firstPerson.Name, len(related))
 	}